@@ -320,6 +320,14 @@ config.yaml: |
   {{- if .Values.config.exposureClassHandlers }}
   exposureClassHandlers:
 {{ toYaml .Values.config.exposureClassHandlers | indent 2 }}
+  {{- end }}
+  {{- if .Values.config.shootStateEncryption }}
+  shootStateEncryption:
+{{ toYaml .Values.config.shootStateEncryption | indent 4 }}
+  {{- end }}
+  {{- if .Values.config.nodeAgent }}
+  nodeAgent:
+{{ toYaml .Values.config.nodeAgent | indent 4 }}
   {{- end }}
   {{- if .Values.nodeToleration }}
   nodeToleration:
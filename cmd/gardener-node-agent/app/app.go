@@ -57,12 +57,17 @@ import (
 	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/nodeagent/bootstrap"
 	"github.com/gardener/gardener/pkg/nodeagent/controller"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
-// Name is a const for the name of this component.
-const Name = "gardener-node-agent"
+const (
+	// Name is a const for the name of this component.
+	Name = "gardener-node-agent"
+
+	planHandlerPath = "/debug/operatingsystemconfig/diff"
+)
 
 // NewCommand creates a new cobra.Command for running gardener-node-agent.
 func NewCommand() *cobra.Command {
@@ -87,6 +92,7 @@ func NewCommand() *cobra.Command {
 	opts.addFlags(flags)
 
 	cmd.AddCommand(getBootstrapCommand(opts))
+	cmd.AddCommand(getDiffCommand(opts))
 	return cmd
 }
 
@@ -144,9 +150,19 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *c
 		}
 	}
 
-	var extraHandlers map[string]http.Handler
+	var (
+		fs   = afero.Afero{Fs: afero.NewOsFs()}
+		dbus = dbus.New(log)
+
+		planHandler   = &operatingsystemconfig.PlanHandler{FS: fs, SecretName: cfg.Controllers.OperatingSystemConfig.SecretName}
+		extraHandlers = map[string]http.Handler{}
+	)
+
 	if cfg.Debugging != nil && cfg.Debugging.EnableProfiling {
-		extraHandlers = routes.ProfilingHandlers
+		extraHandlers[planHandlerPath] = planHandler
+		for path, handler := range routes.ProfilingHandlers {
+			extraHandlers[path] = handler
+		}
 		if cfg.Debugging.EnableContentionProfiling {
 			goruntime.SetBlockProfileRate(1)
 		}
@@ -207,10 +223,9 @@ func run(ctx context.Context, cancel context.CancelFunc, log logr.Logger, cfg *c
 		return err
 	}
 
-	var (
-		fs   = afero.Afero{Fs: afero.NewOsFs()}
-		dbus = dbus.New(log)
-	)
+	// The plan handler is registered at the metrics server before the manager is created, hence its reader can only be
+	// set now.
+	planHandler.Reader = mgr.GetClient()
 
 	log.Info("Creating directory for temporary files", "path", nodeagentv1alpha1.TempDir)
 	if err := fs.MkdirAll(nodeagentv1alpha1.TempDir, os.ModeDir); err != nil {
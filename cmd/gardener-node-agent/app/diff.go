@@ -0,0 +1,157 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/component-base/version/verflag"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/cmd/utils"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/nodeagent/apis/config"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+)
+
+const (
+	diffOutputText = "text"
+	diffOutputJSON = "json"
+)
+
+type diffOptions struct {
+	file   string
+	output string
+}
+
+func (o *diffOptions) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.file, "file", o.file, "Path to a file containing the OperatingSystemConfig (or the secret containing it). If not set, the secret is read from the API server.")
+	fs.StringVarP(&o.output, "output", "o", diffOutputText, "Output format, one of 'text' or 'json'.")
+}
+
+func (o *diffOptions) validate() error {
+	if o.output != diffOutputText && o.output != diffOutputJSON {
+		return fmt.Errorf("unsupported output format %q, must be one of %q or %q", o.output, diffOutputText, diffOutputJSON)
+	}
+	return nil
+}
+
+func getDiffCommand(opts *options) *cobra.Command {
+	diffOpts := &diffOptions{}
+
+	diffCmd := &cobra.Command{
+		Use:     "diff",
+		Aliases: []string{"plan"},
+		Short:   "Show the changes the " + Name + " would apply to the node for the current operating system config",
+		Long: "Computes the files and units which would be changed or deleted, and the systemd actions which would be " +
+			"performed, when applying the operating system config. Nothing is changed on the node.",
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			log, err := utils.InitRun(cmd, opts, Name+"-diff")
+			if err != nil {
+				return err
+			}
+			if err := diffOpts.validate(); err != nil {
+				return err
+			}
+			return runDiff(cmd.Context(), log, cmd.OutOrStdout(), afero.Afero{Fs: afero.NewOsFs()}, opts.config, diffOpts)
+		},
+	}
+
+	flags := diffCmd.Flags()
+	verflag.AddFlags(flags)
+	opts.addFlags(flags)
+	diffOpts.addFlags(flags)
+
+	return diffCmd
+}
+
+func runDiff(ctx context.Context, log logr.Logger, out io.Writer, fs afero.Afero, cfg *config.NodeAgentConfiguration, opts *diffOptions) error {
+	osc, err := readOperatingSystemConfig(ctx, log, cfg, opts.file)
+	if err != nil {
+		return err
+	}
+
+	plan, err := operatingsystemconfig.ComputePlan(fs, osc)
+	if err != nil {
+		return err
+	}
+
+	if opts.output == diffOutputJSON {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(plan)
+	}
+	return plan.Print(out)
+}
+
+func readOperatingSystemConfig(ctx context.Context, log logr.Logger, cfg *config.NodeAgentConfiguration, file string) (*extensionsv1alpha1.OperatingSystemConfig, error) {
+	if file != "" {
+		log.Info("Reading operating system config from file", "path", file)
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed reading file %q: %w", file, err)
+		}
+		return operatingsystemconfig.DecodeOperatingSystemConfig(data)
+	}
+
+	if kubeconfig := os.Getenv("KUBECONFIG"); kubeconfig != "" {
+		cfg.ClientConnection.Kubeconfig = kubeconfig
+	}
+
+	var (
+		restConfig *rest.Config
+		err        error
+	)
+
+	if len(cfg.ClientConnection.Kubeconfig) > 0 {
+		restConfig, err = kubernetes.RESTConfigFromClientConnectionConfiguration(&cfg.ClientConnection, nil, kubernetes.AuthTokenFile)
+	} else {
+		restConfig, _, err = getRESTConfig(log, cfg)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed getting REST config: %w", err)
+	}
+
+	c, err := client.New(restConfig, client.Options{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create client: %w", err)
+	}
+
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: cfg.Controllers.OperatingSystemConfig.SecretName, Namespace: metav1.NamespaceSystem}}
+	log.Info("Reading operating system config secret", "secret", client.ObjectKeyFromObject(secret))
+	if err := c.Get(ctx, client.ObjectKeyFromObject(secret), secret); err != nil {
+		return nil, fmt.Errorf("failed reading secret %s: %w", client.ObjectKeyFromObject(secret), err)
+	}
+
+	oscRaw, ok := secret.Data[nodeagentv1alpha1.DataKeyOperatingSystemConfig]
+	if !ok {
+		return nil, fmt.Errorf("no %s key found in secret %s", nodeagentv1alpha1.DataKeyOperatingSystemConfig, client.ObjectKeyFromObject(secret))
+	}
+	return operatingsystemconfig.DecodeOperatingSystemConfig(oscRaw)
+}
@@ -46,14 +46,28 @@ import (
 	gardenerhealthz "github.com/gardener/gardener/pkg/healthz"
 	"github.com/gardener/gardener/pkg/scheduler/apis/config"
 	"github.com/gardener/gardener/pkg/scheduler/controller"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
+	"github.com/gardener/gardener/pkg/scheduler/framework/plugins"
+	"github.com/gardener/gardener/pkg/scheduler/preview"
 	"github.com/gardener/gardener/pkg/utils"
 )
 
 // Name is a const for the name of this component.
 const Name = "gardener-scheduler"
 
+// Option configures the out-of-tree registry of the scheduling framework.
+type Option func(framework.Registry) error
+
+// WithPlugin returns an Option which registers the given out-of-tree plugin. It allows to build a gardener-scheduler
+// binary with custom filter or score plugins which can then be enabled in the scheduler configuration.
+func WithPlugin(name string, factory framework.PluginFactory) Option {
+	return func(registry framework.Registry) error {
+		return registry.Register(name, factory)
+	}
+}
+
 // NewCommand creates a new cobra.Command for running gardener-scheduler.
-func NewCommand() *cobra.Command {
+func NewCommand(registryOptions ...Option) *cobra.Command {
 	opts := &options{}
 
 	cmd := &cobra.Command{
@@ -65,7 +79,15 @@ func NewCommand() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			return run(cmd.Context(), log, opts.config)
+
+			outOfTreeRegistry := framework.Registry{}
+			for _, option := range registryOptions {
+				if err := option(outOfTreeRegistry); err != nil {
+					return err
+				}
+			}
+
+			return run(cmd.Context(), log, opts.config, outOfTreeRegistry)
 		},
 	}
 
@@ -76,7 +98,7 @@ func NewCommand() *cobra.Command {
 	return cmd
 }
 
-func run(ctx context.Context, log logr.Logger, cfg *config.SchedulerConfiguration) error {
+func run(ctx context.Context, log logr.Logger, cfg *config.SchedulerConfiguration, outOfTreeRegistry framework.Registry) error {
 	log.Info("Feature Gates", "featureGates", features.DefaultFeatureGate)
 
 	log.Info("Getting rest config")
@@ -89,6 +111,11 @@ func run(ctx context.Context, log logr.Logger, cfg *config.SchedulerConfiguratio
 		return err
 	}
 
+	shootFramework, err := plugins.NewFramework(cfg.Schedulers.Shoot, outOfTreeRegistry)
+	if err != nil {
+		return fmt.Errorf("failed creating scheduling framework for Shoot controller: %w", err)
+	}
+
 	var extraHandlers map[string]http.Handler
 	if cfg.Debugging != nil && cfg.Debugging.EnableProfiling {
 		extraHandlers = routes.ProfilingHandlers
@@ -140,8 +167,20 @@ func run(ctx context.Context, log logr.Logger, cfg *config.SchedulerConfiguratio
 		return err
 	}
 
+	if previewConfig := cfg.Schedulers.Shoot.SchedulingPreview; previewConfig != nil && previewConfig.Enabled {
+		log.Info("Adding scheduling preview endpoint to manager", "port", previewConfig.Port)
+		previewHandler := &preview.Handler{
+			Framework:       shootFramework,
+			GardenNamespace: v1beta1constants.GardenNamespace,
+			Logger:          log.WithName("scheduling-preview"),
+		}
+		if err := previewHandler.AddToManager(mgr, previewConfig.Port); err != nil {
+			return fmt.Errorf("failed adding scheduling preview endpoint to manager: %w", err)
+		}
+	}
+
 	log.Info("Adding controllers to manager")
-	if err := controller.AddToManager(mgr, cfg); err != nil {
+	if err := controller.AddToManager(mgr, cfg, shootFramework); err != nil {
 		return fmt.Errorf("failed adding controllers to manager: %w", err)
 	}
 
@@ -0,0 +1,99 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	"k8s.io/component-base/version/verflag"
+	"k8s.io/kms/pkg/service"
+
+	cmdutils "github.com/gardener/gardener/cmd/utils"
+	"github.com/gardener/gardener/pkg/provider-local/kms"
+)
+
+// Name is a const for the name of this component.
+const Name = "local-kms-plugin"
+
+// NewCommand creates a new cobra.Command for running the local KMS plugin. It is a mock KMS v2 plugin which is only
+// meant for testing the KMS based encryption of resources in the local setup.
+func NewCommand() *cobra.Command {
+	opts := &options{}
+
+	cmd := &cobra.Command{
+		Use:   Name,
+		Short: "Launch the " + Name,
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			log, err := cmdutils.InitRun(cmd, opts, Name)
+			if err != nil {
+				return err
+			}
+			return run(cmd.Context(), log, opts)
+		},
+	}
+
+	flags := cmd.Flags()
+	verflag.AddFlags(flags)
+	opts.addFlags(flags)
+
+	return cmd
+}
+
+func run(ctx context.Context, log logr.Logger, opts *options) error {
+	kmsService, err := kms.NewService(opts.secret, opts.keyID)
+	if err != nil {
+		return err
+	}
+
+	// The socket file might still exist from a previous run of the container since it is located in a volume shared
+	// with the API server.
+	if err := os.Remove(opts.socketPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed removing stale socket file: %w", err)
+	}
+
+	grpcService := service.NewGRPCService(opts.socketPath, opts.timeout, kmsService)
+
+	errCh := make(chan error, 1)
+	go func() {
+		log.Info("Serving KMS v2 API", "socketPath", opts.socketPath, "keyID", opts.keyID)
+		errCh <- grpcService.ListenAndServe()
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		log.Info("Shutting down")
+		shutdownDone := make(chan struct{})
+		go func() {
+			grpcService.Shutdown()
+			close(shutdownDone)
+		}()
+
+		select {
+		case <-shutdownDone:
+		case <-time.After(opts.timeout):
+			grpcService.Close()
+		}
+		return nil
+	}
+}
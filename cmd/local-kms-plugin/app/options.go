@@ -0,0 +1,72 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package app
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/pflag"
+
+	"github.com/gardener/gardener/cmd/utils"
+	"github.com/gardener/gardener/pkg/logger"
+)
+
+type options struct {
+	socketPath string
+	keyFile    string
+	keyID      string
+	timeout    time.Duration
+
+	secret []byte
+}
+
+var _ utils.Options = &options{}
+
+func (o *options) addFlags(fs *pflag.FlagSet) {
+	fs.StringVar(&o.socketPath, "socket-path", o.socketPath, "Path of the unix socket the KMS plugin listens on.")
+	fs.StringVar(&o.keyFile, "key-file", o.keyFile, "Path to the file containing the secret from which the key encryption keys are derived.")
+	fs.StringVar(&o.keyID, "key-id", o.keyID, "ID of the key which is used for encrypting data.")
+	fs.DurationVar(&o.timeout, "timeout", 3*time.Second, "Timeout for gRPC connections.")
+}
+
+func (o *options) Complete() error {
+	if len(o.keyFile) == 0 {
+		return fmt.Errorf("missing key file")
+	}
+
+	secret, err := os.ReadFile(o.keyFile)
+	if err != nil {
+		return fmt.Errorf("error reading key file: %w", err)
+	}
+	o.secret = secret
+
+	return nil
+}
+
+func (o *options) Validate() error {
+	if len(o.socketPath) == 0 {
+		return fmt.Errorf("missing socket path")
+	}
+	if len(o.keyID) == 0 {
+		return fmt.Errorf("missing key ID")
+	}
+	return nil
+}
+
+func (o *options) LogConfig() (logLevel, logFormat string) {
+	return logger.InfoLevel, logger.FormatJSON
+}
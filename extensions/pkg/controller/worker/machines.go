@@ -110,11 +110,13 @@ func WorkerPoolHash(pool extensionsv1alpha1.WorkerPool, cluster *extensionscontr
 		return "", err
 	}
 
-	data := []string{
-		shootVersionMajorMinor,
-		pool.MachineType,
-		pool.MachineImage.Name + pool.MachineImage.Version,
+	var data []string
+	// The nodes of worker pools which are updated in-place get the new Kubernetes version via gardener-node-agent,
+	// hence the machines must not be replaced when the version changes.
+	if !isUpdateStrategyInPlace(pool.Name, cluster) {
+		data = append(data, shootVersionMajorMinor)
 	}
+	data = append(data, pool.MachineType, pool.MachineImage.Name+pool.MachineImage.Version)
 
 	if pool.Volume != nil {
 		data = append(data, pool.Volume.Size)
@@ -159,6 +161,15 @@ func WorkerPoolHash(pool extensionsv1alpha1.WorkerPool, cluster *extensionscontr
 	return utils.ComputeSHA256Hex([]byte(result))[:5], nil
 }
 
+func isUpdateStrategyInPlace(poolName string, cluster *extensionscontroller.Cluster) bool {
+	for _, worker := range cluster.Shoot.Spec.Provider.Workers {
+		if worker.Name == poolName {
+			return helper.IsUpdateStrategyInPlace(worker.UpdateStrategy)
+		}
+	}
+	return false
+}
+
 // DistributeOverZones is a function which is used to determine how many nodes should be used
 // for each availability zone. It takes the number of availability zones (<zoneSize>), the
 // index of the current zone (<zoneIndex>) and the number of nodes which must be distributed
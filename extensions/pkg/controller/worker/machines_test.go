@@ -190,6 +190,16 @@ var _ = Describe("Machines", func() {
 			It("when disabling node local dns via specification", func() {
 				c.Shoot.Spec.SystemComponents = &gardencorev1beta1.SystemComponents{NodeLocalDNS: &gardencorev1beta1.NodeLocalDNS{Enabled: false}}
 			})
+
+			It("when changing the kubernetes minor version of a worker pool which is updated in-place", func() {
+				c.Shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{{Name: p.Name, UpdateStrategy: ptr.To(gardencorev1beta1.AutoInPlaceUpdate)}}
+
+				var err error
+				hash, err = WorkerPoolHash(p, c)
+				Expect(err).NotTo(HaveOccurred())
+
+				p.KubernetesVersion = ptr.To("1.3.0")
+			})
 		})
 
 		Context("hash value should change", func() {
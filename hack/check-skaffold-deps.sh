@@ -38,6 +38,7 @@ run "skaffold.yaml" "gardener-resource-manager"          "gardenlet"
 run "skaffold.yaml" "gardener-node-agent"                "gardenlet"
 run "skaffold.yaml" "gardener-scheduler"                 "controlplane"
 run "skaffold.yaml" "gardenlet"                          "gardenlet"
+run "skaffold.yaml" "local-kms-plugin"                   "provider-local"
 
 # skaffold-operator.yaml
 run "skaffold-operator.yaml" "gardener-operator"             "gardener-operator"
@@ -510,6 +510,11 @@ func IsWorkerless(shoot *core.Shoot) bool {
 	return len(shoot.Spec.Provider.Workers) == 0
 }
 
+// IsUpdateStrategyInPlace returns true if the given update strategy updates the nodes of a worker pool in-place.
+func IsUpdateStrategyInPlace(updateStrategy *core.MachineUpdateStrategy) bool {
+	return updateStrategy != nil && *updateStrategy == core.AutoInPlaceUpdate
+}
+
 // ShootEnablesSSHAccess returns true if ssh access to worker nodes should be allowed for the given shoot.
 func ShootEnablesSSHAccess(shoot *core.Shoot) bool {
 	return !IsWorkerless(shoot) &&
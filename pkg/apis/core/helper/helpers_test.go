@@ -979,6 +979,16 @@ var _ = Describe("helper", func() {
 		})
 	})
 
+	DescribeTable("#IsUpdateStrategyInPlace",
+		func(updateStrategy *core.MachineUpdateStrategy, expected bool) {
+			Expect(IsUpdateStrategyInPlace(updateStrategy)).To(Equal(expected))
+		},
+
+		Entry("with nil", nil, false),
+		Entry("with rolling update strategy", ptr.To(core.AutoRollingUpdate), false),
+		Entry("with in-place update strategy", ptr.To(core.AutoInPlaceUpdate), true),
+	)
+
 	DescribeTable("#ShootEnablesSSHAccess",
 		func(workers []core.Worker, workersSettings *core.WorkersSettings, expectedResult bool) {
 			shoot := &core.Shoot{
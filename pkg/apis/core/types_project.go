@@ -66,6 +66,9 @@ type ProjectSpec struct {
 	Namespace *string
 	// Tolerations contains the default tolerations and a list for allowed taints on seed clusters.
 	Tolerations *ProjectTolerations
+	// MaintenanceFreezePeriods is a list of absolute periods of time in which no automatic maintenance operations are
+	// performed for any shoot of the project. They are honored in addition to the freeze periods of the shoots.
+	MaintenanceFreezePeriods []MaintenanceFreezePeriod
 }
 
 // ProjectStatus holds the most recently observed status of the project.
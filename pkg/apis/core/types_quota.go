@@ -29,6 +29,8 @@ type Quota struct {
 	metav1.ObjectMeta
 	// Spec defines the Quota constraints.
 	Spec QuotaSpec
+	// Status contains the most recently observed usage of the Quota.
+	Status QuotaStatus
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -52,6 +54,35 @@ type QuotaSpec struct {
 	Scope corev1.ObjectReference
 }
 
+// QuotaStatus holds the most recently observed usage of a Quota.
+type QuotaStatus struct {
+	// Hard is the set of hard limits enforced for each metric.
+	Hard corev1.ResourceList
+	// Usages is the list of observed usages of the Quota. For Quotas with scope 'secret', there is a single usage
+	// accounting all Shoots using the Quota. For Quotas with scope 'project', there is one usage per project namespace.
+	Usages []QuotaUsage
+}
+
+// QuotaUsage is the observed usage of a Quota within its scope.
+type QuotaUsage struct {
+	// Namespace is the project namespace the usage is accounted for. It is only set for Quotas with scope 'project'.
+	Namespace *string
+	// Used is the observed usage for each metric.
+	Used corev1.ResourceList
+	// Shoots is the list of Shoots contributing to the usage.
+	Shoots []QuotaUsageShoot
+}
+
+// QuotaUsageShoot references a Shoot contributing to the usage of a Quota.
+type QuotaUsageShoot struct {
+	// Namespace is the namespace of the Shoot.
+	Namespace string
+	// Name is the name of the Shoot.
+	Name string
+	// Generation is the generation of the Shoot which the usage was computed for.
+	Generation int64
+}
+
 const (
 	// QuotaMetricCPU is the constraint for the amount of CPUs
 	QuotaMetricCPU corev1.ResourceName = corev1.ResourceCPU
@@ -105,6 +105,20 @@ type SeedStatus struct {
 	ClientCertificateExpirationTimestamp *metav1.Time
 	// LastOperation holds information about the last operation on the Seed.
 	LastOperation *LastOperation
+	// ShootStateEncryptionKeys contains the key encryption keys which are available to the gardenlet for decrypting the
+	// sensitive data persisted in ShootStates.
+	ShootStateEncryptionKeys []ShootStateEncryptionKey
+}
+
+// ShootStateEncryptionKey describes a key encryption key which is available to the gardenlet for decrypting the
+// sensitive data persisted in ShootStates.
+type ShootStateEncryptionKey struct {
+	// Name is the name of the key encryption key.
+	Name string
+	// Fingerprint is the fingerprint of the key encryption key.
+	Fingerprint string
+	// Primary states whether the key encryption key is used for wrapping the data encryption keys of ShootStates.
+	Primary bool
 }
 
 // SeedBackup contains the object store configuration for backups for shoot (currently only etcd).
@@ -313,6 +327,9 @@ const (
 	// SeedTaintProtected is a constant for a taint key on a seed that marks it as protected. Protected seeds
 	// may only be used by shoots in the `garden` namespace.
 	SeedTaintProtected = "seed.gardener.cloud/protected"
+	// SeedTaintEvacuation is a constant for a taint key on a seed that marks it for evacuation. All shoots scheduled
+	// onto an evacuated seed are migrated to other seeds by the gardener-controller-manager.
+	SeedTaintEvacuation = "seed.gardener.cloud/evacuation"
 )
 
 // SeedVolume contains settings for persistentvolumes created in the seed cluster.
@@ -334,6 +351,9 @@ type SeedVolumeProvider struct {
 const (
 	// SeedBackupBucketsReady is a constant for a condition type indicating that associated BackupBuckets are ready.
 	SeedBackupBucketsReady ConditionType = "BackupBucketsReady"
+	// SeedEvacuated is a constant for a condition type indicating whether all shoots have been migrated off a seed
+	// which is marked for evacuation.
+	SeedEvacuated ConditionType = "Evacuated"
 	// SeedExtensionsReady is a constant for a condition type indicating that the extensions are ready.
 	SeedExtensionsReady ConditionType = "ExtensionsReady"
 	// SeedGardenletReady is a constant for a condition type indicating that the Gardenlet is ready.
@@ -164,6 +164,24 @@ type ShootStatus struct {
 	// Secrets are encrypted by default and are not part of the list.
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for more details.
 	EncryptedResources []string
+	// LastActivityTime is the last time when user activity was observed in the Shoot cluster. It is only maintained
+	// if the Shoot has an idle hibernation policy.
+	LastActivityTime *metav1.Time
+	// InPlaceUpdates contains the progress of the in-place updates of worker pools using the in-place update strategy.
+	InPlaceUpdates []WorkerPoolInPlaceUpdate
+}
+
+// WorkerPoolInPlaceUpdate contains the progress of the in-place update of a worker pool.
+type WorkerPoolInPlaceUpdate struct {
+	// Name is the name of the worker pool.
+	Name string
+	// KubernetesVersion is the Kubernetes version the nodes of the worker pool are updated to.
+	KubernetesVersion string
+	// Nodes is the number of nodes of the worker pool.
+	Nodes int32
+	// UpdatedNodes is the number of nodes of the worker pool which have already applied the desired operating system
+	// config.
+	UpdatedNodes int32
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -414,6 +432,16 @@ type Hibernation struct {
 	Enabled *bool
 	// Schedules determine the hibernation schedules.
 	Schedules []HibernationSchedule
+	// IdlePolicy determines that the Shoot is hibernated automatically after it has not been used for a while.
+	IdlePolicy *HibernationIdlePolicy
+}
+
+// HibernationIdlePolicy determines when an idle Shoot is hibernated automatically.
+type HibernationIdlePolicy struct {
+	// IdleDuration is the duration without any user activity after which the Shoot is hibernated, e.g. `8h`.
+	// Requests to the API server of the Shoot which are not issued by system components as well as changes to
+	// workload resources count as activity.
+	IdleDuration metav1.Duration
 }
 
 // HibernationSchedule determines the hibernation schedule of a Shoot.
@@ -621,6 +649,22 @@ type EncryptionConfig struct {
 	// Wildcards are not supported for now.
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for more details.
 	Resources []string
+	// KMS contains the configuration of a KMS v2 plugin which shall be used for envelope encryption of the resources.
+	// If not set, the resources are encrypted with an `aescbc` key managed by Gardener.
+	// Note that KMS is only supported for versions >= 1.29 and it cannot be removed once configured.
+	KMS *KMSConfig
+}
+
+// KMSConfig contains the configuration of a KMS v2 plugin used for the encryption of resources in etcd.
+type KMSConfig struct {
+	// Type is the type of the KMS plugin. The extension registered for this type is responsible for running the KMS
+	// plugin next to the kube-apiserver.
+	Type string
+	// CredentialsResourceName is the name of a resource in `.spec.resources` which contains the credentials for the
+	// KMS provider. The referenced resource must be a secret.
+	CredentialsResourceName *string
+	// ProviderConfig is the provider-specific configuration of the KMS plugin.
+	ProviderConfig *runtime.RawExtension
 }
 
 // ServiceAccountConfig is the kube-apiserver configuration for service accounts.
@@ -1022,6 +1066,19 @@ type Maintenance struct {
 	// Instead, they are rolled out during the shoot's maintenance time window. There is one exception that will trigger
 	// an immediate roll out which is changes to the Spec.Hibernation.Enabled field.
 	ConfineSpecUpdateRollout *bool
+	// FreezePeriods is a list of absolute periods of time in which no automatic maintenance operations are performed,
+	// e.g. during a year-end change freeze.
+	FreezePeriods []MaintenanceFreezePeriod
+}
+
+// MaintenanceFreezePeriod is an absolute period of time in which no automatic maintenance operations are performed.
+type MaintenanceFreezePeriod struct {
+	// Begin is the beginning of the freeze period.
+	Begin metav1.Time
+	// End is the end of the freeze period.
+	End metav1.Time
+	// Reason is a human-readable explanation of the freeze period.
+	Reason *string
 }
 
 // MaintenanceAutoUpdate contains information about which constraints should be automatically updated.
@@ -1040,6 +1097,9 @@ type MaintenanceTimeWindow struct {
 	// End is the end of the time window in the format HHMMSS+ZONE, e.g. "220000+0100".
 	// If not present, the value will be computed based on the "Begin" value.
 	End string
+	// Weekdays restricts the time window to the given days of the week (e.g. "Tuesday") on which it may begin. The days
+	// refer to the time zone of "Begin". If empty, the time window may begin on every day.
+	Weekdays []string
 }
 
 // Monitoring contains information about the monitoring configuration for the shoot.
@@ -1121,8 +1181,22 @@ type Worker struct {
 	Sysctls map[string]string
 	// ClusterAutoscaler contains the cluster autoscaler configurations for the worker pool.
 	ClusterAutoscaler *ClusterAutoscalerOptions
+	// UpdateStrategy specifies how the nodes of the worker pool are updated when its Kubernetes version changes.
+	// Defaults to 'AutoRollingUpdate'.
+	UpdateStrategy *MachineUpdateStrategy
 }
 
+// MachineUpdateStrategy is a type for the strategy used to update the nodes of a worker pool.
+type MachineUpdateStrategy string
+
+const (
+	// AutoRollingUpdate is a constant for the update strategy which replaces the machines of a worker pool.
+	AutoRollingUpdate MachineUpdateStrategy = "AutoRollingUpdate"
+	// AutoInPlaceUpdate is a constant for the update strategy which updates the nodes of a worker pool in-place, i.e.,
+	// the nodes are drained and updated one after another without replacing the machines.
+	AutoInPlaceUpdate MachineUpdateStrategy = "AutoInPlaceUpdate"
+)
+
 // ClusterAutoscalerOptions contains the cluster autoscaler configurations for a worker pool.
 type ClusterAutoscalerOptions struct {
 	// ScaleDownUtilizationThreshold defines the threshold in fraction (0.0 - 1.0) under which a node is being removed.
@@ -1328,6 +1402,10 @@ const (
 	ShootEventSchedulingSuccessful = "SchedulingSuccessful"
 	// ShootEventSchedulingFailed indicates that a scheduling decision failed.
 	ShootEventSchedulingFailed = "SchedulingFailed"
+	// ShootEventEvacuationStarted indicates that the shoot is being migrated off an evacuated seed.
+	ShootEventEvacuationStarted = "EvacuationStarted"
+	// ShootEventEvacuationFailed indicates that the shoot could not be migrated off an evacuated seed.
+	ShootEventEvacuationFailed = "EvacuationFailed"
 )
 
 const (
@@ -329,6 +329,13 @@ const (
 	// Note that this annotation is alpha and can be removed anytime without further notice. Only use it if you know
 	// what you do.
 	ShootAlphaControlPlaneHAVPN = "alpha.control-plane.shoot.gardener.cloud/high-availability-vpn"
+	// ShootAlphaWorkerCoordinatedOperatingSystemConfigRollout is a constant for an annotation on the Shoot resource to
+	// opt in for rolling out disruptive OperatingSystemConfig changes in a coordinated way across the nodes of each worker
+	// pool (at most 'maxUnavailable' nodes at a time). Worker pools with the in-place update strategy are always updated
+	// in a coordinated way.
+	// Note that this annotation is alpha and can be removed anytime without further notice. Only use it if you know
+	// what you do.
+	ShootAlphaWorkerCoordinatedOperatingSystemConfigRollout = "alpha.worker.shoot.gardener.cloud/coordinated-osc-rollout"
 	// ShootExpirationTimestamp is an annotation on a Shoot resource whose value represents the time when the Shoot lifetime
 	// is expired. The lifetime can be extended, but at most by the minimal value of the 'clusterLifetimeDays' property
 	// of referenced quotas.
@@ -573,6 +580,9 @@ const (
 	// DataTypeMachineState is a constant for a value of the 'Type' field in 'GardenerResourceData' structs describing
 	// that the data is machine state.
 	DataTypeMachineState = "machine-state"
+	// DataTypeDataEncryptionKey is a constant for a value of the 'Type' field in 'GardenerResourceData' structs
+	// describing that the data is the wrapped key used for encrypting the sensitive data of a ShootState.
+	DataTypeDataEncryptionKey = "data-encryption-key"
 
 	// DefaultSchedulerName is the name of the default scheduler.
 	DefaultSchedulerName = "default-scheduler"
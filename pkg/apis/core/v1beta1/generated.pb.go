@@ -1721,10 +1721,38 @@ func (m *Hibernation) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Hibernation proto.InternalMessageInfo
 
+func (m *HibernationIdlePolicy) Reset()      { *m = HibernationIdlePolicy{} }
+func (*HibernationIdlePolicy) ProtoMessage() {}
+func (*HibernationIdlePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{60}
+}
+func (m *HibernationIdlePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HibernationIdlePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HibernationIdlePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HibernationIdlePolicy.Merge(m, src)
+}
+func (m *HibernationIdlePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *HibernationIdlePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_HibernationIdlePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HibernationIdlePolicy proto.InternalMessageInfo
+
 func (m *HibernationSchedule) Reset()      { *m = HibernationSchedule{} }
 func (*HibernationSchedule) ProtoMessage() {}
 func (*HibernationSchedule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{60}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{61}
 }
 func (m *HibernationSchedule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1752,7 +1780,7 @@ var xxx_messageInfo_HibernationSchedule proto.InternalMessageInfo
 func (m *HighAvailability) Reset()      { *m = HighAvailability{} }
 func (*HighAvailability) ProtoMessage() {}
 func (*HighAvailability) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{61}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{62}
 }
 func (m *HighAvailability) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1780,7 +1808,7 @@ var xxx_messageInfo_HighAvailability proto.InternalMessageInfo
 func (m *HorizontalPodAutoscalerConfig) Reset()      { *m = HorizontalPodAutoscalerConfig{} }
 func (*HorizontalPodAutoscalerConfig) ProtoMessage() {}
 func (*HorizontalPodAutoscalerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{62}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{63}
 }
 func (m *HorizontalPodAutoscalerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1808,7 +1836,7 @@ var xxx_messageInfo_HorizontalPodAutoscalerConfig proto.InternalMessageInfo
 func (m *Ingress) Reset()      { *m = Ingress{} }
 func (*Ingress) ProtoMessage() {}
 func (*Ingress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{63}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{64}
 }
 func (m *Ingress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1836,7 +1864,7 @@ var xxx_messageInfo_Ingress proto.InternalMessageInfo
 func (m *IngressController) Reset()      { *m = IngressController{} }
 func (*IngressController) ProtoMessage() {}
 func (*IngressController) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{64}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{65}
 }
 func (m *IngressController) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1864,7 +1892,7 @@ var xxx_messageInfo_IngressController proto.InternalMessageInfo
 func (m *InternalSecret) Reset()      { *m = InternalSecret{} }
 func (*InternalSecret) ProtoMessage() {}
 func (*InternalSecret) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{65}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{66}
 }
 func (m *InternalSecret) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1892,7 +1920,7 @@ var xxx_messageInfo_InternalSecret proto.InternalMessageInfo
 func (m *InternalSecretList) Reset()      { *m = InternalSecretList{} }
 func (*InternalSecretList) ProtoMessage() {}
 func (*InternalSecretList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{66}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{67}
 }
 func (m *InternalSecretList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1917,10 +1945,38 @@ func (m *InternalSecretList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_InternalSecretList proto.InternalMessageInfo
 
+func (m *KMSConfig) Reset()      { *m = KMSConfig{} }
+func (*KMSConfig) ProtoMessage() {}
+func (*KMSConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{68}
+}
+func (m *KMSConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KMSConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *KMSConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KMSConfig.Merge(m, src)
+}
+func (m *KMSConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *KMSConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_KMSConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KMSConfig proto.InternalMessageInfo
+
 func (m *KubeAPIServerConfig) Reset()      { *m = KubeAPIServerConfig{} }
 func (*KubeAPIServerConfig) ProtoMessage() {}
 func (*KubeAPIServerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{67}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{69}
 }
 func (m *KubeAPIServerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1948,7 +2004,7 @@ var xxx_messageInfo_KubeAPIServerConfig proto.InternalMessageInfo
 func (m *KubeControllerManagerConfig) Reset()      { *m = KubeControllerManagerConfig{} }
 func (*KubeControllerManagerConfig) ProtoMessage() {}
 func (*KubeControllerManagerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{68}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{70}
 }
 func (m *KubeControllerManagerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1976,7 +2032,7 @@ var xxx_messageInfo_KubeControllerManagerConfig proto.InternalMessageInfo
 func (m *KubeProxyConfig) Reset()      { *m = KubeProxyConfig{} }
 func (*KubeProxyConfig) ProtoMessage() {}
 func (*KubeProxyConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{69}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{71}
 }
 func (m *KubeProxyConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2004,7 +2060,7 @@ var xxx_messageInfo_KubeProxyConfig proto.InternalMessageInfo
 func (m *KubeSchedulerConfig) Reset()      { *m = KubeSchedulerConfig{} }
 func (*KubeSchedulerConfig) ProtoMessage() {}
 func (*KubeSchedulerConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{70}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{72}
 }
 func (m *KubeSchedulerConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2032,7 +2088,7 @@ var xxx_messageInfo_KubeSchedulerConfig proto.InternalMessageInfo
 func (m *KubeletConfig) Reset()      { *m = KubeletConfig{} }
 func (*KubeletConfig) ProtoMessage() {}
 func (*KubeletConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{71}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{73}
 }
 func (m *KubeletConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2060,7 +2116,7 @@ var xxx_messageInfo_KubeletConfig proto.InternalMessageInfo
 func (m *KubeletConfigEviction) Reset()      { *m = KubeletConfigEviction{} }
 func (*KubeletConfigEviction) ProtoMessage() {}
 func (*KubeletConfigEviction) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{72}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{74}
 }
 func (m *KubeletConfigEviction) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2088,7 +2144,7 @@ var xxx_messageInfo_KubeletConfigEviction proto.InternalMessageInfo
 func (m *KubeletConfigEvictionMinimumReclaim) Reset()      { *m = KubeletConfigEvictionMinimumReclaim{} }
 func (*KubeletConfigEvictionMinimumReclaim) ProtoMessage() {}
 func (*KubeletConfigEvictionMinimumReclaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{73}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{75}
 }
 func (m *KubeletConfigEvictionMinimumReclaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2116,7 +2172,7 @@ var xxx_messageInfo_KubeletConfigEvictionMinimumReclaim proto.InternalMessageInf
 func (m *KubeletConfigEvictionSoftGracePeriod) Reset()      { *m = KubeletConfigEvictionSoftGracePeriod{} }
 func (*KubeletConfigEvictionSoftGracePeriod) ProtoMessage() {}
 func (*KubeletConfigEvictionSoftGracePeriod) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{74}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{76}
 }
 func (m *KubeletConfigEvictionSoftGracePeriod) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2144,7 +2200,7 @@ var xxx_messageInfo_KubeletConfigEvictionSoftGracePeriod proto.InternalMessageIn
 func (m *KubeletConfigReserved) Reset()      { *m = KubeletConfigReserved{} }
 func (*KubeletConfigReserved) ProtoMessage() {}
 func (*KubeletConfigReserved) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{75}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{77}
 }
 func (m *KubeletConfigReserved) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2172,7 +2228,7 @@ var xxx_messageInfo_KubeletConfigReserved proto.InternalMessageInfo
 func (m *Kubernetes) Reset()      { *m = Kubernetes{} }
 func (*Kubernetes) ProtoMessage() {}
 func (*Kubernetes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{76}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{78}
 }
 func (m *Kubernetes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2200,7 +2256,7 @@ var xxx_messageInfo_Kubernetes proto.InternalMessageInfo
 func (m *KubernetesConfig) Reset()      { *m = KubernetesConfig{} }
 func (*KubernetesConfig) ProtoMessage() {}
 func (*KubernetesConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{77}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{79}
 }
 func (m *KubernetesConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2228,7 +2284,7 @@ var xxx_messageInfo_KubernetesConfig proto.InternalMessageInfo
 func (m *KubernetesDashboard) Reset()      { *m = KubernetesDashboard{} }
 func (*KubernetesDashboard) ProtoMessage() {}
 func (*KubernetesDashboard) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{78}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{80}
 }
 func (m *KubernetesDashboard) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2256,7 +2312,7 @@ var xxx_messageInfo_KubernetesDashboard proto.InternalMessageInfo
 func (m *KubernetesSettings) Reset()      { *m = KubernetesSettings{} }
 func (*KubernetesSettings) ProtoMessage() {}
 func (*KubernetesSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{79}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{81}
 }
 func (m *KubernetesSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2284,7 +2340,7 @@ var xxx_messageInfo_KubernetesSettings proto.InternalMessageInfo
 func (m *LastError) Reset()      { *m = LastError{} }
 func (*LastError) ProtoMessage() {}
 func (*LastError) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{80}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{82}
 }
 func (m *LastError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2312,7 +2368,7 @@ var xxx_messageInfo_LastError proto.InternalMessageInfo
 func (m *LastMaintenance) Reset()      { *m = LastMaintenance{} }
 func (*LastMaintenance) ProtoMessage() {}
 func (*LastMaintenance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{81}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{83}
 }
 func (m *LastMaintenance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2340,7 +2396,7 @@ var xxx_messageInfo_LastMaintenance proto.InternalMessageInfo
 func (m *LastOperation) Reset()      { *m = LastOperation{} }
 func (*LastOperation) ProtoMessage() {}
 func (*LastOperation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{82}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{84}
 }
 func (m *LastOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2368,7 +2424,7 @@ var xxx_messageInfo_LastOperation proto.InternalMessageInfo
 func (m *Machine) Reset()      { *m = Machine{} }
 func (*Machine) ProtoMessage() {}
 func (*Machine) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{83}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{85}
 }
 func (m *Machine) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2396,7 +2452,7 @@ var xxx_messageInfo_Machine proto.InternalMessageInfo
 func (m *MachineControllerManagerSettings) Reset()      { *m = MachineControllerManagerSettings{} }
 func (*MachineControllerManagerSettings) ProtoMessage() {}
 func (*MachineControllerManagerSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{84}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{86}
 }
 func (m *MachineControllerManagerSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2424,7 +2480,7 @@ var xxx_messageInfo_MachineControllerManagerSettings proto.InternalMessageInfo
 func (m *MachineImage) Reset()      { *m = MachineImage{} }
 func (*MachineImage) ProtoMessage() {}
 func (*MachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{85}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{87}
 }
 func (m *MachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2452,7 +2508,7 @@ var xxx_messageInfo_MachineImage proto.InternalMessageInfo
 func (m *MachineImageVersion) Reset()      { *m = MachineImageVersion{} }
 func (*MachineImageVersion) ProtoMessage() {}
 func (*MachineImageVersion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{86}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{88}
 }
 func (m *MachineImageVersion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2480,7 +2536,7 @@ var xxx_messageInfo_MachineImageVersion proto.InternalMessageInfo
 func (m *MachineType) Reset()      { *m = MachineType{} }
 func (*MachineType) ProtoMessage() {}
 func (*MachineType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{87}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{89}
 }
 func (m *MachineType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2508,7 +2564,7 @@ var xxx_messageInfo_MachineType proto.InternalMessageInfo
 func (m *MachineTypeStorage) Reset()      { *m = MachineTypeStorage{} }
 func (*MachineTypeStorage) ProtoMessage() {}
 func (*MachineTypeStorage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{88}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{90}
 }
 func (m *MachineTypeStorage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2536,7 +2592,7 @@ var xxx_messageInfo_MachineTypeStorage proto.InternalMessageInfo
 func (m *Maintenance) Reset()      { *m = Maintenance{} }
 func (*Maintenance) ProtoMessage() {}
 func (*Maintenance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{89}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{91}
 }
 func (m *Maintenance) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2564,7 +2620,7 @@ var xxx_messageInfo_Maintenance proto.InternalMessageInfo
 func (m *MaintenanceAutoUpdate) Reset()      { *m = MaintenanceAutoUpdate{} }
 func (*MaintenanceAutoUpdate) ProtoMessage() {}
 func (*MaintenanceAutoUpdate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{90}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{92}
 }
 func (m *MaintenanceAutoUpdate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2589,10 +2645,38 @@ func (m *MaintenanceAutoUpdate) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MaintenanceAutoUpdate proto.InternalMessageInfo
 
+func (m *MaintenanceFreezePeriod) Reset()      { *m = MaintenanceFreezePeriod{} }
+func (*MaintenanceFreezePeriod) ProtoMessage() {}
+func (*MaintenanceFreezePeriod) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{93}
+}
+func (m *MaintenanceFreezePeriod) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaintenanceFreezePeriod) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *MaintenanceFreezePeriod) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaintenanceFreezePeriod.Merge(m, src)
+}
+func (m *MaintenanceFreezePeriod) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaintenanceFreezePeriod) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaintenanceFreezePeriod.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaintenanceFreezePeriod proto.InternalMessageInfo
+
 func (m *MaintenanceTimeWindow) Reset()      { *m = MaintenanceTimeWindow{} }
 func (*MaintenanceTimeWindow) ProtoMessage() {}
 func (*MaintenanceTimeWindow) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{91}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{94}
 }
 func (m *MaintenanceTimeWindow) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2620,7 +2704,7 @@ var xxx_messageInfo_MaintenanceTimeWindow proto.InternalMessageInfo
 func (m *MemorySwapConfiguration) Reset()      { *m = MemorySwapConfiguration{} }
 func (*MemorySwapConfiguration) ProtoMessage() {}
 func (*MemorySwapConfiguration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{92}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{95}
 }
 func (m *MemorySwapConfiguration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2648,7 +2732,7 @@ var xxx_messageInfo_MemorySwapConfiguration proto.InternalMessageInfo
 func (m *Monitoring) Reset()      { *m = Monitoring{} }
 func (*Monitoring) ProtoMessage() {}
 func (*Monitoring) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{93}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{96}
 }
 func (m *Monitoring) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2676,7 +2760,7 @@ var xxx_messageInfo_Monitoring proto.InternalMessageInfo
 func (m *NamedResourceReference) Reset()      { *m = NamedResourceReference{} }
 func (*NamedResourceReference) ProtoMessage() {}
 func (*NamedResourceReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{94}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{97}
 }
 func (m *NamedResourceReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2704,7 +2788,7 @@ var xxx_messageInfo_NamedResourceReference proto.InternalMessageInfo
 func (m *Networking) Reset()      { *m = Networking{} }
 func (*Networking) ProtoMessage() {}
 func (*Networking) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{95}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{98}
 }
 func (m *Networking) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2732,7 +2816,7 @@ var xxx_messageInfo_Networking proto.InternalMessageInfo
 func (m *NginxIngress) Reset()      { *m = NginxIngress{} }
 func (*NginxIngress) ProtoMessage() {}
 func (*NginxIngress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{96}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{99}
 }
 func (m *NginxIngress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2760,7 +2844,7 @@ var xxx_messageInfo_NginxIngress proto.InternalMessageInfo
 func (m *NodeLocalDNS) Reset()      { *m = NodeLocalDNS{} }
 func (*NodeLocalDNS) ProtoMessage() {}
 func (*NodeLocalDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{97}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{100}
 }
 func (m *NodeLocalDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2788,7 +2872,7 @@ var xxx_messageInfo_NodeLocalDNS proto.InternalMessageInfo
 func (m *OIDCConfig) Reset()      { *m = OIDCConfig{} }
 func (*OIDCConfig) ProtoMessage() {}
 func (*OIDCConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{98}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{101}
 }
 func (m *OIDCConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2816,7 +2900,7 @@ var xxx_messageInfo_OIDCConfig proto.InternalMessageInfo
 func (m *ObservabilityRotation) Reset()      { *m = ObservabilityRotation{} }
 func (*ObservabilityRotation) ProtoMessage() {}
 func (*ObservabilityRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{99}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{102}
 }
 func (m *ObservabilityRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2844,7 +2928,7 @@ var xxx_messageInfo_ObservabilityRotation proto.InternalMessageInfo
 func (m *OpenIDConnectClientAuthentication) Reset()      { *m = OpenIDConnectClientAuthentication{} }
 func (*OpenIDConnectClientAuthentication) ProtoMessage() {}
 func (*OpenIDConnectClientAuthentication) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{100}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{103}
 }
 func (m *OpenIDConnectClientAuthentication) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2872,7 +2956,7 @@ var xxx_messageInfo_OpenIDConnectClientAuthentication proto.InternalMessageInfo
 func (m *Project) Reset()      { *m = Project{} }
 func (*Project) ProtoMessage() {}
 func (*Project) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{101}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{104}
 }
 func (m *Project) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2900,7 +2984,7 @@ var xxx_messageInfo_Project proto.InternalMessageInfo
 func (m *ProjectList) Reset()      { *m = ProjectList{} }
 func (*ProjectList) ProtoMessage() {}
 func (*ProjectList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{102}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{105}
 }
 func (m *ProjectList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2928,7 +3012,7 @@ var xxx_messageInfo_ProjectList proto.InternalMessageInfo
 func (m *ProjectMember) Reset()      { *m = ProjectMember{} }
 func (*ProjectMember) ProtoMessage() {}
 func (*ProjectMember) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{103}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{106}
 }
 func (m *ProjectMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2956,7 +3040,7 @@ var xxx_messageInfo_ProjectMember proto.InternalMessageInfo
 func (m *ProjectSpec) Reset()      { *m = ProjectSpec{} }
 func (*ProjectSpec) ProtoMessage() {}
 func (*ProjectSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{104}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{107}
 }
 func (m *ProjectSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2984,7 +3068,7 @@ var xxx_messageInfo_ProjectSpec proto.InternalMessageInfo
 func (m *ProjectStatus) Reset()      { *m = ProjectStatus{} }
 func (*ProjectStatus) ProtoMessage() {}
 func (*ProjectStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{105}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{108}
 }
 func (m *ProjectStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3012,7 +3096,7 @@ var xxx_messageInfo_ProjectStatus proto.InternalMessageInfo
 func (m *ProjectTolerations) Reset()      { *m = ProjectTolerations{} }
 func (*ProjectTolerations) ProtoMessage() {}
 func (*ProjectTolerations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{106}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{109}
 }
 func (m *ProjectTolerations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3040,7 +3124,7 @@ var xxx_messageInfo_ProjectTolerations proto.InternalMessageInfo
 func (m *Provider) Reset()      { *m = Provider{} }
 func (*Provider) ProtoMessage() {}
 func (*Provider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{107}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{110}
 }
 func (m *Provider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3068,7 +3152,7 @@ var xxx_messageInfo_Provider proto.InternalMessageInfo
 func (m *Quota) Reset()      { *m = Quota{} }
 func (*Quota) ProtoMessage() {}
 func (*Quota) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{108}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{111}
 }
 func (m *Quota) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3096,7 +3180,7 @@ var xxx_messageInfo_Quota proto.InternalMessageInfo
 func (m *QuotaList) Reset()      { *m = QuotaList{} }
 func (*QuotaList) ProtoMessage() {}
 func (*QuotaList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{109}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{112}
 }
 func (m *QuotaList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3124,7 +3208,7 @@ var xxx_messageInfo_QuotaList proto.InternalMessageInfo
 func (m *QuotaSpec) Reset()      { *m = QuotaSpec{} }
 func (*QuotaSpec) ProtoMessage() {}
 func (*QuotaSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{110}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{113}
 }
 func (m *QuotaSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3149,10 +3233,94 @@ func (m *QuotaSpec) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QuotaSpec proto.InternalMessageInfo
 
+func (m *QuotaStatus) Reset()      { *m = QuotaStatus{} }
+func (*QuotaStatus) ProtoMessage() {}
+func (*QuotaStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{114}
+}
+func (m *QuotaStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QuotaStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaStatus.Merge(m, src)
+}
+func (m *QuotaStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaStatus proto.InternalMessageInfo
+
+func (m *QuotaUsage) Reset()      { *m = QuotaUsage{} }
+func (*QuotaUsage) ProtoMessage() {}
+func (*QuotaUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{115}
+}
+func (m *QuotaUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QuotaUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaUsage.Merge(m, src)
+}
+func (m *QuotaUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaUsage proto.InternalMessageInfo
+
+func (m *QuotaUsageShoot) Reset()      { *m = QuotaUsageShoot{} }
+func (*QuotaUsageShoot) ProtoMessage() {}
+func (*QuotaUsageShoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{116}
+}
+func (m *QuotaUsageShoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuotaUsageShoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *QuotaUsageShoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaUsageShoot.Merge(m, src)
+}
+func (m *QuotaUsageShoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuotaUsageShoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaUsageShoot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuotaUsageShoot proto.InternalMessageInfo
+
 func (m *Region) Reset()      { *m = Region{} }
 func (*Region) ProtoMessage() {}
 func (*Region) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{111}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{117}
 }
 func (m *Region) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3180,7 +3348,7 @@ var xxx_messageInfo_Region proto.InternalMessageInfo
 func (m *ResourceData) Reset()      { *m = ResourceData{} }
 func (*ResourceData) ProtoMessage() {}
 func (*ResourceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{112}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{118}
 }
 func (m *ResourceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3208,7 +3376,7 @@ var xxx_messageInfo_ResourceData proto.InternalMessageInfo
 func (m *ResourceWatchCacheSize) Reset()      { *m = ResourceWatchCacheSize{} }
 func (*ResourceWatchCacheSize) ProtoMessage() {}
 func (*ResourceWatchCacheSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{113}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{119}
 }
 func (m *ResourceWatchCacheSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3236,7 +3404,7 @@ var xxx_messageInfo_ResourceWatchCacheSize proto.InternalMessageInfo
 func (m *SSHAccess) Reset()      { *m = SSHAccess{} }
 func (*SSHAccess) ProtoMessage() {}
 func (*SSHAccess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{114}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{120}
 }
 func (m *SSHAccess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3264,7 +3432,7 @@ var xxx_messageInfo_SSHAccess proto.InternalMessageInfo
 func (m *SecretBinding) Reset()      { *m = SecretBinding{} }
 func (*SecretBinding) ProtoMessage() {}
 func (*SecretBinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{115}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{121}
 }
 func (m *SecretBinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3292,7 +3460,7 @@ var xxx_messageInfo_SecretBinding proto.InternalMessageInfo
 func (m *SecretBindingList) Reset()      { *m = SecretBindingList{} }
 func (*SecretBindingList) ProtoMessage() {}
 func (*SecretBindingList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{116}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{122}
 }
 func (m *SecretBindingList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3320,7 +3488,7 @@ var xxx_messageInfo_SecretBindingList proto.InternalMessageInfo
 func (m *SecretBindingProvider) Reset()      { *m = SecretBindingProvider{} }
 func (*SecretBindingProvider) ProtoMessage() {}
 func (*SecretBindingProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{117}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{123}
 }
 func (m *SecretBindingProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3348,7 +3516,7 @@ var xxx_messageInfo_SecretBindingProvider proto.InternalMessageInfo
 func (m *Seed) Reset()      { *m = Seed{} }
 func (*Seed) ProtoMessage() {}
 func (*Seed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{118}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{124}
 }
 func (m *Seed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3376,7 +3544,7 @@ var xxx_messageInfo_Seed proto.InternalMessageInfo
 func (m *SeedBackup) Reset()      { *m = SeedBackup{} }
 func (*SeedBackup) ProtoMessage() {}
 func (*SeedBackup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{119}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{125}
 }
 func (m *SeedBackup) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3404,7 +3572,7 @@ var xxx_messageInfo_SeedBackup proto.InternalMessageInfo
 func (m *SeedDNS) Reset()      { *m = SeedDNS{} }
 func (*SeedDNS) ProtoMessage() {}
 func (*SeedDNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{120}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{126}
 }
 func (m *SeedDNS) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3432,7 +3600,7 @@ var xxx_messageInfo_SeedDNS proto.InternalMessageInfo
 func (m *SeedDNSProvider) Reset()      { *m = SeedDNSProvider{} }
 func (*SeedDNSProvider) ProtoMessage() {}
 func (*SeedDNSProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{121}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{127}
 }
 func (m *SeedDNSProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3460,7 +3628,7 @@ var xxx_messageInfo_SeedDNSProvider proto.InternalMessageInfo
 func (m *SeedList) Reset()      { *m = SeedList{} }
 func (*SeedList) ProtoMessage() {}
 func (*SeedList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{122}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{128}
 }
 func (m *SeedList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3488,7 +3656,7 @@ var xxx_messageInfo_SeedList proto.InternalMessageInfo
 func (m *SeedNetworks) Reset()      { *m = SeedNetworks{} }
 func (*SeedNetworks) ProtoMessage() {}
 func (*SeedNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{123}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{129}
 }
 func (m *SeedNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3516,7 +3684,7 @@ var xxx_messageInfo_SeedNetworks proto.InternalMessageInfo
 func (m *SeedProvider) Reset()      { *m = SeedProvider{} }
 func (*SeedProvider) ProtoMessage() {}
 func (*SeedProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{124}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{130}
 }
 func (m *SeedProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3544,7 +3712,7 @@ var xxx_messageInfo_SeedProvider proto.InternalMessageInfo
 func (m *SeedSelector) Reset()      { *m = SeedSelector{} }
 func (*SeedSelector) ProtoMessage() {}
 func (*SeedSelector) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{125}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{131}
 }
 func (m *SeedSelector) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3572,7 +3740,7 @@ var xxx_messageInfo_SeedSelector proto.InternalMessageInfo
 func (m *SeedSettingDependencyWatchdog) Reset()      { *m = SeedSettingDependencyWatchdog{} }
 func (*SeedSettingDependencyWatchdog) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdog) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{126}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{132}
 }
 func (m *SeedSettingDependencyWatchdog) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3600,7 +3768,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdog proto.InternalMessageInfo
 func (m *SeedSettingDependencyWatchdogProber) Reset()      { *m = SeedSettingDependencyWatchdogProber{} }
 func (*SeedSettingDependencyWatchdogProber) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogProber) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{127}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{133}
 }
 func (m *SeedSettingDependencyWatchdogProber) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3628,7 +3796,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogProber proto.InternalMessageInf
 func (m *SeedSettingDependencyWatchdogWeeder) Reset()      { *m = SeedSettingDependencyWatchdogWeeder{} }
 func (*SeedSettingDependencyWatchdogWeeder) ProtoMessage() {}
 func (*SeedSettingDependencyWatchdogWeeder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{128}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{134}
 }
 func (m *SeedSettingDependencyWatchdogWeeder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3656,7 +3824,7 @@ var xxx_messageInfo_SeedSettingDependencyWatchdogWeeder proto.InternalMessageInf
 func (m *SeedSettingExcessCapacityReservation) Reset()      { *m = SeedSettingExcessCapacityReservation{} }
 func (*SeedSettingExcessCapacityReservation) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{129}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{135}
 }
 func (m *SeedSettingExcessCapacityReservation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3686,7 +3854,7 @@ func (m *SeedSettingExcessCapacityReservationConfig) Reset() {
 }
 func (*SeedSettingExcessCapacityReservationConfig) ProtoMessage() {}
 func (*SeedSettingExcessCapacityReservationConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{130}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{136}
 }
 func (m *SeedSettingExcessCapacityReservationConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3714,7 +3882,7 @@ var xxx_messageInfo_SeedSettingExcessCapacityReservationConfig proto.InternalMes
 func (m *SeedSettingLoadBalancerServices) Reset()      { *m = SeedSettingLoadBalancerServices{} }
 func (*SeedSettingLoadBalancerServices) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServices) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{131}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{137}
 }
 func (m *SeedSettingLoadBalancerServices) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3742,7 +3910,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServices proto.InternalMessageInfo
 func (m *SeedSettingLoadBalancerServicesZones) Reset()      { *m = SeedSettingLoadBalancerServicesZones{} }
 func (*SeedSettingLoadBalancerServicesZones) ProtoMessage() {}
 func (*SeedSettingLoadBalancerServicesZones) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{132}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{138}
 }
 func (m *SeedSettingLoadBalancerServicesZones) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3770,7 +3938,7 @@ var xxx_messageInfo_SeedSettingLoadBalancerServicesZones proto.InternalMessageIn
 func (m *SeedSettingScheduling) Reset()      { *m = SeedSettingScheduling{} }
 func (*SeedSettingScheduling) ProtoMessage() {}
 func (*SeedSettingScheduling) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{133}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{139}
 }
 func (m *SeedSettingScheduling) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3798,7 +3966,7 @@ var xxx_messageInfo_SeedSettingScheduling proto.InternalMessageInfo
 func (m *SeedSettingTopologyAwareRouting) Reset()      { *m = SeedSettingTopologyAwareRouting{} }
 func (*SeedSettingTopologyAwareRouting) ProtoMessage() {}
 func (*SeedSettingTopologyAwareRouting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{134}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
 }
 func (m *SeedSettingTopologyAwareRouting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3826,7 +3994,7 @@ var xxx_messageInfo_SeedSettingTopologyAwareRouting proto.InternalMessageInfo
 func (m *SeedSettingVerticalPodAutoscaler) Reset()      { *m = SeedSettingVerticalPodAutoscaler{} }
 func (*SeedSettingVerticalPodAutoscaler) ProtoMessage() {}
 func (*SeedSettingVerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{135}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
 }
 func (m *SeedSettingVerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3854,7 +4022,7 @@ var xxx_messageInfo_SeedSettingVerticalPodAutoscaler proto.InternalMessageInfo
 func (m *SeedSettings) Reset()      { *m = SeedSettings{} }
 func (*SeedSettings) ProtoMessage() {}
 func (*SeedSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{136}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
 }
 func (m *SeedSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3882,7 +4050,7 @@ var xxx_messageInfo_SeedSettings proto.InternalMessageInfo
 func (m *SeedSpec) Reset()      { *m = SeedSpec{} }
 func (*SeedSpec) ProtoMessage() {}
 func (*SeedSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{137}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
 }
 func (m *SeedSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3910,7 +4078,7 @@ var xxx_messageInfo_SeedSpec proto.InternalMessageInfo
 func (m *SeedStatus) Reset()      { *m = SeedStatus{} }
 func (*SeedStatus) ProtoMessage() {}
 func (*SeedStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{138}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
 }
 func (m *SeedStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3938,7 +4106,7 @@ var xxx_messageInfo_SeedStatus proto.InternalMessageInfo
 func (m *SeedTaint) Reset()      { *m = SeedTaint{} }
 func (*SeedTaint) ProtoMessage() {}
 func (*SeedTaint) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{139}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
 }
 func (m *SeedTaint) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3966,7 +4134,7 @@ var xxx_messageInfo_SeedTaint proto.InternalMessageInfo
 func (m *SeedTemplate) Reset()      { *m = SeedTemplate{} }
 func (*SeedTemplate) ProtoMessage() {}
 func (*SeedTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{140}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
 }
 func (m *SeedTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3994,7 +4162,7 @@ var xxx_messageInfo_SeedTemplate proto.InternalMessageInfo
 func (m *SeedVolume) Reset()      { *m = SeedVolume{} }
 func (*SeedVolume) ProtoMessage() {}
 func (*SeedVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{141}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
 }
 func (m *SeedVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4022,7 +4190,7 @@ var xxx_messageInfo_SeedVolume proto.InternalMessageInfo
 func (m *SeedVolumeProvider) Reset()      { *m = SeedVolumeProvider{} }
 func (*SeedVolumeProvider) ProtoMessage() {}
 func (*SeedVolumeProvider) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{142}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
 }
 func (m *SeedVolumeProvider) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4050,7 +4218,7 @@ var xxx_messageInfo_SeedVolumeProvider proto.InternalMessageInfo
 func (m *ServiceAccountConfig) Reset()      { *m = ServiceAccountConfig{} }
 func (*ServiceAccountConfig) ProtoMessage() {}
 func (*ServiceAccountConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{143}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
 }
 func (m *ServiceAccountConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4078,7 +4246,7 @@ var xxx_messageInfo_ServiceAccountConfig proto.InternalMessageInfo
 func (m *ServiceAccountKeyRotation) Reset()      { *m = ServiceAccountKeyRotation{} }
 func (*ServiceAccountKeyRotation) ProtoMessage() {}
 func (*ServiceAccountKeyRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{144}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
 }
 func (m *ServiceAccountKeyRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4106,7 +4274,7 @@ var xxx_messageInfo_ServiceAccountKeyRotation proto.InternalMessageInfo
 func (m *Shoot) Reset()      { *m = Shoot{} }
 func (*Shoot) ProtoMessage() {}
 func (*Shoot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{145}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
 }
 func (m *Shoot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4134,7 +4302,7 @@ var xxx_messageInfo_Shoot proto.InternalMessageInfo
 func (m *ShootAdvertisedAddress) Reset()      { *m = ShootAdvertisedAddress{} }
 func (*ShootAdvertisedAddress) ProtoMessage() {}
 func (*ShootAdvertisedAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{146}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
 }
 func (m *ShootAdvertisedAddress) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4162,7 +4330,7 @@ var xxx_messageInfo_ShootAdvertisedAddress proto.InternalMessageInfo
 func (m *ShootCredentials) Reset()      { *m = ShootCredentials{} }
 func (*ShootCredentials) ProtoMessage() {}
 func (*ShootCredentials) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{147}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
 }
 func (m *ShootCredentials) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4190,7 +4358,7 @@ var xxx_messageInfo_ShootCredentials proto.InternalMessageInfo
 func (m *ShootCredentialsRotation) Reset()      { *m = ShootCredentialsRotation{} }
 func (*ShootCredentialsRotation) ProtoMessage() {}
 func (*ShootCredentialsRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{148}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
 }
 func (m *ShootCredentialsRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4218,7 +4386,7 @@ var xxx_messageInfo_ShootCredentialsRotation proto.InternalMessageInfo
 func (m *ShootKubeconfigRotation) Reset()      { *m = ShootKubeconfigRotation{} }
 func (*ShootKubeconfigRotation) ProtoMessage() {}
 func (*ShootKubeconfigRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{149}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
 }
 func (m *ShootKubeconfigRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4246,7 +4414,7 @@ var xxx_messageInfo_ShootKubeconfigRotation proto.InternalMessageInfo
 func (m *ShootList) Reset()      { *m = ShootList{} }
 func (*ShootList) ProtoMessage() {}
 func (*ShootList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{150}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
 }
 func (m *ShootList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4274,7 +4442,7 @@ var xxx_messageInfo_ShootList proto.InternalMessageInfo
 func (m *ShootMachineImage) Reset()      { *m = ShootMachineImage{} }
 func (*ShootMachineImage) ProtoMessage() {}
 func (*ShootMachineImage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{151}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
 }
 func (m *ShootMachineImage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4302,7 +4470,7 @@ var xxx_messageInfo_ShootMachineImage proto.InternalMessageInfo
 func (m *ShootNetworks) Reset()      { *m = ShootNetworks{} }
 func (*ShootNetworks) ProtoMessage() {}
 func (*ShootNetworks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{152}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
 }
 func (m *ShootNetworks) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4330,7 +4498,7 @@ var xxx_messageInfo_ShootNetworks proto.InternalMessageInfo
 func (m *ShootSSHKeypairRotation) Reset()      { *m = ShootSSHKeypairRotation{} }
 func (*ShootSSHKeypairRotation) ProtoMessage() {}
 func (*ShootSSHKeypairRotation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{153}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
 }
 func (m *ShootSSHKeypairRotation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4358,7 +4526,7 @@ var xxx_messageInfo_ShootSSHKeypairRotation proto.InternalMessageInfo
 func (m *ShootSpec) Reset()      { *m = ShootSpec{} }
 func (*ShootSpec) ProtoMessage() {}
 func (*ShootSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{154}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
 }
 func (m *ShootSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4386,7 +4554,7 @@ var xxx_messageInfo_ShootSpec proto.InternalMessageInfo
 func (m *ShootState) Reset()      { *m = ShootState{} }
 func (*ShootState) ProtoMessage() {}
 func (*ShootState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{155}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
 }
 func (m *ShootState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4411,10 +4579,38 @@ func (m *ShootState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ShootState proto.InternalMessageInfo
 
+func (m *ShootStateEncryptionKey) Reset()      { *m = ShootStateEncryptionKey{} }
+func (*ShootStateEncryptionKey) ProtoMessage() {}
+func (*ShootStateEncryptionKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
+}
+func (m *ShootStateEncryptionKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ShootStateEncryptionKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ShootStateEncryptionKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShootStateEncryptionKey.Merge(m, src)
+}
+func (m *ShootStateEncryptionKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *ShootStateEncryptionKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShootStateEncryptionKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShootStateEncryptionKey proto.InternalMessageInfo
+
 func (m *ShootStateList) Reset()      { *m = ShootStateList{} }
 func (*ShootStateList) ProtoMessage() {}
 func (*ShootStateList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{156}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
 }
 func (m *ShootStateList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4442,7 +4638,7 @@ var xxx_messageInfo_ShootStateList proto.InternalMessageInfo
 func (m *ShootStateSpec) Reset()      { *m = ShootStateSpec{} }
 func (*ShootStateSpec) ProtoMessage() {}
 func (*ShootStateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{157}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
 }
 func (m *ShootStateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4470,7 +4666,7 @@ var xxx_messageInfo_ShootStateSpec proto.InternalMessageInfo
 func (m *ShootStatus) Reset()      { *m = ShootStatus{} }
 func (*ShootStatus) ProtoMessage() {}
 func (*ShootStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{158}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
 }
 func (m *ShootStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4498,7 +4694,7 @@ var xxx_messageInfo_ShootStatus proto.InternalMessageInfo
 func (m *ShootTemplate) Reset()      { *m = ShootTemplate{} }
 func (*ShootTemplate) ProtoMessage() {}
 func (*ShootTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{159}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
 }
 func (m *ShootTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4526,7 +4722,7 @@ var xxx_messageInfo_ShootTemplate proto.InternalMessageInfo
 func (m *SystemComponents) Reset()      { *m = SystemComponents{} }
 func (*SystemComponents) ProtoMessage() {}
 func (*SystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{160}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
 }
 func (m *SystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4554,7 +4750,7 @@ var xxx_messageInfo_SystemComponents proto.InternalMessageInfo
 func (m *Toleration) Reset()      { *m = Toleration{} }
 func (*Toleration) ProtoMessage() {}
 func (*Toleration) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{161}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{168}
 }
 func (m *Toleration) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4582,7 +4778,7 @@ var xxx_messageInfo_Toleration proto.InternalMessageInfo
 func (m *VerticalPodAutoscaler) Reset()      { *m = VerticalPodAutoscaler{} }
 func (*VerticalPodAutoscaler) ProtoMessage() {}
 func (*VerticalPodAutoscaler) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{162}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{169}
 }
 func (m *VerticalPodAutoscaler) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4610,7 +4806,7 @@ var xxx_messageInfo_VerticalPodAutoscaler proto.InternalMessageInfo
 func (m *Volume) Reset()      { *m = Volume{} }
 func (*Volume) ProtoMessage() {}
 func (*Volume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{163}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{170}
 }
 func (m *Volume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4638,7 +4834,7 @@ var xxx_messageInfo_Volume proto.InternalMessageInfo
 func (m *VolumeType) Reset()      { *m = VolumeType{} }
 func (*VolumeType) ProtoMessage() {}
 func (*VolumeType) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{164}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{171}
 }
 func (m *VolumeType) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4666,7 +4862,7 @@ var xxx_messageInfo_VolumeType proto.InternalMessageInfo
 func (m *WatchCacheSizes) Reset()      { *m = WatchCacheSizes{} }
 func (*WatchCacheSizes) ProtoMessage() {}
 func (*WatchCacheSizes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{165}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{172}
 }
 func (m *WatchCacheSizes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4694,7 +4890,7 @@ var xxx_messageInfo_WatchCacheSizes proto.InternalMessageInfo
 func (m *Worker) Reset()      { *m = Worker{} }
 func (*Worker) ProtoMessage() {}
 func (*Worker) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{166}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{173}
 }
 func (m *Worker) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4722,7 +4918,7 @@ var xxx_messageInfo_Worker proto.InternalMessageInfo
 func (m *WorkerKubernetes) Reset()      { *m = WorkerKubernetes{} }
 func (*WorkerKubernetes) ProtoMessage() {}
 func (*WorkerKubernetes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{167}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{174}
 }
 func (m *WorkerKubernetes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4747,10 +4943,38 @@ func (m *WorkerKubernetes) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_WorkerKubernetes proto.InternalMessageInfo
 
+func (m *WorkerPoolInPlaceUpdate) Reset()      { *m = WorkerPoolInPlaceUpdate{} }
+func (*WorkerPoolInPlaceUpdate) ProtoMessage() {}
+func (*WorkerPoolInPlaceUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ca37af0df9a5bbd2, []int{175}
+}
+func (m *WorkerPoolInPlaceUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkerPoolInPlaceUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *WorkerPoolInPlaceUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkerPoolInPlaceUpdate.Merge(m, src)
+}
+func (m *WorkerPoolInPlaceUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkerPoolInPlaceUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkerPoolInPlaceUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkerPoolInPlaceUpdate proto.InternalMessageInfo
+
 func (m *WorkerSystemComponents) Reset()      { *m = WorkerSystemComponents{} }
 func (*WorkerSystemComponents) ProtoMessage() {}
 func (*WorkerSystemComponents) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{168}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{176}
 }
 func (m *WorkerSystemComponents) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4778,7 +5002,7 @@ var xxx_messageInfo_WorkerSystemComponents proto.InternalMessageInfo
 func (m *WorkersSettings) Reset()      { *m = WorkersSettings{} }
 func (*WorkersSettings) ProtoMessage() {}
 func (*WorkersSettings) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ca37af0df9a5bbd2, []int{169}
+	return fileDescriptor_ca37af0df9a5bbd2, []int{177}
 }
 func (m *WorkersSettings) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -4865,6 +5089,7 @@ func init() {
 	proto.RegisterType((*GardenerResourceData)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.GardenerResourceData")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.GardenerResourceData.LabelsEntry")
 	proto.RegisterType((*Hibernation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Hibernation")
+	proto.RegisterType((*HibernationIdlePolicy)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.HibernationIdlePolicy")
 	proto.RegisterType((*HibernationSchedule)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.HibernationSchedule")
 	proto.RegisterType((*HighAvailability)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.HighAvailability")
 	proto.RegisterType((*HorizontalPodAutoscalerConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.HorizontalPodAutoscalerConfig")
@@ -4874,6 +5099,7 @@ func init() {
 	proto.RegisterMapType((map[string][]byte)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.InternalSecret.DataEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.InternalSecret.StringDataEntry")
 	proto.RegisterType((*InternalSecretList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.InternalSecretList")
+	proto.RegisterType((*KMSConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.KMSConfig")
 	proto.RegisterType((*KubeAPIServerConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.KubeAPIServerConfig")
 	proto.RegisterMapType((map[string]bool)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.KubeAPIServerConfig.RuntimeConfigEntry")
 	proto.RegisterType((*KubeControllerManagerConfig)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.KubeControllerManagerConfig")
@@ -4900,6 +5126,7 @@ func init() {
 	proto.RegisterType((*MachineTypeStorage)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MachineTypeStorage")
 	proto.RegisterType((*Maintenance)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Maintenance")
 	proto.RegisterType((*MaintenanceAutoUpdate)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceAutoUpdate")
+	proto.RegisterType((*MaintenanceFreezePeriod)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceFreezePeriod")
 	proto.RegisterType((*MaintenanceTimeWindow)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MaintenanceTimeWindow")
 	proto.RegisterType((*MemorySwapConfiguration)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.MemorySwapConfiguration")
 	proto.RegisterType((*Monitoring)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Monitoring")
@@ -4924,6 +5151,11 @@ func init() {
 	proto.RegisterType((*QuotaList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaList")
 	proto.RegisterType((*QuotaSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaSpec")
 	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaSpec.MetricsEntry")
+	proto.RegisterType((*QuotaStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaStatus")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaStatus.HardEntry")
+	proto.RegisterType((*QuotaUsage)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaUsage")
+	proto.RegisterMapType((k8s_io_api_core_v1.ResourceList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaUsage.UsedEntry")
+	proto.RegisterType((*QuotaUsageShoot)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.QuotaUsageShoot")
 	proto.RegisterType((*Region)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Region")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Region.LabelsEntry")
 	proto.RegisterType((*ResourceData)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ResourceData")
@@ -4976,6 +5208,7 @@ func init() {
 	proto.RegisterType((*ShootSSHKeypairRotation)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootSSHKeypairRotation")
 	proto.RegisterType((*ShootSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootSpec")
 	proto.RegisterType((*ShootState)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootState")
+	proto.RegisterType((*ShootStateEncryptionKey)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootStateEncryptionKey")
 	proto.RegisterType((*ShootStateList)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootStateList")
 	proto.RegisterType((*ShootStateSpec)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootStateSpec")
 	proto.RegisterType((*ShootStatus)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.ShootStatus")
@@ -4991,6 +5224,7 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Worker.LabelsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.Worker.SysctlsEntry")
 	proto.RegisterType((*WorkerKubernetes)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.WorkerKubernetes")
+	proto.RegisterType((*WorkerPoolInPlaceUpdate)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.WorkerPoolInPlaceUpdate")
 	proto.RegisterType((*WorkerSystemComponents)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.WorkerSystemComponents")
 	proto.RegisterType((*WorkersSettings)(nil), "github.com.gardener.gardener.pkg.apis.core.v1beta1.WorkersSettings")
 }
@@ -5000,765 +5234,804 @@ func init() {
 }
 
 var fileDescriptor_ca37af0df9a5bbd2 = []byte{
-	// 12114 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7d, 0x6c, 0x2d, 0x49,
-	0x56, 0x18, 0xbe, 0x7d, 0xfd, 0x7d, 0xfc, 0xf1, 0xec, 0x7a, 0x1f, 0xe3, 0xf1, 0x9b, 0x79, 0xf7,
-	0x6d, 0xcf, 0xec, 0xfe, 0x66, 0x98, 0xc5, 0x8f, 0x19, 0x76, 0xd9, 0x9d, 0xb7, 0xcc, 0xce, 0xda,
-	0xf7, 0xda, 0xef, 0x5d, 0x9e, 0xed, 0xe7, 0xad, 0x6b, 0xcf, 0x0c, 0x03, 0xbf, 0x81, 0x76, 0x77,
-	0xf9, 0xba, 0xc7, 0x7d, 0xbb, 0xef, 0x74, 0xf7, 0xf5, 0xb3, 0x67, 0x96, 0xc0, 0x6e, 0x80, 0xb0,
-	0x03, 0x1b, 0x01, 0x12, 0x59, 0xcd, 0x42, 0xc4, 0x22, 0x84, 0xf2, 0x41, 0x44, 0x08, 0x11, 0x91,
-	0x00, 0x45, 0x42, 0x48, 0x84, 0x5d, 0x04, 0x08, 0x41, 0xa2, 0xec, 0x2a, 0xc1, 0x64, 0x1d, 0x02,
-	0x91, 0x12, 0xa1, 0x28, 0x28, 0x8a, 0xf2, 0x82, 0x20, 0xaa, 0x8f, 0xae, 0xae, 0xfe, 0xba, 0xb6,
-	0xfb, 0xda, 0xde, 0x1d, 0xc1, 0x5f, 0xf6, 0xad, 0x53, 0x75, 0x4e, 0x55, 0x75, 0xd5, 0xa9, 0x53,
-	0xa7, 0xce, 0x07, 0x2c, 0xb6, 0xec, 0x70, 0xa7, 0xbb, 0x35, 0x6f, 0x7a, 0xed, 0x5b, 0x2d, 0xc3,
-	0xb7, 0x88, 0x4b, 0xfc, 0xf8, 0x9f, 0xce, 0x6e, 0xeb, 0x96, 0xd1, 0xb1, 0x83, 0x5b, 0xa6, 0xe7,
-	0x93, 0x5b, 0x7b, 0xcf, 0x6e, 0x91, 0xd0, 0x78, 0xf6, 0x56, 0x8b, 0xc2, 0x8c, 0x90, 0x58, 0xf3,
-	0x1d, 0xdf, 0x0b, 0x3d, 0xf4, 0x5c, 0x8c, 0x63, 0x3e, 0x6a, 0x1a, 0xff, 0xd3, 0xd9, 0x6d, 0xcd,
-	0x53, 0x1c, 0xf3, 0x14, 0xc7, 0xbc, 0xc0, 0x31, 0xf7, 0x8d, 0x2a, 0x5d, 0xaf, 0xe5, 0xdd, 0x62,
-	0xa8, 0xb6, 0xba, 0xdb, 0xec, 0x17, 0xfb, 0xc1, 0xfe, 0xe3, 0x24, 0xe6, 0x9e, 0xde, 0xfd, 0x48,
-	0x30, 0x6f, 0x7b, 0xb4, 0x33, 0xb7, 0x8c, 0x6e, 0xe8, 0x05, 0xa6, 0xe1, 0xd8, 0x6e, 0xeb, 0xd6,
-	0x5e, 0xa6, 0x37, 0x73, 0xba, 0x52, 0x55, 0x74, 0xbb, 0x67, 0x1d, 0x7f, 0xcb, 0x30, 0xf3, 0xea,
-	0x7c, 0x30, 0xae, 0xd3, 0x36, 0xcc, 0x1d, 0xdb, 0x25, 0xfe, 0x41, 0x34, 0x21, 0xb7, 0x7c, 0x12,
-	0x78, 0x5d, 0xdf, 0x24, 0xa7, 0x6a, 0x15, 0xdc, 0x6a, 0x93, 0xd0, 0xc8, 0xa3, 0x75, 0xab, 0xa8,
-	0x95, 0xdf, 0x75, 0x43, 0xbb, 0x9d, 0x25, 0xf3, 0x2d, 0xc7, 0x35, 0x08, 0xcc, 0x1d, 0xd2, 0x36,
-	0x32, 0xed, 0xbe, 0xb9, 0xa8, 0x5d, 0x37, 0xb4, 0x9d, 0x5b, 0xb6, 0x1b, 0x06, 0xa1, 0x9f, 0x6e,
-	0xa4, 0xbf, 0xad, 0xc1, 0xf4, 0xc2, 0x7a, 0xa3, 0x49, 0xfc, 0x3d, 0xe2, 0xaf, 0x78, 0xad, 0x96,
-	0xed, 0xb6, 0xd0, 0x33, 0x30, 0xb6, 0x47, 0xfc, 0x2d, 0x2f, 0xb0, 0xc3, 0x83, 0x59, 0xed, 0xa6,
-	0xf6, 0xd4, 0xd0, 0xe2, 0xe4, 0xd1, 0x61, 0x75, 0xec, 0xa5, 0xa8, 0x10, 0xc7, 0x70, 0xd4, 0x80,
-	0xcb, 0x3b, 0x61, 0xd8, 0x59, 0x30, 0x4d, 0x12, 0x04, 0xb2, 0xc6, 0x6c, 0x85, 0x35, 0x7b, 0xe4,
-	0xe8, 0xb0, 0x7a, 0xf9, 0xee, 0xc6, 0xc6, 0x7a, 0x0a, 0x8c, 0xf3, 0xda, 0xe8, 0xbf, 0xa4, 0xc1,
-	0x8c, 0xec, 0x0c, 0x26, 0x6f, 0x74, 0x49, 0x10, 0x06, 0x08, 0xc3, 0xb5, 0xb6, 0xb1, 0xbf, 0xe6,
-	0xb9, 0xab, 0xdd, 0xd0, 0x08, 0x6d, 0xb7, 0xd5, 0x70, 0xb7, 0x1d, 0xbb, 0xb5, 0x13, 0x8a, 0xae,
-	0xcd, 0x1d, 0x1d, 0x56, 0xaf, 0xad, 0xe6, 0xd6, 0xc0, 0x05, 0x2d, 0x69, 0xa7, 0xdb, 0xc6, 0x7e,
-	0x06, 0xa1, 0xd2, 0xe9, 0xd5, 0x2c, 0x18, 0xe7, 0xb5, 0xd1, 0x9f, 0x83, 0xa1, 0x05, 0xcb, 0xf2,
-	0x5c, 0xf4, 0x34, 0x8c, 0x10, 0xd7, 0xd8, 0x72, 0x88, 0xc5, 0x3a, 0x36, 0xba, 0x78, 0xe9, 0x8b,
-	0x87, 0xd5, 0xf7, 0x1c, 0x1d, 0x56, 0x47, 0x96, 0x78, 0x31, 0x8e, 0xe0, 0xfa, 0x4f, 0x54, 0x60,
-	0x98, 0x35, 0x0a, 0xd0, 0x8f, 0x6b, 0x70, 0x79, 0xb7, 0xbb, 0x45, 0x7c, 0x97, 0x84, 0x24, 0xa8,
-	0x1b, 0xc1, 0xce, 0x96, 0x67, 0xf8, 0x1c, 0xc5, 0xf8, 0x73, 0x77, 0xe6, 0x4f, 0xbf, 0xff, 0xe6,
-	0xef, 0x65, 0xd1, 0xf1, 0x31, 0xe5, 0x00, 0x70, 0x1e, 0x71, 0xb4, 0x07, 0x13, 0x6e, 0xcb, 0x76,
-	0xf7, 0x1b, 0x6e, 0xcb, 0x27, 0x41, 0xc0, 0xe6, 0x65, 0xfc, 0xb9, 0x8f, 0x97, 0xe9, 0xcc, 0x9a,
-	0x82, 0x67, 0x71, 0xfa, 0xe8, 0xb0, 0x3a, 0xa1, 0x96, 0xe0, 0x04, 0x1d, 0xfd, 0xaf, 0x34, 0xb8,
-	0xb4, 0x60, 0xb5, 0xed, 0x20, 0xb0, 0x3d, 0x77, 0xdd, 0xe9, 0xb6, 0x6c, 0x17, 0xdd, 0x84, 0x41,
-	0xd7, 0x68, 0x13, 0x36, 0x21, 0x63, 0x8b, 0x13, 0x62, 0x4e, 0x07, 0xd7, 0x8c, 0x36, 0xc1, 0x0c,
-	0x82, 0x3e, 0x01, 0xc3, 0xa6, 0xe7, 0x6e, 0xdb, 0x2d, 0xd1, 0xcf, 0x6f, 0x9c, 0xe7, 0x3b, 0x61,
-	0x5e, 0xdd, 0x09, 0xac, 0x7b, 0x62, 0x07, 0xcd, 0x63, 0xe3, 0xc1, 0xd2, 0x7e, 0x48, 0x5c, 0x4a,
-	0x66, 0x11, 0x8e, 0x0e, 0xab, 0xc3, 0x35, 0x86, 0x00, 0x0b, 0x44, 0xe8, 0x29, 0x18, 0xb5, 0xec,
-	0x80, 0x7f, 0xcc, 0x01, 0xf6, 0x31, 0x27, 0x8e, 0x0e, 0xab, 0xa3, 0x75, 0x51, 0x86, 0x25, 0x14,
-	0xad, 0xc0, 0x15, 0x3a, 0x83, 0xbc, 0x5d, 0x93, 0x98, 0x3e, 0x09, 0x69, 0xd7, 0x66, 0x07, 0x59,
-	0x77, 0x67, 0x8f, 0x0e, 0xab, 0x57, 0xee, 0xe5, 0xc0, 0x71, 0x6e, 0x2b, 0x7d, 0x19, 0x46, 0x17,
-	0x1c, 0xe2, 0xd3, 0x05, 0x86, 0x6e, 0xc3, 0x14, 0x69, 0x1b, 0xb6, 0x83, 0x89, 0x49, 0xec, 0x3d,
-	0xe2, 0x07, 0xb3, 0xda, 0xcd, 0x81, 0xa7, 0xc6, 0x16, 0xd1, 0xd1, 0x61, 0x75, 0x6a, 0x29, 0x01,
-	0xc1, 0xa9, 0x9a, 0xfa, 0xa7, 0x34, 0x18, 0x5f, 0xe8, 0x5a, 0x76, 0xc8, 0xc7, 0x85, 0x7c, 0x18,
-	0x37, 0xe8, 0xcf, 0x75, 0xcf, 0xb1, 0xcd, 0x03, 0xb1, 0xb8, 0x5e, 0x2c, 0xf3, 0x3d, 0x17, 0x62,
-	0x34, 0x8b, 0x97, 0x8e, 0x0e, 0xab, 0xe3, 0x4a, 0x01, 0x56, 0x89, 0xe8, 0x3b, 0xa0, 0xc2, 0xd0,
-	0xb7, 0xc3, 0x04, 0x1f, 0xee, 0xaa, 0xd1, 0xc1, 0x64, 0x5b, 0xf4, 0xe1, 0x09, 0xe5, 0x5b, 0x45,
-	0x84, 0xe6, 0xef, 0x6f, 0xbd, 0x4e, 0xcc, 0x10, 0x93, 0x6d, 0xe2, 0x13, 0xd7, 0x24, 0x7c, 0xd9,
-	0xd4, 0x94, 0xc6, 0x38, 0x81, 0x4a, 0xff, 0x63, 0xca, 0xc4, 0xf6, 0x0c, 0xdb, 0x31, 0xb6, 0x6c,
-	0xc7, 0x0e, 0x0f, 0x5e, 0xf5, 0x5c, 0x72, 0x82, 0x75, 0xb3, 0x09, 0x8f, 0x74, 0x5d, 0x83, 0xb7,
-	0x73, 0xc8, 0x2a, 0x5f, 0x29, 0x1b, 0x07, 0x1d, 0x42, 0x17, 0x3c, 0x9d, 0xe9, 0xeb, 0x47, 0x87,
-	0xd5, 0x47, 0x36, 0xf3, 0xab, 0xe0, 0xa2, 0xb6, 0x94, 0x5f, 0x29, 0xa0, 0x97, 0x3c, 0xa7, 0xdb,
-	0x16, 0x58, 0x07, 0x18, 0x56, 0xc6, 0xaf, 0x36, 0x73, 0x6b, 0xe0, 0x82, 0x96, 0xfa, 0x17, 0x2b,
-	0x30, 0xb1, 0x68, 0x98, 0xbb, 0xdd, 0xce, 0x62, 0xd7, 0xdc, 0x25, 0x21, 0xfa, 0x6e, 0x18, 0xa5,
-	0x07, 0x8e, 0x65, 0x84, 0x86, 0x98, 0xc9, 0x6f, 0x2a, 0x5c, 0xf5, 0xec, 0x23, 0xd2, 0xda, 0xf1,
-	0xdc, 0xae, 0x92, 0xd0, 0x58, 0x44, 0x62, 0x4e, 0x20, 0x2e, 0xc3, 0x12, 0x2b, 0xda, 0x86, 0xc1,
-	0xa0, 0x43, 0x4c, 0xb1, 0xa7, 0xea, 0x65, 0xd6, 0x8a, 0xda, 0xe3, 0x66, 0x87, 0x98, 0xf1, 0x57,
-	0xa0, 0xbf, 0x30, 0xc3, 0x8f, 0x5c, 0x18, 0x0e, 0x42, 0x23, 0xec, 0x06, 0x6c, 0xa3, 0x8d, 0x3f,
-	0xb7, 0xdc, 0x37, 0x25, 0x86, 0x6d, 0x71, 0x4a, 0xd0, 0x1a, 0xe6, 0xbf, 0xb1, 0xa0, 0xa2, 0xff,
-	0x7b, 0x0d, 0xa6, 0xd5, 0xea, 0x2b, 0x76, 0x10, 0xa2, 0xef, 0xcc, 0x4c, 0xe7, 0xfc, 0xc9, 0xa6,
-	0x93, 0xb6, 0x66, 0x93, 0x39, 0x2d, 0xc8, 0x8d, 0x46, 0x25, 0xca, 0x54, 0x12, 0x18, 0xb2, 0x43,
-	0xd2, 0xe6, 0xcb, 0xaa, 0x24, 0x1f, 0x55, 0xbb, 0xbc, 0x38, 0x29, 0x88, 0x0d, 0x35, 0x28, 0x5a,
-	0xcc, 0xb1, 0xeb, 0xdf, 0x0d, 0x57, 0xd4, 0x5a, 0xeb, 0xbe, 0xb7, 0x67, 0x5b, 0xc4, 0xa7, 0x3b,
-	0x21, 0x3c, 0xe8, 0x64, 0x76, 0x02, 0x5d, 0x59, 0x98, 0x41, 0xd0, 0xfb, 0x61, 0xd8, 0x27, 0x2d,
-	0xdb, 0x73, 0xd9, 0xd7, 0x1e, 0x8b, 0xe7, 0x0e, 0xb3, 0x52, 0x2c, 0xa0, 0xfa, 0xff, 0xaa, 0x24,
-	0xe7, 0x8e, 0x7e, 0x46, 0xb4, 0x07, 0xa3, 0x1d, 0x41, 0x4a, 0xcc, 0xdd, 0xdd, 0x7e, 0x07, 0x18,
-	0x75, 0x3d, 0x9e, 0xd5, 0xa8, 0x04, 0x4b, 0x5a, 0xc8, 0x86, 0xa9, 0xe8, 0xff, 0x5a, 0x1f, 0xec,
-	0x9f, 0xb1, 0xd3, 0xf5, 0x04, 0x22, 0x9c, 0x42, 0x8c, 0x36, 0x60, 0x2c, 0x60, 0x4c, 0x9a, 0x32,
-	0xae, 0x81, 0x62, 0xc6, 0xd5, 0x8c, 0x2a, 0x09, 0xc6, 0x35, 0x23, 0xba, 0x3f, 0x26, 0x01, 0x38,
-	0x46, 0x44, 0x0f, 0x99, 0x80, 0x10, 0x4b, 0x39, 0x2e, 0xd8, 0x21, 0xd3, 0x14, 0x65, 0x58, 0x42,
-	0xf5, 0x2f, 0x0c, 0x02, 0xca, 0x2e, 0x71, 0x75, 0x06, 0x78, 0x89, 0x98, 0xff, 0x7e, 0x66, 0x40,
-	0xec, 0x96, 0x14, 0x62, 0xf4, 0x26, 0x4c, 0x3a, 0x46, 0x10, 0xde, 0xef, 0x50, 0xe9, 0x31, 0x5a,
-	0x28, 0xe3, 0xcf, 0x2d, 0x94, 0xf9, 0xd2, 0x2b, 0x2a, 0xa2, 0xc5, 0x99, 0xa3, 0xc3, 0xea, 0x64,
-	0xa2, 0x08, 0x27, 0x49, 0xa1, 0xd7, 0x61, 0x8c, 0x16, 0x2c, 0xf9, 0xbe, 0xe7, 0x8b, 0xd9, 0x7f,
-	0xa1, 0x2c, 0x5d, 0x86, 0x84, 0x4b, 0xb3, 0xf2, 0x27, 0x8e, 0xd1, 0xa3, 0x6f, 0x03, 0xe4, 0x6d,
-	0x05, 0x54, 0x00, 0xb5, 0xee, 0x70, 0x51, 0x99, 0x0e, 0x96, 0x7e, 0x9d, 0x81, 0xc5, 0x39, 0xf1,
-	0x35, 0xd1, 0xfd, 0x4c, 0x0d, 0x9c, 0xd3, 0x0a, 0xed, 0x02, 0x92, 0xe2, 0xb6, 0x5c, 0x00, 0xb3,
-	0x43, 0x27, 0x5f, 0x3e, 0xd7, 0x28, 0xb1, 0x3b, 0x19, 0x14, 0x38, 0x07, 0xad, 0xfe, 0x9b, 0x15,
-	0x18, 0xe7, 0x4b, 0x64, 0xc9, 0x0d, 0xfd, 0x83, 0x0b, 0x38, 0x20, 0x48, 0xe2, 0x80, 0xa8, 0x95,
-	0xdf, 0xf3, 0xac, 0xc3, 0x85, 0xe7, 0x43, 0x3b, 0x75, 0x3e, 0x2c, 0xf5, 0x4b, 0xa8, 0xf7, 0xf1,
-	0xf0, 0xef, 0x34, 0xb8, 0xa4, 0xd4, 0xbe, 0x80, 0xd3, 0xc1, 0x4a, 0x9e, 0x0e, 0x2f, 0xf6, 0x39,
-	0xbe, 0x82, 0xc3, 0xc1, 0x4b, 0x0c, 0x8b, 0x31, 0xee, 0xe7, 0x00, 0xb6, 0x18, 0x3b, 0x59, 0x8b,
-	0xe5, 0x24, 0xf9, 0xc9, 0x17, 0x25, 0x04, 0x2b, 0xb5, 0x12, 0x3c, 0xab, 0xd2, 0x93, 0x67, 0xfd,
-	0x97, 0x01, 0x98, 0xc9, 0x4c, 0x7b, 0x96, 0x8f, 0x68, 0x5f, 0x23, 0x3e, 0x52, 0xf9, 0x5a, 0xf0,
-	0x91, 0x81, 0x52, 0x7c, 0xe4, 0xc4, 0xe7, 0x04, 0xf2, 0x01, 0xb5, 0xed, 0x16, 0x6f, 0xd6, 0x0c,
-	0x0d, 0x3f, 0xdc, 0xb0, 0xdb, 0x44, 0x70, 0x9c, 0x6f, 0x38, 0xd9, 0x92, 0xa5, 0x2d, 0x38, 0xe3,
-	0x59, 0xcd, 0x60, 0xc2, 0x39, 0xd8, 0xf5, 0x3f, 0x18, 0x04, 0xa8, 0x2d, 0x60, 0x2f, 0xe4, 0x9d,
-	0x7d, 0x11, 0x86, 0x3a, 0x3b, 0x46, 0x10, 0xad, 0xa7, 0xa7, 0xa3, 0xc5, 0xb8, 0x4e, 0x0b, 0x1f,
-	0x1e, 0x56, 0x67, 0x6b, 0x3e, 0xb1, 0x88, 0x1b, 0xda, 0x86, 0x13, 0x44, 0x8d, 0x18, 0x0c, 0xf3,
-	0x76, 0x74, 0x0c, 0x74, 0x1a, 0x6b, 0x5e, 0xbb, 0xe3, 0x10, 0x0a, 0x65, 0x63, 0xa8, 0x94, 0x1b,
-	0xc3, 0x4a, 0x06, 0x13, 0xce, 0xc1, 0x1e, 0xd1, 0x6c, 0xb8, 0x76, 0x68, 0x1b, 0x92, 0xe6, 0x40,
-	0x79, 0x9a, 0x49, 0x4c, 0x38, 0x07, 0x3b, 0x7a, 0x5b, 0x83, 0xb9, 0x64, 0xf1, 0xb2, 0xed, 0xda,
-	0xc1, 0x0e, 0xb1, 0x18, 0xf1, 0xc1, 0x53, 0x13, 0xbf, 0x71, 0x74, 0x58, 0x9d, 0x5b, 0x29, 0xc4,
-	0x88, 0x7b, 0x50, 0x43, 0x9f, 0xd5, 0xe0, 0x7a, 0x6a, 0x5e, 0x7c, 0xbb, 0xd5, 0x22, 0xbe, 0xe8,
-	0xcd, 0xe9, 0x97, 0x50, 0xf5, 0xe8, 0xb0, 0x7a, 0x7d, 0xa5, 0x18, 0x25, 0xee, 0x45, 0x4f, 0xff,
-	0x0d, 0x0d, 0x06, 0x6a, 0xb8, 0x81, 0x9e, 0x49, 0x5c, 0xe2, 0x1e, 0x51, 0x2f, 0x71, 0x0f, 0x0f,
-	0xab, 0x23, 0x35, 0xdc, 0x50, 0xee, 0x73, 0x9f, 0xd5, 0x60, 0xc6, 0xf4, 0xdc, 0xd0, 0xa0, 0xfd,
-	0xc2, 0x5c, 0xd2, 0x89, 0xb8, 0x6a, 0xa9, 0xfb, 0x4b, 0x2d, 0x85, 0x6c, 0xf1, 0x51, 0xd1, 0x81,
-	0x99, 0x34, 0x24, 0xc0, 0x59, 0xca, 0xfa, 0x97, 0x35, 0x98, 0xa8, 0x39, 0x5e, 0xd7, 0x5a, 0xf7,
-	0xbd, 0x6d, 0xdb, 0x21, 0xef, 0x8e, 0x4b, 0x9b, 0xda, 0xe3, 0xa2, 0x43, 0x99, 0x5d, 0xa2, 0xd4,
-	0x8a, 0xef, 0x92, 0x4b, 0x94, 0xda, 0xe5, 0x82, 0x73, 0xf2, 0x27, 0x46, 0x92, 0x23, 0x63, 0x27,
-	0xe5, 0x53, 0x30, 0x6a, 0x1a, 0x8b, 0x5d, 0xd7, 0x72, 0xe4, 0x2d, 0x8a, 0xf6, 0xb2, 0xb6, 0xc0,
-	0xcb, 0xb0, 0x84, 0xa2, 0x37, 0x01, 0x62, 0x85, 0x9a, 0xf8, 0x0c, 0xcb, 0xfd, 0x29, 0xf1, 0x9a,
-	0x24, 0x0c, 0x6d, 0xb7, 0x15, 0xc4, 0x9f, 0x3e, 0x86, 0x61, 0x85, 0x1a, 0xfa, 0x1e, 0x98, 0x14,
-	0x93, 0xdc, 0x68, 0x1b, 0x2d, 0xa1, 0x6f, 0x28, 0x39, 0x53, 0xab, 0x0a, 0xa2, 0xc5, 0xab, 0x82,
-	0xf0, 0xa4, 0x5a, 0x1a, 0xe0, 0x24, 0x35, 0x74, 0x00, 0x13, 0x6d, 0x55, 0x87, 0x32, 0x58, 0x5e,
-	0x9c, 0x51, 0xf4, 0x29, 0x8b, 0x57, 0x04, 0xf1, 0x89, 0x84, 0xf6, 0x25, 0x41, 0x2a, 0xe7, 0x2a,
-	0x38, 0x74, 0x5e, 0x57, 0x41, 0x02, 0x23, 0xfc, 0x32, 0x1c, 0xcc, 0x0e, 0xb3, 0x01, 0xde, 0x2e,
-	0x33, 0x40, 0x7e, 0xaf, 0x8e, 0x35, 0xc4, 0xfc, 0x77, 0x80, 0x23, 0xdc, 0x68, 0x0f, 0x26, 0xe8,
-	0xa9, 0xde, 0x24, 0x0e, 0x31, 0x43, 0xcf, 0x9f, 0x1d, 0x29, 0xaf, 0x81, 0x6d, 0x2a, 0x78, 0xb8,
-	0x2a, 0x4d, 0x2d, 0xc1, 0x09, 0x3a, 0x52, 0x57, 0x30, 0x5a, 0xa8, 0x2b, 0xe8, 0xc2, 0xf8, 0x9e,
-	0xa2, 0xd3, 0x1a, 0x63, 0x93, 0xf0, 0xb1, 0x32, 0x1d, 0x8b, 0x15, 0x5c, 0x8b, 0x97, 0x05, 0xa1,
-	0x71, 0x55, 0x19, 0xa6, 0xd2, 0xd1, 0x7f, 0x61, 0x1c, 0x66, 0x6a, 0x4e, 0x37, 0x08, 0x89, 0xbf,
-	0x20, 0x1e, 0x89, 0x88, 0x8f, 0x3e, 0xad, 0xc1, 0x35, 0xf6, 0x6f, 0xdd, 0x7b, 0xe0, 0xd6, 0x89,
-	0x63, 0x1c, 0x2c, 0x6c, 0xd3, 0x1a, 0x96, 0x75, 0x3a, 0x0e, 0x54, 0xef, 0x0a, 0x29, 0x92, 0x29,
-	0xe7, 0x9a, 0xb9, 0x18, 0x71, 0x01, 0x25, 0xf4, 0xc3, 0x1a, 0x3c, 0x9a, 0x03, 0xaa, 0x13, 0x87,
-	0x84, 0x91, 0xe4, 0x72, 0xda, 0x7e, 0x3c, 0x7e, 0x74, 0x58, 0x7d, 0xb4, 0x59, 0x84, 0x14, 0x17,
-	0xd3, 0x43, 0x7f, 0x5f, 0x83, 0xb9, 0x1c, 0xe8, 0xb2, 0x61, 0x3b, 0x5d, 0x3f, 0x12, 0x6a, 0x4e,
-	0xdb, 0x1d, 0x26, 0x5b, 0x34, 0x0b, 0xb1, 0xe2, 0x1e, 0x14, 0xd1, 0xf7, 0xc2, 0x55, 0x09, 0xdd,
-	0x74, 0x5d, 0x42, 0xac, 0x84, 0x88, 0x73, 0xda, 0xae, 0x3c, 0x7a, 0x74, 0x58, 0xbd, 0xda, 0xcc,
-	0x43, 0x88, 0xf3, 0xe9, 0xa0, 0x16, 0x3c, 0x1e, 0x03, 0x42, 0xdb, 0xb1, 0xdf, 0xe4, 0x52, 0xd8,
-	0x8e, 0x4f, 0x82, 0x1d, 0xcf, 0xb1, 0x18, 0xb3, 0xd0, 0x16, 0xdf, 0x7b, 0x74, 0x58, 0x7d, 0xbc,
-	0xd9, 0xab, 0x22, 0xee, 0x8d, 0x07, 0x59, 0x30, 0x11, 0x98, 0x86, 0xdb, 0x70, 0x43, 0xe2, 0xef,
-	0x19, 0xce, 0xec, 0x70, 0xa9, 0x01, 0xf2, 0x2d, 0xaa, 0xe0, 0xc1, 0x09, 0xac, 0xe8, 0x23, 0x30,
-	0x4a, 0xf6, 0x3b, 0x86, 0x6b, 0x11, 0xce, 0x16, 0xc6, 0x16, 0x1f, 0xa3, 0x87, 0xd1, 0x92, 0x28,
-	0x7b, 0x78, 0x58, 0x9d, 0x88, 0xfe, 0x5f, 0xf5, 0x2c, 0x82, 0x65, 0x6d, 0xf4, 0x49, 0xb8, 0xc2,
-	0xde, 0xc3, 0x2c, 0xc2, 0x98, 0x5c, 0x10, 0x09, 0xba, 0xa3, 0xa5, 0xfa, 0xc9, 0xde, 0x36, 0x56,
-	0x73, 0xf0, 0xe1, 0x5c, 0x2a, 0xf4, 0x33, 0xb4, 0x8d, 0xfd, 0x3b, 0xbe, 0x61, 0x92, 0xed, 0xae,
-	0xb3, 0x41, 0xfc, 0xb6, 0xed, 0xf2, 0xbb, 0x04, 0x31, 0x3d, 0xd7, 0xa2, 0xac, 0x44, 0x7b, 0x6a,
-	0x88, 0x7f, 0x86, 0xd5, 0x5e, 0x15, 0x71, 0x6f, 0x3c, 0xe8, 0x83, 0x30, 0x61, 0xb7, 0x5c, 0xcf,
-	0x27, 0x1b, 0x86, 0xed, 0x86, 0xc1, 0x2c, 0x30, 0xb5, 0x3b, 0x9b, 0xd6, 0x86, 0x52, 0x8e, 0x13,
-	0xb5, 0xd0, 0x1e, 0x20, 0x97, 0x3c, 0x58, 0xf7, 0x2c, 0xb6, 0x04, 0x36, 0x3b, 0x6c, 0x21, 0xcf,
-	0x8e, 0x97, 0x9a, 0x1a, 0x76, 0x0f, 0x58, 0xcb, 0x60, 0xc3, 0x39, 0x14, 0xd0, 0x32, 0xa0, 0xb6,
-	0xb1, 0xbf, 0xd4, 0xee, 0x84, 0x07, 0x8b, 0x5d, 0x67, 0x57, 0x70, 0x8d, 0x09, 0x36, 0x17, 0xfc,
-	0x1e, 0x96, 0x81, 0xe2, 0x9c, 0x16, 0xc8, 0x80, 0xeb, 0x7c, 0x3c, 0x75, 0x83, 0xb4, 0x3d, 0x37,
-	0x20, 0x61, 0xa0, 0x2c, 0xd2, 0xd9, 0x49, 0xf6, 0x8a, 0xc5, 0xa4, 0xf2, 0x46, 0x71, 0x35, 0xdc,
-	0x0b, 0x47, 0xf2, 0x5d, 0x78, 0xaa, 0xf7, 0xbb, 0xb0, 0xfe, 0x3f, 0x07, 0x61, 0x36, 0xc3, 0xb0,
-	0xef, 0x77, 0x42, 0x76, 0xbc, 0x1d, 0xbb, 0x25, 0xb5, 0x33, 0xda, 0x92, 0x1d, 0xb8, 0x29, 0x2b,
-	0xdc, 0xe9, 0x74, 0x73, 0x69, 0x55, 0x18, 0xad, 0x27, 0x8f, 0x0e, 0xab, 0x37, 0x9b, 0xc7, 0xd4,
-	0xc5, 0xc7, 0x62, 0x2b, 0x66, 0x77, 0x03, 0x17, 0xc4, 0xee, 0x3e, 0x09, 0x57, 0x14, 0x80, 0x4f,
-	0x0c, 0xeb, 0xa0, 0x0f, 0x76, 0xcb, 0x76, 0x79, 0x33, 0x07, 0x1f, 0xce, 0xa5, 0x52, 0xc8, 0x63,
-	0x86, 0x2e, 0x82, 0xc7, 0xe8, 0x87, 0x03, 0x30, 0x56, 0xf3, 0x5c, 0xcb, 0x66, 0xeb, 0xf5, 0xd9,
-	0xc4, 0xc3, 0xc7, 0xe3, 0xaa, 0x30, 0xf3, 0xf0, 0xb0, 0x3a, 0x29, 0x2b, 0x2a, 0xd2, 0xcd, 0xf3,
-	0x52, 0xdb, 0xc8, 0xb5, 0x5b, 0xef, 0x4d, 0xaa, 0x09, 0x1f, 0x1e, 0x56, 0x2f, 0xc9, 0x66, 0x49,
-	0xcd, 0x21, 0x65, 0x20, 0xf4, 0x4a, 0xbb, 0xe1, 0x1b, 0x6e, 0x60, 0xf7, 0xa1, 0x44, 0x90, 0xea,
-	0xa1, 0x95, 0x0c, 0x36, 0x9c, 0x43, 0x01, 0xbd, 0x0e, 0x53, 0xb4, 0x74, 0xb3, 0x63, 0x19, 0x21,
-	0x29, 0xa9, 0x3b, 0xb8, 0x26, 0x68, 0x4e, 0xad, 0x24, 0x30, 0xe1, 0x14, 0x66, 0xfe, 0x50, 0x64,
-	0x04, 0x9e, 0xcb, 0xbe, 0x67, 0xe2, 0xa1, 0x88, 0x96, 0x62, 0x01, 0x45, 0x4f, 0xc3, 0x48, 0x9b,
-	0x04, 0x81, 0xd1, 0x22, 0xec, 0x10, 0x1c, 0x8b, 0x25, 0xdd, 0x55, 0x5e, 0x8c, 0x23, 0x38, 0xfa,
-	0x00, 0x0c, 0x99, 0x9e, 0x45, 0x82, 0xd9, 0x11, 0xc6, 0xa6, 0x29, 0xcb, 0x1b, 0xaa, 0xd1, 0x82,
-	0x87, 0x87, 0xd5, 0x31, 0xa6, 0x4c, 0xa3, 0xbf, 0x30, 0xaf, 0xa4, 0xff, 0x34, 0xbd, 0x78, 0xa6,
-	0x6e, 0xda, 0x27, 0x78, 0xe0, 0xba, 0xb8, 0xb7, 0x22, 0xfd, 0x73, 0xf4, 0xd6, 0xef, 0xb9, 0xa1,
-	0xef, 0x39, 0xeb, 0x8e, 0xe1, 0x12, 0xf4, 0x83, 0x1a, 0x4c, 0xef, 0xd8, 0xad, 0x1d, 0xf5, 0x85,
-	0x5a, 0x48, 0xa7, 0xa5, 0x2e, 0xe8, 0x77, 0x53, 0xb8, 0x16, 0xaf, 0x1c, 0x1d, 0x56, 0xa7, 0xd3,
-	0xa5, 0x38, 0x43, 0x53, 0xff, 0x4c, 0x05, 0xae, 0x88, 0x9e, 0x39, 0x54, 0x5c, 0xec, 0x38, 0xde,
-	0x41, 0x9b, 0xb8, 0x17, 0xf1, 0x98, 0x1c, 0x7d, 0xa1, 0x4a, 0xe1, 0x17, 0x6a, 0x67, 0xbe, 0xd0,
-	0x40, 0x99, 0x2f, 0x24, 0x17, 0xf2, 0x31, 0x5f, 0xe9, 0xcf, 0x34, 0x98, 0xcd, 0x9b, 0x8b, 0x0b,
-	0x50, 0x64, 0xb4, 0x93, 0x8a, 0x8c, 0xbb, 0x65, 0x35, 0x53, 0xe9, 0xae, 0x17, 0x28, 0x34, 0xfe,
-	0xb4, 0x02, 0xd7, 0xe2, 0xea, 0x0d, 0x37, 0x08, 0x0d, 0xc7, 0xe1, 0xe7, 0xf9, 0xf9, 0x7f, 0xf7,
-	0x4e, 0x42, 0x1f, 0xb5, 0xd6, 0xdf, 0x50, 0xd5, 0xbe, 0x17, 0x3e, 0x17, 0xed, 0xa7, 0x9e, 0x8b,
-	0xd6, 0xcf, 0x90, 0x66, 0xef, 0x97, 0xa3, 0xff, 0xa6, 0xc1, 0x5c, 0x7e, 0xc3, 0x0b, 0x58, 0x54,
-	0x5e, 0x72, 0x51, 0x7d, 0xdb, 0xd9, 0x8d, 0xba, 0x60, 0x59, 0xfd, 0x52, 0xa5, 0x68, 0xb4, 0x4c,
-	0x63, 0xb6, 0x0d, 0x97, 0x7c, 0xd2, 0xb2, 0x83, 0x50, 0xbc, 0x6b, 0x9c, 0xce, 0xe0, 0x27, 0x52,
-	0xf4, 0x5e, 0xc2, 0x49, 0x1c, 0x38, 0x8d, 0x14, 0xad, 0xc1, 0x48, 0x40, 0x88, 0x45, 0xf1, 0x57,
-	0x4e, 0x8e, 0x5f, 0x9e, 0x46, 0x4d, 0xde, 0x16, 0x47, 0x48, 0xd0, 0x77, 0xc2, 0xa4, 0x25, 0x77,
-	0xd4, 0x31, 0xaf, 0xfd, 0x69, 0xac, 0xec, 0x05, 0xaa, 0xae, 0xb6, 0xc6, 0x49, 0x64, 0xfa, 0x5f,
-	0x6a, 0xf0, 0x58, 0xaf, 0xb5, 0x85, 0xde, 0x00, 0x30, 0x23, 0xf1, 0x82, 0xdb, 0x7b, 0x95, 0x7c,
-	0xa3, 0x92, 0x42, 0x4a, 0xbc, 0x41, 0x65, 0x51, 0x80, 0x15, 0x22, 0x39, 0x46, 0x04, 0x95, 0x73,
-	0x32, 0x22, 0xd0, 0xff, 0xbb, 0xa6, 0xb2, 0x22, 0xf5, 0xdb, 0xbe, 0xdb, 0x58, 0x91, 0xda, 0xf7,
-	0x42, 0x25, 0xf9, 0x1f, 0x56, 0xe0, 0x66, 0x7e, 0x13, 0xe5, 0xec, 0xfd, 0x38, 0x0c, 0x77, 0xb8,
-	0x51, 0xde, 0x00, 0x3b, 0x1b, 0x9f, 0xa2, 0x9c, 0x85, 0x9b, 0xcc, 0x3d, 0x3c, 0xac, 0xce, 0xe5,
-	0x31, 0x7a, 0x61, 0x6c, 0x27, 0xda, 0x21, 0x3b, 0xa5, 0x2a, 0xe4, 0xd2, 0xdf, 0x37, 0x9f, 0x90,
-	0xb9, 0x18, 0x5b, 0xc4, 0x39, 0xb1, 0x76, 0xf0, 0x53, 0x1a, 0x4c, 0x25, 0x56, 0x74, 0x30, 0x3b,
-	0xc4, 0xd6, 0x68, 0xa9, 0xf7, 0xdb, 0xc4, 0x56, 0x89, 0x4f, 0xee, 0x44, 0x71, 0x80, 0x53, 0x04,
-	0x53, 0x6c, 0x56, 0x9d, 0xd5, 0x77, 0x1d, 0x9b, 0x55, 0x3b, 0x5f, 0xc0, 0x66, 0x7f, 0xaa, 0x52,
-	0x34, 0x5a, 0xc6, 0x66, 0x1f, 0xc0, 0x58, 0x64, 0xae, 0x1e, 0xb1, 0x8b, 0xe5, 0x7e, 0xfb, 0xc4,
-	0xd1, 0xc5, 0xb6, 0x4b, 0x51, 0x49, 0x80, 0x63, 0x5a, 0xe8, 0xfb, 0x35, 0x80, 0xf8, 0xc3, 0x88,
-	0x4d, 0xb5, 0x71, 0x76, 0xd3, 0xa1, 0x88, 0x35, 0x53, 0x74, 0x4b, 0x2b, 0x8b, 0x42, 0xa1, 0xab,
-	0xff, 0x9f, 0x01, 0x40, 0xd9, 0xbe, 0x53, 0x71, 0x73, 0xd7, 0x76, 0xad, 0xf4, 0x85, 0xe0, 0x9e,
-	0xed, 0x5a, 0x98, 0x41, 0x4e, 0x20, 0x90, 0xbe, 0x00, 0x97, 0x5a, 0x8e, 0xb7, 0x65, 0x38, 0xce,
-	0x81, 0xb0, 0xdf, 0x16, 0x96, 0xc0, 0x97, 0xe9, 0xc1, 0x74, 0x27, 0x09, 0xc2, 0xe9, 0xba, 0xa8,
-	0x03, 0xd3, 0x3e, 0x31, 0x3d, 0xd7, 0xb4, 0x1d, 0x76, 0x75, 0xf2, 0xba, 0x61, 0xc9, 0x1b, 0x38,
-	0x13, 0xef, 0x71, 0x0a, 0x17, 0xce, 0x60, 0x47, 0xef, 0x83, 0x91, 0x8e, 0x6f, 0xb7, 0x0d, 0xff,
-	0x80, 0x5d, 0xce, 0x46, 0x17, 0xc7, 0xe9, 0x09, 0xb7, 0xce, 0x8b, 0x70, 0x04, 0x43, 0x9f, 0x84,
-	0x31, 0xc7, 0xde, 0x26, 0xe6, 0x81, 0xe9, 0x10, 0xa1, 0xa1, 0xbc, 0x7f, 0x36, 0x4b, 0x66, 0x25,
-	0x42, 0x2b, 0xec, 0x22, 0xa2, 0x9f, 0x38, 0x26, 0x88, 0x1a, 0x70, 0xf9, 0x81, 0xe7, 0xef, 0x12,
-	0xdf, 0x21, 0x41, 0xd0, 0xec, 0x76, 0x3a, 0x9e, 0x1f, 0x12, 0x8b, 0xe9, 0x31, 0x47, 0xb9, 0x91,
-	0xfa, 0xcb, 0x59, 0x30, 0xce, 0x6b, 0xa3, 0xbf, 0x5d, 0x81, 0xeb, 0x3d, 0x3a, 0x81, 0x30, 0xdd,
-	0x1b, 0x62, 0x8e, 0xc4, 0x4a, 0xf8, 0x20, 0x5f, 0xcf, 0xa2, 0xf0, 0xe1, 0x61, 0xf5, 0x89, 0x1e,
-	0x08, 0x9a, 0x74, 0x29, 0x92, 0xd6, 0x01, 0x8e, 0xd1, 0xa0, 0x06, 0x0c, 0x5b, 0xb1, 0x5a, 0x7f,
-	0x6c, 0xf1, 0x59, 0xca, 0xad, 0xb9, 0x02, 0xee, 0xa4, 0xd8, 0x04, 0x02, 0xb4, 0x02, 0x23, 0xdc,
-	0x9a, 0x82, 0x08, 0xce, 0xff, 0x1c, 0xbb, 0x1e, 0xf3, 0xa2, 0x93, 0x22, 0x8b, 0x50, 0xe8, 0xff,
-	0x5b, 0x83, 0x91, 0x9a, 0xe7, 0x93, 0xfa, 0x5a, 0x13, 0x1d, 0xc0, 0xb8, 0xe2, 0x47, 0x23, 0xb8,
-	0x60, 0x49, 0xb6, 0xc0, 0x30, 0x2e, 0xc4, 0xd8, 0x22, 0x9b, 0x6f, 0x59, 0x80, 0x55, 0x5a, 0xe8,
-	0x0d, 0x3a, 0xe7, 0x0f, 0x7c, 0x3b, 0xa4, 0x84, 0xfb, 0x79, 0x84, 0xe6, 0x84, 0x71, 0x84, 0x8b,
-	0xaf, 0x28, 0xf9, 0x13, 0xc7, 0x54, 0xf4, 0x75, 0xca, 0x01, 0xd2, 0xdd, 0x44, 0xb7, 0x61, 0xb0,
-	0xed, 0x59, 0xd1, 0x77, 0x7f, 0x7f, 0xb4, 0xbf, 0x57, 0x3d, 0x8b, 0xce, 0xed, 0xb5, 0x6c, 0x0b,
-	0xa6, 0x2a, 0x67, 0x6d, 0xf4, 0x35, 0x98, 0x4e, 0xd3, 0x47, 0xb7, 0x61, 0xca, 0xf4, 0xda, 0x6d,
-	0xcf, 0x6d, 0x76, 0xb7, 0xb7, 0xed, 0x7d, 0x92, 0x30, 0xc6, 0xaf, 0x25, 0x20, 0x38, 0x55, 0x53,
-	0xff, 0x49, 0x0d, 0x06, 0xe8, 0x77, 0xd1, 0x61, 0xd8, 0xf2, 0xda, 0x86, 0xed, 0x8a, 0x5e, 0x31,
-	0xc7, 0x83, 0x3a, 0x2b, 0xc1, 0x02, 0x82, 0x3a, 0x30, 0x16, 0x09, 0x4d, 0x7d, 0x19, 0x84, 0xd5,
-	0xd7, 0x9a, 0xd2, 0x88, 0x56, 0x72, 0xf2, 0xa8, 0x24, 0xc0, 0x31, 0x11, 0xdd, 0x80, 0x99, 0xfa,
-	0x5a, 0xb3, 0xe1, 0x9a, 0x4e, 0xd7, 0x22, 0x4b, 0xfb, 0xec, 0x0f, 0xe5, 0x25, 0x36, 0x2f, 0x11,
-	0xe3, 0x64, 0xbc, 0x44, 0x54, 0xc2, 0x11, 0x8c, 0x56, 0x23, 0xbc, 0x85, 0xb0, 0x98, 0x67, 0xd5,
-	0x04, 0x12, 0x1c, 0xc1, 0xf4, 0x2f, 0x57, 0x60, 0x5c, 0xe9, 0x10, 0x72, 0x60, 0x84, 0x0f, 0x37,
-	0x32, 0x58, 0x5d, 0x2a, 0x39, 0xc4, 0x64, 0xaf, 0x39, 0x75, 0x3e, 0xa1, 0x01, 0x8e, 0x48, 0xa8,
-	0x7c, 0xb1, 0xd2, 0x83, 0x2f, 0xce, 0x03, 0x04, 0xb1, 0xfb, 0x06, 0xdf, 0x92, 0xec, 0xe8, 0x51,
-	0x9c, 0x36, 0x94, 0x1a, 0xe8, 0x31, 0x71, 0x82, 0x70, 0x8b, 0xac, 0xd1, 0xd4, 0xe9, 0xb1, 0x0d,
-	0x43, 0x6f, 0x7a, 0x2e, 0x09, 0x84, 0xde, 0xf3, 0x8c, 0x06, 0x38, 0x46, 0xe5, 0x83, 0x57, 0x29,
-	0x5e, 0xcc, 0xd1, 0xeb, 0x3f, 0xa3, 0x01, 0xd4, 0x8d, 0xd0, 0xe0, 0xef, 0xa6, 0x27, 0x70, 0x7a,
-	0x78, 0x2c, 0x71, 0xf0, 0x8d, 0x66, 0x0c, 0xc1, 0x07, 0x03, 0xfb, 0xcd, 0x68, 0xf8, 0x52, 0xa0,
-	0xe6, 0xd8, 0x9b, 0xf6, 0x9b, 0x04, 0x33, 0x38, 0x7a, 0x06, 0xc6, 0x88, 0x6b, 0xfa, 0x07, 0x1d,
-	0xca, 0xbc, 0x07, 0xd9, 0xac, 0xb2, 0x1d, 0xba, 0x14, 0x15, 0xe2, 0x18, 0xae, 0x3f, 0x0b, 0xc9,
-	0x5b, 0xd1, 0xf1, 0xbd, 0xd4, 0xbf, 0x3a, 0x08, 0x8f, 0x2e, 0x6d, 0xd4, 0xea, 0x02, 0x9f, 0xed,
-	0xb9, 0xf7, 0xc8, 0xc1, 0xdf, 0xda, 0x98, 0xfd, 0xad, 0x8d, 0xd9, 0x19, 0xda, 0x98, 0xbd, 0x08,
-	0xd3, 0xf1, 0xf2, 0x12, 0xd6, 0x1d, 0xcf, 0xa4, 0xe5, 0xe9, 0xb1, 0xe8, 0xe4, 0xc9, 0xca, 0xc0,
-	0xfa, 0x43, 0x0d, 0xa6, 0x97, 0xf6, 0x3b, 0xb6, 0xcf, 0xbc, 0x75, 0x88, 0x4f, 0xef, 0xc1, 0xe8,
-	0x69, 0x18, 0xd9, 0xe3, 0xff, 0x8a, 0xd5, 0x29, 0x75, 0x0d, 0xa2, 0x06, 0x8e, 0xe0, 0x68, 0x1b,
-	0xa6, 0x08, 0x6b, 0xce, 0x04, 0x5e, 0x23, 0x2c, 0xb3, 0x02, 0xb9, 0x33, 0x58, 0x02, 0x0b, 0x4e,
-	0x61, 0x45, 0x4d, 0x98, 0x32, 0x1d, 0x23, 0x08, 0xec, 0x6d, 0xdb, 0x8c, 0xed, 0x50, 0xc7, 0x16,
-	0x9f, 0x61, 0x67, 0x57, 0x02, 0xf2, 0xf0, 0xb0, 0x7a, 0x55, 0xf4, 0x33, 0x09, 0xc0, 0x29, 0x14,
-	0xfa, 0x3b, 0x15, 0x98, 0x5c, 0xda, 0xef, 0x78, 0x41, 0xd7, 0x27, 0xac, 0xea, 0x05, 0x5c, 0xe1,
-	0x9f, 0x86, 0x91, 0x1d, 0xc3, 0xb5, 0x1c, 0xe2, 0x0b, 0xf6, 0x25, 0xe7, 0xf6, 0x2e, 0x2f, 0xc6,
-	0x11, 0x1c, 0xbd, 0x05, 0x10, 0x98, 0x3b, 0xc4, 0xea, 0x32, 0x11, 0x88, 0xef, 0xb2, 0x7b, 0x65,
-	0x98, 0x70, 0x62, 0x8c, 0x4d, 0x89, 0x52, 0x1c, 0x0d, 0xf2, 0x37, 0x56, 0xc8, 0xe9, 0x5f, 0xd1,
-	0x60, 0x26, 0xd1, 0xee, 0x02, 0x6e, 0xa6, 0xdb, 0xc9, 0x9b, 0xe9, 0x42, 0xdf, 0x63, 0x2d, 0xb8,
-	0x90, 0xfe, 0x50, 0x05, 0x1e, 0x29, 0x98, 0x93, 0x8c, 0xd1, 0x92, 0x76, 0x41, 0x46, 0x4b, 0x5d,
-	0x18, 0x0f, 0x3d, 0x47, 0x98, 0x4b, 0x47, 0x33, 0x50, 0xca, 0x24, 0x69, 0x43, 0xa2, 0x89, 0x4d,
-	0x92, 0xe2, 0xb2, 0x00, 0xab, 0x74, 0xf4, 0xdf, 0xd0, 0x60, 0x4c, 0x2a, 0xc0, 0xbe, 0xae, 0x1e,
-	0xa1, 0x4e, 0xee, 0xbf, 0xaa, 0xff, 0x4e, 0x05, 0xae, 0x49, 0xdc, 0x11, 0x9b, 0x6b, 0x86, 0x94,
-	0x6f, 0x1c, 0x7f, 0x8b, 0x7e, 0x4c, 0x1c, 0xe4, 0x8a, 0x30, 0xa1, 0x88, 0x1a, 0x54, 0xf0, 0xea,
-	0xfa, 0x1d, 0x2f, 0x88, 0xe4, 0x09, 0x2e, 0x78, 0xf1, 0x22, 0x1c, 0xc1, 0xd0, 0x1a, 0x0c, 0x05,
-	0x94, 0x9e, 0x38, 0x8e, 0x4e, 0x39, 0x1b, 0x4c, 0x24, 0x62, 0xfd, 0xc5, 0x1c, 0x0d, 0x7a, 0x4b,
-	0xe5, 0xe1, 0x43, 0xe5, 0xf5, 0x34, 0x74, 0x24, 0x56, 0x34, 0x23, 0x39, 0x3e, 0x5d, 0xb9, 0x67,
-	0xc2, 0x0a, 0x4c, 0x0b, 0xbb, 0x27, 0xbe, 0x6c, 0x5c, 0x93, 0xa0, 0x8f, 0x24, 0x56, 0xc6, 0x93,
-	0xa9, 0x67, 0xe8, 0x2b, 0xe9, 0xfa, 0xf1, 0x8a, 0xd1, 0x03, 0x18, 0xbd, 0x23, 0x3a, 0x89, 0xe6,
-	0xa0, 0x62, 0x47, 0xdf, 0x02, 0x04, 0x8e, 0x4a, 0xa3, 0x8e, 0x2b, 0xb6, 0x25, 0x05, 0xaa, 0x4a,
-	0xa1, 0xd8, 0xa7, 0x1c, 0x4b, 0x03, 0xbd, 0x8f, 0x25, 0xfd, 0x4f, 0x2a, 0x70, 0x25, 0xa2, 0x1a,
-	0x8d, 0xb1, 0x2e, 0x1e, 0xf1, 0x8e, 0x11, 0x2e, 0x8f, 0xd7, 0xaa, 0xdc, 0x87, 0x41, 0xc6, 0x00,
-	0x4b, 0x3d, 0xee, 0x49, 0x84, 0xb4, 0x3b, 0x98, 0x21, 0x42, 0x9f, 0x84, 0x61, 0xc7, 0xd8, 0x22,
-	0x4e, 0x64, 0x6f, 0x5a, 0x4a, 0x07, 0x95, 0x37, 0x5c, 0xae, 0x1a, 0x0d, 0xb8, 0x4f, 0x8d, 0x7c,
-	0xf3, 0xe1, 0x85, 0x58, 0xd0, 0x9c, 0x7b, 0x1e, 0xc6, 0x95, 0x6a, 0x68, 0x1a, 0x06, 0x76, 0x09,
-	0x7f, 0xdc, 0x1d, 0xc3, 0xf4, 0x5f, 0x74, 0x05, 0x86, 0xf6, 0x0c, 0xa7, 0x2b, 0xa6, 0x04, 0xf3,
-	0x1f, 0xb7, 0x2b, 0x1f, 0xd1, 0xf4, 0x5f, 0xd0, 0x60, 0xfc, 0xae, 0xbd, 0x45, 0x7c, 0x6e, 0xbc,
-	0xc4, 0xee, 0x52, 0x89, 0xf0, 0x01, 0xe3, 0x79, 0xa1, 0x03, 0xd0, 0x3e, 0x8c, 0x89, 0x93, 0x46,
-	0xda, 0xb6, 0xdf, 0x29, 0xf7, 0x8a, 0x2c, 0x49, 0x0b, 0x0e, 0xae, 0xba, 0x2b, 0x46, 0x14, 0x70,
-	0x4c, 0x4c, 0x7f, 0x0b, 0x2e, 0xe7, 0x34, 0x42, 0x55, 0xb6, 0x7d, 0xfd, 0x50, 0x2c, 0x8b, 0x68,
-	0x3f, 0xfa, 0x21, 0xe6, 0xe5, 0xe8, 0x51, 0x18, 0x20, 0xae, 0x25, 0xd6, 0xc4, 0xc8, 0xd1, 0x61,
-	0x75, 0x60, 0xc9, 0xb5, 0x30, 0x2d, 0xa3, 0x6c, 0xca, 0xf1, 0x12, 0x32, 0x09, 0x63, 0x53, 0x2b,
-	0xa2, 0x0c, 0x4b, 0x28, 0x7b, 0xf7, 0x4f, 0x3f, 0x71, 0x53, 0xf1, 0x76, 0x7a, 0x3b, 0xb5, 0x7b,
-	0xfa, 0x79, 0x59, 0x4f, 0xef, 0xc4, 0xc5, 0x59, 0x31, 0x21, 0x99, 0x3d, 0x8d, 0x33, 0x74, 0xf5,
-	0x5f, 0x1d, 0x84, 0xc7, 0xef, 0x7a, 0xbe, 0xfd, 0xa6, 0xe7, 0x86, 0x86, 0xb3, 0xee, 0x59, 0xb1,
-	0xd5, 0x93, 0x60, 0xca, 0x3f, 0xa0, 0xc1, 0x23, 0x66, 0xa7, 0xcb, 0xc5, 0xe3, 0xc8, 0x70, 0x68,
-	0x9d, 0xf8, 0xb6, 0x57, 0xd6, 0x5a, 0x95, 0x39, 0xa8, 0xd7, 0xd6, 0x37, 0xf3, 0x50, 0xe2, 0x22,
-	0x5a, 0xcc, 0x68, 0xd6, 0xf2, 0x1e, 0xb8, 0xac, 0x73, 0xcd, 0x90, 0xcd, 0xe6, 0x9b, 0xf1, 0x47,
-	0x28, 0x69, 0x34, 0x5b, 0xcf, 0xc5, 0x88, 0x0b, 0x28, 0xa1, 0xef, 0x85, 0xab, 0x36, 0xef, 0x1c,
-	0x26, 0x86, 0x65, 0xbb, 0x24, 0x08, 0xb8, 0xc5, 0x5d, 0x1f, 0x56, 0xa1, 0x8d, 0x3c, 0x84, 0x38,
-	0x9f, 0x0e, 0x7a, 0x0d, 0x20, 0x38, 0x70, 0x4d, 0x31, 0xff, 0xe5, 0xcc, 0x93, 0xb8, 0x10, 0x28,
-	0xb1, 0x60, 0x05, 0x23, 0xbd, 0x4a, 0x84, 0x72, 0x51, 0x0e, 0x33, 0x13, 0x33, 0x76, 0x95, 0x88,
-	0xd7, 0x50, 0x0c, 0xd7, 0xff, 0x99, 0x06, 0x23, 0x22, 0x08, 0x06, 0x7a, 0x7f, 0x4a, 0x4d, 0x24,
-	0x79, 0x4f, 0x4a, 0x55, 0x74, 0xc0, 0xde, 0x0a, 0x85, 0x8a, 0x50, 0x88, 0x12, 0xa5, 0xf4, 0x0c,
-	0x82, 0x70, 0xac, 0x6f, 0x4c, 0xbc, 0x19, 0x46, 0x3a, 0x48, 0x85, 0x98, 0xfe, 0x05, 0x0d, 0x66,
-	0x32, 0xad, 0x4e, 0x20, 0x2f, 0x5c, 0xa0, 0x19, 0xce, 0x1f, 0x0e, 0xc2, 0x14, 0x33, 0x99, 0x75,
-	0x0d, 0x87, 0x6b, 0x70, 0x2e, 0xe0, 0x82, 0xf2, 0x0c, 0x8c, 0xd9, 0xed, 0x76, 0x37, 0xa4, 0xac,
-	0x5a, 0x28, 0xe1, 0xd9, 0x37, 0x6f, 0x44, 0x85, 0x38, 0x86, 0x23, 0x57, 0x1c, 0x85, 0x9c, 0x89,
-	0xaf, 0x94, 0xfb, 0x72, 0xea, 0x00, 0xe7, 0xe9, 0xb1, 0xc5, 0xcf, 0xab, 0xbc, 0x93, 0xf2, 0x07,
-	0x35, 0x80, 0x20, 0xf4, 0x6d, 0xb7, 0x45, 0x0b, 0xc5, 0x71, 0x89, 0xcf, 0x80, 0x6c, 0x53, 0x22,
-	0xe5, 0xc4, 0xe5, 0x1c, 0xc5, 0x00, 0xac, 0x50, 0x46, 0x0b, 0x42, 0x4a, 0xe0, 0x1c, 0xff, 0x1b,
-	0x53, 0xf2, 0xd0, 0xe3, 0xd9, 0x18, 0x4f, 0xc2, 0x31, 0x3a, 0x16, 0x23, 0xe6, 0x3e, 0x0c, 0x63,
-	0x92, 0xde, 0x71, 0xa7, 0xee, 0x84, 0x72, 0xea, 0xce, 0xbd, 0x00, 0x97, 0x52, 0xdd, 0x3d, 0xd5,
-	0xa1, 0xfd, 0x1f, 0x34, 0x40, 0xc9, 0xd1, 0x5f, 0xc0, 0xd5, 0xae, 0x95, 0xbc, 0xda, 0x2d, 0xf6,
-	0xff, 0xc9, 0x0a, 0xee, 0x76, 0x5f, 0x99, 0x02, 0x16, 0x23, 0x48, 0xc6, 0x60, 0x12, 0x07, 0x17,
-	0x3d, 0x67, 0x63, 0x3f, 0x23, 0xb1, 0x73, 0xfb, 0x38, 0x67, 0xef, 0xa5, 0x70, 0xc5, 0xe7, 0x6c,
-	0x1a, 0x82, 0x33, 0x74, 0xd1, 0x67, 0x34, 0x98, 0x36, 0x92, 0x31, 0x82, 0xa2, 0x99, 0x29, 0xe5,
-	0x83, 0x9e, 0x8a, 0x37, 0x14, 0xf7, 0x25, 0x05, 0x08, 0x70, 0x86, 0x2c, 0xfa, 0x20, 0x4c, 0x18,
-	0x1d, 0x7b, 0xa1, 0x6b, 0xd9, 0xf4, 0x6a, 0x10, 0x05, 0x78, 0x61, 0xd7, 0xd5, 0x85, 0xf5, 0x86,
-	0x2c, 0xc7, 0x89, 0x5a, 0x32, 0x18, 0x8f, 0x98, 0xc8, 0xc1, 0x3e, 0x83, 0xf1, 0x88, 0x39, 0x8c,
-	0x83, 0xf1, 0x88, 0xa9, 0x53, 0x89, 0x20, 0x17, 0xc0, 0xb3, 0x2d, 0x53, 0x90, 0xe4, 0xcf, 0x7e,
-	0xa5, 0x6e, 0xc8, 0xf7, 0x1b, 0xf5, 0x9a, 0xa0, 0xc8, 0x4e, 0xbf, 0xf8, 0x37, 0x56, 0x28, 0xa0,
-	0xcf, 0x69, 0x30, 0x29, 0x78, 0xb7, 0xa0, 0x39, 0xc2, 0x3e, 0xd1, 0xab, 0x65, 0xd7, 0x4b, 0x6a,
-	0x4d, 0xce, 0x63, 0x15, 0x39, 0xe7, 0x3b, 0xd2, 0x4d, 0x2d, 0x01, 0xc3, 0xc9, 0x7e, 0xa0, 0x7f,
-	0xa0, 0xc1, 0x95, 0x80, 0xf8, 0x7b, 0xb6, 0x49, 0x16, 0x4c, 0xd3, 0xeb, 0xba, 0xd1, 0x77, 0x18,
-	0x2d, 0x1f, 0xbb, 0xa4, 0x99, 0x83, 0x4f, 0x58, 0x4e, 0xe7, 0x40, 0x70, 0x2e, 0x7d, 0x2a, 0x96,
-	0x5d, 0x7a, 0x60, 0x84, 0xe6, 0x4e, 0xcd, 0x30, 0x77, 0x98, 0xb2, 0x9d, 0xbb, 0x44, 0x94, 0x5c,
-	0xd7, 0x2f, 0x27, 0x51, 0xf1, 0x67, 0xeb, 0x54, 0x21, 0x4e, 0x13, 0x44, 0x1e, 0x8c, 0xfa, 0x22,
-	0xf0, 0xda, 0x2c, 0x94, 0x17, 0x29, 0x32, 0x51, 0xdc, 0xb8, 0x60, 0x1f, 0xfd, 0xc2, 0x92, 0x08,
-	0x6a, 0xc1, 0xe3, 0xfc, 0x6a, 0xb3, 0xe0, 0x7a, 0xee, 0x41, 0xdb, 0xeb, 0x06, 0x0b, 0xdd, 0x70,
-	0x87, 0xb8, 0x61, 0xa4, 0xab, 0x1c, 0x67, 0xc7, 0x28, 0xf3, 0x04, 0x58, 0xea, 0x55, 0x11, 0xf7,
-	0xc6, 0x83, 0x5e, 0x81, 0x51, 0xb2, 0x47, 0xdc, 0x70, 0x63, 0x63, 0x85, 0x79, 0x57, 0x9c, 0x5e,
-	0xda, 0x63, 0x43, 0x58, 0x12, 0x38, 0xb0, 0xc4, 0x86, 0x76, 0x61, 0xc4, 0xe1, 0x91, 0xf3, 0x98,
-	0x97, 0x45, 0x49, 0xa6, 0x98, 0x8e, 0xc2, 0xc7, 0xef, 0x7f, 0xe2, 0x07, 0x8e, 0x28, 0xa0, 0x0e,
-	0xdc, 0xb4, 0xc8, 0xb6, 0xd1, 0x75, 0xc2, 0x35, 0x2f, 0xc4, 0xcc, 0xec, 0x5e, 0xaa, 0xa4, 0x22,
-	0x47, 0x9a, 0x29, 0x16, 0x66, 0x80, 0x39, 0x34, 0xd4, 0x8f, 0xa9, 0x8b, 0x8f, 0xc5, 0x86, 0x0e,
-	0xe0, 0x09, 0x51, 0x87, 0xd9, 0xf9, 0x9b, 0x3b, 0x74, 0x96, 0xb3, 0x44, 0x2f, 0x31, 0xa2, 0xff,
-	0xdf, 0xd1, 0x61, 0xf5, 0x89, 0xfa, 0xf1, 0xd5, 0xf1, 0x49, 0x70, 0x32, 0xd3, 0x69, 0x92, 0xd2,
-	0xd1, 0xcf, 0x4e, 0x97, 0x9f, 0xe3, 0xb4, 0xbe, 0x9f, 0xdb, 0x56, 0xa4, 0x4b, 0x71, 0x86, 0xe6,
-	0xdc, 0xc7, 0x01, 0x65, 0x19, 0xce, 0x71, 0x92, 0xc3, 0xa8, 0x2a, 0x39, 0x7c, 0x7e, 0x08, 0xae,
-	0x53, 0x3e, 0x16, 0xcb, 0xcb, 0xab, 0x86, 0x6b, 0xb4, 0xbe, 0x3e, 0xcf, 0xd8, 0x5f, 0xd0, 0xe0,
-	0x91, 0x9d, 0xfc, 0xbb, 0xac, 0x90, 0xd8, 0x3f, 0x51, 0x4a, 0xe7, 0xd0, 0xeb, 0x7a, 0xcc, 0xb7,
-	0x78, 0xcf, 0x2a, 0xb8, 0xa8, 0x53, 0xe8, 0xe3, 0x30, 0xed, 0x7a, 0x16, 0xa9, 0x35, 0xea, 0x78,
-	0xd5, 0x08, 0x76, 0x9b, 0xd1, 0x1b, 0xe6, 0x10, 0xff, 0xc2, 0x6b, 0x29, 0x18, 0xce, 0xd4, 0x46,
-	0x7b, 0x80, 0x3a, 0x9e, 0xb5, 0xb4, 0x67, 0x9b, 0xd1, 0xeb, 0x59, 0x79, 0x8b, 0x1d, 0xf6, 0x44,
-	0xb7, 0x9e, 0xc1, 0x86, 0x73, 0x28, 0xb0, 0xcb, 0x38, 0xed, 0xcc, 0xaa, 0xe7, 0xda, 0xa1, 0xe7,
-	0x33, 0xb7, 0xb6, 0xbe, 0xee, 0xa4, 0xec, 0x32, 0xbe, 0x96, 0x8b, 0x11, 0x17, 0x50, 0xd2, 0xff,
-	0x87, 0x06, 0x97, 0xe8, 0xb2, 0x58, 0xf7, 0xbd, 0xfd, 0x83, 0xaf, 0xc7, 0x05, 0xf9, 0xb4, 0x30,
-	0xe7, 0xe0, 0x4a, 0xa4, 0xab, 0x8a, 0x29, 0xc7, 0x18, 0xeb, 0x73, 0x6c, 0xbd, 0xa1, 0xea, 0xd1,
-	0x06, 0x8a, 0xf5, 0x68, 0xfa, 0xe7, 0x2a, 0x5c, 0xd6, 0x8d, 0xf4, 0x58, 0x5f, 0x97, 0xfb, 0xf0,
-	0xc3, 0x30, 0x49, 0xcb, 0x56, 0x8d, 0xfd, 0xf5, 0xfa, 0x4b, 0x9e, 0x13, 0x39, 0x25, 0x31, 0x43,
-	0xe3, 0x7b, 0x2a, 0x00, 0x27, 0xeb, 0xa1, 0xdb, 0x30, 0xd2, 0xe1, 0xf1, 0x0b, 0xc4, 0x2d, 0xeb,
-	0x26, 0xb7, 0x79, 0x60, 0x45, 0x0f, 0x0f, 0xab, 0x33, 0xf1, 0xab, 0x8d, 0x28, 0xc4, 0x51, 0x03,
-	0xfd, 0xaf, 0x2f, 0x03, 0x43, 0xee, 0x90, 0xf0, 0xeb, 0x71, 0x4e, 0x9e, 0x85, 0x71, 0xb3, 0xd3,
-	0xad, 0x2d, 0x37, 0x3f, 0xd1, 0xf5, 0xd8, 0xed, 0x99, 0x85, 0x5a, 0xa5, 0xc2, 0x6f, 0x6d, 0x7d,
-	0x33, 0x2a, 0xc6, 0x6a, 0x1d, 0xca, 0x1d, 0xcc, 0x4e, 0x57, 0xf0, 0xdb, 0x75, 0xd5, 0xda, 0x96,
-	0x71, 0x87, 0xda, 0xfa, 0x66, 0x02, 0x86, 0x33, 0xb5, 0xd1, 0xf7, 0xc2, 0x04, 0x11, 0x1b, 0xf7,
-	0xae, 0xe1, 0x5b, 0x82, 0x2f, 0x34, 0xca, 0x0e, 0x5e, 0x4e, 0x6d, 0xc4, 0x0d, 0xf8, 0x9d, 0x61,
-	0x49, 0x21, 0x81, 0x13, 0x04, 0xd1, 0x77, 0xc0, 0xa3, 0xd1, 0x6f, 0xfa, 0x95, 0x3d, 0x2b, 0xcd,
-	0x28, 0x86, 0xb8, 0xcb, 0xf8, 0x52, 0x51, 0x25, 0x5c, 0xdc, 0x1e, 0xfd, 0xbc, 0x06, 0xd7, 0x24,
-	0xd4, 0x76, 0xed, 0x76, 0xb7, 0x8d, 0x89, 0xe9, 0x18, 0x76, 0x5b, 0xdc, 0x14, 0x5e, 0x3e, 0xb3,
-	0x81, 0x26, 0xd1, 0x73, 0x66, 0x95, 0x0f, 0xc3, 0x05, 0x5d, 0x42, 0x5f, 0xd0, 0xe0, 0x66, 0x04,
-	0x5a, 0xf7, 0x49, 0x10, 0x74, 0x7d, 0x12, 0xbb, 0xc4, 0x89, 0x29, 0x19, 0x29, 0xc5, 0x3b, 0x99,
-	0xc8, 0xb4, 0x74, 0x0c, 0x6e, 0x7c, 0x2c, 0x75, 0x75, 0xb9, 0x34, 0xbd, 0xed, 0x50, 0x5c, 0x2d,
-	0xce, 0x6b, 0xb9, 0x50, 0x12, 0x38, 0x41, 0x10, 0xfd, 0x73, 0x0d, 0x1e, 0x51, 0x0b, 0xd4, 0xd5,
-	0xc2, 0xef, 0x14, 0xaf, 0x9c, 0x59, 0x67, 0x52, 0xf8, 0xb9, 0x52, 0xba, 0x00, 0x88, 0x8b, 0x7a,
-	0x45, 0xd9, 0x76, 0x9b, 0x2d, 0x4c, 0x7e, 0xef, 0x18, 0xe2, 0x6c, 0x9b, 0xaf, 0xd5, 0x00, 0x47,
-	0x30, 0x7a, 0xe3, 0xee, 0x78, 0xd6, 0xba, 0x6d, 0x05, 0x2b, 0x76, 0xdb, 0x0e, 0xd9, 0xed, 0x60,
-	0x80, 0x4f, 0xc7, 0xba, 0x67, 0xad, 0x37, 0xea, 0xbc, 0x1c, 0x27, 0x6a, 0xa1, 0x79, 0x80, 0x6d,
-	0xc3, 0x76, 0x9a, 0x0f, 0x8c, 0xce, 0xfd, 0xc8, 0x15, 0x9a, 0xdd, 0x5e, 0x97, 0x65, 0x29, 0x56,
-	0x6a, 0xd0, 0xef, 0x47, 0xf9, 0x0e, 0x26, 0x3c, 0x16, 0x17, 0x13, 0xa8, 0xcf, 0xe2, 0xfb, 0x45,
-	0x08, 0x79, 0x87, 0xef, 0x29, 0x24, 0x70, 0x82, 0x20, 0xfa, 0x01, 0x0d, 0xa6, 0x82, 0x83, 0x20,
-	0x24, 0x6d, 0xd9, 0x87, 0x4b, 0x67, 0xdd, 0x07, 0xa6, 0x45, 0x6d, 0x26, 0x88, 0xe0, 0x14, 0x51,
-	0xe6, 0x54, 0xde, 0x36, 0x5a, 0xe4, 0x4e, 0xed, 0xae, 0xdd, 0xda, 0x91, 0x4e, 0xce, 0xeb, 0xc4,
-	0x37, 0x89, 0x1b, 0x32, 0x51, 0x7c, 0x48, 0x38, 0x95, 0x17, 0x57, 0xc3, 0xbd, 0x70, 0xa0, 0xd7,
-	0x60, 0x4e, 0x80, 0x57, 0xbc, 0x07, 0x19, 0x0a, 0x33, 0x8c, 0x02, 0x33, 0x3b, 0x6a, 0x14, 0xd6,
-	0xc2, 0x3d, 0x30, 0xa0, 0x06, 0x5c, 0x0e, 0x88, 0xcf, 0x1e, 0x41, 0x78, 0xa4, 0x9a, 0xf5, 0xae,
-	0xe3, 0x04, 0xb3, 0x28, 0xb6, 0x38, 0x6e, 0x66, 0xc1, 0x38, 0xaf, 0x0d, 0x7a, 0x41, 0x3a, 0x35,
-	0x1d, 0xd0, 0x82, 0x4f, 0xac, 0x37, 0x67, 0x2f, 0xb3, 0xfe, 0x5d, 0x56, 0x7c, 0x95, 0x22, 0x10,
-	0x4e, 0xd7, 0xa5, 0xa7, 0x79, 0x54, 0xb4, 0xd8, 0xf5, 0x83, 0x70, 0xf6, 0x0a, 0x6b, 0xcc, 0x4e,
-	0x73, 0xac, 0x02, 0x70, 0xb2, 0x1e, 0xba, 0x0d, 0x53, 0x01, 0x31, 0x4d, 0xaf, 0xdd, 0x11, 0x37,
-	0xab, 0xd9, 0xab, 0xac, 0xf7, 0xfc, 0x0b, 0x26, 0x20, 0x38, 0x55, 0x13, 0x1d, 0xc0, 0x65, 0x19,
-	0x99, 0x6a, 0xc5, 0x6b, 0xad, 0x1a, 0xfb, 0x4c, 0x38, 0xbe, 0x76, 0x3c, 0x7f, 0x9c, 0x8f, 0x5e,
-	0xb5, 0xe7, 0x3f, 0xd1, 0x35, 0xdc, 0xd0, 0x0e, 0x0f, 0xf8, 0x74, 0xd5, 0xb2, 0xe8, 0x70, 0x1e,
-	0x0d, 0xb4, 0x02, 0x57, 0x52, 0xc5, 0xcb, 0xb6, 0x43, 0x82, 0xd9, 0x47, 0xd8, 0xb0, 0x99, 0x7a,
-	0xa4, 0x96, 0x03, 0xc7, 0xb9, 0xad, 0xd0, 0x7d, 0xb8, 0xda, 0xf1, 0xbd, 0x90, 0x98, 0xe1, 0x3d,
-	0x2a, 0x10, 0x38, 0x62, 0x80, 0xc1, 0xec, 0x2c, 0x9b, 0x0b, 0xf6, 0x00, 0xb4, 0x9e, 0x57, 0x01,
-	0xe7, 0xb7, 0x43, 0x9f, 0xd7, 0xe0, 0x46, 0x10, 0xfa, 0xc4, 0x68, 0xdb, 0x6e, 0xab, 0xe6, 0xb9,
-	0x2e, 0x61, 0x8c, 0xa9, 0x61, 0xc5, 0x06, 0xfb, 0x8f, 0x96, 0x3a, 0x45, 0xf4, 0xa3, 0xc3, 0xea,
-	0x8d, 0x66, 0x4f, 0xcc, 0xf8, 0x18, 0xca, 0xe8, 0x2d, 0x80, 0x36, 0x69, 0x7b, 0xfe, 0x01, 0xe5,
-	0x48, 0xb3, 0x73, 0xe5, 0xed, 0x97, 0x56, 0x25, 0x16, 0xbe, 0xfd, 0x13, 0x4f, 0x57, 0x31, 0x10,
-	0x2b, 0xe4, 0xf4, 0xc3, 0x0a, 0x5c, 0xcd, 0x65, 0xf5, 0x74, 0x07, 0xf0, 0x7a, 0x0b, 0x51, 0x94,
-	0x6a, 0xf1, 0xda, 0xc3, 0x76, 0xc0, 0x6a, 0x12, 0x84, 0xd3, 0x75, 0xa9, 0x20, 0xc6, 0x76, 0xea,
-	0x72, 0x33, 0x6e, 0x5f, 0x89, 0x05, 0xb1, 0x46, 0x0a, 0x86, 0x33, 0xb5, 0x51, 0x0d, 0x66, 0x44,
-	0x59, 0x83, 0xde, 0x65, 0x82, 0x65, 0x9f, 0x44, 0x22, 0x2e, 0xbd, 0x15, 0xcc, 0x34, 0xd2, 0x40,
-	0x9c, 0xad, 0x4f, 0x47, 0x41, 0x7f, 0xa8, 0xbd, 0x18, 0x8c, 0x47, 0xb1, 0x96, 0x04, 0xe1, 0x74,
-	0xdd, 0xe8, 0xb2, 0x99, 0xe8, 0xc2, 0x50, 0x3c, 0x8a, 0xb5, 0x14, 0x0c, 0x67, 0x6a, 0xeb, 0xff,
-	0x71, 0x10, 0x9e, 0x38, 0x81, 0x78, 0x84, 0xda, 0xf9, 0xd3, 0x7d, 0xfa, 0x8d, 0x7b, 0xb2, 0xcf,
-	0xd3, 0x29, 0xf8, 0x3c, 0xa7, 0xa7, 0x77, 0xd2, 0xcf, 0x19, 0x14, 0x7d, 0xce, 0xd3, 0x93, 0x3c,
-	0xf9, 0xe7, 0x6f, 0xe7, 0x7f, 0xfe, 0x92, 0xb3, 0x7a, 0xec, 0x72, 0xe9, 0x14, 0x2c, 0x97, 0x92,
-	0xb3, 0x7a, 0x82, 0xe5, 0xf5, 0x47, 0x83, 0xf0, 0xe4, 0x49, 0x44, 0xb5, 0x92, 0xeb, 0x2b, 0x87,
-	0xe5, 0x9d, 0xeb, 0xfa, 0x2a, 0xf2, 0x89, 0x3a, 0xc7, 0xf5, 0x95, 0x43, 0xf2, 0xbc, 0xd7, 0x57,
-	0xd1, 0xac, 0x9e, 0xd7, 0xfa, 0x2a, 0x9a, 0xd5, 0x13, 0xac, 0xaf, 0xbf, 0x48, 0x9f, 0x0f, 0x52,
-	0x5e, 0x6c, 0xc0, 0x80, 0xd9, 0xe9, 0x96, 0x64, 0x52, 0xcc, 0x36, 0xa8, 0xb6, 0xbe, 0x89, 0x29,
-	0x0e, 0x84, 0x61, 0x98, 0xaf, 0x9f, 0x92, 0x2c, 0x88, 0x79, 0xd7, 0xf0, 0x25, 0x89, 0x05, 0x26,
-	0x3a, 0x55, 0xa4, 0xb3, 0x43, 0xda, 0xc4, 0x37, 0x9c, 0x66, 0xe8, 0xf9, 0x46, 0xab, 0x2c, 0xb7,
-	0xe1, 0x8a, 0xe3, 0x14, 0x2e, 0x9c, 0xc1, 0x4e, 0x27, 0xa4, 0x63, 0x5b, 0x25, 0xf9, 0x0b, 0x9b,
-	0x90, 0xf5, 0x46, 0x1d, 0x53, 0x1c, 0xfa, 0x97, 0x46, 0x41, 0x89, 0xfc, 0x88, 0xde, 0xd6, 0x60,
-	0xc6, 0x4c, 0xc7, 0x57, 0xea, 0xc7, 0x0c, 0x24, 0x13, 0xac, 0x89, 0x2f, 0xf9, 0x4c, 0x31, 0xce,
-	0x92, 0x45, 0xdf, 0xa7, 0x71, 0x4d, 0x95, 0x7c, 0xc4, 0x10, 0xd3, 0x7a, 0xe7, 0x8c, 0x9e, 0xfb,
-	0x62, 0x95, 0x57, 0xfc, 0xb2, 0x94, 0x24, 0x88, 0xbe, 0xa0, 0xc1, 0xd5, 0xdd, 0x3c, 0x05, 0xbb,
-	0x98, 0xfc, 0xfb, 0x65, 0xbb, 0x52, 0xa0, 0xb1, 0xe7, 0x12, 0x67, 0x6e, 0x05, 0x9c, 0xdf, 0x11,
-	0x39, 0x4b, 0x52, 0xe7, 0x28, 0xf6, 0x69, 0xe9, 0x59, 0x4a, 0x29, 0x2f, 0xe3, 0x59, 0x92, 0x00,
-	0x9c, 0x24, 0x88, 0x3a, 0x30, 0xb6, 0x1b, 0x29, 0x7a, 0x85, 0x72, 0xa7, 0x56, 0x96, 0xba, 0xa2,
-	0x2d, 0xe6, 0x66, 0x2e, 0xb2, 0x10, 0xc7, 0x44, 0xd0, 0x0e, 0x8c, 0xec, 0x72, 0x5e, 0x21, 0x94,
-	0x32, 0x0b, 0x7d, 0x5f, 0x61, 0xb9, 0x6e, 0x40, 0x14, 0xe1, 0x08, 0xbd, 0x6a, 0xe3, 0x3a, 0x7a,
-	0x8c, 0xeb, 0xc5, 0xe7, 0x35, 0xb8, 0xba, 0x47, 0xfc, 0xd0, 0x36, 0xd3, 0xcf, 0x1b, 0x63, 0xe5,
-	0xaf, 0xd9, 0x2f, 0xe5, 0x21, 0xe4, 0xcb, 0x24, 0x17, 0x84, 0xf3, 0xbb, 0x40, 0x2f, 0xdd, 0x5c,
-	0x4b, 0xdd, 0x0c, 0x8d, 0xd0, 0x36, 0x37, 0xbc, 0x5d, 0xe2, 0xc6, 0x39, 0x84, 0x98, 0x7a, 0x44,
-	0x44, 0x72, 0x5b, 0x2a, 0xae, 0x86, 0x7b, 0xe1, 0xd0, 0xff, 0x54, 0x83, 0x8c, 0xae, 0x15, 0xfd,
-	0xa8, 0x06, 0x13, 0xdb, 0xc4, 0x08, 0xbb, 0x3e, 0xb9, 0x63, 0x84, 0xd2, 0xa1, 0xfc, 0xa5, 0xb3,
-	0x50, 0xf1, 0xce, 0x2f, 0x2b, 0x88, 0xf9, 0x73, 0xbd, 0x0c, 0xec, 0xaa, 0x82, 0x70, 0xa2, 0x07,
-	0x73, 0x2f, 0xc2, 0x4c, 0xa6, 0xe1, 0xa9, 0x9e, 0xdd, 0xfe, 0xb5, 0x06, 0x79, 0x69, 0xaf, 0xd0,
-	0x6b, 0x30, 0x64, 0x58, 0x96, 0xcc, 0x63, 0xf1, 0x7c, 0x39, 0xcb, 0x11, 0x4b, 0xf5, 0xdb, 0x67,
-	0x3f, 0x31, 0x47, 0x8b, 0x96, 0x01, 0x19, 0x89, 0xf7, 0xe7, 0xd5, 0xd8, 0x1b, 0x95, 0x3d, 0x0f,
-	0x2d, 0x64, 0xa0, 0x38, 0xa7, 0x85, 0xfe, 0x43, 0x1a, 0xa0, 0x6c, 0x28, 0x60, 0xe4, 0xc3, 0xa8,
-	0x58, 0xca, 0xd1, 0x57, 0xaa, 0x97, 0x74, 0xf8, 0x48, 0x78, 0x2f, 0xc5, 0x66, 0x48, 0xa2, 0x20,
-	0xc0, 0x92, 0x8e, 0xfe, 0x97, 0x1a, 0xc4, 0xb1, 0xee, 0xd1, 0x87, 0x60, 0xdc, 0x22, 0x81, 0xe9,
-	0xdb, 0x9d, 0x30, 0xf6, 0x75, 0x92, 0x3e, 0x13, 0xf5, 0x18, 0x84, 0xd5, 0x7a, 0x48, 0x87, 0xe1,
-	0xd0, 0x08, 0x76, 0x1b, 0x75, 0x71, 0xef, 0x63, 0xa7, 0xf4, 0x06, 0x2b, 0xc1, 0x02, 0x12, 0x47,
-	0x04, 0x1b, 0x38, 0x41, 0x44, 0x30, 0xb4, 0x7d, 0x06, 0xe1, 0xcf, 0xd0, 0xf1, 0xa1, 0xcf, 0xf4,
-	0x9f, 0xab, 0xc0, 0x25, 0x5a, 0x65, 0xd5, 0xb0, 0xdd, 0x90, 0xb8, 0xcc, 0xb2, 0xbf, 0xe4, 0x24,
-	0xb4, 0x60, 0x32, 0x4c, 0xb8, 0xbe, 0x9d, 0xde, 0xef, 0x4b, 0xda, 0xba, 0x24, 0x1d, 0xde, 0x92,
-	0x78, 0xd1, 0xf3, 0x91, 0x6b, 0x05, 0xbf, 0x21, 0x3f, 0x11, 0x2d, 0x55, 0xe6, 0x2f, 0xf1, 0x50,
-	0xf8, 0x11, 0xca, 0x04, 0x09, 0x09, 0x2f, 0x8a, 0x0f, 0xc3, 0xa4, 0x30, 0x71, 0xe6, 0xa1, 0xdd,
-	0xc4, 0x0d, 0x99, 0x9d, 0x30, 0xcb, 0x2a, 0x00, 0x27, 0xeb, 0xe9, 0x7f, 0x50, 0x81, 0x64, 0x1a,
-	0x86, 0xb2, 0xb3, 0x94, 0x8d, 0x6b, 0x57, 0x39, 0xb7, 0xb8, 0x76, 0x1f, 0x60, 0x39, 0x8c, 0x78,
-	0xb2, 0x3b, 0xfe, 0x6e, 0xac, 0x66, 0x1e, 0xe2, 0xa9, 0xea, 0x64, 0x8d, 0x78, 0x5a, 0x07, 0x4f,
-	0x3d, 0xad, 0x1f, 0x12, 0xb6, 0x8f, 0x43, 0x89, 0xe8, 0x82, 0x91, 0xed, 0xe3, 0x4c, 0xa2, 0xa1,
-	0xe2, 0x08, 0xf2, 0x25, 0x0d, 0x46, 0x44, 0xfc, 0xeb, 0x13, 0x38, 0x1a, 0x6d, 0xc3, 0x10, 0xbb,
-	0x95, 0xf4, 0x23, 0x0d, 0x36, 0x77, 0x3c, 0x2f, 0x4c, 0x44, 0x01, 0x67, 0x96, 0xfd, 0xec, 0x5f,
-	0xcc, 0xd1, 0x33, 0xf3, 0x37, 0xdf, 0xdc, 0xb1, 0x43, 0x62, 0x86, 0x51, 0x6c, 0xe1, 0xc8, 0xfc,
-	0x4d, 0x29, 0xc7, 0x89, 0x5a, 0xfa, 0x4f, 0x0e, 0xc2, 0x4d, 0x81, 0x38, 0x23, 0x22, 0x49, 0x06,
-	0x77, 0x00, 0x97, 0xc5, 0xb7, 0xad, 0xfb, 0x86, 0x2d, 0xdf, 0xe3, 0xcb, 0xdd, 0x4e, 0x45, 0x42,
-	0xc7, 0x0c, 0x3a, 0x9c, 0x47, 0x83, 0x47, 0xb0, 0x64, 0xc5, 0x77, 0x89, 0xe1, 0x84, 0x3b, 0x11,
-	0xed, 0x4a, 0x3f, 0x11, 0x2c, 0xb3, 0xf8, 0x70, 0x2e, 0x15, 0x66, 0x0f, 0x20, 0x00, 0x35, 0x9f,
-	0x18, 0xaa, 0x31, 0x42, 0x1f, 0xc6, 0xf9, 0xab, 0xb9, 0x18, 0x71, 0x01, 0x25, 0xa6, 0xe6, 0x33,
-	0xf6, 0x99, 0xd6, 0x00, 0x93, 0xd0, 0xb7, 0x59, 0x34, 0x77, 0xa9, 0xe8, 0x5e, 0x4d, 0x82, 0x70,
-	0xba, 0x2e, 0xba, 0x0d, 0x53, 0xcc, 0xbe, 0x22, 0x8e, 0x64, 0x35, 0x14, 0x07, 0x4b, 0x58, 0x4b,
-	0x40, 0x70, 0xaa, 0xa6, 0xfe, 0xa9, 0x0a, 0x4c, 0xa8, 0xcb, 0xee, 0x04, 0x5e, 0x47, 0x5d, 0xe5,
-	0x30, 0xec, 0xc3, 0x23, 0x46, 0xa5, 0x7a, 0x82, 0xf3, 0x10, 0xbd, 0x02, 0x53, 0x5d, 0xc6, 0x41,
-	0xa2, 0x68, 0x1c, 0x62, 0xfd, 0x7f, 0x13, 0x1d, 0xe5, 0x66, 0x02, 0xf2, 0xf0, 0xb0, 0x3a, 0xa7,
-	0xa2, 0x4f, 0x42, 0x71, 0x0a, 0x8f, 0xfe, 0xd9, 0x01, 0xb8, 0x9c, 0xd3, 0x1b, 0xf6, 0x0e, 0x4f,
-	0x52, 0x47, 0x76, 0x3f, 0xef, 0xf0, 0x99, 0xe3, 0x5f, 0xbe, 0xc3, 0xa7, 0x21, 0x38, 0x43, 0x17,
-	0xbd, 0x04, 0x03, 0xa6, 0x6f, 0x8b, 0x09, 0xff, 0x70, 0xa9, 0x0b, 0x27, 0x6e, 0x2c, 0x8e, 0x0b,
-	0x8a, 0x03, 0x35, 0xdc, 0xc0, 0x14, 0x21, 0x3d, 0x78, 0x54, 0x76, 0x11, 0x49, 0x01, 0xec, 0xe0,
-	0x51, 0xb9, 0x4a, 0x80, 0x93, 0xf5, 0xd0, 0x2b, 0x30, 0x2b, 0x6e, 0x02, 0x91, 0x07, 0xb3, 0xe7,
-	0x06, 0x21, 0xdd, 0xd9, 0xa1, 0x60, 0xd4, 0x8f, 0x1d, 0x1d, 0x56, 0x67, 0xef, 0x15, 0xd4, 0xc1,
-	0x85, 0xad, 0xf5, 0x3f, 0x1f, 0x80, 0x71, 0x25, 0xfb, 0x00, 0x5a, 0xed, 0x47, 0xcb, 0x11, 0x8f,
-	0x38, 0xd2, 0x74, 0xac, 0xc2, 0x40, 0xab, 0xd3, 0x2d, 0xa9, 0xe6, 0x90, 0xe8, 0xee, 0x50, 0x74,
-	0xad, 0x4e, 0x17, 0xbd, 0x24, 0x15, 0x27, 0xe5, 0x54, 0x1b, 0xd2, 0xdf, 0x24, 0xa5, 0x3c, 0x89,
-	0x36, 0xe2, 0x60, 0xe1, 0x46, 0x6c, 0xc3, 0x48, 0x20, 0xb4, 0x2a, 0x43, 0xe5, 0x83, 0xce, 0x28,
-	0x33, 0x2d, 0xb4, 0x28, 0xfc, 0xbe, 0x17, 0x29, 0x59, 0x22, 0x1a, 0x54, 0x96, 0xec, 0x32, 0x2f,
-	0x56, 0x76, 0x91, 0x1d, 0xe5, 0xb2, 0xe4, 0x26, 0x2b, 0xc1, 0x02, 0x92, 0x39, 0xa2, 0x46, 0x4e,
-	0x74, 0x44, 0xfd, 0xbd, 0x0a, 0xa0, 0x6c, 0x37, 0xd0, 0x13, 0x30, 0xc4, 0xbc, 0xe0, 0x05, 0x2f,
-	0x92, 0x92, 0x3f, 0xf3, 0x83, 0xc6, 0x1c, 0x86, 0x9a, 0x22, 0x84, 0x46, 0xb9, 0xcf, 0xc9, 0x0c,
-	0x59, 0x04, 0x3d, 0x25, 0xde, 0xc6, 0xcd, 0x84, 0xcb, 0x44, 0xde, 0x99, 0xbf, 0x09, 0x23, 0x6d,
-	0xdb, 0x65, 0x6f, 0x7b, 0xe5, 0x94, 0x4d, 0xfc, 0xbd, 0x9d, 0xa3, 0xc0, 0x11, 0x2e, 0xfd, 0x8f,
-	0x2a, 0x74, 0xe9, 0xc7, 0x12, 0xef, 0x01, 0x80, 0xd1, 0x0d, 0x3d, 0xce, 0xc0, 0xc4, 0x0e, 0x68,
-	0x94, 0xfb, 0xca, 0x12, 0xe9, 0x82, 0x44, 0xc8, 0x5f, 0xa5, 0xe2, 0xdf, 0x58, 0x21, 0x46, 0x49,
-	0x87, 0x76, 0x9b, 0xbc, 0x6c, 0xbb, 0x96, 0xf7, 0x40, 0x4c, 0x6f, 0xbf, 0xa4, 0x37, 0x24, 0x42,
-	0x4e, 0x3a, 0xfe, 0x8d, 0x15, 0x62, 0x94, 0xb5, 0xb0, 0x8b, 0xb3, 0xcb, 0xd2, 0xc1, 0x88, 0xbe,
-	0x79, 0x8e, 0x13, 0x9d, 0xca, 0xa3, 0x9c, 0xb5, 0xd4, 0x0a, 0xea, 0xe0, 0xc2, 0xd6, 0xfa, 0xcf,
-	0x6b, 0x70, 0x35, 0x77, 0x2a, 0xd0, 0x1d, 0x98, 0x89, 0x6d, 0x9f, 0x54, 0x66, 0x3f, 0x1a, 0xa7,
-	0x21, 0xba, 0x97, 0xae, 0x80, 0xb3, 0x6d, 0x78, 0xae, 0xeb, 0xcc, 0x61, 0x22, 0x0c, 0xa7, 0x54,
-	0xd1, 0x48, 0x05, 0xe3, 0xbc, 0x36, 0xfa, 0x77, 0x24, 0x3a, 0x1b, 0x4f, 0x16, 0xdd, 0x19, 0x5b,
-	0xa4, 0x25, 0x5d, 0xd6, 0xe4, 0xce, 0x58, 0xa4, 0x85, 0x98, 0xc3, 0xd0, 0xe3, 0xaa, 0x23, 0xa8,
-	0xe4, 0x5b, 0x91, 0x33, 0xa8, 0xfe, 0x5d, 0xf0, 0x48, 0xc1, 0x63, 0x25, 0xaa, 0xc3, 0x44, 0xf0,
-	0xc0, 0xe8, 0x2c, 0x92, 0x1d, 0x63, 0xcf, 0x16, 0x81, 0x05, 0xb8, 0x4d, 0xdb, 0x44, 0x53, 0x29,
-	0x7f, 0x98, 0xfa, 0x8d, 0x13, 0xad, 0xf4, 0x10, 0x40, 0xd8, 0x3e, 0xda, 0x6e, 0x0b, 0x6d, 0xc3,
-	0xa8, 0x21, 0x52, 0x2d, 0x8b, 0x75, 0xfc, 0xad, 0xa5, 0x94, 0x00, 0x02, 0x07, 0xb7, 0x0e, 0x8f,
-	0x7e, 0x61, 0x89, 0x5b, 0xff, 0x27, 0x1a, 0x5c, 0xcb, 0x77, 0x25, 0x3f, 0x81, 0x68, 0xd3, 0x86,
-	0x71, 0x3f, 0x6e, 0x26, 0x16, 0xfd, 0xb7, 0xa8, 0xc1, 0x48, 0x95, 0xe8, 0x5b, 0x54, 0xec, 0xab,
-	0xf9, 0x5e, 0x10, 0x7d, 0xf9, 0x74, 0x7c, 0x52, 0x79, 0xe5, 0x52, 0x7a, 0x82, 0x55, 0xfc, 0xfa,
-	0xaf, 0x56, 0x00, 0xd6, 0x48, 0xf8, 0xc0, 0xf3, 0x77, 0xe9, 0x14, 0x3d, 0x96, 0xb8, 0x69, 0x8c,
-	0x7e, 0xed, 0xc2, 0x19, 0x3c, 0x06, 0x83, 0x1d, 0xcf, 0x0a, 0x04, 0xfb, 0x63, 0x1d, 0x61, 0x66,
-	0x41, 0xac, 0x14, 0x55, 0x61, 0x88, 0xbd, 0x4d, 0x88, 0x93, 0x89, 0xdd, 0x53, 0xa8, 0x94, 0x19,
-	0x60, 0x5e, 0xce, 0x13, 0xe8, 0x31, 0x8f, 0x8b, 0x40, 0x5c, 0xbc, 0x44, 0x02, 0x3d, 0x5e, 0x86,
-	0x25, 0x14, 0xdd, 0x06, 0xb0, 0x3b, 0xcb, 0x46, 0xdb, 0x76, 0xa8, 0xcc, 0x3b, 0x2c, 0xf3, 0x35,
-	0x43, 0x63, 0x3d, 0x2a, 0x7d, 0x78, 0x58, 0x1d, 0x15, 0xbf, 0x0e, 0xb0, 0x52, 0x5b, 0xff, 0xab,
-	0x01, 0x48, 0xe4, 0x36, 0x8f, 0x75, 0x4c, 0xda, 0xf9, 0xe8, 0x98, 0x5e, 0x81, 0x59, 0xc7, 0x33,
-	0xac, 0x45, 0xc3, 0xa1, 0xbb, 0xd1, 0x6f, 0xf2, 0xcf, 0x68, 0xb8, 0x2d, 0x99, 0xc0, 0x9a, 0x71,
-	0xa5, 0x95, 0x82, 0x3a, 0xb8, 0xb0, 0x35, 0x0a, 0x65, 0x46, 0xf5, 0x81, 0xf2, 0xce, 0x89, 0xea,
-	0x5c, 0xcc, 0xab, 0x7e, 0x3a, 0x52, 0xc0, 0x48, 0x25, 0x5d, 0xff, 0xb4, 0x06, 0x57, 0xc9, 0x3e,
-	0xf7, 0x53, 0xdb, 0xf0, 0x8d, 0xed, 0x6d, 0xdb, 0x14, 0xc6, 0x9a, 0xfc, 0xc3, 0xae, 0x1c, 0x1d,
-	0x56, 0xaf, 0x2e, 0xe5, 0x55, 0x78, 0x78, 0x58, 0xbd, 0x95, 0xeb, 0x36, 0xc8, 0x3e, 0x6b, 0x6e,
-	0x13, 0x9c, 0x4f, 0x6a, 0xee, 0x79, 0x18, 0x3f, 0x85, 0x89, 0x7f, 0xc2, 0x39, 0xf0, 0xd7, 0x2a,
-	0x30, 0x41, 0xd7, 0xdd, 0x8a, 0x67, 0x1a, 0x4e, 0x7d, 0xad, 0x89, 0x9e, 0x4e, 0xbb, 0xf4, 0x4b,
-	0x85, 0x74, 0xc6, 0xad, 0x7f, 0x05, 0xae, 0x6c, 0x7b, 0xbe, 0x49, 0x36, 0x6a, 0xeb, 0x1b, 0x9e,
-	0x78, 0x72, 0xa9, 0xaf, 0x35, 0x05, 0x97, 0x66, 0x97, 0xc8, 0xe5, 0x1c, 0x38, 0xce, 0x6d, 0x85,
-	0xee, 0xc3, 0xd5, 0xb8, 0x7c, 0xb3, 0xc3, 0x6d, 0x4d, 0x28, 0xba, 0x81, 0xd8, 0x56, 0x66, 0x39,
-	0xaf, 0x02, 0xce, 0x6f, 0x87, 0x0c, 0xb8, 0x2e, 0x22, 0x86, 0x2c, 0x7b, 0xfe, 0x03, 0xc3, 0xb7,
-	0x92, 0x68, 0x07, 0x63, 0x95, 0x74, 0xbd, 0xb8, 0x1a, 0xee, 0x85, 0x43, 0xff, 0xa9, 0x61, 0x50,
-	0x9c, 0xc9, 0x4e, 0x91, 0x72, 0xed, 0x67, 0x35, 0xb8, 0x62, 0x3a, 0x36, 0x71, 0xc3, 0x94, 0xe7,
-	0x10, 0x67, 0x47, 0x9b, 0xa5, 0xbc, 0xdc, 0x3a, 0xc4, 0x6d, 0xd4, 0x85, 0x69, 0x4e, 0x2d, 0x07,
-	0xb9, 0x30, 0x5f, 0xca, 0x81, 0xe0, 0xdc, 0xce, 0xb0, 0xf1, 0xb0, 0xf2, 0x46, 0x5d, 0x0d, 0x75,
-	0x50, 0x13, 0x65, 0x58, 0x42, 0xd1, 0xb3, 0x30, 0xde, 0xf2, 0xbd, 0x6e, 0x27, 0xa8, 0x31, 0x0b,
-	0x5c, 0xbe, 0xf6, 0x99, 0x5c, 0x78, 0x27, 0x2e, 0xc6, 0x6a, 0x1d, 0x2a, 0xe5, 0xf2, 0x9f, 0xeb,
-	0x3e, 0xd9, 0xb6, 0xf7, 0x05, 0x93, 0x63, 0x52, 0xee, 0x1d, 0xa5, 0x1c, 0x27, 0x6a, 0x31, 0x6f,
-	0xe5, 0x20, 0xe8, 0x12, 0x7f, 0x13, 0xaf, 0x88, 0x34, 0x0d, 0xdc, 0x5b, 0x39, 0x2a, 0xc4, 0x31,
-	0x1c, 0xfd, 0xb8, 0x06, 0x53, 0x3e, 0x79, 0xa3, 0x6b, 0xfb, 0xc4, 0x62, 0x44, 0x03, 0xe1, 0xd1,
-	0x87, 0xfb, 0xf3, 0x22, 0x9c, 0xc7, 0x09, 0xa4, 0x9c, 0x43, 0x48, 0xb5, 0x5d, 0x12, 0x88, 0x53,
-	0x3d, 0xa0, 0x53, 0x15, 0xd8, 0x2d, 0xd7, 0x76, 0x5b, 0x0b, 0x4e, 0x2b, 0x98, 0x1d, 0x65, 0x4c,
-	0x8f, 0x8b, 0xd0, 0x71, 0x31, 0x56, 0xeb, 0xd0, 0xeb, 0x65, 0x37, 0xa0, 0xfb, 0xbe, 0x4d, 0xf8,
-	0xfc, 0x8e, 0xc5, 0x7a, 0xcd, 0x4d, 0x15, 0x80, 0x93, 0xf5, 0xd0, 0x6d, 0x98, 0x8a, 0x0a, 0xc4,
-	0x2c, 0x03, 0x0f, 0x92, 0xc7, 0xae, 0xfb, 0x09, 0x08, 0x4e, 0xd5, 0x9c, 0x5b, 0x80, 0xcb, 0x39,
-	0xc3, 0x3c, 0x15, 0x73, 0xf9, 0x6b, 0x0d, 0xae, 0xf2, 0x7c, 0xb1, 0x51, 0x82, 0x87, 0x28, 0x1a,
-	0x5e, 0x7e, 0x60, 0x39, 0xed, 0x5c, 0x03, 0xcb, 0x7d, 0x0d, 0x02, 0xe8, 0xe9, 0xff, 0xa8, 0x02,
-	0xef, 0x3d, 0x76, 0x5f, 0xa2, 0x7f, 0xa8, 0xc1, 0x38, 0xd9, 0x0f, 0x7d, 0x43, 0xba, 0x29, 0xd0,
-	0x45, 0xba, 0x7d, 0x2e, 0x4c, 0x60, 0x7e, 0x29, 0x26, 0xc4, 0x17, 0xae, 0x14, 0xb1, 0x14, 0x08,
-	0x56, 0xfb, 0x43, 0x2f, 0xad, 0x3c, 0x88, 0xa4, 0xfa, 0x00, 0x22, 0xd2, 0x78, 0x0b, 0xc8, 0xdc,
-	0xc7, 0x60, 0x3a, 0x8d, 0xf9, 0x54, 0x6b, 0xe5, 0x57, 0x2a, 0x30, 0xb2, 0xee, 0x7b, 0x54, 0xfa,
-	0xbb, 0x80, 0xa0, 0x07, 0x46, 0x22, 0xb0, 0x7a, 0x29, 0x3f, 0x66, 0xd1, 0xd9, 0xc2, 0xa4, 0x0e,
-	0x76, 0x2a, 0xa9, 0xc3, 0x42, 0x3f, 0x44, 0x7a, 0x67, 0x71, 0xf8, 0x5d, 0x0d, 0xc6, 0x45, 0xcd,
-	0x0b, 0x70, 0xed, 0xff, 0xee, 0xa4, 0x6b, 0xff, 0x47, 0xfb, 0x18, 0x57, 0x81, 0x4f, 0xff, 0xe7,
-	0x35, 0x98, 0x14, 0x35, 0x56, 0x49, 0x7b, 0x8b, 0xf8, 0x68, 0x19, 0x46, 0x82, 0x2e, 0xfb, 0x90,
-	0x62, 0x40, 0xd7, 0xd5, 0xfb, 0x84, 0xbf, 0x65, 0x98, 0x2c, 0x17, 0x3d, 0xaf, 0xa2, 0xa4, 0x4a,
-	0xe0, 0x05, 0x38, 0x6a, 0x4c, 0x6f, 0x2f, 0xbe, 0xe7, 0x64, 0x82, 0x3d, 0x61, 0xcf, 0x21, 0x98,
-	0x41, 0xa8, 0x60, 0x4e, 0xff, 0x46, 0x2a, 0x3c, 0x26, 0x98, 0x53, 0x70, 0x80, 0x79, 0xb9, 0xfe,
-	0x03, 0x83, 0x72, 0xb2, 0x59, 0x38, 0xf3, 0xbb, 0x30, 0x66, 0xfa, 0xc4, 0x08, 0x89, 0xb5, 0x78,
-	0x70, 0x92, 0xce, 0xb1, 0xe3, 0xaa, 0x16, 0xb5, 0xc0, 0x71, 0x63, 0x7a, 0x32, 0xa8, 0x6f, 0x4e,
-	0x95, 0xf8, 0x10, 0x2d, 0x7c, 0x6f, 0xfa, 0x56, 0x18, 0xf2, 0x1e, 0xb8, 0xd2, 0x74, 0xa5, 0x27,
-	0x61, 0x36, 0x94, 0xfb, 0xb4, 0x36, 0xe6, 0x8d, 0xd4, 0x60, 0x67, 0x83, 0x3d, 0x82, 0x9d, 0x39,
-	0x30, 0xd2, 0x66, 0x9f, 0xa1, 0xaf, 0xc8, 0xf9, 0x89, 0x0f, 0xaa, 0xe6, 0x56, 0x62, 0x98, 0x71,
-	0x44, 0x82, 0x9e, 0xf0, 0xf4, 0x14, 0x0a, 0x3a, 0x86, 0x49, 0xd4, 0x13, 0x7e, 0x2d, 0x2a, 0xc4,
-	0x31, 0x1c, 0x1d, 0x24, 0xa3, 0xe8, 0x8d, 0x94, 0xd7, 0xe0, 0x89, 0xee, 0x29, 0x81, 0xf3, 0xf8,
-	0xd4, 0x17, 0x46, 0xd2, 0xfb, 0xe1, 0x41, 0xb9, 0x48, 0x45, 0x22, 0x8c, 0xfc, 0xfc, 0xe9, 0x5a,
-	0xa9, 0xfc, 0xe9, 0xdf, 0x1c, 0x85, 0x8b, 0xad, 0x24, 0xf2, 0x80, 0xc9, 0x70, 0xb1, 0x13, 0x82,
-	0x74, 0x22, 0x44, 0x6c, 0x17, 0x2e, 0x07, 0xa1, 0xe1, 0x90, 0xa6, 0x2d, 0x34, 0x1d, 0x41, 0x68,
-	0xb4, 0x3b, 0x25, 0xe2, 0xb5, 0x72, 0x17, 0x83, 0x2c, 0x2a, 0x9c, 0x87, 0x1f, 0x7d, 0xbf, 0x06,
-	0xb3, 0xac, 0x7c, 0xa1, 0x1b, 0x7a, 0x3c, 0xb0, 0x78, 0x4c, 0xfc, 0xf4, 0x0f, 0xdb, 0xec, 0x02,
-	0xd8, 0x2c, 0xc0, 0x87, 0x0b, 0x29, 0xa1, 0xb7, 0xe0, 0x2a, 0x3d, 0x81, 0x17, 0xcc, 0xd0, 0xde,
-	0xb3, 0xc3, 0x83, 0xb8, 0x0b, 0xa7, 0x0f, 0xd2, 0xca, 0x2e, 0x1b, 0x2b, 0x79, 0xc8, 0x70, 0x3e,
-	0x0d, 0xfd, 0x2f, 0x34, 0x40, 0xd9, 0x25, 0x84, 0x1c, 0x18, 0xb5, 0x22, 0x9b, 0x7f, 0xed, 0x4c,
-	0x42, 0x3c, 0x4a, 0xce, 0x2c, 0x5d, 0x05, 0x24, 0x05, 0xe4, 0xc1, 0xd8, 0x83, 0x1d, 0x3b, 0x24,
-	0x8e, 0x1d, 0x84, 0x67, 0x14, 0x51, 0x52, 0x86, 0x57, 0x7b, 0x39, 0x42, 0x8c, 0x63, 0x1a, 0xfa,
-	0x8f, 0x0c, 0xc2, 0xa8, 0x8c, 0x90, 0x7d, 0xfc, 0x1b, 0x6f, 0x17, 0x90, 0xa9, 0x64, 0x19, 0xeb,
-	0x47, 0x03, 0xc3, 0x84, 0xb0, 0x5a, 0x06, 0x19, 0xce, 0x21, 0x80, 0xde, 0x82, 0x2b, 0xb6, 0xbb,
-	0xed, 0x1b, 0x41, 0xe8, 0x77, 0x99, 0xae, 0xbc, 0x9f, 0x64, 0x5d, 0xec, 0x0e, 0xd5, 0xc8, 0x41,
-	0x87, 0x73, 0x89, 0x20, 0x02, 0x23, 0x3c, 0x11, 0x40, 0x14, 0xec, 0xaf, 0x54, 0xee, 0x65, 0x9e,
-	0x60, 0x20, 0xe6, 0x9a, 0xfc, 0x77, 0x80, 0x23, 0xdc, 0x3c, 0x10, 0x07, 0xff, 0x3f, 0x7a, 0x8f,
-	0x16, 0xeb, 0xbe, 0x56, 0x9e, 0x5e, 0x9c, 0xc6, 0x9b, 0x07, 0xe2, 0x48, 0x16, 0xe2, 0x34, 0x41,
-	0xfd, 0xb7, 0x35, 0x18, 0xe2, 0xde, 0xab, 0xe7, 0x2f, 0xc1, 0x7d, 0x57, 0x42, 0x82, 0x2b, 0x95,
-	0x6f, 0x88, 0x75, 0xb5, 0x30, 0x13, 0xce, 0x97, 0x34, 0x18, 0x63, 0x35, 0x2e, 0x40, 0xa4, 0x7a,
-	0x2d, 0x29, 0x52, 0x3d, 0x5f, 0x7a, 0x34, 0x05, 0x02, 0xd5, 0x6f, 0x0f, 0x88, 0xb1, 0x30, 0x89,
-	0xa5, 0x01, 0x97, 0x85, 0x35, 0xec, 0x8a, 0xbd, 0x4d, 0xe8, 0x12, 0xaf, 0x1b, 0x07, 0xfc, 0x81,
-	0x68, 0x48, 0xb8, 0x4b, 0x65, 0xc1, 0x38, 0xaf, 0x0d, 0xfa, 0x35, 0x8d, 0xca, 0x06, 0xa1, 0x6f,
-	0x9b, 0x7d, 0xa5, 0x97, 0x91, 0x7d, 0x9b, 0x5f, 0xe5, 0xc8, 0xf8, 0xcd, 0x64, 0x33, 0x16, 0x12,
-	0x58, 0xe9, 0xc3, 0xc3, 0x6a, 0x35, 0x47, 0x65, 0x16, 0xa7, 0x9a, 0x08, 0xc2, 0x4f, 0xff, 0x71,
-	0xcf, 0x2a, 0x4c, 0x4d, 0x1d, 0xf5, 0x18, 0xdd, 0x85, 0xa1, 0xc0, 0xf4, 0x3a, 0xe4, 0x34, 0x09,
-	0xb3, 0xe4, 0x04, 0x37, 0x69, 0x4b, 0xcc, 0x11, 0xcc, 0xbd, 0x0e, 0x13, 0x6a, 0xcf, 0x73, 0x6e,
-	0x3e, 0x75, 0xf5, 0xe6, 0x73, 0xea, 0x97, 0x2e, 0xf5, 0xa6, 0xf4, 0xeb, 0x15, 0x18, 0xe6, 0xb9,
-	0xd7, 0x4f, 0xa0, 0x8c, 0xb7, 0xa3, 0x98, 0xfe, 0x95, 0xf2, 0x16, 0x77, 0x6a, 0xfc, 0xca, 0x57,
-	0x3d, 0x57, 0x99, 0x03, 0x35, 0xac, 0x3f, 0x72, 0x65, 0x54, 0xd3, 0x81, 0xf2, 0x49, 0x7d, 0xf8,
-	0xc0, 0xce, 0x3b, 0x8e, 0xe9, 0xef, 0x69, 0x30, 0x91, 0x08, 0x13, 0xdb, 0x86, 0x01, 0x5f, 0xa6,
-	0x7b, 0x2b, 0xfb, 0x56, 0x11, 0xd9, 0x54, 0x5d, 0xef, 0x51, 0x09, 0x53, 0x3a, 0x32, 0xa2, 0x6c,
-	0xe5, 0x8c, 0x22, 0xca, 0xea, 0x9f, 0xd3, 0xe0, 0x5a, 0x34, 0xa0, 0x64, 0xbc, 0x24, 0xf4, 0x14,
-	0x8c, 0x1a, 0x1d, 0x9b, 0xa9, 0xd4, 0x54, 0xa5, 0xe4, 0xc2, 0x7a, 0x83, 0x95, 0x61, 0x09, 0x45,
-	0x1f, 0x80, 0xd1, 0x68, 0xe1, 0x09, 0xb1, 0x53, 0xf2, 0x2c, 0xf9, 0xfa, 0x22, 0x6b, 0xa0, 0xf7,
-	0x29, 0x69, 0x17, 0x86, 0x62, 0x39, 0x41, 0x12, 0xe6, 0xaf, 0xc0, 0xfa, 0xb7, 0xc0, 0x58, 0xb3,
-	0x79, 0x77, 0xc1, 0x34, 0x49, 0x10, 0x9c, 0x42, 0xb9, 0xac, 0x7f, 0x66, 0x00, 0x26, 0x45, 0xe0,
-	0x37, 0xdb, 0xb5, 0x6c, 0xb7, 0x75, 0x01, 0x67, 0xca, 0x06, 0x8c, 0x71, 0x6d, 0xc6, 0x31, 0xa9,
-	0xf9, 0x9a, 0x51, 0xa5, 0x74, 0x78, 0x65, 0x09, 0xc0, 0x31, 0x22, 0x74, 0x0f, 0x86, 0xdf, 0xa0,
-	0xfc, 0x2d, 0xda, 0x17, 0x27, 0x62, 0x33, 0x72, 0xd1, 0x33, 0xd6, 0x18, 0x60, 0x81, 0x02, 0x05,
-	0xcc, 0xe8, 0x8f, 0x09, 0x5c, 0xfd, 0x04, 0x74, 0x48, 0xcc, 0xac, 0x4c, 0xba, 0x32, 0x21, 0x6c,
-	0x07, 0xd9, 0x2f, 0x2c, 0x09, 0xb1, 0xd8, 0xf0, 0x89, 0x16, 0xef, 0x92, 0xd8, 0xf0, 0x89, 0x3e,
-	0x17, 0x1c, 0x8d, 0xcf, 0xc3, 0xd5, 0xdc, 0xc9, 0x38, 0x5e, 0x9c, 0xd5, 0x7f, 0xb1, 0x02, 0x83,
-	0x4d, 0x42, 0xac, 0x0b, 0x58, 0x99, 0xaf, 0x25, 0xa4, 0x9d, 0x6f, 0x2d, 0x1d, 0x9d, 0xbe, 0x48,
-	0x59, 0xb5, 0x9d, 0x52, 0x56, 0x7d, 0xac, 0x34, 0x85, 0xde, 0x9a, 0xaa, 0x9f, 0xae, 0x00, 0xd0,
-	0x6a, 0x8b, 0x86, 0xb9, 0xcb, 0x39, 0x8e, 0x5c, 0xcd, 0x5a, 0x92, 0xe3, 0x64, 0x97, 0xe1, 0x45,
-	0x3e, 0xde, 0xea, 0x30, 0xec, 0xb3, 0x93, 0x48, 0xbc, 0x7b, 0x00, 0xcf, 0x17, 0x4d, 0x4b, 0xb0,
-	0x80, 0x24, 0xb9, 0xc5, 0xe0, 0x19, 0x71, 0x0b, 0x7d, 0x1f, 0x58, 0x82, 0xcf, 0xfa, 0x5a, 0x13,
-	0xb5, 0x95, 0xd9, 0xa9, 0x94, 0x97, 0xe5, 0x05, 0xba, 0x63, 0x77, 0xf9, 0x67, 0x34, 0xb8, 0x94,
-	0xaa, 0x7b, 0x82, 0x3b, 0xdd, 0xb9, 0xf0, 0x4c, 0xfd, 0xb7, 0x34, 0x18, 0xa5, 0x7d, 0xb9, 0x00,
-	0x46, 0xf3, 0xff, 0x27, 0x19, 0xcd, 0x47, 0xca, 0x4e, 0x71, 0x01, 0x7f, 0xf9, 0xb3, 0x0a, 0xb0,
-	0x34, 0x10, 0xc2, 0x44, 0x41, 0x79, 0xf9, 0xd7, 0x0a, 0x5e, 0xfe, 0x6f, 0x0a, 0xc3, 0x81, 0x94,
-	0x8e, 0x52, 0x31, 0x1e, 0xf8, 0x80, 0x62, 0x1b, 0x30, 0x90, 0xdc, 0x36, 0x39, 0xf6, 0x01, 0x6f,
-	0xc2, 0x64, 0xb0, 0xe3, 0x79, 0xa1, 0x0c, 0x3e, 0x30, 0x58, 0x5e, 0x1f, 0xcd, 0x2c, 0xac, 0xa3,
-	0xa1, 0xf0, 0x07, 0xa8, 0xa6, 0x8a, 0x1b, 0x27, 0x49, 0xa1, 0x79, 0x80, 0x2d, 0xc7, 0x33, 0x77,
-	0x6b, 0x8d, 0x3a, 0x8e, 0x2c, 0x6a, 0x99, 0xd1, 0xd2, 0xa2, 0x2c, 0xc5, 0x4a, 0x8d, 0xbe, 0x6c,
-	0x19, 0xfe, 0x44, 0xe3, 0x33, 0x7d, 0x8a, 0xc5, 0x7b, 0x81, 0x1c, 0xe5, 0xfd, 0x29, 0x8e, 0xa2,
-	0x64, 0xa1, 0x4f, 0x70, 0x95, 0x6a, 0x24, 0xb0, 0x0f, 0xc6, 0xfa, 0xe7, 0x44, 0xf6, 0xac, 0x5f,
-	0x11, 0xc3, 0x94, 0x99, 0x44, 0x3a, 0x30, 0xe9, 0xa8, 0x19, 0x51, 0xc5, 0x1e, 0x29, 0x95, 0x4c,
-	0x55, 0xba, 0x68, 0x24, 0x8a, 0x71, 0x92, 0x00, 0xfa, 0x30, 0x4c, 0x46, 0xa3, 0xa3, 0x93, 0x19,
-	0x59, 0x6e, 0xb0, 0xe5, 0xb0, 0xae, 0x02, 0x70, 0xb2, 0x9e, 0xfe, 0x4e, 0x05, 0x1e, 0xe7, 0x7d,
-	0x67, 0x1a, 0x83, 0x3a, 0xe9, 0x10, 0xd7, 0x22, 0xae, 0x79, 0xc0, 0x64, 0x56, 0xcb, 0x6b, 0xa1,
-	0xb7, 0x60, 0xf8, 0x01, 0x21, 0x96, 0xd4, 0x68, 0xbf, 0x5c, 0x3e, 0x11, 0x4b, 0x01, 0x89, 0x97,
-	0x19, 0x7a, 0xce, 0xd1, 0xf9, 0xff, 0x58, 0x90, 0xa4, 0xc4, 0x3b, 0xbe, 0xb7, 0x25, 0x45, 0xab,
-	0xb3, 0x27, 0xbe, 0xce, 0xd0, 0x73, 0xe2, 0xfc, 0x7f, 0x2c, 0x48, 0xea, 0xeb, 0xf0, 0xc4, 0x09,
-	0x9a, 0x9e, 0x46, 0x84, 0x3e, 0x0e, 0x23, 0x1f, 0xfd, 0x69, 0x30, 0x7e, 0x45, 0x83, 0x27, 0x15,
-	0x94, 0x4b, 0xfb, 0x54, 0xaa, 0xaf, 0x19, 0x1d, 0xc3, 0xa4, 0x77, 0x54, 0xe6, 0x50, 0x7d, 0xaa,
-	0xc4, 0x10, 0x9f, 0xd1, 0x60, 0x84, 0x1b, 0xd2, 0x44, 0xec, 0xf7, 0xb5, 0x3e, 0xa7, 0xbc, 0xb0,
-	0x4b, 0x51, 0xc4, 0xe1, 0x68, 0x6c, 0xfc, 0x77, 0x80, 0x23, 0xfa, 0xfa, 0xbf, 0x19, 0x82, 0x6f,
-	0x38, 0x39, 0x22, 0xf4, 0x27, 0x5a, 0x36, 0x8d, 0x6d, 0xfb, 0x7c, 0x3b, 0x2f, 0xb5, 0x18, 0xe2,
-	0x62, 0xfc, 0x72, 0x26, 0xab, 0xcb, 0x19, 0x29, 0x48, 0x94, 0x9c, 0xb9, 0xff, 0x54, 0x83, 0x09,
-	0x7a, 0x2c, 0x49, 0xe6, 0xc2, 0x3f, 0x53, 0xe7, 0x9c, 0x47, 0xba, 0xa6, 0x90, 0x4c, 0x79, 0x5e,
-	0xaa, 0x20, 0x9c, 0xe8, 0x1b, 0xda, 0x4c, 0xbe, 0x06, 0xf1, 0xeb, 0xd6, 0x8d, 0x3c, 0x69, 0xe4,
-	0x34, 0x39, 0x93, 0xe6, 0x1c, 0x98, 0x4a, 0xce, 0xfc, 0x79, 0xaa, 0x77, 0xe6, 0x5e, 0x84, 0x99,
-	0xcc, 0xe8, 0x4f, 0xa5, 0xdc, 0xf8, 0xbb, 0x83, 0x50, 0x55, 0xa6, 0x3a, 0x61, 0x4a, 0x17, 0xc9,
-	0x04, 0x3f, 0xa9, 0xc1, 0xb8, 0xe1, 0xba, 0xc2, 0x1c, 0x23, 0x5a, 0xbf, 0x56, 0x9f, 0x5f, 0x35,
-	0x8f, 0xd4, 0xfc, 0x42, 0x4c, 0x26, 0x65, 0x6f, 0xa0, 0x40, 0xb0, 0xda, 0x9b, 0x1e, 0x46, 0x75,
-	0x95, 0x0b, 0x33, 0xaa, 0x43, 0xdf, 0x13, 0x1d, 0xc4, 0x7c, 0x19, 0xbd, 0x72, 0x0e, 0x73, 0xc3,
-	0xce, 0xf5, 0x7c, 0x6d, 0xda, 0xdc, 0xc7, 0x60, 0x3a, 0x3d, 0x73, 0xa7, 0x5a, 0x05, 0xbf, 0x38,
-	0x90, 0x60, 0xd5, 0x85, 0xe4, 0x4f, 0xa0, 0x43, 0xfc, 0x42, 0x6a, 0xb1, 0x70, 0x16, 0x60, 0x9f,
-	0xd7, 0x84, 0x9c, 0xed, 0x8a, 0x19, 0xb8, 0x38, 0x33, 0xcc, 0x7e, 0x3f, 0xd9, 0x22, 0x5c, 0x55,
-	0xe6, 0x47, 0xc9, 0x51, 0xf7, 0x34, 0x8c, 0xec, 0xd9, 0x81, 0x1d, 0x85, 0xba, 0x51, 0x4e, 0xe8,
-	0x97, 0x78, 0x31, 0x8e, 0xe0, 0xfa, 0x4a, 0x62, 0xef, 0x6f, 0x78, 0x1d, 0xcf, 0xf1, 0x5a, 0x07,
-	0x0b, 0x0f, 0x0c, 0x9f, 0x60, 0xaf, 0x1b, 0x0a, 0x6c, 0x27, 0x3d, 0xef, 0x57, 0xe1, 0xa6, 0x82,
-	0x2d, 0x37, 0x20, 0xc0, 0x69, 0xd0, 0xfd, 0xee, 0x48, 0x24, 0xba, 0x0a, 0x8f, 0xc9, 0x5f, 0xd6,
-	0xe0, 0x51, 0x52, 0x74, 0x14, 0x08, 0x39, 0xf6, 0x95, 0xf3, 0x3a, 0x6a, 0x44, 0xf0, 0xd1, 0x22,
-	0x30, 0x2e, 0xee, 0x19, 0x3a, 0x48, 0x64, 0x6a, 0xac, 0xf4, 0xa3, 0x87, 0xcb, 0xf9, 0xde, 0xbd,
-	0xf2, 0x34, 0xa2, 0x9f, 0xd1, 0xe0, 0x8a, 0x93, 0xb3, 0x75, 0x84, 0xc8, 0xda, 0x3c, 0x87, 0x5d,
-	0xc9, 0xdf, 0x3c, 0xf3, 0x20, 0x38, 0xb7, 0x2b, 0xe8, 0xe7, 0x0a, 0x23, 0x55, 0x0c, 0x95, 0xcf,
-	0xb9, 0x7f, 0xdc, 0x42, 0x2c, 0x11, 0xb4, 0xe2, 0x1d, 0x0d, 0x90, 0x95, 0x11, 0x8b, 0x85, 0x15,
-	0xc9, 0x27, 0xce, 0x5c, 0xf8, 0xe7, 0x8f, 0xd6, 0xd9, 0x72, 0x9c, 0xd3, 0x09, 0xf6, 0x9d, 0xc3,
-	0x9c, 0xed, 0x2b, 0xe2, 0xb2, 0xf6, 0xfb, 0x9d, 0xf3, 0x38, 0x03, 0xff, 0xce, 0x79, 0x10, 0x9c,
-	0xdb, 0x15, 0xfd, 0x37, 0x87, 0xb9, 0x96, 0x86, 0xbd, 0x2a, 0x6e, 0xc1, 0xf0, 0x16, 0xd3, 0xea,
-	0x89, 0x7d, 0x5b, 0x5a, 0x85, 0xc8, 0x75, 0x83, 0xfc, 0x8e, 0xc4, 0xff, 0xc7, 0x02, 0x33, 0x7a,
-	0x15, 0x06, 0x2c, 0x37, 0x10, 0x1b, 0xee, 0xa3, 0x7d, 0x28, 0xc3, 0x62, 0x57, 0x9e, 0xfa, 0x5a,
-	0x13, 0x53, 0xa4, 0xc8, 0x85, 0x51, 0x57, 0x28, 0x36, 0xc4, 0xdd, 0xb3, 0x74, 0x12, 0x50, 0xa9,
-	0x20, 0x91, 0x6a, 0x99, 0xa8, 0x04, 0x4b, 0x1a, 0x94, 0x5e, 0x4a, 0x93, 0x5f, 0x9a, 0x9e, 0x54,
-	0xed, 0xf5, 0xd2, 0x9e, 0x12, 0x18, 0x0e, 0x0d, 0xdb, 0x0d, 0xb9, 0x5a, 0xa5, 0xe4, 0x93, 0x39,
-	0xa5, 0xb6, 0x41, 0xb1, 0xc4, 0xfa, 0x0b, 0xf6, 0x33, 0xc0, 0x02, 0x39, 0x5d, 0x06, 0x7b, 0x2c,
-	0xf3, 0xb6, 0xd8, 0x46, 0xa5, 0x97, 0x01, 0xcf, 0xdf, 0xcd, 0x97, 0x01, 0xff, 0x1f, 0x0b, 0xcc,
-	0xe8, 0x75, 0x18, 0x0d, 0x22, 0x23, 0x87, 0xd1, 0x7e, 0xf3, 0xb5, 0x0a, 0x0b, 0x07, 0xe1, 0x5d,
-	0x23, 0x4c, 0x1b, 0x24, 0x7e, 0xb4, 0x05, 0x23, 0x36, 0xf7, 0x07, 0x11, 0x61, 0x76, 0x3e, 0xda,
-	0x47, 0xba, 0xb2, 0x28, 0x25, 0x3d, 0x0f, 0xce, 0x10, 0x21, 0xd6, 0x7f, 0x17, 0xb8, 0x56, 0x5c,
-	0xd8, 0x91, 0x6d, 0xc3, 0x68, 0x84, 0xae, 0x1f, 0x2f, 0xaf, 0x28, 0x41, 0x24, 0x1f, 0x9a, 0x4c,
-	0x17, 0x29, 0x71, 0xa3, 0x5a, 0x9e, 0xb7, 0x5e, 0x1c, 0xad, 0xfe, 0x64, 0x9e, 0x7a, 0x6f, 0xb0,
-	0x8c, 0x6e, 0x91, 0xcf, 0xfc, 0x40, 0xf9, 0xa5, 0x25, 0xfd, 0xe9, 0x13, 0x99, 0xdc, 0x22, 0x97,
-	0x7b, 0x85, 0x48, 0x81, 0x9d, 0xdd, 0x60, 0x29, 0x3b, 0xbb, 0x17, 0xe0, 0x92, 0xb0, 0x6b, 0x68,
-	0xb0, 0xec, 0xeb, 0xe1, 0x81, 0x70, 0x44, 0x60, 0x16, 0x2f, 0xb5, 0x24, 0x08, 0xa7, 0xeb, 0xa2,
-	0x5f, 0xd7, 0x60, 0xd4, 0x14, 0x02, 0x82, 0xd8, 0x57, 0x2b, 0xfd, 0x3d, 0x9d, 0xcc, 0x47, 0xf2,
-	0x06, 0x17, 0x7d, 0x5f, 0x8a, 0x76, 0x74, 0x54, 0x7c, 0x46, 0x57, 0x7c, 0xd9, 0x6b, 0xf4, 0x3b,
-	0x54, 0xba, 0x77, 0x58, 0xd2, 0x4a, 0xe6, 0x97, 0xcc, 0x3d, 0x24, 0xee, 0xf7, 0x39, 0x8a, 0x85,
-	0x18, 0x23, 0x1f, 0xc8, 0xb7, 0x4b, 0x19, 0x3e, 0x86, 0x9c, 0xd1, 0x58, 0xd4, 0xee, 0xa3, 0x7f,
-	0xac, 0xc1, 0x93, 0xdc, 0x2d, 0xa5, 0x46, 0xcf, 0x7c, 0x96, 0xfb, 0x9b, 0xc4, 0xc9, 0xc6, 0x63,
-	0xab, 0xc0, 0xd1, 0x53, 0x5b, 0x05, 0x3e, 0x75, 0x74, 0x58, 0x7d, 0xb2, 0x76, 0x02, 0xdc, 0xf8,
-	0x44, 0x3d, 0x40, 0x6f, 0xc2, 0xa4, 0xa3, 0xc6, 0x4e, 0x11, 0x0c, 0xa6, 0x94, 0x62, 0x3e, 0x11,
-	0x84, 0x85, 0x6b, 0x62, 0x13, 0x45, 0x38, 0x49, 0x6a, 0x6e, 0x17, 0x26, 0x13, 0x0b, 0xed, 0x5c,
-	0x55, 0x1a, 0x2e, 0x4c, 0xa7, 0xd7, 0xc3, 0xb9, 0x5a, 0xc8, 0xdc, 0x83, 0x31, 0x79, 0x50, 0xa1,
-	0xc7, 0x15, 0x42, 0xf1, 0xb1, 0x7f, 0x8f, 0x1c, 0x70, 0xaa, 0xd5, 0xc4, 0x75, 0x8c, 0xeb, 0xdb,
-	0x5f, 0xa2, 0x05, 0x02, 0xa1, 0xfe, 0xfb, 0x42, 0xdf, 0xbe, 0x41, 0xda, 0x1d, 0xc7, 0x08, 0xc9,
-	0xbb, 0xff, 0xb5, 0x57, 0xff, 0xaf, 0x1a, 0x3f, 0x6f, 0xf8, 0xb1, 0x8a, 0x0c, 0x18, 0x6f, 0xf3,
-	0x18, 0xbe, 0xcc, 0x15, 0x5f, 0x2b, 0x1f, 0x04, 0x60, 0x35, 0x46, 0x83, 0x55, 0x9c, 0xe8, 0x01,
-	0x8c, 0x45, 0x82, 0x48, 0xa4, 0x3f, 0x58, 0xee, 0x4f, 0x30, 0x90, 0x32, 0x8f, 0x7c, 0x48, 0x8c,
-	0x4a, 0x02, 0x1c, 0xd3, 0xd2, 0x0d, 0x40, 0xd9, 0x36, 0xf4, 0xce, 0x1a, 0x19, 0xbe, 0x6b, 0xc9,
-	0xa8, 0x7b, 0x19, 0xe3, 0xf7, 0x63, 0xd3, 0x54, 0xeb, 0xbf, 0x51, 0x81, 0xdc, 0x94, 0x69, 0x48,
-	0x87, 0x61, 0xee, 0x8b, 0x16, 0x65, 0xc0, 0xa6, 0xa2, 0x0c, 0x77, 0x54, 0xc3, 0x02, 0x82, 0xee,
-	0x73, 0xbd, 0x85, 0x6b, 0xb1, 0x68, 0x77, 0x31, 0x97, 0x50, 0xbd, 0x1e, 0x97, 0xf2, 0x2a, 0xe0,
-	0xfc, 0x76, 0x68, 0x0f, 0x50, 0xdb, 0xd8, 0x4f, 0x63, 0xeb, 0x23, 0x27, 0xd0, 0x6a, 0x06, 0x1b,
-	0xce, 0xa1, 0x40, 0x0f, 0x52, 0xc3, 0x34, 0x49, 0x27, 0x24, 0x16, 0x1f, 0x62, 0xf4, 0xdc, 0xc7,
-	0x0e, 0xd2, 0x85, 0x24, 0x08, 0xa7, 0xeb, 0xea, 0x5f, 0x1d, 0x84, 0x47, 0x93, 0x93, 0x48, 0x77,
-	0x68, 0xe4, 0x2e, 0xf6, 0x62, 0x64, 0x0d, 0xcf, 0x27, 0xf2, 0xe9, 0xb4, 0x35, 0xfc, 0x6c, 0xcd,
-	0x27, 0xec, 0x48, 0x36, 0x9c, 0x20, 0x6a, 0x94, 0xb0, 0x8c, 0xff, 0x1a, 0xf8, 0x7e, 0x15, 0xf8,
-	0xb8, 0x0d, 0x9c, 0xab, 0x8f, 0xdb, 0xdb, 0x1a, 0xcc, 0x25, 0x8b, 0x97, 0x6d, 0xd7, 0x0e, 0x76,
-	0x44, 0xcc, 0xb6, 0xd3, 0x1b, 0xe3, 0xb3, 0x2c, 0x06, 0x2b, 0x85, 0x18, 0x71, 0x0f, 0x6a, 0xe8,
-	0xb3, 0x1a, 0x5c, 0x4f, 0xcd, 0x4b, 0x22, 0x82, 0xdc, 0xe9, 0xed, 0xf2, 0x99, 0xb7, 0xee, 0x4a,
-	0x31, 0x4a, 0xdc, 0x8b, 0x9e, 0xfe, 0x2f, 0x2b, 0x30, 0xc4, 0x5e, 0xab, 0xdf, 0x1d, 0xe6, 0xc9,
-	0xac, 0xab, 0x85, 0x16, 0x3b, 0xad, 0x94, 0xc5, 0xce, 0x8b, 0xe5, 0x49, 0xf4, 0x36, 0xd9, 0xf9,
-	0x76, 0xb8, 0xc6, 0xaa, 0x2d, 0x58, 0x4c, 0x89, 0x12, 0x10, 0x6b, 0xc1, 0xb2, 0x58, 0xac, 0x80,
-	0xe3, 0x35, 0xc7, 0x8f, 0xc3, 0x40, 0xd7, 0x77, 0xd2, 0xd1, 0x33, 0x36, 0xf1, 0x0a, 0xa6, 0xe5,
-	0xfa, 0xdb, 0x1a, 0x4c, 0x33, 0xdc, 0xca, 0xf6, 0x45, 0x7b, 0x30, 0xea, 0x8b, 0x2d, 0x2c, 0xbe,
-	0xcd, 0x4a, 0xe9, 0xa1, 0xe5, 0xb0, 0x05, 0x91, 0xd4, 0x51, 0xfc, 0xc2, 0x92, 0x96, 0xfe, 0xe5,
-	0x61, 0x98, 0x2d, 0x6a, 0x84, 0x7e, 0x5c, 0x83, 0x6b, 0x66, 0x2c, 0xcd, 0x2d, 0x74, 0xc3, 0x1d,
-	0xcf, 0xb7, 0x43, 0x5b, 0x98, 0x71, 0x94, 0xbc, 0xe6, 0xd6, 0x16, 0x64, 0xaf, 0x58, 0xc4, 0xb3,
-	0x5a, 0x2e, 0x05, 0x5c, 0x40, 0x19, 0xbd, 0x05, 0xb0, 0x1b, 0x87, 0x58, 0xad, 0x94, 0xcf, 0xb7,
-	0xc0, 0x86, 0xad, 0x84, 0x61, 0x8d, 0x3a, 0xc5, 0xf4, 0x90, 0x4a, 0xb9, 0x42, 0x8e, 0x12, 0x0f,
-	0x82, 0x9d, 0x7b, 0xe4, 0xa0, 0x63, 0xd8, 0xd1, 0x63, 0x7d, 0x79, 0xe2, 0xcd, 0xe6, 0x5d, 0x81,
-	0x2a, 0x49, 0x5c, 0x29, 0x57, 0xc8, 0xa1, 0x4f, 0x6b, 0x30, 0xe9, 0xa9, 0x8e, 0xc5, 0xfd, 0xd8,
-	0x42, 0xe6, 0x7a, 0x28, 0x73, 0x11, 0x3a, 0x09, 0x4a, 0x92, 0xa4, 0x6b, 0x62, 0x26, 0x48, 0x1f,
-	0x59, 0x82, 0xa9, 0xad, 0xf6, 0x9f, 0x91, 0x55, 0x39, 0xff, 0xf8, 0x75, 0x3c, 0x0b, 0xce, 0x92,
-	0x67, 0x9d, 0x22, 0xa1, 0x69, 0xc5, 0xf9, 0x21, 0x69, 0xa7, 0x86, 0xcb, 0x77, 0x6a, 0x69, 0xa3,
-	0x56, 0x4f, 0x20, 0x4b, 0x76, 0x2a, 0x0b, 0xce, 0x92, 0xd7, 0x3f, 0x55, 0x81, 0x47, 0x0a, 0xd6,
-	0xd8, 0xdf, 0x18, 0x4f, 0xf0, 0x2f, 0x69, 0x30, 0xc6, 0xe6, 0xe0, 0x5d, 0xe2, 0x4e, 0xc2, 0xfa,
-	0x5a, 0x60, 0xd3, 0xf6, 0x5b, 0x1a, 0xcc, 0x64, 0x62, 0x6d, 0x9e, 0xc8, 0x19, 0xe1, 0xc2, 0xcc,
-	0xad, 0xde, 0x17, 0xc7, 0xd5, 0x1e, 0x88, 0x5d, 0x5b, 0xd3, 0x31, 0xb5, 0xf5, 0x97, 0x61, 0x32,
-	0x61, 0xd2, 0x26, 0xa3, 0xf6, 0x68, 0xb9, 0x51, 0x7b, 0xd4, 0xa0, 0x3c, 0x95, 0x5e, 0x41, 0x79,
-	0xe2, 0x25, 0x9f, 0xe5, 0x6c, 0x7f, 0x63, 0x96, 0xfc, 0x57, 0x2e, 0x89, 0x25, 0xcf, 0xde, 0x07,
-	0x5e, 0x83, 0x61, 0x16, 0x02, 0x28, 0x3a, 0x31, 0x6f, 0x97, 0x0e, 0x2d, 0x14, 0xf0, 0x9b, 0x14,
-	0xff, 0x1f, 0x0b, 0xac, 0xa8, 0x0e, 0xd3, 0xa6, 0xe3, 0x75, 0x2d, 0x91, 0x1b, 0x72, 0x2d, 0xbe,
-	0xb4, 0xc9, 0x08, 0x91, 0xb5, 0x14, 0x1c, 0x67, 0x5a, 0x20, 0xcc, 0x5f, 0x18, 0xf8, 0x79, 0x56,
-	0x2a, 0x42, 0x64, 0x7d, 0xad, 0xc9, 0x93, 0x20, 0xc8, 0x97, 0x85, 0x37, 0x00, 0x48, 0xb4, 0x78,
-	0x23, 0x2f, 0xc0, 0x17, 0xca, 0xc5, 0xbe, 0x94, 0x5b, 0x20, 0x12, 0x3e, 0x65, 0x51, 0x80, 0x15,
-	0x22, 0xc8, 0x87, 0xf1, 0x1d, 0x7b, 0x8b, 0xf8, 0x2e, 0x97, 0xa3, 0x86, 0xca, 0x8b, 0x88, 0x77,
-	0x63, 0x34, 0xfc, 0x8e, 0xaf, 0x14, 0x60, 0x95, 0x08, 0xf2, 0xb9, 0x38, 0xc2, 0xd5, 0xc3, 0xfd,
-	0xa4, 0x6b, 0x8f, 0xf5, 0xce, 0xf1, 0x38, 0xe3, 0x32, 0xac, 0x50, 0x41, 0x2e, 0x80, 0x2b, 0x63,
-	0x7f, 0xf5, 0xf3, 0xe2, 0x10, 0x47, 0x10, 0xe3, 0x82, 0x47, 0xfc, 0x1b, 0x2b, 0x14, 0xe8, 0xbc,
-	0xb6, 0xe3, 0x60, 0x72, 0x42, 0x87, 0xf8, 0x62, 0x9f, 0x01, 0xfd, 0x84, 0xee, 0x24, 0x2e, 0xc0,
-	0x2a, 0x11, 0x3a, 0xc6, 0xb6, 0x0c, 0x01, 0x27, 0x74, 0x84, 0xa5, 0xc6, 0x18, 0x07, 0x92, 0x13,
-	0x99, 0xb4, 0xe4, 0x6f, 0xac, 0x50, 0x40, 0xaf, 0x2b, 0x0f, 0x53, 0x50, 0x5e, 0x03, 0x75, 0xa2,
-	0x47, 0xa9, 0x0f, 0xc5, 0x8a, 0x98, 0x71, 0xb6, 0x57, 0xaf, 0x2b, 0x4a, 0x18, 0x16, 0x1a, 0x8f,
-	0xf2, 0x8f, 0x8c, 0x52, 0x26, 0x36, 0xa6, 0x9d, 0xe8, 0x69, 0x4c, 0x5b, 0xa3, 0x12, 0x9a, 0xe2,
-	0xdc, 0xc1, 0x98, 0xc2, 0x64, 0xfc, 0xc2, 0xd1, 0x4c, 0x03, 0x71, 0xb6, 0x3e, 0x67, 0xfa, 0xc4,
-	0x62, 0x6d, 0xa7, 0x54, 0xa6, 0xcf, 0xcb, 0xb0, 0x84, 0xa2, 0x3d, 0x98, 0x08, 0x14, 0xcb, 0x5c,
-	0x91, 0xfe, 0xb0, 0x8f, 0xb7, 0x29, 0x61, 0x95, 0xcb, 0x82, 0x22, 0xa9, 0x25, 0x38, 0x41, 0x07,
-	0xbd, 0xa5, 0x9a, 0x22, 0x4e, 0x97, 0x77, 0xc3, 0xcc, 0x0f, 0xf9, 0x17, 0x6b, 0xd8, 0xa4, 0x15,
-	0x9c, 0x6a, 0x21, 0xd8, 0x4d, 0x1a, 0xdd, 0xcd, 0x9c, 0x89, 0xdb, 0xf9, 0xb1, 0x46, 0x79, 0xf4,
-	0xd3, 0x92, 0xfd, 0x8e, 0x17, 0x74, 0x7d, 0xc2, 0x42, 0x99, 0xb2, 0xcf, 0x83, 0xe2, 0x4f, 0xbb,
-	0x94, 0x06, 0xe2, 0x6c, 0x7d, 0x96, 0xab, 0x9d, 0x67, 0x8f, 0xa4, 0x47, 0x97, 0xe7, 0x12, 0x37,
-	0x0c, 0x58, 0x7a, 0xc4, 0x92, 0x9e, 0x92, 0xcd, 0x14, 0x2e, 0x9e, 0x72, 0x27, 0x5d, 0x8a, 0x33,
-	0x34, 0xe9, 0xca, 0x51, 0x1d, 0xd7, 0x59, 0x96, 0xc5, 0x92, 0x2b, 0x47, 0x75, 0x8a, 0xe7, 0x2b,
-	0x47, 0x2d, 0xc1, 0x09, 0x3a, 0xe8, 0xc3, 0x30, 0x19, 0x44, 0x79, 0x56, 0xd8, 0x0c, 0x5e, 0x8d,
-	0x23, 0x4b, 0x35, 0x55, 0x00, 0x4e, 0xd6, 0xd3, 0xff, 0xad, 0x06, 0x20, 0xb5, 0x07, 0x17, 0xa1,
-	0x13, 0xb7, 0x12, 0x0a, 0x95, 0xc5, 0xbe, 0xb4, 0x1d, 0xa4, 0x50, 0x33, 0xfe, 0x87, 0x1a, 0x4c,
-	0xc5, 0xd5, 0x2e, 0x40, 0x54, 0x37, 0x93, 0xa2, 0xfa, 0xc7, 0xfa, 0x1b, 0x57, 0x81, 0xbc, 0xfe,
-	0x7f, 0x2b, 0xea, 0xa8, 0x98, 0x34, 0xb6, 0x97, 0x78, 0x63, 0xa6, 0xa4, 0xef, 0xf6, 0xf3, 0xc6,
-	0xac, 0x3a, 0xd3, 0xc6, 0xe3, 0xcd, 0x79, 0x73, 0xfe, 0x3b, 0x09, 0x59, 0xa8, 0x0f, 0x97, 0x71,
-	0x29, 0xf8, 0x44, 0xa4, 0xf9, 0x04, 0x1c, 0x27, 0x18, 0xbd, 0xa1, 0xb2, 0x4a, 0xfe, 0x5a, 0xfd,
-	0xf1, 0x72, 0x7e, 0xca, 0xca, 0x80, 0x7b, 0x32, 0x48, 0xfd, 0xc7, 0xa6, 0x60, 0x5c, 0x51, 0xb4,
-	0xa5, 0x5e, 0xcc, 0xb5, 0x8b, 0x78, 0x31, 0x0f, 0x61, 0xdc, 0x94, 0xa1, 0xc1, 0xa3, 0x69, 0xef,
-	0x93, 0xa6, 0x64, 0xd1, 0x71, 0xd0, 0xf1, 0x00, 0xab, 0x64, 0xa8, 0x20, 0x21, 0xd7, 0xd8, 0xc0,
-	0x19, 0xd8, 0x31, 0xf4, 0x5a, 0x57, 0x1f, 0x04, 0x88, 0x64, 0x51, 0x62, 0x89, 0xd8, 0x8e, 0xd2,
-	0x64, 0xbc, 0x11, 0xdc, 0x95, 0x30, 0xac, 0xd4, 0xcb, 0xbe, 0xc0, 0x0e, 0x5d, 0xd8, 0x0b, 0x2c,
-	0x5d, 0x06, 0x4e, 0x94, 0x99, 0xa6, 0x2f, 0x9b, 0x1c, 0x99, 0xdf, 0x26, 0x5e, 0x06, 0xb2, 0x28,
-	0xc0, 0x0a, 0x91, 0x02, 0xc3, 0x89, 0x91, 0x52, 0x86, 0x13, 0x5d, 0xb8, 0xec, 0x93, 0xd0, 0x3f,
-	0xa8, 0x1d, 0x98, 0x2c, 0x61, 0x93, 0x1f, 0xb2, 0x1b, 0xe5, 0x68, 0xb9, 0x58, 0x43, 0x38, 0x8b,
-	0x0a, 0xe7, 0xe1, 0x4f, 0x08, 0x63, 0x63, 0x3d, 0x85, 0xb1, 0x0f, 0xc1, 0x78, 0x48, 0xcc, 0x1d,
-	0xd7, 0x36, 0x0d, 0xa7, 0x51, 0x17, 0x81, 0x0f, 0x63, 0xb9, 0x22, 0x06, 0x61, 0xb5, 0x1e, 0x5a,
-	0x84, 0x81, 0xae, 0x6d, 0x09, 0x69, 0xf4, 0x9b, 0xa4, 0xca, 0xba, 0x51, 0x7f, 0x78, 0x58, 0x7d,
-	0x6f, 0x6c, 0x89, 0x20, 0x47, 0x75, 0xab, 0xb3, 0xdb, 0xba, 0x15, 0x1e, 0x74, 0x48, 0x30, 0xbf,
-	0xd9, 0xa8, 0x63, 0xda, 0x38, 0xcf, 0xa8, 0x64, 0xe2, 0x14, 0x46, 0x25, 0xef, 0x68, 0x70, 0xd9,
-	0x48, 0x6b, 0xdb, 0x49, 0x30, 0x3b, 0x59, 0x9e, 0x5b, 0xe6, 0x6b, 0xf0, 0x17, 0xaf, 0x8b, 0xf1,
-	0x5d, 0x5e, 0xc8, 0x92, 0xc3, 0x79, 0x7d, 0x40, 0x3e, 0xa0, 0xb6, 0xdd, 0x92, 0x49, 0x62, 0xc4,
-	0x57, 0x9f, 0x2a, 0xa7, 0x47, 0x58, 0xcd, 0x60, 0xc2, 0x39, 0xd8, 0xd1, 0x03, 0x18, 0x37, 0x63,
-	0x9d, 0xbc, 0x90, 0xaa, 0xeb, 0x67, 0xf1, 0x28, 0xc0, 0x6f, 0x5e, 0xaa, 0xc2, 0x5f, 0xa5, 0x24,
-	0x5f, 0xd3, 0x94, 0x2b, 0xaf, 0x78, 0x51, 0x62, 0xa3, 0x9e, 0x2e, 0xff, 0x9a, 0x96, 0x8f, 0x11,
-	0xf7, 0xa0, 0xc6, 0x22, 0xfc, 0x38, 0xc9, 0x5c, 0x4e, 0x2c, 0xd3, 0x78, 0x49, 0xaf, 0xe0, 0x54,
-	0x5a, 0x28, 0xbe, 0x34, 0x53, 0x85, 0x38, 0x4d, 0x10, 0x2d, 0x03, 0x22, 0x5c, 0xb5, 0x1b, 0x5f,
-	0x14, 0x82, 0x59, 0x24, 0x73, 0x5e, 0xa1, 0xa5, 0x0c, 0x14, 0xe7, 0xb4, 0xd0, 0xff, 0x40, 0x13,
-	0x8a, 0xb7, 0x0b, 0xb4, 0xaa, 0x38, 0xef, 0x27, 0x39, 0xfd, 0xcf, 0x35, 0xc8, 0xc8, 0xfa, 0x68,
-	0x0b, 0x46, 0x28, 0x8a, 0xfa, 0x5a, 0x53, 0x0c, 0xeb, 0xa3, 0xe5, 0x8e, 0x5d, 0x86, 0x82, 0x6b,
-	0x31, 0xc5, 0x0f, 0x1c, 0x21, 0xa6, 0xb7, 0x07, 0x57, 0x89, 0xe1, 0x2c, 0x46, 0x58, 0x4a, 0xae,
-	0x51, 0x63, 0x41, 0xf3, 0xdb, 0x83, 0x5a, 0x82, 0x13, 0x74, 0xf4, 0x15, 0x80, 0xf8, 0x7e, 0xd6,
-	0xb7, 0xa1, 0xcd, 0xbf, 0x18, 0x86, 0xab, 0xfd, 0xba, 0x18, 0xb0, 0x54, 0x44, 0x64, 0xcf, 0x36,
-	0xc3, 0x85, 0xed, 0x90, 0xf8, 0xf7, 0xef, 0xaf, 0xca, 0xdc, 0xfa, 0x25, 0x73, 0x21, 0xb1, 0x87,
-	0xb9, 0xa5, 0x5c, 0x8c, 0xb8, 0x80, 0x12, 0xbb, 0x9b, 0x8a, 0xec, 0xc5, 0x98, 0x0a, 0xa5, 0x2c,
-	0x71, 0x3e, 0x8f, 0x93, 0xc2, 0xef, 0xa6, 0x69, 0x20, 0xce, 0xd6, 0x4f, 0x23, 0x59, 0xb1, 0xdb,
-	0x36, 0xcf, 0x09, 0xa3, 0x65, 0x91, 0x30, 0x20, 0xce, 0xd6, 0x57, 0x91, 0xf0, 0x2f, 0x45, 0xb9,
-	0xc6, 0x50, 0x16, 0x89, 0x04, 0xe2, 0x6c, 0x7d, 0x64, 0xc1, 0x63, 0x3e, 0x31, 0xbd, 0x76, 0x9b,
-	0xb8, 0x16, 0xcf, 0xf2, 0x67, 0xf8, 0x2d, 0xdb, 0x5d, 0xf6, 0x0d, 0x56, 0x91, 0xa9, 0xfa, 0x34,
-	0x96, 0xd9, 0xe0, 0x31, 0xdc, 0xa3, 0x1e, 0xee, 0x89, 0x05, 0xb5, 0xe1, 0x12, 0x4f, 0x29, 0xe4,
-	0x37, 0xdc, 0x90, 0xf8, 0x7b, 0x86, 0x23, 0xf4, 0x79, 0xa5, 0x32, 0x10, 0x6f, 0x26, 0x51, 0xe1,
-	0x34, 0x6e, 0x74, 0x40, 0xe5, 0x17, 0xd1, 0x1d, 0x85, 0xe4, 0x68, 0xf9, 0x64, 0x5d, 0x38, 0x8b,
-	0x0e, 0xe7, 0xd1, 0x40, 0x0d, 0xb8, 0x1c, 0x1a, 0x7e, 0x8b, 0x84, 0xb5, 0xf5, 0xcd, 0x75, 0xe2,
-	0x9b, 0xf4, 0xb8, 0x71, 0xb8, 0x38, 0xa3, 0x71, 0x54, 0x1b, 0x59, 0x30, 0xce, 0x6b, 0xa3, 0xbf,
-	0xa3, 0x81, 0x30, 0x8e, 0x46, 0x8f, 0x25, 0x9e, 0x5f, 0x46, 0x53, 0x4f, 0x2f, 0x51, 0x5a, 0x84,
-	0x4a, 0x6e, 0x5a, 0x84, 0xf7, 0x2b, 0xb1, 0x7c, 0xc6, 0x62, 0x36, 0xca, 0x31, 0x2b, 0x29, 0x5d,
-	0x9e, 0x81, 0x31, 0xc9, 0xcc, 0x85, 0x90, 0xcd, 0x62, 0x73, 0xc6, 0x5c, 0x3f, 0x86, 0xeb, 0xbf,
-	0xa7, 0x81, 0xc0, 0xc0, 0x12, 0x10, 0x9d, 0x28, 0x11, 0xcd, 0xb1, 0xd6, 0x56, 0x4a, 0x02, 0x9d,
-	0x81, 0xc2, 0x04, 0x3a, 0xe7, 0x94, 0x57, 0xe6, 0x97, 0x35, 0xb8, 0x94, 0x0c, 0xae, 0x14, 0xa0,
-	0xf7, 0xc1, 0x88, 0x08, 0xbf, 0x28, 0xe2, 0xa7, 0xb1, 0xa6, 0x22, 0xfe, 0x01, 0x8e, 0x60, 0x49,
-	0x0d, 0x5d, 0x1f, 0xb7, 0xde, 0xfc, 0x18, 0x4f, 0xc7, 0x5c, 0x40, 0xdf, 0x99, 0x81, 0x61, 0x1e,
-	0xbb, 0x8f, 0xb2, 0xc7, 0x1c, 0xbf, 0xcf, 0x7b, 0xe5, 0x43, 0x04, 0x96, 0x71, 0xd6, 0x53, 0xc3,
-	0xe4, 0x57, 0x7a, 0x86, 0xc9, 0xc7, 0x3c, 0x5f, 0x57, 0x1f, 0xaf, 0x31, 0x35, 0xdc, 0x10, 0x39,
-	0xba, 0xa3, 0x5c, 0x5d, 0x61, 0xe2, 0x99, 0x62, 0xb0, 0xbc, 0x30, 0xc9, 0x27, 0x40, 0x79, 0xac,
-	0x98, 0xea, 0xf9, 0x50, 0x11, 0x05, 0x47, 0x1b, 0x2a, 0x6f, 0xfd, 0x28, 0xa6, 0xfc, 0x04, 0xc1,
-	0xd1, 0xe4, 0x46, 0x1a, 0x2e, 0xdc, 0x48, 0xdb, 0x30, 0x22, 0xb6, 0x82, 0xe0, 0xb3, 0x1f, 0xed,
-	0x23, 0xf1, 0x95, 0x12, 0xcf, 0x97, 0x17, 0xe0, 0x08, 0x39, 0x3d, 0xbc, 0xdb, 0xc6, 0xbe, 0xdd,
-	0xee, 0xb6, 0x19, 0x73, 0x1d, 0x52, 0xab, 0xb2, 0x62, 0x1c, 0xc1, 0x59, 0x55, 0x6e, 0x34, 0xca,
-	0x98, 0xa1, 0x5a, 0x95, 0x17, 0xe3, 0x08, 0x8e, 0x5e, 0x85, 0xd1, 0xb6, 0xb1, 0xdf, 0xec, 0xfa,
-	0x2d, 0x22, 0x1e, 0x29, 0x8a, 0xc5, 0xc5, 0x6e, 0x68, 0x3b, 0xf3, 0xb6, 0x1b, 0x06, 0xa1, 0x3f,
-	0xdf, 0x70, 0xc3, 0xfb, 0x7e, 0x33, 0xf4, 0x65, 0xf6, 0x9b, 0x55, 0x81, 0x05, 0x4b, 0x7c, 0xc8,
-	0x81, 0xa9, 0xb6, 0xb1, 0xbf, 0xe9, 0x1a, 0x32, 0x15, 0xfe, 0x78, 0x49, 0x0a, 0xec, 0xa5, 0x7a,
-	0x35, 0x81, 0x0b, 0xa7, 0x70, 0xe7, 0x3c, 0x8a, 0x4f, 0x9c, 0xd7, 0xa3, 0xf8, 0x82, 0x74, 0x01,
-	0xe2, 0x57, 0xc9, 0x47, 0x73, 0x5d, 0xe3, 0x7b, 0xba, 0xf7, 0xbc, 0x26, 0xdd, 0x7b, 0xa6, 0xca,
-	0xbf, 0xe2, 0xf6, 0x70, 0xed, 0xe9, 0xc2, 0x38, 0x15, 0xd6, 0x79, 0x29, 0xbd, 0xeb, 0x95, 0xd6,
-	0x8a, 0xd6, 0x25, 0x1a, 0x25, 0x6f, 0x6b, 0x8c, 0x1a, 0xab, 0x74, 0xd0, 0x7d, 0x9e, 0x87, 0xdd,
-	0x21, 0x61, 0x5c, 0x85, 0xe9, 0x18, 0xa6, 0xd9, 0xfe, 0x91, 0x69, 0xd3, 0x33, 0x15, 0x70, 0x7e,
-	0xbb, 0x38, 0x8c, 0xcb, 0x4c, 0x7e, 0x18, 0x17, 0xf4, 0x23, 0x79, 0x4f, 0x0f, 0x88, 0xcd, 0xe9,
-	0xb7, 0x95, 0xe7, 0x0d, 0xa5, 0x1f, 0x20, 0xfe, 0x95, 0x06, 0xb3, 0xed, 0x82, 0x04, 0xa7, 0xe2,
-	0x45, 0x64, 0xa3, 0x0f, 0xfe, 0x50, 0x98, 0x34, 0x75, 0xf1, 0xc9, 0xa3, 0xc3, 0xea, 0xb1, 0xa9,
-	0x55, 0x71, 0x61, 0xdf, 0x90, 0x0f, 0x23, 0xc1, 0x41, 0x60, 0x86, 0x4e, 0x30, 0x7b, 0xa5, 0x7c,
-	0x1e, 0x4d, 0xc1, 0x59, 0x9b, 0x1c, 0x13, 0x67, 0xad, 0x71, 0x14, 0x79, 0x5e, 0x8a, 0x23, 0x42,
-	0xe8, 0xc7, 0x34, 0x98, 0x11, 0x4a, 0x1b, 0xc5, 0xb7, 0xf5, 0x6a, 0x79, 0x63, 0xc5, 0x5a, 0x1a,
-	0xd9, 0xfd, 0x0e, 0x0f, 0x41, 0xce, 0x84, 0xf4, 0x0c, 0x14, 0x67, 0xa9, 0xf7, 0xeb, 0x7c, 0xde,
-	0x47, 0x34, 0xcd, 0xb9, 0xdb, 0x30, 0xa1, 0x4e, 0xdc, 0xa9, 0x7c, 0xde, 0x7f, 0x56, 0x83, 0xe9,
-	0xf4, 0x41, 0xaa, 0xa6, 0xdf, 0xd7, 0xce, 0x37, 0xfd, 0xbe, 0x62, 0x26, 0x54, 0xe9, 0x61, 0x26,
-	0xf4, 0x02, 0x5c, 0xcb, 0xdf, 0x5f, 0x54, 0xaa, 0x35, 0x1c, 0xc7, 0x7b, 0x20, 0x2e, 0xa6, 0x71,
-	0xd2, 0x2b, 0x5a, 0x88, 0x39, 0x4c, 0xff, 0x1e, 0x48, 0xc7, 0x4e, 0x46, 0xaf, 0xc3, 0x58, 0x10,
-	0xec, 0xf0, 0xb0, 0x98, 0x62, 0x90, 0xe5, 0x34, 0x12, 0x51, 0x6c, 0x4d, 0x2e, 0x88, 0xcb, 0x9f,
-	0x38, 0x46, 0xbf, 0xf8, 0xca, 0x17, 0xbf, 0x7a, 0xe3, 0x3d, 0xbf, 0xff, 0xd5, 0x1b, 0xef, 0xf9,
-	0xf2, 0x57, 0x6f, 0xbc, 0xe7, 0xfb, 0x8e, 0x6e, 0x68, 0x5f, 0x3c, 0xba, 0xa1, 0xfd, 0xfe, 0xd1,
-	0x0d, 0xed, 0xcb, 0x47, 0x37, 0xb4, 0xff, 0x74, 0x74, 0x43, 0xfb, 0xd1, 0xff, 0x7c, 0xe3, 0x3d,
-	0xaf, 0x3e, 0x17, 0x53, 0xbf, 0x15, 0x11, 0x8d, 0xff, 0xe9, 0xec, 0xb6, 0x6e, 0x51, 0xea, 0x91,
-	0x07, 0x16, 0xa3, 0xfe, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x95, 0xe6, 0x48, 0xbf, 0xae, 0xed,
-	0x00, 0x00,
+	// 12745 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7d, 0x6c, 0x6d, 0xd9,
+	0x55, 0x18, 0x9e, 0x73, 0xaf, 0x3f, 0x97, 0x3f, 0x9e, 0xbd, 0xdf, 0xf3, 0x7b, 0x1e, 0xcf, 0x87,
+	0x5f, 0xce, 0x4c, 0xf2, 0x9b, 0x61, 0x82, 0x1f, 0x33, 0x24, 0x24, 0x33, 0x61, 0x32, 0xb1, 0xaf,
+	0xed, 0xf7, 0x6e, 0x9e, 0xed, 0xe7, 0xec, 0x6b, 0xcf, 0x0c, 0xf3, 0xa3, 0x03, 0xc7, 0xe7, 0x6c,
+	0x5f, 0x9f, 0xf1, 0xb9, 0xe7, 0xdc, 0x39, 0xe7, 0x5c, 0x3f, 0xdf, 0x99, 0x50, 0x08, 0x05, 0x4a,
+	0x02, 0xa9, 0x68, 0x24, 0x9a, 0x26, 0x80, 0x08, 0x42, 0xa8, 0xa5, 0x54, 0x14, 0xa8, 0xa8, 0x04,
+	0xa8, 0x12, 0x8a, 0x44, 0x49, 0x2a, 0x40, 0x08, 0x5a, 0x35, 0x51, 0x5b, 0xd3, 0x18, 0x0a, 0x48,
+	0xad, 0x68, 0x55, 0x54, 0x55, 0xbc, 0x22, 0xa8, 0xf6, 0xc7, 0xd9, 0x67, 0x9f, 0xaf, 0x6b, 0xfb,
+	0x5c, 0xdb, 0xc9, 0x08, 0xfe, 0xb2, 0xef, 0x5e, 0x7b, 0xaf, 0xb5, 0xbf, 0xce, 0xda, 0x6b, 0xad,
+	0xbd, 0xf6, 0x5a, 0xb0, 0xd4, 0xb4, 0xc3, 0xbd, 0xce, 0xce, 0x82, 0xe9, 0xb5, 0x6e, 0x35, 0x0d,
+	0xdf, 0x22, 0x2e, 0xf1, 0xe3, 0x7f, 0xda, 0xfb, 0xcd, 0x5b, 0x46, 0xdb, 0x0e, 0x6e, 0x99, 0x9e,
+	0x4f, 0x6e, 0x1d, 0x3c, 0xb3, 0x43, 0x42, 0xe3, 0x99, 0x5b, 0x4d, 0x0a, 0x33, 0x42, 0x62, 0x2d,
+	0xb4, 0x7d, 0x2f, 0xf4, 0xd0, 0xb3, 0x31, 0x8e, 0x85, 0xa8, 0x69, 0xfc, 0x4f, 0x7b, 0xbf, 0xb9,
+	0x40, 0x71, 0x2c, 0x50, 0x1c, 0x0b, 0x02, 0xc7, 0xdc, 0x37, 0xaa, 0x74, 0xbd, 0xa6, 0x77, 0x8b,
+	0xa1, 0xda, 0xe9, 0xec, 0xb2, 0x5f, 0xec, 0x07, 0xfb, 0x8f, 0x93, 0x98, 0x7b, 0x6a, 0xff, 0x03,
+	0xc1, 0x82, 0xed, 0xd1, 0xce, 0xdc, 0x32, 0x3a, 0xa1, 0x17, 0x98, 0x86, 0x63, 0xbb, 0xcd, 0x5b,
+	0x07, 0x99, 0xde, 0xcc, 0xe9, 0x4a, 0x55, 0xd1, 0xed, 0x9e, 0x75, 0xfc, 0x1d, 0xc3, 0xcc, 0xab,
+	0xf3, 0xde, 0xb8, 0x4e, 0xcb, 0x30, 0xf7, 0x6c, 0x97, 0xf8, 0xdd, 0x68, 0x42, 0x6e, 0xf9, 0x24,
+	0xf0, 0x3a, 0xbe, 0x49, 0xce, 0xd4, 0x2a, 0xb8, 0xd5, 0x22, 0xa1, 0x91, 0x47, 0xeb, 0x56, 0x51,
+	0x2b, 0xbf, 0xe3, 0x86, 0x76, 0x2b, 0x4b, 0xe6, 0x5b, 0x4e, 0x6a, 0x10, 0x98, 0x7b, 0xa4, 0x65,
+	0x64, 0xda, 0x7d, 0x73, 0x51, 0xbb, 0x4e, 0x68, 0x3b, 0xb7, 0x6c, 0x37, 0x0c, 0x42, 0x3f, 0xdd,
+	0x48, 0xff, 0xa4, 0x06, 0x53, 0x8b, 0x9b, 0xf5, 0x06, 0xf1, 0x0f, 0x88, 0xbf, 0xe6, 0x35, 0x9b,
+	0xb6, 0xdb, 0x44, 0x4f, 0xc3, 0xe8, 0x01, 0xf1, 0x77, 0xbc, 0xc0, 0x0e, 0xbb, 0xb3, 0xda, 0x4d,
+	0xed, 0xc9, 0xc1, 0xa5, 0x89, 0xe3, 0xa3, 0xf9, 0xd1, 0x97, 0xa2, 0x42, 0x1c, 0xc3, 0x51, 0x1d,
+	0xae, 0xee, 0x85, 0x61, 0x7b, 0xd1, 0x34, 0x49, 0x10, 0xc8, 0x1a, 0xb3, 0x15, 0xd6, 0xec, 0xc6,
+	0xf1, 0xd1, 0xfc, 0xd5, 0x3b, 0x5b, 0x5b, 0x9b, 0x29, 0x30, 0xce, 0x6b, 0xa3, 0xff, 0x92, 0x06,
+	0xd3, 0xb2, 0x33, 0x98, 0xbc, 0xd1, 0x21, 0x41, 0x18, 0x20, 0x0c, 0xd7, 0x5b, 0xc6, 0xe1, 0x86,
+	0xe7, 0xae, 0x77, 0x42, 0x23, 0xb4, 0xdd, 0x66, 0xdd, 0xdd, 0x75, 0xec, 0xe6, 0x5e, 0x28, 0xba,
+	0x36, 0x77, 0x7c, 0x34, 0x7f, 0x7d, 0x3d, 0xb7, 0x06, 0x2e, 0x68, 0x49, 0x3b, 0xdd, 0x32, 0x0e,
+	0x33, 0x08, 0x95, 0x4e, 0xaf, 0x67, 0xc1, 0x38, 0xaf, 0x8d, 0xfe, 0x2c, 0x0c, 0x2e, 0x5a, 0x96,
+	0xe7, 0xa2, 0xa7, 0x60, 0x98, 0xb8, 0xc6, 0x8e, 0x43, 0x2c, 0xd6, 0xb1, 0x91, 0xa5, 0x2b, 0x5f,
+	0x3c, 0x9a, 0x7f, 0xc7, 0xf1, 0xd1, 0xfc, 0xf0, 0x0a, 0x2f, 0xc6, 0x11, 0x5c, 0xff, 0xd1, 0x0a,
+	0x0c, 0xb1, 0x46, 0x01, 0xfa, 0xb4, 0x06, 0x57, 0xf7, 0x3b, 0x3b, 0xc4, 0x77, 0x49, 0x48, 0x82,
+	0x65, 0x23, 0xd8, 0xdb, 0xf1, 0x0c, 0x9f, 0xa3, 0x18, 0x7b, 0xf6, 0xf6, 0xc2, 0xd9, 0xbf, 0xbf,
+	0x85, 0xbb, 0x59, 0x74, 0x7c, 0x4c, 0x39, 0x00, 0x9c, 0x47, 0x1c, 0x1d, 0xc0, 0xb8, 0xdb, 0xb4,
+	0xdd, 0xc3, 0xba, 0xdb, 0xf4, 0x49, 0x10, 0xb0, 0x79, 0x19, 0x7b, 0xf6, 0xc3, 0x65, 0x3a, 0xb3,
+	0xa1, 0xe0, 0x59, 0x9a, 0x3a, 0x3e, 0x9a, 0x1f, 0x57, 0x4b, 0x70, 0x82, 0x8e, 0xfe, 0x57, 0x1a,
+	0x5c, 0x59, 0xb4, 0x5a, 0x76, 0x10, 0xd8, 0x9e, 0xbb, 0xe9, 0x74, 0x9a, 0xb6, 0x8b, 0x6e, 0xc2,
+	0x80, 0x6b, 0xb4, 0x08, 0x9b, 0x90, 0xd1, 0xa5, 0x71, 0x31, 0xa7, 0x03, 0x1b, 0x46, 0x8b, 0x60,
+	0x06, 0x41, 0x1f, 0x85, 0x21, 0xd3, 0x73, 0x77, 0xed, 0xa6, 0xe8, 0xe7, 0x37, 0x2e, 0xf0, 0x2f,
+	0x61, 0x41, 0xfd, 0x12, 0x58, 0xf7, 0xc4, 0x17, 0xb4, 0x80, 0x8d, 0xfb, 0x2b, 0x87, 0x21, 0x71,
+	0x29, 0x99, 0x25, 0x38, 0x3e, 0x9a, 0x1f, 0xaa, 0x31, 0x04, 0x58, 0x20, 0x42, 0x4f, 0xc2, 0x88,
+	0x65, 0x07, 0x7c, 0x31, 0xab, 0x6c, 0x31, 0xc7, 0x8f, 0x8f, 0xe6, 0x47, 0x96, 0x45, 0x19, 0x96,
+	0x50, 0xb4, 0x06, 0xd7, 0xe8, 0x0c, 0xf2, 0x76, 0x0d, 0x62, 0xfa, 0x24, 0xa4, 0x5d, 0x9b, 0x1d,
+	0x60, 0xdd, 0x9d, 0x3d, 0x3e, 0x9a, 0xbf, 0x76, 0x37, 0x07, 0x8e, 0x73, 0x5b, 0xe9, 0xab, 0x30,
+	0xb2, 0xe8, 0x10, 0x9f, 0x6e, 0x30, 0xf4, 0x3c, 0x4c, 0x92, 0x96, 0x61, 0x3b, 0x98, 0x98, 0xc4,
+	0x3e, 0x20, 0x7e, 0x30, 0xab, 0xdd, 0xac, 0x3e, 0x39, 0xba, 0x84, 0x8e, 0x8f, 0xe6, 0x27, 0x57,
+	0x12, 0x10, 0x9c, 0xaa, 0xa9, 0x7f, 0x5c, 0x83, 0xb1, 0xc5, 0x8e, 0x65, 0x87, 0x7c, 0x5c, 0xc8,
+	0x87, 0x31, 0x83, 0xfe, 0xdc, 0xf4, 0x1c, 0xdb, 0xec, 0x8a, 0xcd, 0xf5, 0x62, 0x99, 0xf5, 0x5c,
+	0x8c, 0xd1, 0x2c, 0x5d, 0x39, 0x3e, 0x9a, 0x1f, 0x53, 0x0a, 0xb0, 0x4a, 0x44, 0xdf, 0x03, 0x15,
+	0x86, 0xbe, 0x0d, 0xc6, 0xf9, 0x70, 0xd7, 0x8d, 0x36, 0x26, 0xbb, 0xa2, 0x0f, 0x8f, 0x2b, 0x6b,
+	0x15, 0x11, 0x5a, 0xb8, 0xb7, 0xf3, 0x3a, 0x31, 0x43, 0x4c, 0x76, 0x89, 0x4f, 0x5c, 0x93, 0xf0,
+	0x6d, 0x53, 0x53, 0x1a, 0xe3, 0x04, 0x2a, 0xfd, 0x0f, 0x28, 0x13, 0x3b, 0x30, 0x6c, 0xc7, 0xd8,
+	0xb1, 0x1d, 0x3b, 0xec, 0xbe, 0xea, 0xb9, 0xe4, 0x14, 0xfb, 0x66, 0x1b, 0x6e, 0x74, 0x5c, 0x83,
+	0xb7, 0x73, 0xc8, 0x3a, 0xdf, 0x29, 0x5b, 0xdd, 0x36, 0xa1, 0x1b, 0x9e, 0xce, 0xf4, 0xc3, 0xc7,
+	0x47, 0xf3, 0x37, 0xb6, 0xf3, 0xab, 0xe0, 0xa2, 0xb6, 0x94, 0x5f, 0x29, 0xa0, 0x97, 0x3c, 0xa7,
+	0xd3, 0x12, 0x58, 0xab, 0x0c, 0x2b, 0xe3, 0x57, 0xdb, 0xb9, 0x35, 0x70, 0x41, 0x4b, 0xfd, 0x8b,
+	0x15, 0x18, 0x5f, 0x32, 0xcc, 0xfd, 0x4e, 0x7b, 0xa9, 0x63, 0xee, 0x93, 0x10, 0x7d, 0x27, 0x8c,
+	0xd0, 0x03, 0xc7, 0x32, 0x42, 0x43, 0xcc, 0xe4, 0x37, 0x15, 0xee, 0x7a, 0xb6, 0x88, 0xb4, 0x76,
+	0x3c, 0xb7, 0xeb, 0x24, 0x34, 0x96, 0x90, 0x98, 0x13, 0x88, 0xcb, 0xb0, 0xc4, 0x8a, 0x76, 0x61,
+	0x20, 0x68, 0x13, 0x53, 0x7c, 0x53, 0xcb, 0x65, 0xf6, 0x8a, 0xda, 0xe3, 0x46, 0x9b, 0x98, 0xf1,
+	0x2a, 0xd0, 0x5f, 0x98, 0xe1, 0x47, 0x2e, 0x0c, 0x05, 0xa1, 0x11, 0x76, 0x02, 0xf6, 0xa1, 0x8d,
+	0x3d, 0xbb, 0xda, 0x37, 0x25, 0x86, 0x6d, 0x69, 0x52, 0xd0, 0x1a, 0xe2, 0xbf, 0xb1, 0xa0, 0xa2,
+	0xff, 0x07, 0x0d, 0xa6, 0xd4, 0xea, 0x6b, 0x76, 0x10, 0xa2, 0x6f, 0xcf, 0x4c, 0xe7, 0xc2, 0xe9,
+	0xa6, 0x93, 0xb6, 0x66, 0x93, 0x39, 0x25, 0xc8, 0x8d, 0x44, 0x25, 0xca, 0x54, 0x12, 0x18, 0xb4,
+	0x43, 0xd2, 0xe2, 0xdb, 0xaa, 0x24, 0x1f, 0x55, 0xbb, 0xbc, 0x34, 0x21, 0x88, 0x0d, 0xd6, 0x29,
+	0x5a, 0xcc, 0xb1, 0xeb, 0xdf, 0x09, 0xd7, 0xd4, 0x5a, 0x9b, 0xbe, 0x77, 0x60, 0x5b, 0xc4, 0xa7,
+	0x5f, 0x42, 0xd8, 0x6d, 0x67, 0xbe, 0x04, 0xba, 0xb3, 0x30, 0x83, 0xa0, 0x77, 0xc3, 0x90, 0x4f,
+	0x9a, 0xb6, 0xe7, 0xb2, 0xd5, 0x1e, 0x8d, 0xe7, 0x0e, 0xb3, 0x52, 0x2c, 0xa0, 0xfa, 0xff, 0xae,
+	0x24, 0xe7, 0x8e, 0x2e, 0x23, 0x3a, 0x80, 0x91, 0xb6, 0x20, 0x25, 0xe6, 0xee, 0x4e, 0xbf, 0x03,
+	0x8c, 0xba, 0x1e, 0xcf, 0x6a, 0x54, 0x82, 0x25, 0x2d, 0x64, 0xc3, 0x64, 0xf4, 0x7f, 0xad, 0x0f,
+	0xf6, 0xcf, 0xd8, 0xe9, 0x66, 0x02, 0x11, 0x4e, 0x21, 0x46, 0x5b, 0x30, 0x1a, 0x30, 0x26, 0x4d,
+	0x19, 0x57, 0xb5, 0x98, 0x71, 0x35, 0xa2, 0x4a, 0x82, 0x71, 0x4d, 0x8b, 0xee, 0x8f, 0x4a, 0x00,
+	0x8e, 0x11, 0xd1, 0x43, 0x26, 0x20, 0xc4, 0x52, 0x8e, 0x0b, 0x76, 0xc8, 0x34, 0x44, 0x19, 0x96,
+	0x50, 0xfd, 0xf3, 0x03, 0x80, 0xb2, 0x5b, 0x5c, 0x9d, 0x01, 0x5e, 0x22, 0xe6, 0xbf, 0x9f, 0x19,
+	0x10, 0x5f, 0x4b, 0x0a, 0x31, 0x7a, 0x13, 0x26, 0x1c, 0x23, 0x08, 0xef, 0xb5, 0xa9, 0xf4, 0x18,
+	0x6d, 0x94, 0xb1, 0x67, 0x17, 0xcb, 0xac, 0xf4, 0x9a, 0x8a, 0x68, 0x69, 0xfa, 0xf8, 0x68, 0x7e,
+	0x22, 0x51, 0x84, 0x93, 0xa4, 0xd0, 0xeb, 0x30, 0x4a, 0x0b, 0x56, 0x7c, 0xdf, 0xf3, 0xc5, 0xec,
+	0xbf, 0x50, 0x96, 0x2e, 0x43, 0xc2, 0xa5, 0x59, 0xf9, 0x13, 0xc7, 0xe8, 0xd1, 0x47, 0x00, 0x79,
+	0x3b, 0x01, 0x15, 0x40, 0xad, 0xdb, 0x5c, 0x54, 0xa6, 0x83, 0xa5, 0xab, 0x53, 0x5d, 0x9a, 0x13,
+	0xab, 0x89, 0xee, 0x65, 0x6a, 0xe0, 0x9c, 0x56, 0x68, 0x1f, 0x90, 0x14, 0xb7, 0xe5, 0x06, 0x98,
+	0x1d, 0x3c, 0xfd, 0xf6, 0xb9, 0x4e, 0x89, 0xdd, 0xce, 0xa0, 0xc0, 0x39, 0x68, 0xf5, 0xdf, 0xa8,
+	0xc0, 0x18, 0xdf, 0x22, 0x2b, 0x6e, 0xe8, 0x77, 0x2f, 0xe1, 0x80, 0x20, 0x89, 0x03, 0xa2, 0x56,
+	0xfe, 0x9b, 0x67, 0x1d, 0x2e, 0x3c, 0x1f, 0x5a, 0xa9, 0xf3, 0x61, 0xa5, 0x5f, 0x42, 0xbd, 0x8f,
+	0x87, 0x7f, 0xaf, 0xc1, 0x15, 0xa5, 0xf6, 0x25, 0x9c, 0x0e, 0x56, 0xf2, 0x74, 0x78, 0xb1, 0xcf,
+	0xf1, 0x15, 0x1c, 0x0e, 0x5e, 0x62, 0x58, 0x8c, 0x71, 0x3f, 0x0b, 0xb0, 0xc3, 0xd8, 0xc9, 0x46,
+	0x2c, 0x27, 0xc9, 0x25, 0x5f, 0x92, 0x10, 0xac, 0xd4, 0x4a, 0xf0, 0xac, 0x4a, 0x4f, 0x9e, 0xf5,
+	0x5f, 0xab, 0x30, 0x9d, 0x99, 0xf6, 0x2c, 0x1f, 0xd1, 0xbe, 0x46, 0x7c, 0xa4, 0xf2, 0xb5, 0xe0,
+	0x23, 0xd5, 0x52, 0x7c, 0xe4, 0xd4, 0xe7, 0x04, 0xf2, 0x01, 0xb5, 0xec, 0x26, 0x6f, 0xd6, 0x08,
+	0x0d, 0x3f, 0xdc, 0xb2, 0x5b, 0x44, 0x70, 0x9c, 0x6f, 0x38, 0xdd, 0x96, 0xa5, 0x2d, 0x38, 0xe3,
+	0x59, 0xcf, 0x60, 0xc2, 0x39, 0xd8, 0xf5, 0xdf, 0x1b, 0x00, 0xa8, 0x2d, 0x62, 0x2f, 0xe4, 0x9d,
+	0x7d, 0x11, 0x06, 0xdb, 0x7b, 0x46, 0x10, 0xed, 0xa7, 0xa7, 0xa2, 0xcd, 0xb8, 0x49, 0x0b, 0x1f,
+	0x1c, 0xcd, 0xcf, 0xd6, 0x7c, 0x62, 0x11, 0x37, 0xb4, 0x0d, 0x27, 0x88, 0x1a, 0x31, 0x18, 0xe6,
+	0xed, 0xe8, 0x18, 0xe8, 0x34, 0xd6, 0xbc, 0x56, 0xdb, 0x21, 0x14, 0xca, 0xc6, 0x50, 0x29, 0x37,
+	0x86, 0xb5, 0x0c, 0x26, 0x9c, 0x83, 0x3d, 0xa2, 0x59, 0x77, 0xed, 0xd0, 0x36, 0x24, 0xcd, 0x6a,
+	0x79, 0x9a, 0x49, 0x4c, 0x38, 0x07, 0x3b, 0xfa, 0xa4, 0x06, 0x73, 0xc9, 0xe2, 0x55, 0xdb, 0xb5,
+	0x83, 0x3d, 0x62, 0x31, 0xe2, 0x03, 0x67, 0x26, 0xfe, 0xd8, 0xf1, 0xd1, 0xfc, 0xdc, 0x5a, 0x21,
+	0x46, 0xdc, 0x83, 0x1a, 0xfa, 0x94, 0x06, 0x0f, 0xa7, 0xe6, 0xc5, 0xb7, 0x9b, 0x4d, 0xe2, 0x8b,
+	0xde, 0x9c, 0x7d, 0x0b, 0xcd, 0x1f, 0x1f, 0xcd, 0x3f, 0xbc, 0x56, 0x8c, 0x12, 0xf7, 0xa2, 0xa7,
+	0x7f, 0x41, 0x83, 0x6a, 0x0d, 0xd7, 0xd1, 0xd3, 0x09, 0x25, 0xee, 0x86, 0xaa, 0xc4, 0x3d, 0x38,
+	0x9a, 0x1f, 0xae, 0xe1, 0xba, 0xa2, 0xcf, 0x7d, 0x4a, 0x83, 0x69, 0xd3, 0x73, 0x43, 0x83, 0xf6,
+	0x0b, 0x73, 0x49, 0x27, 0xe2, 0xaa, 0xa5, 0xf4, 0x97, 0x5a, 0x0a, 0xd9, 0xd2, 0x43, 0xa2, 0x03,
+	0xd3, 0x69, 0x48, 0x80, 0xb3, 0x94, 0xf5, 0x2f, 0x6b, 0x30, 0x5e, 0x73, 0xbc, 0x8e, 0xb5, 0xe9,
+	0x7b, 0xbb, 0xb6, 0x43, 0xde, 0x1e, 0x4a, 0x9b, 0xda, 0xe3, 0xa2, 0x43, 0x99, 0x29, 0x51, 0x6a,
+	0xc5, 0xb7, 0x89, 0x12, 0xa5, 0x76, 0xb9, 0xe0, 0x9c, 0xfc, 0xd1, 0xe1, 0xe4, 0xc8, 0xd8, 0x49,
+	0xf9, 0x24, 0x8c, 0x98, 0xc6, 0x52, 0xc7, 0xb5, 0x1c, 0xa9, 0x45, 0xd1, 0x5e, 0xd6, 0x16, 0x79,
+	0x19, 0x96, 0x50, 0xf4, 0x26, 0x40, 0x6c, 0x50, 0x13, 0xcb, 0xb0, 0xda, 0x9f, 0x11, 0xaf, 0x41,
+	0xc2, 0xd0, 0x76, 0x9b, 0x41, 0xbc, 0xf4, 0x31, 0x0c, 0x2b, 0xd4, 0xd0, 0x77, 0xc1, 0x84, 0x98,
+	0xe4, 0x7a, 0xcb, 0x68, 0x0a, 0x7b, 0x43, 0xc9, 0x99, 0x5a, 0x57, 0x10, 0x2d, 0xcd, 0x08, 0xc2,
+	0x13, 0x6a, 0x69, 0x80, 0x93, 0xd4, 0x50, 0x17, 0xc6, 0x5b, 0xaa, 0x0d, 0x65, 0xa0, 0xbc, 0x38,
+	0xa3, 0xd8, 0x53, 0x96, 0xae, 0x09, 0xe2, 0xe3, 0x09, 0xeb, 0x4b, 0x82, 0x54, 0x8e, 0x2a, 0x38,
+	0x78, 0x51, 0xaa, 0x20, 0x81, 0x61, 0xae, 0x0c, 0x07, 0xb3, 0x43, 0x6c, 0x80, 0xcf, 0x97, 0x19,
+	0x20, 0xd7, 0xab, 0x63, 0x0b, 0x31, 0xff, 0x1d, 0xe0, 0x08, 0x37, 0x3a, 0x80, 0x71, 0x7a, 0xaa,
+	0x37, 0x88, 0x43, 0xcc, 0xd0, 0xf3, 0x67, 0x87, 0xcb, 0x5b, 0x60, 0x1b, 0x0a, 0x1e, 0x6e, 0x4a,
+	0x53, 0x4b, 0x70, 0x82, 0x8e, 0xb4, 0x15, 0x8c, 0x14, 0xda, 0x0a, 0x3a, 0x30, 0x76, 0xa0, 0xd8,
+	0xb4, 0x46, 0xd9, 0x24, 0x7c, 0xa8, 0x4c, 0xc7, 0x62, 0x03, 0xd7, 0xd2, 0x55, 0x41, 0x68, 0x4c,
+	0x35, 0x86, 0xa9, 0x74, 0xf4, 0x9f, 0x1f, 0x83, 0xe9, 0x9a, 0xd3, 0x09, 0x42, 0xe2, 0x2f, 0x8a,
+	0x4b, 0x22, 0xe2, 0xa3, 0xef, 0xd5, 0xe0, 0x3a, 0xfb, 0x77, 0xd9, 0xbb, 0xef, 0x2e, 0x13, 0xc7,
+	0xe8, 0x2e, 0xee, 0xd2, 0x1a, 0x96, 0x75, 0x36, 0x0e, 0xb4, 0xdc, 0x11, 0x52, 0x24, 0x33, 0xce,
+	0x35, 0x72, 0x31, 0xe2, 0x02, 0x4a, 0xe8, 0x87, 0x34, 0x78, 0x28, 0x07, 0xb4, 0x4c, 0x1c, 0x12,
+	0x46, 0x92, 0xcb, 0x59, 0xfb, 0xf1, 0xe8, 0xf1, 0xd1, 0xfc, 0x43, 0x8d, 0x22, 0xa4, 0xb8, 0x98,
+	0x1e, 0xfa, 0x07, 0x1a, 0xcc, 0xe5, 0x40, 0x57, 0x0d, 0xdb, 0xe9, 0xf8, 0x91, 0x50, 0x73, 0xd6,
+	0xee, 0x30, 0xd9, 0xa2, 0x51, 0x88, 0x15, 0xf7, 0xa0, 0x88, 0xbe, 0x1b, 0x66, 0x24, 0x74, 0xdb,
+	0x75, 0x09, 0xb1, 0x12, 0x22, 0xce, 0x59, 0xbb, 0xf2, 0xd0, 0xf1, 0xd1, 0xfc, 0x4c, 0x23, 0x0f,
+	0x21, 0xce, 0xa7, 0x83, 0x9a, 0xf0, 0x68, 0x0c, 0x08, 0x6d, 0xc7, 0x7e, 0x93, 0x4b, 0x61, 0x7b,
+	0x3e, 0x09, 0xf6, 0x3c, 0xc7, 0x62, 0xcc, 0x42, 0x5b, 0x7a, 0xe7, 0xf1, 0xd1, 0xfc, 0xa3, 0x8d,
+	0x5e, 0x15, 0x71, 0x6f, 0x3c, 0xc8, 0x82, 0xf1, 0xc0, 0x34, 0xdc, 0xba, 0x1b, 0x12, 0xff, 0xc0,
+	0x70, 0x66, 0x87, 0x4a, 0x0d, 0x90, 0x7f, 0xa2, 0x0a, 0x1e, 0x9c, 0xc0, 0x8a, 0x3e, 0x00, 0x23,
+	0xe4, 0xb0, 0x6d, 0xb8, 0x16, 0xe1, 0x6c, 0x61, 0x74, 0xe9, 0x11, 0x7a, 0x18, 0xad, 0x88, 0xb2,
+	0x07, 0x47, 0xf3, 0xe3, 0xd1, 0xff, 0xeb, 0x9e, 0x45, 0xb0, 0xac, 0x8d, 0x3e, 0x06, 0xd7, 0xd8,
+	0x7d, 0x98, 0x45, 0x18, 0x93, 0x0b, 0x22, 0x41, 0x77, 0xa4, 0x54, 0x3f, 0xd9, 0xdd, 0xc6, 0x7a,
+	0x0e, 0x3e, 0x9c, 0x4b, 0x85, 0x2e, 0x43, 0xcb, 0x38, 0xbc, 0xed, 0x1b, 0x26, 0xd9, 0xed, 0x38,
+	0x5b, 0xc4, 0x6f, 0xd9, 0x2e, 0xd7, 0x25, 0x88, 0xe9, 0xb9, 0x16, 0x65, 0x25, 0xda, 0x93, 0x83,
+	0x7c, 0x19, 0xd6, 0x7b, 0x55, 0xc4, 0xbd, 0xf1, 0xa0, 0xf7, 0xc2, 0xb8, 0xdd, 0x74, 0x3d, 0x9f,
+	0x6c, 0x19, 0xb6, 0x1b, 0x06, 0xb3, 0xc0, 0xcc, 0xee, 0x6c, 0x5a, 0xeb, 0x4a, 0x39, 0x4e, 0xd4,
+	0x42, 0x07, 0x80, 0x5c, 0x72, 0x7f, 0xd3, 0xb3, 0xd8, 0x16, 0xd8, 0x6e, 0xb3, 0x8d, 0x3c, 0x3b,
+	0x56, 0x6a, 0x6a, 0x98, 0x1e, 0xb0, 0x91, 0xc1, 0x86, 0x73, 0x28, 0xa0, 0x55, 0x40, 0x2d, 0xe3,
+	0x70, 0xa5, 0xd5, 0x0e, 0xbb, 0x4b, 0x1d, 0x67, 0x5f, 0x70, 0x8d, 0x71, 0x36, 0x17, 0x5c, 0x0f,
+	0xcb, 0x40, 0x71, 0x4e, 0x0b, 0x64, 0xc0, 0xc3, 0x7c, 0x3c, 0xcb, 0x06, 0x69, 0x79, 0x6e, 0x40,
+	0xc2, 0x40, 0xd9, 0xa4, 0xb3, 0x13, 0xec, 0x16, 0x8b, 0x49, 0xe5, 0xf5, 0xe2, 0x6a, 0xb8, 0x17,
+	0x8e, 0xe4, 0xbd, 0xf0, 0x64, 0xef, 0x7b, 0x61, 0xfd, 0x7f, 0x0d, 0xc0, 0x6c, 0x86, 0x61, 0xdf,
+	0x6b, 0x87, 0xec, 0x78, 0x3b, 0xf1, 0x93, 0xd4, 0xce, 0xe9, 0x93, 0x6c, 0xc3, 0x4d, 0x59, 0xe1,
+	0x76, 0xbb, 0x93, 0x4b, 0xab, 0xc2, 0x68, 0x3d, 0x71, 0x7c, 0x34, 0x7f, 0xb3, 0x71, 0x42, 0x5d,
+	0x7c, 0x22, 0xb6, 0x62, 0x76, 0x57, 0xbd, 0x24, 0x76, 0xf7, 0x31, 0xb8, 0xa6, 0x00, 0x7c, 0x62,
+	0x58, 0xdd, 0x3e, 0xd8, 0x2d, 0xfb, 0xca, 0x1b, 0x39, 0xf8, 0x70, 0x2e, 0x95, 0x42, 0x1e, 0x33,
+	0x78, 0x19, 0x3c, 0x46, 0x3f, 0xaa, 0xc2, 0x68, 0xcd, 0x73, 0x2d, 0x9b, 0xed, 0xd7, 0x67, 0x12,
+	0x17, 0x1f, 0x8f, 0xaa, 0xc2, 0xcc, 0x83, 0xa3, 0xf9, 0x09, 0x59, 0x51, 0x91, 0x6e, 0x9e, 0x93,
+	0xd6, 0x46, 0x6e, 0xdd, 0x7a, 0x67, 0xd2, 0x4c, 0xf8, 0xe0, 0x68, 0xfe, 0x8a, 0x6c, 0x96, 0xb4,
+	0x1c, 0x52, 0x06, 0x42, 0x55, 0xda, 0x2d, 0xdf, 0x70, 0x03, 0xbb, 0x0f, 0x23, 0x82, 0x34, 0x0f,
+	0xad, 0x65, 0xb0, 0xe1, 0x1c, 0x0a, 0xe8, 0x75, 0x98, 0xa4, 0xa5, 0xdb, 0x6d, 0xcb, 0x08, 0x49,
+	0x49, 0xdb, 0xc1, 0x75, 0x41, 0x73, 0x72, 0x2d, 0x81, 0x09, 0xa7, 0x30, 0xf3, 0x8b, 0x22, 0x23,
+	0xf0, 0x5c, 0xb6, 0x9e, 0x89, 0x8b, 0x22, 0x5a, 0x8a, 0x05, 0x14, 0x3d, 0x05, 0xc3, 0x2d, 0x12,
+	0x04, 0x46, 0x93, 0xb0, 0x43, 0x70, 0x34, 0x96, 0x74, 0xd7, 0x79, 0x31, 0x8e, 0xe0, 0xe8, 0x3d,
+	0x30, 0x68, 0x7a, 0x16, 0x09, 0x66, 0x87, 0x19, 0x9b, 0xa6, 0x2c, 0x6f, 0xb0, 0x46, 0x0b, 0x1e,
+	0x1c, 0xcd, 0x8f, 0x32, 0x63, 0x1a, 0xfd, 0x85, 0x79, 0x25, 0xfd, 0x27, 0xa9, 0xe2, 0x99, 0xd2,
+	0xb4, 0x4f, 0x71, 0xc1, 0x75, 0x79, 0x77, 0x45, 0xfa, 0x67, 0xa8, 0xd6, 0xef, 0xb9, 0xa1, 0xef,
+	0x39, 0x9b, 0x8e, 0xe1, 0x12, 0xf4, 0x03, 0x1a, 0x4c, 0xed, 0xd9, 0xcd, 0x3d, 0xf5, 0x86, 0x5a,
+	0x48, 0xa7, 0xa5, 0x14, 0xf4, 0x3b, 0x29, 0x5c, 0x4b, 0xd7, 0x8e, 0x8f, 0xe6, 0xa7, 0xd2, 0xa5,
+	0x38, 0x43, 0x53, 0xff, 0x44, 0x05, 0xae, 0x89, 0x9e, 0x39, 0x54, 0x5c, 0x6c, 0x3b, 0x5e, 0xb7,
+	0x45, 0xdc, 0xcb, 0xb8, 0x4c, 0x8e, 0x56, 0xa8, 0x52, 0xb8, 0x42, 0xad, 0xcc, 0x0a, 0x55, 0xcb,
+	0xac, 0x90, 0xdc, 0xc8, 0x27, 0xac, 0xd2, 0x9f, 0x68, 0x30, 0x9b, 0x37, 0x17, 0x97, 0x60, 0xc8,
+	0x68, 0x25, 0x0d, 0x19, 0x77, 0xca, 0x5a, 0xa6, 0xd2, 0x5d, 0x2f, 0x30, 0x68, 0xfc, 0x71, 0x05,
+	0xae, 0xc7, 0xd5, 0xeb, 0x6e, 0x10, 0x1a, 0x8e, 0xc3, 0xcf, 0xf3, 0x8b, 0x5f, 0xf7, 0x76, 0xc2,
+	0x1e, 0xb5, 0xd1, 0xdf, 0x50, 0xd5, 0xbe, 0x17, 0x5e, 0x17, 0x1d, 0xa6, 0xae, 0x8b, 0x36, 0xcf,
+	0x91, 0x66, 0xef, 0x9b, 0xa3, 0xff, 0xa6, 0xc1, 0x5c, 0x7e, 0xc3, 0x4b, 0xd8, 0x54, 0x5e, 0x72,
+	0x53, 0x7d, 0xe4, 0xfc, 0x46, 0x5d, 0xb0, 0xad, 0x7e, 0xa9, 0x52, 0x34, 0x5a, 0x66, 0x31, 0xdb,
+	0x85, 0x2b, 0x3e, 0x69, 0xda, 0x41, 0x28, 0xee, 0x35, 0xce, 0xe6, 0xf0, 0x13, 0x19, 0x7a, 0xaf,
+	0xe0, 0x24, 0x0e, 0x9c, 0x46, 0x8a, 0x36, 0x60, 0x38, 0x20, 0xc4, 0xa2, 0xf8, 0x2b, 0xa7, 0xc7,
+	0x2f, 0x4f, 0xa3, 0x06, 0x6f, 0x8b, 0x23, 0x24, 0xe8, 0xdb, 0x61, 0xc2, 0x92, 0x5f, 0xd4, 0x09,
+	0xb7, 0xfd, 0x69, 0xac, 0xec, 0x06, 0x6a, 0x59, 0x6d, 0x8d, 0x93, 0xc8, 0xf4, 0xbf, 0xd4, 0xe0,
+	0x91, 0x5e, 0x7b, 0x0b, 0xbd, 0x01, 0x60, 0x46, 0xe2, 0x05, 0xf7, 0xf7, 0x2a, 0x79, 0x47, 0x25,
+	0x85, 0x94, 0xf8, 0x03, 0x95, 0x45, 0x01, 0x56, 0x88, 0xe4, 0x38, 0x11, 0x54, 0x2e, 0xc8, 0x89,
+	0x40, 0xff, 0xef, 0x9a, 0xca, 0x8a, 0xd4, 0xb5, 0x7d, 0xbb, 0xb1, 0x22, 0xb5, 0xef, 0x85, 0x46,
+	0xf2, 0xdf, 0xaf, 0xc0, 0xcd, 0xfc, 0x26, 0xca, 0xd9, 0xfb, 0x61, 0x18, 0x6a, 0x73, 0xa7, 0xbc,
+	0x2a, 0x3b, 0x1b, 0x9f, 0xa4, 0x9c, 0x85, 0xbb, 0xcc, 0x3d, 0x38, 0x9a, 0x9f, 0xcb, 0x63, 0xf4,
+	0xc2, 0xd9, 0x4e, 0xb4, 0x43, 0x76, 0xca, 0x54, 0xc8, 0xa5, 0xbf, 0x6f, 0x3e, 0x25, 0x73, 0x31,
+	0x76, 0x88, 0x73, 0x6a, 0xeb, 0xe0, 0xc7, 0x35, 0x98, 0x4c, 0xec, 0xe8, 0x60, 0x76, 0x90, 0xed,
+	0xd1, 0x52, 0xf7, 0xb7, 0x89, 0x4f, 0x25, 0x3e, 0xb9, 0x13, 0xc5, 0x01, 0x4e, 0x11, 0x4c, 0xb1,
+	0x59, 0x75, 0x56, 0xdf, 0x76, 0x6c, 0x56, 0xed, 0x7c, 0x01, 0x9b, 0xfd, 0xf1, 0x4a, 0xd1, 0x68,
+	0x19, 0x9b, 0xbd, 0x0f, 0xa3, 0x91, 0xbb, 0x7a, 0xc4, 0x2e, 0x56, 0xfb, 0xed, 0x13, 0x47, 0x17,
+	0xfb, 0x2e, 0x45, 0x25, 0x01, 0x8e, 0x69, 0xa1, 0xef, 0xd3, 0x00, 0xe2, 0x85, 0x11, 0x1f, 0xd5,
+	0xd6, 0xf9, 0x4d, 0x87, 0x22, 0xd6, 0x4c, 0xd2, 0x4f, 0x5a, 0xd9, 0x14, 0x0a, 0x5d, 0xfd, 0x2f,
+	0xaa, 0x80, 0xb2, 0x7d, 0xa7, 0xe2, 0xe6, 0xbe, 0xed, 0x5a, 0x69, 0x85, 0xe0, 0xae, 0xed, 0x5a,
+	0x98, 0x41, 0x4e, 0x21, 0x90, 0xbe, 0x00, 0x57, 0x9a, 0x8e, 0xb7, 0x63, 0x38, 0x4e, 0x57, 0xf8,
+	0x6f, 0x0b, 0x4f, 0xe0, 0xab, 0xf4, 0x60, 0xba, 0x9d, 0x04, 0xe1, 0x74, 0x5d, 0xd4, 0x86, 0x29,
+	0x9f, 0x98, 0x9e, 0x6b, 0xda, 0x0e, 0x53, 0x9d, 0xbc, 0x4e, 0x58, 0x52, 0x03, 0x67, 0xe2, 0x3d,
+	0x4e, 0xe1, 0xc2, 0x19, 0xec, 0xe8, 0x5d, 0x30, 0xdc, 0xf6, 0xed, 0x96, 0xe1, 0x77, 0x99, 0x72,
+	0x36, 0xb2, 0x34, 0x46, 0x4f, 0xb8, 0x4d, 0x5e, 0x84, 0x23, 0x18, 0xfa, 0x18, 0x8c, 0x3a, 0xf6,
+	0x2e, 0x31, 0xbb, 0xa6, 0x43, 0x84, 0x85, 0xf2, 0xde, 0xf9, 0x6c, 0x99, 0xb5, 0x08, 0xad, 0xf0,
+	0x8b, 0x88, 0x7e, 0xe2, 0x98, 0x20, 0xaa, 0xc3, 0xd5, 0xfb, 0x9e, 0xbf, 0x4f, 0x7c, 0x87, 0x04,
+	0x41, 0xa3, 0xd3, 0x6e, 0x7b, 0x7e, 0x48, 0x2c, 0x66, 0xc7, 0x1c, 0xe1, 0x4e, 0xea, 0x2f, 0x67,
+	0xc1, 0x38, 0xaf, 0x8d, 0xfe, 0xc9, 0x0a, 0x3c, 0xdc, 0xa3, 0x13, 0x08, 0xd3, 0x6f, 0x43, 0xcc,
+	0x91, 0xd8, 0x09, 0xef, 0xe5, 0xfb, 0x59, 0x14, 0x3e, 0x38, 0x9a, 0x7f, 0xbc, 0x07, 0x82, 0x06,
+	0xdd, 0x8a, 0xa4, 0xd9, 0xc5, 0x31, 0x1a, 0x54, 0x87, 0x21, 0x2b, 0x36, 0xeb, 0x8f, 0x2e, 0x3d,
+	0x43, 0xb9, 0x35, 0x37, 0xc0, 0x9d, 0x16, 0x9b, 0x40, 0x80, 0xd6, 0x60, 0x98, 0x7b, 0x53, 0x10,
+	0xc1, 0xf9, 0x9f, 0x65, 0xea, 0x31, 0x2f, 0x3a, 0x2d, 0xb2, 0x08, 0x85, 0xfe, 0x7f, 0x34, 0x18,
+	0xae, 0x79, 0x3e, 0x59, 0xde, 0x68, 0xa0, 0x2e, 0x8c, 0x29, 0xef, 0x68, 0x04, 0x17, 0x2c, 0xc9,
+	0x16, 0x18, 0xc6, 0xc5, 0x18, 0x5b, 0xe4, 0xf3, 0x2d, 0x0b, 0xb0, 0x4a, 0x0b, 0xbd, 0x41, 0xe7,
+	0xfc, 0xbe, 0x6f, 0x87, 0x94, 0x70, 0x3f, 0x97, 0xd0, 0x9c, 0x30, 0x8e, 0x70, 0xf1, 0x1d, 0x25,
+	0x7f, 0xe2, 0x98, 0x8a, 0xbe, 0x49, 0x39, 0x40, 0xba, 0x9b, 0xe8, 0x79, 0x18, 0x68, 0x79, 0x56,
+	0xb4, 0xee, 0xef, 0x8e, 0xbe, 0xef, 0x75, 0xcf, 0xa2, 0x73, 0x7b, 0x3d, 0xdb, 0x82, 0x99, 0xca,
+	0x59, 0x1b, 0x7d, 0x03, 0xa6, 0xd2, 0xf4, 0xd1, 0xf3, 0x30, 0x69, 0x7a, 0xad, 0x96, 0xe7, 0x36,
+	0x3a, 0xbb, 0xbb, 0xf6, 0x21, 0x49, 0x38, 0xe3, 0xd7, 0x12, 0x10, 0x9c, 0xaa, 0xa9, 0xff, 0x98,
+	0x06, 0x55, 0xba, 0x2e, 0x3a, 0x0c, 0x59, 0x5e, 0xcb, 0xb0, 0x5d, 0xd1, 0x2b, 0xf6, 0xf0, 0x60,
+	0x99, 0x95, 0x60, 0x01, 0x41, 0x6d, 0x18, 0x8d, 0x84, 0xa6, 0xbe, 0x1c, 0xc2, 0x96, 0x37, 0x1a,
+	0xd2, 0x89, 0x56, 0x72, 0xf2, 0xa8, 0x24, 0xc0, 0x31, 0x11, 0xdd, 0x80, 0xe9, 0xe5, 0x8d, 0x46,
+	0xdd, 0x35, 0x9d, 0x8e, 0x45, 0x56, 0x0e, 0xd9, 0x1f, 0xca, 0x4b, 0x6c, 0x5e, 0x22, 0xc6, 0xc9,
+	0x78, 0x89, 0xa8, 0x84, 0x23, 0x18, 0xad, 0x46, 0x78, 0x0b, 0xe1, 0x31, 0xcf, 0xaa, 0x09, 0x24,
+	0x38, 0x82, 0xe9, 0x5f, 0xae, 0xc0, 0x98, 0xd2, 0x21, 0xe4, 0xc0, 0x30, 0x1f, 0x6e, 0xe4, 0xb0,
+	0xba, 0x52, 0x72, 0x88, 0xc9, 0x5e, 0x73, 0xea, 0x7c, 0x42, 0x03, 0x1c, 0x91, 0x50, 0xf9, 0x62,
+	0xa5, 0x07, 0x5f, 0x5c, 0x00, 0x08, 0xe2, 0xe7, 0x1b, 0xfc, 0x93, 0x64, 0x47, 0x8f, 0xf2, 0x68,
+	0x43, 0xa9, 0x81, 0x1e, 0x11, 0x27, 0x08, 0xf7, 0xc8, 0x1a, 0x49, 0x9d, 0x1e, 0xbb, 0x30, 0xf8,
+	0xa6, 0xe7, 0x92, 0x40, 0xd8, 0x3d, 0xcf, 0x69, 0x80, 0xa3, 0x54, 0x3e, 0x78, 0x95, 0xe2, 0xc5,
+	0x1c, 0xbd, 0xfe, 0x53, 0x1a, 0xc0, 0xb2, 0x11, 0x1a, 0xfc, 0xde, 0xf4, 0x14, 0x8f, 0x1e, 0x1e,
+	0x49, 0x1c, 0x7c, 0x23, 0x19, 0x47, 0xf0, 0x81, 0xc0, 0x7e, 0x33, 0x1a, 0xbe, 0x14, 0xa8, 0x39,
+	0xf6, 0x86, 0xfd, 0x26, 0xc1, 0x0c, 0x8e, 0x9e, 0x86, 0x51, 0xe2, 0x9a, 0x7e, 0xb7, 0x4d, 0x99,
+	0xf7, 0x00, 0x9b, 0x55, 0xf6, 0x85, 0xae, 0x44, 0x85, 0x38, 0x86, 0xeb, 0xcf, 0x40, 0x52, 0x2b,
+	0x3a, 0xb9, 0x97, 0xfa, 0x57, 0x07, 0xe0, 0xa1, 0x95, 0xad, 0xda, 0xb2, 0xc0, 0x67, 0x7b, 0xee,
+	0x5d, 0xd2, 0xfd, 0x5b, 0x1f, 0xb3, 0xbf, 0xf5, 0x31, 0x3b, 0x47, 0x1f, 0xb3, 0xcf, 0x6a, 0x30,
+	0x15, 0xef, 0x2f, 0xe1, 0xde, 0xf1, 0x74, 0x5a, 0xa0, 0x1e, 0x8d, 0x8e, 0x9e, 0x1c, 0x21, 0xf8,
+	0x15, 0xa8, 0xee, 0xb7, 0x82, 0x7e, 0x5c, 0x49, 0xef, 0xae, 0x37, 0x38, 0xe1, 0xa5, 0xe1, 0xe3,
+	0xa3, 0xf9, 0xea, 0xdd, 0xf5, 0x06, 0xa6, 0x28, 0xf5, 0x07, 0xb4, 0x6f, 0x87, 0x6d, 0xdb, 0x67,
+	0x0f, 0x81, 0x88, 0x4f, 0x55, 0x6c, 0xf4, 0x14, 0x0c, 0x1f, 0xf0, 0x7f, 0xc5, 0xc6, 0x97, 0x66,
+	0x0c, 0x51, 0x03, 0x47, 0x70, 0xb4, 0x0b, 0x93, 0x84, 0x35, 0x67, 0xb2, 0xb4, 0x11, 0x96, 0xd9,
+	0xdc, 0xfc, 0x9d, 0x59, 0x02, 0x0b, 0x4e, 0x61, 0x45, 0x0d, 0x98, 0x34, 0x1d, 0x23, 0x08, 0xec,
+	0x5d, 0xdb, 0x8c, 0x5d, 0x5c, 0x47, 0x97, 0x9e, 0x66, 0xc7, 0x62, 0x02, 0xf2, 0xe0, 0x68, 0x7e,
+	0x46, 0xf4, 0x33, 0x09, 0xc0, 0x29, 0x14, 0xfa, 0x67, 0x2b, 0x30, 0xb1, 0x72, 0xd8, 0xf6, 0x82,
+	0x8e, 0x4f, 0x58, 0xd5, 0x4b, 0xb0, 0x0e, 0x3c, 0x05, 0xc3, 0x7b, 0x86, 0x6b, 0x39, 0xc4, 0x17,
+	0x9c, 0x51, 0xce, 0xed, 0x1d, 0x5e, 0x8c, 0x23, 0x38, 0x7a, 0x0b, 0x20, 0x30, 0xf7, 0x88, 0xd5,
+	0x61, 0xd2, 0x15, 0xff, 0x80, 0xef, 0x96, 0x59, 0xfc, 0xc4, 0x18, 0x1b, 0x12, 0xa5, 0x38, 0x75,
+	0xe4, 0x6f, 0xac, 0x90, 0xd3, 0xbf, 0xa2, 0xc1, 0x74, 0xa2, 0xdd, 0x25, 0x28, 0xbd, 0xbb, 0x49,
+	0xa5, 0x77, 0xb1, 0xef, 0xb1, 0x16, 0xe8, 0xba, 0x3f, 0x58, 0x81, 0x1b, 0x05, 0x73, 0x92, 0xf1,
+	0x87, 0xd2, 0x2e, 0xc9, 0x1f, 0xaa, 0x03, 0x63, 0xa1, 0xe7, 0x08, 0x4f, 0xec, 0x68, 0x06, 0x4a,
+	0x79, 0x3b, 0x6d, 0x49, 0x34, 0xb1, 0xb7, 0x53, 0x5c, 0x16, 0x60, 0x95, 0x8e, 0xfe, 0x05, 0x0d,
+	0x46, 0xa5, 0x6d, 0xed, 0xeb, 0xea, 0x7e, 0xeb, 0xf4, 0x4f, 0x63, 0xf5, 0xdf, 0xaa, 0xc0, 0x75,
+	0x89, 0x3b, 0x62, 0xa0, 0x8d, 0x90, 0xf2, 0x8d, 0x93, 0x15, 0xf4, 0x47, 0x84, 0x8c, 0xa0, 0xc8,
+	0x29, 0x8a, 0x14, 0x43, 0x65, 0xba, 0x8e, 0xdf, 0xf6, 0x82, 0x48, 0x54, 0xe1, 0x32, 0x1d, 0x2f,
+	0xc2, 0x11, 0x0c, 0x6d, 0xc0, 0x60, 0x40, 0xe9, 0x89, 0x93, 0xee, 0x8c, 0xb3, 0xc1, 0xa4, 0x2d,
+	0xd6, 0x5f, 0xcc, 0xd1, 0xa0, 0xb7, 0xd4, 0xd3, 0x61, 0xb0, 0xbc, 0x09, 0x88, 0x8e, 0xc4, 0x8a,
+	0x66, 0x24, 0xe7, 0xb9, 0x58, 0xde, 0x69, 0xa3, 0xaf, 0xc1, 0x94, 0x70, 0xa9, 0xe2, 0xdb, 0xc6,
+	0x35, 0x09, 0xfa, 0x40, 0x62, 0x67, 0x3c, 0x91, 0xba, 0xe1, 0xbe, 0x96, 0xae, 0x1f, 0xef, 0x18,
+	0x3d, 0x80, 0x91, 0xdb, 0xa2, 0x93, 0x68, 0x0e, 0x2a, 0x76, 0xb4, 0x16, 0x20, 0x70, 0x54, 0xea,
+	0xcb, 0xb8, 0x62, 0x5b, 0x52, 0x56, 0xab, 0x14, 0x4a, 0x94, 0xca, 0xb1, 0x54, 0xed, 0x7d, 0x2c,
+	0xe9, 0x7f, 0x54, 0x81, 0x6b, 0x11, 0xd5, 0x68, 0x8c, 0xcb, 0xe2, 0x7e, 0xf0, 0x04, 0xb9, 0xf5,
+	0x64, 0x83, 0xcd, 0x3d, 0x18, 0x60, 0x0c, 0xb0, 0xd4, 0xbd, 0xa1, 0x44, 0x48, 0xbb, 0x83, 0x19,
+	0x22, 0xf4, 0x31, 0x18, 0x72, 0x8c, 0x1d, 0xe2, 0x44, 0xae, 0xac, 0xa5, 0xcc, 0x5b, 0x79, 0xc3,
+	0xe5, 0x56, 0xd7, 0x80, 0x3f, 0xd7, 0x91, 0xd7, 0x49, 0xbc, 0x10, 0x0b, 0x9a, 0x73, 0xcf, 0xc1,
+	0x98, 0x52, 0x0d, 0x4d, 0x41, 0x75, 0x9f, 0xf0, 0x7b, 0xe3, 0x51, 0x4c, 0xff, 0x45, 0xd7, 0x60,
+	0xf0, 0xc0, 0x70, 0x3a, 0x62, 0x4a, 0x30, 0xff, 0xf1, 0x7c, 0xe5, 0x03, 0x9a, 0xfe, 0xb3, 0x15,
+	0x18, 0xbb, 0x63, 0xef, 0x10, 0x9f, 0xfb, 0x45, 0x31, 0x35, 0x2d, 0x11, 0x99, 0x60, 0x2c, 0x2f,
+	0x2a, 0x01, 0x3a, 0x84, 0x51, 0x71, 0xd2, 0x48, 0xb7, 0xf9, 0xdb, 0xe5, 0x2e, 0xa8, 0x25, 0x69,
+	0xc1, 0xc1, 0xd5, 0x97, 0x90, 0x11, 0x05, 0x1c, 0x13, 0x43, 0x5d, 0x00, 0xdb, 0x72, 0xc8, 0x66,
+	0x6c, 0x08, 0x1f, 0x7b, 0xb6, 0xde, 0x27, 0xe9, 0xba, 0x44, 0xc8, 0x0f, 0xd4, 0xf8, 0x37, 0x56,
+	0x88, 0xe9, 0x1f, 0xd7, 0x60, 0x26, 0xb7, 0x15, 0xda, 0x83, 0x71, 0x5a, 0x2f, 0xb2, 0xc3, 0x95,
+	0x74, 0x28, 0x95, 0xee, 0xcb, 0x75, 0x05, 0x17, 0x4e, 0x60, 0xd6, 0xdf, 0x82, 0xab, 0x39, 0x73,
+	0x86, 0xe6, 0x19, 0xf7, 0xf2, 0x43, 0xf1, 0x55, 0x44, 0xec, 0xc8, 0x0f, 0x31, 0x2f, 0x47, 0x0f,
+	0x41, 0x95, 0xb8, 0x96, 0xf8, 0x24, 0x98, 0x00, 0xb9, 0xe2, 0x5a, 0x98, 0x96, 0x51, 0x2e, 0xed,
+	0x78, 0x09, 0x91, 0x8c, 0x71, 0xe9, 0x35, 0x51, 0x86, 0x25, 0x94, 0x79, 0x54, 0xa4, 0x9d, 0x07,
+	0xa8, 0xe2, 0x30, 0xb5, 0x9b, 0x62, 0x1e, 0xfd, 0xf8, 0x2c, 0xa4, 0x19, 0xd1, 0xd2, 0xac, 0x98,
+	0x96, 0x0c, 0x4b, 0xc3, 0x19, 0xba, 0xfa, 0xaf, 0x0e, 0xc0, 0xa3, 0x77, 0x3c, 0xdf, 0x7e, 0xd3,
+	0x73, 0x43, 0xc3, 0xd9, 0xf4, 0xac, 0xd8, 0x9f, 0x4c, 0x9c, 0x49, 0xdf, 0xaf, 0xc1, 0x0d, 0xb3,
+	0xdd, 0xe1, 0x8a, 0x47, 0xe4, 0x92, 0xb5, 0x49, 0x7c, 0xdb, 0x2b, 0xeb, 0x07, 0xcc, 0x9e, 0xfe,
+	0xd7, 0x36, 0xb7, 0xf3, 0x50, 0xe2, 0x22, 0x5a, 0xcc, 0x1d, 0xd9, 0xf2, 0xee, 0xbb, 0xac, 0x73,
+	0x8d, 0x90, 0xcd, 0xe6, 0x9b, 0xf1, 0x22, 0x94, 0x74, 0x47, 0x5e, 0xce, 0xc5, 0x88, 0x0b, 0x28,
+	0xa1, 0xef, 0x86, 0x19, 0x9b, 0x77, 0x0e, 0x13, 0xc3, 0xb2, 0x5d, 0x12, 0x04, 0xdc, 0x97, 0xb1,
+	0x0f, 0x7f, 0xdb, 0x7a, 0x1e, 0x42, 0x9c, 0x4f, 0x07, 0xbd, 0x06, 0x10, 0x74, 0x5d, 0x53, 0xcc,
+	0x7f, 0x39, 0xc7, 0x2f, 0x2e, 0x03, 0x4b, 0x2c, 0x58, 0xc1, 0x48, 0x75, 0xb4, 0x50, 0x6e, 0xca,
+	0x21, 0xe6, 0xbc, 0xc7, 0x74, 0xb4, 0x78, 0x0f, 0xc5, 0x70, 0xfd, 0x9f, 0x6b, 0x30, 0x2c, 0xc2,
+	0x8b, 0xa0, 0x77, 0xa7, 0x0c, 0x70, 0x92, 0xf5, 0xa6, 0x8c, 0x70, 0x5d, 0x76, 0x0b, 0x2b, 0x8c,
+	0xaf, 0x42, 0x92, 0x2a, 0x65, 0xc1, 0x11, 0x84, 0x63, 0x4b, 0x6e, 0xe2, 0x36, 0x36, 0xb2, 0xee,
+	0x2a, 0xc4, 0xf4, 0xcf, 0x6b, 0x30, 0x9d, 0x69, 0x75, 0x0a, 0x71, 0xe9, 0x12, 0x1d, 0x9c, 0x7e,
+	0x7f, 0x00, 0x26, 0x99, 0x33, 0xb2, 0x6b, 0x38, 0xdc, 0x36, 0x76, 0x09, 0xfa, 0xd9, 0xd3, 0x30,
+	0x6a, 0xb7, 0x5a, 0x9d, 0x90, 0x9e, 0x54, 0xe2, 0x7a, 0x83, 0xad, 0x79, 0x3d, 0x2a, 0xc4, 0x31,
+	0x1c, 0xb9, 0x42, 0x12, 0xe0, 0x67, 0xd8, 0x5a, 0xb9, 0x95, 0x53, 0x07, 0xb8, 0x40, 0x4f, 0x6d,
+	0x7e, 0x5c, 0xe7, 0x09, 0x0a, 0x3f, 0xa0, 0x01, 0x04, 0xa1, 0x6f, 0xbb, 0x4d, 0x5a, 0x28, 0xa4,
+	0x05, 0x7c, 0x0e, 0x64, 0x1b, 0x12, 0x29, 0x27, 0x2e, 0xe7, 0x28, 0x06, 0x60, 0x85, 0x32, 0x5a,
+	0x14, 0x42, 0x12, 0xe7, 0xf8, 0xdf, 0x98, 0x12, 0x07, 0x1f, 0xcd, 0x46, 0xcf, 0x12, 0x4f, 0xce,
+	0x63, 0x29, 0x6a, 0xee, 0xfd, 0x30, 0x2a, 0xe9, 0x9d, 0x24, 0x74, 0x8c, 0x2b, 0x42, 0xc7, 0xdc,
+	0x0b, 0x70, 0x25, 0xd5, 0xdd, 0x33, 0xc9, 0x2c, 0xff, 0x51, 0x03, 0x94, 0x1c, 0xfd, 0x25, 0x68,
+	0xb6, 0xcd, 0xa4, 0x66, 0xbb, 0xd4, 0xff, 0x92, 0x15, 0xa8, 0xb6, 0xff, 0x43, 0x83, 0x51, 0x69,
+	0xeb, 0x39, 0x85, 0x3e, 0xb7, 0x0d, 0x37, 0x4c, 0xc5, 0x86, 0x29, 0x64, 0x47, 0xe5, 0xd5, 0x35,
+	0x3f, 0x9f, 0xf2, 0xab, 0xe0, 0xa2, 0xb6, 0x39, 0x5c, 0xa2, 0x7a, 0x51, 0x5c, 0xe2, 0x2b, 0x93,
+	0xc0, 0xe2, 0x4d, 0xc9, 0x78, 0x5e, 0x62, 0xec, 0x54, 0xb2, 0x88, 0xdf, 0xac, 0x89, 0x5e, 0xf4,
+	0x21, 0x59, 0xdc, 0x4d, 0xe1, 0x8a, 0x25, 0x8b, 0x34, 0x04, 0x67, 0xe8, 0xa2, 0x4f, 0x68, 0x30,
+	0x65, 0x24, 0xe3, 0x4d, 0x45, 0x7b, 0xa1, 0x54, 0x3c, 0x83, 0x54, 0xec, 0xaa, 0xb8, 0x2f, 0x29,
+	0x40, 0x80, 0x33, 0x64, 0xd1, 0x7b, 0x61, 0xdc, 0x68, 0xdb, 0x8b, 0x1d, 0xcb, 0xa6, 0xba, 0x60,
+	0x14, 0x2c, 0x88, 0xd9, 0x27, 0x16, 0x37, 0xeb, 0xb2, 0x1c, 0x27, 0x6a, 0xc9, 0xc0, 0x4e, 0x62,
+	0x22, 0x07, 0xfa, 0x0c, 0xec, 0x24, 0xe6, 0x30, 0x0e, 0xec, 0x24, 0xa6, 0x4e, 0x25, 0x82, 0x5c,
+	0x00, 0xcf, 0xb6, 0x4c, 0x41, 0x92, 0x5f, 0x21, 0x97, 0x32, 0x89, 0xdc, 0xab, 0x2f, 0xd7, 0x04,
+	0x45, 0x76, 0xde, 0xc7, 0xbf, 0xb1, 0x42, 0x01, 0x7d, 0x46, 0x83, 0x09, 0xb1, 0x0f, 0x05, 0xcd,
+	0x61, 0xb6, 0x44, 0xaf, 0x96, 0xdd, 0x2f, 0xa9, 0x3d, 0xb9, 0x80, 0x55, 0xe4, 0x9c, 0xd3, 0xca,
+	0x27, 0x8f, 0x09, 0x18, 0x4e, 0xf6, 0x03, 0xfd, 0x23, 0x0d, 0xae, 0x05, 0xc4, 0x3f, 0xb0, 0x4d,
+	0xb2, 0x68, 0x9a, 0x5e, 0xc7, 0x8d, 0xd6, 0x61, 0xa4, 0x7c, 0x1c, 0x9c, 0x46, 0x0e, 0x3e, 0xe1,
+	0x85, 0x9f, 0x03, 0xc1, 0xb9, 0xf4, 0xa9, 0x20, 0x7a, 0xe5, 0xbe, 0x11, 0x9a, 0x7b, 0x35, 0xc3,
+	0xdc, 0x63, 0x17, 0x37, 0xfc, 0x79, 0x4d, 0xc9, 0x7d, 0xfd, 0x72, 0x12, 0x15, 0x77, 0x81, 0x48,
+	0x15, 0xe2, 0x34, 0x41, 0xe4, 0xc1, 0x88, 0x2f, 0x82, 0xf8, 0xcd, 0x42, 0x79, 0x21, 0x2a, 0x13,
+	0x11, 0x90, 0xab, 0x32, 0xd1, 0x2f, 0x2c, 0x89, 0xa0, 0x26, 0x3c, 0xca, 0x75, 0xd9, 0x45, 0xd7,
+	0x73, 0xbb, 0x2d, 0xaf, 0x13, 0x2c, 0x76, 0xc2, 0x3d, 0xca, 0x08, 0x85, 0x26, 0x34, 0xc6, 0x04,
+	0x07, 0xf6, 0xaa, 0x64, 0xa5, 0x57, 0x45, 0xdc, 0x1b, 0x0f, 0x7a, 0x05, 0x46, 0xc8, 0x01, 0x71,
+	0xc3, 0xad, 0xad, 0x35, 0xf6, 0x52, 0xe7, 0xec, 0xf2, 0x2d, 0x1b, 0xc2, 0x8a, 0xc0, 0x81, 0x25,
+	0x36, 0xb4, 0x0f, 0xc3, 0x0e, 0x8f, 0xc2, 0xc8, 0x5e, 0xec, 0x94, 0x64, 0x8a, 0xe9, 0x88, 0x8e,
+	0x5c, 0xe1, 0x17, 0x3f, 0x70, 0x44, 0x01, 0xb5, 0xe1, 0xa6, 0x45, 0x76, 0x8d, 0x8e, 0x13, 0x6e,
+	0x78, 0x21, 0x66, 0x4f, 0x38, 0xa4, 0x0d, 0x32, 0x7a, 0x94, 0x35, 0xc9, 0x42, 0x56, 0xb0, 0xc7,
+	0x31, 0xcb, 0x27, 0xd4, 0xc5, 0x27, 0x62, 0x43, 0x5d, 0x78, 0x5c, 0xd4, 0x61, 0x6f, 0x46, 0xcc,
+	0x3d, 0x3a, 0xcb, 0x59, 0xa2, 0x57, 0x18, 0xd1, 0xff, 0xef, 0xf8, 0x68, 0xfe, 0xf1, 0xe5, 0x93,
+	0xab, 0xe3, 0xd3, 0xe0, 0x64, 0x6e, 0xf8, 0x24, 0x75, 0xdd, 0x33, 0x3b, 0x55, 0x7e, 0x8e, 0xd3,
+	0x57, 0x47, 0xdc, 0x4f, 0x27, 0x5d, 0x8a, 0x33, 0x34, 0xe7, 0x3e, 0x0c, 0x28, 0xcb, 0x70, 0x4e,
+	0x92, 0x95, 0x46, 0x54, 0x59, 0xe9, 0x73, 0x83, 0xf0, 0x30, 0xe5, 0x63, 0xb1, 0x86, 0xb0, 0x6e,
+	0xb8, 0x46, 0xf3, 0xeb, 0xf3, 0x8c, 0xfd, 0x79, 0x0d, 0x6e, 0xec, 0xe5, 0x6b, 0xef, 0x42, 0x47,
+	0xf9, 0x68, 0x29, 0x4b, 0x4f, 0x2f, 0x83, 0x00, 0xff, 0xc4, 0x7b, 0x56, 0xc1, 0x45, 0x9d, 0x42,
+	0x1f, 0x86, 0x29, 0xd7, 0xb3, 0x48, 0xad, 0xbe, 0x8c, 0xd7, 0x8d, 0x60, 0xbf, 0x11, 0xdd, 0x87,
+	0x0f, 0xf2, 0x15, 0xde, 0x48, 0xc1, 0x70, 0xa6, 0x36, 0x3a, 0x00, 0xd4, 0xf6, 0xac, 0x95, 0x03,
+	0xdb, 0x8c, 0x6e, 0x62, 0xcb, 0x7b, 0x7f, 0xb1, 0xeb, 0xde, 0xcd, 0x0c, 0x36, 0x9c, 0x43, 0x81,
+	0x99, 0x1f, 0x68, 0x67, 0xd6, 0x3d, 0xd7, 0x0e, 0x3d, 0x9f, 0x3d, 0x91, 0xec, 0x4b, 0x0b, 0x67,
+	0xe6, 0x87, 0x8d, 0x5c, 0x8c, 0xb8, 0x80, 0x92, 0xfe, 0x3f, 0x35, 0xb8, 0x42, 0xb7, 0xc5, 0xa6,
+	0xef, 0x1d, 0x76, 0xbf, 0x1e, 0x37, 0xe4, 0x53, 0xc2, 0x35, 0x88, 0x0b, 0xd2, 0x33, 0x8a, 0x5b,
+	0xd0, 0x28, 0xeb, 0x73, 0xec, 0x09, 0xa4, 0x1a, 0x4e, 0xab, 0xc5, 0x86, 0x53, 0xfd, 0x33, 0x15,
+	0x2e, 0xeb, 0x46, 0x96, 0xbb, 0xaf, 0xcb, 0xef, 0xf0, 0xfd, 0x30, 0x41, 0xcb, 0xd6, 0x8d, 0xc3,
+	0xcd, 0xe5, 0x97, 0x3c, 0x27, 0x7a, 0xe0, 0xc6, 0x9c, 0xd6, 0xef, 0xaa, 0x00, 0x9c, 0xac, 0x87,
+	0x9e, 0x87, 0xe1, 0x36, 0x8f, 0x85, 0x21, 0xf4, 0xca, 0x9b, 0xdc, 0x7f, 0x86, 0x15, 0x3d, 0x38,
+	0x9a, 0x9f, 0x8e, 0xaf, 0xe9, 0x44, 0x21, 0x8e, 0x1a, 0xe8, 0x7f, 0x7d, 0x15, 0x18, 0x72, 0x87,
+	0x84, 0x5f, 0x8f, 0x73, 0xf2, 0x0c, 0x8c, 0x99, 0xed, 0x4e, 0x6d, 0xb5, 0xf1, 0xd1, 0x8e, 0xc7,
+	0xec, 0x05, 0x2c, 0x6c, 0x2f, 0x15, 0x7e, 0x6b, 0x9b, 0xdb, 0x51, 0x31, 0x56, 0xeb, 0x50, 0xee,
+	0x60, 0xb6, 0x3b, 0x82, 0xdf, 0x6e, 0xaa, 0x9e, 0xdb, 0x8c, 0x3b, 0xd4, 0x36, 0xb7, 0x13, 0x30,
+	0x9c, 0xa9, 0x8d, 0xbe, 0x1b, 0xc6, 0x89, 0xf8, 0x70, 0xef, 0x18, 0xbe, 0x25, 0xf8, 0x42, 0xbd,
+	0xec, 0xe0, 0xe5, 0xd4, 0x46, 0xdc, 0x80, 0xeb, 0x0c, 0x2b, 0x0a, 0x09, 0x9c, 0x20, 0x88, 0xfe,
+	0x7f, 0x78, 0x28, 0xfa, 0x4d, 0x57, 0xd9, 0xb3, 0xd2, 0x8c, 0x62, 0x90, 0x87, 0x1f, 0x58, 0x29,
+	0xaa, 0x84, 0x8b, 0xdb, 0xa3, 0x9f, 0xd3, 0xe0, 0xba, 0x84, 0xda, 0xae, 0xdd, 0xea, 0xb4, 0x30,
+	0x31, 0x1d, 0xc3, 0x6e, 0x09, 0x4d, 0xe1, 0xe5, 0x73, 0x1b, 0x68, 0x12, 0x3d, 0x67, 0x56, 0xf9,
+	0x30, 0x5c, 0xd0, 0x25, 0xf4, 0x79, 0x0d, 0x6e, 0x46, 0xa0, 0x4d, 0x9f, 0x04, 0x41, 0xc7, 0x27,
+	0xf1, 0xf3, 0x4a, 0x31, 0x25, 0xc3, 0xa5, 0x78, 0x27, 0x13, 0x99, 0x56, 0x4e, 0xc0, 0x8d, 0x4f,
+	0xa4, 0xae, 0x6e, 0x97, 0x86, 0xb7, 0x1b, 0x0a, 0xd5, 0xe2, 0xa2, 0xb6, 0x0b, 0x25, 0x81, 0x13,
+	0x04, 0xd1, 0xbf, 0xd0, 0xe0, 0x86, 0x5a, 0xa0, 0xee, 0x16, 0xae, 0x53, 0xbc, 0x72, 0x6e, 0x9d,
+	0x49, 0xe1, 0xe7, 0x66, 0x8e, 0x02, 0x20, 0x2e, 0xea, 0x15, 0x65, 0xdb, 0x2d, 0xb6, 0x31, 0xb9,
+	0xde, 0x31, 0xc8, 0xd9, 0x36, 0xdf, 0xab, 0x01, 0x8e, 0x60, 0x54, 0xe3, 0x6e, 0x7b, 0xd6, 0xa6,
+	0x6d, 0x05, 0x6b, 0x76, 0xcb, 0x0e, 0x99, 0x76, 0x50, 0xe5, 0xd3, 0xb1, 0xe9, 0x59, 0x9b, 0xf5,
+	0x65, 0x5e, 0x8e, 0x13, 0xb5, 0xd0, 0x02, 0xc0, 0xae, 0x61, 0x3b, 0x8d, 0xfb, 0x46, 0xfb, 0x5e,
+	0xf4, 0xac, 0x9e, 0x69, 0xaf, 0xab, 0xb2, 0x14, 0x2b, 0x35, 0xe8, 0xfa, 0x51, 0xbe, 0x83, 0x09,
+	0x8f, 0xeb, 0xc6, 0x04, 0xea, 0xf3, 0x58, 0xbf, 0x08, 0x21, 0xef, 0xf0, 0x5d, 0x85, 0x04, 0x4e,
+	0x10, 0x44, 0xdf, 0xaf, 0xc1, 0x64, 0xd0, 0x0d, 0x42, 0xd2, 0x92, 0x7d, 0xb8, 0x72, 0xde, 0x7d,
+	0x60, 0x16, 0xa1, 0x46, 0x82, 0x08, 0x4e, 0x11, 0x65, 0x01, 0x0a, 0x5a, 0x46, 0x93, 0xdc, 0xae,
+	0xdd, 0xb1, 0x9b, 0x7b, 0xf2, 0xc1, 0xfc, 0x26, 0xf1, 0x4d, 0xe2, 0x86, 0x4c, 0x14, 0x1f, 0x14,
+	0x01, 0x0a, 0x8a, 0xab, 0xe1, 0x5e, 0x38, 0xd0, 0x6b, 0x30, 0x27, 0xc0, 0x6b, 0xde, 0xfd, 0x0c,
+	0x85, 0x69, 0x46, 0x81, 0xb9, 0xb0, 0xd5, 0x0b, 0x6b, 0xe1, 0x1e, 0x18, 0x50, 0x1d, 0xae, 0x06,
+	0xc4, 0x67, 0xd7, 0x3e, 0x3c, 0xea, 0xd1, 0x66, 0xc7, 0x71, 0x82, 0x59, 0x14, 0x7b, 0xaf, 0x37,
+	0xb2, 0x60, 0x9c, 0xd7, 0x06, 0xbd, 0x20, 0x1f, 0xc8, 0x75, 0x69, 0xc1, 0x47, 0x37, 0x1b, 0xb3,
+	0x57, 0x59, 0xff, 0xae, 0x2a, 0xef, 0xde, 0x22, 0x10, 0x4e, 0xd7, 0xa5, 0xa7, 0x79, 0x54, 0xb4,
+	0xd4, 0xf1, 0x83, 0x70, 0xf6, 0x1a, 0x6b, 0xcc, 0x4e, 0x73, 0xac, 0x02, 0x70, 0xb2, 0x1e, 0x7a,
+	0x1e, 0x26, 0x03, 0x62, 0x9a, 0x5e, 0xab, 0x2d, 0x34, 0xab, 0xd9, 0x19, 0xd6, 0x7b, 0xbe, 0x82,
+	0x09, 0x08, 0x4e, 0xd5, 0x44, 0x5d, 0xb8, 0x2a, 0xa3, 0x9c, 0xad, 0x79, 0xcd, 0x75, 0xe3, 0x90,
+	0x09, 0xc7, 0xd7, 0x4f, 0xe6, 0x8f, 0x0b, 0x91, 0x1b, 0xc3, 0xc2, 0x47, 0x3b, 0x86, 0x1b, 0xda,
+	0x61, 0x97, 0x4f, 0x57, 0x2d, 0x8b, 0x0e, 0xe7, 0xd1, 0x40, 0x6b, 0x70, 0x2d, 0x55, 0xbc, 0x6a,
+	0x3b, 0x24, 0x98, 0xbd, 0xc1, 0x86, 0xcd, 0xcc, 0x23, 0xb5, 0x1c, 0x38, 0xce, 0x6d, 0x85, 0xee,
+	0xc1, 0x4c, 0xdb, 0xf7, 0x42, 0x62, 0x86, 0x77, 0xa9, 0x40, 0xe0, 0x88, 0x01, 0x06, 0xb3, 0xb3,
+	0x6c, 0x2e, 0xd8, 0x95, 0xd7, 0x66, 0x5e, 0x05, 0x9c, 0xdf, 0x0e, 0x7d, 0x4e, 0x83, 0xc7, 0x82,
+	0xd0, 0x27, 0x46, 0xcb, 0x76, 0x9b, 0x35, 0xcf, 0x75, 0x89, 0x19, 0xdd, 0x26, 0x47, 0xe2, 0xff,
+	0x43, 0xa5, 0x4e, 0x11, 0xfd, 0xf8, 0x68, 0xfe, 0xb1, 0x46, 0x4f, 0xcc, 0xf8, 0x04, 0xca, 0xe8,
+	0x2d, 0x80, 0x16, 0x69, 0x79, 0x7e, 0x97, 0x72, 0xa4, 0xd9, 0xb9, 0xf2, 0x0e, 0x6b, 0xeb, 0x12,
+	0x0b, 0xff, 0xfc, 0x13, 0x97, 0x75, 0x31, 0x10, 0x2b, 0xe4, 0xf4, 0xa3, 0x0a, 0xcc, 0xe4, 0xb2,
+	0x7a, 0xfa, 0x05, 0xf0, 0x7a, 0x8b, 0x51, 0xc4, 0x73, 0x61, 0x10, 0x67, 0x5f, 0xc0, 0x7a, 0x12,
+	0x84, 0xd3, 0x75, 0xa9, 0x20, 0xc6, 0xbe, 0xd4, 0xd5, 0x46, 0xdc, 0xbe, 0x12, 0x0b, 0x62, 0xf5,
+	0x14, 0x0c, 0x67, 0x6a, 0xa3, 0x1a, 0x4c, 0x8b, 0xb2, 0x3a, 0xd5, 0x65, 0x82, 0x55, 0x9f, 0x44,
+	0x22, 0x2e, 0xd5, 0x0a, 0xa6, 0xeb, 0x69, 0x20, 0xce, 0xd6, 0xa7, 0xa3, 0xa0, 0x3f, 0xd4, 0x5e,
+	0x0c, 0xc4, 0xa3, 0xd8, 0x48, 0x82, 0x70, 0xba, 0x6e, 0xa4, 0x6c, 0x26, 0xba, 0x30, 0x18, 0x8f,
+	0x62, 0x23, 0x05, 0xc3, 0x99, 0xda, 0xfa, 0x7f, 0x1a, 0x80, 0xc7, 0x4f, 0x21, 0x1e, 0xa1, 0x56,
+	0xfe, 0x74, 0x9f, 0xfd, 0xc3, 0x3d, 0xdd, 0xf2, 0xb4, 0x0b, 0x96, 0xe7, 0xec, 0xf4, 0x4e, 0xbb,
+	0x9c, 0x41, 0xd1, 0x72, 0x9e, 0x9d, 0xe4, 0xe9, 0x97, 0xbf, 0x95, 0xbf, 0xfc, 0x25, 0x67, 0xf5,
+	0xc4, 0xed, 0xd2, 0x2e, 0xd8, 0x2e, 0x25, 0x67, 0xf5, 0x14, 0xdb, 0xeb, 0x3f, 0x0f, 0xc0, 0x13,
+	0xa7, 0x11, 0xd5, 0x4a, 0xee, 0xaf, 0x1c, 0x96, 0x77, 0xa1, 0xfb, 0xab, 0xe8, 0x7d, 0xdd, 0x05,
+	0xee, 0xaf, 0x1c, 0x92, 0x17, 0xbd, 0xbf, 0x8a, 0x66, 0xf5, 0xa2, 0xf6, 0x57, 0xd1, 0xac, 0x9e,
+	0x62, 0x7f, 0xfd, 0x79, 0xfa, 0x7c, 0x90, 0xf2, 0x62, 0x1d, 0xaa, 0x66, 0xbb, 0x53, 0x92, 0x49,
+	0x31, 0x6f, 0xa8, 0xda, 0xe6, 0x36, 0xa6, 0x38, 0x10, 0x86, 0x21, 0xbe, 0x7f, 0x4a, 0xb2, 0x20,
+	0xf6, 0x52, 0x8b, 0x6f, 0x49, 0x2c, 0x30, 0xd1, 0xa9, 0x22, 0xed, 0x3d, 0xd2, 0x22, 0xbe, 0xe1,
+	0x34, 0x42, 0xcf, 0x37, 0x9a, 0x65, 0xb9, 0x0d, 0x37, 0x1c, 0xa7, 0x70, 0xe1, 0x0c, 0x76, 0x3a,
+	0x21, 0x6d, 0xdb, 0x2a, 0xc9, 0x5f, 0xd8, 0x84, 0x6c, 0xd6, 0x97, 0x31, 0xc5, 0xa1, 0x7f, 0x69,
+	0x04, 0x94, 0x28, 0xa2, 0xe8, 0x93, 0x1a, 0x4c, 0x9b, 0xe9, 0x58, 0x5d, 0xfd, 0x38, 0xbe, 0x64,
+	0x02, 0x7f, 0xf1, 0x2d, 0x9f, 0x29, 0xc6, 0x59, 0xb2, 0xe8, 0x7b, 0x34, 0x6e, 0xa9, 0x92, 0x97,
+	0x18, 0x62, 0x5a, 0x6f, 0x9f, 0xd3, 0x75, 0x5f, 0x6c, 0xf2, 0x8a, 0x6f, 0x96, 0x92, 0x04, 0xd1,
+	0xe7, 0x35, 0x98, 0xd9, 0xcf, 0x33, 0xb0, 0x8b, 0xc9, 0xbf, 0x57, 0xb6, 0x2b, 0x05, 0x16, 0x7b,
+	0x2e, 0x71, 0xe6, 0x56, 0xc0, 0xf9, 0x1d, 0x91, 0xb3, 0x24, 0x6d, 0x8e, 0xe2, 0x3b, 0x2d, 0x3d,
+	0x4b, 0x29, 0xe3, 0x65, 0x3c, 0x4b, 0x12, 0x80, 0x93, 0x04, 0x51, 0x1b, 0x46, 0xf7, 0x23, 0x43,
+	0xaf, 0x30, 0xee, 0xd4, 0xca, 0x52, 0x57, 0xac, 0xc5, 0xdc, 0xb1, 0x47, 0x16, 0xe2, 0x98, 0x08,
+	0xda, 0x83, 0xe1, 0x7d, 0xce, 0x2b, 0x84, 0x51, 0x66, 0xb1, 0x6f, 0x15, 0x96, 0xdb, 0x06, 0x44,
+	0x11, 0x8e, 0xd0, 0xab, 0x4e, 0xcd, 0x23, 0x27, 0xbc, 0xb5, 0xf9, 0x9c, 0x06, 0x33, 0x07, 0xc4,
+	0x0f, 0x6d, 0x33, 0x7d, 0xbd, 0x31, 0x5a, 0x5e, 0xcd, 0x7e, 0x29, 0x0f, 0x21, 0xdf, 0x26, 0xb9,
+	0x20, 0x9c, 0xdf, 0x05, 0xaa, 0x74, 0x73, 0x2b, 0x75, 0x23, 0x34, 0x42, 0xdb, 0xdc, 0xf2, 0xf6,
+	0x89, 0x1b, 0xe7, 0xa3, 0x62, 0xe6, 0x11, 0x11, 0x15, 0x70, 0xa5, 0xb8, 0x1a, 0xee, 0x85, 0x43,
+	0xff, 0x63, 0x0d, 0x32, 0xb6, 0x56, 0xf4, 0x23, 0x1a, 0x8c, 0xef, 0x12, 0x23, 0xec, 0xf8, 0xe4,
+	0xb6, 0x11, 0xca, 0xe0, 0x04, 0x2f, 0x9d, 0x87, 0x89, 0x77, 0x61, 0x55, 0x41, 0xcc, 0xaf, 0xeb,
+	0xa5, 0x97, 0xad, 0x0a, 0xc2, 0x89, 0x1e, 0xcc, 0xbd, 0x08, 0xd3, 0x99, 0x86, 0x67, 0xba, 0x76,
+	0xfb, 0xd7, 0x1a, 0xe4, 0xa5, 0x50, 0x43, 0xaf, 0xc1, 0xa0, 0x61, 0x59, 0x32, 0x27, 0xca, 0x73,
+	0xe5, 0x3c, 0x47, 0x2c, 0x35, 0x06, 0x04, 0xfb, 0x89, 0x39, 0x5a, 0xb4, 0x0a, 0xc8, 0x48, 0xdc,
+	0x3f, 0xaf, 0xc7, 0x2f, 0x9b, 0xd9, 0xf5, 0xd0, 0x62, 0x06, 0x8a, 0x73, 0x5a, 0xe8, 0x3f, 0xa8,
+	0x01, 0xca, 0x86, 0x95, 0x46, 0x3e, 0x8c, 0x88, 0xad, 0x1c, 0xad, 0xd2, 0x72, 0xc9, 0x17, 0x3e,
+	0x89, 0xe7, 0x6a, 0xb1, 0xe3, 0x95, 0x28, 0x08, 0xb0, 0xa4, 0xa3, 0xff, 0xa5, 0x06, 0x71, 0xde,
+	0x04, 0xf4, 0x3e, 0x18, 0xb3, 0x48, 0x60, 0xfa, 0x76, 0x3b, 0x8c, 0x1f, 0xb7, 0xc9, 0x47, 0x32,
+	0xcb, 0x31, 0x08, 0xab, 0xf5, 0x90, 0x0e, 0x43, 0xa1, 0x11, 0xec, 0xd7, 0x97, 0x85, 0xde, 0xc7,
+	0x4e, 0xe9, 0x2d, 0x56, 0x82, 0x05, 0x24, 0x8e, 0x2e, 0x57, 0x3d, 0x45, 0x74, 0x39, 0xb4, 0x7b,
+	0x0e, 0xa1, 0xf4, 0xd0, 0xc9, 0x61, 0xf4, 0xf4, 0x9f, 0xa9, 0xc0, 0x15, 0x5a, 0x65, 0xdd, 0xb0,
+	0xdd, 0x90, 0xb8, 0xec, 0x29, 0x47, 0xc9, 0x49, 0x68, 0xc2, 0x44, 0x98, 0x78, 0x46, 0x79, 0xf6,
+	0x87, 0x7e, 0xd2, 0xd7, 0x25, 0xf9, 0x78, 0x32, 0x89, 0x17, 0x3d, 0x17, 0xbd, 0xa5, 0xe1, 0x1a,
+	0xf2, 0xe3, 0xd1, 0x56, 0x65, 0x0f, 0x64, 0x1e, 0x88, 0x37, 0xa9, 0x32, 0xd9, 0x46, 0xe2, 0xd9,
+	0xcc, 0xfb, 0x61, 0x42, 0x38, 0x75, 0xf3, 0x30, 0x81, 0x42, 0x43, 0x66, 0x27, 0xcc, 0xaa, 0x0a,
+	0xc0, 0xc9, 0x7a, 0xfa, 0xef, 0x55, 0x20, 0x99, 0xd2, 0xa3, 0xec, 0x2c, 0x65, 0x63, 0x24, 0x56,
+	0x2e, 0x2c, 0x46, 0xe2, 0x7b, 0x58, 0x3e, 0x2c, 0x9e, 0x38, 0x91, 0xdf, 0x1b, 0xab, 0x59, 0xac,
+	0x78, 0xda, 0x43, 0x59, 0x23, 0x9e, 0xd6, 0x81, 0x33, 0x4f, 0xeb, 0xfb, 0x84, 0x1b, 0xe1, 0x60,
+	0x22, 0x52, 0x65, 0xe4, 0xed, 0x39, 0x9d, 0x68, 0xa8, 0xbc, 0xfc, 0xf9, 0x92, 0x06, 0xc3, 0x22,
+	0x96, 0xfa, 0x29, 0x3c, 0x11, 0x77, 0x61, 0x90, 0x69, 0x25, 0xfd, 0x48, 0x83, 0x8d, 0x3d, 0xcf,
+	0x0b, 0x13, 0x11, 0xe5, 0xd9, 0x5b, 0x06, 0xf6, 0x2f, 0xe6, 0xe8, 0x99, 0xfb, 0x9b, 0x6f, 0xee,
+	0xd9, 0x21, 0x31, 0xc3, 0x28, 0x4e, 0x75, 0xe4, 0xfe, 0xa6, 0x94, 0xe3, 0x44, 0x2d, 0xfd, 0xc7,
+	0x06, 0xe0, 0xa6, 0x40, 0x9c, 0x11, 0x91, 0x24, 0x83, 0xeb, 0xc2, 0x55, 0xb1, 0xb6, 0xcb, 0xbe,
+	0x61, 0xcb, 0xfb, 0xf8, 0x72, 0xda, 0xa9, 0x48, 0x0e, 0x9a, 0x41, 0x87, 0xf3, 0x68, 0xf0, 0x68,
+	0xa8, 0xac, 0xf8, 0x0e, 0x31, 0x9c, 0x70, 0x2f, 0xa2, 0x5d, 0xe9, 0x27, 0x1a, 0x6a, 0x16, 0x1f,
+	0xce, 0xa5, 0xc2, 0xfc, 0x01, 0x04, 0xa0, 0xe6, 0x13, 0x43, 0x75, 0x46, 0xe8, 0xe3, 0x39, 0xc2,
+	0x7a, 0x2e, 0x46, 0x5c, 0x40, 0x89, 0x99, 0xf9, 0x8c, 0x43, 0x66, 0x35, 0xc0, 0x24, 0xf4, 0x6d,
+	0x96, 0x19, 0x40, 0x1a, 0xba, 0xd7, 0x93, 0x20, 0x9c, 0xae, 0x8b, 0x9e, 0x87, 0x49, 0xe6, 0x5f,
+	0x11, 0x47, 0x45, 0x1b, 0x8c, 0x03, 0x6f, 0x6c, 0x24, 0x20, 0x38, 0x55, 0x53, 0xff, 0x78, 0x05,
+	0xc6, 0xd5, 0x6d, 0x77, 0x8a, 0x67, 0x66, 0x1d, 0xe5, 0x30, 0xec, 0xe3, 0x09, 0x94, 0x4a, 0xf5,
+	0x14, 0xe7, 0x21, 0x7a, 0x05, 0x26, 0x3b, 0x8c, 0x83, 0x44, 0x91, 0x5d, 0xc4, 0xfe, 0xff, 0x26,
+	0x3a, 0xca, 0xed, 0x04, 0xe4, 0xc1, 0xd1, 0xfc, 0x9c, 0x8a, 0x3e, 0x09, 0xc5, 0x29, 0x3c, 0xfa,
+	0xa7, 0xaa, 0x70, 0x35, 0xa7, 0x37, 0xec, 0x1e, 0x9e, 0xa4, 0x8e, 0xec, 0x7e, 0xee, 0xe1, 0x33,
+	0xc7, 0xbf, 0xbc, 0x87, 0x4f, 0x43, 0x70, 0x86, 0x2e, 0x7a, 0x09, 0xaa, 0xa6, 0x6f, 0x8b, 0x09,
+	0x7f, 0x7f, 0x29, 0x85, 0x13, 0xd7, 0x97, 0xc6, 0x04, 0xc5, 0x6a, 0x0d, 0xd7, 0x31, 0x45, 0x48,
+	0x0f, 0x1e, 0x95, 0x5d, 0x44, 0x52, 0x00, 0x3b, 0x78, 0x54, 0xae, 0x12, 0xe0, 0x64, 0x3d, 0xf4,
+	0x0a, 0xcc, 0x0a, 0x4d, 0x20, 0x7a, 0xb2, 0xee, 0xb9, 0x41, 0x48, 0xbf, 0xec, 0x50, 0x30, 0xea,
+	0x47, 0x8e, 0x8f, 0xe6, 0x67, 0xef, 0x16, 0xd4, 0xc1, 0x85, 0xad, 0xf5, 0x3f, 0xab, 0xc2, 0x98,
+	0x92, 0xc9, 0x02, 0xad, 0xf7, 0x63, 0xe5, 0x88, 0x47, 0x1c, 0x59, 0x3a, 0xd6, 0xa1, 0xda, 0x6c,
+	0x77, 0x4a, 0x9a, 0x39, 0x24, 0xba, 0xdb, 0x14, 0x5d, 0xb3, 0xdd, 0x41, 0x2f, 0x49, 0xc3, 0x49,
+	0x39, 0xd3, 0x86, 0x7c, 0x61, 0x93, 0x32, 0x9e, 0x44, 0x1f, 0xe2, 0x40, 0xe1, 0x87, 0xd8, 0x82,
+	0xe1, 0x40, 0x58, 0x55, 0x06, 0xcb, 0x07, 0x30, 0x52, 0x66, 0x5a, 0x58, 0x51, 0xb8, 0xbe, 0x17,
+	0x19, 0x59, 0x22, 0x1a, 0x54, 0x96, 0xec, 0xb0, 0x67, 0xcb, 0x4c, 0x91, 0x1d, 0xe1, 0xb2, 0xe4,
+	0x36, 0x2b, 0xc1, 0x02, 0x92, 0x39, 0xa2, 0x86, 0x4f, 0x75, 0x44, 0xfd, 0xfd, 0x0a, 0xa0, 0x6c,
+	0x37, 0xd0, 0xe3, 0x30, 0xc8, 0xc2, 0x1e, 0x08, 0x5e, 0x24, 0x25, 0x7f, 0xf6, 0xf0, 0x1d, 0x73,
+	0x18, 0x6a, 0x88, 0x70, 0x2c, 0xe5, 0x96, 0x93, 0x39, 0xb2, 0x08, 0x7a, 0x4a, 0xec, 0x96, 0x9b,
+	0x89, 0x47, 0x22, 0xf9, 0xaf, 0x0f, 0x86, 0x5b, 0xb6, 0xcb, 0xee, 0xf6, 0xca, 0x19, 0x9b, 0xf8,
+	0x7d, 0x3b, 0x47, 0x81, 0x23, 0x5c, 0xfa, 0x9f, 0xb2, 0xad, 0x1f, 0x4b, 0xbc, 0x5d, 0x00, 0xa3,
+	0x13, 0x7a, 0x9c, 0x81, 0x89, 0x2f, 0xa0, 0x5e, 0x6e, 0x95, 0x25, 0xd2, 0x45, 0x89, 0x90, 0xdf,
+	0x4a, 0xc5, 0xbf, 0xb1, 0x42, 0x8c, 0x92, 0x0e, 0xed, 0x16, 0x79, 0xd9, 0x76, 0x2d, 0xef, 0xbe,
+	0x98, 0xde, 0x7e, 0x49, 0x6f, 0x49, 0x84, 0x9c, 0x74, 0xfc, 0x1b, 0x2b, 0xc4, 0x28, 0x6b, 0x61,
+	0x8a, 0xb3, 0xcb, 0x52, 0x0b, 0x89, 0xbe, 0x79, 0x8e, 0x13, 0x9d, 0xca, 0x23, 0x9c, 0xb5, 0xd4,
+	0x0a, 0xea, 0xe0, 0xc2, 0xd6, 0xe8, 0x07, 0x35, 0x98, 0xd8, 0xf5, 0x09, 0x79, 0x53, 0x98, 0xe4,
+	0xa3, 0x77, 0xcb, 0x77, 0xfb, 0x1c, 0xd8, 0xaa, 0x82, 0x33, 0x56, 0x16, 0xd4, 0xd2, 0x00, 0x27,
+	0x09, 0xeb, 0x3f, 0xa7, 0xc1, 0x4c, 0xee, 0xaa, 0xa0, 0xdb, 0x30, 0x1d, 0xbb, 0x61, 0xa9, 0xe7,
+	0xce, 0x48, 0x9c, 0x5d, 0xeb, 0x6e, 0xba, 0x02, 0xce, 0xb6, 0xe1, 0x29, 0xdc, 0x33, 0xe7, 0x9a,
+	0xf0, 0xe1, 0x52, 0xa5, 0x34, 0x15, 0x8c, 0xf3, 0xda, 0xe8, 0x5f, 0xd1, 0xe0, 0x46, 0xc1, 0x78,
+	0xd1, 0x3d, 0x18, 0xdc, 0x21, 0x4d, 0x3b, 0x3a, 0x1b, 0xcf, 0xa2, 0x30, 0xc8, 0x6f, 0x7a, 0x89,
+	0x22, 0xc0, 0x1c, 0x0f, 0xaa, 0xc7, 0x8f, 0x76, 0xcf, 0x86, 0x4e, 0x72, 0x67, 0xf9, 0xc8, 0x57,
+	0x97, 0xd1, 0xd8, 0xab, 0xb1, 0x02, 0x9c, 0x8c, 0xc4, 0xae, 0x7f, 0x7f, 0x72, 0x25, 0xe2, 0x4d,
+	0x49, 0x39, 0x50, 0x3c, 0xb2, 0xd1, 0x82, 0xde, 0x3e, 0xaa, 0x3e, 0x31, 0xce, 0xf6, 0xe0, 0x49,
+	0x18, 0xb9, 0x4f, 0xc8, 0xbe, 0x65, 0x74, 0xa3, 0xb3, 0x95, 0x39, 0xb6, 0xbf, 0x2c, 0xca, 0xb0,
+	0x84, 0xea, 0xdf, 0x01, 0x37, 0x0a, 0xae, 0x8f, 0xd1, 0x32, 0x8c, 0x07, 0xf7, 0x8d, 0xf6, 0x12,
+	0xd9, 0x33, 0x0e, 0x6c, 0x11, 0xdb, 0x83, 0x7b, 0x19, 0x8e, 0x37, 0x94, 0xf2, 0x07, 0xa9, 0xdf,
+	0x38, 0xd1, 0x4a, 0x0f, 0x01, 0x84, 0x37, 0xaa, 0xed, 0x36, 0xd1, 0x2e, 0x8c, 0x18, 0x22, 0x91,
+	0xba, 0x58, 0xb9, 0x6f, 0x2d, 0x65, 0x96, 0x11, 0x38, 0xf8, 0xb0, 0xa2, 0x5f, 0x58, 0xe2, 0xd6,
+	0x7f, 0x56, 0x83, 0xeb, 0xf9, 0xd1, 0x1c, 0x4e, 0x21, 0x6c, 0xb6, 0x60, 0xcc, 0x8f, 0x9b, 0x89,
+	0x2d, 0xf1, 0x2d, 0x6a, 0xa8, 0x61, 0x25, 0xb6, 0x1e, 0xdd, 0x07, 0x35, 0xdf, 0x0b, 0xa2, 0x0f,
+	0x20, 0x1d, 0x7d, 0x58, 0x2a, 0xc1, 0x4a, 0x4f, 0xb0, 0x8a, 0x5f, 0xff, 0xd5, 0x0a, 0xc0, 0x06,
+	0x09, 0xef, 0x7b, 0xfe, 0x3e, 0x9d, 0xa2, 0x47, 0x12, 0xba, 0xdf, 0xc8, 0xd7, 0x2e, 0xa2, 0xc8,
+	0x23, 0x30, 0xd0, 0xa6, 0xdc, 0xaa, 0x1a, 0x77, 0x84, 0x39, 0x6a, 0xb1, 0x52, 0x34, 0x0f, 0x83,
+	0xec, 0xb6, 0x48, 0xc8, 0x0a, 0x4c, 0x73, 0xa4, 0x72, 0x7f, 0x80, 0x79, 0x39, 0x4f, 0x8f, 0xc9,
+	0xde, 0xc0, 0x04, 0x42, 0x15, 0x16, 0xe9, 0x31, 0x79, 0x19, 0x96, 0x50, 0xf4, 0x3c, 0x80, 0xdd,
+	0x5e, 0x35, 0x5a, 0xb6, 0x43, 0xb5, 0x90, 0x21, 0x99, 0x8d, 0x1d, 0xea, 0x9b, 0x51, 0xe9, 0x83,
+	0xa3, 0xf9, 0x11, 0xf1, 0xab, 0x8b, 0x95, 0xda, 0xfa, 0x5f, 0x55, 0x61, 0x7c, 0xa3, 0x69, 0xbb,
+	0x87, 0xd1, 0x63, 0x62, 0x69, 0xf5, 0xd3, 0x2e, 0xc6, 0xea, 0xf7, 0x0a, 0xcc, 0x3a, 0x9e, 0x61,
+	0x2d, 0x19, 0x0e, 0xfd, 0x6e, 0xfd, 0x06, 0x5f, 0x46, 0xc3, 0x6d, 0xca, 0xf4, 0xf4, 0xec, 0x9c,
+	0x58, 0x2b, 0xa8, 0x83, 0x0b, 0x5b, 0xa3, 0x10, 0x86, 0xcc, 0xe8, 0xf5, 0x5f, 0xe9, 0x07, 0xb2,
+	0xea, 0x5c, 0x2c, 0xa8, 0x2f, 0xa7, 0xa4, 0xc8, 0x27, 0x56, 0x5b, 0xd0, 0xa2, 0xca, 0xe8, 0x0c,
+	0x39, 0xe4, 0x6f, 0x25, 0xb7, 0x7c, 0x63, 0x77, 0xd7, 0x36, 0x85, 0xfb, 0x2c, 0x5f, 0xd8, 0xb5,
+	0xe3, 0xa3, 0xf9, 0x99, 0x95, 0xbc, 0x0a, 0x0f, 0x8e, 0xe6, 0x6f, 0xe5, 0x3e, 0x5d, 0x65, 0xcb,
+	0x9a, 0xdb, 0x04, 0xe7, 0x93, 0x9a, 0x7b, 0x0e, 0xc6, 0xce, 0xf0, 0xe8, 0x22, 0xf1, 0x40, 0xf5,
+	0xd7, 0x2a, 0x30, 0x4e, 0xf7, 0xdd, 0x9a, 0x67, 0x1a, 0xce, 0xf2, 0x46, 0x03, 0x3d, 0x95, 0x8e,
+	0xaa, 0x21, 0xaf, 0x08, 0x32, 0x91, 0x35, 0xd6, 0xe0, 0xda, 0xae, 0xe7, 0x9b, 0x64, 0xab, 0xb6,
+	0xb9, 0xe5, 0x89, 0x4b, 0xb0, 0xe5, 0x8d, 0x86, 0x38, 0xac, 0x98, 0x5a, 0xbf, 0x9a, 0x03, 0xc7,
+	0xb9, 0xad, 0xd0, 0x3d, 0x98, 0x89, 0xcb, 0xb7, 0xdb, 0xdc, 0xfb, 0x87, 0xa2, 0xab, 0xc6, 0xde,
+	0x4b, 0xab, 0x79, 0x15, 0x70, 0x7e, 0x3b, 0x64, 0xc0, 0xc3, 0x22, 0x68, 0xcf, 0xaa, 0xe7, 0xdf,
+	0x37, 0x7c, 0x2b, 0x89, 0x76, 0x20, 0xbe, 0x24, 0x58, 0x2e, 0xae, 0x86, 0x7b, 0xe1, 0xd0, 0x7f,
+	0x7c, 0x08, 0x94, 0xe7, 0x7d, 0x67, 0x48, 0xa8, 0xf8, 0xd3, 0x1a, 0x5c, 0x33, 0x1d, 0x9b, 0xb8,
+	0x61, 0xea, 0x2d, 0x17, 0x67, 0x47, 0xdb, 0xa5, 0xde, 0x1d, 0xb6, 0x89, 0x5b, 0x5f, 0x16, 0xce,
+	0x52, 0xb5, 0x1c, 0xe4, 0xc2, 0xa1, 0x2c, 0x07, 0x82, 0x73, 0x3b, 0xc3, 0xc6, 0xc3, 0xca, 0xeb,
+	0xcb, 0x6a, 0xb8, 0x8d, 0x9a, 0x28, 0xc3, 0x12, 0x8a, 0x9e, 0x81, 0xb1, 0xa6, 0xef, 0x75, 0xda,
+	0x41, 0x8d, 0xf9, 0x44, 0xf3, 0xbd, 0xcf, 0x24, 0xf5, 0xdb, 0x71, 0x31, 0x56, 0xeb, 0x50, 0xbd,
+	0x83, 0xff, 0xdc, 0xf4, 0xc9, 0xae, 0x7d, 0x28, 0x98, 0x1c, 0xd3, 0x3b, 0x6e, 0x2b, 0xe5, 0x38,
+	0x51, 0x8b, 0xbd, 0x98, 0x0f, 0x82, 0x0e, 0xf1, 0xb7, 0xf1, 0x9a, 0x48, 0xc2, 0xc2, 0x5f, 0xcc,
+	0x47, 0x85, 0x38, 0x86, 0xa3, 0x4f, 0x6b, 0x30, 0xe9, 0x93, 0x37, 0x3a, 0xb6, 0x4f, 0x2c, 0x46,
+	0x34, 0x10, 0x6f, 0x2c, 0x71, 0x7f, 0xef, 0x3a, 0x17, 0x70, 0x02, 0x29, 0xe7, 0x10, 0xd2, 0x90,
+	0x9a, 0x04, 0xe2, 0x54, 0x0f, 0xe8, 0x54, 0x05, 0x76, 0xd3, 0xb5, 0xdd, 0xe6, 0xa2, 0xd3, 0x0c,
+	0x66, 0x47, 0x18, 0xd3, 0xe3, 0x4a, 0x4d, 0x5c, 0x8c, 0xd5, 0x3a, 0x54, 0xe1, 0xef, 0x04, 0xf4,
+	0xbb, 0x6f, 0x11, 0x3e, 0xbf, 0xa3, 0xb1, 0xa5, 0x79, 0x5b, 0x05, 0xe0, 0x64, 0x3d, 0xf4, 0x3c,
+	0x4c, 0x46, 0x05, 0x62, 0x96, 0x81, 0x87, 0xc0, 0x64, 0x06, 0x98, 0x04, 0x04, 0xa7, 0x6a, 0xce,
+	0x2d, 0xc2, 0xd5, 0x9c, 0x61, 0x9e, 0x89, 0xb9, 0xfc, 0xb5, 0x06, 0x33, 0x3c, 0x1b, 0x74, 0x94,
+	0xbe, 0x25, 0x8a, 0x75, 0x99, 0x1f, 0x36, 0x52, 0xbb, 0xd0, 0xb0, 0x91, 0x5f, 0x83, 0xf0, 0x98,
+	0xfa, 0x3f, 0xa9, 0xc0, 0x3b, 0x4f, 0xfc, 0x2e, 0xd1, 0x4f, 0x68, 0x30, 0x46, 0x0e, 0x43, 0xdf,
+	0x90, 0x0f, 0x47, 0xe8, 0x26, 0xdd, 0xbd, 0x10, 0x26, 0xb0, 0xb0, 0x12, 0x13, 0xe2, 0x1b, 0x57,
+	0x8a, 0x58, 0x0a, 0x04, 0xab, 0xfd, 0xa1, 0x12, 0x39, 0x0f, 0x11, 0xab, 0x5e, 0x49, 0x89, 0x24,
+	0xfd, 0x02, 0x32, 0xf7, 0x21, 0x98, 0x4a, 0x63, 0x3e, 0xd3, 0x5e, 0xf9, 0x95, 0x0a, 0x0c, 0x6f,
+	0xfa, 0x1e, 0x95, 0xfe, 0x2e, 0x21, 0xf0, 0x86, 0x91, 0x48, 0x9b, 0x50, 0xea, 0x65, 0xb9, 0xe8,
+	0x6c, 0x61, 0xca, 0x16, 0x3b, 0x95, 0xb2, 0x65, 0xb1, 0x1f, 0x22, 0xbd, 0x73, 0xb4, 0xfc, 0xb6,
+	0x06, 0x63, 0xa2, 0xe6, 0x25, 0x84, 0x97, 0xf8, 0xce, 0x64, 0x78, 0x89, 0x0f, 0xf6, 0x31, 0xae,
+	0x82, 0xb8, 0x12, 0x9f, 0xd3, 0x60, 0x42, 0xd4, 0x58, 0x27, 0xad, 0x1d, 0xe2, 0xa3, 0x55, 0x18,
+	0x0e, 0x3a, 0x6c, 0x21, 0xc5, 0x80, 0x1e, 0x56, 0xf5, 0x09, 0x7f, 0xc7, 0x30, 0x69, 0xf7, 0x1b,
+	0xbc, 0x8a, 0x92, 0x08, 0x85, 0x17, 0xe0, 0xa8, 0x31, 0xd5, 0x5e, 0x7c, 0xcf, 0xc9, 0xc4, 0x5b,
+	0xc3, 0x9e, 0x43, 0x30, 0x83, 0x50, 0xc1, 0x9c, 0xfe, 0x8d, 0x14, 0x3f, 0x26, 0x98, 0x53, 0x70,
+	0x80, 0x79, 0xb9, 0xfe, 0x8b, 0x83, 0x72, 0xb2, 0x59, 0xb2, 0x82, 0x3b, 0x30, 0x6a, 0xfa, 0xc4,
+	0x08, 0x89, 0xb5, 0xd4, 0x3d, 0x4d, 0xe7, 0xd8, 0x71, 0x55, 0x8b, 0x5a, 0xe0, 0xb8, 0x31, 0x3d,
+	0x19, 0xd4, 0x5b, 0xc0, 0x4a, 0x7c, 0x88, 0x16, 0xde, 0x00, 0x7e, 0x2b, 0x0c, 0x7a, 0xf7, 0x5d,
+	0xe9, 0x4c, 0xd4, 0x93, 0x30, 0x1b, 0xca, 0x3d, 0x5a, 0x1b, 0xf3, 0x46, 0x6a, 0xbc, 0xc1, 0x81,
+	0x1e, 0xf1, 0x06, 0x1d, 0x18, 0x6e, 0xb1, 0x65, 0xe8, 0x2b, 0x2f, 0x46, 0x62, 0x41, 0xd5, 0xcc,
+	0x69, 0x0c, 0x33, 0x8e, 0x48, 0xd0, 0x13, 0x9e, 0x9e, 0x42, 0x41, 0xdb, 0x30, 0x89, 0x7a, 0xc2,
+	0x6f, 0x44, 0x85, 0x38, 0x86, 0xa3, 0x6e, 0x32, 0x90, 0xe5, 0x70, 0x79, 0x9b, 0xaa, 0xe8, 0x9e,
+	0x12, 0xbb, 0x92, 0x4f, 0x7d, 0x51, 0x30, 0x4b, 0xf4, 0xb3, 0x1a, 0xcc, 0xb6, 0xf2, 0xcd, 0x2b,
+	0xfc, 0x54, 0x3f, 0x67, 0x13, 0xd5, 0x4d, 0x31, 0x63, 0xb3, 0x05, 0x15, 0x02, 0x5c, 0xd8, 0x1d,
+	0xfd, 0x87, 0x06, 0xe4, 0x07, 0x25, 0x52, 0xf2, 0x7c, 0x04, 0x90, 0xb7, 0xc3, 0x5d, 0x12, 0x6f,
+	0xd3, 0xce, 0xc4, 0xc1, 0xe0, 0xaa, 0x71, 0xaa, 0xbe, 0x7b, 0x99, 0x1a, 0x38, 0xa7, 0x15, 0xfa,
+	0xe6, 0x28, 0x70, 0x75, 0x25, 0x91, 0x91, 0x50, 0x06, 0xae, 0x1e, 0x17, 0xa4, 0x13, 0xc1, 0xaa,
+	0x3b, 0x70, 0x35, 0x08, 0x0d, 0x87, 0x34, 0x6c, 0x61, 0xbf, 0x09, 0x42, 0xa3, 0xd5, 0x2e, 0x11,
+	0x39, 0x9a, 0x3f, 0x50, 0xc9, 0xa2, 0xc2, 0x79, 0xf8, 0xd1, 0xf7, 0x69, 0x30, 0xcb, 0xca, 0x17,
+	0x3b, 0xa1, 0xc7, 0x53, 0x1c, 0xc4, 0xc4, 0xcf, 0xee, 0x16, 0xc1, 0x94, 0xd5, 0x46, 0x01, 0x3e,
+	0x5c, 0x48, 0x09, 0xbd, 0x05, 0x33, 0x54, 0x5a, 0x58, 0x34, 0x43, 0xfb, 0xc0, 0x0e, 0xbb, 0x71,
+	0x17, 0xce, 0x1e, 0x2e, 0x9a, 0x29, 0x46, 0x6b, 0x79, 0xc8, 0x70, 0x3e, 0x0d, 0xfd, 0xcf, 0x35,
+	0x40, 0xd9, 0xed, 0x8e, 0x1c, 0x18, 0xb1, 0xa2, 0x17, 0x23, 0xda, 0xb9, 0x44, 0x84, 0x95, 0xa7,
+	0x88, 0x7c, 0x68, 0x22, 0x29, 0x20, 0x0f, 0x46, 0xef, 0xef, 0xd9, 0x21, 0x71, 0xec, 0x20, 0x3c,
+	0xa7, 0x00, 0xb4, 0x32, 0x1a, 0xe3, 0xcb, 0x11, 0x62, 0x1c, 0xd3, 0xd0, 0x7f, 0x78, 0x00, 0x46,
+	0x64, 0xac, 0xfe, 0x93, 0x3d, 0x04, 0x3a, 0x80, 0x4c, 0x25, 0xdf, 0x61, 0x3f, 0xd6, 0x22, 0x26,
+	0x30, 0xd6, 0x32, 0xc8, 0x70, 0x0e, 0x01, 0xf4, 0x16, 0x5c, 0xb3, 0xdd, 0x5d, 0xdf, 0x08, 0x42,
+	0xbf, 0xc3, 0x6e, 0x5a, 0xfa, 0x89, 0x68, 0xc4, 0xf4, 0xbd, 0x7a, 0x0e, 0x3a, 0x9c, 0x4b, 0x04,
+	0x11, 0x18, 0xe6, 0x29, 0x49, 0x22, 0x1b, 0x7b, 0xa9, 0x2c, 0xf0, 0x3c, 0xd5, 0x49, 0xcc, 0xe1,
+	0xf9, 0xef, 0x00, 0x47, 0xb8, 0x79, 0x18, 0x17, 0xfe, 0x7f, 0xe4, 0xcd, 0x20, 0xf6, 0x7d, 0xad,
+	0x3c, 0x3d, 0x89, 0x4a, 0x84, 0x71, 0x49, 0x16, 0xe2, 0x34, 0x41, 0xfd, 0x5f, 0x56, 0x60, 0x90,
+	0xbf, 0x7d, 0xbe, 0x78, 0x69, 0xf3, 0x3b, 0x12, 0xd2, 0x66, 0xa9, 0x90, 0xea, 0xac, 0xab, 0x85,
+	0xb2, 0x66, 0x33, 0x25, 0x6b, 0xbe, 0x58, 0x9e, 0x44, 0x6f, 0x49, 0xf3, 0x4b, 0x1a, 0x8c, 0xb2,
+	0x7a, 0x97, 0x20, 0x67, 0xbe, 0x96, 0x94, 0x33, 0x9f, 0x2b, 0x3d, 0xa6, 0x02, 0x29, 0xf3, 0xdf,
+	0x56, 0xc5, 0x58, 0x98, 0x18, 0x57, 0x87, 0xab, 0xc2, 0x69, 0x7b, 0xcd, 0xde, 0x25, 0xf4, 0x5b,
+	0x5a, 0x36, 0xba, 0xfc, 0x1e, 0x73, 0x50, 0xbc, 0xea, 0xcb, 0x82, 0x71, 0x5e, 0x1b, 0xf4, 0x6b,
+	0x1a, 0x15, 0x98, 0x42, 0xdf, 0x36, 0xfb, 0xca, 0xa8, 0x25, 0xfb, 0xb6, 0xb0, 0xce, 0x91, 0x71,
+	0x75, 0x6d, 0x3b, 0x96, 0x9c, 0x58, 0xe9, 0x83, 0xa3, 0xf9, 0xf9, 0x1c, 0x3b, 0x62, 0x9c, 0x5d,
+	0x27, 0x08, 0xbf, 0xf7, 0x0f, 0x7a, 0x56, 0x61, 0xb6, 0xfb, 0xa8, 0xc7, 0xe8, 0x0e, 0x0c, 0x06,
+	0xa6, 0xd7, 0x26, 0x67, 0xc9, 0x11, 0x28, 0x27, 0xb8, 0x41, 0x5b, 0x62, 0x8e, 0x60, 0xee, 0x75,
+	0x18, 0x57, 0x7b, 0x9e, 0xa3, 0x0e, 0x2e, 0xab, 0xea, 0xe0, 0x99, 0x2f, 0x64, 0x55, 0xf5, 0xf1,
+	0xd3, 0x55, 0x18, 0x53, 0x36, 0x30, 0xfa, 0x45, 0x0d, 0x06, 0xf6, 0x0c, 0xdf, 0x12, 0x27, 0x59,
+	0xbd, 0xcf, 0x0f, 0x62, 0xe1, 0x8e, 0xe1, 0x5b, 0x7c, 0xfe, 0x71, 0xf4, 0xfd, 0xd1, 0xa2, 0x73,
+	0x9a, 0x7c, 0xd6, 0x55, 0xb4, 0xcb, 0x6e, 0xeb, 0x9b, 0xa4, 0xaf, 0x80, 0xec, 0xac, 0xd3, 0xdb,
+	0x14, 0x4d, 0xfc, 0x11, 0xb3, 0x9f, 0x01, 0x16, 0xd8, 0xe7, 0x9a, 0x30, 0x2a, 0x87, 0x73, 0xa1,
+	0x8b, 0xf2, 0x85, 0x2a, 0x40, 0xdc, 0x9f, 0xa4, 0x64, 0xaf, 0x9d, 0x20, 0xd9, 0xff, 0x82, 0x06,
+	0x03, 0x9d, 0x80, 0x58, 0xfd, 0xe4, 0x93, 0x8d, 0x69, 0x2f, 0x6c, 0x07, 0x24, 0xbd, 0x7e, 0xb4,
+	0xe8, 0xbc, 0xd6, 0x8f, 0xf6, 0x14, 0xed, 0xc3, 0x50, 0xb0, 0xe7, 0x79, 0x61, 0x20, 0x6e, 0x20,
+	0x6a, 0xfd, 0xf5, 0x99, 0xf9, 0x17, 0x2a, 0x9c, 0x98, 0xa1, 0xc6, 0x82, 0x04, 0x5d, 0x44, 0x39,
+	0xa6, 0x0b, 0x5d, 0xc4, 0x7f, 0xac, 0xc1, 0x95, 0x54, 0xa7, 0xd0, 0xad, 0xec, 0x4a, 0x4a, 0xe1,
+	0x2b, 0x77, 0x35, 0x4f, 0x8e, 0xb7, 0xfe, 0x2c, 0x40, 0x33, 0x56, 0x44, 0xaa, 0x4c, 0x11, 0x91,
+	0xa7, 0xaa, 0xa2, 0x80, 0x28, 0xb5, 0xf4, 0x5f, 0xaf, 0xc0, 0x10, 0x26, 0x4d, 0x91, 0x4c, 0xe0,
+	0x84, 0x6b, 0x49, 0x3b, 0xca, 0x5d, 0x54, 0x29, 0xef, 0x0d, 0xae, 0x46, 0x93, 0x7e, 0xd5, 0x73,
+	0x15, 0xc6, 0xa7, 0xa6, 0x2f, 0x42, 0xae, 0x0c, 0xb1, 0x5e, 0x2d, 0x9f, 0xbc, 0x90, 0x0f, 0xec,
+	0xa2, 0x83, 0xaa, 0xff, 0x8e, 0x06, 0xe3, 0x89, 0x98, 0xf5, 0x2d, 0xa8, 0xfa, 0x32, 0xad, 0x6d,
+	0xd9, 0x5b, 0xdb, 0xc8, 0xdf, 0xf7, 0xe1, 0x1e, 0x95, 0x30, 0xa5, 0x23, 0xc3, 0xdb, 0x57, 0xce,
+	0x29, 0xbc, 0xbd, 0xfe, 0x19, 0x0d, 0xae, 0x47, 0x03, 0x4a, 0xc6, 0xf2, 0x43, 0x4f, 0xc2, 0x88,
+	0xd1, 0xb6, 0xd9, 0xe5, 0x82, 0x7a, 0x3d, 0xb3, 0xb8, 0x59, 0x67, 0x65, 0x58, 0x42, 0xd1, 0x7b,
+	0x60, 0x24, 0xfa, 0x26, 0xc4, 0x96, 0x95, 0x82, 0x8a, 0xbc, 0x87, 0x96, 0x35, 0xd0, 0xbb, 0x94,
+	0xf4, 0x52, 0x83, 0xf1, 0x87, 0x20, 0x09, 0x73, 0x0f, 0x25, 0xfd, 0x5b, 0x60, 0xb4, 0xd1, 0xb8,
+	0xb3, 0x68, 0x9a, 0x24, 0x08, 0xce, 0x70, 0xcd, 0xa6, 0x7f, 0xa2, 0x0a, 0x13, 0x22, 0x0c, 0xab,
+	0xed, 0x5a, 0xb6, 0xdb, 0xbc, 0x04, 0x89, 0x75, 0x0b, 0x46, 0xb9, 0x5d, 0xf7, 0x84, 0x14, 0xc4,
+	0x8d, 0xa8, 0x52, 0x3a, 0xd7, 0x83, 0x04, 0xe0, 0x18, 0x11, 0xba, 0x0b, 0x43, 0x6f, 0x50, 0x4e,
+	0x12, 0x7d, 0x17, 0xa7, 0x92, 0x2d, 0xe4, 0xa6, 0x67, 0x4c, 0x28, 0xc0, 0x02, 0x05, 0x0a, 0x98,
+	0x43, 0x3a, 0x53, 0xe7, 0xfa, 0x09, 0x36, 0x94, 0x98, 0x59, 0x99, 0x5c, 0x6e, 0x5c, 0xf8, 0xb5,
+	0xb3, 0x5f, 0x58, 0x12, 0x62, 0x89, 0x6a, 0x12, 0x2d, 0xde, 0x26, 0x89, 0x6a, 0x12, 0x7d, 0x2e,
+	0x90, 0x87, 0x9f, 0x83, 0x99, 0xdc, 0xc9, 0x38, 0x59, 0x59, 0xd6, 0x7f, 0xa1, 0x02, 0x03, 0x0d,
+	0x42, 0xac, 0x4b, 0xd8, 0x99, 0xaf, 0x25, 0x74, 0xa9, 0x6f, 0x2d, 0x9d, 0x2a, 0xa7, 0x48, 0x95,
+	0xda, 0x4d, 0xa9, 0x52, 0x1f, 0x2a, 0x4d, 0xa1, 0xb7, 0x26, 0xf5, 0x93, 0x15, 0x00, 0x5a, 0x6d,
+	0xc9, 0x30, 0xf7, 0x39, 0xc7, 0x91, 0xbb, 0x59, 0x4b, 0x72, 0x9c, 0xec, 0x36, 0xbc, 0x4c, 0x37,
+	0x16, 0xe6, 0x8d, 0xd5, 0xb4, 0xd3, 0xde, 0x58, 0xb4, 0x04, 0x0b, 0x48, 0x92, 0x5b, 0x0c, 0x9c,
+	0x13, 0xb7, 0xd0, 0x0f, 0x81, 0x25, 0x32, 0x5f, 0xde, 0x68, 0xa0, 0x96, 0x32, 0x3b, 0x95, 0xf2,
+	0x96, 0x02, 0x81, 0xee, 0xc4, 0xaf, 0xfc, 0x13, 0x1a, 0x5c, 0x49, 0xd5, 0x3d, 0x85, 0xc5, 0xe8,
+	0x42, 0x78, 0xa6, 0xfe, 0x9b, 0x1a, 0x8c, 0xd0, 0xbe, 0x5c, 0x02, 0xa3, 0xf9, 0x3b, 0x49, 0x46,
+	0xf3, 0x81, 0xb2, 0x53, 0x5c, 0xc0, 0x5f, 0xfe, 0xa4, 0x02, 0x2c, 0x27, 0x95, 0x70, 0xd6, 0x52,
+	0x7c, 0xa0, 0xb4, 0x02, 0x1f, 0xa8, 0x9b, 0xc2, 0x85, 0x2a, 0x25, 0x35, 0x2a, 0x6e, 0x54, 0xef,
+	0x51, 0xbc, 0xa4, 0xaa, 0xc9, 0xcf, 0x26, 0xc7, 0x53, 0xea, 0x4d, 0x98, 0x60, 0xd2, 0xb3, 0x0c,
+	0x8c, 0x33, 0x50, 0xfe, 0x66, 0x8e, 0x09, 0xc2, 0xd1, 0x50, 0xf8, 0x55, 0x7c, 0x43, 0xc5, 0x8d,
+	0x93, 0xa4, 0xd0, 0x02, 0xc0, 0x8e, 0xe3, 0x99, 0xfb, 0xb5, 0xfa, 0x32, 0x8e, 0x5e, 0x7b, 0x30,
+	0x87, 0xda, 0x25, 0x59, 0x8a, 0x95, 0x1a, 0x7d, 0x79, 0x75, 0xfd, 0x91, 0xc6, 0x67, 0xfa, 0x0c,
+	0x9b, 0xf7, 0x12, 0x39, 0xca, 0xbb, 0x53, 0x1c, 0x45, 0x72, 0xc8, 0x14, 0x57, 0x99, 0x8f, 0x04,
+	0xf6, 0x81, 0xf8, 0x26, 0x2e, 0x91, 0x25, 0xf4, 0x57, 0xc4, 0x30, 0x65, 0x5a, 0xb3, 0x36, 0x4c,
+	0x38, 0x6a, 0xe6, 0x77, 0xf1, 0x8d, 0x94, 0x4a, 0x1a, 0x2f, 0x3d, 0x82, 0x13, 0xc5, 0x38, 0x49,
+	0x00, 0xbd, 0x1f, 0x26, 0xa2, 0xd1, 0xd1, 0xc9, 0x8c, 0x7c, 0xd8, 0xd8, 0x76, 0xd8, 0x54, 0x01,
+	0x38, 0x59, 0x4f, 0xff, 0x6c, 0x05, 0x1e, 0xe5, 0x7d, 0x67, 0xf6, 0xc8, 0x65, 0xd2, 0x26, 0xae,
+	0x45, 0x5c, 0xb3, 0xcb, 0x64, 0x56, 0xcb, 0x6b, 0xa2, 0xb7, 0x60, 0xe8, 0x3e, 0x21, 0x96, 0xbc,
+	0xdb, 0x7b, 0xb9, 0x7c, 0x56, 0xb8, 0x02, 0x12, 0x2f, 0x33, 0xf4, 0x9c, 0xa3, 0xf3, 0xff, 0xb1,
+	0x20, 0x49, 0x89, 0xb7, 0x7d, 0x6f, 0x47, 0x8a, 0x56, 0xe7, 0x4f, 0x7c, 0x93, 0xa1, 0xe7, 0xc4,
+	0xf9, 0xff, 0x58, 0x90, 0xd4, 0x37, 0xe1, 0xf1, 0x53, 0x34, 0x3d, 0x8b, 0x08, 0x7d, 0x12, 0x46,
+	0x3e, 0xfa, 0xb3, 0x60, 0xfc, 0x8a, 0x06, 0x4f, 0x28, 0x28, 0x57, 0x0e, 0xa9, 0x54, 0x5f, 0x33,
+	0xda, 0x86, 0x49, 0xd5, 0x67, 0x16, 0xec, 0xe3, 0x4c, 0x59, 0xaa, 0x3e, 0xa1, 0xc1, 0x30, 0x77,
+	0x29, 0x8c, 0xd8, 0xef, 0x6b, 0x7d, 0x4e, 0x79, 0x61, 0x97, 0xa2, 0x68, 0xf8, 0xd1, 0xd8, 0xf8,
+	0xef, 0x00, 0x47, 0xf4, 0xf5, 0x7f, 0x33, 0x08, 0xdf, 0x70, 0x7a, 0x44, 0xe8, 0x8f, 0xb4, 0x6c,
+	0xba, 0xfe, 0xd6, 0xc5, 0x76, 0x5e, 0x5a, 0x5f, 0x84, 0x62, 0xfc, 0x72, 0x26, 0xc5, 0xdc, 0x39,
+	0x19, 0x76, 0x94, 0xb4, 0xa8, 0xff, 0x4c, 0x83, 0x71, 0x7a, 0x2c, 0x49, 0xe6, 0xc2, 0x97, 0xa9,
+	0x7d, 0xc1, 0x23, 0xdd, 0x50, 0x48, 0xa6, 0xa2, 0x02, 0xa8, 0x20, 0x9c, 0xe8, 0x1b, 0xda, 0x4e,
+	0xde, 0x8b, 0x73, 0x75, 0xeb, 0xb1, 0x3c, 0x69, 0xe4, 0x2c, 0x09, 0x1c, 0xe7, 0x1c, 0x98, 0x4c,
+	0xce, 0xfc, 0x45, 0x5a, 0x9e, 0xe6, 0x5e, 0x84, 0xe9, 0xcc, 0xe8, 0xcf, 0x64, 0xdc, 0xf8, 0x7b,
+	0x03, 0x30, 0xaf, 0x4c, 0x75, 0xc2, 0xa9, 0x38, 0x92, 0x09, 0x7e, 0x4c, 0x83, 0x31, 0xc3, 0x75,
+	0x85, 0x63, 0x5a, 0xb4, 0x7f, 0xad, 0x3e, 0x57, 0x35, 0x8f, 0xd4, 0xc2, 0x62, 0x4c, 0x26, 0xe5,
+	0x79, 0xa5, 0x40, 0xb0, 0xda, 0x9b, 0x1e, 0xee, 0xc5, 0x95, 0x4b, 0x73, 0x2f, 0x46, 0xdf, 0x15,
+	0x1d, 0xc4, 0x7c, 0x1b, 0xbd, 0x72, 0x01, 0x73, 0xc3, 0xce, 0xf5, 0x7c, 0x6b, 0xda, 0xdc, 0x87,
+	0x60, 0x2a, 0x3d, 0x73, 0x67, 0xda, 0x05, 0xbf, 0x50, 0x4d, 0xb0, 0xea, 0x42, 0xf2, 0xa7, 0xb0,
+	0x21, 0x7e, 0x3e, 0xb5, 0x59, 0x38, 0x0b, 0xb0, 0x2f, 0x6a, 0x42, 0xce, 0x77, 0xc7, 0x54, 0x2f,
+	0xcf, 0x21, 0xbd, 0xdf, 0x25, 0x5b, 0x82, 0x19, 0x65, 0x7e, 0x94, 0x84, 0xb9, 0x4f, 0xc1, 0xf0,
+	0x81, 0x1d, 0xd8, 0x51, 0x18, 0x36, 0xe5, 0x84, 0x7e, 0x89, 0x17, 0xe3, 0x08, 0xae, 0xaf, 0x25,
+	0xbe, 0xfd, 0x2d, 0xaf, 0xed, 0x39, 0x5e, 0xb3, 0xbb, 0x78, 0xdf, 0xf0, 0x09, 0xf6, 0x3a, 0xa1,
+	0xc0, 0x76, 0xda, 0xf3, 0x7e, 0x1d, 0x6e, 0x2a, 0xd8, 0x72, 0x83, 0xd5, 0x9c, 0x05, 0xdd, 0x6f,
+	0x0f, 0x47, 0xa2, 0xab, 0x78, 0xcd, 0xff, 0xcb, 0x1a, 0x3c, 0x44, 0x8a, 0x8e, 0x02, 0x21, 0xc7,
+	0xbe, 0x72, 0x51, 0x47, 0x8d, 0x08, 0x8c, 0x5d, 0x04, 0xc6, 0xc5, 0x3d, 0x43, 0xdd, 0x44, 0xda,
+	0xe8, 0x4a, 0x3f, 0x76, 0xb8, 0x9c, 0xf5, 0xee, 0x95, 0x34, 0x1a, 0xfd, 0x94, 0x06, 0xd7, 0x9c,
+	0x9c, 0x4f, 0x47, 0x88, 0xac, 0x8d, 0x0b, 0xf8, 0x2a, 0xb9, 0x47, 0x45, 0x1e, 0x04, 0xe7, 0x76,
+	0x05, 0xfd, 0x4c, 0x61, 0x14, 0x25, 0xee, 0xf0, 0xb0, 0xd5, 0x67, 0x27, 0xcf, 0x2b, 0xa0, 0xd2,
+	0x67, 0x35, 0x40, 0x56, 0x46, 0x2c, 0x16, 0xfe, 0x74, 0x1f, 0x3d, 0x77, 0xe1, 0x9f, 0xbb, 0xc4,
+	0x64, 0xcb, 0x71, 0x4e, 0x27, 0xd8, 0x3a, 0x87, 0x39, 0x9f, 0xaf, 0x88, 0x19, 0xde, 0xef, 0x3a,
+	0xe7, 0x71, 0x06, 0xbe, 0xce, 0x79, 0x10, 0x9c, 0xdb, 0x15, 0xfd, 0x37, 0x86, 0xb8, 0x95, 0x86,
+	0xb9, 0x12, 0xec, 0xc0, 0xd0, 0x0e, 0xb3, 0xea, 0x89, 0xef, 0xb6, 0xb4, 0x09, 0x91, 0xdb, 0x06,
+	0xb9, 0x8e, 0xc4, 0xff, 0xc7, 0x02, 0x33, 0x7a, 0x15, 0xaa, 0x96, 0x1b, 0x25, 0xe9, 0xff, 0x60,
+	0x1f, 0xc6, 0xb0, 0xf8, 0xf9, 0xe3, 0xf2, 0x46, 0x03, 0x53, 0xa4, 0xc8, 0x85, 0x11, 0x57, 0x18,
+	0x36, 0x84, 0xee, 0x59, 0x3a, 0x23, 0xb9, 0x34, 0x90, 0x48, 0xb3, 0x4c, 0x54, 0x82, 0x25, 0x0d,
+	0x4a, 0x2f, 0x65, 0xc9, 0x2f, 0x4d, 0x4f, 0x9a, 0xf6, 0x7a, 0x59, 0x4f, 0x09, 0x0c, 0x85, 0x86,
+	0xed, 0x86, 0xdc, 0xac, 0x52, 0xd2, 0x21, 0x87, 0x52, 0xdb, 0xa2, 0x58, 0x62, 0xfb, 0x05, 0xfb,
+	0x19, 0x60, 0x81, 0x9c, 0x6e, 0x83, 0x03, 0xcf, 0xe9, 0xb4, 0x88, 0xf8, 0x8c, 0x4a, 0x6f, 0x83,
+	0x97, 0x18, 0x16, 0xbe, 0x0d, 0xf8, 0xff, 0x58, 0x60, 0x46, 0xaf, 0xc3, 0x48, 0x10, 0xb9, 0x50,
+	0x8d, 0xf4, 0x9b, 0x3c, 0x5e, 0xf8, 0x4f, 0x89, 0x77, 0x86, 0xc2, 0x71, 0x4a, 0xe2, 0x47, 0x3b,
+	0x30, 0x6c, 0xf3, 0x97, 0x71, 0x22, 0x04, 0xdc, 0x07, 0xfb, 0x48, 0x1e, 0xca, 0xd5, 0x60, 0xf1,
+	0x03, 0x47, 0x88, 0xf5, 0xbf, 0x18, 0xe3, 0x56, 0x71, 0xe1, 0xc5, 0xb1, 0x0b, 0x23, 0x11, 0xba,
+	0x7e, 0xde, 0xbb, 0x46, 0xd9, 0xaa, 0xf9, 0xd0, 0x64, 0xee, 0x6a, 0x89, 0x1b, 0xd5, 0xf2, 0x9e,
+	0x6f, 0xc7, 0x99, 0x54, 0x4e, 0xf7, 0x74, 0xfb, 0x0d, 0x96, 0x5f, 0x35, 0x8a, 0xe7, 0x52, 0x2d,
+	0xbf, 0xb5, 0x64, 0xac, 0x97, 0x44, 0x5e, 0xd5, 0x28, 0x1c, 0x8c, 0x42, 0xa4, 0xc0, 0x8b, 0x77,
+	0xa0, 0x94, 0x17, 0xef, 0x0b, 0x70, 0x45, 0x38, 0x33, 0xd5, 0x59, 0x96, 0xc5, 0xb0, 0x2b, 0x9e,
+	0x64, 0x31, 0x7f, 0xba, 0x5a, 0x12, 0x84, 0xd3, 0x75, 0xd1, 0xaf, 0x6b, 0x30, 0x62, 0x0a, 0x01,
+	0x41, 0x7c, 0x57, 0x6b, 0xfd, 0x5d, 0x9d, 0x2c, 0x44, 0xf2, 0x06, 0x17, 0x7d, 0x5f, 0x8a, 0xbe,
+	0xe8, 0xa8, 0xf8, 0x9c, 0x54, 0x7c, 0xd9, 0x6b, 0xf4, 0x5b, 0x54, 0xba, 0x77, 0x58, 0x0a, 0x69,
+	0x16, 0x33, 0x83, 0xbf, 0x15, 0xbb, 0xd7, 0xe7, 0x28, 0x16, 0x63, 0x8c, 0x7c, 0x20, 0xdf, 0x26,
+	0x65, 0xf8, 0x18, 0x72, 0x4e, 0x63, 0x51, 0xbb, 0x8f, 0xfe, 0xa9, 0x06, 0x4f, 0xf0, 0x07, 0x7a,
+	0x35, 0x7a, 0xe6, 0xef, 0xda, 0xa6, 0x11, 0x12, 0x1e, 0xb6, 0x26, 0x7a, 0x9f, 0xc4, 0x7d, 0x8e,
+	0x47, 0xce, 0xec, 0x73, 0xfc, 0xe4, 0xf1, 0xd1, 0xfc, 0x13, 0xb5, 0x53, 0xe0, 0xc6, 0xa7, 0xea,
+	0x01, 0x7a, 0x13, 0x26, 0x1c, 0x35, 0xae, 0x97, 0x60, 0x30, 0xa5, 0x0c, 0xf3, 0x89, 0x00, 0x61,
+	0xdc, 0x12, 0x9b, 0x28, 0xc2, 0x49, 0x52, 0xcc, 0x8f, 0x9f, 0x99, 0xea, 0x59, 0x14, 0xb2, 0x38,
+	0xd3, 0xd9, 0x5d, 0xd2, 0x0d, 0x66, 0xa1, 0xbc, 0x1f, 0x7f, 0x23, 0x1f, 0x67, 0xec, 0xc7, 0x5f,
+	0x50, 0x21, 0xc0, 0x85, 0xdd, 0x99, 0xdb, 0x87, 0x89, 0xc4, 0x47, 0x71, 0xa1, 0xe6, 0x17, 0x17,
+	0xa6, 0xd2, 0x7b, 0xf7, 0x42, 0x1d, 0x8d, 0xee, 0xc2, 0xa8, 0x3c, 0x54, 0xd1, 0xa3, 0x0a, 0xa1,
+	0x58, 0x44, 0xb9, 0x4b, 0xba, 0x9c, 0xea, 0x7c, 0x42, 0x75, 0xe4, 0x77, 0x03, 0x2f, 0xd1, 0x02,
+	0x81, 0x50, 0xff, 0x5d, 0x71, 0x37, 0xb0, 0x45, 0x5a, 0x6d, 0xc7, 0x08, 0xc9, 0xdb, 0xff, 0x66,
+	0x5a, 0xff, 0x53, 0x8d, 0x9f, 0x8d, 0x5c, 0x04, 0x40, 0x06, 0x8c, 0xb5, 0x78, 0x2c, 0x7c, 0x16,
+	0xd2, 0x46, 0x2b, 0x1f, 0x4c, 0x67, 0x3d, 0x46, 0x83, 0x55, 0x9c, 0xe8, 0x3e, 0x8c, 0x46, 0x42,
+	0x53, 0x64, 0xeb, 0x58, 0xed, 0x4f, 0x88, 0x91, 0xf2, 0x99, 0xbc, 0xf4, 0x8c, 0x4a, 0x02, 0x1c,
+	0xd3, 0xd2, 0x0d, 0x40, 0xd9, 0x36, 0x54, 0xbf, 0x8e, 0x9e, 0x2b, 0x69, 0xc9, 0xe8, 0xb5, 0x99,
+	0x27, 0x4b, 0x27, 0xfa, 0x9b, 0xe9, 0x5f, 0xa8, 0x40, 0x6e, 0xea, 0x51, 0xa4, 0xc3, 0x10, 0x7f,
+	0x41, 0x2c, 0x88, 0x30, 0xb1, 0x8b, 0x3f, 0x2f, 0xc6, 0x02, 0x82, 0xee, 0x71, 0x1b, 0x8b, 0x6b,
+	0xb1, 0xa8, 0xb1, 0x31, 0x47, 0x53, 0xdf, 0xaa, 0xaf, 0xe4, 0x55, 0xc0, 0xf9, 0xed, 0xd0, 0x01,
+	0xa0, 0x96, 0x71, 0x98, 0xc6, 0xd6, 0x47, 0x6e, 0xbd, 0xf5, 0x0c, 0x36, 0x9c, 0x43, 0x81, 0x1e,
+	0xfa, 0x86, 0x69, 0x92, 0x76, 0x48, 0x2c, 0x3e, 0xc4, 0xe8, 0x6a, 0x92, 0x1d, 0xfa, 0x8b, 0x49,
+	0x10, 0x4e, 0xd7, 0xd5, 0xbf, 0x3a, 0x00, 0x0f, 0x25, 0x27, 0x91, 0x7e, 0xa1, 0xd1, 0x23, 0xdf,
+	0x17, 0xa3, 0x77, 0x41, 0x7c, 0x22, 0x9f, 0x4a, 0xbf, 0x0b, 0x9a, 0x55, 0x33, 0x3d, 0x8b, 0x46,
+	0x89, 0x37, 0x42, 0x5f, 0x83, 0x17, 0xbb, 0x05, 0x2f, 0x93, 0xab, 0x17, 0xfa, 0x32, 0xf9, 0x93,
+	0x1a, 0xcc, 0x25, 0x8b, 0x57, 0x6d, 0xd7, 0x0e, 0xf6, 0x44, 0xec, 0xd3, 0xb3, 0x3f, 0x4b, 0x62,
+	0xd9, 0x80, 0xd6, 0x0a, 0x31, 0xe2, 0x1e, 0xd4, 0xd0, 0xa7, 0x34, 0x78, 0x38, 0x35, 0x2f, 0x89,
+	0x48, 0xac, 0x67, 0x7f, 0xa1, 0xc4, 0x62, 0x2c, 0xac, 0x15, 0xa3, 0xc4, 0xbd, 0xe8, 0xb1, 0x87,
+	0x1a, 0xdc, 0xeb, 0xf4, 0x6d, 0xf1, 0x50, 0x83, 0x9f, 0xea, 0x17, 0xfa, 0x50, 0x43, 0x0a, 0x0e,
+	0x3d, 0xdc, 0x8b, 0xbe, 0x0d, 0xae, 0xb3, 0x6a, 0x8b, 0x16, 0x33, 0xf8, 0x04, 0xc4, 0x5a, 0xb4,
+	0x2c, 0x16, 0xe1, 0xe5, 0x64, 0x2b, 0xf7, 0xa3, 0x50, 0xed, 0xf8, 0x4e, 0x3a, 0x3a, 0xd2, 0x36,
+	0x5e, 0xc3, 0xb4, 0x5c, 0xff, 0xa4, 0x06, 0x53, 0x0c, 0xb7, 0xf2, 0xf9, 0xa2, 0x03, 0x18, 0xf1,
+	0xc5, 0x27, 0x2c, 0xd6, 0x66, 0xad, 0xf4, 0xd0, 0x72, 0xd8, 0x82, 0x48, 0x8e, 0x2c, 0x7e, 0x61,
+	0x49, 0x4b, 0xff, 0xf2, 0x10, 0xcc, 0x16, 0x35, 0x42, 0x9f, 0xd6, 0xe0, 0xba, 0x19, 0x4b, 0x9e,
+	0x8b, 0x9d, 0x70, 0xcf, 0xf3, 0xed, 0xd0, 0x16, 0x2e, 0x27, 0x25, 0x55, 0xf2, 0xda, 0xa2, 0xec,
+	0x15, 0x8b, 0x1c, 0x5a, 0xcb, 0xa5, 0x80, 0x0b, 0x28, 0xa3, 0xb7, 0x00, 0xf6, 0xe3, 0x50, 0xe5,
+	0x95, 0xf2, 0x79, 0x8b, 0xd8, 0xb0, 0x95, 0x70, 0xe6, 0x51, 0xa7, 0x98, 0xcd, 0x54, 0x29, 0x57,
+	0xc8, 0x51, 0xe2, 0x41, 0xb0, 0x77, 0x97, 0x74, 0xdb, 0x86, 0x1d, 0x39, 0x16, 0xf4, 0x21, 0xdd,
+	0x36, 0xee, 0x08, 0x54, 0x49, 0xe2, 0x4a, 0xb9, 0x42, 0x0e, 0x7d, 0xaf, 0x06, 0x13, 0x9e, 0x1a,
+	0x0e, 0xa2, 0x1f, 0xbf, 0xcd, 0xdc, 0xb8, 0x12, 0x5c, 0xdc, 0x4f, 0x82, 0x92, 0x24, 0xe9, 0x9e,
+	0x98, 0x0e, 0xd2, 0x47, 0x96, 0x60, 0x6a, 0xeb, 0xfd, 0x67, 0x36, 0x57, 0xce, 0x3f, 0x6e, 0x3a,
+	0xc8, 0x82, 0xb3, 0xe4, 0x59, 0xa7, 0x48, 0x68, 0x5a, 0x09, 0x71, 0x5f, 0x24, 0x1f, 0x28, 0xd5,
+	0xa9, 0x95, 0xad, 0xda, 0x72, 0x02, 0x59, 0xb2, 0x53, 0x59, 0x70, 0x96, 0xbc, 0xfe, 0xf1, 0x0a,
+	0xdc, 0x28, 0xd8, 0x63, 0x7f, 0x63, 0xe2, 0x77, 0x7c, 0x49, 0x83, 0x51, 0x36, 0x07, 0x6f, 0x93,
+	0xf7, 0x6e, 0xfc, 0xcd, 0x48, 0xbe, 0xff, 0xdd, 0x6f, 0x6a, 0x30, 0x9d, 0x89, 0x59, 0x7d, 0xaa,
+	0x87, 0x13, 0x97, 0xe6, 0x1a, 0xf6, 0xae, 0x38, 0x3f, 0x45, 0x35, 0x0e, 0x48, 0x90, 0xce, 0x4d,
+	0xa1, 0xbf, 0x0c, 0x13, 0x09, 0xf7, 0x3b, 0x19, 0x6b, 0x4d, 0xcb, 0x8d, 0xb5, 0xa6, 0x86, 0x52,
+	0xab, 0xf4, 0x0a, 0xa5, 0x16, 0x6f, 0xf9, 0x2c, 0x67, 0xfb, 0x1b, 0xb3, 0xe5, 0xbf, 0x72, 0x45,
+	0x6c, 0x79, 0x76, 0x97, 0xf1, 0x1a, 0x0c, 0xb1, 0xc0, 0x6d, 0xd1, 0x89, 0xf9, 0x7c, 0xe9, 0x80,
+	0x70, 0x01, 0xd7, 0xa4, 0xf8, 0xff, 0x58, 0x60, 0x45, 0xcb, 0x30, 0x65, 0x3a, 0x5e, 0xc7, 0x12,
+	0x39, 0x96, 0x37, 0x62, 0xa5, 0x4d, 0x46, 0x5a, 0xae, 0xa5, 0xe0, 0x38, 0xd3, 0x02, 0x61, 0x7e,
+	0x1b, 0xc2, 0xcf, 0xb3, 0x52, 0x91, 0x96, 0x97, 0x37, 0x1a, 0x3c, 0x99, 0x90, 0xbc, 0x05, 0x79,
+	0x03, 0x80, 0x44, 0x9b, 0x37, 0x7a, 0x0f, 0xfd, 0x42, 0xb9, 0x18, 0xd2, 0xf2, 0x13, 0x88, 0x84,
+	0x4f, 0x59, 0x14, 0x60, 0x85, 0x08, 0xf2, 0x61, 0x6c, 0xcf, 0xde, 0x21, 0xbe, 0xcb, 0xe5, 0xa8,
+	0xc1, 0xf2, 0x22, 0xe2, 0x9d, 0x18, 0x0d, 0xd7, 0xf1, 0x95, 0x02, 0xac, 0x12, 0x41, 0x3e, 0x17,
+	0x47, 0xb8, 0x29, 0x5b, 0x1c, 0x39, 0x1f, 0xea, 0x2f, 0x9f, 0x49, 0x3c, 0xce, 0xb8, 0x0c, 0x2b,
+	0x54, 0x90, 0x0b, 0xe0, 0xca, 0x88, 0x8d, 0xfd, 0xdc, 0x8e, 0xc4, 0x71, 0x1f, 0xb9, 0xe0, 0x11,
+	0xff, 0xc6, 0x0a, 0x05, 0x3a, 0xaf, 0x4a, 0x68, 0x0c, 0x61, 0xef, 0x7c, 0xb1, 0xcf, 0xe0, 0x1c,
+	0xc2, 0x76, 0x12, 0x17, 0x60, 0x95, 0x08, 0x1d, 0x63, 0x4b, 0x06, 0xee, 0x14, 0xf6, 0xcc, 0x52,
+	0x63, 0x8c, 0xc3, 0x7f, 0x8a, 0x8c, 0x94, 0xf2, 0x37, 0x56, 0x28, 0xa0, 0xd7, 0x95, 0x4b, 0x34,
+	0x28, 0x6f, 0x81, 0x3a, 0xd5, 0x05, 0xda, 0xfb, 0x62, 0x43, 0xcc, 0x18, 0xfb, 0x56, 0x1f, 0x56,
+	0x8c, 0x30, 0x2c, 0xa0, 0x29, 0xe5, 0x1f, 0x19, 0xa3, 0x4c, 0xec, 0xf8, 0x3b, 0xde, 0xd3, 0xf1,
+	0xb7, 0x46, 0x25, 0x34, 0xe5, 0x21, 0x0a, 0x63, 0x0a, 0x13, 0xf1, 0x6d, 0x4c, 0x23, 0x0d, 0xc4,
+	0xd9, 0xfa, 0x9c, 0xe9, 0x13, 0x8b, 0xb5, 0x9d, 0x54, 0x99, 0x3e, 0x2f, 0xc3, 0x12, 0x8a, 0x0e,
+	0x60, 0x3c, 0x50, 0xbc, 0x88, 0x45, 0x1a, 0xe1, 0x3e, 0xee, 0xd1, 0x84, 0x07, 0x31, 0x0b, 0x65,
+	0xa7, 0x96, 0xe0, 0x04, 0x1d, 0xf4, 0x96, 0xea, 0x36, 0x39, 0x55, 0xfe, 0x9d, 0x78, 0x7e, 0xa0,
+	0xd6, 0xd8, 0xc2, 0x26, 0x3d, 0xf6, 0x54, 0x6f, 0xc6, 0x4e, 0xd2, 0x41, 0x70, 0xfa, 0x5c, 0x02,
+	0x70, 0x9c, 0xe8, 0x40, 0x48, 0x97, 0x96, 0x1c, 0xb6, 0xbd, 0xa0, 0xe3, 0x13, 0x16, 0x12, 0x9c,
+	0x2d, 0x0f, 0x8a, 0x97, 0x76, 0x25, 0x0d, 0xc4, 0xd9, 0xfa, 0xe8, 0x07, 0x34, 0x98, 0xe2, 0x59,
+	0x98, 0xe9, 0xd1, 0xe5, 0xb9, 0xc4, 0x0d, 0x03, 0x96, 0x66, 0xb8, 0xe4, 0xab, 0xce, 0x46, 0x0a,
+	0x17, 0x4f, 0x5d, 0x97, 0x2e, 0xc5, 0x19, 0x9a, 0x74, 0xe7, 0xa8, 0x21, 0x3c, 0x58, 0xb6, 0xe2,
+	0x92, 0x3b, 0x47, 0x0d, 0x0f, 0xc2, 0x77, 0x8e, 0x5a, 0x82, 0x13, 0x74, 0xd0, 0xfb, 0x61, 0x22,
+	0x88, 0xf2, 0x95, 0xb1, 0x19, 0x9c, 0x89, 0xe3, 0x01, 0x36, 0x54, 0x00, 0x4e, 0xd6, 0xd3, 0xff,
+	0x9d, 0x06, 0x10, 0x5f, 0x3b, 0x5c, 0x82, 0x41, 0xc5, 0x4a, 0x18, 0x54, 0x96, 0xfa, 0xbb, 0x47,
+	0x29, 0xb4, 0x8c, 0xff, 0x84, 0x16, 0x89, 0x6d, 0x99, 0x3b, 0x93, 0x53, 0xc8, 0xb7, 0xef, 0x83,
+	0xb1, 0x5d, 0xdb, 0x6d, 0x12, 0xbf, 0xed, 0xdb, 0x6e, 0x14, 0xe2, 0x4e, 0x6e, 0xe5, 0xd5, 0x18,
+	0x84, 0xd5, 0x7a, 0xcc, 0x1a, 0xed, 0xdb, 0x2d, 0x43, 0x64, 0x11, 0x50, 0xbc, 0xbd, 0x36, 0x79,
+	0x31, 0x8e, 0xe0, 0xfa, 0xef, 0x6b, 0x30, 0x19, 0xf7, 0xef, 0x12, 0x54, 0x09, 0x33, 0xa9, 0x4a,
+	0x7c, 0xa8, 0xbf, 0x79, 0x2f, 0xd0, 0x27, 0xfe, 0x6f, 0x45, 0x1d, 0x15, 0x93, 0x16, 0x0f, 0x12,
+	0xf7, 0xf5, 0xa5, 0xdf, 0xed, 0xcb, 0x1b, 0x7a, 0xe5, 0x61, 0x72, 0x3c, 0xde, 0x9c, 0xfb, 0xfb,
+	0xbf, 0x9b, 0x90, 0xd5, 0xfa, 0x88, 0xb9, 0x21, 0x05, 0xb3, 0x88, 0x34, 0x9f, 0x80, 0x93, 0x04,
+	0xb7, 0x37, 0x54, 0x56, 0xce, 0x6f, 0xfe, 0x3f, 0x5c, 0xee, 0xcd, 0xb7, 0x32, 0xe0, 0x9e, 0x0c,
+	0x5c, 0xff, 0xd4, 0x14, 0x8c, 0x29, 0x86, 0xc0, 0x94, 0xf7, 0x81, 0x76, 0x19, 0xde, 0x07, 0x21,
+	0x8c, 0x99, 0x32, 0x05, 0x48, 0x34, 0xed, 0x7d, 0xd2, 0x94, 0xdf, 0x5d, 0x9c, 0x5c, 0x24, 0xc0,
+	0x2a, 0x19, 0x2a, 0xe8, 0xc8, 0x3d, 0x56, 0x3d, 0x07, 0x9f, 0x90, 0x5e, 0xfb, 0xea, 0xbd, 0x00,
+	0x91, 0xac, 0x4c, 0x2c, 0x11, 0x31, 0x58, 0xba, 0xdf, 0xd7, 0x83, 0x3b, 0x12, 0x86, 0x95, 0x7a,
+	0xd9, 0xdb, 0xec, 0xc1, 0xcb, 0xbb, 0xcd, 0x7e, 0x03, 0xc0, 0x89, 0x32, 0xd0, 0xf5, 0xe5, 0xdf,
+	0x24, 0xf3, 0xd8, 0xc5, 0xdb, 0x40, 0x16, 0x05, 0x58, 0x21, 0x52, 0xe0, 0x84, 0x32, 0x5c, 0xca,
+	0x09, 0xa5, 0x03, 0x57, 0x7d, 0x12, 0xfa, 0xdd, 0x5a, 0xd7, 0x64, 0x89, 0x19, 0xfd, 0x90, 0x69,
+	0xbc, 0x23, 0xe5, 0xa2, 0xc2, 0xe1, 0x2c, 0x2a, 0x9c, 0x87, 0x3f, 0x21, 0x2c, 0x8e, 0xf6, 0x14,
+	0x16, 0xdf, 0x07, 0x63, 0x21, 0x31, 0xf7, 0x5c, 0xdb, 0x34, 0x9c, 0xfa, 0xb2, 0x08, 0xa7, 0x1b,
+	0xcb, 0x3d, 0x31, 0x08, 0xab, 0xf5, 0xd0, 0x12, 0x54, 0x3b, 0xb6, 0x25, 0xa4, 0xe5, 0x6f, 0x92,
+	0x26, 0xf5, 0xfa, 0xf2, 0x83, 0xa3, 0xf9, 0x77, 0xc6, 0x5e, 0x1d, 0x72, 0x54, 0xb7, 0xda, 0xfb,
+	0xcd, 0x5b, 0x61, 0xb7, 0x4d, 0x82, 0x85, 0xed, 0xfa, 0x32, 0xa6, 0x8d, 0xf3, 0x1c, 0x74, 0xc6,
+	0xcf, 0xe0, 0xa0, 0xf3, 0x59, 0x0d, 0xae, 0x1a, 0xe9, 0xdb, 0x00, 0x12, 0xcc, 0x4e, 0x94, 0xe7,
+	0x96, 0xf9, 0x37, 0x0c, 0x4b, 0x0f, 0x8b, 0xf1, 0x5d, 0x5d, 0xcc, 0x92, 0xc3, 0x79, 0x7d, 0x40,
+	0x3e, 0xa0, 0x96, 0xdd, 0x94, 0xc9, 0xe0, 0xc4, 0xaa, 0x4f, 0x96, 0xb3, 0x73, 0xac, 0x67, 0x30,
+	0xe1, 0x1c, 0xec, 0xe8, 0x3e, 0x8c, 0x99, 0xf1, 0x9d, 0x81, 0x90, 0xfa, 0x97, 0xcf, 0xe3, 0xd2,
+	0x82, 0x6b, 0x86, 0xea, 0x85, 0x84, 0x4a, 0x49, 0xde, 0xf6, 0x29, 0x2a, 0xb9, 0xb8, 0xf1, 0x62,
+	0xa3, 0x9e, 0x2a, 0x7f, 0xdb, 0x97, 0x8f, 0x11, 0xf7, 0xa0, 0xc6, 0x62, 0xb1, 0x39, 0xc9, 0x9c,
+	0x8d, 0xb3, 0xd3, 0xe5, 0x5f, 0x58, 0xa7, 0xd2, 0x3f, 0xf2, 0xad, 0x99, 0x2a, 0xc4, 0x69, 0x82,
+	0x68, 0x15, 0x10, 0xe1, 0x42, 0x5b, 0xac, 0xc8, 0x04, 0xb3, 0x48, 0xe6, 0xb6, 0x44, 0x2b, 0x19,
+	0x28, 0xce, 0x69, 0x81, 0x1c, 0x98, 0x4a, 0x47, 0x3c, 0x14, 0x7a, 0xc1, 0x59, 0xa6, 0x93, 0x49,
+	0xff, 0xe9, 0x80, 0x8a, 0x38, 0x83, 0x19, 0xfd, 0x90, 0x06, 0x93, 0xb6, 0xbb, 0xe9, 0x18, 0xa6,
+	0xc8, 0x56, 0x16, 0xcc, 0x5e, 0x2b, 0xef, 0x2e, 0xc4, 0x03, 0xd6, 0x6d, 0x7a, 0x9e, 0x53, 0x57,
+	0x71, 0xc6, 0x61, 0xc5, 0x13, 0xc5, 0x01, 0x4e, 0x91, 0xd6, 0x7f, 0x4f, 0x13, 0x46, 0xd1, 0x4b,
+	0xf4, 0x78, 0xb9, 0xe8, 0xeb, 0x52, 0xfd, 0xcf, 0x34, 0xc8, 0xe8, 0x61, 0x68, 0x07, 0x86, 0x29,
+	0x8a, 0xe5, 0x8d, 0x86, 0x18, 0xd6, 0x07, 0xcb, 0x89, 0x1c, 0x0c, 0x05, 0xb7, 0x30, 0x8b, 0x1f,
+	0x38, 0x42, 0x4c, 0x35, 0x3b, 0x57, 0xc9, 0x8a, 0x20, 0x46, 0x58, 0x4a, 0xa6, 0x53, 0xb3, 0x2b,
+	0x70, 0xcd, 0x4e, 0x2d, 0xc1, 0x09, 0x3a, 0xfa, 0x1a, 0x40, 0xac, 0x3b, 0xf7, 0xed, 0x04, 0xf5,
+	0x8b, 0x43, 0x30, 0xd3, 0xef, 0x53, 0x15, 0x96, 0x6e, 0x91, 0x1c, 0xd8, 0x66, 0xb8, 0xb8, 0x1b,
+	0x12, 0xff, 0xde, 0xbd, 0xf5, 0xad, 0x3d, 0x9f, 0x04, 0x7b, 0x9e, 0x63, 0x95, 0xcc, 0xf7, 0xc8,
+	0x2e, 0x4d, 0x57, 0x72, 0x31, 0xe2, 0x02, 0x4a, 0xcc, 0x6e, 0x40, 0x21, 0x54, 0x6e, 0xa0, 0x5f,
+	0x45, 0xc7, 0x0f, 0x42, 0x11, 0x6f, 0x87, 0xdb, 0x0d, 0xd2, 0x40, 0x9c, 0xad, 0x9f, 0x46, 0xb2,
+	0x66, 0xb7, 0x6c, 0x9e, 0xf7, 0x4e, 0xcb, 0x22, 0x61, 0x40, 0x9c, 0xad, 0xaf, 0x22, 0xe1, 0x2b,
+	0x45, 0x39, 0xe6, 0x60, 0x16, 0x89, 0x04, 0xe2, 0x6c, 0x7d, 0x64, 0xc1, 0x23, 0x3e, 0x31, 0xbd,
+	0x56, 0x8b, 0xb8, 0x16, 0xcf, 0x64, 0x6c, 0xf8, 0x4d, 0xdb, 0x5d, 0xf5, 0x0d, 0x56, 0x91, 0x99,
+	0x61, 0x35, 0x96, 0x2b, 0xe8, 0x11, 0xdc, 0xa3, 0x1e, 0xee, 0x89, 0x05, 0xb5, 0xe0, 0x0a, 0x4f,
+	0x9b, 0xe8, 0xd7, 0xdd, 0x90, 0xf8, 0x07, 0x86, 0x23, 0x6c, 0xad, 0x67, 0x5d, 0x31, 0xc6, 0xc5,
+	0xb7, 0x93, 0xa8, 0x70, 0x1a, 0x37, 0xea, 0x52, 0xd9, 0x4d, 0x74, 0x47, 0x21, 0x39, 0x52, 0x3e,
+	0x21, 0x29, 0xce, 0xa2, 0xc3, 0x79, 0x34, 0x50, 0x1d, 0xae, 0x86, 0x86, 0xdf, 0x24, 0x61, 0x6d,
+	0x73, 0x7b, 0x93, 0xf8, 0x26, 0x3d, 0x6a, 0x1d, 0x2e, 0xca, 0x69, 0x1c, 0xd5, 0x56, 0x16, 0x8c,
+	0xf3, 0xda, 0xe8, 0x9f, 0xd5, 0x40, 0x38, 0xd9, 0xa3, 0x47, 0x12, 0xa6, 0x83, 0x91, 0x94, 0xd9,
+	0x20, 0x4a, 0x34, 0x54, 0xc9, 0x4d, 0x34, 0xf4, 0x6e, 0x25, 0x26, 0xd4, 0x68, 0xcc, 0x46, 0x39,
+	0x66, 0x25, 0x6d, 0xdd, 0xd3, 0x30, 0x2a, 0x0f, 0x32, 0xa1, 0x60, 0xb0, 0x98, 0x78, 0xf1, 0x89,
+	0x17, 0xc3, 0xf5, 0xdf, 0xd1, 0x40, 0x60, 0x60, 0x49, 0x16, 0x4f, 0x95, 0x6c, 0xef, 0xe4, 0xc8,
+	0x6b, 0x71, 0x92, 0xc0, 0x6a, 0x61, 0x92, 0xc0, 0x0b, 0xca, 0x9d, 0xf7, 0xcb, 0x1a, 0x5c, 0x49,
+	0x06, 0xe9, 0x0a, 0xd0, 0xbb, 0x60, 0x58, 0x04, 0x09, 0x16, 0xc1, 0x37, 0x59, 0x53, 0x11, 0x47,
+	0x03, 0x47, 0xb0, 0xa4, 0xf5, 0xb4, 0x0f, 0x8d, 0x3f, 0x3f, 0x56, 0xd8, 0x09, 0xca, 0xf7, 0x9f,
+	0x4d, 0xc3, 0x10, 0x3f, 0xb0, 0x29, 0x7b, 0xcc, 0x79, 0x3f, 0xdc, 0x87, 0x08, 0x50, 0xe6, 0xd1,
+	0xa7, 0x9a, 0x78, 0xa6, 0xd2, 0x33, 0xf1, 0x0c, 0xe6, 0x39, 0x49, 0xfb, 0xb8, 0x29, 0xab, 0xe1,
+	0x3a, 0xbf, 0x29, 0x93, 0xf9, 0x48, 0xc3, 0xc4, 0x15, 0xd2, 0x40, 0x79, 0x41, 0x9a, 0x4f, 0x80,
+	0x72, 0x91, 0x34, 0xd9, 0xf3, 0x12, 0x29, 0x0a, 0xb2, 0x37, 0x58, 0xde, 0x33, 0x55, 0x4c, 0xf9,
+	0x29, 0x82, 0xec, 0xc9, 0x0f, 0x69, 0xa8, 0xf0, 0x43, 0xda, 0x85, 0x61, 0xf1, 0x29, 0x08, 0x3e,
+	0xfb, 0xc1, 0x3e, 0x92, 0x7b, 0x2a, 0x11, 0xf2, 0x79, 0x01, 0x8e, 0x90, 0xd3, 0xc3, 0xbb, 0x65,
+	0x1c, 0xda, 0xad, 0x4e, 0x8b, 0x31, 0xd7, 0x41, 0xb5, 0x2a, 0x2b, 0xc6, 0x11, 0x9c, 0x55, 0xe5,
+	0x0e, 0xbd, 0x8c, 0x19, 0xaa, 0x55, 0x79, 0x31, 0x8e, 0xe0, 0xe8, 0x55, 0x18, 0x69, 0x19, 0x87,
+	0x8d, 0x8e, 0xdf, 0x24, 0xe2, 0x02, 0xa9, 0x58, 0x5c, 0xec, 0x84, 0xb6, 0xb3, 0x60, 0xbb, 0x61,
+	0x10, 0xfa, 0x0b, 0x75, 0x37, 0xbc, 0xe7, 0x37, 0x42, 0x5f, 0xe6, 0x93, 0x5b, 0x17, 0x58, 0xb0,
+	0xc4, 0x87, 0x1c, 0x98, 0x6c, 0x19, 0x87, 0xdb, 0xae, 0xc1, 0xe3, 0x27, 0x3a, 0xfc, 0xde, 0xa8,
+	0x0c, 0x05, 0xe6, 0x45, 0xb0, 0x9e, 0xc0, 0x85, 0x53, 0xb8, 0x73, 0x1c, 0x16, 0xc6, 0x2f, 0xca,
+	0x61, 0x61, 0x51, 0x3e, 0x25, 0xe3, 0x6a, 0xf4, 0x43, 0xb9, 0x21, 0x16, 0x7a, 0x3e, 0x13, 0x7b,
+	0x4d, 0x3e, 0x13, 0x9b, 0x2c, 0x7f, 0xc3, 0xde, 0xe3, 0x89, 0x58, 0x07, 0xc6, 0xa8, 0xb0, 0xce,
+	0x4b, 0xa9, 0x9e, 0x5b, 0xda, 0x22, 0xbc, 0x2c, 0xd1, 0x28, 0xb9, 0xe9, 0x63, 0xd4, 0x58, 0xa5,
+	0x83, 0xee, 0xc1, 0x8c, 0xc8, 0x16, 0x1c, 0x57, 0x61, 0xf6, 0x95, 0x29, 0xf6, 0xfd, 0x30, 0x17,
+	0xe9, 0xbb, 0x79, 0x15, 0x70, 0x7e, 0xbb, 0x38, 0x1c, 0xd0, 0x74, 0x7e, 0x38, 0x20, 0xf4, 0xc3,
+	0x79, 0xd7, 0x42, 0x88, 0xcd, 0xe9, 0x47, 0xca, 0xf3, 0x86, 0xd2, 0x97, 0x43, 0xff, 0x8a, 0xe5,
+	0x87, 0xc8, 0x4f, 0xe2, 0x2e, 0xb4, 0xd2, 0xad, 0x3e, 0xf8, 0x43, 0x61, 0x62, 0xf8, 0xa5, 0x27,
+	0x8e, 0x8f, 0xe6, 0x4f, 0x4c, 0x1f, 0x8f, 0x0b, 0xfb, 0x86, 0x7c, 0x18, 0x0e, 0xba, 0x81, 0x19,
+	0x3a, 0x91, 0x3e, 0x7b, 0xbb, 0x0f, 0xce, 0xda, 0xe0, 0x98, 0x38, 0x6b, 0x8d, 0xf3, 0xb2, 0xf0,
+	0x52, 0x1c, 0x11, 0x42, 0xff, 0x50, 0x83, 0x69, 0x61, 0xb0, 0x52, 0xde, 0x48, 0xcf, 0x94, 0x77,
+	0x24, 0xad, 0xa5, 0x91, 0xdd, 0x6b, 0xf3, 0xa4, 0x1e, 0x4c, 0x48, 0xcf, 0x40, 0x71, 0x96, 0x3a,
+	0x6a, 0x64, 0xb2, 0x97, 0x5f, 0x67, 0x5b, 0xf7, 0xe9, 0xdc, 0xec, 0xe5, 0x33, 0x62, 0xc6, 0x7b,
+	0x27, 0x2e, 0xef, 0x37, 0x32, 0x42, 0x1f, 0xa1, 0x5e, 0xe7, 0x9e, 0x87, 0x71, 0x75, 0x35, 0xce,
+	0x14, 0x90, 0xe1, 0xa7, 0x35, 0x98, 0x4a, 0x9f, 0xce, 0x68, 0x0f, 0x86, 0xc5, 0xa7, 0x2a, 0x14,
+	0xf1, 0xc5, 0xb2, 0x7e, 0x23, 0x0e, 0x11, 0xaf, 0x2f, 0xb8, 0xb0, 0x27, 0x8a, 0x70, 0x84, 0x5e,
+	0xf5, 0x0b, 0xab, 0xf4, 0xf0, 0x0b, 0xfb, 0x43, 0x0d, 0x6e, 0x14, 0xd8, 0x51, 0x4e, 0x71, 0x0f,
+	0x78, 0xbb, 0xf8, 0x11, 0xe8, 0xd9, 0x72, 0xf8, 0x3e, 0x1e, 0xc5, 0xb5, 0xe3, 0x0a, 0xaa, 0x94,
+	0xcb, 0x13, 0xb1, 0xed, 0x3e, 0x00, 0xe3, 0x7c, 0x6b, 0x58, 0x1b, 0x32, 0x0f, 0xe8, 0x60, 0x7c,
+	0xb9, 0xb0, 0xad, 0xc0, 0x70, 0xa2, 0xa6, 0xfe, 0x02, 0x5c, 0xcf, 0x67, 0x4d, 0x94, 0xb0, 0xe1,
+	0x38, 0xde, 0x7d, 0xa1, 0xd3, 0xc7, 0x19, 0x38, 0x69, 0x21, 0xe6, 0x30, 0xfd, 0xbb, 0x20, 0x9d,
+	0x1c, 0x01, 0xbd, 0x0e, 0xa3, 0x41, 0xb0, 0xc7, 0x23, 0xd3, 0x8a, 0xa5, 0x2c, 0x67, 0xcc, 0x89,
+	0xc2, 0xdb, 0x72, 0x1d, 0x46, 0xfe, 0xc4, 0x31, 0xfa, 0xa5, 0x57, 0xbe, 0xf8, 0xd5, 0xc7, 0xde,
+	0xf1, 0xbb, 0x5f, 0x7d, 0xec, 0x1d, 0x5f, 0xfe, 0xea, 0x63, 0xef, 0xf8, 0x9e, 0xe3, 0xc7, 0xb4,
+	0x2f, 0x1e, 0x3f, 0xa6, 0xfd, 0xee, 0xf1, 0x63, 0xda, 0x97, 0x8f, 0x1f, 0xd3, 0xfe, 0xcb, 0xf1,
+	0x63, 0xda, 0x8f, 0xfc, 0xe1, 0x63, 0xef, 0x78, 0xf5, 0xd9, 0x98, 0xfa, 0xad, 0x88, 0x68, 0xfc,
+	0x4f, 0x7b, 0xbf, 0x79, 0x8b, 0x52, 0x8f, 0x1e, 0x41, 0x32, 0xea, 0xff, 0x2f, 0x00, 0x00, 0xff,
+	0xff, 0xf8, 0xb5, 0xad, 0x97, 0x19, 0xfa, 0x00, 0x00,
 }
 
 func (m *APIServerLogging) Marshal() (dAtA []byte, err error) {
@@ -8350,6 +8623,18 @@ func (m *EncryptionConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.KMS != nil {
+		{
+			size, err := m.KMS.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Resources) > 0 {
 		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Resources[iNdEx])
@@ -8826,6 +9111,18 @@ func (m *Hibernation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IdlePolicy != nil {
+		{
+			size, err := m.IdlePolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Schedules) > 0 {
 		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -8853,6 +9150,39 @@ func (m *Hibernation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HibernationIdlePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HibernationIdlePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HibernationIdlePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.IdleDuration.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *HibernationSchedule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -9230,6 +9560,53 @@ func (m *InternalSecretList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *KMSConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KMSConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KMSConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProviderConfig != nil {
+		{
+			size, err := m.ProviderConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CredentialsResourceName != nil {
+		i -= len(*m.CredentialsResourceName)
+		copy(dAtA[i:], *m.CredentialsResourceName)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.CredentialsResourceName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Type)
+	copy(dAtA[i:], m.Type)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Type)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *KubeAPIServerConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -10971,6 +11348,20 @@ func (m *Maintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FreezePeriods) > 0 {
+		for iNdEx := len(m.FreezePeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FreezePeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.ConfineSpecUpdateRollout != nil {
 		i--
 		if *m.ConfineSpecUpdateRollout {
@@ -11049,6 +11440,56 @@ func (m *MaintenanceAutoUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MaintenanceFreezePeriod) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaintenanceFreezePeriod) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaintenanceFreezePeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reason != nil {
+		i -= len(*m.Reason)
+		copy(dAtA[i:], *m.Reason)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.End.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Begin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *MaintenanceTimeWindow) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -11069,6 +11510,15 @@ func (m *MaintenanceTimeWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Weekdays) > 0 {
+		for iNdEx := len(m.Weekdays) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Weekdays[iNdEx])
+			copy(dAtA[i:], m.Weekdays[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Weekdays[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	i -= len(m.End)
 	copy(dAtA[i:], m.End)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.End)))
@@ -11776,6 +12226,20 @@ func (m *ProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MaintenanceFreezePeriods) > 0 {
+		for iNdEx := len(m.MaintenanceFreezePeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaintenanceFreezePeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if m.Tolerations != nil {
 		{
 			size, err := m.Tolerations.MarshalToSizedBuffer(dAtA[:i])
@@ -12066,6 +12530,16 @@ func (m *Quota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -12203,6 +12677,181 @@ func (m *QuotaSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QuotaStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Usages) > 0 {
+		for iNdEx := len(m.Usages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Usages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Hard) > 0 {
+		keysForHard := make([]string, 0, len(m.Hard))
+		for k := range m.Hard {
+			keysForHard = append(keysForHard, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForHard)
+		for iNdEx := len(keysForHard) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Hard[k8s_io_api_core_v1.ResourceName(keysForHard[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForHard[iNdEx])
+			copy(dAtA[i:], keysForHard[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForHard[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuotaUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shoots) > 0 {
+		for iNdEx := len(m.Shoots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shoots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Used) > 0 {
+		keysForUsed := make([]string, 0, len(m.Used))
+		for k := range m.Used {
+			keysForUsed = append(keysForUsed, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+		for iNdEx := len(keysForUsed) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Used[k8s_io_api_core_v1.ResourceName(keysForUsed[iNdEx])]
+			baseI := i
+			{
+				size, err := (&v).MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForUsed[iNdEx])
+			copy(dAtA[i:], keysForUsed[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForUsed[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Namespace != nil {
+		i -= len(*m.Namespace)
+		copy(dAtA[i:], *m.Namespace)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuotaUsageShoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuotaUsageShoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuotaUsageShoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Generation))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Region) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -13617,6 +14266,20 @@ func (m *SeedStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ShootStateEncryptionKeys) > 0 {
+		for iNdEx := len(m.ShootStateEncryptionKeys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ShootStateEncryptionKeys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if m.LastOperation != nil {
 		{
 			size, err := m.LastOperation.MarshalToSizedBuffer(dAtA[:i])
@@ -14776,6 +15439,47 @@ func (m *ShootState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ShootStateEncryptionKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ShootStateEncryptionKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ShootStateEncryptionKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Primary {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.Fingerprint)
+	copy(dAtA[i:], m.Fingerprint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Fingerprint)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *ShootStateList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -14908,6 +15612,36 @@ func (m *ShootStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.InPlaceUpdates) > 0 {
+		for iNdEx := len(m.InPlaceUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InPlaceUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.LastActivityTime != nil {
+		{
+			size, err := m.LastActivityTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
 	if len(m.EncryptedResources) > 0 {
 		for iNdEx := len(m.EncryptedResources) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.EncryptedResources[iNdEx])
@@ -15489,6 +16223,15 @@ func (m *Worker) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.UpdateStrategy != nil {
+		i -= len(*m.UpdateStrategy)
+		copy(dAtA[i:], *m.UpdateStrategy)
+		i = encodeVarintGenerated(dAtA, i, uint64(len(*m.UpdateStrategy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
 	if m.ClusterAutoscaler != nil {
 		{
 			size, err := m.ClusterAutoscaler.MarshalToSizedBuffer(dAtA[:i])
@@ -15798,6 +16541,45 @@ func (m *WorkerKubernetes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *WorkerPoolInPlaceUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkerPoolInPlaceUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkerPoolInPlaceUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.UpdatedNodes))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Nodes))
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.KubernetesVersion)
+	copy(dAtA[i:], m.KubernetesVersion)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.KubernetesVersion)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *WorkerSystemComponents) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -16854,6 +17636,10 @@ func (m *EncryptionConfig) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.KMS != nil {
+		l = m.KMS.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -17040,6 +17826,21 @@ func (m *Hibernation) Size() (n int) {
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.IdlePolicy != nil {
+		l = m.IdlePolicy.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *HibernationIdlePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.IdleDuration.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -17184,6 +17985,25 @@ func (m *InternalSecretList) Size() (n int) {
 	return n
 }
 
+func (m *KMSConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CredentialsResourceName != nil {
+		l = len(*m.CredentialsResourceName)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.ProviderConfig != nil {
+		l = m.ProviderConfig.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *KubeAPIServerConfig) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17839,6 +18659,12 @@ func (m *Maintenance) Size() (n int) {
 	if m.ConfineSpecUpdateRollout != nil {
 		n += 2
 	}
+	if len(m.FreezePeriods) > 0 {
+		for _, e := range m.FreezePeriods {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -17855,6 +18681,23 @@ func (m *MaintenanceAutoUpdate) Size() (n int) {
 	return n
 }
 
+func (m *MaintenanceFreezePeriod) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Begin.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.End.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Reason != nil {
+		l = len(*m.Reason)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *MaintenanceTimeWindow) Size() (n int) {
 	if m == nil {
 		return 0
@@ -17865,6 +18708,12 @@ func (m *MaintenanceTimeWindow) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = len(m.End)
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Weekdays) > 0 {
+		for _, s := range m.Weekdays {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -18170,6 +19019,12 @@ func (m *ProjectSpec) Size() (n int) {
 		l = m.Tolerations.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.MaintenanceFreezePeriods) > 0 {
+		for _, e := range m.MaintenanceFreezePeriods {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -18257,6 +19112,8 @@ func (m *Quota) Size() (n int) {
 	n += 1 + l + sovGenerated(uint64(l))
 	l = m.Spec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
@@ -18300,6 +19157,72 @@ func (m *QuotaSpec) Size() (n int) {
 	return n
 }
 
+func (m *QuotaStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hard) > 0 {
+		for k, v := range m.Hard {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Usages) > 0 {
+		for _, e := range m.Usages {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuotaUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Namespace != nil {
+		l = len(*m.Namespace)
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Used) > 0 {
+		for k, v := range m.Used {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Shoots) > 0 {
+		for _, e := range m.Shoots {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuotaUsageShoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Generation))
+	return n
+}
+
 func (m *Region) Size() (n int) {
 	if m == nil {
 		return 0
@@ -18855,6 +19778,12 @@ func (m *SeedStatus) Size() (n int) {
 		l = m.LastOperation.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if len(m.ShootStateEncryptionKeys) > 0 {
+		for _, e := range m.ShootStateEncryptionKeys {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -19236,6 +20165,20 @@ func (m *ShootState) Size() (n int) {
 	return n
 }
 
+func (m *ShootStateEncryptionKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Fingerprint)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	return n
+}
+
 func (m *ShootStateList) Size() (n int) {
 	if m == nil {
 		return 0
@@ -19356,6 +20299,16 @@ func (m *ShootStatus) Size() (n int) {
 			n += 2 + l + sovGenerated(uint64(l))
 		}
 	}
+	if m.LastActivityTime != nil {
+		l = m.LastActivityTime.Size()
+		n += 2 + l + sovGenerated(uint64(l))
+	}
+	if len(m.InPlaceUpdates) > 0 {
+		for _, e := range m.InPlaceUpdates {
+			l = e.Size()
+			n += 2 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -19599,6 +20552,10 @@ func (m *Worker) Size() (n int) {
 		l = m.ClusterAutoscaler.Size()
 		n += 2 + l + sovGenerated(uint64(l))
 	}
+	if m.UpdateStrategy != nil {
+		l = len(*m.UpdateStrategy)
+		n += 2 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -19619,6 +20576,21 @@ func (m *WorkerKubernetes) Size() (n int) {
 	return n
 }
 
+func (m *WorkerPoolInPlaceUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.KubernetesVersion)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.Nodes))
+	n += 1 + sovGenerated(uint64(m.UpdatedNodes))
+	return n
+}
+
 func (m *WorkerSystemComponents) Size() (n int) {
 	if m == nil {
 		return 0
@@ -20321,6 +21293,7 @@ func (this *EncryptionConfig) String() string {
 	}
 	s := strings.Join([]string{`&EncryptionConfig{`,
 		`Resources:` + fmt.Sprintf("%v", this.Resources) + `,`,
+		`KMS:` + strings.Replace(this.KMS.String(), "KMSConfig", "KMSConfig", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20469,6 +21442,17 @@ func (this *Hibernation) String() string {
 	s := strings.Join([]string{`&Hibernation{`,
 		`Enabled:` + valueToStringGenerated(this.Enabled) + `,`,
 		`Schedules:` + repeatedStringForSchedules + `,`,
+		`IdlePolicy:` + strings.Replace(this.IdlePolicy.String(), "HibernationIdlePolicy", "HibernationIdlePolicy", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HibernationIdlePolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HibernationIdlePolicy{`,
+		`IdleDuration:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.IdleDuration), "Duration", "v11.Duration", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20581,6 +21565,18 @@ func (this *InternalSecretList) String() string {
 	}, "")
 	return s
 }
+func (this *KMSConfig) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&KMSConfig{`,
+		`Type:` + fmt.Sprintf("%v", this.Type) + `,`,
+		`CredentialsResourceName:` + valueToStringGenerated(this.CredentialsResourceName) + `,`,
+		`ProviderConfig:` + strings.Replace(fmt.Sprintf("%v", this.ProviderConfig), "RawExtension", "runtime.RawExtension", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *KubeAPIServerConfig) String() string {
 	if this == nil {
 		return "nil"
@@ -20945,10 +21941,16 @@ func (this *Maintenance) String() string {
 	if this == nil {
 		return "nil"
 	}
+	repeatedStringForFreezePeriods := "[]MaintenanceFreezePeriod{"
+	for _, f := range this.FreezePeriods {
+		repeatedStringForFreezePeriods += strings.Replace(strings.Replace(f.String(), "MaintenanceFreezePeriod", "MaintenanceFreezePeriod", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForFreezePeriods += "}"
 	s := strings.Join([]string{`&Maintenance{`,
 		`AutoUpdate:` + strings.Replace(this.AutoUpdate.String(), "MaintenanceAutoUpdate", "MaintenanceAutoUpdate", 1) + `,`,
 		`TimeWindow:` + strings.Replace(this.TimeWindow.String(), "MaintenanceTimeWindow", "MaintenanceTimeWindow", 1) + `,`,
 		`ConfineSpecUpdateRollout:` + valueToStringGenerated(this.ConfineSpecUpdateRollout) + `,`,
+		`FreezePeriods:` + repeatedStringForFreezePeriods + `,`,
 		`}`,
 	}, "")
 	return s
@@ -20964,6 +21966,18 @@ func (this *MaintenanceAutoUpdate) String() string {
 	}, "")
 	return s
 }
+func (this *MaintenanceFreezePeriod) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MaintenanceFreezePeriod{`,
+		`Begin:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Begin), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`End:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.End), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`Reason:` + valueToStringGenerated(this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *MaintenanceTimeWindow) String() string {
 	if this == nil {
 		return "nil"
@@ -20971,6 +21985,7 @@ func (this *MaintenanceTimeWindow) String() string {
 	s := strings.Join([]string{`&MaintenanceTimeWindow{`,
 		`Begin:` + fmt.Sprintf("%v", this.Begin) + `,`,
 		`End:` + fmt.Sprintf("%v", this.End) + `,`,
+		`Weekdays:` + fmt.Sprintf("%v", this.Weekdays) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21167,6 +22182,11 @@ func (this *ProjectSpec) String() string {
 		repeatedStringForMembers += strings.Replace(strings.Replace(f.String(), "ProjectMember", "ProjectMember", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForMembers += "}"
+	repeatedStringForMaintenanceFreezePeriods := "[]MaintenanceFreezePeriod{"
+	for _, f := range this.MaintenanceFreezePeriods {
+		repeatedStringForMaintenanceFreezePeriods += strings.Replace(strings.Replace(f.String(), "MaintenanceFreezePeriod", "MaintenanceFreezePeriod", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForMaintenanceFreezePeriods += "}"
 	s := strings.Join([]string{`&ProjectSpec{`,
 		`CreatedBy:` + strings.Replace(fmt.Sprintf("%v", this.CreatedBy), "Subject", "v13.Subject", 1) + `,`,
 		`Description:` + valueToStringGenerated(this.Description) + `,`,
@@ -21175,6 +22195,7 @@ func (this *ProjectSpec) String() string {
 		`Members:` + repeatedStringForMembers + `,`,
 		`Namespace:` + valueToStringGenerated(this.Namespace) + `,`,
 		`Tolerations:` + strings.Replace(this.Tolerations.String(), "ProjectTolerations", "ProjectTolerations", 1) + `,`,
+		`MaintenanceFreezePeriods:` + repeatedStringForMaintenanceFreezePeriods + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21240,6 +22261,7 @@ func (this *Quota) String() string {
 	s := strings.Join([]string{`&Quota{`,
 		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "QuotaSpec", "QuotaSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "QuotaStatus", "QuotaStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21282,6 +22304,71 @@ func (this *QuotaSpec) String() string {
 	}, "")
 	return s
 }
+func (this *QuotaStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForUsages := "[]QuotaUsage{"
+	for _, f := range this.Usages {
+		repeatedStringForUsages += strings.Replace(strings.Replace(f.String(), "QuotaUsage", "QuotaUsage", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForUsages += "}"
+	keysForHard := make([]string, 0, len(this.Hard))
+	for k := range this.Hard {
+		keysForHard = append(keysForHard, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForHard)
+	mapStringForHard := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForHard {
+		mapStringForHard += fmt.Sprintf("%v: %v,", k, this.Hard[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForHard += "}"
+	s := strings.Join([]string{`&QuotaStatus{`,
+		`Hard:` + mapStringForHard + `,`,
+		`Usages:` + repeatedStringForUsages + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuotaUsage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForShoots := "[]QuotaUsageShoot{"
+	for _, f := range this.Shoots {
+		repeatedStringForShoots += strings.Replace(strings.Replace(f.String(), "QuotaUsageShoot", "QuotaUsageShoot", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForShoots += "}"
+	keysForUsed := make([]string, 0, len(this.Used))
+	for k := range this.Used {
+		keysForUsed = append(keysForUsed, string(k))
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForUsed)
+	mapStringForUsed := "k8s_io_api_core_v1.ResourceList{"
+	for _, k := range keysForUsed {
+		mapStringForUsed += fmt.Sprintf("%v: %v,", k, this.Used[k8s_io_api_core_v1.ResourceName(k)])
+	}
+	mapStringForUsed += "}"
+	s := strings.Join([]string{`&QuotaUsage{`,
+		`Namespace:` + valueToStringGenerated(this.Namespace) + `,`,
+		`Used:` + mapStringForUsed + `,`,
+		`Shoots:` + repeatedStringForShoots + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *QuotaUsageShoot) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&QuotaUsageShoot{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Generation:` + fmt.Sprintf("%v", this.Generation) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Region) String() string {
 	if this == nil {
 		return "nil"
@@ -21696,6 +22783,11 @@ func (this *SeedStatus) String() string {
 		repeatedStringForConditions += strings.Replace(strings.Replace(f.String(), "Condition", "Condition", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForConditions += "}"
+	repeatedStringForShootStateEncryptionKeys := "[]ShootStateEncryptionKey{"
+	for _, f := range this.ShootStateEncryptionKeys {
+		repeatedStringForShootStateEncryptionKeys += strings.Replace(strings.Replace(f.String(), "ShootStateEncryptionKey", "ShootStateEncryptionKey", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForShootStateEncryptionKeys += "}"
 	keysForCapacity := make([]string, 0, len(this.Capacity))
 	for k := range this.Capacity {
 		keysForCapacity = append(keysForCapacity, string(k))
@@ -21726,6 +22818,7 @@ func (this *SeedStatus) String() string {
 		`Allocatable:` + mapStringForAllocatable + `,`,
 		`ClientCertificateExpirationTimestamp:` + strings.Replace(fmt.Sprintf("%v", this.ClientCertificateExpirationTimestamp), "Time", "v11.Time", 1) + `,`,
 		`LastOperation:` + strings.Replace(this.LastOperation.String(), "LastOperation", "LastOperation", 1) + `,`,
+		`ShootStateEncryptionKeys:` + repeatedStringForShootStateEncryptionKeys + `,`,
 		`}`,
 	}, "")
 	return s
@@ -21971,6 +23064,18 @@ func (this *ShootState) String() string {
 	}, "")
 	return s
 }
+func (this *ShootStateEncryptionKey) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ShootStateEncryptionKey{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Fingerprint:` + fmt.Sprintf("%v", this.Fingerprint) + `,`,
+		`Primary:` + fmt.Sprintf("%v", this.Primary) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *ShootStateList) String() string {
 	if this == nil {
 		return "nil"
@@ -22038,6 +23143,11 @@ func (this *ShootStatus) String() string {
 		repeatedStringForAdvertisedAddresses += strings.Replace(strings.Replace(f.String(), "ShootAdvertisedAddress", "ShootAdvertisedAddress", 1), `&`, ``, 1) + ","
 	}
 	repeatedStringForAdvertisedAddresses += "}"
+	repeatedStringForInPlaceUpdates := "[]WorkerPoolInPlaceUpdate{"
+	for _, f := range this.InPlaceUpdates {
+		repeatedStringForInPlaceUpdates += strings.Replace(strings.Replace(f.String(), "WorkerPoolInPlaceUpdate", "WorkerPoolInPlaceUpdate", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForInPlaceUpdates += "}"
 	s := strings.Join([]string{`&ShootStatus{`,
 		`Conditions:` + repeatedStringForConditions + `,`,
 		`Constraints:` + repeatedStringForConstraints + `,`,
@@ -22057,6 +23167,8 @@ func (this *ShootStatus) String() string {
 		`LastHibernationTriggerTime:` + strings.Replace(fmt.Sprintf("%v", this.LastHibernationTriggerTime), "Time", "v11.Time", 1) + `,`,
 		`LastMaintenance:` + strings.Replace(this.LastMaintenance.String(), "LastMaintenance", "LastMaintenance", 1) + `,`,
 		`EncryptedResources:` + fmt.Sprintf("%v", this.EncryptedResources) + `,`,
+		`LastActivityTime:` + strings.Replace(fmt.Sprintf("%v", this.LastActivityTime), "Time", "v11.Time", 1) + `,`,
+		`InPlaceUpdates:` + repeatedStringForInPlaceUpdates + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22220,6 +23332,7 @@ func (this *Worker) String() string {
 		`MachineControllerManagerSettings:` + strings.Replace(this.MachineControllerManagerSettings.String(), "MachineControllerManagerSettings", "MachineControllerManagerSettings", 1) + `,`,
 		`Sysctls:` + mapStringForSysctls + `,`,
 		`ClusterAutoscaler:` + strings.Replace(this.ClusterAutoscaler.String(), "ClusterAutoscalerOptions", "ClusterAutoscalerOptions", 1) + `,`,
+		`UpdateStrategy:` + valueToStringGenerated(this.UpdateStrategy) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -22235,6 +23348,19 @@ func (this *WorkerKubernetes) String() string {
 	}, "")
 	return s
 }
+func (this *WorkerPoolInPlaceUpdate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&WorkerPoolInPlaceUpdate{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`KubernetesVersion:` + fmt.Sprintf("%v", this.KubernetesVersion) + `,`,
+		`Nodes:` + fmt.Sprintf("%v", this.Nodes) + `,`,
+		`UpdatedNodes:` + fmt.Sprintf("%v", this.UpdatedNodes) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *WorkerSystemComponents) String() string {
 	if this == nil {
 		return "nil"
@@ -29599,6 +30725,42 @@ func (m *EncryptionConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.Resources = append(m.Resources, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KMS", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KMS == nil {
+				m.KMS = &KMSConfig{}
+			}
+			if err := m.KMS.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -31102,6 +32264,125 @@ func (m *Hibernation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdlePolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IdlePolicy == nil {
+				m.IdlePolicy = &HibernationIdlePolicy{}
+			}
+			if err := m.IdlePolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HibernationIdlePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HibernationIdlePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HibernationIdlePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdleDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IdleDuration.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -32302,7 +33583,7 @@ func (m *InternalSecretList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
+func (m *KMSConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -32325,17 +33606,17 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KubeAPIServerConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: KMSConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KubeAPIServerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: KMSConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -32345,28 +33626,179 @@ func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.KubernetesConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Type = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdmissionPlugins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialsResourceName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.CredentialsResourceName = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ProviderConfig == nil {
+				m.ProviderConfig = &runtime.RawExtension{}
+			}
+			if err := m.ProviderConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KubeAPIServerConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KubeAPIServerConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KubeAPIServerConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesConfig", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KubernetesConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdmissionPlugins", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -37574,61 +39006,11 @@ func (m *Maintenance) Unmarshal(dAtA []byte) error {
 			}
 			b := bool(v != 0)
 			m.ConfineSpecUpdateRollout = &b
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MaintenanceAutoUpdate) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MaintenanceAutoUpdate: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MaintenanceAutoUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesVersion", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreezePeriods", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37638,33 +39020,26 @@ func (m *MaintenanceAutoUpdate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.KubernetesVersion = bool(v != 0)
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MachineImageVersion", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			b := bool(v != 0)
-			m.MachineImageVersion = &b
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FreezePeriods = append(m.FreezePeriods, MaintenanceFreezePeriod{})
+			if err := m.FreezePeriods[len(m.FreezePeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -37686,7 +39061,7 @@ func (m *MaintenanceAutoUpdate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
+func (m *MaintenanceAutoUpdate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -37709,17 +39084,17 @@ func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MaintenanceTimeWindow: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaintenanceAutoUpdate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MaintenanceTimeWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaintenanceAutoUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesVersion", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37729,29 +39104,17 @@ func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Begin = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.KubernetesVersion = bool(v != 0)
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineImageVersion", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -37761,24 +39124,308 @@ func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.End = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := bool(v != 0)
+			m.MachineImageVersion = &b
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaintenanceFreezePeriod) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaintenanceFreezePeriod: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaintenanceFreezePeriod: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Begin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.End.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Reason = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaintenanceTimeWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaintenanceTimeWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaintenanceTimeWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Begin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Begin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field End", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.End = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weekdays", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weekdays = append(m.Weekdays, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -40233,6 +41880,40 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaintenanceFreezePeriods", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaintenanceFreezePeriods = append(m.MaintenanceFreezePeriods, MaintenanceFreezePeriod{})
+			if err := m.MaintenanceFreezePeriods[len(m.MaintenanceFreezePeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -40900,6 +42581,39 @@ func (m *Quota) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -41214,11 +42928,469 @@ func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Metrics[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			m.Metrics[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Hard == nil {
+				m.Hard = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Hard[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Usages = append(m.Usages, QuotaUsage{})
+			if err := m.Usages[len(m.Usages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuotaUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Namespace = &s
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Used == nil {
+				m.Used = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Used[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shoots", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -41245,7 +43417,8 @@ func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Scope.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Shoots = append(m.Shoots, QuotaUsageShoot{})
+			if err := m.Shoots[len(m.Shoots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -41270,6 +43443,139 @@ func (m *QuotaSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuotaUsageShoot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuotaUsageShoot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuotaUsageShoot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Generation", wireType)
+			}
+			m.Generation = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Generation |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Region) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -45751,109 +48057,145 @@ func (m *SeedStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Allocatable == nil {
-				m.Allocatable = make(k8s_io_api_core_v1.ResourceList)
+			if m.Allocatable == nil {
+				m.Allocatable = make(k8s_io_api_core_v1.ResourceList)
+			}
+			var mapkey k8s_io_api_core_v1.ResourceName
+			mapvalue := &resource.Quantity{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &resource.Quantity{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Allocatable[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientCertificateExpirationTimestamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ClientCertificateExpirationTimestamp == nil {
+				m.ClientCertificateExpirationTimestamp = &v11.Time{}
 			}
-			var mapkey k8s_io_api_core_v1.ResourceName
-			mapvalue := &resource.Quantity{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = k8s_io_api_core_v1.ResourceName(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &resource.Quantity{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.ClientCertificateExpirationTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Allocatable[k8s_io_api_core_v1.ResourceName(mapkey)] = *mapvalue
 			iNdEx = postIndex
-		case 8:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClientCertificateExpirationTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastOperation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -45880,16 +48222,16 @@ func (m *SeedStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ClientCertificateExpirationTimestamp == nil {
-				m.ClientCertificateExpirationTimestamp = &v11.Time{}
+			if m.LastOperation == nil {
+				m.LastOperation = &LastOperation{}
 			}
-			if err := m.ClientCertificateExpirationTimestamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastOperation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShootStateEncryptionKeys", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -45916,10 +48258,8 @@ func (m *SeedStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastOperation == nil {
-				m.LastOperation = &LastOperation{}
-			}
-			if err := m.LastOperation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ShootStateEncryptionKeys = append(m.ShootStateEncryptionKeys, ShootStateEncryptionKey{})
+			if err := m.ShootStateEncryptionKeys[len(m.ShootStateEncryptionKeys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -48587,18 +50927,191 @@ func (m *ShootSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SeedSelector == nil {
-				m.SeedSelector = &SeedSelector{}
+			if m.SeedSelector == nil {
+				m.SeedSelector = &SeedSelector{}
+			}
+			if err := m.SeedSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, NamedResourceReference{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tolerations = append(m.Tolerations, Toleration{})
+			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExposureClassName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.ExposureClassName = &s
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemComponents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SystemComponents == nil {
+				m.SystemComponents = &SystemComponents{}
+			}
+			if err := m.SystemComponents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControlPlane", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ControlPlane == nil {
+				m.ControlPlane = &ControlPlane{}
 			}
-			if err := m.SeedSelector.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ControlPlane.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 21:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulerName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48608,96 +51121,78 @@ func (m *ShootSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Resources = append(m.Resources, NamedResourceReference{})
-			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.SchedulerName = &s
 			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tolerations", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tolerations = append(m.Tolerations, Toleration{})
-			if err := m.Tolerations[len(m.Tolerations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 18:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExposureClassName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ShootState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.ExposureClassName = &s
-			iNdEx = postIndex
-		case 19:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ShootState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ShootState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SystemComponents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48724,16 +51219,13 @@ func (m *ShootSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SystemComponents == nil {
-				m.SystemComponents = &SystemComponents{}
-			}
-			if err := m.SystemComponents.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 20:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControlPlane", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -48760,46 +51252,10 @@ func (m *ShootSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ControlPlane == nil {
-				m.ControlPlane = &ControlPlane{}
-			}
-			if err := m.ControlPlane.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 21:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SchedulerName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			s := string(dAtA[iNdEx:postIndex])
-			m.SchedulerName = &s
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -48821,7 +51277,7 @@ func (m *ShootSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ShootState) Unmarshal(dAtA []byte) error {
+func (m *ShootStateEncryptionKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -48844,17 +51300,17 @@ func (m *ShootState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ShootState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ShootStateEncryptionKey: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ShootState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ShootStateEncryptionKey: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48864,30 +51320,29 @@ func (m *ShootState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -48897,25 +51352,44 @@ func (m *ShootState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Primary", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Primary = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -49640,14 +52114,50 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdvertisedAddresses = append(m.AdvertisedAddresses, ShootAdvertisedAddress{})
-			if err := m.AdvertisedAddresses[len(m.AdvertisedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AdvertisedAddresses = append(m.AdvertisedAddresses, ShootAdvertisedAddress{})
+			if err := m.AdvertisedAddresses[len(m.AdvertisedAddresses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrationStartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MigrationStartTime == nil {
+				m.MigrationStartTime = &v11.Time{}
+			}
+			if err := m.MigrationStartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MigrationStartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -49674,16 +52184,16 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MigrationStartTime == nil {
-				m.MigrationStartTime = &v11.Time{}
+			if m.Credentials == nil {
+				m.Credentials = &ShootCredentials{}
 			}
-			if err := m.MigrationStartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credentials", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHibernationTriggerTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -49710,16 +52220,16 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Credentials == nil {
-				m.Credentials = &ShootCredentials{}
+			if m.LastHibernationTriggerTime == nil {
+				m.LastHibernationTriggerTime = &v11.Time{}
 			}
-			if err := m.Credentials.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastHibernationTriggerTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 16:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHibernationTriggerTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMaintenance", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -49746,16 +52256,48 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastHibernationTriggerTime == nil {
-				m.LastHibernationTriggerTime = &v11.Time{}
+			if m.LastMaintenance == nil {
+				m.LastMaintenance = &LastMaintenance{}
 			}
-			if err := m.LastHibernationTriggerTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastMaintenance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastMaintenance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedResources", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedResources = append(m.EncryptedResources, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastActivityTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -49782,18 +52324,18 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastMaintenance == nil {
-				m.LastMaintenance = &LastMaintenance{}
+			if m.LastActivityTime == nil {
+				m.LastActivityTime = &v11.Time{}
 			}
-			if err := m.LastMaintenance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastActivityTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 18:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedResources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InPlaceUpdates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -49803,23 +52345,25 @@ func (m *ShootStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncryptedResources = append(m.EncryptedResources, string(dAtA[iNdEx:postIndex]))
+			m.InPlaceUpdates = append(m.InPlaceUpdates, WorkerPoolInPlaceUpdate{})
+			if err := m.InPlaceUpdates[len(m.InPlaceUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -51888,6 +54432,39 @@ func (m *Worker) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateStrategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := MachineUpdateStrategy(dAtA[iNdEx:postIndex])
+			m.UpdateStrategy = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -52028,6 +54605,158 @@ func (m *WorkerKubernetes) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *WorkerPoolInPlaceUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkerPoolInPlaceUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkerPoolInPlaceUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubernetesVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KubernetesVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			m.Nodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nodes |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedNodes", wireType)
+			}
+			m.UpdatedNodes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedNodes |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *WorkerSystemComponents) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
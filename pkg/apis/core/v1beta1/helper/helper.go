@@ -1227,6 +1227,11 @@ func IsNodeLocalDNSEnabled(systemComponents *gardencorev1beta1.SystemComponents)
 	return systemComponents != nil && systemComponents.NodeLocalDNS != nil && systemComponents.NodeLocalDNS.Enabled
 }
 
+// IsUpdateStrategyInPlace returns true if the given update strategy updates the nodes of a worker pool in-place.
+func IsUpdateStrategyInPlace(updateStrategy *gardencorev1beta1.MachineUpdateStrategy) bool {
+	return updateStrategy != nil && *updateStrategy == gardencorev1beta1.AutoInPlaceUpdate
+}
+
 // GetNodeLocalDNS returns a pointer to the NodeLocalDNS spec.
 func GetNodeLocalDNS(systemComponents *gardencorev1beta1.SystemComponents) *gardencorev1beta1.NodeLocalDNS {
 	if systemComponents != nil {
@@ -1406,6 +1411,14 @@ func GetShootETCDEncryptionKeyRotationPhase(credentials *gardencorev1beta1.Shoot
 	return ""
 }
 
+// GetShootKMSConfig returns the KMS configuration of the shoot's etcd encryption, or nil if no KMS plugin is configured.
+func GetShootKMSConfig(shoot *gardencorev1beta1.Shoot) *gardencorev1beta1.KMSConfig {
+	if kubeAPIServer := shoot.Spec.Kubernetes.KubeAPIServer; kubeAPIServer != nil && kubeAPIServer.EncryptionConfig != nil {
+		return kubeAPIServer.EncryptionConfig.KMS
+	}
+	return nil
+}
+
 // MutateShootETCDEncryptionKeyRotation mutates the .status.credentials.rotation.etcdEncryptionKey field based on the
 // provided mutation function. If the field is nil then it is initialized.
 func MutateShootETCDEncryptionKeyRotation(shoot *gardencorev1beta1.Shoot, f func(*gardencorev1beta1.ETCDEncryptionKeyRotation)) {
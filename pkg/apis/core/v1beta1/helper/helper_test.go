@@ -2706,6 +2706,16 @@ var _ = Describe("Helper", func() {
 		Entry("with system components and node-local-dns is disabled", &gardencorev1beta1.SystemComponents{NodeLocalDNS: &gardencorev1beta1.NodeLocalDNS{Enabled: false}}, false),
 	)
 
+	DescribeTable("#IsUpdateStrategyInPlace",
+		func(updateStrategy *gardencorev1beta1.MachineUpdateStrategy, expected bool) {
+			Expect(IsUpdateStrategyInPlace(updateStrategy)).To(Equal(expected))
+		},
+
+		Entry("with nil", nil, false),
+		Entry("with rolling update strategy", ptr.To(gardencorev1beta1.AutoRollingUpdate), false),
+		Entry("with in-place update strategy", ptr.To(gardencorev1beta1.AutoInPlaceUpdate), true),
+	)
+
 	DescribeTable("#GetNodeLocalDNS",
 		func(systemComponents *gardencorev1beta1.SystemComponents, expected *gardencorev1beta1.NodeLocalDNS) {
 			Expect(GetNodeLocalDNS(systemComponents)).To(Equal(expected))
@@ -2906,6 +2916,18 @@ var _ = Describe("Helper", func() {
 		Entry("phase set", &gardencorev1beta1.ShootCredentials{Rotation: &gardencorev1beta1.ShootCredentialsRotation{ETCDEncryptionKey: &gardencorev1beta1.ETCDEncryptionKeyRotation{Phase: gardencorev1beta1.RotationCompleting}}}, gardencorev1beta1.RotationCompleting),
 	)
 
+	DescribeTable("#GetShootKMSConfig",
+		func(kubeAPIServer *gardencorev1beta1.KubeAPIServerConfig, expected *gardencorev1beta1.KMSConfig) {
+			shoot := &gardencorev1beta1.Shoot{Spec: gardencorev1beta1.ShootSpec{Kubernetes: gardencorev1beta1.Kubernetes{KubeAPIServer: kubeAPIServer}}}
+			Expect(GetShootKMSConfig(shoot)).To(Equal(expected))
+		},
+
+		Entry("kube-apiserver config nil", nil, nil),
+		Entry("encryption config nil", &gardencorev1beta1.KubeAPIServerConfig{}, nil),
+		Entry("kms nil", &gardencorev1beta1.KubeAPIServerConfig{EncryptionConfig: &gardencorev1beta1.EncryptionConfig{}}, nil),
+		Entry("kms set", &gardencorev1beta1.KubeAPIServerConfig{EncryptionConfig: &gardencorev1beta1.EncryptionConfig{KMS: &gardencorev1beta1.KMSConfig{Type: "local"}}}, &gardencorev1beta1.KMSConfig{Type: "local"}),
+	)
+
 	Describe("#MutateShootETCDEncryptionKeyRotation", func() {
 		It("should do nothing when mutate function is nil", func() {
 			shoot := &gardencorev1beta1.Shoot{}
@@ -81,6 +81,10 @@ type ProjectSpec struct {
 	// Tolerations contains the tolerations for taints on seed clusters.
 	// +optional
 	Tolerations *ProjectTolerations `json:"tolerations,omitempty" protobuf:"bytes,7,opt,name=tolerations"`
+	// MaintenanceFreezePeriods is a list of absolute periods of time in which no automatic maintenance operations are
+	// performed for any shoot of the project. They are honored in addition to the freeze periods of the shoots.
+	// +optional
+	MaintenanceFreezePeriods []MaintenanceFreezePeriod `json:"maintenanceFreezePeriods,omitempty" protobuf:"bytes,8,rep,name=maintenanceFreezePeriods"`
 }
 
 // ProjectStatus holds the most recently observed status of the project.
@@ -31,6 +31,9 @@ type Quota struct {
 	// Spec defines the Quota constraints.
 	// +optional
 	Spec QuotaSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
+	// Status contains the most recently observed usage of the Quota.
+	// +optional
+	Status QuotaStatus `json:"status,omitempty" protobuf:"bytes,3,opt,name=status"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -55,3 +58,53 @@ type QuotaSpec struct {
 	// Scope is the scope of the Quota object, either 'project' or 'secret'. This field is immutable.
 	Scope corev1.ObjectReference `json:"scope" protobuf:"bytes,3,opt,name=scope"`
 }
+
+// QuotaStatus holds the most recently observed usage of a Quota.
+type QuotaStatus struct {
+	// Hard is the set of hard limits enforced for each metric.
+	// +optional
+	Hard corev1.ResourceList `json:"hard,omitempty" protobuf:"bytes,1,rep,name=hard,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// Usages is the list of observed usages of the Quota. For Quotas with scope 'secret', there is a single usage
+	// accounting all Shoots using the Quota. For Quotas with scope 'project', there is one usage per project namespace.
+	// +optional
+	Usages []QuotaUsage `json:"usages,omitempty" protobuf:"bytes,2,rep,name=usages"`
+}
+
+// QuotaUsage is the observed usage of a Quota within its scope.
+type QuotaUsage struct {
+	// Namespace is the project namespace the usage is accounted for. It is only set for Quotas with scope 'project'.
+	// +optional
+	Namespace *string `json:"namespace,omitempty" protobuf:"bytes,1,opt,name=namespace"`
+	// Used is the observed usage for each metric.
+	// +optional
+	Used corev1.ResourceList `json:"used,omitempty" protobuf:"bytes,2,rep,name=used,casttype=k8s.io/api/core/v1.ResourceList,castkey=k8s.io/api/core/v1.ResourceName"`
+	// Shoots is the list of Shoots contributing to the usage.
+	// +optional
+	Shoots []QuotaUsageShoot `json:"shoots,omitempty" protobuf:"bytes,3,rep,name=shoots"`
+}
+
+// QuotaUsageShoot references a Shoot contributing to the usage of a Quota.
+type QuotaUsageShoot struct {
+	// Namespace is the namespace of the Shoot.
+	Namespace string `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	// Name is the name of the Shoot.
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
+	// Generation is the generation of the Shoot which the usage was computed for.
+	// +optional
+	Generation int64 `json:"generation,omitempty" protobuf:"varint,3,opt,name=generation"`
+}
+
+const (
+	// QuotaMetricCPU is the constraint for the amount of CPUs
+	QuotaMetricCPU corev1.ResourceName = corev1.ResourceCPU
+	// QuotaMetricGPU is the constraint for the amount of GPUs (e.g. from Nvidia)
+	QuotaMetricGPU corev1.ResourceName = "gpu"
+	// QuotaMetricMemory is the constraint for the amount of memory
+	QuotaMetricMemory corev1.ResourceName = corev1.ResourceMemory
+	// QuotaMetricStorageStandard is the constraint for the size of a standard disk
+	QuotaMetricStorageStandard corev1.ResourceName = corev1.ResourceStorage + ".standard"
+	// QuotaMetricStoragePremium is the constraint for the size of a premium disk (e.g. SSD)
+	QuotaMetricStoragePremium corev1.ResourceName = corev1.ResourceStorage + ".premium"
+	// QuotaMetricLoadbalancer is the constraint for the amount of loadbalancers
+	QuotaMetricLoadbalancer corev1.ResourceName = "loadbalancer"
+)
@@ -123,6 +123,22 @@ type SeedStatus struct {
 	// LastOperation holds information about the last operation on the Seed.
 	// +optional
 	LastOperation *LastOperation `json:"lastOperation,omitempty" protobuf:"bytes,9,opt,name=lastOperation"`
+	// ShootStateEncryptionKeys contains the key encryption keys which are available to the gardenlet for decrypting the
+	// sensitive data persisted in ShootStates.
+	// +optional
+	ShootStateEncryptionKeys []ShootStateEncryptionKey `json:"shootStateEncryptionKeys,omitempty" protobuf:"bytes,10,rep,name=shootStateEncryptionKeys"`
+}
+
+// ShootStateEncryptionKey describes a key encryption key which is available to the gardenlet for decrypting the
+// sensitive data persisted in ShootStates.
+type ShootStateEncryptionKey struct {
+	// Name is the name of the key encryption key.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Fingerprint is the fingerprint of the key encryption key.
+	Fingerprint string `json:"fingerprint" protobuf:"bytes,2,opt,name=fingerprint"`
+	// Primary states whether the key encryption key is used for wrapping the data encryption keys of ShootStates.
+	// +optional
+	Primary bool `json:"primary,omitempty" protobuf:"varint,3,opt,name=primary"`
 }
 
 // SeedBackup contains the object store configuration for backups for shoot (currently only etcd).
@@ -387,6 +403,9 @@ const (
 	// SeedTaintProtected is a constant for a taint key on a seed that marks it as protected. Protected seeds
 	// may only be used by shoots in the `garden` namespace.
 	SeedTaintProtected = "seed.gardener.cloud/protected"
+	// SeedTaintEvacuation is a constant for a taint key on a seed that marks it for evacuation. All shoots scheduled
+	// onto an evacuated seed are migrated to other seeds by the gardener-controller-manager.
+	SeedTaintEvacuation = "seed.gardener.cloud/evacuation"
 )
 
 // SeedVolume contains settings for persistentvolumes created in the seed cluster.
@@ -412,6 +431,9 @@ type SeedVolumeProvider struct {
 const (
 	// SeedBackupBucketsReady is a constant for a condition type indicating that associated BackupBuckets are ready.
 	SeedBackupBucketsReady ConditionType = "BackupBucketsReady"
+	// SeedEvacuated is a constant for a condition type indicating whether all shoots have been migrated off a seed
+	// which is marked for evacuation.
+	SeedEvacuated ConditionType = "Evacuated"
 	// SeedExtensionsReady is a constant for a condition type indicating that the extensions are ready.
 	SeedExtensionsReady ConditionType = "ExtensionsReady"
 	// SeedGardenletReady is a constant for a condition type indicating that the Gardenlet is ready.
@@ -211,6 +211,26 @@ type ShootStatus struct {
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for more details.
 	// +optional
 	EncryptedResources []string `json:"encryptedResources,omitempty" protobuf:"bytes,18,rep,name=encryptedResources"`
+	// LastActivityTime is the last time when user activity was observed in the Shoot cluster. It is only maintained
+	// if the Shoot has an idle hibernation policy.
+	// +optional
+	LastActivityTime *metav1.Time `json:"lastActivityTime,omitempty" protobuf:"bytes,19,opt,name=lastActivityTime"`
+	// InPlaceUpdates contains the progress of the in-place updates of worker pools using the in-place update strategy.
+	// +optional
+	InPlaceUpdates []WorkerPoolInPlaceUpdate `json:"inPlaceUpdates,omitempty" protobuf:"bytes,20,rep,name=inPlaceUpdates"`
+}
+
+// WorkerPoolInPlaceUpdate contains the progress of the in-place update of a worker pool.
+type WorkerPoolInPlaceUpdate struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// KubernetesVersion is the Kubernetes version the nodes of the worker pool are updated to.
+	KubernetesVersion string `json:"kubernetesVersion" protobuf:"bytes,2,opt,name=kubernetesVersion"`
+	// Nodes is the number of nodes of the worker pool.
+	Nodes int32 `json:"nodes" protobuf:"varint,3,opt,name=nodes"`
+	// UpdatedNodes is the number of nodes of the worker pool which have already applied the desired operating system
+	// config.
+	UpdatedNodes int32 `json:"updatedNodes" protobuf:"varint,4,opt,name=updatedNodes"`
 }
 
 // LastMaintenance holds information about a maintenance operation on the Shoot.
@@ -508,6 +528,17 @@ type Hibernation struct {
 	// Schedules determine the hibernation schedules.
 	// +optional
 	Schedules []HibernationSchedule `json:"schedules,omitempty" protobuf:"bytes,2,rep,name=schedules"`
+	// IdlePolicy determines that the Shoot is hibernated automatically after it has not been used for a while.
+	// +optional
+	IdlePolicy *HibernationIdlePolicy `json:"idlePolicy,omitempty" protobuf:"bytes,3,opt,name=idlePolicy"`
+}
+
+// HibernationIdlePolicy determines when an idle Shoot is hibernated automatically.
+type HibernationIdlePolicy struct {
+	// IdleDuration is the duration without any user activity after which the Shoot is hibernated, e.g. `8h`.
+	// Requests to the API server of the Shoot which are not issued by system components as well as changes to
+	// workload resources count as activity.
+	IdleDuration metav1.Duration `json:"idleDuration" protobuf:"bytes,1,opt,name=idleDuration"`
 }
 
 // HibernationSchedule determines the hibernation schedule of a Shoot.
@@ -803,6 +834,25 @@ type EncryptionConfig struct {
 	// Wildcards are not supported for now.
 	// See https://github.com/gardener/gardener/blob/master/docs/usage/etcd_encryption_config.md for more details.
 	Resources []string `json:"resources" protobuf:"bytes,1,rep,name=resources"`
+	// KMS contains the configuration of a KMS v2 plugin which shall be used for envelope encryption of the resources.
+	// If not set, the resources are encrypted with an `aescbc` key managed by Gardener.
+	// Note that KMS is only supported for versions >= 1.29 and it cannot be removed once configured.
+	// +optional
+	KMS *KMSConfig `json:"kms,omitempty" protobuf:"bytes,2,opt,name=kms"`
+}
+
+// KMSConfig contains the configuration of a KMS v2 plugin used for the encryption of resources in etcd.
+type KMSConfig struct {
+	// Type is the type of the KMS plugin. The extension registered for this type is responsible for running the KMS
+	// plugin next to the kube-apiserver.
+	Type string `json:"type" protobuf:"bytes,1,opt,name=type"`
+	// CredentialsResourceName is the name of a resource in `.spec.resources` which contains the credentials for the
+	// KMS provider. The referenced resource must be a secret.
+	// +optional
+	CredentialsResourceName *string `json:"credentialsResourceName,omitempty" protobuf:"bytes,2,opt,name=credentialsResourceName"`
+	// ProviderConfig is the provider-specific configuration of the KMS plugin.
+	// +optional
+	ProviderConfig *runtime.RawExtension `json:"providerConfig,omitempty" protobuf:"bytes,3,opt,name=providerConfig"`
 }
 
 // ServiceAccountConfig is the kube-apiserver configuration for service accounts.
@@ -1321,6 +1371,21 @@ type Maintenance struct {
 	// an immediate roll out which is changes to the Spec.Hibernation.Enabled field.
 	// +optional
 	ConfineSpecUpdateRollout *bool `json:"confineSpecUpdateRollout,omitempty" protobuf:"varint,3,opt,name=confineSpecUpdateRollout"`
+	// FreezePeriods is a list of absolute periods of time in which no automatic maintenance operations are performed,
+	// e.g. during a year-end change freeze.
+	// +optional
+	FreezePeriods []MaintenanceFreezePeriod `json:"freezePeriods,omitempty" protobuf:"bytes,4,rep,name=freezePeriods"`
+}
+
+// MaintenanceFreezePeriod is an absolute period of time in which no automatic maintenance operations are performed.
+type MaintenanceFreezePeriod struct {
+	// Begin is the beginning of the freeze period.
+	Begin metav1.Time `json:"begin" protobuf:"bytes,1,opt,name=begin"`
+	// End is the end of the freeze period.
+	End metav1.Time `json:"end" protobuf:"bytes,2,opt,name=end"`
+	// Reason is a human-readable explanation of the freeze period.
+	// +optional
+	Reason *string `json:"reason,omitempty" protobuf:"bytes,3,opt,name=reason"`
 }
 
 // MaintenanceAutoUpdate contains information about which constraints should be automatically updated.
@@ -1344,6 +1409,10 @@ type MaintenanceTimeWindow struct {
 	// +kubebuilder:validation:Required
 	// +kubebuilder:validation:Pattern=`([0-1][0-9]|2[0-3])[0-5][0-9][0-5][0-9]\+[0-1][0-4]00`
 	End string `json:"end" protobuf:"bytes,2,opt,name=end"`
+	// Weekdays restricts the time window to the given days of the week (e.g. "Tuesday") on which it may begin. The days
+	// refer to the time zone of "Begin". If empty, the time window may begin on every day.
+	// +optional
+	Weekdays []string `json:"weekdays,omitempty" protobuf:"bytes,3,rep,name=weekdays"`
 }
 
 // Monitoring contains information about the monitoring configuration for the shoot.
@@ -1450,8 +1519,23 @@ type Worker struct {
 	// ClusterAutoscaler contains the cluster autoscaler configurations for the worker pool.
 	// +optional
 	ClusterAutoscaler *ClusterAutoscalerOptions `json:"clusterAutoscaler,omitempty" protobuf:"bytes,21,opt,name=clusterAutoscaler"`
+	// UpdateStrategy specifies how the nodes of the worker pool are updated when its Kubernetes version changes.
+	// Defaults to 'AutoRollingUpdate'.
+	// +optional
+	UpdateStrategy *MachineUpdateStrategy `json:"updateStrategy,omitempty" protobuf:"bytes,22,opt,name=updateStrategy,casttype=MachineUpdateStrategy"`
 }
 
+// MachineUpdateStrategy is a type for the strategy used to update the nodes of a worker pool.
+type MachineUpdateStrategy string
+
+const (
+	// AutoRollingUpdate is a constant for the update strategy which replaces the machines of a worker pool.
+	AutoRollingUpdate MachineUpdateStrategy = "AutoRollingUpdate"
+	// AutoInPlaceUpdate is a constant for the update strategy which updates the nodes of a worker pool in-place, i.e.,
+	// the nodes are drained and updated one after another without replacing the machines.
+	AutoInPlaceUpdate MachineUpdateStrategy = "AutoInPlaceUpdate"
+)
+
 // ClusterAutoscalerOptions contains the cluster autoscaler configurations for a worker pool.
 type ClusterAutoscalerOptions struct {
 	// ScaleDownUtilizationThreshold defines the threshold in fraction (0.0 - 1.0) under which a node is being removed.
@@ -1693,6 +1777,10 @@ const (
 	ShootEventSchedulingSuccessful = "SchedulingSuccessful"
 	// ShootEventSchedulingFailed indicates that a scheduling decision failed.
 	ShootEventSchedulingFailed = "SchedulingFailed"
+	// ShootEventEvacuationStarted indicates that the shoot is being migrated off an evacuated seed.
+	ShootEventEvacuationStarted = "EvacuationStarted"
+	// ShootEventEvacuationFailed indicates that the shoot could not be migrated off an evacuated seed.
+	ShootEventEvacuationFailed = "EvacuationFailed"
 )
 
 const (
@@ -630,6 +630,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HibernationIdlePolicy)(nil), (*core.HibernationIdlePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_HibernationIdlePolicy_To_core_HibernationIdlePolicy(a.(*HibernationIdlePolicy), b.(*core.HibernationIdlePolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.HibernationIdlePolicy)(nil), (*HibernationIdlePolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_HibernationIdlePolicy_To_v1beta1_HibernationIdlePolicy(a.(*core.HibernationIdlePolicy), b.(*HibernationIdlePolicy), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HibernationSchedule)(nil), (*core.HibernationSchedule)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_HibernationSchedule_To_core_HibernationSchedule(a.(*HibernationSchedule), b.(*core.HibernationSchedule), scope)
 	}); err != nil {
@@ -695,6 +705,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KMSConfig)(nil), (*core.KMSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KMSConfig_To_core_KMSConfig(a.(*KMSConfig), b.(*core.KMSConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.KMSConfig)(nil), (*KMSConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_KMSConfig_To_v1beta1_KMSConfig(a.(*core.KMSConfig), b.(*KMSConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*KubeAPIServerConfig)(nil), (*core.KubeAPIServerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_KubeAPIServerConfig_To_core_KubeAPIServerConfig(a.(*KubeAPIServerConfig), b.(*core.KubeAPIServerConfig), scope)
 	}); err != nil {
@@ -935,6 +955,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*MaintenanceFreezePeriod)(nil), (*core.MaintenanceFreezePeriod)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_MaintenanceFreezePeriod_To_core_MaintenanceFreezePeriod(a.(*MaintenanceFreezePeriod), b.(*core.MaintenanceFreezePeriod), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.MaintenanceFreezePeriod)(nil), (*MaintenanceFreezePeriod)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_MaintenanceFreezePeriod_To_v1beta1_MaintenanceFreezePeriod(a.(*core.MaintenanceFreezePeriod), b.(*MaintenanceFreezePeriod), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MaintenanceTimeWindow)(nil), (*core.MaintenanceTimeWindow)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(a.(*MaintenanceTimeWindow), b.(*core.MaintenanceTimeWindow), scope)
 	}); err != nil {
@@ -1115,6 +1145,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*QuotaStatus)(nil), (*core.QuotaStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QuotaStatus_To_core_QuotaStatus(a.(*QuotaStatus), b.(*core.QuotaStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.QuotaStatus)(nil), (*QuotaStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_QuotaStatus_To_v1beta1_QuotaStatus(a.(*core.QuotaStatus), b.(*QuotaStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*QuotaUsage)(nil), (*core.QuotaUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QuotaUsage_To_core_QuotaUsage(a.(*QuotaUsage), b.(*core.QuotaUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.QuotaUsage)(nil), (*QuotaUsage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_QuotaUsage_To_v1beta1_QuotaUsage(a.(*core.QuotaUsage), b.(*QuotaUsage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*QuotaUsageShoot)(nil), (*core.QuotaUsageShoot)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_QuotaUsageShoot_To_core_QuotaUsageShoot(a.(*QuotaUsageShoot), b.(*core.QuotaUsageShoot), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.QuotaUsageShoot)(nil), (*QuotaUsageShoot)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_QuotaUsageShoot_To_v1beta1_QuotaUsageShoot(a.(*core.QuotaUsageShoot), b.(*QuotaUsageShoot), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Region)(nil), (*core.Region)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Region_To_core_Region(a.(*Region), b.(*core.Region), scope)
 	}); err != nil {
@@ -1565,6 +1625,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootStateEncryptionKey)(nil), (*core.ShootStateEncryptionKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_ShootStateEncryptionKey_To_core_ShootStateEncryptionKey(a.(*ShootStateEncryptionKey), b.(*core.ShootStateEncryptionKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.ShootStateEncryptionKey)(nil), (*ShootStateEncryptionKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_ShootStateEncryptionKey_To_v1beta1_ShootStateEncryptionKey(a.(*core.ShootStateEncryptionKey), b.(*ShootStateEncryptionKey), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ShootStateList)(nil), (*core.ShootStateList)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_ShootStateList_To_core_ShootStateList(a.(*ShootStateList), b.(*core.ShootStateList), scope)
 	}); err != nil {
@@ -1685,6 +1755,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*WorkerPoolInPlaceUpdate)(nil), (*core.WorkerPoolInPlaceUpdate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_WorkerPoolInPlaceUpdate_To_core_WorkerPoolInPlaceUpdate(a.(*WorkerPoolInPlaceUpdate), b.(*core.WorkerPoolInPlaceUpdate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*core.WorkerPoolInPlaceUpdate)(nil), (*WorkerPoolInPlaceUpdate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_core_WorkerPoolInPlaceUpdate_To_v1beta1_WorkerPoolInPlaceUpdate(a.(*core.WorkerPoolInPlaceUpdate), b.(*WorkerPoolInPlaceUpdate), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerSystemComponents)(nil), (*core.WorkerSystemComponents)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_WorkerSystemComponents_To_core_WorkerSystemComponents(a.(*WorkerSystemComponents), b.(*core.WorkerSystemComponents), scope)
 	}); err != nil {
@@ -2983,6 +3063,7 @@ func Convert_core_ETCDEncryptionKeyRotation_To_v1beta1_ETCDEncryptionKeyRotation
 
 func autoConvert_v1beta1_EncryptionConfig_To_core_EncryptionConfig(in *EncryptionConfig, out *core.EncryptionConfig, s conversion.Scope) error {
 	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.KMS = (*core.KMSConfig)(unsafe.Pointer(in.KMS))
 	return nil
 }
 
@@ -2993,6 +3074,7 @@ func Convert_v1beta1_EncryptionConfig_To_core_EncryptionConfig(in *EncryptionCon
 
 func autoConvert_core_EncryptionConfig_To_v1beta1_EncryptionConfig(in *core.EncryptionConfig, out *EncryptionConfig, s conversion.Scope) error {
 	out.Resources = *(*[]string)(unsafe.Pointer(&in.Resources))
+	out.KMS = (*KMSConfig)(unsafe.Pointer(in.KMS))
 	return nil
 }
 
@@ -3218,6 +3300,7 @@ func Convert_core_GardenerResourceData_To_v1beta1_GardenerResourceData(in *core.
 func autoConvert_v1beta1_Hibernation_To_core_Hibernation(in *Hibernation, out *core.Hibernation, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Schedules = *(*[]core.HibernationSchedule)(unsafe.Pointer(&in.Schedules))
+	out.IdlePolicy = (*core.HibernationIdlePolicy)(unsafe.Pointer(in.IdlePolicy))
 	return nil
 }
 
@@ -3229,6 +3312,7 @@ func Convert_v1beta1_Hibernation_To_core_Hibernation(in *Hibernation, out *core.
 func autoConvert_core_Hibernation_To_v1beta1_Hibernation(in *core.Hibernation, out *Hibernation, s conversion.Scope) error {
 	out.Enabled = (*bool)(unsafe.Pointer(in.Enabled))
 	out.Schedules = *(*[]HibernationSchedule)(unsafe.Pointer(&in.Schedules))
+	out.IdlePolicy = (*HibernationIdlePolicy)(unsafe.Pointer(in.IdlePolicy))
 	return nil
 }
 
@@ -3237,6 +3321,26 @@ func Convert_core_Hibernation_To_v1beta1_Hibernation(in *core.Hibernation, out *
 	return autoConvert_core_Hibernation_To_v1beta1_Hibernation(in, out, s)
 }
 
+func autoConvert_v1beta1_HibernationIdlePolicy_To_core_HibernationIdlePolicy(in *HibernationIdlePolicy, out *core.HibernationIdlePolicy, s conversion.Scope) error {
+	out.IdleDuration = in.IdleDuration
+	return nil
+}
+
+// Convert_v1beta1_HibernationIdlePolicy_To_core_HibernationIdlePolicy is an autogenerated conversion function.
+func Convert_v1beta1_HibernationIdlePolicy_To_core_HibernationIdlePolicy(in *HibernationIdlePolicy, out *core.HibernationIdlePolicy, s conversion.Scope) error {
+	return autoConvert_v1beta1_HibernationIdlePolicy_To_core_HibernationIdlePolicy(in, out, s)
+}
+
+func autoConvert_core_HibernationIdlePolicy_To_v1beta1_HibernationIdlePolicy(in *core.HibernationIdlePolicy, out *HibernationIdlePolicy, s conversion.Scope) error {
+	out.IdleDuration = in.IdleDuration
+	return nil
+}
+
+// Convert_core_HibernationIdlePolicy_To_v1beta1_HibernationIdlePolicy is an autogenerated conversion function.
+func Convert_core_HibernationIdlePolicy_To_v1beta1_HibernationIdlePolicy(in *core.HibernationIdlePolicy, out *HibernationIdlePolicy, s conversion.Scope) error {
+	return autoConvert_core_HibernationIdlePolicy_To_v1beta1_HibernationIdlePolicy(in, out, s)
+}
+
 func autoConvert_v1beta1_HibernationSchedule_To_core_HibernationSchedule(in *HibernationSchedule, out *core.HibernationSchedule, s conversion.Scope) error {
 	out.Start = (*string)(unsafe.Pointer(in.Start))
 	out.End = (*string)(unsafe.Pointer(in.End))
@@ -3425,6 +3529,30 @@ func Convert_core_InternalSecretList_To_v1beta1_InternalSecretList(in *core.Inte
 	return autoConvert_core_InternalSecretList_To_v1beta1_InternalSecretList(in, out, s)
 }
 
+func autoConvert_v1beta1_KMSConfig_To_core_KMSConfig(in *KMSConfig, out *core.KMSConfig, s conversion.Scope) error {
+	out.Type = in.Type
+	out.CredentialsResourceName = (*string)(unsafe.Pointer(in.CredentialsResourceName))
+	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
+	return nil
+}
+
+// Convert_v1beta1_KMSConfig_To_core_KMSConfig is an autogenerated conversion function.
+func Convert_v1beta1_KMSConfig_To_core_KMSConfig(in *KMSConfig, out *core.KMSConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_KMSConfig_To_core_KMSConfig(in, out, s)
+}
+
+func autoConvert_core_KMSConfig_To_v1beta1_KMSConfig(in *core.KMSConfig, out *KMSConfig, s conversion.Scope) error {
+	out.Type = in.Type
+	out.CredentialsResourceName = (*string)(unsafe.Pointer(in.CredentialsResourceName))
+	out.ProviderConfig = (*runtime.RawExtension)(unsafe.Pointer(in.ProviderConfig))
+	return nil
+}
+
+// Convert_core_KMSConfig_To_v1beta1_KMSConfig is an autogenerated conversion function.
+func Convert_core_KMSConfig_To_v1beta1_KMSConfig(in *core.KMSConfig, out *KMSConfig, s conversion.Scope) error {
+	return autoConvert_core_KMSConfig_To_v1beta1_KMSConfig(in, out, s)
+}
+
 func autoConvert_v1beta1_KubeAPIServerConfig_To_core_KubeAPIServerConfig(in *KubeAPIServerConfig, out *core.KubeAPIServerConfig, s conversion.Scope) error {
 	if err := Convert_v1beta1_KubernetesConfig_To_core_KubernetesConfig(&in.KubernetesConfig, &out.KubernetesConfig, s); err != nil {
 		return err
@@ -4165,6 +4293,7 @@ func autoConvert_v1beta1_Maintenance_To_core_Maintenance(in *Maintenance, out *c
 	out.AutoUpdate = (*core.MaintenanceAutoUpdate)(unsafe.Pointer(in.AutoUpdate))
 	out.TimeWindow = (*core.MaintenanceTimeWindow)(unsafe.Pointer(in.TimeWindow))
 	out.ConfineSpecUpdateRollout = (*bool)(unsafe.Pointer(in.ConfineSpecUpdateRollout))
+	out.FreezePeriods = *(*[]core.MaintenanceFreezePeriod)(unsafe.Pointer(&in.FreezePeriods))
 	return nil
 }
 
@@ -4177,6 +4306,7 @@ func autoConvert_core_Maintenance_To_v1beta1_Maintenance(in *core.Maintenance, o
 	out.AutoUpdate = (*MaintenanceAutoUpdate)(unsafe.Pointer(in.AutoUpdate))
 	out.TimeWindow = (*MaintenanceTimeWindow)(unsafe.Pointer(in.TimeWindow))
 	out.ConfineSpecUpdateRollout = (*bool)(unsafe.Pointer(in.ConfineSpecUpdateRollout))
+	out.FreezePeriods = *(*[]MaintenanceFreezePeriod)(unsafe.Pointer(&in.FreezePeriods))
 	return nil
 }
 
@@ -4207,9 +4337,34 @@ func Convert_core_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in *cor
 	return autoConvert_core_MaintenanceAutoUpdate_To_v1beta1_MaintenanceAutoUpdate(in, out, s)
 }
 
+func autoConvert_v1beta1_MaintenanceFreezePeriod_To_core_MaintenanceFreezePeriod(in *MaintenanceFreezePeriod, out *core.MaintenanceFreezePeriod, s conversion.Scope) error {
+	out.Begin = in.Begin
+	out.End = in.End
+	out.Reason = (*string)(unsafe.Pointer(in.Reason))
+	return nil
+}
+
+// Convert_v1beta1_MaintenanceFreezePeriod_To_core_MaintenanceFreezePeriod is an autogenerated conversion function.
+func Convert_v1beta1_MaintenanceFreezePeriod_To_core_MaintenanceFreezePeriod(in *MaintenanceFreezePeriod, out *core.MaintenanceFreezePeriod, s conversion.Scope) error {
+	return autoConvert_v1beta1_MaintenanceFreezePeriod_To_core_MaintenanceFreezePeriod(in, out, s)
+}
+
+func autoConvert_core_MaintenanceFreezePeriod_To_v1beta1_MaintenanceFreezePeriod(in *core.MaintenanceFreezePeriod, out *MaintenanceFreezePeriod, s conversion.Scope) error {
+	out.Begin = in.Begin
+	out.End = in.End
+	out.Reason = (*string)(unsafe.Pointer(in.Reason))
+	return nil
+}
+
+// Convert_core_MaintenanceFreezePeriod_To_v1beta1_MaintenanceFreezePeriod is an autogenerated conversion function.
+func Convert_core_MaintenanceFreezePeriod_To_v1beta1_MaintenanceFreezePeriod(in *core.MaintenanceFreezePeriod, out *MaintenanceFreezePeriod, s conversion.Scope) error {
+	return autoConvert_core_MaintenanceFreezePeriod_To_v1beta1_MaintenanceFreezePeriod(in, out, s)
+}
+
 func autoConvert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(in *MaintenanceTimeWindow, out *core.MaintenanceTimeWindow, s conversion.Scope) error {
 	out.Begin = in.Begin
 	out.End = in.End
+	out.Weekdays = *(*[]string)(unsafe.Pointer(&in.Weekdays))
 	return nil
 }
 
@@ -4221,6 +4376,7 @@ func Convert_v1beta1_MaintenanceTimeWindow_To_core_MaintenanceTimeWindow(in *Mai
 func autoConvert_core_MaintenanceTimeWindow_To_v1beta1_MaintenanceTimeWindow(in *core.MaintenanceTimeWindow, out *MaintenanceTimeWindow, s conversion.Scope) error {
 	out.Begin = in.Begin
 	out.End = in.End
+	out.Weekdays = *(*[]string)(unsafe.Pointer(&in.Weekdays))
 	return nil
 }
 
@@ -4564,6 +4720,7 @@ func autoConvert_v1beta1_ProjectSpec_To_core_ProjectSpec(in *ProjectSpec, out *c
 	}
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Tolerations = (*core.ProjectTolerations)(unsafe.Pointer(in.Tolerations))
+	out.MaintenanceFreezePeriods = *(*[]core.MaintenanceFreezePeriod)(unsafe.Pointer(&in.MaintenanceFreezePeriods))
 	return nil
 }
 
@@ -4585,6 +4742,7 @@ func autoConvert_core_ProjectSpec_To_v1beta1_ProjectSpec(in *core.ProjectSpec, o
 	}
 	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
 	out.Tolerations = (*ProjectTolerations)(unsafe.Pointer(in.Tolerations))
+	out.MaintenanceFreezePeriods = *(*[]MaintenanceFreezePeriod)(unsafe.Pointer(&in.MaintenanceFreezePeriods))
 	return nil
 }
 
@@ -4691,6 +4849,9 @@ func autoConvert_v1beta1_Quota_To_core_Quota(in *Quota, out *core.Quota, s conve
 	if err := Convert_v1beta1_QuotaSpec_To_core_QuotaSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_v1beta1_QuotaStatus_To_core_QuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4704,6 +4865,9 @@ func autoConvert_core_Quota_To_v1beta1_Quota(in *core.Quota, out *Quota, s conve
 	if err := Convert_core_QuotaSpec_To_v1beta1_QuotaSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
+	if err := Convert_core_QuotaStatus_To_v1beta1_QuotaStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -4758,6 +4922,76 @@ func Convert_core_QuotaSpec_To_v1beta1_QuotaSpec(in *core.QuotaSpec, out *QuotaS
 	return autoConvert_core_QuotaSpec_To_v1beta1_QuotaSpec(in, out, s)
 }
 
+func autoConvert_v1beta1_QuotaStatus_To_core_QuotaStatus(in *QuotaStatus, out *core.QuotaStatus, s conversion.Scope) error {
+	out.Hard = *(*v1.ResourceList)(unsafe.Pointer(&in.Hard))
+	out.Usages = *(*[]core.QuotaUsage)(unsafe.Pointer(&in.Usages))
+	return nil
+}
+
+// Convert_v1beta1_QuotaStatus_To_core_QuotaStatus is an autogenerated conversion function.
+func Convert_v1beta1_QuotaStatus_To_core_QuotaStatus(in *QuotaStatus, out *core.QuotaStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_QuotaStatus_To_core_QuotaStatus(in, out, s)
+}
+
+func autoConvert_core_QuotaStatus_To_v1beta1_QuotaStatus(in *core.QuotaStatus, out *QuotaStatus, s conversion.Scope) error {
+	out.Hard = *(*v1.ResourceList)(unsafe.Pointer(&in.Hard))
+	out.Usages = *(*[]QuotaUsage)(unsafe.Pointer(&in.Usages))
+	return nil
+}
+
+// Convert_core_QuotaStatus_To_v1beta1_QuotaStatus is an autogenerated conversion function.
+func Convert_core_QuotaStatus_To_v1beta1_QuotaStatus(in *core.QuotaStatus, out *QuotaStatus, s conversion.Scope) error {
+	return autoConvert_core_QuotaStatus_To_v1beta1_QuotaStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_QuotaUsage_To_core_QuotaUsage(in *QuotaUsage, out *core.QuotaUsage, s conversion.Scope) error {
+	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	out.Shoots = *(*[]core.QuotaUsageShoot)(unsafe.Pointer(&in.Shoots))
+	return nil
+}
+
+// Convert_v1beta1_QuotaUsage_To_core_QuotaUsage is an autogenerated conversion function.
+func Convert_v1beta1_QuotaUsage_To_core_QuotaUsage(in *QuotaUsage, out *core.QuotaUsage, s conversion.Scope) error {
+	return autoConvert_v1beta1_QuotaUsage_To_core_QuotaUsage(in, out, s)
+}
+
+func autoConvert_core_QuotaUsage_To_v1beta1_QuotaUsage(in *core.QuotaUsage, out *QuotaUsage, s conversion.Scope) error {
+	out.Namespace = (*string)(unsafe.Pointer(in.Namespace))
+	out.Used = *(*v1.ResourceList)(unsafe.Pointer(&in.Used))
+	out.Shoots = *(*[]QuotaUsageShoot)(unsafe.Pointer(&in.Shoots))
+	return nil
+}
+
+// Convert_core_QuotaUsage_To_v1beta1_QuotaUsage is an autogenerated conversion function.
+func Convert_core_QuotaUsage_To_v1beta1_QuotaUsage(in *core.QuotaUsage, out *QuotaUsage, s conversion.Scope) error {
+	return autoConvert_core_QuotaUsage_To_v1beta1_QuotaUsage(in, out, s)
+}
+
+func autoConvert_v1beta1_QuotaUsageShoot_To_core_QuotaUsageShoot(in *QuotaUsageShoot, out *core.QuotaUsageShoot, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.Generation = in.Generation
+	return nil
+}
+
+// Convert_v1beta1_QuotaUsageShoot_To_core_QuotaUsageShoot is an autogenerated conversion function.
+func Convert_v1beta1_QuotaUsageShoot_To_core_QuotaUsageShoot(in *QuotaUsageShoot, out *core.QuotaUsageShoot, s conversion.Scope) error {
+	return autoConvert_v1beta1_QuotaUsageShoot_To_core_QuotaUsageShoot(in, out, s)
+}
+
+func autoConvert_core_QuotaUsageShoot_To_v1beta1_QuotaUsageShoot(in *core.QuotaUsageShoot, out *QuotaUsageShoot, s conversion.Scope) error {
+	out.Namespace = in.Namespace
+	out.Name = in.Name
+	out.Generation = in.Generation
+	return nil
+}
+
+// Convert_core_QuotaUsageShoot_To_v1beta1_QuotaUsageShoot is an autogenerated conversion function.
+func Convert_core_QuotaUsageShoot_To_v1beta1_QuotaUsageShoot(in *core.QuotaUsageShoot, out *QuotaUsageShoot, s conversion.Scope) error {
+	return autoConvert_core_QuotaUsageShoot_To_v1beta1_QuotaUsageShoot(in, out, s)
+}
+
 func autoConvert_v1beta1_Region_To_core_Region(in *Region, out *core.Region, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Zones = *(*[]core.AvailabilityZone)(unsafe.Pointer(&in.Zones))
@@ -5438,6 +5672,7 @@ func autoConvert_v1beta1_SeedStatus_To_core_SeedStatus(in *SeedStatus, out *core
 	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
 	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*core.LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.ShootStateEncryptionKeys = *(*[]core.ShootStateEncryptionKey)(unsafe.Pointer(&in.ShootStateEncryptionKeys))
 	return nil
 }
 
@@ -5456,6 +5691,7 @@ func autoConvert_core_SeedStatus_To_v1beta1_SeedStatus(in *core.SeedStatus, out
 	out.Allocatable = *(*v1.ResourceList)(unsafe.Pointer(&in.Allocatable))
 	out.ClientCertificateExpirationTimestamp = (*metav1.Time)(unsafe.Pointer(in.ClientCertificateExpirationTimestamp))
 	out.LastOperation = (*LastOperation)(unsafe.Pointer(in.LastOperation))
+	out.ShootStateEncryptionKeys = *(*[]ShootStateEncryptionKey)(unsafe.Pointer(&in.ShootStateEncryptionKeys))
 	return nil
 }
 
@@ -5944,6 +6180,30 @@ func Convert_core_ShootState_To_v1beta1_ShootState(in *core.ShootState, out *Sho
 	return autoConvert_core_ShootState_To_v1beta1_ShootState(in, out, s)
 }
 
+func autoConvert_v1beta1_ShootStateEncryptionKey_To_core_ShootStateEncryptionKey(in *ShootStateEncryptionKey, out *core.ShootStateEncryptionKey, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Fingerprint = in.Fingerprint
+	out.Primary = in.Primary
+	return nil
+}
+
+// Convert_v1beta1_ShootStateEncryptionKey_To_core_ShootStateEncryptionKey is an autogenerated conversion function.
+func Convert_v1beta1_ShootStateEncryptionKey_To_core_ShootStateEncryptionKey(in *ShootStateEncryptionKey, out *core.ShootStateEncryptionKey, s conversion.Scope) error {
+	return autoConvert_v1beta1_ShootStateEncryptionKey_To_core_ShootStateEncryptionKey(in, out, s)
+}
+
+func autoConvert_core_ShootStateEncryptionKey_To_v1beta1_ShootStateEncryptionKey(in *core.ShootStateEncryptionKey, out *ShootStateEncryptionKey, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Fingerprint = in.Fingerprint
+	out.Primary = in.Primary
+	return nil
+}
+
+// Convert_core_ShootStateEncryptionKey_To_v1beta1_ShootStateEncryptionKey is an autogenerated conversion function.
+func Convert_core_ShootStateEncryptionKey_To_v1beta1_ShootStateEncryptionKey(in *core.ShootStateEncryptionKey, out *ShootStateEncryptionKey, s conversion.Scope) error {
+	return autoConvert_core_ShootStateEncryptionKey_To_v1beta1_ShootStateEncryptionKey(in, out, s)
+}
+
 func autoConvert_v1beta1_ShootStateList_To_core_ShootStateList(in *ShootStateList, out *core.ShootStateList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
 	out.Items = *(*[]core.ShootState)(unsafe.Pointer(&in.Items))
@@ -6011,6 +6271,8 @@ func autoConvert_v1beta1_ShootStatus_To_core_ShootStatus(in *ShootStatus, out *c
 	out.LastHibernationTriggerTime = (*metav1.Time)(unsafe.Pointer(in.LastHibernationTriggerTime))
 	out.LastMaintenance = (*core.LastMaintenance)(unsafe.Pointer(in.LastMaintenance))
 	out.EncryptedResources = *(*[]string)(unsafe.Pointer(&in.EncryptedResources))
+	out.LastActivityTime = (*metav1.Time)(unsafe.Pointer(in.LastActivityTime))
+	out.InPlaceUpdates = *(*[]core.WorkerPoolInPlaceUpdate)(unsafe.Pointer(&in.InPlaceUpdates))
 	return nil
 }
 
@@ -6040,6 +6302,8 @@ func autoConvert_core_ShootStatus_To_v1beta1_ShootStatus(in *core.ShootStatus, o
 	out.Credentials = (*ShootCredentials)(unsafe.Pointer(in.Credentials))
 	out.LastMaintenance = (*LastMaintenance)(unsafe.Pointer(in.LastMaintenance))
 	out.EncryptedResources = *(*[]string)(unsafe.Pointer(&in.EncryptedResources))
+	out.LastActivityTime = (*metav1.Time)(unsafe.Pointer(in.LastActivityTime))
+	out.InPlaceUpdates = *(*[]WorkerPoolInPlaceUpdate)(unsafe.Pointer(&in.InPlaceUpdates))
 	return nil
 }
 
@@ -6260,6 +6524,7 @@ func autoConvert_v1beta1_Worker_To_core_Worker(in *Worker, out *core.Worker, s c
 	out.MachineControllerManagerSettings = (*core.MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
 	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
 	out.ClusterAutoscaler = (*core.ClusterAutoscalerOptions)(unsafe.Pointer(in.ClusterAutoscaler))
+	out.UpdateStrategy = (*core.MachineUpdateStrategy)(unsafe.Pointer(in.UpdateStrategy))
 	return nil
 }
 
@@ -6300,6 +6565,7 @@ func autoConvert_core_Worker_To_v1beta1_Worker(in *core.Worker, out *Worker, s c
 	out.MachineControllerManagerSettings = (*MachineControllerManagerSettings)(unsafe.Pointer(in.MachineControllerManagerSettings))
 	out.Sysctls = *(*map[string]string)(unsafe.Pointer(&in.Sysctls))
 	out.ClusterAutoscaler = (*ClusterAutoscalerOptions)(unsafe.Pointer(in.ClusterAutoscaler))
+	out.UpdateStrategy = (*MachineUpdateStrategy)(unsafe.Pointer(in.UpdateStrategy))
 	return nil
 }
 
@@ -6346,6 +6612,32 @@ func Convert_core_WorkerKubernetes_To_v1beta1_WorkerKubernetes(in *core.WorkerKu
 	return autoConvert_core_WorkerKubernetes_To_v1beta1_WorkerKubernetes(in, out, s)
 }
 
+func autoConvert_v1beta1_WorkerPoolInPlaceUpdate_To_core_WorkerPoolInPlaceUpdate(in *WorkerPoolInPlaceUpdate, out *core.WorkerPoolInPlaceUpdate, s conversion.Scope) error {
+	out.Name = in.Name
+	out.KubernetesVersion = in.KubernetesVersion
+	out.Nodes = in.Nodes
+	out.UpdatedNodes = in.UpdatedNodes
+	return nil
+}
+
+// Convert_v1beta1_WorkerPoolInPlaceUpdate_To_core_WorkerPoolInPlaceUpdate is an autogenerated conversion function.
+func Convert_v1beta1_WorkerPoolInPlaceUpdate_To_core_WorkerPoolInPlaceUpdate(in *WorkerPoolInPlaceUpdate, out *core.WorkerPoolInPlaceUpdate, s conversion.Scope) error {
+	return autoConvert_v1beta1_WorkerPoolInPlaceUpdate_To_core_WorkerPoolInPlaceUpdate(in, out, s)
+}
+
+func autoConvert_core_WorkerPoolInPlaceUpdate_To_v1beta1_WorkerPoolInPlaceUpdate(in *core.WorkerPoolInPlaceUpdate, out *WorkerPoolInPlaceUpdate, s conversion.Scope) error {
+	out.Name = in.Name
+	out.KubernetesVersion = in.KubernetesVersion
+	out.Nodes = in.Nodes
+	out.UpdatedNodes = in.UpdatedNodes
+	return nil
+}
+
+// Convert_core_WorkerPoolInPlaceUpdate_To_v1beta1_WorkerPoolInPlaceUpdate is an autogenerated conversion function.
+func Convert_core_WorkerPoolInPlaceUpdate_To_v1beta1_WorkerPoolInPlaceUpdate(in *core.WorkerPoolInPlaceUpdate, out *WorkerPoolInPlaceUpdate, s conversion.Scope) error {
+	return autoConvert_core_WorkerPoolInPlaceUpdate_To_v1beta1_WorkerPoolInPlaceUpdate(in, out, s)
+}
+
 func autoConvert_v1beta1_WorkerSystemComponents_To_core_WorkerSystemComponents(in *WorkerSystemComponents, out *core.WorkerSystemComponents, s conversion.Scope) error {
 	out.Allow = in.Allow
 	return nil
@@ -122,6 +122,8 @@ func ValidateProjectSpec(projectSpec *core.ProjectSpec, fldPath *field.Path) fie
 		allErrs = append(allErrs, ValidateTolerationsAgainstAllowlist(projectSpec.Tolerations.Defaults, projectSpec.Tolerations.Whitelist, fldPath.Child("tolerations", "defaults"))...)
 	}
 
+	allErrs = append(allErrs, ValidateMaintenanceFreezePeriods(projectSpec.MaintenanceFreezePeriods, fldPath.Child("maintenanceFreezePeriods"))...)
+
 	return allErrs
 }
 
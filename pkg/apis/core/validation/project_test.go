@@ -402,6 +402,32 @@ var _ = Describe("Project Validation Tests", func() {
 			))
 		})
 
+		It("should forbid invalid maintenance freeze periods", func() {
+			now := metav1.Now()
+			project.Spec.MaintenanceFreezePeriods = []core.MaintenanceFreezePeriod{
+				{Begin: now, End: metav1.NewTime(now.AddDate(0, 0, 14)), Reason: ptr.To("year-end change freeze")},
+				{Begin: now, End: now},
+				{End: now, Reason: ptr.To("")},
+			}
+
+			errorList := ValidateProject(project)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.maintenanceFreezePeriods[1].end"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.maintenanceFreezePeriods[2].begin"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.maintenanceFreezePeriods[2].reason"),
+				})),
+			))
+		})
+
 		DescribeTable("namespace immutability",
 			func(old, new *string, matcher gomegatypes.GomegaMatcher) {
 				project.Spec.Namespace = old
@@ -19,7 +19,9 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/apis/core"
 	"github.com/gardener/gardener/pkg/apis/core/helper"
@@ -53,14 +55,7 @@ func ValidateQuotaSpec(quotaSpec *core.QuotaSpec, fldPath *field.Path) field.Err
 		allErrs = append(allErrs, field.NotSupported(fldPath.Child("scope"), scopeRef, []string{"project", "secret"}))
 	}
 
-	metricsFldPath := fldPath.Child("metrics")
-	for k, v := range quotaSpec.Metrics {
-		keyPath := metricsFldPath.Key(string(k))
-		if !isValidQuotaMetric(k) {
-			allErrs = append(allErrs, field.Invalid(keyPath, v.String(), fmt.Sprintf("%s is no supported quota metric", string(k))))
-		}
-		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(k.String(), v, keyPath)...)
-	}
+	allErrs = append(allErrs, validateQuotaResourceList(quotaSpec.Metrics, fldPath.Child("metrics"))...)
 
 	return allErrs
 }
@@ -78,3 +73,60 @@ func isValidQuotaMetric(metric corev1.ResourceName) bool {
 	}
 	return false
 }
+
+// ValidateQuotaStatusUpdate validates the status field of a Quota object.
+func ValidateQuotaStatusUpdate(newQuota, oldQuota *core.Quota) field.ErrorList {
+	allErrs := apivalidation.ValidateObjectMetaUpdate(&newQuota.ObjectMeta, &oldQuota.ObjectMeta, field.NewPath("metadata"))
+	allErrs = append(allErrs, ValidateQuotaStatus(&newQuota.Status, field.NewPath("status"))...)
+	return allErrs
+}
+
+// ValidateQuotaStatus validates the status of a Quota object.
+func ValidateQuotaStatus(quotaStatus *core.QuotaStatus, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	allErrs = append(allErrs, validateQuotaResourceList(quotaStatus.Hard, fldPath.Child("hard"))...)
+
+	namespaces := sets.New[string]()
+	for i, usage := range quotaStatus.Usages {
+		idxPath := fldPath.Child("usages").Index(i)
+
+		namespace := ptr.Deref(usage.Namespace, "")
+		if usage.Namespace != nil && len(namespace) == 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("namespace"), namespace, "namespace must not be empty if set"))
+		}
+		if namespaces.Has(namespace) {
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("namespace"), namespace))
+		}
+		namespaces.Insert(namespace)
+
+		allErrs = append(allErrs, validateQuotaResourceList(usage.Used, idxPath.Child("used"))...)
+
+		for j, shoot := range usage.Shoots {
+			shootPath := idxPath.Child("shoots").Index(j)
+
+			if len(shoot.Namespace) == 0 {
+				allErrs = append(allErrs, field.Required(shootPath.Child("namespace"), "must provide the namespace of the shoot"))
+			}
+			if len(shoot.Name) == 0 {
+				allErrs = append(allErrs, field.Required(shootPath.Child("name"), "must provide the name of the shoot"))
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateQuotaResourceList(resources corev1.ResourceList, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for k, v := range resources {
+		keyPath := fldPath.Key(string(k))
+		if !isValidQuotaMetric(k) {
+			allErrs = append(allErrs, field.Invalid(keyPath, v.String(), fmt.Sprintf("%s is no supported quota metric", string(k))))
+		}
+		allErrs = append(allErrs, kubernetescorevalidation.ValidateResourceQuantityValue(k.String(), v, keyPath)...)
+	}
+
+	return allErrs
+}
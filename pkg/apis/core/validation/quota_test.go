@@ -23,6 +23,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/apis/core"
 	. "github.com/gardener/gardener/pkg/apis/core/validation"
@@ -130,4 +131,94 @@ var _ = Describe("Quota Validation Tests ", func() {
 			))
 		})
 	})
+
+	Describe("#ValidateQuotaStatusUpdate", func() {
+		var oldQuota, newQuota *core.Quota
+
+		BeforeEach(func() {
+			oldQuota = &core.Quota{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            "quota-1",
+					Namespace:       "my-namespace",
+					ResourceVersion: "1",
+				},
+				Spec: core.QuotaSpec{
+					Scope: corev1.ObjectReference{
+						APIVersion: "core.gardener.cloud/v1beta1",
+						Kind:       "Project",
+					},
+					Metrics: corev1.ResourceList{
+						"cpu": resource.MustParse("200"),
+					},
+				},
+			}
+			newQuota = oldQuota.DeepCopy()
+		})
+
+		It("should allow a valid status", func() {
+			newQuota.Status = core.QuotaStatus{
+				Hard: corev1.ResourceList{"cpu": resource.MustParse("200")},
+				Usages: []core.QuotaUsage{
+					{
+						Namespace: ptr.To("garden-foo"),
+						Used:      corev1.ResourceList{"cpu": resource.MustParse("8")},
+						Shoots:    []core.QuotaUsageShoot{{Namespace: "garden-foo", Name: "bar"}},
+					},
+					{
+						Namespace: ptr.To("garden-bar"),
+						Used:      corev1.ResourceList{"cpu": resource.MustParse("0")},
+					},
+				},
+			}
+
+			Expect(ValidateQuotaStatusUpdate(newQuota, oldQuota)).To(BeEmpty())
+		})
+
+		It("should forbid invalid status values", func() {
+			newQuota.Status = core.QuotaStatus{
+				Hard: corev1.ResourceList{"foo": resource.MustParse("1")},
+				Usages: []core.QuotaUsage{
+					{
+						Namespace: ptr.To(""),
+						Used:      corev1.ResourceList{"cpu": resource.MustParse("-1")},
+						Shoots:    []core.QuotaUsageShoot{{}},
+					},
+					{
+						Namespace: ptr.To(""),
+					},
+				},
+			}
+
+			Expect(ValidateQuotaStatusUpdate(newQuota, oldQuota)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.hard[foo]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.usages[0].namespace"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.usages[0].used[cpu]"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("status.usages[0].shoots[0].namespace"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("status.usages[0].shoots[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("status.usages[1].namespace"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("status.usages[1].namespace"),
+				})),
+			))
+		})
+	})
 })
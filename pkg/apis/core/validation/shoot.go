@@ -102,6 +102,10 @@ var (
 	availableWorkerCRINames = sets.New(
 		string(core.CRINameContainerD),
 	)
+	availableMachineUpdateStrategies = sets.New(
+		string(core.AutoRollingUpdate),
+		string(core.AutoInPlaceUpdate),
+	)
 	availableClusterAutoscalerExpanderModes = sets.New(
 		string(core.ClusterAutoscalerExpanderLeastWaste),
 		string(core.ClusterAutoscalerExpanderMostPods),
@@ -273,6 +277,7 @@ func ValidateShootSpec(meta metav1.ObjectMeta, spec *core.ShootSpec, fldPath *fi
 	allErrs = append(allErrs, validateExtensions(spec.Extensions, fldPath.Child("extensions"))...)
 	allErrs = append(allErrs, validateResources(spec.Resources, fldPath.Child("resources"))...)
 	allErrs = append(allErrs, validateKubernetes(spec.Kubernetes, spec.Networking, workerless, fldPath.Child("kubernetes"))...)
+	allErrs = append(allErrs, validateKMSCredentialsResourceReference(spec.Kubernetes.KubeAPIServer, spec.Resources, fldPath.Child("kubernetes", "kubeAPIServer", "encryptionConfig", "kms", "credentialsResourceName"))...)
 	allErrs = append(allErrs, validateNetworking(spec.Networking, workerless, fldPath.Child("networking"))...)
 	allErrs = append(allErrs, validateMaintenance(spec.Maintenance, fldPath.Child("maintenance"), workerless)...)
 	allErrs = append(allErrs, validateMonitoring(spec.Monitoring, fldPath.Child("monitoring"))...)
@@ -396,7 +401,21 @@ func validateWorkerUpdate(newHasWorkers, oldHasWorkers bool, fldPath *field.Path
 
 // ValidateProviderUpdate validates the specification of a Provider object.
 func ValidateProviderUpdate(newProvider, oldProvider *core.Provider, fldPath *field.Path) field.ErrorList {
-	return apivalidation.ValidateImmutableField(newProvider.Type, oldProvider.Type, fldPath.Child("type"))
+	allErrs := apivalidation.ValidateImmutableField(newProvider.Type, oldProvider.Type, fldPath.Child("type"))
+
+	for i, newWorker := range newProvider.Workers {
+		for _, oldWorker := range oldProvider.Workers {
+			if newWorker.Name != oldWorker.Name {
+				continue
+			}
+
+			if helper.IsUpdateStrategyInPlace(newWorker.UpdateStrategy) != helper.IsUpdateStrategyInPlace(oldWorker.UpdateStrategy) {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("workers").Index(i).Child("updateStrategy"), "switching between in-place and rolling update strategies is not allowed"))
+			}
+		}
+	}
+
+	return allErrs
 }
 
 // ValidateShootStatusUpdate validates the status field of a Shoot object.
@@ -612,6 +631,15 @@ func ValidateEncryptionConfigUpdate(newConfig, oldConfig *core.EncryptionConfig,
 		}
 	}
 
+	if oldConfig != nil && oldConfig.KMS != nil {
+		// Resources encrypted by the KMS plugin can only be decrypted by it, hence it must not be removed or exchanged.
+		if newConfig == nil || newConfig.KMS == nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("kms"), "kms cannot be removed once configured"))
+		} else {
+			allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.KMS.Type, oldConfig.KMS.Type, fldPath.Child("kms", "type"))...)
+		}
+	}
+
 	return allErrs
 }
 
@@ -1109,9 +1137,51 @@ func validateEncryptionConfig(encryptionConfig *core.EncryptionConfig, version s
 		}
 	}
 
+	if kms := encryptionConfig.KMS; kms != nil {
+		kmsPath := fldPath.Child("encryptionConfig", "kms")
+
+		if k8sLess129, _ := versionutils.CheckVersionMeetsConstraint(version, "< 1.29"); k8sLess129 {
+			allErrs = append(allErrs, field.Forbidden(kmsPath, "kms is only supported for Kubernetes versions >= 1.29"))
+		}
+
+		if len(kms.Type) == 0 {
+			allErrs = append(allErrs, field.Required(kmsPath.Child("type"), "must provide a type"))
+		}
+
+		if kms.CredentialsResourceName != nil && len(*kms.CredentialsResourceName) == 0 {
+			allErrs = append(allErrs, field.Invalid(kmsPath.Child("credentialsResourceName"), *kms.CredentialsResourceName, "must not be empty when providing the key"))
+		}
+	}
+
 	return allErrs
 }
 
+func validateKMSCredentialsResourceReference(kubeAPIServer *core.KubeAPIServerConfig, resources []core.NamedResourceReference, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if kubeAPIServer == nil || kubeAPIServer.EncryptionConfig == nil || kubeAPIServer.EncryptionConfig.KMS == nil {
+		return allErrs
+	}
+
+	credentialsResourceName := ptr.Deref(kubeAPIServer.EncryptionConfig.KMS.CredentialsResourceName, "")
+	if len(credentialsResourceName) == 0 {
+		return allErrs
+	}
+
+	for _, resource := range resources {
+		if resource.Name != credentialsResourceName {
+			continue
+		}
+
+		if resource.ResourceRef.APIVersion != "v1" || resource.ResourceRef.Kind != "Secret" {
+			allErrs = append(allErrs, field.Invalid(fldPath, credentialsResourceName, "must reference a resource of kind Secret in apiVersion v1"))
+		}
+		return allErrs
+	}
+
+	return append(allErrs, field.Invalid(fldPath, credentialsResourceName, "must reference a resource in spec.resources"))
+}
+
 // ValidateClusterAutoscaler validates the given ClusterAutoscaler fields.
 func ValidateClusterAutoscaler(autoScaler core.ClusterAutoscaler, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -1489,6 +1559,52 @@ func validateMaintenance(maintenance *core.Maintenance, fldPath *field.Path, wor
 				return allErrs
 			}
 		}
+
+		weekdays := sets.New[string]()
+		for i, weekday := range maintenance.TimeWindow.Weekdays {
+			idxPath := fldPath.Child("timeWindow", "weekdays").Index(i)
+			if _, err := timewindow.ParseWeekdays([]string{weekday}); err != nil {
+				allErrs = append(allErrs, field.NotSupported(idxPath, weekday, availableWeekdays))
+			}
+			if weekdays.Has(weekday) {
+				allErrs = append(allErrs, field.Duplicate(idxPath, weekday))
+			}
+			weekdays.Insert(weekday)
+		}
+	}
+
+	allErrs = append(allErrs, ValidateMaintenanceFreezePeriods(maintenance.FreezePeriods, fldPath.Child("freezePeriods"))...)
+
+	return allErrs
+}
+
+var availableWeekdays = func() []string {
+	var weekdays []string
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		weekdays = append(weekdays, day.String())
+	}
+	return weekdays
+}()
+
+// ValidateMaintenanceFreezePeriods validates the given maintenance freeze periods.
+func ValidateMaintenanceFreezePeriods(freezePeriods []core.MaintenanceFreezePeriod, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, freezePeriod := range freezePeriods {
+		idxPath := fldPath.Index(i)
+
+		if freezePeriod.Begin.IsZero() {
+			allErrs = append(allErrs, field.Required(idxPath.Child("begin"), "must provide the beginning of the freeze period"))
+		}
+		if freezePeriod.End.IsZero() {
+			allErrs = append(allErrs, field.Required(idxPath.Child("end"), "must provide the end of the freeze period"))
+		}
+		if !freezePeriod.Begin.IsZero() && !freezePeriod.End.IsZero() && !freezePeriod.End.After(freezePeriod.Begin.Time) {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("end"), freezePeriod.End, "end of the freeze period must be after its beginning"))
+		}
+		if freezePeriod.Reason != nil && len(*freezePeriod.Reason) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("reason"), "must provide a reason when key is present"))
+		}
 	}
 
 	return allErrs
@@ -1674,6 +1790,13 @@ func ValidateWorker(worker core.Worker, kubernetes core.Kubernetes, fldPath *fie
 		allErrs = append(allErrs, ValidateClusterAutoscalerOptions(worker.ClusterAutoscaler, fldPath.Child("autoscaler"))...)
 	}
 
+	if worker.UpdateStrategy != nil && !availableMachineUpdateStrategies.Has(string(*worker.UpdateStrategy)) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("updateStrategy"), *worker.UpdateStrategy, sets.List(availableMachineUpdateStrategies)))
+	}
+	if helper.IsUpdateStrategyInPlace(worker.UpdateStrategy) && !features.DefaultFeatureGate.Enabled(features.InPlaceNodeUpdates) {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("updateStrategy"), fmt.Sprintf("the in-place update strategy requires the %s feature gate to be enabled", features.InPlaceNodeUpdates)))
+	}
+
 	return allErrs
 }
 
@@ -2029,6 +2152,26 @@ func ValidateHibernation(annotations map[string]string, hibernation *core.Hibern
 	}
 
 	allErrs = append(allErrs, ValidateHibernationSchedules(hibernation.Schedules, fldPath.Child("schedules"))...)
+	allErrs = append(allErrs, ValidateHibernationIdlePolicy(hibernation.IdlePolicy, fldPath.Child("idlePolicy"))...)
+
+	return allErrs
+}
+
+// minimumHibernationIdleDuration is the minimum idle duration which can be configured in a hibernation idle policy.
+// Lower durations would hibernate clusters while they are still in use, because activity is only reported periodically.
+const minimumHibernationIdleDuration = 30 * time.Minute
+
+// ValidateHibernationIdlePolicy validates a HibernationIdlePolicy.
+func ValidateHibernationIdlePolicy(idlePolicy *core.HibernationIdlePolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if idlePolicy == nil {
+		return allErrs
+	}
+
+	if idlePolicy.IdleDuration.Duration < minimumHibernationIdleDuration {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("idleDuration"), idlePolicy.IdleDuration.Duration.String(), fmt.Sprintf("must be at least %s", minimumHibernationIdleDuration)))
+	}
 
 	return allErrs
 }
@@ -810,6 +810,25 @@ var _ = Describe("Shoot Validation Tests", func() {
 			))
 		})
 
+		It("should forbid switching between in-place and rolling update strategies of worker pools", func() {
+			DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.InPlaceNodeUpdates, true))
+
+			newShoot := prepareShootForUpdate(shoot)
+			newShoot.Spec.Provider.Workers[0].UpdateStrategy = ptr.To(core.AutoInPlaceUpdate)
+
+			Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("spec.provider.workers[0].updateStrategy"),
+			}))))
+		})
+
+		It("should allow setting the rolling update strategy explicitly for existing worker pools", func() {
+			newShoot := prepareShootForUpdate(shoot)
+			newShoot.Spec.Provider.Workers[0].UpdateStrategy = ptr.To(core.AutoRollingUpdate)
+
+			Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+		})
+
 		It("should forbid updating some cloud keys", func() {
 			newShoot := prepareShootForUpdate(shoot)
 			shoot.Spec.CloudProfileName = "another-profile"
@@ -2202,6 +2221,113 @@ var _ = Describe("Shoot Validation Tests", func() {
 
 					Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
 				})
+
+				Context("kms", func() {
+					BeforeEach(func() {
+						shoot.Spec.Kubernetes.Version = "1.29.0"
+						shoot.Spec.Resources = []core.NamedResourceReference{{
+							Name:        "kms-credentials",
+							ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "my-kms-credentials"},
+						}}
+						shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = &core.EncryptionConfig{
+							KMS: &core.KMSConfig{
+								Type:                    "local",
+								CredentialsResourceName: ptr.To("kms-credentials"),
+							},
+						}
+					})
+
+					It("should allow a valid kms configuration", func() {
+						Expect(ValidateShoot(shoot)).To(BeEmpty())
+					})
+
+					It("should forbid kms for Kubernetes versions < 1.29", func() {
+						shoot.Spec.Kubernetes.Version = "1.28.2"
+
+						Expect(ValidateShoot(shoot)).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeForbidden),
+								"Field": Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.kms"),
+							})),
+						))
+					})
+
+					It("should require a type", func() {
+						shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig.KMS.Type = ""
+
+						Expect(ValidateShoot(shoot)).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeRequired),
+								"Field": Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.kms.type"),
+							})),
+						))
+					})
+
+					It("should forbid credentials resource names which are not referenced in spec.resources", func() {
+						shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig.KMS.CredentialsResourceName = ptr.To("foo")
+
+						Expect(ValidateShoot(shoot)).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":   Equal(field.ErrorTypeInvalid),
+								"Field":  Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.kms.credentialsResourceName"),
+								"Detail": Equal("must reference a resource in spec.resources"),
+							})),
+						))
+					})
+
+					It("should forbid credentials resources which are not secrets", func() {
+						shoot.Spec.Resources[0].ResourceRef.Kind = "ConfigMap"
+
+						Expect(ValidateShoot(shoot)).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":   Equal(field.ErrorTypeInvalid),
+								"Field":  Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.kms.credentialsResourceName"),
+								"Detail": Equal("must reference a resource of kind Secret in apiVersion v1"),
+							})),
+						))
+					})
+
+					It("should forbid removing the kms configuration", func() {
+						newShoot := prepareShootForUpdate(shoot)
+						newShoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig.KMS = nil
+
+						Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeForbidden),
+								"Field": Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.kms"),
+							})),
+						))
+					})
+
+					It("should forbid changing the kms type", func() {
+						newShoot := prepareShootForUpdate(shoot)
+						newShoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig.KMS.Type = "other"
+
+						Expect(ValidateShootUpdate(newShoot, shoot)).To(ConsistOf(
+							PointTo(MatchFields(IgnoreExtras, Fields{
+								"Type":  Equal(field.ErrorTypeInvalid),
+								"Field": Equal("spec.kubernetes.kubeAPIServer.encryptionConfig.kms.type"),
+							})),
+						))
+					})
+
+					It("should allow adding a kms configuration and changing its credentials", func() {
+						kms := shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig.KMS
+						shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = nil
+
+						newShoot := prepareShootForUpdate(shoot)
+						newShoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = &core.EncryptionConfig{KMS: kms}
+						Expect(ValidateShootUpdate(newShoot, shoot)).To(BeEmpty())
+
+						newerShoot := prepareShootForUpdate(newShoot)
+						newerShoot.Spec.Resources = append(newerShoot.Spec.Resources, core.NamedResourceReference{
+							Name:        "other-kms-credentials",
+							ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "other"},
+						})
+						newerShoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig.KMS.CredentialsResourceName = ptr.To("other-kms-credentials")
+						Expect(ValidateShootUpdate(newerShoot, newShoot)).To(BeEmpty())
+					})
+				})
 			})
 
 			Context("WatchCacheSizes validation", func() {
@@ -3683,6 +3809,52 @@ var _ = Describe("Shoot Validation Tests", func() {
 				Expect(errorList).To(BeEmpty())
 			})
 
+			It("should allow time windows restricted to weekdays", func() {
+				shoot.Spec.Maintenance.TimeWindow.Weekdays = []string{"Tuesday", "Thursday"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid unknown or duplicate weekdays", func() {
+				shoot.Spec.Maintenance.TimeWindow.Weekdays = []string{"Tuesday", "Tue", "Tuesday"}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("spec.maintenance.timeWindow.weekdays[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("spec.maintenance.timeWindow.weekdays[2]"),
+					})),
+				))
+			})
+
+			It("should allow valid freeze periods", func() {
+				now := metav1.Now()
+				shoot.Spec.Maintenance.FreezePeriods = []core.MaintenanceFreezePeriod{{Begin: now, End: metav1.NewTime(now.AddDate(0, 0, 14)), Reason: ptr.To("year-end change freeze")}}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid freeze periods which end before they begin", func() {
+				now := metav1.Now()
+				shoot.Spec.Maintenance.FreezePeriods = []core.MaintenanceFreezePeriod{{Begin: now, End: metav1.NewTime(now.Add(-time.Hour))}}
+
+				errorList := ValidateShoot(shoot)
+
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.maintenance.freezePeriods[0].end"),
+				}))))
+			})
+
 			It("should not allow setting machineImageVersion for autoUpdate if it's a workerless Shoot", func() {
 				shoot.Spec.Provider.Workers = nil
 				shoot.Spec.Maintenance.AutoUpdate.MachineImageVersion = ptr.To(true)
@@ -5750,6 +5922,43 @@ var _ = Describe("Shoot Validation Tests", func() {
 			})))),
 		)
 
+		DescribeTable("validate update strategy",
+			func(updateStrategy *core.MachineUpdateStrategy, matcher gomegatypes.GomegaMatcher) {
+				DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.InPlaceNodeUpdates, true))
+
+				worker := core.Worker{
+					Name:           "worker",
+					Machine:        core.Machine{Type: "large"},
+					UpdateStrategy: updateStrategy,
+				}
+
+				Expect(ValidateWorker(worker, core.Kubernetes{Version: "1.27.3"}, field.NewPath("worker"), false)).To(matcher)
+			},
+
+			Entry("no update strategy", nil, BeEmpty()),
+			Entry("rolling update strategy", ptr.To(core.AutoRollingUpdate), BeEmpty()),
+			Entry("in-place update strategy", ptr.To(core.AutoInPlaceUpdate), BeEmpty()),
+			Entry("unknown update strategy", ptr.To(core.MachineUpdateStrategy("foo")), ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("worker.updateStrategy"),
+			})))),
+		)
+
+		It("should forbid the in-place update strategy if the InPlaceNodeUpdates feature gate is disabled", func() {
+			DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.InPlaceNodeUpdates, false))
+
+			worker := core.Worker{
+				Name:           "worker",
+				Machine:        core.Machine{Type: "large"},
+				UpdateStrategy: ptr.To(core.AutoInPlaceUpdate),
+			}
+
+			Expect(ValidateWorker(worker, core.Kubernetes{Version: "1.27.3"}, field.NewPath("worker"), false)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("worker.updateStrategy"),
+			}))))
+		})
+
 		DescribeTable("validate architecture",
 			func(arch *string, matcher gomegatypes.GomegaMatcher) {
 				errList := ValidateArchitecture(arch, field.NewPath("architecture"))
@@ -6540,6 +6749,25 @@ var _ = Describe("Shoot Validation Tests", func() {
 		)
 	})
 
+	Describe("#ValidateHibernationIdlePolicy", func() {
+		DescribeTable("validate hibernation idle policy",
+			func(idlePolicy *core.HibernationIdlePolicy, matcher gomegatypes.GomegaMatcher) {
+				Expect(ValidateHibernationIdlePolicy(idlePolicy, field.NewPath("idlePolicy"))).To(matcher)
+			},
+			Entry("nil idle policy", nil, BeEmpty()),
+			Entry("valid idle duration", &core.HibernationIdlePolicy{IdleDuration: metav1.Duration{Duration: 8 * time.Hour}}, BeEmpty()),
+			Entry("minimum idle duration", &core.HibernationIdlePolicy{IdleDuration: metav1.Duration{Duration: 30 * time.Minute}}, BeEmpty()),
+			Entry("too short idle duration", &core.HibernationIdlePolicy{IdleDuration: metav1.Duration{Duration: 10 * time.Minute}}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("idlePolicy.idleDuration"),
+			})))),
+			Entry("negative idle duration", &core.HibernationIdlePolicy{IdleDuration: metav1.Duration{Duration: -time.Hour}}, ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("idlePolicy.idleDuration"),
+			})))),
+		)
+	})
+
 	Describe("#ValidateHibernationCronSpec", func() {
 		DescribeTable("validate cron spec",
 			func(seenSpecs sets.Set[string], spec string, matcher gomegatypes.GomegaMatcher) {
@@ -124,6 +124,41 @@ type Unit struct {
 	// FilePaths is a list of files the unit depends on. If any file changes a restart of the dependent unit will be
 	// triggered. For each FilePath there must exist a File with matching Path in OperatingSystemConfig.Spec.Files.
 	FilePaths []string `json:"filePaths,omitempty"`
+	// HealthCheck describes how the health of the unit is checked on the node. If the check fails for FailureThreshold
+	// consecutive times, the unit is restarted.
+	// +optional
+	HealthCheck *UnitHealthCheck `json:"healthCheck,omitempty"`
+}
+
+// UnitHealthCheck describes how the health of a unit is checked. At most one of HTTPGet and Exec may be set. If none
+// of them is set, the unit is considered healthy if it is active (same as 'systemctl is-active').
+type UnitHealthCheck struct {
+	// HTTPGet describes an HTTP GET request to perform. The unit is considered healthy if the response has a status
+	// code in the range [200, 400).
+	// +optional
+	HTTPGet *UnitHealthCheckHTTPGet `json:"httpGet,omitempty"`
+	// Exec describes a command to execute. The unit is considered healthy if the command exits with code 0.
+	// +optional
+	Exec *UnitHealthCheckExec `json:"exec,omitempty"`
+	// TimeoutSeconds is the number of seconds after which the check times out. Defaults to 10.
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+	// FailureThreshold is the number of consecutive failed checks after which the unit is restarted. Defaults to 3.
+	// +optional
+	FailureThreshold *int32 `json:"failureThreshold,omitempty"`
+}
+
+// UnitHealthCheckHTTPGet describes an HTTP GET request of a unit health check.
+type UnitHealthCheckHTTPGet struct {
+	// URL is the URL to request. Its scheme must be 'http' or 'https'.
+	URL string `json:"url"`
+}
+
+// UnitHealthCheckExec describes a command of a unit health check.
+type UnitHealthCheckExec struct {
+	// Command is the command line to execute. It is not run in a shell, i.e., the first element is the path of the
+	// executable and the remaining elements are its arguments.
+	Command []string `json:"command"`
 }
 
 // UnitCommand is a string alias.
@@ -157,8 +192,23 @@ type File struct {
 	Permissions *int32 `json:"permissions,omitempty"`
 	// Content describe the file's content.
 	Content FileContent `json:"content"`
+	// DriftPolicy describes how drift of the file on the node (i.e., manual changes of its content or permissions) is
+	// handled. Possible values are 'Report' (drift is only reported) and 'Restore' (the file is restored to its desired
+	// state). Defaults to 'Report'.
+	// +optional
+	DriftPolicy *FileDriftPolicy `json:"driftPolicy,omitempty"`
 }
 
+// FileDriftPolicy is a string alias.
+type FileDriftPolicy string
+
+const (
+	// FileDriftPolicyReport is a constant for a drift policy which only reports drift of a file.
+	FileDriftPolicyReport FileDriftPolicy = "Report"
+	// FileDriftPolicyRestore is a constant for a drift policy which restores a drifted file to its desired state.
+	FileDriftPolicyRestore FileDriftPolicy = "Restore"
+)
+
 // FileContent can either reference a secret or contain inline configuration.
 type FileContent struct {
 	// SecretRef is a struct that contains information about the referenced secret.
@@ -248,6 +248,22 @@ type WorkerStatus struct {
 	// MachineDeploymentsLastUpdateTime is the timestamp when the status.MachineDeployments slice was last updated.
 	// +optional
 	MachineDeploymentsLastUpdateTime *metav1.Time `json:"machineDeploymentsLastUpdateTime,omitempty"`
+	// InPlaceUpdates contains the progress of the in-place updates of worker pools using the in-place update strategy.
+	// +optional
+	InPlaceUpdates []WorkerPoolInPlaceUpdate `json:"inPlaceUpdates,omitempty"`
+}
+
+// WorkerPoolInPlaceUpdate contains the progress of the in-place update of a worker pool.
+type WorkerPoolInPlaceUpdate struct {
+	// Name is the name of the worker pool.
+	Name string `json:"name"`
+	// KubernetesVersion is the Kubernetes version the nodes of the worker pool are updated to.
+	KubernetesVersion string `json:"kubernetesVersion"`
+	// Nodes is the number of nodes of the worker pool.
+	Nodes int32 `json:"nodes"`
+	// UpdatedNodes is the number of nodes of the worker pool which have already applied the desired operating system
+	// config.
+	UpdatedNodes int32 `json:"updatedNodes"`
 }
 
 // MachineDeployment is a created machine deployment.
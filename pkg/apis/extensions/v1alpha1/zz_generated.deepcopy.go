@@ -1055,6 +1055,11 @@ func (in *File) DeepCopyInto(out *File) {
 		**out = **in
 	}
 	in.Content.DeepCopyInto(&out.Content)
+	if in.DriftPolicy != nil {
+		in, out := &in.DriftPolicy, &out.DriftPolicy
+		*out = new(FileDriftPolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -1599,6 +1604,11 @@ func (in *Unit) DeepCopyInto(out *Unit) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(UnitHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1612,6 +1622,79 @@ func (in *Unit) DeepCopy() *Unit {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnitHealthCheck) DeepCopyInto(out *UnitHealthCheck) {
+	*out = *in
+	if in.HTTPGet != nil {
+		in, out := &in.HTTPGet, &out.HTTPGet
+		*out = new(UnitHealthCheckHTTPGet)
+		**out = **in
+	}
+	if in.Exec != nil {
+		in, out := &in.Exec, &out.Exec
+		*out = new(UnitHealthCheckExec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailureThreshold != nil {
+		in, out := &in.FailureThreshold, &out.FailureThreshold
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnitHealthCheck.
+func (in *UnitHealthCheck) DeepCopy() *UnitHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(UnitHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnitHealthCheckExec) DeepCopyInto(out *UnitHealthCheckExec) {
+	*out = *in
+	if in.Command != nil {
+		in, out := &in.Command, &out.Command
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnitHealthCheckExec.
+func (in *UnitHealthCheckExec) DeepCopy() *UnitHealthCheckExec {
+	if in == nil {
+		return nil
+	}
+	out := new(UnitHealthCheckExec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UnitHealthCheckHTTPGet) DeepCopyInto(out *UnitHealthCheckHTTPGet) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UnitHealthCheckHTTPGet.
+func (in *UnitHealthCheckHTTPGet) DeepCopy() *UnitHealthCheckHTTPGet {
+	if in == nil {
+		return nil
+	}
+	out := new(UnitHealthCheckHTTPGet)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Volume) DeepCopyInto(out *Volume) {
 	*out = *in
@@ -1801,6 +1884,22 @@ func (in *WorkerPool) DeepCopy() *WorkerPool {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkerPoolInPlaceUpdate) DeepCopyInto(out *WorkerPoolInPlaceUpdate) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkerPoolInPlaceUpdate.
+func (in *WorkerPoolInPlaceUpdate) DeepCopy() *WorkerPoolInPlaceUpdate {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkerPoolInPlaceUpdate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerSpec) DeepCopyInto(out *WorkerSpec) {
 	*out = *in
@@ -1849,6 +1948,11 @@ func (in *WorkerStatus) DeepCopyInto(out *WorkerStatus) {
 		in, out := &in.MachineDeploymentsLastUpdateTime, &out.MachineDeploymentsLastUpdateTime
 		*out = (*in).DeepCopy()
 	}
+	if in.InPlaceUpdates != nil {
+		in, out := &in.InPlaceUpdates, &out.InPlaceUpdates
+		*out = make([]WorkerPoolInPlaceUpdate, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
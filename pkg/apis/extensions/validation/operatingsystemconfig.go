@@ -15,6 +15,8 @@
 package validation
 
 import (
+	"fmt"
+	"net/url"
 	"slices"
 	"strings"
 
@@ -110,6 +112,40 @@ func ValidateUnits(units []extensionsv1alpha1.Unit, pathsFromFiles sets.Set[stri
 		}
 
 		allErrs = append(allErrs, validateFilePaths(unit.FilePaths, pathsFromFiles, idxPath.Child("filePaths"))...)
+
+		if unit.HealthCheck != nil {
+			allErrs = append(allErrs, validateUnitHealthCheck(unit.HealthCheck, idxPath.Child("healthCheck"))...)
+		}
+	}
+
+	return allErrs
+}
+
+func validateUnitHealthCheck(healthCheck *extensionsv1alpha1.UnitHealthCheck, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if healthCheck.HTTPGet != nil && healthCheck.Exec != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, "at most one of 'httpGet' and 'exec' may be set"))
+	}
+
+	if healthCheck.HTTPGet != nil {
+		if u, err := url.Parse(healthCheck.HTTPGet.URL); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("httpGet", "url"), healthCheck.HTTPGet.URL, fmt.Sprintf("invalid URL: %v", err)))
+		} else if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("httpGet", "url"), healthCheck.HTTPGet.URL, "must be an absolute URL with scheme 'http' or 'https'"))
+		}
+	}
+
+	if healthCheck.Exec != nil && (len(healthCheck.Exec.Command) == 0 || len(healthCheck.Exec.Command[0]) == 0) {
+		allErrs = append(allErrs, field.Required(fldPath.Child("exec", "command"), "command must not be empty"))
+	}
+
+	if healthCheck.TimeoutSeconds != nil && *healthCheck.TimeoutSeconds <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeoutSeconds"), *healthCheck.TimeoutSeconds, "must be greater than 0"))
+	}
+
+	if healthCheck.FailureThreshold != nil && *healthCheck.FailureThreshold <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("failureThreshold"), *healthCheck.FailureThreshold, "must be greater than 0"))
 	}
 
 	return allErrs
@@ -192,6 +228,13 @@ func ValidateFiles(files []extensionsv1alpha1.File, fldPath *field.Path) field.E
 				allErrs = append(allErrs, field.Required(idxPath.Child("content", "imageRef", "filePathInImage"), "field is required"))
 			}
 		}
+
+		if file.DriftPolicy != nil {
+			driftPolicies := []string{string(extensionsv1alpha1.FileDriftPolicyReport), string(extensionsv1alpha1.FileDriftPolicyRestore)}
+			if !slices.Contains(driftPolicies, string(*file.DriftPolicy)) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("driftPolicy"), *file.DriftPolicy, driftPolicies))
+			}
+		}
 	}
 
 	return allErrs
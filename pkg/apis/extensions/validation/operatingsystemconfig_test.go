@@ -21,6 +21,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	. "github.com/gardener/gardener/pkg/apis/extensions/validation"
@@ -266,6 +267,67 @@ var _ = Describe("OperatingSystemConfig validation tests", func() {
 			))
 		})
 
+		It("should forbid OperatingSystemConfigs with unsupported file drift policies", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.Files[0].DriftPolicy = ptr.To(extensionsv1alpha1.FileDriftPolicy("foo"))
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+				"Type":  Equal(field.ErrorTypeNotSupported),
+				"Field": Equal("spec.files[0].driftPolicy"),
+			}))))
+		})
+
+		It("should allow OperatingSystemConfigs with supported file drift policies", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.Files[0].DriftPolicy = ptr.To(extensionsv1alpha1.FileDriftPolicyRestore)
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(BeEmpty())
+		})
+
+		It("should forbid OperatingSystemConfigs with invalid unit health checks", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.Units[0].HealthCheck = &extensionsv1alpha1.UnitHealthCheck{
+				HTTPGet:          &extensionsv1alpha1.UnitHealthCheckHTTPGet{URL: "ftp://localhost"},
+				Exec:             &extensionsv1alpha1.UnitHealthCheckExec{},
+				TimeoutSeconds:   ptr.To[int32](0),
+				FailureThreshold: ptr.To[int32](-1),
+			}
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("spec.units[0].healthCheck"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.units[0].healthCheck.httpGet.url"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("spec.units[0].healthCheck.exec.command"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.units[0].healthCheck.timeoutSeconds"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.units[0].healthCheck.failureThreshold"),
+				})),
+			))
+		})
+
+		It("should allow OperatingSystemConfigs with valid unit health checks", func() {
+			oscCopy := osc.DeepCopy()
+			oscCopy.Spec.Units[0].HealthCheck = &extensionsv1alpha1.UnitHealthCheck{FailureThreshold: ptr.To[int32](5)}
+			oscCopy.Status.ExtensionUnits = []extensionsv1alpha1.Unit{
+				{Name: "bar", HealthCheck: &extensionsv1alpha1.UnitHealthCheck{HTTPGet: &extensionsv1alpha1.UnitHealthCheckHTTPGet{URL: "http://127.0.0.1:8080/healthz"}}},
+				{Name: "baz", HealthCheck: &extensionsv1alpha1.UnitHealthCheck{Exec: &extensionsv1alpha1.UnitHealthCheckExec{Command: []string{"/opt/bin/baz", "health"}}, TimeoutSeconds: ptr.To[int32](5)}},
+			}
+
+			Expect(ValidateOperatingSystemConfig(oscCopy)).To(BeEmpty())
+		})
+
 		It("should forbid OperatingSystemConfigs with duplicate files", func() {
 			oscCopy := osc.DeepCopy()
 			oscCopy.Spec.Units = nil
@@ -0,0 +1,117 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"github.com/andybalholm/brotli"
+	corev1 "k8s.io/api/core/v1"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+// MaxDecompressedSize is the maximum size (in bytes) of the data of a Secret after decompression. It protects readers of
+// compressed data against decompression bombs.
+const MaxDecompressedSize = 32 * 1024 * 1024
+
+// Compress compresses the given data with the given algorithm.
+func Compress(algorithm string, data []byte) ([]byte, error) {
+	var (
+		buf = &bytes.Buffer{}
+		w   io.WriteCloser
+	)
+
+	switch algorithm {
+	case resourcesv1alpha1.CompressionAlgorithmGzip:
+		w = gzip.NewWriter(buf)
+	case resourcesv1alpha1.CompressionAlgorithmBrotli:
+		w = brotli.NewWriter(buf)
+	default:
+		return nil, fmt.Errorf("unsupported compression algorithm %q", algorithm)
+	}
+
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Decompress decompresses the given data with the given algorithm. It fails if the decompressed data exceeds
+// MaxDecompressedSize.
+func Decompress(algorithm string, data []byte) ([]byte, error) {
+	return decompress(algorithm, data, MaxDecompressedSize)
+}
+
+func decompress(algorithm string, data []byte, maxSize int) ([]byte, error) {
+	var r io.Reader
+
+	switch algorithm {
+	case resourcesv1alpha1.CompressionAlgorithmGzip:
+		gzipReader, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer gzipReader.Close()
+		r = gzipReader
+	case resourcesv1alpha1.CompressionAlgorithmBrotli:
+		r = brotli.NewReader(bytes.NewReader(data))
+	default:
+		return nil, fmt.Errorf("unsupported compression algorithm %q", algorithm)
+	}
+
+	decompressed, err := io.ReadAll(io.LimitReader(r, int64(maxSize)+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(decompressed) > maxSize {
+		return nil, fmt.Errorf("decompressed data exceeds the maximum size of %d bytes", MaxDecompressedSize)
+	}
+
+	return decompressed, nil
+}
+
+// SecretData returns the data of the given Secret referenced by a ManagedResource. If the Secret is annotated with
+// resourcesv1alpha1.CompressionAlgorithm, all values are decompressed with the respective algorithm. The decompressed
+// values must not exceed MaxDecompressedSize in total.
+func SecretData(secret *corev1.Secret) (map[string][]byte, error) {
+	algorithm, ok := secret.Annotations[resourcesv1alpha1.CompressionAlgorithm]
+	if !ok {
+		return secret.Data, nil
+	}
+
+	var (
+		data      = make(map[string][]byte, len(secret.Data))
+		remaining = MaxDecompressedSize
+	)
+
+	for key, value := range secret.Data {
+		decompressed, err := decompress(algorithm, value, remaining)
+		if err != nil {
+			return nil, fmt.Errorf("failed decompressing value of key %q: %w", key, err)
+		}
+		data[key] = decompressed
+		remaining -= len(decompressed)
+	}
+
+	return data, nil
+}
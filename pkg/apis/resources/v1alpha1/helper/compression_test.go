@@ -0,0 +1,98 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	. "github.com/gardener/gardener/pkg/apis/resources/v1alpha1/helper"
+)
+
+var _ = Describe("Compression", func() {
+	data := []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: foo\n  namespace: bar\n")
+
+	DescribeTable("#Compress and #Decompress",
+		func(algorithm string) {
+			compressed, err := Compress(algorithm, data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(compressed).NotTo(Equal(data))
+
+			Expect(Decompress(algorithm, compressed)).To(Equal(data))
+		},
+
+		Entry("gzip", resourcesv1alpha1.CompressionAlgorithmGzip),
+		Entry("brotli", resourcesv1alpha1.CompressionAlgorithmBrotli),
+	)
+
+	It("should fail for unsupported algorithms", func() {
+		_, err := Compress("foo", data)
+		Expect(err).To(MatchError(`unsupported compression algorithm "foo"`))
+
+		_, err = Decompress("foo", data)
+		Expect(err).To(MatchError(`unsupported compression algorithm "foo"`))
+	})
+
+	It("should fail if the decompressed data exceeds the maximum size", func() {
+		compressed, err := Compress(resourcesv1alpha1.CompressionAlgorithmGzip, make([]byte, MaxDecompressedSize+1))
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = Decompress(resourcesv1alpha1.CompressionAlgorithmGzip, compressed)
+		Expect(err).To(MatchError(ContainSubstring("decompressed data exceeds the maximum size")))
+	})
+
+	Describe("#SecretData", func() {
+		var secret *corev1.Secret
+
+		BeforeEach(func() {
+			secret = &corev1.Secret{Data: map[string][]byte{"foo.yaml": data}}
+		})
+
+		It("should return the data as is if the secret is not annotated", func() {
+			Expect(SecretData(secret)).To(Equal(secret.Data))
+		})
+
+		It("should return the decompressed data if the secret is annotated", func() {
+			compressed, err := Compress(resourcesv1alpha1.CompressionAlgorithmBrotli, data)
+			Expect(err).NotTo(HaveOccurred())
+
+			secret.Annotations = map[string]string{resourcesv1alpha1.CompressionAlgorithm: resourcesv1alpha1.CompressionAlgorithmBrotli}
+			secret.Data["foo.yaml"] = compressed
+
+			Expect(SecretData(secret)).To(Equal(map[string][]byte{"foo.yaml": data}))
+		})
+
+		It("should fail if the decompressed values exceed the maximum size in total", func() {
+			compressed, err := Compress(resourcesv1alpha1.CompressionAlgorithmGzip, make([]byte, MaxDecompressedSize/2+1))
+			Expect(err).NotTo(HaveOccurred())
+
+			secret.Annotations = map[string]string{resourcesv1alpha1.CompressionAlgorithm: resourcesv1alpha1.CompressionAlgorithmGzip}
+			secret.Data = map[string][]byte{"foo.yaml": compressed, "bar.yaml": compressed}
+
+			_, err = SecretData(secret)
+			Expect(err).To(MatchError(ContainSubstring("decompressed data exceeds the maximum size")))
+		})
+
+		It("should fail if the data cannot be decompressed", func() {
+			secret.ObjectMeta = metav1.ObjectMeta{Annotations: map[string]string{resourcesv1alpha1.CompressionAlgorithm: resourcesv1alpha1.CompressionAlgorithmGzip}}
+
+			_, err := SecretData(secret)
+			Expect(err).To(MatchError(ContainSubstring(`failed decompressing value of key "foo.yaml"`)))
+		})
+	})
+})
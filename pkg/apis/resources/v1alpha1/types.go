@@ -55,6 +55,25 @@ const (
 	// FinalizeDeletionAfter is an annotation on an object part of a ManagedResource that whose value states the
 	// duration after which a deletion should be finalized (i.e., removal of `.metadata.finalizers[]`).
 	FinalizeDeletionAfter = "resources.gardener.cloud/finalize-deletion-after"
+	// HealthRule is a constant for an annotation on a resource managed by a ManagedResource. Its value is a CEL
+	// expression which is evaluated against the object (accessible via `self`) by the health controller. The object is
+	// considered healthy if the expression evaluates to true.
+	HealthRule = "resources.gardener.cloud/health-rule"
+	// ProgressingRule is a constant for an annotation on a resource managed by a ManagedResource. Its value is a CEL
+	// expression which is evaluated against the object (accessible via `self`) by the progressing controller. The object
+	// is considered progressing if the expression evaluates to true.
+	ProgressingRule = "resources.gardener.cloud/progressing-rule"
+	// FieldManagerServerSideApply is the name of the field manager used by the ManagedResource controller when applying
+	// resources via server-side apply.
+	FieldManagerServerSideApply = "gardener-resource-manager-ssa"
+	// CompressionAlgorithm is a constant for an annotation on a Secret referenced by a ManagedResource. It states the
+	// algorithm which was used to compress all values in the data of the Secret. If it is not set, the values are not
+	// compressed.
+	CompressionAlgorithm = "resources.gardener.cloud/compression-algorithm"
+	// CompressionAlgorithmGzip is a constant for the 'gzip' compression algorithm.
+	CompressionAlgorithmGzip = "gzip"
+	// CompressionAlgorithmBrotli is a constant for the 'brotli' compression algorithm.
+	CompressionAlgorithmBrotli = "brotli"
 
 	// ManagedBy is a constant for a label on an object managed by a ManagedResource.
 	// It is set by the ManagedResource controller depending on its configuration. By default it is set to "gardener".
@@ -262,6 +281,19 @@ type ManagedResourceSpec struct {
 	// resource, should also be deleted when the corresponding StatefulSet is deleted (defaults to false).
 	// +optional
 	DeletePersistentVolumeClaims *bool `json:"deletePersistentVolumeClaims,omitempty"`
+	// ServerSideApply configures that the resources are applied via server-side apply (with a dedicated field manager)
+	// instead of being merged with the existing objects. If not set, the resources are merged.
+	// +optional
+	ServerSideApply *ServerSideApply `json:"serverSideApply,omitempty"`
+}
+
+// ServerSideApply contains the configuration for applying the resources via server-side apply.
+type ServerSideApply struct {
+	// ForceConflicts specifies whether the ownership of conflicting fields is taken over from other field managers.
+	// If false, conflicts are reported in the ResourcesApplied condition and the affected resources are not updated.
+	// Defaults to false.
+	// +optional
+	ForceConflicts *bool `json:"forceConflicts,omitempty"`
 }
 
 // ManagedResourceStatus is the status of a managed resource.
@@ -303,6 +335,9 @@ const (
 	// ConditionApplyFailed indicates that the `ResourcesApplied` condition is `False`,
 	// because applying the resources failed.
 	ConditionApplyFailed = "ApplyFailed"
+	// ConditionApplyConflict indicates that the `ResourcesApplied` condition is `False`,
+	// because applying the resources via server-side apply resulted in conflicts with other field managers.
+	ConditionApplyConflict = "ApplyConflict"
 	// ConditionDecodingFailed indicates that the `ResourcesApplied` condition is `False`,
 	// because decoding the resources of the ManagedResource failed.
 	ConditionDecodingFailed = "DecodingFailed"
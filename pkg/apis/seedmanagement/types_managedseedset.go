@@ -65,6 +65,24 @@ type ManagedSeedSetSpec struct {
 	// RevisionHistoryLimit is the maximum number of revisions that will be maintained
 	// in the ManagedSeedSet's revision history. Defaults to 10. This field is immutable.
 	RevisionHistoryLimit *int32
+	// Autoscaling specifies the policy for scaling the ManagedSeedSet based on the utilization of its seeds. If set,
+	// Replicas is managed by the ManagedSeedSet controller.
+	Autoscaling *Autoscaling
+}
+
+// Autoscaling specifies the policy for scaling a ManagedSeedSet based on the utilization of its seeds, i.e., the
+// ratio of the shoots scheduled onto the seeds to their allocatable shoots.
+type Autoscaling struct {
+	// MinReplicas is the lower limit for the number of replicas. Defaults to 1.
+	MinReplicas *int32
+	// MaxReplicas is the upper limit for the number of replicas. It must not be less than MinReplicas.
+	MaxReplicas int32
+	// TargetUtilizationPercentage is the target utilization of the seeds of the set, in percent. A replica is added
+	// when the utilization reaches this value. Defaults to 80.
+	TargetUtilizationPercentage *int32
+	// ScaleDownCooldown is the minimum duration since the last scaling of the set before an empty replica is removed.
+	// Defaults to 30m.
+	ScaleDownCooldown *metav1.Duration
 }
 
 // UpdateStrategy specifies the strategy that the ManagedSeedSet
@@ -126,6 +144,8 @@ type ManagedSeedSetStatus struct {
 	// PendingReplica, if not empty, indicates the replica that is currently pending creation, update, or deletion.
 	// This replica is in a state that requires the controller to wait for it to change before advancing to the next replica.
 	PendingReplica *PendingReplica
+	// LastScaleTime is the last time the ManagedSeedSet controller changed the number of replicas due to autoscaling.
+	LastScaleTime *metav1.Time
 }
 
 // PendingReplicaReason is a string enumeration type that enumerates all possible reasons for a replica to be pending.
@@ -15,6 +15,9 @@
 package v1alpha1
 
 import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 )
 
@@ -52,3 +55,21 @@ func SetDefaults_RollingUpdateStrategy(obj *RollingUpdateStrategy) {
 		obj.Partition = ptr.To(int32(0))
 	}
 }
+
+// SetDefaults_Autoscaling sets default values for Autoscaling objects.
+func SetDefaults_Autoscaling(obj *Autoscaling) {
+	// Set default min replicas
+	if obj.MinReplicas == nil {
+		obj.MinReplicas = ptr.To(int32(1))
+	}
+
+	// Set default target utilization
+	if obj.TargetUtilizationPercentage == nil {
+		obj.TargetUtilizationPercentage = ptr.To(int32(80))
+	}
+
+	// Set default scale down cooldown
+	if obj.ScaleDownCooldown == nil {
+		obj.ScaleDownCooldown = &metav1.Duration{Duration: 30 * time.Minute}
+	}
+}
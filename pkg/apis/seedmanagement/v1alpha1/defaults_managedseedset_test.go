@@ -15,8 +15,11 @@
 package v1alpha1_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/ptr"
 
 	. "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
@@ -98,4 +101,35 @@ var _ = Describe("Defaults", func() {
 			}))
 		})
 	})
+
+	Describe("Autoscaling defaulting", func() {
+		It("should default minReplicas, targetUtilizationPercentage and scaleDownCooldown", func() {
+			obj.Spec.Autoscaling = &Autoscaling{MaxReplicas: 3}
+			SetObjectDefaults_ManagedSeedSet(obj)
+
+			Expect(obj.Spec.Autoscaling).To(Equal(&Autoscaling{
+				MinReplicas:                 ptr.To(int32(1)),
+				MaxReplicas:                 3,
+				TargetUtilizationPercentage: ptr.To(int32(80)),
+				ScaleDownCooldown:           &metav1.Duration{Duration: 30 * time.Minute},
+			}))
+		})
+
+		It("should not overwrite the already set values for Autoscaling", func() {
+			obj.Spec.Autoscaling = &Autoscaling{
+				MinReplicas:                 ptr.To(int32(2)),
+				MaxReplicas:                 4,
+				TargetUtilizationPercentage: ptr.To(int32(50)),
+				ScaleDownCooldown:           &metav1.Duration{Duration: time.Hour},
+			}
+			SetObjectDefaults_ManagedSeedSet(obj)
+
+			Expect(obj.Spec.Autoscaling).To(Equal(&Autoscaling{
+				MinReplicas:                 ptr.To(int32(2)),
+				MaxReplicas:                 4,
+				TargetUtilizationPercentage: ptr.To(int32(50)),
+				ScaleDownCooldown:           &metav1.Duration{Duration: time.Hour},
+			}))
+		})
+	})
 })
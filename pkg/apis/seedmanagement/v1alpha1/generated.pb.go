@@ -16,7 +16,8 @@ import (
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
 	k8s_io_api_core_v1 "k8s.io/api/core/v1"
-	v1 "k8s.io/api/core/v1"
+	v11 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	math "math"
 	math_bits "math/bits"
@@ -35,10 +36,38 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+func (m *Autoscaling) Reset()      { *m = Autoscaling{} }
+func (*Autoscaling) ProtoMessage() {}
+func (*Autoscaling) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d64c05a219673fe5, []int{0}
+}
+func (m *Autoscaling) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Autoscaling) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Autoscaling) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Autoscaling.Merge(m, src)
+}
+func (m *Autoscaling) XXX_Size() int {
+	return m.Size()
+}
+func (m *Autoscaling) XXX_DiscardUnknown() {
+	xxx_messageInfo_Autoscaling.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Autoscaling proto.InternalMessageInfo
+
 func (m *Gardenlet) Reset()      { *m = Gardenlet{} }
 func (*Gardenlet) ProtoMessage() {}
 func (*Gardenlet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{0}
+	return fileDescriptor_d64c05a219673fe5, []int{1}
 }
 func (m *Gardenlet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -66,7 +95,7 @@ var xxx_messageInfo_Gardenlet proto.InternalMessageInfo
 func (m *GardenletDeployment) Reset()      { *m = GardenletDeployment{} }
 func (*GardenletDeployment) ProtoMessage() {}
 func (*GardenletDeployment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{1}
+	return fileDescriptor_d64c05a219673fe5, []int{2}
 }
 func (m *GardenletDeployment) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -94,7 +123,7 @@ var xxx_messageInfo_GardenletDeployment proto.InternalMessageInfo
 func (m *Image) Reset()      { *m = Image{} }
 func (*Image) ProtoMessage() {}
 func (*Image) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{2}
+	return fileDescriptor_d64c05a219673fe5, []int{3}
 }
 func (m *Image) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -122,7 +151,7 @@ var xxx_messageInfo_Image proto.InternalMessageInfo
 func (m *ManagedSeed) Reset()      { *m = ManagedSeed{} }
 func (*ManagedSeed) ProtoMessage() {}
 func (*ManagedSeed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{3}
+	return fileDescriptor_d64c05a219673fe5, []int{4}
 }
 func (m *ManagedSeed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -150,7 +179,7 @@ var xxx_messageInfo_ManagedSeed proto.InternalMessageInfo
 func (m *ManagedSeedList) Reset()      { *m = ManagedSeedList{} }
 func (*ManagedSeedList) ProtoMessage() {}
 func (*ManagedSeedList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{4}
+	return fileDescriptor_d64c05a219673fe5, []int{5}
 }
 func (m *ManagedSeedList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -178,7 +207,7 @@ var xxx_messageInfo_ManagedSeedList proto.InternalMessageInfo
 func (m *ManagedSeedSet) Reset()      { *m = ManagedSeedSet{} }
 func (*ManagedSeedSet) ProtoMessage() {}
 func (*ManagedSeedSet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{5}
+	return fileDescriptor_d64c05a219673fe5, []int{6}
 }
 func (m *ManagedSeedSet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -206,7 +235,7 @@ var xxx_messageInfo_ManagedSeedSet proto.InternalMessageInfo
 func (m *ManagedSeedSetList) Reset()      { *m = ManagedSeedSetList{} }
 func (*ManagedSeedSetList) ProtoMessage() {}
 func (*ManagedSeedSetList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{6}
+	return fileDescriptor_d64c05a219673fe5, []int{7}
 }
 func (m *ManagedSeedSetList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -234,7 +263,7 @@ var xxx_messageInfo_ManagedSeedSetList proto.InternalMessageInfo
 func (m *ManagedSeedSetSpec) Reset()      { *m = ManagedSeedSetSpec{} }
 func (*ManagedSeedSetSpec) ProtoMessage() {}
 func (*ManagedSeedSetSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{7}
+	return fileDescriptor_d64c05a219673fe5, []int{8}
 }
 func (m *ManagedSeedSetSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -262,7 +291,7 @@ var xxx_messageInfo_ManagedSeedSetSpec proto.InternalMessageInfo
 func (m *ManagedSeedSetStatus) Reset()      { *m = ManagedSeedSetStatus{} }
 func (*ManagedSeedSetStatus) ProtoMessage() {}
 func (*ManagedSeedSetStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{8}
+	return fileDescriptor_d64c05a219673fe5, []int{9}
 }
 func (m *ManagedSeedSetStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -290,7 +319,7 @@ var xxx_messageInfo_ManagedSeedSetStatus proto.InternalMessageInfo
 func (m *ManagedSeedSpec) Reset()      { *m = ManagedSeedSpec{} }
 func (*ManagedSeedSpec) ProtoMessage() {}
 func (*ManagedSeedSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{9}
+	return fileDescriptor_d64c05a219673fe5, []int{10}
 }
 func (m *ManagedSeedSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -318,7 +347,7 @@ var xxx_messageInfo_ManagedSeedSpec proto.InternalMessageInfo
 func (m *ManagedSeedStatus) Reset()      { *m = ManagedSeedStatus{} }
 func (*ManagedSeedStatus) ProtoMessage() {}
 func (*ManagedSeedStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{10}
+	return fileDescriptor_d64c05a219673fe5, []int{11}
 }
 func (m *ManagedSeedStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,7 +375,7 @@ var xxx_messageInfo_ManagedSeedStatus proto.InternalMessageInfo
 func (m *ManagedSeedTemplate) Reset()      { *m = ManagedSeedTemplate{} }
 func (*ManagedSeedTemplate) ProtoMessage() {}
 func (*ManagedSeedTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{11}
+	return fileDescriptor_d64c05a219673fe5, []int{12}
 }
 func (m *ManagedSeedTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -374,7 +403,7 @@ var xxx_messageInfo_ManagedSeedTemplate proto.InternalMessageInfo
 func (m *PendingReplica) Reset()      { *m = PendingReplica{} }
 func (*PendingReplica) ProtoMessage() {}
 func (*PendingReplica) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{12}
+	return fileDescriptor_d64c05a219673fe5, []int{13}
 }
 func (m *PendingReplica) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -402,7 +431,7 @@ var xxx_messageInfo_PendingReplica proto.InternalMessageInfo
 func (m *RollingUpdateStrategy) Reset()      { *m = RollingUpdateStrategy{} }
 func (*RollingUpdateStrategy) ProtoMessage() {}
 func (*RollingUpdateStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{13}
+	return fileDescriptor_d64c05a219673fe5, []int{14}
 }
 func (m *RollingUpdateStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -430,7 +459,7 @@ var xxx_messageInfo_RollingUpdateStrategy proto.InternalMessageInfo
 func (m *Shoot) Reset()      { *m = Shoot{} }
 func (*Shoot) ProtoMessage() {}
 func (*Shoot) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{14}
+	return fileDescriptor_d64c05a219673fe5, []int{15}
 }
 func (m *Shoot) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -458,7 +487,7 @@ var xxx_messageInfo_Shoot proto.InternalMessageInfo
 func (m *UpdateStrategy) Reset()      { *m = UpdateStrategy{} }
 func (*UpdateStrategy) ProtoMessage() {}
 func (*UpdateStrategy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d64c05a219673fe5, []int{15}
+	return fileDescriptor_d64c05a219673fe5, []int{16}
 }
 func (m *UpdateStrategy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -484,6 +513,7 @@ func (m *UpdateStrategy) XXX_DiscardUnknown() {
 var xxx_messageInfo_UpdateStrategy proto.InternalMessageInfo
 
 func init() {
+	proto.RegisterType((*Autoscaling)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.Autoscaling")
 	proto.RegisterType((*Gardenlet)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.Gardenlet")
 	proto.RegisterType((*GardenletDeployment)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.GardenletDeployment")
 	proto.RegisterMapType((map[string]string)(nil), "github.com.gardener.gardener.pkg.apis.seedmanagement.v1alpha1.GardenletDeployment.PodAnnotationsEntry")
@@ -509,116 +539,174 @@ func init() {
 }
 
 var fileDescriptor_d64c05a219673fe5 = []byte{
-	// 1732 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcd, 0x8f, 0x1b, 0x49,
-	0x15, 0x9f, 0x9e, 0x19, 0xcf, 0xb8, 0x9f, 0x33, 0x9e, 0x4c, 0x65, 0x36, 0x78, 0x47, 0xc2, 0x1e,
-	0x59, 0x02, 0x0d, 0x1f, 0xdb, 0x26, 0xc3, 0x0a, 0x85, 0x85, 0xac, 0xe4, 0x9e, 0x84, 0xec, 0xae,
-	0x32, 0x89, 0x29, 0xcf, 0x04, 0x09, 0x71, 0xa0, 0xdc, 0x5d, 0xf1, 0x34, 0x71, 0x7f, 0x6c, 0x57,
-	0xd9, 0x1b, 0x0b, 0x09, 0xad, 0xb8, 0x71, 0x40, 0x42, 0xfb, 0x2f, 0x20, 0xf1, 0xb7, 0xe4, 0xb8,
-	0x42, 0x1c, 0x56, 0x20, 0x59, 0x89, 0x41, 0x48, 0x70, 0xe1, 0x3e, 0x07, 0x84, 0xaa, 0xba, 0xfa,
-	0xd3, 0x36, 0x19, 0x62, 0x93, 0xc3, 0xde, 0xba, 0xde, 0xc7, 0xef, 0xbd, 0x7a, 0xf5, 0xab, 0xaa,
-	0x57, 0x0d, 0xa7, 0x7d, 0x87, 0x5f, 0x0c, 0x7b, 0x86, 0xe5, 0xbb, 0xad, 0x3e, 0x09, 0x6d, 0xea,
-	0xd1, 0x30, 0xfd, 0x08, 0x9e, 0xf6, 0x5b, 0x24, 0x70, 0x58, 0x8b, 0x51, 0x6a, 0xbb, 0xc4, 0x23,
-	0x7d, 0xea, 0x52, 0x8f, 0xb7, 0x46, 0xb7, 0xc8, 0x20, 0xb8, 0x20, 0xb7, 0x5a, 0x7d, 0x61, 0x46,
-	0x38, 0xb5, 0x8d, 0x20, 0xf4, 0xb9, 0x8f, 0xee, 0xa4, 0x70, 0x46, 0x8c, 0x92, 0x7e, 0x04, 0x4f,
-	0xfb, 0x86, 0x80, 0x33, 0xf2, 0x70, 0x46, 0x0c, 0x77, 0x60, 0x5e, 0x2d, 0x1b, 0xcb, 0x0f, 0x69,
-	0x6b, 0x74, 0xab, 0x47, 0xf9, 0x6c, 0x0a, 0x07, 0xef, 0x64, 0x31, 0xfc, 0xbe, 0xdf, 0x92, 0xe2,
-	0xde, 0xf0, 0x89, 0x1c, 0xc9, 0x81, 0xfc, 0x52, 0xe6, 0xcd, 0xa7, 0xb7, 0x99, 0xe1, 0xf8, 0x02,
-	0x38, 0xc6, 0x9d, 0x81, 0x7c, 0x37, 0xb5, 0x71, 0x89, 0x75, 0xe1, 0x78, 0x34, 0x1c, 0xa7, 0xd9,
-	0xb8, 0x94, 0x93, 0x79, 0x5e, 0xad, 0x45, 0x5e, 0xe1, 0xd0, 0xe3, 0x8e, 0x4b, 0x67, 0x1c, 0xbe,
-	0xf7, 0x2a, 0x07, 0x66, 0x5d, 0x50, 0x97, 0x14, 0xfd, 0x9a, 0x2f, 0xd6, 0x41, 0xbf, 0x2f, 0x8b,
-	0x34, 0xa0, 0x1c, 0xfd, 0x5a, 0x03, 0xb0, 0x69, 0x30, 0xf0, 0xc7, 0xa2, 0xb6, 0x35, 0xed, 0x50,
-	0x3b, 0xaa, 0x1c, 0x63, 0x63, 0xa9, 0x85, 0x31, 0x12, 0xf8, 0xbb, 0x09, 0xb2, 0x59, 0x9d, 0x4e,
-	0x1a, 0x90, 0x8e, 0x71, 0x26, 0x2a, 0x3a, 0x87, 0x2d, 0xcb, 0xf7, 0x9e, 0x38, 0xfd, 0xda, 0xba,
-	0x8c, 0xff, 0x8e, 0x11, 0xcd, 0xcd, 0xc8, 0xce, 0x4d, 0x86, 0x55, 0x73, 0x33, 0x30, 0xf9, 0xe4,
-	0xde, 0x33, 0x4e, 0x3d, 0xe6, 0xf8, 0x9e, 0x59, 0x7d, 0x3e, 0x69, 0xac, 0x4d, 0x27, 0x8d, 0xad,
-	0x13, 0x09, 0x82, 0x15, 0x18, 0xba, 0x0d, 0x7a, 0xcf, 0xf7, 0x39, 0xe3, 0x21, 0x09, 0x6a, 0x1b,
-	0x87, 0xda, 0x91, 0x6e, 0x1e, 0x4c, 0x27, 0x0d, 0xdd, 0x8c, 0x85, 0x97, 0xd9, 0x01, 0x4e, 0x8d,
-	0xd1, 0x1d, 0xd8, 0x75, 0x69, 0xd8, 0xa7, 0x3f, 0x71, 0xf8, 0x45, 0x87, 0x84, 0xa2, 0x32, 0x9b,
-	0x87, 0xda, 0x51, 0xd9, 0xbc, 0x31, 0x9d, 0x34, 0x76, 0x4f, 0xf3, 0x2a, 0x5c, 0xb4, 0x6d, 0x7e,
-	0xa6, 0xc3, 0x8d, 0x39, 0x35, 0x40, 0xef, 0xc2, 0xb5, 0x90, 0x06, 0x03, 0xc7, 0x22, 0x27, 0xfe,
-	0x50, 0x55, 0xbb, 0x64, 0x5e, 0x9f, 0x4e, 0x1a, 0xd7, 0x70, 0x46, 0x8e, 0x73, 0x56, 0xe8, 0x01,
-	0xec, 0x87, 0x74, 0xe4, 0x88, 0xa9, 0x7e, 0xe0, 0x30, 0xee, 0x87, 0xe3, 0x07, 0x8e, 0xeb, 0x70,
-	0x59, 0xab, 0x92, 0x59, 0x9b, 0x4e, 0x1a, 0xfb, 0x78, 0x8e, 0x1e, 0xcf, 0xf5, 0x42, 0x3f, 0x02,
-	0xc4, 0x68, 0x38, 0x72, 0x2c, 0xda, 0xb6, 0x2c, 0x81, 0xff, 0x90, 0xb8, 0x54, 0x55, 0xe7, 0xe6,
-	0x74, 0xd2, 0x40, 0xdd, 0x19, 0x2d, 0x9e, 0xe3, 0x81, 0x28, 0x94, 0x1c, 0x97, 0xf4, 0xa9, 0x2c,
-	0x4c, 0xe5, 0xf8, 0xee, 0x92, 0x94, 0xf9, 0x50, 0x60, 0x99, 0xfa, 0x74, 0xd2, 0x28, 0xc9, 0x4f,
-	0x1c, 0xa1, 0xa3, 0x73, 0xd0, 0x43, 0xca, 0xfc, 0x61, 0x68, 0x51, 0x56, 0x2b, 0xc9, 0x50, 0x47,
-	0x19, 0x76, 0x18, 0x62, 0x13, 0x1a, 0xa3, 0x5b, 0x06, 0x56, 0x46, 0x98, 0x7e, 0x3c, 0x74, 0x42,
-	0x09, 0xce, 0xcc, 0x1d, 0xb1, 0xda, 0xb1, 0x86, 0xe1, 0x14, 0x09, 0x7d, 0xa6, 0x81, 0x1e, 0xf8,
-	0xf6, 0x03, 0xd2, 0xa3, 0x03, 0x56, 0xdb, 0x3a, 0xdc, 0x38, 0xaa, 0x1c, 0x93, 0xd5, 0xb3, 0xde,
-	0xe8, 0xc4, 0x31, 0xee, 0x79, 0x3c, 0x1c, 0x9b, 0x7b, 0x8a, 0xa9, 0x7a, 0x22, 0xc7, 0x69, 0x1a,
-	0xe8, 0x0f, 0x1a, 0x54, 0x03, 0xdf, 0x6e, 0x7b, 0x9e, 0xcf, 0x09, 0x77, 0x7c, 0x8f, 0xd5, 0xb6,
-	0x65, 0x66, 0x4f, 0xfe, 0x3f, 0x99, 0x65, 0x02, 0x45, 0xe9, 0xdd, 0x54, 0xe9, 0x55, 0xf3, 0x4a,
-	0x5c, 0xc8, 0x0a, 0x59, 0xb0, 0x47, 0x6c, 0xdb, 0x11, 0x03, 0x32, 0x78, 0xec, 0x0f, 0x86, 0x2e,
-	0x65, 0xb5, 0xb2, 0x4c, 0xf5, 0x60, 0xde, 0xe2, 0x44, 0x26, 0xe6, 0xdb, 0x0a, 0x7e, 0xaf, 0x5d,
-	0x74, 0xc6, 0xb3, 0x78, 0xe8, 0x13, 0xb8, 0x59, 0x14, 0x9e, 0x0a, 0xf6, 0xb1, 0x9a, 0x2e, 0x23,
-	0x35, 0x16, 0x47, 0x92, 0x76, 0x66, 0x5d, 0x85, 0xbb, 0xd9, 0x9e, 0x0b, 0x83, 0x17, 0xc0, 0xa3,
-	0xef, 0xc3, 0x06, 0xf5, 0x46, 0x35, 0x58, 0x3c, 0x9f, 0x7b, 0xde, 0xe8, 0x31, 0x09, 0xcd, 0x8a,
-	0x0a, 0xb0, 0x71, 0xcf, 0x1b, 0x61, 0xe1, 0x83, 0xde, 0x86, 0x8d, 0x51, 0x40, 0x6a, 0x15, 0x79,
-	0x56, 0x6c, 0x0b, 0xd5, 0xe3, 0x4e, 0x1b, 0x0b, 0xd9, 0xc1, 0x0f, 0xa1, 0x9a, 0x27, 0x03, 0xba,
-	0x0e, 0x1b, 0x4f, 0xe9, 0x58, 0x1e, 0x02, 0x3a, 0x16, 0x9f, 0x68, 0x1f, 0x4a, 0x23, 0x32, 0x18,
-	0x52, 0xb9, 0xb5, 0x75, 0x1c, 0x0d, 0xde, 0x5b, 0xbf, 0xad, 0x1d, 0xb4, 0xe1, 0xc6, 0x9c, 0x05,
-	0xfb, 0x5f, 0x20, 0x9a, 0xbf, 0xd7, 0x20, 0xda, 0x5a, 0xc8, 0x00, 0x08, 0x69, 0xe0, 0x33, 0x47,
-	0x9c, 0x0a, 0x91, 0x73, 0x74, 0x3c, 0xe3, 0x44, 0x8a, 0x33, 0x16, 0x62, 0x56, 0x9c, 0x44, 0x67,
-	0xb3, 0x1e, 0xcd, 0xea, 0x8c, 0xf4, 0xb1, 0x90, 0xa1, 0x47, 0x00, 0xc1, 0x70, 0x30, 0xe8, 0xf8,
-	0x03, 0xc7, 0x1a, 0xab, 0x53, 0xa4, 0x25, 0xa0, 0x3a, 0x89, 0xf4, 0x72, 0xd2, 0xf8, 0xea, 0xec,
-	0xad, 0x69, 0xa4, 0x06, 0x38, 0x03, 0xd1, 0xfc, 0xcb, 0x3a, 0x54, 0x4e, 0x25, 0x85, 0xed, 0x2e,
-	0xa5, 0x36, 0xfa, 0x39, 0x94, 0xc5, 0x8d, 0x69, 0x13, 0x4e, 0xd4, 0xe5, 0xf4, 0x9d, 0x85, 0x97,
-	0x83, 0xdc, 0x03, 0xc2, 0x5a, 0xac, 0xd1, 0xa3, 0xde, 0x2f, 0xa8, 0xc5, 0x4f, 0x29, 0x27, 0x26,
-	0x52, 0xeb, 0x04, 0xa9, 0x0c, 0x27, 0xa8, 0x28, 0x80, 0x4d, 0x16, 0x50, 0x4b, 0x5d, 0x3d, 0x0f,
-	0x97, 0xdc, 0x6a, 0x99, 0xdc, 0xbb, 0x01, 0xb5, 0xcc, 0x6b, 0x2a, 0xf6, 0xa6, 0x18, 0x61, 0x19,
-	0x09, 0x3d, 0x83, 0x2d, 0xc6, 0x09, 0x1f, 0x32, 0x59, 0xb0, 0xca, 0x71, 0x67, 0x85, 0x31, 0x25,
-	0x6e, 0x7a, 0x23, 0x46, 0x63, 0xac, 0xe2, 0x35, 0x5f, 0x68, 0xb0, 0x9b, 0xb1, 0x7e, 0xe0, 0x30,
-	0x8e, 0x7e, 0x36, 0x53, 0x61, 0xe3, 0x6a, 0x15, 0x16, 0xde, 0xb2, 0xbe, 0xd7, 0x55, 0xb4, 0x72,
-	0x2c, 0xc9, 0x54, 0xd7, 0x87, 0x92, 0xc3, 0xa9, 0xcb, 0x6a, 0xeb, 0x72, 0x3b, 0x7d, 0xb4, 0xba,
-	0xa9, 0x9a, 0x3b, 0x2a, 0x6c, 0xe9, 0x43, 0x11, 0x00, 0x47, 0x71, 0x9a, 0x7f, 0x5b, 0x87, 0x6a,
-	0xb6, 0x20, 0x94, 0xbf, 0x01, 0x0e, 0xb1, 0x1c, 0x87, 0x7e, 0xbc, 0xc2, 0xf5, 0xa4, 0x7c, 0x21,
-	0x8d, 0x7e, 0x59, 0xa0, 0x51, 0x77, 0xb5, 0x61, 0xff, 0x3b, 0x93, 0xfe, 0xae, 0x01, 0xca, 0x3b,
-	0xbc, 0x01, 0x32, 0x85, 0x79, 0x32, 0x9d, 0xae, 0x74, 0xc2, 0x0b, 0xf8, 0xf4, 0xef, 0xcd, 0xe2,
-	0x44, 0xc5, 0x12, 0xa0, 0x23, 0x28, 0xab, 0x26, 0x8d, 0xa9, 0x36, 0xee, 0x9a, 0x48, 0x5a, 0xb5,
-	0x71, 0x0c, 0x27, 0x5a, 0x44, 0xa0, 0xcc, 0xe8, 0x80, 0x5a, 0xdc, 0x0f, 0x15, 0x3f, 0xbe, 0x7b,
-	0xc5, 0x92, 0x88, 0xbb, 0xa2, 0xab, 0x5c, 0xd3, 0xba, 0xc4, 0x12, 0x9c, 0xc0, 0xa2, 0x4f, 0x35,
-	0x28, 0x73, 0xea, 0x06, 0x03, 0xc2, 0xa9, 0x22, 0x03, 0x5e, 0x5d, 0x6d, 0xce, 0x14, 0x72, 0x9a,
-	0x42, 0x2c, 0xc1, 0x49, 0x54, 0xf4, 0x2b, 0xd8, 0x61, 0x17, 0xbe, 0xcf, 0x63, 0x95, 0x6a, 0x0b,
-	0xdb, 0x57, 0x4c, 0x43, 0xdd, 0xac, 0xf2, 0x8d, 0x66, 0x74, 0xb3, 0x40, 0xe6, 0x5b, 0x2a, 0xea,
-	0x4e, 0x4e, 0x8c, 0xf3, 0xe1, 0xd0, 0x6f, 0x34, 0xa8, 0x0e, 0x03, 0x9b, 0x70, 0xda, 0xe5, 0xe2,
-	0xb5, 0xd3, 0x1f, 0xab, 0x6e, 0x71, 0x59, 0x92, 0x9c, 0xe7, 0x40, 0x4d, 0x24, 0xda, 0xa3, 0xbc,
-	0x0c, 0x17, 0x02, 0x2f, 0x6c, 0xd8, 0xb7, 0x5e, 0xa7, 0x61, 0x6f, 0xfe, 0x79, 0x0b, 0xf6, 0xe7,
-	0x6d, 0x4d, 0xf4, 0x11, 0x20, 0xbf, 0x27, 0x3a, 0x73, 0x6a, 0xdf, 0x8f, 0xde, 0x78, 0x8e, 0xef,
-	0x49, 0x32, 0x6e, 0x98, 0x07, 0xaa, 0x68, 0xe8, 0xd1, 0x8c, 0x05, 0x9e, 0xe3, 0x85, 0xbe, 0x9d,
-	0xa1, 0x73, 0xf4, 0xae, 0x48, 0x16, 0x7b, 0x0e, 0xa5, 0x7f, 0x00, 0x3b, 0x21, 0x25, 0xf6, 0x38,
-	0x56, 0x49, 0xce, 0x95, 0xd2, 0x95, 0xc2, 0x59, 0x25, 0xce, 0xdb, 0xa2, 0xfb, 0xb0, 0xe7, 0xd1,
-	0x67, 0x5c, 0x8d, 0x1f, 0x0e, 0xdd, 0x1e, 0x0d, 0x25, 0x5b, 0x4a, 0x69, 0x83, 0xf8, 0xb0, 0x68,
-	0x80, 0x67, 0x7d, 0x50, 0x1b, 0x76, 0xad, 0x61, 0x28, 0x5f, 0x60, 0x71, 0x1e, 0x25, 0x09, 0xf3,
-	0x15, 0x05, 0xb3, 0x7b, 0x92, 0x57, 0xe3, 0xa2, 0xbd, 0x80, 0x88, 0xd6, 0xce, 0x4e, 0x20, 0xb6,
-	0xf2, 0x10, 0xe7, 0x79, 0x35, 0x2e, 0xda, 0xe7, 0xb2, 0x88, 0x56, 0xaf, 0xb6, 0x2d, 0xdb, 0xa0,
-	0xd9, 0x2c, 0x22, 0x35, 0x2e, 0xda, 0xa3, 0xf7, 0x63, 0xea, 0x26, 0x08, 0xe5, 0xe8, 0x39, 0x16,
-	0xb7, 0xe3, 0xe7, 0x39, 0x2d, 0x2e, 0x58, 0xa3, 0xf7, 0xa0, 0x6a, 0xf9, 0x83, 0x81, 0x1c, 0x44,
-	0x0f, 0x4b, 0x5d, 0x4e, 0x42, 0x72, 0xf5, 0x24, 0xa7, 0xc1, 0x05, 0x4b, 0xf4, 0x31, 0x80, 0xe5,
-	0x7b, 0x51, 0x1f, 0xcc, 0x54, 0xcf, 0x7b, 0xe7, 0x75, 0x36, 0xed, 0x49, 0x8c, 0x92, 0x5e, 0x95,
-	0x89, 0x88, 0xe1, 0x4c, 0x10, 0xb9, 0x55, 0x03, 0xea, 0xd9, 0x8e, 0xd7, 0x57, 0x55, 0x94, 0x0d,
-	0xf3, 0xf2, 0x5b, 0xb5, 0x93, 0x03, 0x8d, 0xa6, 0x9f, 0x97, 0xe1, 0x42, 0xe0, 0xe6, 0xbf, 0xf2,
-	0x0d, 0x91, 0x3c, 0xda, 0x29, 0x94, 0xe4, 0xd9, 0xa2, 0x2e, 0xb0, 0x65, 0x5f, 0xb6, 0xf2, 0xd8,
-	0x8a, 0x5e, 0xb6, 0xf2, 0x13, 0x47, 0xe8, 0x68, 0x08, 0x7a, 0x3f, 0x7e, 0x97, 0xa9, 0x43, 0xfb,
-	0x83, 0x55, 0xbd, 0xf3, 0xa2, 0x97, 0x6f, 0x32, 0xc4, 0x69, 0xa4, 0xe6, 0x1f, 0x35, 0xd8, 0x9b,
-	0x69, 0x18, 0x0b, 0x34, 0xd0, 0xde, 0x04, 0x0d, 0xe6, 0x1f, 0x5f, 0xeb, 0xaf, 0x73, 0x7c, 0x35,
-	0xff, 0xa1, 0xc1, 0x8d, 0x39, 0x37, 0xd6, 0x97, 0xf1, 0xf5, 0xd0, 0xfc, 0xa7, 0x06, 0x05, 0x56,
-	0xa3, 0x43, 0xd8, 0xf4, 0x88, 0x4b, 0xd5, 0x53, 0x2e, 0x71, 0x92, 0xff, 0x6e, 0xa4, 0x06, 0xbd,
-	0x0f, 0x5b, 0x21, 0x25, 0x4c, 0x15, 0x58, 0x37, 0xbf, 0x1e, 0xb7, 0x75, 0x58, 0x4a, 0x2f, 0x27,
-	0x8d, 0xfd, 0xc2, 0x4e, 0x91, 0x72, 0xac, 0xbc, 0xd0, 0x23, 0x28, 0x31, 0xc7, 0xb3, 0xe2, 0xee,
-	0xe2, 0x9b, 0x57, 0xab, 0xe2, 0x99, 0xe3, 0xd2, 0xb4, 0xad, 0xea, 0x0a, 0x00, 0x1c, 0xe1, 0xa0,
-	0xaf, 0xc1, 0x76, 0x48, 0x79, 0xe8, 0x50, 0xa6, 0xce, 0xfe, 0xca, 0x74, 0xd2, 0xd8, 0xc6, 0x91,
-	0x08, 0xc7, 0xba, 0xe6, 0x5d, 0x78, 0x0b, 0x8b, 0x03, 0xcb, 0xeb, 0xe7, 0xef, 0x5c, 0xf4, 0x2d,
-	0xd0, 0x03, 0x12, 0x72, 0x27, 0xb9, 0xf3, 0x4a, 0x11, 0xe7, 0x3b, 0xb1, 0x10, 0xa7, 0xfa, 0xe6,
-	0x37, 0x20, 0xda, 0x7a, 0xaf, 0x2e, 0x54, 0xf3, 0x4f, 0x1a, 0x14, 0xae, 0x77, 0x74, 0x0c, 0x9b,
-	0x7c, 0x1c, 0xc4, 0x4e, 0x75, 0xe1, 0x70, 0x36, 0x0e, 0xe8, 0xe5, 0xa4, 0x81, 0xf2, 0x96, 0x42,
-	0x8a, 0xa5, 0x2d, 0xfa, 0xad, 0x06, 0x3b, 0x61, 0x36, 0x71, 0x45, 0x90, 0xb3, 0x25, 0x09, 0x32,
-	0xb7, 0x18, 0xe6, 0x9e, 0xbc, 0x74, 0xb3, 0x2a, 0x9c, 0x8f, 0x6e, 0x5a, 0xcf, 0x5f, 0xd6, 0xd7,
-	0x3e, 0x7f, 0x59, 0x5f, 0xfb, 0xe2, 0x65, 0x7d, 0xed, 0xd3, 0x69, 0x5d, 0x7b, 0x3e, 0xad, 0x6b,
-	0x9f, 0x4f, 0xeb, 0xda, 0x17, 0xd3, 0xba, 0xf6, 0x62, 0x5a, 0xd7, 0x7e, 0xf7, 0xd7, 0xfa, 0xda,
-	0x4f, 0xef, 0x2c, 0xf5, 0x7b, 0xff, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x9f, 0x51, 0x54, 0xe6,
-	0x1e, 0x18, 0x00, 0x00,
+	// 1898 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x59, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x77, 0xdb, 0x1e, 0xdb, 0xfd, 0xc6, 0x1f, 0x71, 0xd9, 0x09, 0xb3, 0x5e, 0x31, 0x13, 0x8d,
+	0x04, 0x32, 0x1f, 0xdb, 0xc6, 0x66, 0x41, 0x61, 0x21, 0x2b, 0x4d, 0xdb, 0x21, 0xbb, 0x2b, 0x3b,
+	0x19, 0x6a, 0xec, 0x20, 0x21, 0x0e, 0x94, 0xbb, 0x2b, 0xe3, 0x26, 0xfd, 0xb5, 0xdd, 0x35, 0x93,
+	0x0c, 0x08, 0xb4, 0xe2, 0xc6, 0x01, 0x09, 0xed, 0xbf, 0x80, 0xc4, 0xbf, 0xc1, 0x35, 0x37, 0x56,
+	0x88, 0xc3, 0x4a, 0x48, 0xa3, 0x64, 0x40, 0x48, 0x70, 0xe1, 0x1e, 0x09, 0x09, 0xd5, 0x47, 0x7f,
+	0xce, 0xcc, 0xae, 0x93, 0x31, 0x39, 0xec, 0xad, 0xeb, 0xd5, 0x7b, 0xbf, 0xf7, 0xea, 0xd5, 0xaf,
+	0xaa, 0xde, 0x9b, 0x81, 0x93, 0xae, 0xc3, 0x2e, 0x7a, 0xe7, 0x86, 0x15, 0x78, 0x7b, 0x5d, 0x12,
+	0xd9, 0xd4, 0xa7, 0x51, 0xf6, 0x11, 0x3e, 0xea, 0xee, 0x91, 0xd0, 0x89, 0xf7, 0x62, 0x4a, 0x6d,
+	0x8f, 0xf8, 0xa4, 0x4b, 0x3d, 0xea, 0xb3, 0xbd, 0xfe, 0x3e, 0x71, 0xc3, 0x0b, 0xb2, 0xbf, 0xd7,
+	0xe5, 0x6a, 0x84, 0x51, 0xdb, 0x08, 0xa3, 0x80, 0x05, 0xe8, 0x76, 0x06, 0x67, 0x24, 0x28, 0xd9,
+	0x47, 0xf8, 0xa8, 0x6b, 0x70, 0x38, 0xa3, 0x08, 0x67, 0x24, 0x70, 0x3b, 0xe6, 0xe5, 0xa2, 0xb1,
+	0x82, 0x88, 0xee, 0xf5, 0xf7, 0xcf, 0x29, 0x1b, 0x0f, 0x61, 0xe7, 0xad, 0x3c, 0x46, 0xd0, 0x0d,
+	0xf6, 0x84, 0xf8, 0xbc, 0xf7, 0x50, 0x8c, 0xc4, 0x40, 0x7c, 0x29, 0xf5, 0xe6, 0xa3, 0x5b, 0xb1,
+	0xe1, 0x04, 0x1c, 0x38, 0xc1, 0x1d, 0x83, 0x7c, 0x3b, 0xd3, 0xf1, 0x88, 0x75, 0xe1, 0xf8, 0x34,
+	0x1a, 0x64, 0xd1, 0x78, 0x94, 0x91, 0x49, 0x56, 0x7b, 0xd3, 0xac, 0xa2, 0x9e, 0xcf, 0x1c, 0x8f,
+	0x8e, 0x19, 0x7c, 0xf7, 0xf3, 0x0c, 0x62, 0xeb, 0x82, 0x7a, 0xa4, 0x6c, 0xd7, 0xfc, 0xf3, 0x3c,
+	0x54, 0x5b, 0x3d, 0x16, 0xc4, 0x16, 0x71, 0x1d, 0xbf, 0x8b, 0xf6, 0xa1, 0xea, 0x39, 0x3e, 0xa6,
+	0xa1, 0xeb, 0x58, 0x24, 0xae, 0x69, 0x37, 0xb5, 0xdd, 0x8a, 0xb9, 0x31, 0x1a, 0x36, 0xaa, 0x27,
+	0x99, 0x18, 0xe7, 0x75, 0xd0, 0x77, 0xa0, 0xea, 0x91, 0x27, 0xa9, 0xc9, 0xbc, 0x30, 0xd9, 0x7a,
+	0x3a, 0x6c, 0xcc, 0x09, 0xb3, 0x6c, 0x0a, 0xe7, 0xf5, 0x10, 0x81, 0x37, 0x19, 0x89, 0xba, 0x94,
+	0x9d, 0x31, 0xc7, 0x75, 0x7e, 0x41, 0x98, 0x13, 0xf8, 0x6d, 0x1a, 0x59, 0xd4, 0x67, 0xa4, 0x4b,
+	0x6b, 0x0b, 0x02, 0xa6, 0x31, 0x1a, 0x36, 0xde, 0x3c, 0x9d, 0xae, 0x86, 0x3f, 0x0b, 0x03, 0xc5,
+	0xb0, 0xc9, 0xd7, 0x45, 0x8f, 0x82, 0xc7, 0xfe, 0x61, 0x10, 0xb8, 0x76, 0xf0, 0xd8, 0xaf, 0x2d,
+	0xde, 0xd4, 0x76, 0xab, 0x07, 0x86, 0x21, 0x13, 0x66, 0xe4, 0x13, 0x96, 0x91, 0x8c, 0xef, 0x8b,
+	0xd1, 0xdf, 0x37, 0x8e, 0x7a, 0x91, 0x00, 0x35, 0xaf, 0x8f, 0x86, 0x8d, 0xcd, 0x4e, 0x19, 0x0c,
+	0x8f, 0xe3, 0x37, 0x9f, 0xcd, 0x83, 0x7e, 0x57, 0xd0, 0xce, 0xa5, 0x0c, 0xfd, 0x46, 0x03, 0xb0,
+	0x69, 0xe8, 0x06, 0x03, 0xce, 0x56, 0x91, 0xcf, 0xea, 0x01, 0x36, 0x66, 0xa2, 0xba, 0x91, 0xc2,
+	0x1f, 0xa5, 0xc8, 0xe6, 0xfa, 0x68, 0xd8, 0x80, 0x6c, 0x8c, 0x73, 0x5e, 0xd1, 0x19, 0x2c, 0x59,
+	0x81, 0xff, 0xd0, 0xe9, 0x8a, 0xcd, 0xa9, 0x1e, 0xbc, 0x35, 0x75, 0xf1, 0x8a, 0x2d, 0x06, 0x26,
+	0x8f, 0xef, 0x3c, 0x61, 0xd4, 0x8f, 0xf9, 0xda, 0xd7, 0xd5, 0x5e, 0x2e, 0x1d, 0x0a, 0x10, 0xac,
+	0xc0, 0xd0, 0x2d, 0xd0, 0xcf, 0x83, 0x80, 0xc5, 0x2c, 0x22, 0xa1, 0xd8, 0x2f, 0xdd, 0xdc, 0x19,
+	0x0d, 0x1b, 0xba, 0x99, 0x08, 0x5f, 0xe4, 0x07, 0x38, 0x53, 0x46, 0xb7, 0x61, 0xc3, 0xa3, 0x51,
+	0x97, 0xfe, 0xd8, 0x61, 0x17, 0x6d, 0x12, 0xf1, 0xcc, 0xf0, 0x6d, 0x59, 0x31, 0xb7, 0x46, 0xc3,
+	0xc6, 0xc6, 0x49, 0x71, 0x0a, 0x97, 0x75, 0x9b, 0x1f, 0xeb, 0xb0, 0x35, 0x21, 0x07, 0xe8, 0x6d,
+	0x58, 0x8d, 0x24, 0xbd, 0x0e, 0x83, 0x9e, 0xca, 0x76, 0xc5, 0xbc, 0x36, 0x1a, 0x36, 0x56, 0x71,
+	0x4e, 0x8e, 0x0b, 0x5a, 0xe8, 0x18, 0xb6, 0x23, 0xda, 0x77, 0xf8, 0x52, 0xdf, 0x73, 0x62, 0x16,
+	0x44, 0x83, 0x63, 0xc7, 0x73, 0x98, 0x22, 0x72, 0x6d, 0x34, 0x6c, 0x6c, 0xe3, 0x09, 0xf3, 0x78,
+	0xa2, 0x15, 0xfa, 0x21, 0xa0, 0x98, 0x46, 0x7d, 0xc7, 0xa2, 0x2d, 0xcb, 0xe2, 0xf8, 0xf7, 0x88,
+	0x47, 0x55, 0x76, 0x6e, 0x8c, 0x86, 0x0d, 0xd4, 0x19, 0x9b, 0xc5, 0x13, 0x2c, 0x10, 0x85, 0x8a,
+	0xe3, 0xf1, 0x83, 0x20, 0xf9, 0x7a, 0x34, 0x23, 0x65, 0xde, 0xe7, 0x58, 0xa6, 0x3e, 0x1a, 0x36,
+	0x2a, 0xe2, 0x13, 0x4b, 0x74, 0x74, 0x06, 0x7a, 0x44, 0xe3, 0xa0, 0x17, 0x59, 0x34, 0xae, 0x55,
+	0x84, 0xab, 0xdd, 0x1c, 0x3b, 0x0c, 0x7e, 0xad, 0xf1, 0x83, 0x80, 0x95, 0x12, 0xa6, 0x1f, 0xf6,
+	0x9c, 0x48, 0x80, 0xc7, 0xe6, 0x1a, 0xdf, 0xed, 0x64, 0x26, 0xc6, 0x19, 0x12, 0xfa, 0x58, 0x03,
+	0x3d, 0x0c, 0xec, 0x63, 0x72, 0x4e, 0xdd, 0xb8, 0xb6, 0x74, 0x73, 0x61, 0xb7, 0x7a, 0x40, 0xae,
+	0x9e, 0xf5, 0x46, 0x3b, 0xf1, 0x71, 0xc7, 0x67, 0xd1, 0xc0, 0xdc, 0x54, 0x4c, 0xd5, 0x53, 0x39,
+	0xce, 0xc2, 0x40, 0x7f, 0xd4, 0x60, 0x3d, 0x0c, 0xec, 0x96, 0xef, 0x07, 0x4c, 0x9c, 0xea, 0xb8,
+	0xb6, 0x2c, 0x22, 0x7b, 0xf8, 0xff, 0x89, 0x2c, 0xe7, 0x48, 0x86, 0x77, 0x43, 0x85, 0xb7, 0x5e,
+	0x9c, 0xc4, 0xa5, 0xa8, 0x90, 0x05, 0x9b, 0xc4, 0xb6, 0x1d, 0x3e, 0x20, 0xee, 0x83, 0xc0, 0xed,
+	0x79, 0x34, 0xae, 0xad, 0x88, 0x50, 0x77, 0x26, 0x6d, 0x8e, 0x54, 0x31, 0xdf, 0x50, 0xf0, 0x9b,
+	0xad, 0xb2, 0x31, 0x1e, 0xc7, 0x43, 0x8f, 0xe1, 0x46, 0x59, 0x78, 0xc2, 0xd9, 0x17, 0xd7, 0x74,
+	0xe1, 0xa9, 0x31, 0xdd, 0x93, 0xd0, 0x33, 0xeb, 0xca, 0xdd, 0x8d, 0xd6, 0x44, 0x18, 0x3c, 0x05,
+	0x1e, 0x7d, 0x0f, 0x16, 0xa8, 0xdf, 0xaf, 0xc1, 0xf4, 0xf5, 0xdc, 0xf1, 0xfb, 0x0f, 0x48, 0x64,
+	0x56, 0x95, 0x83, 0x85, 0x3b, 0x7e, 0x1f, 0x73, 0x1b, 0xf4, 0x06, 0x2c, 0xf4, 0x43, 0x52, 0xab,
+	0x8a, 0xbb, 0x62, 0x99, 0x4f, 0x3d, 0x68, 0xb7, 0x30, 0x97, 0xed, 0xfc, 0x00, 0xd6, 0x8b, 0x64,
+	0x40, 0xd7, 0x60, 0xe1, 0x11, 0x1d, 0x88, 0x4b, 0x40, 0xc7, 0xfc, 0x13, 0x6d, 0x43, 0xa5, 0x4f,
+	0xdc, 0x1e, 0x15, 0x47, 0x5b, 0xc7, 0x72, 0xf0, 0xce, 0xfc, 0x2d, 0x6d, 0xa7, 0x05, 0x5b, 0x13,
+	0x36, 0xec, 0x65, 0x20, 0x9a, 0x7f, 0xd0, 0x40, 0x1e, 0x2d, 0x64, 0x00, 0x44, 0x34, 0x0c, 0x62,
+	0x87, 0xdf, 0x0a, 0xd2, 0x58, 0x5e, 0xcf, 0x38, 0x95, 0xe2, 0x9c, 0x06, 0x5f, 0x15, 0x23, 0xf2,
+	0x6e, 0xd6, 0xe5, 0xaa, 0x4e, 0x49, 0x17, 0x73, 0x19, 0xba, 0x0f, 0x10, 0xf6, 0x5c, 0xb7, 0x1d,
+	0xb8, 0x8e, 0x35, 0x50, 0xb7, 0xc8, 0x1e, 0x87, 0x6a, 0xa7, 0xd2, 0x17, 0xc3, 0xc6, 0x97, 0xc7,
+	0xeb, 0x10, 0x23, 0x53, 0xc0, 0x39, 0x88, 0xe6, 0xdf, 0xe6, 0xa1, 0x7a, 0x22, 0x28, 0x6c, 0x77,
+	0x28, 0xb5, 0xd1, 0xcf, 0x60, 0x85, 0xbf, 0x75, 0x36, 0x61, 0x44, 0x3d, 0x4e, 0xdf, 0xba, 0xdc,
+	0xcb, 0x78, 0xff, 0xfc, 0xe7, 0xd4, 0x62, 0x27, 0x94, 0x11, 0x13, 0xa9, 0x7d, 0x82, 0x4c, 0x86,
+	0x53, 0x54, 0x14, 0xc2, 0x62, 0x1c, 0x52, 0x4b, 0x3d, 0x3d, 0xf7, 0x66, 0x3c, 0x6a, 0xb9, 0xd8,
+	0x3b, 0x21, 0xb5, 0xcc, 0x55, 0xe5, 0x7b, 0x91, 0x8f, 0xb0, 0xf0, 0x84, 0x9e, 0xc0, 0x52, 0xcc,
+	0x08, 0xeb, 0xc5, 0x22, 0x61, 0xd5, 0x83, 0xf6, 0x15, 0xfa, 0x14, 0xb8, 0xd9, 0x8b, 0x28, 0xc7,
+	0x58, 0xf9, 0x6b, 0x3e, 0xd3, 0x60, 0x23, 0xa7, 0x7d, 0xec, 0xc4, 0x0c, 0xfd, 0x74, 0x2c, 0xc3,
+	0x97, 0xac, 0x3d, 0xb8, 0xb5, 0xc8, 0xef, 0x35, 0xe5, 0x6d, 0x25, 0x91, 0xe4, 0xb2, 0x1b, 0x40,
+	0xc5, 0x61, 0xd4, 0xe3, 0x65, 0x17, 0x3f, 0x4e, 0x1f, 0x5c, 0xdd, 0x52, 0xcd, 0x35, 0xe5, 0xb6,
+	0xf2, 0x3e, 0x77, 0x80, 0xa5, 0x9f, 0xe6, 0x3f, 0xe6, 0x61, 0x3d, 0x9f, 0x10, 0xca, 0x5e, 0x03,
+	0x87, 0xe2, 0x02, 0x87, 0x7e, 0x74, 0x85, 0xfb, 0x49, 0xd9, 0x54, 0x1a, 0xfd, 0xb2, 0x44, 0xa3,
+	0xce, 0xd5, 0xba, 0xfd, 0x6c, 0x26, 0xfd, 0x53, 0x03, 0x54, 0x34, 0x78, 0x0d, 0x64, 0x8a, 0x8a,
+	0x64, 0x3a, 0xb9, 0xd2, 0x05, 0x4f, 0xe1, 0xd3, 0x7f, 0x2b, 0xe5, 0x85, 0xf2, 0x2d, 0x40, 0xbb,
+	0xb0, 0x12, 0x15, 0x9b, 0x90, 0x55, 0x1e, 0x74, 0xda, 0x4a, 0xa4, 0xb3, 0x88, 0xc0, 0x4a, 0x4c,
+	0x5d, 0x6a, 0xb1, 0x20, 0x52, 0xfc, 0xf8, 0xf6, 0x25, 0x53, 0xc2, 0xdf, 0x8a, 0x8e, 0x32, 0xcd,
+	0xf2, 0x92, 0x48, 0x70, 0x0a, 0x8b, 0x3e, 0xd2, 0x60, 0x85, 0x51, 0x2f, 0x74, 0x09, 0xa3, 0x8a,
+	0x0c, 0xf8, 0xea, 0x72, 0x73, 0xaa, 0x90, 0xb3, 0x10, 0x12, 0x09, 0x4e, 0xbd, 0xa2, 0x5f, 0xc3,
+	0x5a, 0x7c, 0x11, 0x04, 0x2c, 0x99, 0x52, 0x65, 0x61, 0xeb, 0x92, 0x61, 0xa8, 0x97, 0x55, 0x74,
+	0xbd, 0x46, 0x27, 0x0f, 0x64, 0x5e, 0x57, 0x5e, 0xd7, 0x0a, 0x62, 0x5c, 0x74, 0x87, 0x7e, 0xab,
+	0xc1, 0x7a, 0x2f, 0xb4, 0x09, 0xa3, 0x1d, 0xc6, 0xfb, 0xc7, 0xee, 0x40, 0x55, 0x8b, 0xb3, 0x92,
+	0xe4, 0xac, 0x00, 0x6a, 0x22, 0x5e, 0x1e, 0x15, 0x65, 0xb8, 0xe4, 0x78, 0x6a, 0xc1, 0xbe, 0xf4,
+	0x4a, 0x05, 0xfb, 0xaf, 0xa0, 0x4a, 0xb2, 0x06, 0xb8, 0xb6, 0x2c, 0x56, 0x35, 0xeb, 0x3d, 0x9a,
+	0x6b, 0xa9, 0x65, 0xf7, 0x9c, 0x13, 0xe0, 0xbc, 0xbf, 0xe6, 0x9f, 0x96, 0x61, 0x7b, 0xd2, 0xcd,
+	0x80, 0x3e, 0x00, 0x14, 0x9c, 0xf3, 0xc6, 0x80, 0xda, 0x77, 0x65, 0xd3, 0xee, 0x04, 0xbe, 0x38,
+	0x0b, 0x0b, 0xe6, 0x8e, 0xda, 0x33, 0x74, 0x7f, 0x4c, 0x03, 0x4f, 0xb0, 0x42, 0xdf, 0xcc, 0x9d,
+	0x26, 0xd9, 0xd6, 0xa4, 0x5c, 0x9b, 0x70, 0xa2, 0xbe, 0x0f, 0x6b, 0x11, 0x25, 0xf6, 0x20, 0x6d,
+	0xe9, 0x65, 0x2f, 0x9e, 0x12, 0x05, 0xe7, 0x27, 0x71, 0x51, 0x17, 0xdd, 0x85, 0x4d, 0x9f, 0x3e,
+	0x61, 0x6a, 0x7c, 0xaf, 0xe7, 0x9d, 0xd3, 0x48, 0x90, 0xb5, 0x92, 0xd5, 0xa7, 0xf7, 0xca, 0x0a,
+	0x78, 0xdc, 0x06, 0xb5, 0x60, 0xc3, 0xea, 0x45, 0xa2, 0x01, 0x4c, 0xe2, 0xa8, 0x08, 0x98, 0x2f,
+	0x29, 0x98, 0x8d, 0xc3, 0xe2, 0x34, 0x2e, 0xeb, 0x73, 0x08, 0x49, 0x1d, 0x3b, 0x85, 0x58, 0x2a,
+	0x42, 0x9c, 0x15, 0xa7, 0x71, 0x59, 0xbf, 0x10, 0x85, 0x24, 0x8f, 0x60, 0x88, 0x3e, 0x21, 0x0a,
+	0x39, 0x8d, 0xcb, 0xfa, 0xe8, 0xdd, 0xe4, 0xe4, 0xa4, 0x08, 0x2b, 0xb2, 0x1b, 0x4c, 0xba, 0x81,
+	0xb3, 0xc2, 0x2c, 0x2e, 0x69, 0xa3, 0x77, 0x60, 0xdd, 0x0a, 0x5c, 0x57, 0x0c, 0x64, 0x5f, 0xab,
+	0x8b, 0x45, 0x88, 0xa3, 0x72, 0x58, 0x98, 0xc1, 0x25, 0x4d, 0xf4, 0x21, 0x80, 0x15, 0xf8, 0xb2,
+	0x0c, 0x8f, 0x55, 0xc9, 0x7d, 0xfb, 0x55, 0xee, 0x8c, 0xc3, 0x04, 0x25, 0x7b, 0xa9, 0x53, 0x51,
+	0x8c, 0x73, 0x4e, 0xc4, 0x4d, 0x11, 0x52, 0xdf, 0xe6, 0x4c, 0x97, 0x59, 0x14, 0xf5, 0xfa, 0xec,
+	0x37, 0x45, 0xbb, 0x00, 0x2a, 0x97, 0x5f, 0x94, 0xe1, 0x92, 0x63, 0x64, 0xc1, 0x9a, 0x4b, 0x62,
+	0x26, 0x7e, 0xb7, 0x39, 0x75, 0x3c, 0x5a, 0x5b, 0x15, 0x91, 0x7c, 0xfd, 0x72, 0x0f, 0x04, 0xb7,
+	0x30, 0x37, 0x39, 0xe3, 0x8f, 0xf3, 0x20, 0xb8, 0x88, 0xd9, 0xfc, 0x4f, 0xb1, 0xe8, 0x13, 0xcf,
+	0x17, 0x85, 0x8a, 0xb8, 0x3f, 0xd5, 0x23, 0x3d, 0x6b, 0xf7, 0x2e, 0xae, 0x66, 0xd9, 0xbd, 0x8b,
+	0x4f, 0x2c, 0xd1, 0x51, 0x0f, 0xf4, 0x6e, 0xd2, 0x7b, 0xaa, 0x87, 0xe9, 0xbd, 0xab, 0xea, 0x65,
+	0x65, 0x77, 0x9f, 0x0e, 0x71, 0xe6, 0xa9, 0xf9, 0x17, 0x0d, 0x36, 0xc7, 0x8a, 0xe2, 0x12, 0xd7,
+	0xb4, 0xd7, 0xc1, 0xb5, 0xc9, 0x77, 0xe4, 0xfc, 0xab, 0xdc, 0x91, 0xcd, 0x7f, 0x69, 0xb0, 0x35,
+	0xe1, 0x55, 0xfe, 0x22, 0x76, 0x48, 0xcd, 0x7f, 0x6b, 0x50, 0x3a, 0x3a, 0xe8, 0x26, 0x2c, 0xfa,
+	0xc4, 0xa3, 0xaa, 0x5d, 0x4d, 0x8d, 0xc4, 0xef, 0x53, 0x62, 0x06, 0xbd, 0x0b, 0x4b, 0x11, 0x25,
+	0xb1, 0x4a, 0xb0, 0x6e, 0x7e, 0x35, 0x29, 0x5d, 0xb1, 0x90, 0xbe, 0x18, 0x36, 0xb6, 0x4b, 0xc7,
+	0x51, 0xc8, 0xb1, 0xb2, 0x42, 0xf7, 0xa1, 0x12, 0x3b, 0xbe, 0x95, 0x54, 0x50, 0x2f, 0x73, 0x08,
+	0xd3, 0xd2, 0xb1, 0xc3, 0x01, 0xb0, 0xc4, 0x41, 0x5f, 0x81, 0xe5, 0x88, 0xb2, 0xc8, 0xa1, 0xb1,
+	0x7a, 0x60, 0xaa, 0xa3, 0x61, 0x63, 0x19, 0x4b, 0x11, 0x4e, 0xe6, 0x9a, 0x47, 0x70, 0x1d, 0xf3,
+	0x5b, 0xd1, 0xef, 0x16, 0xeb, 0x0a, 0xf4, 0x0d, 0xd0, 0x43, 0x12, 0x31, 0x27, 0x7d, 0x58, 0x2b,
+	0x92, 0xf3, 0xed, 0x44, 0x88, 0xb3, 0xf9, 0xe6, 0xd7, 0x40, 0x1e, 0xbd, 0xcf, 0x4f, 0x54, 0xf3,
+	0xaf, 0x1a, 0x94, 0x4a, 0x18, 0x74, 0x00, 0x8b, 0x6c, 0x10, 0x26, 0x46, 0x75, 0x6e, 0x70, 0x3a,
+	0x08, 0xe9, 0x8b, 0x61, 0x03, 0x15, 0x35, 0xb9, 0x14, 0x0b, 0x5d, 0xf4, 0x3b, 0x0d, 0xd6, 0xa2,
+	0x7c, 0xe0, 0x8a, 0x20, 0xa7, 0x33, 0x12, 0x64, 0x62, 0x32, 0xe4, 0x3d, 0x57, 0x98, 0xc2, 0x45,
+	0xef, 0xa6, 0xf5, 0xf4, 0x79, 0x7d, 0xee, 0x93, 0xe7, 0xf5, 0xb9, 0x4f, 0x9f, 0xd7, 0xe7, 0x3e,
+	0x1a, 0xd5, 0xb5, 0xa7, 0xa3, 0xba, 0xf6, 0xc9, 0xa8, 0xae, 0x7d, 0x3a, 0xaa, 0x6b, 0xcf, 0x46,
+	0x75, 0xed, 0xf7, 0x7f, 0xaf, 0xcf, 0xfd, 0xe4, 0xf6, 0x4c, 0x7f, 0x0a, 0xfd, 0x2f, 0x00, 0x00,
+	0xff, 0xff, 0xfc, 0x81, 0x0c, 0xeb, 0x54, 0x1a, 0x00, 0x00,
+}
+
+func (m *Autoscaling) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Autoscaling) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Autoscaling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ScaleDownCooldown != nil {
+		{
+			size, err := m.ScaleDownCooldown.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TargetUtilizationPercentage != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.TargetUtilizationPercentage))
+		i--
+		dAtA[i] = 0x18
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxReplicas))
+	i--
+	dAtA[i] = 0x10
+	if m.MinReplicas != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MinReplicas))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *Gardenlet) Marshal() (dAtA []byte, err error) {
@@ -1111,6 +1199,18 @@ func (m *ManagedSeedSetSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Autoscaling != nil {
+		{
+			size, err := m.Autoscaling.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.RevisionHistoryLimit != nil {
 		i = encodeVarintGenerated(dAtA, i, uint64(*m.RevisionHistoryLimit))
 		i--
@@ -1186,6 +1286,18 @@ func (m *ManagedSeedSetStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastScaleTime != nil {
+		{
+			size, err := m.LastScaleTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.PendingReplica != nil {
 		{
 			size, err := m.PendingReplica.MarshalToSizedBuffer(dAtA[:i])
@@ -1535,6 +1647,26 @@ func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *Autoscaling) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinReplicas != nil {
+		n += 1 + sovGenerated(uint64(*m.MinReplicas))
+	}
+	n += 1 + sovGenerated(uint64(m.MaxReplicas))
+	if m.TargetUtilizationPercentage != nil {
+		n += 1 + sovGenerated(uint64(*m.TargetUtilizationPercentage))
+	}
+	if m.ScaleDownCooldown != nil {
+		l = m.ScaleDownCooldown.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
 func (m *Gardenlet) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1728,6 +1860,10 @@ func (m *ManagedSeedSetSpec) Size() (n int) {
 	if m.RevisionHistoryLimit != nil {
 		n += 1 + sovGenerated(uint64(*m.RevisionHistoryLimit))
 	}
+	if m.Autoscaling != nil {
+		l = m.Autoscaling.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -1760,6 +1896,10 @@ func (m *ManagedSeedSetStatus) Size() (n int) {
 		l = m.PendingReplica.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
+	if m.LastScaleTime != nil {
+		l = m.LastScaleTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
@@ -1873,6 +2013,19 @@ func sovGenerated(x uint64) (n int) {
 func sozGenerated(x uint64) (n int) {
 	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
+func (this *Autoscaling) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Autoscaling{`,
+		`MinReplicas:` + valueToStringGenerated(this.MinReplicas) + `,`,
+		`MaxReplicas:` + fmt.Sprintf("%v", this.MaxReplicas) + `,`,
+		`TargetUtilizationPercentage:` + valueToStringGenerated(this.TargetUtilizationPercentage) + `,`,
+		`ScaleDownCooldown:` + strings.Replace(fmt.Sprintf("%v", this.ScaleDownCooldown), "Duration", "v1.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
 func (this *Gardenlet) String() string {
 	if this == nil {
 		return "nil"
@@ -1930,7 +2083,7 @@ func (this *GardenletDeployment) String() string {
 		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
 		`ServiceAccountName:` + valueToStringGenerated(this.ServiceAccountName) + `,`,
 		`Image:` + strings.Replace(this.Image.String(), "Image", "Image", 1) + `,`,
-		`Resources:` + strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceRequirements", "v1.ResourceRequirements", 1) + `,`,
+		`Resources:` + strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceRequirements", "v11.ResourceRequirements", 1) + `,`,
 		`PodLabels:` + mapStringForPodLabels + `,`,
 		`PodAnnotations:` + mapStringForPodAnnotations + `,`,
 		`AdditionalVolumes:` + repeatedStringForAdditionalVolumes + `,`,
@@ -1958,7 +2111,7 @@ func (this *ManagedSeed) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ManagedSeed{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ManagedSeedSpec", "ManagedSeedSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ManagedSeedStatus", "ManagedSeedStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -1975,7 +2128,7 @@ func (this *ManagedSeedList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&ManagedSeedList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -1986,7 +2139,7 @@ func (this *ManagedSeedSet) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ManagedSeedSet{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ManagedSeedSetSpec", "ManagedSeedSetSpec", 1), `&`, ``, 1) + `,`,
 		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ManagedSeedSetStatus", "ManagedSeedSetStatus", 1), `&`, ``, 1) + `,`,
 		`}`,
@@ -2003,7 +2156,7 @@ func (this *ManagedSeedSetList) String() string {
 	}
 	repeatedStringForItems += "}"
 	s := strings.Join([]string{`&ManagedSeedSetList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v11.ListMeta", 1), `&`, ``, 1) + `,`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
 		`Items:` + repeatedStringForItems + `,`,
 		`}`,
 	}, "")
@@ -2015,11 +2168,12 @@ func (this *ManagedSeedSetSpec) String() string {
 	}
 	s := strings.Join([]string{`&ManagedSeedSetSpec{`,
 		`Replicas:` + valueToStringGenerated(this.Replicas) + `,`,
-		`Selector:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v11.LabelSelector", 1), `&`, ``, 1) + `,`,
+		`Selector:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Selector), "LabelSelector", "v1.LabelSelector", 1), `&`, ``, 1) + `,`,
 		`Template:` + strings.Replace(strings.Replace(this.Template.String(), "ManagedSeedTemplate", "ManagedSeedTemplate", 1), `&`, ``, 1) + `,`,
 		`ShootTemplate:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ShootTemplate), "ShootTemplate", "v1beta1.ShootTemplate", 1), `&`, ``, 1) + `,`,
 		`UpdateStrategy:` + strings.Replace(this.UpdateStrategy.String(), "UpdateStrategy", "UpdateStrategy", 1) + `,`,
 		`RevisionHistoryLimit:` + valueToStringGenerated(this.RevisionHistoryLimit) + `,`,
+		`Autoscaling:` + strings.Replace(this.Autoscaling.String(), "Autoscaling", "Autoscaling", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2045,6 +2199,7 @@ func (this *ManagedSeedSetStatus) String() string {
 		`CollisionCount:` + valueToStringGenerated(this.CollisionCount) + `,`,
 		`Conditions:` + repeatedStringForConditions + `,`,
 		`PendingReplica:` + strings.Replace(this.PendingReplica.String(), "PendingReplica", "PendingReplica", 1) + `,`,
+		`LastScaleTime:` + strings.Replace(fmt.Sprintf("%v", this.LastScaleTime), "Time", "v1.Time", 1) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -2081,7 +2236,7 @@ func (this *ManagedSeedTemplate) String() string {
 		return "nil"
 	}
 	s := strings.Join([]string{`&ManagedSeedTemplate{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v11.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
 		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "ManagedSeedSpec", "ManagedSeedSpec", 1), `&`, ``, 1) + `,`,
 		`}`,
 	}, "")
@@ -2094,7 +2249,7 @@ func (this *PendingReplica) String() string {
 	s := strings.Join([]string{`&PendingReplica{`,
 		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
 		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
-		`Since:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Since), "Time", "v11.Time", 1), `&`, ``, 1) + `,`,
+		`Since:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Since), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
 		`Retries:` + valueToStringGenerated(this.Retries) + `,`,
 		`}`,
 	}, "")
@@ -2139,6 +2294,151 @@ func valueToStringGenerated(v interface{}) string {
 	pv := reflect.Indirect(rv).Interface()
 	return fmt.Sprintf("*%v", pv)
 }
+func (m *Autoscaling) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Autoscaling: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Autoscaling: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinReplicas", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.MinReplicas = &v
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReplicas", wireType)
+			}
+			m.MaxReplicas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxReplicas |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetUtilizationPercentage", wireType)
+			}
+			var v int32
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TargetUtilizationPercentage = &v
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScaleDownCooldown", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScaleDownCooldown == nil {
+				m.ScaleDownCooldown = &v1.Duration{}
+			}
+			if err := m.ScaleDownCooldown.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Gardenlet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -2480,7 +2780,7 @@ func (m *GardenletDeployment) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Resources == nil {
-				m.Resources = &v1.ResourceRequirements{}
+				m.Resources = &v11.ResourceRequirements{}
 			}
 			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -2769,7 +3069,7 @@ func (m *GardenletDeployment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdditionalVolumes = append(m.AdditionalVolumes, v1.Volume{})
+			m.AdditionalVolumes = append(m.AdditionalVolumes, v11.Volume{})
 			if err := m.AdditionalVolumes[len(m.AdditionalVolumes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -2803,7 +3103,7 @@ func (m *GardenletDeployment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdditionalVolumeMounts = append(m.AdditionalVolumeMounts, v1.VolumeMount{})
+			m.AdditionalVolumeMounts = append(m.AdditionalVolumeMounts, v11.VolumeMount{})
 			if err := m.AdditionalVolumeMounts[len(m.AdditionalVolumeMounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -2837,7 +3137,7 @@ func (m *GardenletDeployment) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Env = append(m.Env, v1.EnvVar{})
+			m.Env = append(m.Env, v11.EnvVar{})
 			if err := m.Env[len(m.Env)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -3769,6 +4069,42 @@ func (m *ManagedSeedSetSpec) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.RevisionHistoryLimit = &v
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Autoscaling", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Autoscaling == nil {
+				m.Autoscaling = &Autoscaling{}
+			}
+			if err := m.Autoscaling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -4087,6 +4423,42 @@ func (m *ManagedSeedSetStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastScaleTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastScaleTime == nil {
+				m.LastScaleTime = &v1.Time{}
+			}
+			if err := m.LastScaleTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
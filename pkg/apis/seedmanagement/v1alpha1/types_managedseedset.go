@@ -72,6 +72,28 @@ type ManagedSeedSetSpec struct {
 	// in the ManagedSeedSet's revision history. Defaults to 10. This field is immutable.
 	// +optional
 	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty" protobuf:"varint,6,opt,name=revisionHistoryLimit"`
+	// Autoscaling specifies the policy for scaling the ManagedSeedSet based on the utilization of its seeds. If set,
+	// Replicas is managed by the ManagedSeedSet controller.
+	// +optional
+	Autoscaling *Autoscaling `json:"autoscaling,omitempty" protobuf:"bytes,7,opt,name=autoscaling"`
+}
+
+// Autoscaling specifies the policy for scaling a ManagedSeedSet based on the utilization of its seeds, i.e., the
+// ratio of the shoots scheduled onto the seeds to their allocatable shoots.
+type Autoscaling struct {
+	// MinReplicas is the lower limit for the number of replicas. Defaults to 1.
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty" protobuf:"varint,1,opt,name=minReplicas"`
+	// MaxReplicas is the upper limit for the number of replicas. It must not be less than MinReplicas.
+	MaxReplicas int32 `json:"maxReplicas" protobuf:"varint,2,opt,name=maxReplicas"`
+	// TargetUtilizationPercentage is the target utilization of the seeds of the set, in percent. A replica is added
+	// when the utilization reaches this value. Defaults to 80.
+	// +optional
+	TargetUtilizationPercentage *int32 `json:"targetUtilizationPercentage,omitempty" protobuf:"varint,3,opt,name=targetUtilizationPercentage"`
+	// ScaleDownCooldown is the minimum duration since the last scaling of the set before an empty replica is removed.
+	// Defaults to 30m.
+	// +optional
+	ScaleDownCooldown *metav1.Duration `json:"scaleDownCooldown,omitempty" protobuf:"bytes,4,opt,name=scaleDownCooldown"`
 }
 
 // UpdateStrategy specifies the strategy that the ManagedSeedSet
@@ -141,6 +163,9 @@ type ManagedSeedSetStatus struct {
 	// This replica is in a state that requires the controller to wait for it to change before advancing to the next replica.
 	// +optional
 	PendingReplica *PendingReplica `json:"pendingReplica,omitempty" protobuf:"bytes,11,opt,name=pendingReplica"`
+	// LastScaleTime is the last time the ManagedSeedSet controller changed the number of replicas due to autoscaling.
+	// +optional
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty" protobuf:"bytes,12,opt,name=lastScaleTime"`
 }
 
 // PendingReplicaReason is a string enumeration type that enumerates all possible reasons for a replica to be pending.
@@ -15,7 +15,8 @@ import (
 	core "github.com/gardener/gardener/pkg/apis/core"
 	v1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	seedmanagement "github.com/gardener/gardener/pkg/apis/seedmanagement"
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	conversion "k8s.io/apimachinery/pkg/conversion"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
@@ -27,6 +28,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*Autoscaling)(nil), (*seedmanagement.Autoscaling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Autoscaling_To_seedmanagement_Autoscaling(a.(*Autoscaling), b.(*seedmanagement.Autoscaling), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*seedmanagement.Autoscaling)(nil), (*Autoscaling)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_seedmanagement_Autoscaling_To_v1alpha1_Autoscaling(a.(*seedmanagement.Autoscaling), b.(*Autoscaling), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*GardenletDeployment)(nil), (*seedmanagement.GardenletDeployment)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_GardenletDeployment_To_seedmanagement_GardenletDeployment(a.(*GardenletDeployment), b.(*seedmanagement.GardenletDeployment), scope)
 	}); err != nil {
@@ -210,6 +221,32 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha1_Autoscaling_To_seedmanagement_Autoscaling(in *Autoscaling, out *seedmanagement.Autoscaling, s conversion.Scope) error {
+	out.MinReplicas = (*int32)(unsafe.Pointer(in.MinReplicas))
+	out.MaxReplicas = in.MaxReplicas
+	out.TargetUtilizationPercentage = (*int32)(unsafe.Pointer(in.TargetUtilizationPercentage))
+	out.ScaleDownCooldown = (*v1.Duration)(unsafe.Pointer(in.ScaleDownCooldown))
+	return nil
+}
+
+// Convert_v1alpha1_Autoscaling_To_seedmanagement_Autoscaling is an autogenerated conversion function.
+func Convert_v1alpha1_Autoscaling_To_seedmanagement_Autoscaling(in *Autoscaling, out *seedmanagement.Autoscaling, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Autoscaling_To_seedmanagement_Autoscaling(in, out, s)
+}
+
+func autoConvert_seedmanagement_Autoscaling_To_v1alpha1_Autoscaling(in *seedmanagement.Autoscaling, out *Autoscaling, s conversion.Scope) error {
+	out.MinReplicas = (*int32)(unsafe.Pointer(in.MinReplicas))
+	out.MaxReplicas = in.MaxReplicas
+	out.TargetUtilizationPercentage = (*int32)(unsafe.Pointer(in.TargetUtilizationPercentage))
+	out.ScaleDownCooldown = (*v1.Duration)(unsafe.Pointer(in.ScaleDownCooldown))
+	return nil
+}
+
+// Convert_seedmanagement_Autoscaling_To_v1alpha1_Autoscaling is an autogenerated conversion function.
+func Convert_seedmanagement_Autoscaling_To_v1alpha1_Autoscaling(in *seedmanagement.Autoscaling, out *Autoscaling, s conversion.Scope) error {
+	return autoConvert_seedmanagement_Autoscaling_To_v1alpha1_Autoscaling(in, out, s)
+}
+
 func autoConvert_v1alpha1_Gardenlet_To_seedmanagement_Gardenlet(in *Gardenlet, out *seedmanagement.Gardenlet, s conversion.Scope) error {
 	out.Deployment = (*seedmanagement.GardenletDeployment)(unsafe.Pointer(in.Deployment))
 	if err := runtime.Convert_runtime_RawExtension_To_runtime_Object(&in.Config, &out.Config, s); err != nil {
@@ -235,12 +272,12 @@ func autoConvert_v1alpha1_GardenletDeployment_To_seedmanagement_GardenletDeploym
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	out.ServiceAccountName = (*string)(unsafe.Pointer(in.ServiceAccountName))
 	out.Image = (*seedmanagement.Image)(unsafe.Pointer(in.Image))
-	out.Resources = (*v1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
 	out.PodLabels = *(*map[string]string)(unsafe.Pointer(&in.PodLabels))
 	out.PodAnnotations = *(*map[string]string)(unsafe.Pointer(&in.PodAnnotations))
-	out.AdditionalVolumes = *(*[]v1.Volume)(unsafe.Pointer(&in.AdditionalVolumes))
-	out.AdditionalVolumeMounts = *(*[]v1.VolumeMount)(unsafe.Pointer(&in.AdditionalVolumeMounts))
-	out.Env = *(*[]v1.EnvVar)(unsafe.Pointer(&in.Env))
+	out.AdditionalVolumes = *(*[]corev1.Volume)(unsafe.Pointer(&in.AdditionalVolumes))
+	out.AdditionalVolumeMounts = *(*[]corev1.VolumeMount)(unsafe.Pointer(&in.AdditionalVolumeMounts))
+	out.Env = *(*[]corev1.EnvVar)(unsafe.Pointer(&in.Env))
 	out.VPA = (*bool)(unsafe.Pointer(in.VPA))
 	return nil
 }
@@ -255,12 +292,12 @@ func autoConvert_seedmanagement_GardenletDeployment_To_v1alpha1_GardenletDeploym
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
 	out.ServiceAccountName = (*string)(unsafe.Pointer(in.ServiceAccountName))
 	out.Image = (*Image)(unsafe.Pointer(in.Image))
-	out.Resources = (*v1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
 	out.PodLabels = *(*map[string]string)(unsafe.Pointer(&in.PodLabels))
 	out.PodAnnotations = *(*map[string]string)(unsafe.Pointer(&in.PodAnnotations))
-	out.AdditionalVolumes = *(*[]v1.Volume)(unsafe.Pointer(&in.AdditionalVolumes))
-	out.AdditionalVolumeMounts = *(*[]v1.VolumeMount)(unsafe.Pointer(&in.AdditionalVolumeMounts))
-	out.Env = *(*[]v1.EnvVar)(unsafe.Pointer(&in.Env))
+	out.AdditionalVolumes = *(*[]corev1.Volume)(unsafe.Pointer(&in.AdditionalVolumes))
+	out.AdditionalVolumeMounts = *(*[]corev1.VolumeMount)(unsafe.Pointer(&in.AdditionalVolumeMounts))
+	out.Env = *(*[]corev1.EnvVar)(unsafe.Pointer(&in.Env))
 	out.VPA = (*bool)(unsafe.Pointer(in.VPA))
 	return nil
 }
@@ -273,7 +310,7 @@ func Convert_seedmanagement_GardenletDeployment_To_v1alpha1_GardenletDeployment(
 func autoConvert_v1alpha1_Image_To_seedmanagement_Image(in *Image, out *seedmanagement.Image, s conversion.Scope) error {
 	out.Repository = (*string)(unsafe.Pointer(in.Repository))
 	out.Tag = (*string)(unsafe.Pointer(in.Tag))
-	out.PullPolicy = (*v1.PullPolicy)(unsafe.Pointer(in.PullPolicy))
+	out.PullPolicy = (*corev1.PullPolicy)(unsafe.Pointer(in.PullPolicy))
 	return nil
 }
 
@@ -285,7 +322,7 @@ func Convert_v1alpha1_Image_To_seedmanagement_Image(in *Image, out *seedmanageme
 func autoConvert_seedmanagement_Image_To_v1alpha1_Image(in *seedmanagement.Image, out *Image, s conversion.Scope) error {
 	out.Repository = (*string)(unsafe.Pointer(in.Repository))
 	out.Tag = (*string)(unsafe.Pointer(in.Tag))
-	out.PullPolicy = (*v1.PullPolicy)(unsafe.Pointer(in.PullPolicy))
+	out.PullPolicy = (*corev1.PullPolicy)(unsafe.Pointer(in.PullPolicy))
 	return nil
 }
 
@@ -453,6 +490,7 @@ func autoConvert_v1alpha1_ManagedSeedSetSpec_To_seedmanagement_ManagedSeedSetSpe
 	}
 	out.UpdateStrategy = (*seedmanagement.UpdateStrategy)(unsafe.Pointer(in.UpdateStrategy))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	out.Autoscaling = (*seedmanagement.Autoscaling)(unsafe.Pointer(in.Autoscaling))
 	return nil
 }
 
@@ -472,6 +510,7 @@ func autoConvert_seedmanagement_ManagedSeedSetSpec_To_v1alpha1_ManagedSeedSetSpe
 	}
 	out.UpdateStrategy = (*UpdateStrategy)(unsafe.Pointer(in.UpdateStrategy))
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	out.Autoscaling = (*Autoscaling)(unsafe.Pointer(in.Autoscaling))
 	return nil
 }
 
@@ -492,6 +531,7 @@ func autoConvert_v1alpha1_ManagedSeedSetStatus_To_seedmanagement_ManagedSeedSetS
 	out.CollisionCount = (*int32)(unsafe.Pointer(in.CollisionCount))
 	out.Conditions = *(*[]core.Condition)(unsafe.Pointer(&in.Conditions))
 	out.PendingReplica = (*seedmanagement.PendingReplica)(unsafe.Pointer(in.PendingReplica))
+	out.LastScaleTime = (*v1.Time)(unsafe.Pointer(in.LastScaleTime))
 	return nil
 }
 
@@ -512,6 +552,7 @@ func autoConvert_seedmanagement_ManagedSeedSetStatus_To_v1alpha1_ManagedSeedSetS
 	out.CollisionCount = (*int32)(unsafe.Pointer(in.CollisionCount))
 	out.Conditions = *(*[]v1beta1.Condition)(unsafe.Pointer(&in.Conditions))
 	out.PendingReplica = (*PendingReplica)(unsafe.Pointer(in.PendingReplica))
+	out.LastScaleTime = (*v1.Time)(unsafe.Pointer(in.LastScaleTime))
 	return nil
 }
 
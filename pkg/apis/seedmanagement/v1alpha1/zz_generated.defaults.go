@@ -59,6 +59,9 @@ func SetObjectDefaults_ManagedSeedSet(in *ManagedSeedSet) {
 			SetDefaults_RollingUpdateStrategy(in.Spec.UpdateStrategy.RollingUpdate)
 		}
 	}
+	if in.Spec.Autoscaling != nil {
+		SetDefaults_Autoscaling(in.Spec.Autoscaling)
+	}
 }
 
 func SetObjectDefaults_ManagedSeedSetList(in *ManagedSeedSetList) {
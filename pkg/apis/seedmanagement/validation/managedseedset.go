@@ -114,6 +114,35 @@ func ValidateManagedSeedSetSpec(spec *seedmanagement.ManagedSeedSetSpec, fldPath
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(*spec.RevisionHistoryLimit), fldPath.Child("revisionHistoryLimit"))...)
 	}
 
+	if spec.Autoscaling != nil {
+		allErrs = append(allErrs, validateAutoscaling(spec.Autoscaling, fldPath.Child("autoscaling"))...)
+	}
+
+	return allErrs
+}
+
+func validateAutoscaling(autoscaling *seedmanagement.Autoscaling, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// Ensure minReplicas is positive and not greater than maxReplicas
+	minReplicas := ptr.Deref(autoscaling.MinReplicas, 1)
+	if minReplicas < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minReplicas"), minReplicas, "must be greater than or equal to 1"))
+	}
+	if autoscaling.MaxReplicas < minReplicas {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxReplicas"), autoscaling.MaxReplicas, "must be greater than or equal to minReplicas"))
+	}
+
+	// Ensure targetUtilizationPercentage is a valid percentage if specified
+	if autoscaling.TargetUtilizationPercentage != nil && (*autoscaling.TargetUtilizationPercentage < 1 || *autoscaling.TargetUtilizationPercentage > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("targetUtilizationPercentage"), *autoscaling.TargetUtilizationPercentage, "must be between 1 and 100"))
+	}
+
+	// Ensure scaleDownCooldown is non-negative if specified
+	if autoscaling.ScaleDownCooldown != nil && autoscaling.ScaleDownCooldown.Duration < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("scaleDownCooldown"), autoscaling.ScaleDownCooldown.Duration.String(), "must be non-negative"))
+	}
+
 	return allErrs
 }
 
@@ -15,6 +15,8 @@
 package validation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
@@ -205,6 +207,63 @@ var _ = Describe("ManagedSeedSet Validation Tests", func() {
 			))
 		})
 
+		It("should allow valid autoscaling settings", func() {
+			managedSeedSet.Spec.Autoscaling = &seedmanagement.Autoscaling{
+				MinReplicas:                 ptr.To(int32(2)),
+				MaxReplicas:                 5,
+				TargetUtilizationPercentage: ptr.To(int32(80)),
+				ScaleDownCooldown:           &metav1.Duration{Duration: time.Hour},
+			}
+
+			Expect(ValidateManagedSeedSet(managedSeedSet)).To(BeEmpty())
+		})
+
+		It("should forbid invalid autoscaling settings", func() {
+			managedSeedSet.Spec.Autoscaling = &seedmanagement.Autoscaling{
+				MinReplicas:                 ptr.To(int32(0)),
+				MaxReplicas:                 -1,
+				TargetUtilizationPercentage: ptr.To(int32(101)),
+				ScaleDownCooldown:           &metav1.Duration{Duration: -time.Minute},
+			}
+
+			errorList := ValidateManagedSeedSet(managedSeedSet)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.autoscaling.minReplicas"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.autoscaling.maxReplicas"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.autoscaling.targetUtilizationPercentage"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.autoscaling.scaleDownCooldown"),
+				})),
+			))
+		})
+
+		It("should forbid autoscaling maxReplicas less than minReplicas", func() {
+			managedSeedSet.Spec.Autoscaling = &seedmanagement.Autoscaling{
+				MinReplicas: ptr.To(int32(3)),
+				MaxReplicas: 2,
+			}
+
+			errorList := ValidateManagedSeedSet(managedSeedSet)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("spec.autoscaling.maxReplicas"),
+				})),
+			))
+		})
+
 		It("should forbid empty selector", func() {
 			managedSeedSet.Spec.Selector = metav1.LabelSelector{}
 
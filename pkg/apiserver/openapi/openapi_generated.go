@@ -87,6 +87,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener":                                   schema_pkg_apis_core_v1beta1_Gardener(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.GardenerResourceData":                       schema_pkg_apis_core_v1beta1_GardenerResourceData(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Hibernation":                                schema_pkg_apis_core_v1beta1_Hibernation(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationIdlePolicy":                      schema_pkg_apis_core_v1beta1_HibernationIdlePolicy(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationSchedule":                        schema_pkg_apis_core_v1beta1_HibernationSchedule(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HighAvailability":                           schema_pkg_apis_core_v1beta1_HighAvailability(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.HorizontalPodAutoscalerConfig":              schema_pkg_apis_core_v1beta1_HorizontalPodAutoscalerConfig(ref),
@@ -94,6 +95,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.IngressController":                          schema_pkg_apis_core_v1beta1_IngressController(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.InternalSecret":                             schema_pkg_apis_core_v1beta1_InternalSecret(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.InternalSecretList":                         schema_pkg_apis_core_v1beta1_InternalSecretList(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KMSConfig":                                  schema_pkg_apis_core_v1beta1_KMSConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeAPIServerConfig":                        schema_pkg_apis_core_v1beta1_KubeAPIServerConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeControllerManagerConfig":                schema_pkg_apis_core_v1beta1_KubeControllerManagerConfig(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.KubeProxyConfig":                            schema_pkg_apis_core_v1beta1_KubeProxyConfig(ref),
@@ -118,6 +120,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MachineTypeStorage":                         schema_pkg_apis_core_v1beta1_MachineTypeStorage(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Maintenance":                                schema_pkg_apis_core_v1beta1_Maintenance(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceAutoUpdate":                      schema_pkg_apis_core_v1beta1_MaintenanceAutoUpdate(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceFreezePeriod":                    schema_pkg_apis_core_v1beta1_MaintenanceFreezePeriod(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeWindow":                      schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.MemorySwapConfiguration":                    schema_pkg_apis_core_v1beta1_MemorySwapConfiguration(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Monitoring":                                 schema_pkg_apis_core_v1beta1_Monitoring(ref),
@@ -138,6 +141,9 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Quota":                                      schema_pkg_apis_core_v1beta1_Quota(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaList":                                  schema_pkg_apis_core_v1beta1_QuotaList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaSpec":                                  schema_pkg_apis_core_v1beta1_QuotaSpec(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaStatus":                                schema_pkg_apis_core_v1beta1_QuotaStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaUsage":                                 schema_pkg_apis_core_v1beta1_QuotaUsage(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaUsageShoot":                            schema_pkg_apis_core_v1beta1_QuotaUsageShoot(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Region":                                     schema_pkg_apis_core_v1beta1_Region(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceData":                               schema_pkg_apis_core_v1beta1_ResourceData(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ResourceWatchCacheSize":                     schema_pkg_apis_core_v1beta1_ResourceWatchCacheSize(ref),
@@ -183,6 +189,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSSHKeypairRotation":                    schema_pkg_apis_core_v1beta1_ShootSSHKeypairRotation(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootSpec":                                  schema_pkg_apis_core_v1beta1_ShootSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootState":                                 schema_pkg_apis_core_v1beta1_ShootState(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStateEncryptionKey":                    schema_pkg_apis_core_v1beta1_ShootStateEncryptionKey(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStateList":                             schema_pkg_apis_core_v1beta1_ShootStateList(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStateSpec":                             schema_pkg_apis_core_v1beta1_ShootStateSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStatus":                                schema_pkg_apis_core_v1beta1_ShootStatus(ref),
@@ -195,6 +202,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WatchCacheSizes":                            schema_pkg_apis_core_v1beta1_WatchCacheSizes(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.Worker":                                     schema_pkg_apis_core_v1beta1_Worker(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerKubernetes":                           schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref),
+		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerPoolInPlaceUpdate":                    schema_pkg_apis_core_v1beta1_WorkerPoolInPlaceUpdate(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerSystemComponents":                     schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref),
 		"github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkersSettings":                            schema_pkg_apis_core_v1beta1_WorkersSettings(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.Bastion":                             schema_pkg_apis_operations_v1alpha1_Bastion(ref),
@@ -202,6 +210,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionList":                         schema_pkg_apis_operations_v1alpha1_BastionList(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionSpec":                         schema_pkg_apis_operations_v1alpha1_BastionSpec(ref),
 		"github.com/gardener/gardener/pkg/apis/operations/v1alpha1.BastionStatus":                       schema_pkg_apis_operations_v1alpha1_BastionStatus(ref),
+		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Autoscaling":                     schema_pkg_apis_seedmanagement_v1alpha1_Autoscaling(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Gardenlet":                       schema_pkg_apis_seedmanagement_v1alpha1_Gardenlet(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.GardenletDeployment":             schema_pkg_apis_seedmanagement_v1alpha1_GardenletDeployment(ref),
 		"github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Image":                           schema_pkg_apis_seedmanagement_v1alpha1_Image(ref),
@@ -2926,10 +2935,18 @@ func schema_pkg_apis_core_v1beta1_EncryptionConfig(ref common.ReferenceCallback)
 							},
 						},
 					},
+					"kms": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KMS contains the configuration of a KMS v2 plugin which shall be used for envelope encryption of the resources. If not set, the resources are encrypted with an `aescbc` key managed by Gardener. Note that KMS is only supported for versions >= 1.29 and it cannot be removed once configured.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.KMSConfig"),
+						},
+					},
 				},
 				Required: []string{"resources"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.KMSConfig"},
 	}
 }
 
@@ -3350,11 +3367,39 @@ func schema_pkg_apis_core_v1beta1_Hibernation(ref common.ReferenceCallback) comm
 							},
 						},
 					},
+					"idlePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IdlePolicy determines that the Shoot is hibernated automatically after it has not been used for a while.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationIdlePolicy"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationIdlePolicy", "github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationSchedule"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_HibernationIdlePolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HibernationIdlePolicy determines when an idle Shoot is hibernated automatically.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"idleDuration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "IdleDuration is the duration without any user activity after which the Shoot is hibernated, e.g. `8h`. Requests to the API server of the Shoot which are not issued by system components as well as changes to workload resources count as activity.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
 				},
+				Required: []string{"idleDuration"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.HibernationSchedule"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
 	}
 }
 
@@ -3654,6 +3699,43 @@ func schema_pkg_apis_core_v1beta1_InternalSecretList(ref common.ReferenceCallbac
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_KMSConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "KMSConfig contains the configuration of a KMS v2 plugin used for the encryption of resources in etcd.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type is the type of the KMS plugin. The extension registered for this type is responsible for running the KMS plugin next to the kube-apiserver.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"credentialsResourceName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CredentialsResourceName is the name of a resource in `.spec.resources` which contains the credentials for the KMS provider. The referenced resource must be a secret.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"providerConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ProviderConfig is the provider-specific configuration of the KMS plugin.",
+							Ref:         ref("k8s.io/apimachinery/pkg/runtime.RawExtension"),
+						},
+					},
+				},
+				Required: []string{"type"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/runtime.RawExtension"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_KubeAPIServerConfig(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -4976,11 +5058,25 @@ func schema_pkg_apis_core_v1beta1_Maintenance(ref common.ReferenceCallback) comm
 							Format:      "",
 						},
 					},
+					"freezePeriods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FreezePeriods is a list of absolute periods of time in which no automatic maintenance operations are performed, e.g. during a year-end change freeze.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceFreezePeriod"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceAutoUpdate", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeWindow"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceAutoUpdate", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceFreezePeriod", "github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceTimeWindow"},
 	}
 }
 
@@ -5013,6 +5109,41 @@ func schema_pkg_apis_core_v1beta1_MaintenanceAutoUpdate(ref common.ReferenceCall
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_MaintenanceFreezePeriod(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MaintenanceFreezePeriod is an absolute period of time in which no automatic maintenance operations are performed.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"begin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Begin is the beginning of the freeze period.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"end": {
+						SchemaProps: spec.SchemaProps{
+							Description: "End is the end of the freeze period.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"reason": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Reason is a human-readable explanation of the freeze period.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"begin", "end"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -5036,6 +5167,21 @@ func schema_pkg_apis_core_v1beta1_MaintenanceTimeWindow(ref common.ReferenceCall
 							Format:      "",
 						},
 					},
+					"weekdays": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Weekdays restricts the time window to the given days of the week (e.g. \"Tuesday\") on which it may begin. The days refer to the time zone of \"Begin\". If empty, the time window may begin on every day.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"begin", "end"},
 			},
@@ -5675,11 +5821,25 @@ func schema_pkg_apis_core_v1beta1_ProjectSpec(ref common.ReferenceCallback) comm
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectTolerations"),
 						},
 					},
+					"maintenanceFreezePeriods": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaintenanceFreezePeriods is a list of absolute periods of time in which no automatic maintenance operations are performed for any shoot of the project. They are honored in addition to the freeze periods of the shoots.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceFreezePeriod"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMember", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectTolerations", "k8s.io/api/rbac/v1.Subject"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.MaintenanceFreezePeriod", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectMember", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ProjectTolerations", "k8s.io/api/rbac/v1.Subject"},
 	}
 }
 
@@ -5882,11 +6042,18 @@ func schema_pkg_apis_core_v1beta1_Quota(ref common.ReferenceCallback) common.Ope
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaSpec"),
 						},
 					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Status contains the most recently observed usage of the Quota.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaStatus"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaSpec", "github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
@@ -5985,6 +6152,136 @@ func schema_pkg_apis_core_v1beta1_QuotaSpec(ref common.ReferenceCallback) common
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_QuotaStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuotaStatus holds the most recently observed usage of a Quota.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"hard": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Hard is the set of hard limits enforced for each metric.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"usages": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Usages is the list of observed usages of the Quota. For Quotas with scope 'secret', there is a single usage accounting all Shoots using the Quota. For Quotas with scope 'project', there is one usage per project namespace.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaUsage"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaUsage", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_QuotaUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuotaUsage is the observed usage of a Quota within its scope.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the project namespace the usage is accounted for. It is only set for Quotas with scope 'project'.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"used": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Used is the observed usage for each metric.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"shoots": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Shoots is the list of Shoots contributing to the usage.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaUsageShoot"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.QuotaUsageShoot", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_pkg_apis_core_v1beta1_QuotaUsageShoot(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "QuotaUsageShoot references a Shoot contributing to the usage of a Quota.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace is the namespace of the Shoot.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the Shoot.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"generation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Generation is the generation of the Shoot which the usage was computed for.",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"namespace", "name"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_Region(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -7223,11 +7520,25 @@ func schema_pkg_apis_core_v1beta1_SeedStatus(ref common.ReferenceCallback) commo
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation"),
 						},
 					},
+					"shootStateEncryptionKeys": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ShootStateEncryptionKeys contains the key encryption keys which are available to the gardenlet for decrypting the sensitive data persisted in ShootStates.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStateEncryptionKey"),
+									},
+								},
+							},
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootStateEncryptionKey", "k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -8005,6 +8316,43 @@ func schema_pkg_apis_core_v1beta1_ShootState(ref common.ReferenceCallback) commo
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_ShootStateEncryptionKey(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ShootStateEncryptionKey describes a key encryption key which is available to the gardenlet for decrypting the sensitive data persisted in ShootStates.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the key encryption key.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"fingerprint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Fingerprint is the fingerprint of the key encryption key.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"primary": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Primary states whether the key encryption key is used for wrapping the data encryption keys of ShootStates.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "fingerprint"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_ShootStateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8303,12 +8651,32 @@ func schema_pkg_apis_core_v1beta1_ShootStatus(ref common.ReferenceCallback) comm
 							},
 						},
 					},
+					"lastActivityTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastActivityTime is the last time when user activity was observed in the Shoot cluster. It is only maintained if the Shoot has an idle hibernation policy.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"inPlaceUpdates": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InPlaceUpdates contains the progress of the in-place updates of worker pools using the in-place update strategy.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerPoolInPlaceUpdate"),
+									},
+								},
+							},
+						},
+					},
 				},
 				Required: []string{"gardener", "hibernated", "technicalID", "uid"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastMaintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/core/v1beta1.Gardener", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastError", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastMaintenance", "github.com/gardener/gardener/pkg/apis/core/v1beta1.LastOperation", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootAdvertisedAddress", "github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootCredentials", "github.com/gardener/gardener/pkg/apis/core/v1beta1.WorkerPoolInPlaceUpdate", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -8795,6 +9163,13 @@ func schema_pkg_apis_core_v1beta1_Worker(ref common.ReferenceCallback) common.Op
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/core/v1beta1.ClusterAutoscalerOptions"),
 						},
 					},
+					"updateStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdateStrategy specifies how the nodes of the worker pool are updated when its Kubernetes version changes. Defaults to 'AutoRollingUpdate'.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 				Required: []string{"name", "machine", "maximum", "minimum"},
 			},
@@ -8832,6 +9207,52 @@ func schema_pkg_apis_core_v1beta1_WorkerKubernetes(ref common.ReferenceCallback)
 	}
 }
 
+func schema_pkg_apis_core_v1beta1_WorkerPoolInPlaceUpdate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "WorkerPoolInPlaceUpdate contains the progress of the in-place update of a worker pool.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the name of the worker pool.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kubernetesVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "KubernetesVersion is the Kubernetes version the nodes of the worker pool are updated to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes is the number of nodes of the worker pool.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"updatedNodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpdatedNodes is the number of nodes of the worker pool which have already applied the desired operating system config.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name", "kubernetesVersion", "nodes", "updatedNodes"},
+			},
+		},
+	}
+}
+
 func schema_pkg_apis_core_v1beta1_WorkerSystemComponents(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -9119,6 +9540,50 @@ func schema_pkg_apis_operations_v1alpha1_BastionStatus(ref common.ReferenceCallb
 	}
 }
 
+func schema_pkg_apis_seedmanagement_v1alpha1_Autoscaling(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Autoscaling specifies the policy for scaling a ManagedSeedSet based on the utilization of its seeds, i.e., the ratio of the shoots scheduled onto the seeds to their allocatable shoots.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"minReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MinReplicas is the lower limit for the number of replicas. Defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxReplicas is the upper limit for the number of replicas. It must not be less than MinReplicas.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"targetUtilizationPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetUtilizationPercentage is the target utilization of the seeds of the set, in percent. A replica is added when the utilization reaches this value. Defaults to 80.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"scaleDownCooldown": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ScaleDownCooldown is the minimum duration since the last scaling of the set before an empty replica is removed. Defaults to 30m.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+				},
+				Required: []string{"maxReplicas"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
 func schema_pkg_apis_seedmanagement_v1alpha1_Gardenlet(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -9574,12 +10039,18 @@ func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSetSpec(ref common.Refer
 							Format:      "int32",
 						},
 					},
+					"autoscaling": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Autoscaling specifies the policy for scaling the ManagedSeedSet based on the utilization of its seeds. If set, Replicas is managed by the ManagedSeedSet controller.",
+							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Autoscaling"),
+						},
+					},
 				},
 				Required: []string{"selector", "template", "shootTemplate"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootTemplate", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedTemplate", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.UpdateStrategy", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.ShootTemplate", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.Autoscaling", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.ManagedSeedTemplate", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.UpdateStrategy", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -9680,12 +10151,18 @@ func schema_pkg_apis_seedmanagement_v1alpha1_ManagedSeedSetStatus(ref common.Ref
 							Ref:         ref("github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.PendingReplica"),
 						},
 					},
+					"lastScaleTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastScaleTime is the last time the ManagedSeedSet controller changed the number of replicas due to autoscaling.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
 				Required: []string{"replicas"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.PendingReplica"},
+			"github.com/gardener/gardener/pkg/apis/core/v1beta1.Condition", "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1.PendingReplica", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
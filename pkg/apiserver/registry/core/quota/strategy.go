@@ -38,7 +38,10 @@ func (quotaStrategy) NamespaceScoped() bool {
 	return true
 }
 
-func (quotaStrategy) PrepareForCreate(_ context.Context, _ runtime.Object) {
+func (quotaStrategy) PrepareForCreate(_ context.Context, obj runtime.Object) {
+	quota := obj.(*core.Quota)
+
+	quota.Status = core.QuotaStatus{}
 }
 
 func (quotaStrategy) Validate(_ context.Context, obj runtime.Object) field.ErrorList {
@@ -54,8 +57,9 @@ func (quotaStrategy) AllowCreateOnUpdate() bool {
 }
 
 func (quotaStrategy) PrepareForUpdate(_ context.Context, newObj, oldObj runtime.Object) {
-	_ = oldObj.(*core.Quota)
-	_ = newObj.(*core.Quota)
+	oldQuota := oldObj.(*core.Quota)
+	newQuota := newObj.(*core.Quota)
+	newQuota.Status = oldQuota.Status
 }
 
 func (quotaStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
@@ -76,3 +80,21 @@ func (quotaStrategy) WarningsOnCreate(_ context.Context, _ runtime.Object) []str
 func (quotaStrategy) WarningsOnUpdate(_ context.Context, _, _ runtime.Object) []string {
 	return nil
 }
+
+type quotaStatusStrategy struct {
+	quotaStrategy
+}
+
+// StatusStrategy defines the storage strategy for the status subresource of Quotas.
+var StatusStrategy = quotaStatusStrategy{Strategy}
+
+func (quotaStatusStrategy) PrepareForUpdate(_ context.Context, newObj, oldObj runtime.Object) {
+	oldQuota := oldObj.(*core.Quota)
+	newQuota := newObj.(*core.Quota)
+	newQuota.Spec = oldQuota.Spec
+}
+
+func (quotaStatusStrategy) ValidateUpdate(_ context.Context, newObj, oldObj runtime.Object) field.ErrorList {
+	oldQuota, newQuota := oldObj.(*core.Quota), newObj.(*core.Quota)
+	return validation.ValidateQuotaStatusUpdate(newQuota, oldQuota)
+}
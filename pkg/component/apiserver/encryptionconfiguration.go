@@ -17,11 +17,14 @@ package apiserver
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"reflect"
+	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
@@ -61,6 +64,17 @@ func init() {
 }
 
 const (
+	// KMSProviderName is the name of the KMS provider in the encryption configuration. It must not be changed since it
+	// is part of the prefix of all resources encrypted by the KMS plugin.
+	KMSProviderName = "kms"
+	// VolumeNameKMSPluginSocket is the name of the volume containing the socket of the KMS plugin. Extensions which
+	// deploy the KMS plugin as sidecar container must mount this volume.
+	VolumeNameKMSPluginSocket = "kms-plugin-socket"
+	// VolumeMountPathKMSPluginSocket is the mount path of the volume containing the socket of the KMS plugin.
+	VolumeMountPathKMSPluginSocket = "/var/run/kms-plugin"
+	// KMSPluginSocketFileName is the name of the socket file the KMS plugin must listen on.
+	KMSPluginSocketFileName = "socket.sock"
+
 	secretETCDEncryptionConfigurationDataKey = "encryption-configuration.yaml"
 
 	volumeNameEtcdEncryptionConfig      = "etcd-encryption-secret"
@@ -95,42 +109,49 @@ func ReconcileSecretETCDEncryptionConfiguration(
 	}
 
 	var (
-		keySecretOld, _         = secretsManager.Get(secretNameETCDEncryptionKey, secretsmanager.Old)
-		encryptionKeys          = etcdEncryptionAESKeys(keySecret, keySecretOld, config.EncryptWithCurrentKey)
+		keySecretOld, _ = secretsManager.Get(secretNameETCDEncryptionKey, secretsmanager.Old)
+		encryptionKeys  = etcdEncryptionAESKeys(keySecret, keySecretOld, config.EncryptWithCurrentKey)
+		aescbcProvider  = apiserverconfigv1.ProviderConfiguration{
+			AESCBC: &apiserverconfigv1.AESConfiguration{
+				Keys: encryptionKeys,
+			},
+		}
+		identityProvider = apiserverconfigv1.ProviderConfiguration{
+			Identity: &apiserverconfigv1.IdentityConfiguration{},
+		}
+		// The aescbc provider is kept even if a KMS plugin is used for encryption so that resources which were encrypted
+		// before the KMS plugin was configured can still be decrypted.
+		decryptionProviders     = []apiserverconfigv1.ProviderConfiguration{aescbcProvider}
 		encryptionConfiguration = &apiserverconfigv1.EncryptionConfiguration{
 			Resources: []apiserverconfigv1.ResourceConfiguration{
 				{
 					Resources: config.ResourcesToEncrypt,
-					Providers: []apiserverconfigv1.ProviderConfiguration{
-						{
-							AESCBC: &apiserverconfigv1.AESConfiguration{
-								Keys: encryptionKeys,
-							},
-						},
-						{
-							Identity: &apiserverconfigv1.IdentityConfiguration{},
-						},
-					},
+					Providers: []apiserverconfigv1.ProviderConfiguration{aescbcProvider, identityProvider},
 				},
 			},
 		}
 	)
 
+	if config.KMS != nil {
+		kmsProvider := apiserverconfigv1.ProviderConfiguration{
+			KMS: &apiserverconfigv1.KMSConfiguration{
+				APIVersion: "v2",
+				Name:       KMSProviderName,
+				Endpoint:   "unix://" + filepath.Join(VolumeMountPathKMSPluginSocket, KMSPluginSocketFileName),
+				Timeout:    &metav1.Duration{Duration: 3 * time.Second},
+			},
+		}
+
+		decryptionProviders = []apiserverconfigv1.ProviderConfiguration{kmsProvider, aescbcProvider}
+		encryptionConfiguration.Resources[0].Providers = []apiserverconfigv1.ProviderConfiguration{kmsProvider, aescbcProvider, identityProvider}
+	}
+
 	if !reflect.DeepEqual(config.ResourcesToEncrypt, config.EncryptedResources) {
 		removedResources := sets.New(config.EncryptedResources...).Difference(sets.New(config.ResourcesToEncrypt...))
 		if removedResources.Len() > 0 {
 			encryptionConfiguration.Resources = append(encryptionConfiguration.Resources, apiserverconfigv1.ResourceConfiguration{
 				Resources: sets.List(removedResources),
-				Providers: []apiserverconfigv1.ProviderConfiguration{
-					{
-						Identity: &apiserverconfigv1.IdentityConfiguration{},
-					},
-					{
-						AESCBC: &apiserverconfigv1.AESConfiguration{
-							Keys: encryptionKeys,
-						},
-					},
-				},
+				Providers: append([]apiserverconfigv1.ProviderConfiguration{identityProvider}, decryptionProviders...),
 			})
 		}
 	}
@@ -184,7 +205,7 @@ func aesKeyFromSecretData(data map[string][]byte) apiserverconfigv1.Key {
 }
 
 // InjectEncryptionSettings injects the encryption settings into `gardener-apiserver` and `kube-apiserver` deployments.
-func InjectEncryptionSettings(deployment *appsv1.Deployment, secretETCDEncryptionConfiguration *corev1.Secret) {
+func InjectEncryptionSettings(deployment *appsv1.Deployment, secretETCDEncryptionConfiguration *corev1.Secret, config ETCDEncryptionConfig) {
 	deployment.Spec.Template.Spec.Containers[0].Args = append(deployment.Spec.Template.Spec.Containers[0].Args, fmt.Sprintf("--encryption-provider-config=%s/%s", volumeMountPathEtcdEncryptionConfig, secretETCDEncryptionConfigurationDataKey))
 	deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(deployment.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
 		Name:      volumeNameEtcdEncryptionConfig,
@@ -200,4 +221,19 @@ func InjectEncryptionSettings(deployment *appsv1.Deployment, secretETCDEncryptio
 			},
 		},
 	})
+
+	if config.KMS != nil {
+		// The KMS plugin itself is injected as sidecar container by the extension responsible for the KMS type. It
+		// shares the socket with the API server via this volume.
+		deployment.Spec.Template.Spec.Containers[0].VolumeMounts = append(deployment.Spec.Template.Spec.Containers[0].VolumeMounts, corev1.VolumeMount{
+			Name:      VolumeNameKMSPluginSocket,
+			MountPath: VolumeMountPathKMSPluginSocket,
+		})
+		deployment.Spec.Template.Spec.Volumes = append(deployment.Spec.Template.Spec.Volumes, corev1.Volume{
+			Name: VolumeNameKMSPluginSocket,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{},
+			},
+		})
+	}
 }
@@ -26,6 +26,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	. "github.com/gardener/gardener/pkg/component/apiserver"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -114,6 +115,58 @@ resources:
 			Expect(secretList.Items[0].Labels).To(HaveKeyWithValue("persist", "true"))
 		})
 
+		It("should successfully deploy the ETCD encryption configuration secret resource with a KMS provider", func() {
+			config = ETCDEncryptionConfig{
+				ResourcesToEncrypt: []string{"foo", "bin"},
+				EncryptedResources: []string{"bar", "bin"},
+				KMS:                &gardencorev1beta1.KMSConfig{Type: "local"},
+			}
+
+			etcdEncryptionConfiguration := `apiVersion: apiserver.config.k8s.io/v1
+kind: EncryptionConfiguration
+resources:
+- providers:
+  - kms:
+      apiVersion: v2
+      endpoint: unix:///var/run/kms-plugin/socket.sock
+      name: kms
+      timeout: 3s
+  - aescbc:
+      keys:
+      - name: key-62135596800
+        secret: ________________________________
+  - identity: {}
+  resources:
+  - foo
+  - bin
+- providers:
+  - identity: {}
+  - kms:
+      apiVersion: v2
+      endpoint: unix:///var/run/kms-plugin/socket.sock
+      name: kms
+      timeout: 3s
+  - aescbc:
+      keys:
+      - name: key-62135596800
+        secret: ________________________________
+  resources:
+  - bar
+`
+
+			expectedSecretETCDEncryptionConfiguration := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "apiserver-encryption-config", Namespace: namespace},
+				Data:       map[string][]byte{"encryption-configuration.yaml": []byte(etcdEncryptionConfiguration)},
+			}
+			Expect(kubernetesutils.MakeUnique(expectedSecretETCDEncryptionConfiguration)).To(Succeed())
+
+			actualSecretETCDEncryptionConfiguration := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "apiserver-encryption-config", Namespace: namespace}}
+			Expect(ReconcileSecretETCDEncryptionConfiguration(ctx, fakeClient, fakeSecretManager, config, actualSecretETCDEncryptionConfiguration, secretNameETCDEncryptionKey, encryptionRoleLabel)).To(Succeed())
+
+			Expect(actualSecretETCDEncryptionConfiguration.Name).To(Equal(expectedSecretETCDEncryptionConfiguration.Name))
+			Expect(string(actualSecretETCDEncryptionConfiguration.Data["encryption-configuration.yaml"])).To(Equal(etcdEncryptionConfiguration))
+		})
+
 		It("should successfully deploy the ETCD encryption configuration secret resource with the right config when resources are removed from encryption", func() {
 			config = ETCDEncryptionConfig{ResourcesToEncrypt: []string{"foo", "bin"}, EncryptedResources: []string{"bar", "bin"}}
 
@@ -274,7 +327,7 @@ resources:
 
 			secretETCDEncryptionConfiguration := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "etcd-enc-config"}}
 
-			InjectEncryptionSettings(deployment, secretETCDEncryptionConfiguration)
+			InjectEncryptionSettings(deployment, secretETCDEncryptionConfiguration, config)
 
 			Expect(deployment).To(Equal(&appsv1.Deployment{
 				Spec: appsv1.DeploymentSpec{
@@ -304,5 +357,24 @@ resources:
 				},
 			}))
 		})
+
+		It("should inject the KMS plugin socket volume if KMS is configured", func() {
+			deployment := &appsv1.Deployment{}
+			deployment.Spec.Template.Spec.Containers = append(deployment.Spec.Template.Spec.Containers, corev1.Container{})
+
+			config.KMS = &gardencorev1beta1.KMSConfig{Type: "local"}
+			InjectEncryptionSettings(deployment, &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "etcd-enc-config"}}, config)
+
+			Expect(deployment.Spec.Template.Spec.Containers[0].VolumeMounts).To(ContainElement(corev1.VolumeMount{
+				Name:      "kms-plugin-socket",
+				MountPath: "/var/run/kms-plugin",
+			}))
+			Expect(deployment.Spec.Template.Spec.Volumes).To(ContainElement(corev1.Volume{
+				Name: "kms-plugin-socket",
+				VolumeSource: corev1.VolumeSource{
+					EmptyDir: &corev1.EmptyDirVolumeSource{},
+				},
+			}))
+		})
 	})
 })
@@ -118,4 +118,7 @@ type ETCDEncryptionConfig struct {
 	ResourcesToEncrypt []string
 	// EncryptedResources are the resources which are currently encrypted.
 	EncryptedResources []string
+	// KMS contains the configuration of the KMS v2 plugin used for the encryption of resources. If it is nil, the
+	// resources are encrypted with the aescbc provider only.
+	KMS *gardencorev1beta1.KMSConfig
 }
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"html/template"
 
+	"github.com/containerd/containerd/reference/docker"
 	"k8s.io/utils/ptr"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -47,6 +48,10 @@ func Config(
 	[]extensionsv1alpha1.File,
 	error,
 ) {
+	if err := verifyNodeAgentImage(nodeAgentImage, config.Controllers.OperatingSystemConfig.ImageVerification); err != nil {
+		return nil, nil, err
+	}
+
 	initScript, err := generateInitScript(nodeAgentImage)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed generating init script: %w", err)
@@ -120,6 +125,26 @@ WantedBy=multi-user.target`),
 	return nodeInitUnits, nodeInitFiles, nil
 }
 
+// verifyNodeAgentImage checks that the gardener-node-agent image used for bootstrapping is pinned by digest if image
+// verification is configured. Signatures cannot be verified on the node before gardener-node-agent runs, hence the
+// digest from the image vector is the trust anchor: containerd rejects pulled content which does not match it.
+func verifyNodeAgentImage(nodeAgentImage string, imageVerification *nodeagentv1alpha1.ImageVerificationConfig) error {
+	if imageVerification == nil || (!imageVerification.RequireDigest && len(imageVerification.PublicKeys) == 0) {
+		return nil
+	}
+
+	named, err := docker.ParseDockerRef(nodeAgentImage)
+	if err != nil {
+		return fmt.Errorf("failed parsing gardener-node-agent image %q: %w", nodeAgentImage, err)
+	}
+
+	if _, ok := named.(docker.Digested); !ok {
+		return fmt.Errorf("image verification is configured but gardener-node-agent image %q used for bootstrapping is not pinned by digest", nodeAgentImage)
+	}
+
+	return nil
+}
+
 var (
 	//go:embed templates/scripts/init.tpl.sh
 	initScriptTplContent string
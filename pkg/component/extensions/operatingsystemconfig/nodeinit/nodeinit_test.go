@@ -16,6 +16,7 @@ package nodeinit_test
 
 import (
 	"context"
+	"strings"
 	"time"
 	"unicode/utf8"
 
@@ -50,7 +51,7 @@ var _ = Describe("Init", func() {
 
 		BeforeEach(func() {
 			worker = gardencorev1beta1.Worker{}
-			config = nodeagentcomponent.ComponentConfig(oscSecretName, kubernetesVersion, apiServerURL, caBundle, oscSyncJitterPeriod, nil)
+			config = nodeagentcomponent.ComponentConfig(oscSecretName, kubernetesVersion, apiServerURL, caBundle, oscSyncJitterPeriod, nil, nil, nil, nil)
 		})
 
 		When("kubelet data volume is not configured", func() {
@@ -222,5 +223,60 @@ server: {}
 				Expect(utf8.RuneCountInString(writeFilesToDiskScript + writeUnitsToDiskScript)).To(BeNumerically("<", 4096))
 			})
 		})
+
+		When("image verification is configured", func() {
+			BeforeEach(func() {
+				config = nodeagentcomponent.ComponentConfig(oscSecretName, kubernetesVersion, apiServerURL, caBundle, oscSyncJitterPeriod, nil, nil, &nodeagentv1alpha1.ImageVerificationConfig{
+					RequireDigest: true,
+					PublicKeys:    []string{"public-key"},
+				}, nil)
+			})
+
+			It("should return an error when the gardener-node-agent image is not pinned by digest", func() {
+				units, files, err := Config(worker, image, config)
+				Expect(err).To(MatchError(ContainSubstring("used for bootstrapping is not pinned by digest")))
+				Expect(units).To(BeNil())
+				Expect(files).To(BeNil())
+			})
+
+			It("should render the image verification policy into the configuration", func() {
+				_, files, err := Config(worker, "gna-repo@sha256:"+strings.Repeat("a", 64), config)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(files).To(ContainElement(extensionsv1alpha1.File{
+					Path:        "/var/lib/gardener-node-agent/config.yaml",
+					Permissions: ptr.To(int32(0600)),
+					Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Encoding: "b64", Data: utils.EncodeBase64([]byte(`apiServer:
+  caBundle: ` + utils.EncodeBase64(caBundle) + `
+  server: ` + apiServerURL + `
+apiVersion: nodeagent.config.gardener.cloud/v1alpha1
+bootstrap: {}
+clientConnection:
+  acceptContentTypes: ""
+  burst: 0
+  contentType: ""
+  kubeconfig: ""
+  qps: 0
+controllers:
+  operatingSystemConfig:
+    imageVerification:
+      publicKeys:
+      - public-key
+      requireDigest: true
+    kubernetesVersion: ` + kubernetesVersion.String() + `
+    secretName: ` + oscSecretName + `
+    syncJitterPeriod: ` + oscSyncJitterPeriod.Duration.String() + `
+  token:
+    syncConfigs:
+    - path: /var/lib/gardener-node-agent/credentials/token
+      secretName: gardener-node-agent
+    syncPeriod: 12h0m0s
+kind: NodeAgentConfiguration
+logFormat: ""
+logLevel: ""
+server: {}
+`))}},
+				}))
+			})
+		})
 	})
 })
@@ -17,14 +17,17 @@ package operatingsystemconfig
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/containerd/containerd/remotes/docker"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -46,6 +49,7 @@ import (
 	"github.com/gardener/gardener/pkg/extensions"
 	"github.com/gardener/gardener/pkg/features"
 	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/registry/verification"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
@@ -138,6 +142,12 @@ type OriginalValues struct {
 	SyncJitterPeriod *metav1.Duration
 	// PrimaryIPFamily represents the preferred IP family (IPv4 or IPv6) to be used.
 	PrimaryIPFamily gardencorev1beta1.IPFamily
+	// CoordinatedRolloutEnabled states whether disruptive changes shall be rolled out in a coordinated way across the
+	// nodes of all worker pools. Worker pools with the in-place update strategy are always updated in a coordinated way.
+	CoordinatedRolloutEnabled bool
+	// ImageVerification is the policy for verifying the images from which gardener-node-agent extracts files, including
+	// its own image used for bootstrapping.
+	ImageVerification *nodeagentv1alpha1.ImageVerificationConfig
 }
 
 // New creates a new instance of Interface.
@@ -243,8 +253,13 @@ func (o *operatingSystemConfig) reconcile(ctx context.Context, reconcileFn func(
 		}
 	}
 
+	nodeAgentImage, err := o.resolveNodeAgentImage(ctx)
+	if err != nil {
+		return err
+	}
+
 	fns := o.forEachWorkerPoolAndPurposeTaskFn(func(_ context.Context, osc *extensionsv1alpha1.OperatingSystemConfig, worker gardencorev1beta1.Worker, purpose extensionsv1alpha1.OperatingSystemConfigPurpose) error {
-		d, err := o.newDeployer(osc, worker, purpose)
+		d, err := o.newDeployer(osc, worker, purpose, nodeAgentImage)
 		if err != nil {
 			return err
 		}
@@ -255,6 +270,38 @@ func (o *operatingSystemConfig) reconcile(ctx context.Context, reconcileFn func(
 	return flow.Parallel(fns...)(ctx)
 }
 
+// resolveNodeAgentImage resolves the gardener-node-agent image to a reference pinned by digest if image verification
+// is configured. Its signatures cannot be verified on the node before gardener-node-agent runs, hence they are
+// verified here, and the nodes pull exactly the verified digest. It returns nil if the image does not need to be
+// resolved.
+func (o *operatingSystemConfig) resolveNodeAgentImage(ctx context.Context) (*imagevectorutils.Image, error) {
+	image, ok := o.values.Images[imagevector.ImageNameGardenerNodeAgent]
+	if !ok || !features.DefaultFeatureGate.Enabled(features.UseGardenerNodeAgent) ||
+		o.values.ImageVerification == nil || (!o.values.ImageVerification.RequireDigest && len(o.values.ImageVerification.PublicKeys) == 0) {
+		return nil, nil
+	}
+
+	publicKeys, err := verification.ParsePublicKeys(o.values.ImageVerification.PublicKeys)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing public keys for image verification: %w", err)
+	}
+
+	pinnedRef, err := VerifyImageFn(ctx, docker.NewResolver(docker.ResolverOptions{}), image.String(), &verification.Policy{PublicKeys: publicKeys})
+	if err != nil {
+		return nil, fmt.Errorf("failed resolving gardener-node-agent image %q: %w", image.String(), err)
+	}
+
+	repository, digest, ok := strings.Cut(pinnedRef, "@")
+	if !ok {
+		return nil, fmt.Errorf("resolved gardener-node-agent image %q is not pinned by digest", pinnedRef)
+	}
+
+	pinnedImage := *image
+	pinnedImage.Repository = repository
+	pinnedImage.Tag = &digest
+	return &pinnedImage, nil
+}
+
 // Wait waits until the OperatingSystemConfig CRD is ready (deployed or restored). It also reads the produced secret
 // containing the cloud-config and stores its data which can later be retrieved with the WorkerNameToOperatingSystemConfigsMap
 // method.
@@ -405,7 +452,7 @@ func (o *operatingSystemConfig) getWantedOSCNames() (sets.Set[string], error) {
 			if err != nil {
 				return nil, err
 			}
-			wantedOSCNames.Insert(Key(worker.Name, kubernetesVersion, worker.CRI) + keySuffix(worker.Machine.Image.Name, purpose))
+			wantedOSCNames.Insert(Key(worker.Name, kubernetesVersion, worker.CRI, worker.UpdateStrategy) + keySuffix(worker.Machine.Image.Name, purpose))
 		}
 	}
 
@@ -426,7 +473,7 @@ func (o *operatingSystemConfig) forEachWorkerPoolAndPurpose(fn func(*extensionsv
 			if err != nil {
 				return err
 			}
-			oscName := Key(worker.Name, kubernetesVersion, worker.CRI) + keySuffix(worker.Machine.Image.Name, purpose)
+			oscName := Key(worker.Name, kubernetesVersion, worker.CRI, worker.UpdateStrategy) + keySuffix(worker.Machine.Image.Name, purpose)
 
 			osc, ok := o.oscs[oscName]
 			if !ok {
@@ -483,7 +530,7 @@ func (o *operatingSystemConfig) WorkerNameToOperatingSystemConfigsMap() map[stri
 	return o.workerNameToOSCs
 }
 
-func (o *operatingSystemConfig) newDeployer(osc *extensionsv1alpha1.OperatingSystemConfig, worker gardencorev1beta1.Worker, purpose extensionsv1alpha1.OperatingSystemConfigPurpose) (deployer, error) {
+func (o *operatingSystemConfig) newDeployer(osc *extensionsv1alpha1.OperatingSystemConfig, worker gardencorev1beta1.Worker, purpose extensionsv1alpha1.OperatingSystemConfigPurpose, nodeAgentImage *imagevectorutils.Image) (deployer, error) {
 	criName := extensionsv1alpha1.CRINameContainerD
 	if worker.CRI != nil {
 		criName = extensionsv1alpha1.CRIName(worker.CRI.Name)
@@ -525,6 +572,9 @@ func (o *operatingSystemConfig) newDeployer(osc *extensionsv1alpha1.OperatingSys
 	for imageName, image := range o.values.Images {
 		images[imageName] = image
 	}
+	if nodeAgentImage != nil {
+		images[imagevector.ImageNameGardenerNodeAgent] = nodeAgentImage
+	}
 
 	if features.DefaultFeatureGate.Enabled(features.UseGardenerNodeAgent) {
 		images[imagevector.ImageNameHyperkube], err = imagevector.ImageVector().FindImage(imagevector.ImageNameHyperkube, imagevectorutils.RuntimeVersion(kubernetesVersion.String()), imagevectorutils.TargetVersion(kubernetesVersion.String()))
@@ -538,7 +588,7 @@ func (o *operatingSystemConfig) newDeployer(osc *extensionsv1alpha1.OperatingSys
 		osc:                     osc,
 		worker:                  worker,
 		purpose:                 purpose,
-		key:                     Key(worker.Name, kubernetesVersion, worker.CRI),
+		key:                     Key(worker.Name, kubernetesVersion, worker.CRI, worker.UpdateStrategy),
 		apiServerURL:            o.values.APIServerURL,
 		caBundle:                caBundle,
 		clusterCASecretName:     clusterCASecret.Name,
@@ -559,6 +609,8 @@ func (o *operatingSystemConfig) newDeployer(osc *extensionsv1alpha1.OperatingSys
 		nodeLocalDNSEnabled:     o.values.NodeLocalDNSEnabled,
 		oscSyncJitterPeriod:     o.values.SyncJitterPeriod,
 		primaryIPFamily:         o.values.PrimaryIPFamily,
+		coordinatedRollout:      o.values.CoordinatedRolloutEnabled,
+		imageVerification:       o.values.ImageVerification,
 	}, nil
 }
 
@@ -623,6 +675,8 @@ type deployer struct {
 	nodeLocalDNSEnabled     bool
 	oscSyncJitterPeriod     *metav1.Duration
 	primaryIPFamily         gardencorev1beta1.IPFamily
+	coordinatedRollout      bool
+	imageVerification       *nodeagentv1alpha1.ImageVerificationConfig
 }
 
 // exposed for testing
@@ -631,10 +685,31 @@ var (
 	DownloaderConfigFn = downloader.Config
 	// InitConfigFn is a function for computing the gardener-node-init units and files.
 	InitConfigFn = nodeinit.Config
+	// VerifyImageFn is a function for verifying an image and resolving it to a reference pinned by digest.
+	VerifyImageFn = verification.VerifyImage
 	// OriginalConfigFn is a function for computing the downloaded cloud config user data units and files.
 	OriginalConfigFn = original.Config
 )
 
+// rolloutSettings returns the settings for coordinating the rollout of disruptive changes across the nodes of the worker
+// pool. The coordination is opt-in and only takes place if it was enabled explicitly. Worker pools with the in-place
+// update strategy are always updated one node at a time, and each node is drained before the changes are applied.
+func (d *deployer) rolloutSettings() (*intstr.IntOrString, *nodeagentv1alpha1.OperatingSystemConfigDrainConfig) {
+	if !v1beta1helper.IsUpdateStrategyInPlace(d.worker.UpdateStrategy) {
+		if !d.coordinatedRollout || d.worker.MaxUnavailable == nil {
+			return nil, nil
+		}
+		return d.worker.MaxUnavailable, nil
+	}
+
+	drain := &nodeagentv1alpha1.OperatingSystemConfigDrainConfig{}
+	if d.worker.MachineControllerManagerSettings != nil {
+		drain.Timeout = d.worker.MachineControllerManagerSettings.MachineDrainTimeout
+	}
+
+	return ptr.To(intstr.FromInt32(1)), drain
+}
+
 func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1alpha1.Object, error) {
 	var (
 		units []extensionsv1alpha1.Unit
@@ -645,6 +720,8 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 		err       error
 	)
 
+	maxUnavailable, drain := d.rolloutSettings()
+
 	componentsContext := components.Context{
 		Key:                     d.key,
 		CABundle:                d.caBundle,
@@ -666,13 +743,16 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 		Sysctls:                 d.worker.Sysctls,
 		OSCSyncJitterPeriod:     d.oscSyncJitterPeriod,
 		PreferIPv6:              d.primaryIPFamily == gardencorev1beta1.IPFamilyIPv6,
+		MaxUnavailable:          maxUnavailable,
+		Drain:                   drain,
+		ImageVerification:       d.imageVerification,
 	}
 
 	if features.DefaultFeatureGate.Enabled(features.UseGardenerNodeAgent) {
 		initUnits, initFiles, err = InitConfigFn(
 			d.worker,
 			d.images[imagevector.ImageNameGardenerNodeAgent].String(),
-			nodeagent.ComponentConfig(d.key, d.kubernetesVersion, d.apiServerURL, d.clusterCABundle, d.oscSyncJitterPeriod, nil),
+			nodeagent.ComponentConfig(d.key, d.kubernetesVersion, d.apiServerURL, d.clusterCABundle, d.oscSyncJitterPeriod, maxUnavailable, drain, d.imageVerification, nil),
 		)
 		if err != nil {
 			return nil, err
@@ -801,11 +881,12 @@ func (d *deployer) deploy(ctx context.Context, operation string) (extensionsv1al
 	return d.osc, err
 }
 
-// Key returns the key that can be used as secret name based on the provided worker name, Kubernetes version and CRI
-// configuration.
-func Key(workerName string, kubernetesVersion *semver.Version, criConfig *gardencorev1beta1.CRI) string {
+// Key returns the key that can be used as secret name based on the provided worker name, Kubernetes version, CRI
+// configuration and update strategy. For worker pools which are updated in-place, the key does not depend on the
+// Kubernetes version since the nodes are not replaced and must keep using the same secret.
+func Key(workerName string, kubernetesVersion *semver.Version, criConfig *gardencorev1beta1.CRI, updateStrategy *gardencorev1beta1.MachineUpdateStrategy) string {
 	if features.DefaultFeatureGate.Enabled(features.UseGardenerNodeAgent) {
-		return key("gardener-node-agent", workerName, kubernetesVersion, criConfig)
+		return key("gardener-node-agent", workerName, kubernetesVersion, criConfig, v1beta1helper.IsUpdateStrategyInPlace(updateStrategy))
 	}
 	return LegacyKey(workerName, kubernetesVersion, criConfig)
 }
@@ -814,10 +895,10 @@ func Key(workerName string, kubernetesVersion *semver.Version, criConfig *garden
 // version and CRI configuration.
 // TODO(rfranzke): Remove this function when UseGardenerNodeAgent feature gate gets removed.
 func LegacyKey(workerName string, kubernetesVersion *semver.Version, criConfig *gardencorev1beta1.CRI) string {
-	return key("cloud-config", workerName, kubernetesVersion, criConfig)
+	return key("cloud-config", workerName, kubernetesVersion, criConfig, false)
 }
 
-func key(prefix string, workerName string, kubernetesVersion *semver.Version, criConfig *gardencorev1beta1.CRI) string {
+func key(prefix string, workerName string, kubernetesVersion *semver.Version, criConfig *gardencorev1beta1.CRI, inPlaceUpdate bool) string {
 	if kubernetesVersion == nil {
 		return ""
 	}
@@ -827,6 +908,10 @@ func key(prefix string, workerName string, kubernetesVersion *semver.Version, cr
 		criName                     gardencorev1beta1.CRIName
 	)
 
+	if inPlaceUpdate {
+		kubernetesMajorMinorVersion = ""
+	}
+
 	if criConfig != nil {
 		criName = criConfig.Name
 	}
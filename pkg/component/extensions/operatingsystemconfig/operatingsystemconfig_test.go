@@ -19,9 +19,11 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
+	"github.com/containerd/containerd/remotes"
 	"github.com/go-logr/logr"
 	"github.com/hashicorp/go-multierror"
 	. "github.com/onsi/ginkgo/v2"
@@ -33,6 +35,8 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
 	kubernetesfake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -47,6 +51,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/sshdensurer"
 	"github.com/gardener/gardener/pkg/extensions"
 	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/registry/verification"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
@@ -205,7 +210,7 @@ var _ = Describe("OperatingSystemConfig", func() {
 					k8sVersion = semver.MustParse(*worker.Kubernetes.Version)
 				}
 
-				key := Key(worker.Name, k8sVersion, worker.CRI)
+				key := Key(worker.Name, k8sVersion, worker.CRI, worker.UpdateStrategy)
 
 				imagesCopy := make(map[string]*imagevector.Image, len(images))
 				for imageName, image := range images {
@@ -517,6 +522,164 @@ var _ = Describe("OperatingSystemConfig", func() {
 					Expect(actual).To(Equal(obj))
 				}
 			})
+			It("should configure the rollout for worker pools with the in-place update strategy", func() {
+				inPlaceWorker := workers[0]
+				inPlaceWorker.UpdateStrategy = ptr.To(gardencorev1beta1.AutoInPlaceUpdate)
+				inPlaceWorker.MachineControllerManagerSettings = &gardencorev1beta1.MachineControllerManagerSettings{
+					MachineDrainTimeout: &metav1.Duration{Duration: 5 * time.Minute},
+				}
+				values.Workers = []gardencorev1beta1.Worker{inPlaceWorker, workers[1]}
+
+				var (
+					mutex   sync.Mutex
+					configs = map[string]*nodeagentv1alpha1.NodeAgentConfiguration{}
+				)
+
+				DeferCleanup(test.WithVars(
+					&TimeNow, mockNow.Do,
+					&InitConfigFn, func(worker gardencorev1beta1.Worker, nodeAgentImage string, config *nodeagentv1alpha1.NodeAgentConfiguration) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+						mutex.Lock()
+						defer mutex.Unlock()
+						configs[worker.Name] = config
+						return initConfigFn(worker, nodeAgentImage, config)
+					},
+					&OriginalConfigFn, originalConfigFn,
+				))
+
+				mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+				Expect(New(log, c, sm, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond).Deploy(ctx)).To(Succeed())
+
+				Expect(configs[worker1Name].Controllers.OperatingSystemConfig.Rollout).To(Equal(&nodeagentv1alpha1.OperatingSystemConfigRolloutConfig{
+					MaxUnavailable: intstr.FromInt32(1),
+					Drain:          &nodeagentv1alpha1.OperatingSystemConfigDrainConfig{Timeout: &metav1.Duration{Duration: 5 * time.Minute}},
+				}))
+				Expect(configs[worker2Name].Controllers.OperatingSystemConfig.Rollout).To(BeNil())
+			})
+
+			It("should only configure the rollout for worker pools with the rolling update strategy if it is enabled", func() {
+				worker := workers[1]
+				worker.MaxUnavailable = ptr.To(intstr.FromString("25%"))
+				values.Workers = []gardencorev1beta1.Worker{workers[0], worker}
+
+				var (
+					mutex   sync.Mutex
+					configs = map[string]*nodeagentv1alpha1.NodeAgentConfiguration{}
+				)
+
+				DeferCleanup(test.WithVars(
+					&TimeNow, mockNow.Do,
+					&InitConfigFn, func(worker gardencorev1beta1.Worker, nodeAgentImage string, config *nodeagentv1alpha1.NodeAgentConfiguration) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+						mutex.Lock()
+						defer mutex.Unlock()
+						configs[worker.Name] = config
+						return initConfigFn(worker, nodeAgentImage, config)
+					},
+					&OriginalConfigFn, originalConfigFn,
+				))
+
+				mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+				By("Deploy without coordinated rollout")
+				Expect(New(log, c, sm, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond).Deploy(ctx)).To(Succeed())
+				Expect(configs[worker2Name].Controllers.OperatingSystemConfig.Rollout).To(BeNil())
+
+				By("Deploy with coordinated rollout")
+				values.CoordinatedRolloutEnabled = true
+				Expect(New(log, c, sm, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond).Deploy(ctx)).To(Succeed())
+				Expect(configs[worker1Name].Controllers.OperatingSystemConfig.Rollout).To(BeNil())
+				Expect(configs[worker2Name].Controllers.OperatingSystemConfig.Rollout).To(Equal(&nodeagentv1alpha1.OperatingSystemConfigRolloutConfig{
+					MaxUnavailable: intstr.FromString("25%"),
+				}))
+			})
+
+			It("should pass the image verification policy to gardener-node-agent", func() {
+				imageVerification := &nodeagentv1alpha1.ImageVerificationConfig{RequireDigest: true}
+				values.ImageVerification = imageVerification
+
+				var (
+					mutex          sync.Mutex
+					initConfigs    = map[string]*nodeagentv1alpha1.NodeAgentConfiguration{}
+					originalValues = map[string]*nodeagentv1alpha1.ImageVerificationConfig{}
+				)
+
+				DeferCleanup(test.WithVars(
+					&TimeNow, mockNow.Do,
+					&VerifyImageFn, func(_ context.Context, _ remotes.Resolver, _ string, _ *verification.Policy) (string, error) {
+						return "gardener-node-agent@sha256:" + strings.Repeat("0", 64), nil
+					},
+					&InitConfigFn, func(worker gardencorev1beta1.Worker, nodeAgentImage string, config *nodeagentv1alpha1.NodeAgentConfiguration) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+						mutex.Lock()
+						defer mutex.Unlock()
+						initConfigs[worker.Name] = config
+						return initConfigFn(worker, nodeAgentImage, config)
+					},
+					&OriginalConfigFn, func(cctx components.Context) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+						mutex.Lock()
+						defer mutex.Unlock()
+						originalValues[cctx.Key] = cctx.ImageVerification
+						return originalConfigFn(cctx)
+					},
+				))
+
+				mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+				Expect(New(log, c, sm, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond).Deploy(ctx)).To(Succeed())
+
+				for _, worker := range values.Workers {
+					Expect(initConfigs[worker.Name].Controllers.OperatingSystemConfig.ImageVerification).To(Equal(imageVerification))
+				}
+				Expect(originalValues).To(HaveLen(len(values.Workers)))
+				for _, verification := range originalValues {
+					Expect(verification).To(Equal(imageVerification))
+				}
+			})
+
+			It("should pin the gardener-node-agent image by digest if image verification is configured", func() {
+				values.ImageVerification = &nodeagentv1alpha1.ImageVerificationConfig{RequireDigest: true}
+				values.Images = map[string]*imagevector.Image{"gardener-node-agent": {Repository: "example.com/gardener-node-agent", Tag: ptr.To("v1.91.0")}}
+
+				var (
+					mutex           sync.Mutex
+					resolvedRef     string
+					nodeAgentImages = sets.New[string]()
+					digest          = "sha256:" + strings.Repeat("1", 64)
+				)
+
+				DeferCleanup(test.WithVars(
+					&TimeNow, mockNow.Do,
+					&VerifyImageFn, func(_ context.Context, _ remotes.Resolver, imageRef string, policy *verification.Policy) (string, error) {
+						Expect(policy.RequireDigest).To(BeFalse())
+						resolvedRef = imageRef
+						return "example.com/gardener-node-agent@" + digest, nil
+					},
+					&InitConfigFn, func(worker gardencorev1beta1.Worker, nodeAgentImage string, config *nodeagentv1alpha1.NodeAgentConfiguration) ([]extensionsv1alpha1.Unit, []extensionsv1alpha1.File, error) {
+						mutex.Lock()
+						defer mutex.Unlock()
+						nodeAgentImages.Insert(nodeAgentImage)
+						return initConfigFn(worker, nodeAgentImage, config)
+					},
+				))
+
+				mockNow.EXPECT().Do().Return(now.UTC()).AnyTimes()
+
+				Expect(New(log, c, sm, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond).Deploy(ctx)).To(Succeed())
+
+				Expect(resolvedRef).To(Equal("example.com/gardener-node-agent:v1.91.0"))
+				Expect(sets.List(nodeAgentImages)).To(ConsistOf("example.com/gardener-node-agent@" + digest))
+			})
+
+			It("should fail if the gardener-node-agent image cannot be verified", func() {
+				values.ImageVerification = &nodeagentv1alpha1.ImageVerificationConfig{RequireDigest: true}
+
+				DeferCleanup(test.WithVar(
+					&VerifyImageFn, func(_ context.Context, _ remotes.Resolver, _ string, _ *verification.Policy) (string, error) {
+						return "", fmt.Errorf("fake")
+					},
+				))
+
+				Expect(New(log, c, sm, values, time.Millisecond, 250*time.Millisecond, 500*time.Millisecond).Deploy(ctx)).To(MatchError(ContainSubstring("failed resolving gardener-node-agent image")))
+			})
 		})
 
 		Describe("#Restore", func() {
@@ -533,7 +696,7 @@ var _ = Describe("OperatingSystemConfig", func() {
 					if worker.Kubernetes != nil && worker.Kubernetes.Version != nil {
 						k8sVersion = semver.MustParse(*worker.Kubernetes.Version)
 					}
-					key := Key(worker.Name, k8sVersion, worker.CRI)
+					key := Key(worker.Name, k8sVersion, worker.CRI, worker.UpdateStrategy)
 
 					extensions = append(extensions,
 						gardencorev1beta1.ExtensionResourceState{
@@ -1050,18 +1213,27 @@ var _ = Describe("OperatingSystemConfig", func() {
 		)
 
 		It("should return an empty string", func() {
-			Expect(Key(workerName, nil, nil)).To(BeEmpty())
+			Expect(Key(workerName, nil, nil, nil)).To(BeEmpty())
 		})
 
 		It("should return the expected key", func() {
-			Expect(Key(workerName, semver.MustParse(kubernetesVersion), nil)).To(Equal("gardener-node-agent-" + workerName + "-77ac3"))
+			Expect(Key(workerName, semver.MustParse(kubernetesVersion), nil, nil)).To(Equal("gardener-node-agent-" + workerName + "-77ac3"))
 		})
 
 		It("is different for different worker.cri configurations", func() {
-			containerDKey := Key(workerName, semver.MustParse("1.2.3"), &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD})
-			otherKey := Key(workerName, semver.MustParse("1.2.3"), &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRIName("other")})
+			containerDKey := Key(workerName, semver.MustParse("1.2.3"), &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRINameContainerD}, nil)
+			otherKey := Key(workerName, semver.MustParse("1.2.3"), &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRIName("other")}, nil)
 			Expect(containerDKey).NotTo(Equal(otherKey))
 		})
+
+		It("is different for different Kubernetes minor versions", func() {
+			Expect(Key(workerName, semver.MustParse("1.2.3"), nil, nil)).NotTo(Equal(Key(workerName, semver.MustParse("1.3.0"), nil, nil)))
+		})
+
+		It("is the same for different Kubernetes minor versions if the worker pool is updated in-place", func() {
+			updateStrategy := ptr.To(gardencorev1beta1.AutoInPlaceUpdate)
+			Expect(Key(workerName, semver.MustParse("1.2.3"), nil, updateStrategy)).To(Equal(Key(workerName, semver.MustParse("1.3.0"), nil, updateStrategy)))
+		})
 	})
 
 	Describe("#LegacyKey", func() {
@@ -17,8 +17,10 @@ package components
 import (
 	"github.com/Masterminds/semver/v3"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 )
 
@@ -52,4 +54,7 @@ type Context struct {
 	Sysctls                 map[string]string
 	OSCSyncJitterPeriod     *metav1.Duration
 	PreferIPv6              bool
+	MaxUnavailable          *intstr.IntOrString
+	Drain                   *nodeagentv1alpha1.OperatingSystemConfigDrainConfig
+	ImageVerification       *nodeagentv1alpha1.ImageVerificationConfig
 }
@@ -24,6 +24,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/utils/ptr"
 
@@ -78,7 +79,7 @@ func (component) Config(ctx components.Context) ([]extensionsv1alpha1.Unit, []ex
 		})
 	}
 
-	files, err := Files(ComponentConfig(ctx.Key, ctx.KubernetesVersion, ctx.APIServerURL, caBundle, ctx.OSCSyncJitterPeriod, additionalTokenSyncConfigs))
+	files, err := Files(ComponentConfig(ctx.Key, ctx.KubernetesVersion, ctx.APIServerURL, caBundle, ctx.OSCSyncJitterPeriod, ctx.MaxUnavailable, ctx.Drain, ctx.ImageVerification, additionalTokenSyncConfigs))
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed generating files: %w", err)
 	}
@@ -127,8 +128,16 @@ func ComponentConfig(
 	apiServerURL string,
 	caBundle []byte,
 	syncJitterPeriod *metav1.Duration,
+	maxUnavailable *intstr.IntOrString,
+	drain *nodeagentv1alpha1.OperatingSystemConfigDrainConfig,
+	imageVerification *nodeagentv1alpha1.ImageVerificationConfig,
 	additionalTokenSyncConfigs []nodeagentv1alpha1.TokenSecretSyncConfig,
 ) *nodeagentv1alpha1.NodeAgentConfiguration {
+	var rollout *nodeagentv1alpha1.OperatingSystemConfigRolloutConfig
+	if maxUnavailable != nil {
+		rollout = &nodeagentv1alpha1.OperatingSystemConfigRolloutConfig{MaxUnavailable: *maxUnavailable, Drain: drain}
+	}
+
 	return &nodeagentv1alpha1.NodeAgentConfiguration{
 		APIServer: nodeagentv1alpha1.APIServer{
 			Server:   apiServerURL,
@@ -139,6 +148,8 @@ func ComponentConfig(
 				SecretName:        oscSecretName,
 				KubernetesVersion: kubernetesVersion,
 				SyncJitterPeriod:  syncJitterPeriod,
+				Rollout:           rollout,
+				ImageVerification: imageVerification,
 			},
 			Token: nodeagentv1alpha1.TokenControllerConfig{
 				SyncConfigs: append([]nodeagentv1alpha1.TokenSecretSyncConfig{{
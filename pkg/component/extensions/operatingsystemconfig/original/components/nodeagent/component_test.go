@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
@@ -38,6 +39,9 @@ var _ = Describe("Component", func() {
 		apiServerURL               = "https://localhost"
 		caBundle                   = []byte("ca-bundle")
 		syncJitterPeriod           = &metav1.Duration{Duration: time.Second}
+		maxUnavailable             = ptr.To(intstr.FromInt32(2))
+		drain                      = &nodeagentv1alpha1.OperatingSystemConfigDrainConfig{Timeout: &metav1.Duration{Duration: 5 * time.Minute}}
+		imageVerification          = &nodeagentv1alpha1.ImageVerificationConfig{RequireDigest: true, PublicKeys: []string{"public-key"}}
 		additionalTokenSyncConfigs = []nodeagentv1alpha1.TokenSecretSyncConfig{{
 			SecretName: "gardener-valitail",
 			Path:       "/var/lib/valitail/auth-token",
@@ -54,7 +58,7 @@ var _ = Describe("Component", func() {
 		It("should return the expected units and files", func() {
 			key := "key"
 
-			expectedFiles, err := Files(ComponentConfig(key, kubernetesVersion, apiServerURL, caBundle, syncJitterPeriod, nil))
+			expectedFiles, err := Files(ComponentConfig(key, kubernetesVersion, apiServerURL, caBundle, syncJitterPeriod, maxUnavailable, drain, imageVerification, nil))
 			Expect(err).NotTo(HaveOccurred())
 
 			units, files, err := component.Config(components.Context{
@@ -64,6 +68,9 @@ var _ = Describe("Component", func() {
 				CABundle:            ptr.To(string(caBundle)),
 				Images:              map[string]*imagevectorutils.Image{"gardener-node-agent": {Repository: "gardener-node-agent", Tag: ptr.To("v1")}},
 				OSCSyncJitterPeriod: syncJitterPeriod,
+				MaxUnavailable:      maxUnavailable,
+				Drain:               drain,
+				ImageVerification:   imageVerification,
 			})
 
 			expectedFiles = append(expectedFiles, extensionsv1alpha1.File{
@@ -120,7 +127,7 @@ WantedBy=multi-user.target`))
 
 	Describe("#ComponentConfig", func() {
 		It("should return the expected result", func() {
-			Expect(ComponentConfig(oscSecretName, kubernetesVersion, apiServerURL, caBundle, syncJitterPeriod, additionalTokenSyncConfigs)).To(Equal(&nodeagentv1alpha1.NodeAgentConfiguration{
+			Expect(ComponentConfig(oscSecretName, kubernetesVersion, apiServerURL, caBundle, syncJitterPeriod, maxUnavailable, drain, imageVerification, additionalTokenSyncConfigs)).To(Equal(&nodeagentv1alpha1.NodeAgentConfiguration{
 				APIServer: nodeagentv1alpha1.APIServer{
 					Server:   apiServerURL,
 					CABundle: caBundle,
@@ -130,6 +137,11 @@ WantedBy=multi-user.target`))
 						SecretName:        oscSecretName,
 						KubernetesVersion: kubernetesVersion,
 						SyncJitterPeriod:  syncJitterPeriod,
+						Rollout: &nodeagentv1alpha1.OperatingSystemConfigRolloutConfig{
+							MaxUnavailable: intstr.FromInt32(2),
+							Drain:          drain,
+						},
+						ImageVerification: imageVerification,
 					},
 					Token: nodeagentv1alpha1.TokenControllerConfig{
 						SyncConfigs: []nodeagentv1alpha1.TokenSecretSyncConfig{
@@ -151,7 +163,7 @@ WantedBy=multi-user.target`))
 
 	Describe("#Files", func() {
 		It("should return the expected files", func() {
-			config := ComponentConfig(oscSecretName, nil, apiServerURL, caBundle, syncJitterPeriod, additionalTokenSyncConfigs)
+			config := ComponentConfig(oscSecretName, nil, apiServerURL, caBundle, syncJitterPeriod, maxUnavailable, drain, imageVerification, additionalTokenSyncConfigs)
 
 			Expect(Files(config)).To(ConsistOf(extensionsv1alpha1.File{
 				Path:        "/var/lib/gardener-node-agent/config.yaml",
@@ -168,7 +180,15 @@ clientConnection:
   qps: 0
 controllers:
   operatingSystemConfig:
+    imageVerification:
+      publicKeys:
+      - public-key
+      requireDigest: true
     kubernetesVersion: null
+    rollout:
+      drain:
+        timeout: 5m0s
+      maxUnavailable: 2
     secretName: ` + oscSecretName + `
     syncJitterPeriod: ` + syncJitterPeriod.Duration.String() + `
   token:
@@ -375,7 +375,7 @@ func (k *kubeAPIServer) reconcileDeployment(
 		apiserver.InjectDefaultSettings(deployment, k.values.NamePrefix, k.values.Values, secretCAETCD, secretETCDClient, secretServer)
 		apiserver.InjectAuditSettings(deployment, configMapAuditPolicy, secretAuditWebhookKubeconfig, k.values.Audit)
 		apiserver.InjectAdmissionSettings(deployment, configMapAdmissionConfigs, secretAdmissionKubeconfigs, k.values.Values)
-		apiserver.InjectEncryptionSettings(deployment, secretETCDEncryptionConfiguration)
+		apiserver.InjectEncryptionSettings(deployment, secretETCDEncryptionConfiguration, k.values.ETCDEncryption)
 		k.handleSNISettings(deployment)
 		k.handleTLSSNISettings(deployment, tlsSNISecrets)
 		k.handleOIDCSettings(deployment, secretOIDCCABundle)
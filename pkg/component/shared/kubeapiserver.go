@@ -212,6 +212,7 @@ func DeployKubeAPIServer(
 	nodeNetworkCIDR *string,
 	resourcesToEncrypt []string,
 	encryptedResources []string,
+	kmsConfig *gardencorev1beta1.KMSConfig,
 	etcdEncryptionKeyRotationPhase gardencorev1beta1.CredentialsRotationPhase,
 	wantScaleDown bool,
 ) error {
@@ -259,6 +260,7 @@ func DeployKubeAPIServer(
 	if err != nil {
 		return err
 	}
+	etcdEncryptionConfig.KMS = kmsConfig
 	kubeAPIServer.SetETCDEncryptionConfig(etcdEncryptionConfig)
 
 	if err := kubeAPIServer.Deploy(ctx); err != nil {
@@ -67,6 +67,8 @@ type ControllerManagerControllerConfiguration struct {
 	Project *ProjectControllerConfiguration
 	// Quota defines the configuration of the Quota controller.
 	Quota *QuotaControllerConfiguration
+	// QuotaUsage defines the configuration of the QuotaUsage controller.
+	QuotaUsage *QuotaUsageControllerConfiguration
 	// SecretBinding defines the configuration of the SecretBinding controller.
 	SecretBinding *SecretBindingControllerConfiguration
 	// Seed defines the configuration of the Seed controller.
@@ -75,6 +77,8 @@ type ControllerManagerControllerConfiguration struct {
 	SeedExtensionsCheck *SeedExtensionsCheckControllerConfiguration
 	// SeedBackupBucketsCheck defines the configuration of the SeedBackupBucketsCheck controller.
 	SeedBackupBucketsCheck *SeedBackupBucketsCheckControllerConfiguration
+	// SeedEvacuation defines the configuration of the SeedEvacuation controller.
+	SeedEvacuation *SeedEvacuationControllerConfiguration
 	// ShootMaintenance defines the configuration of the ShootMaintenance controller.
 	ShootMaintenance ShootMaintenanceControllerConfiguration
 	// ShootQuota defines the configuration of the ShootQuota controller.
@@ -191,6 +195,15 @@ type QuotaControllerConfiguration struct {
 	ConcurrentSyncs *int
 }
 
+// QuotaUsageControllerConfiguration defines the configuration of the QuotaUsage controller.
+type QuotaUsageControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the usage of Quotas is recomputed.
+	SyncPeriod *metav1.Duration
+}
+
 // SecretBindingControllerConfiguration defines the configuration of the
 // SecretBinding controller.
 type SecretBindingControllerConfiguration struct {
@@ -242,6 +255,23 @@ type SeedBackupBucketsCheckControllerConfiguration struct {
 	ConditionThresholds []ConditionThreshold
 }
 
+// SeedEvacuationControllerConfiguration defines the configuration of the SeedEvacuation controller.
+type SeedEvacuationControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	ConcurrentSyncs *int
+	// SyncPeriod is the duration how often the progress of the evacuation of a seed is checked.
+	SyncPeriod *metav1.Duration
+	// MaxConcurrentMigrations is the maximum number of shoots which are migrated off a seed at the same time.
+	MaxConcurrentMigrations *int
+	// RespectMaintenanceTimeWindow defines whether the migration of a shoot is only started within its maintenance
+	// time window.
+	RespectMaintenanceTimeWindow *bool
+	// CandidateDeterminationStrategy is the strategy of the scheduler which is used for determining the target seeds
+	// of the shoots.
+	CandidateDeterminationStrategy *string
+}
+
 // ShootMaintenanceControllerConfiguration defines the configuration of the
 // ShootMaintenance controller.
 type ShootMaintenanceControllerConfiguration struct {
@@ -188,6 +188,18 @@ func SetDefaults_QuotaControllerConfiguration(obj *QuotaControllerConfiguration)
 	}
 }
 
+// SetDefaults_QuotaUsageControllerConfiguration sets defaults for the QuotaUsageControllerConfiguration.
+func SetDefaults_QuotaUsageControllerConfiguration(obj *QuotaUsageControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{
+			Duration: 10 * time.Minute,
+		}
+	}
+}
+
 // SetDefaults_SecretBindingControllerConfiguration sets defaults for the SecretBindingControllerConfiguration.
 func SetDefaults_SecretBindingControllerConfiguration(obj *SecretBindingControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -215,6 +227,25 @@ func SetDefaults_SeedBackupBucketsCheckControllerConfiguration(obj *SeedBackupBu
 	}
 }
 
+// SetDefaults_SeedEvacuationControllerConfiguration sets defaults for the SeedEvacuationControllerConfiguration.
+func SetDefaults_SeedEvacuationControllerConfiguration(obj *SeedEvacuationControllerConfiguration) {
+	if obj.ConcurrentSyncs == nil {
+		obj.ConcurrentSyncs = ptr.To(DefaultControllerConcurrentSyncs)
+	}
+	if obj.SyncPeriod == nil {
+		obj.SyncPeriod = &metav1.Duration{Duration: time.Minute}
+	}
+	if obj.MaxConcurrentMigrations == nil {
+		obj.MaxConcurrentMigrations = ptr.To(3)
+	}
+	if obj.RespectMaintenanceTimeWindow == nil {
+		obj.RespectMaintenanceTimeWindow = ptr.To(true)
+	}
+	if obj.CandidateDeterminationStrategy == nil {
+		obj.CandidateDeterminationStrategy = ptr.To("SameRegion")
+	}
+}
+
 // SetDefaults_ShootHibernationControllerConfiguration sets defaults for the ShootHibernationControllerConfiguration.
 func SetDefaults_ShootHibernationControllerConfiguration(obj *ShootHibernationControllerConfiguration) {
 	if obj.ConcurrentSyncs == nil {
@@ -314,6 +345,9 @@ func SetDefaults_ControllerManagerControllerConfiguration(obj *ControllerManager
 	if obj.Quota == nil {
 		obj.Quota = &QuotaControllerConfiguration{}
 	}
+	if obj.QuotaUsage == nil {
+		obj.QuotaUsage = &QuotaUsageControllerConfiguration{}
+	}
 	if obj.SecretBinding == nil {
 		obj.SecretBinding = &SecretBindingControllerConfiguration{}
 	}
@@ -326,6 +360,9 @@ func SetDefaults_ControllerManagerControllerConfiguration(obj *ControllerManager
 	if obj.SeedBackupBucketsCheck == nil {
 		obj.SeedBackupBucketsCheck = &SeedBackupBucketsCheckControllerConfiguration{}
 	}
+	if obj.SeedEvacuation == nil {
+		obj.SeedEvacuation = &SeedEvacuationControllerConfiguration{}
+	}
 	if obj.ShootQuota == nil {
 		obj.ShootQuota = &ShootQuotaControllerConfiguration{}
 	}
@@ -454,6 +454,33 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("QuotaUsageControllerConfiguration defaulting", func() {
+		It("should default QuotaUsageControllerConfiguration correctly", func() {
+			expected := &QuotaUsageControllerConfiguration{
+				ConcurrentSyncs: ptr.To(DefaultControllerConcurrentSyncs),
+				SyncPeriod:      &metav1.Duration{Duration: 10 * time.Minute},
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.QuotaUsage).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					QuotaUsage: &QuotaUsageControllerConfiguration{
+						ConcurrentSyncs: ptr.To(10),
+						SyncPeriod:      &metav1.Duration{Duration: time.Minute},
+					},
+				},
+			}
+			expected := obj.Controllers.QuotaUsage.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.QuotaUsage).To(Equal(expected))
+		})
+	})
+
 	Describe("SecretBindingControllerConfiguration defaulting", func() {
 		It("should default SecretBindingControllerConfiguration correctly", func() {
 			expected := &SecretBindingControllerConfiguration{
@@ -533,6 +560,39 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("SeedEvacuationControllerConfiguration defaulting", func() {
+		It("should default SeedEvacuationControllerConfiguration correctly", func() {
+			expected := &SeedEvacuationControllerConfiguration{
+				ConcurrentSyncs:                ptr.To(DefaultControllerConcurrentSyncs),
+				SyncPeriod:                     &metav1.Duration{Duration: time.Minute},
+				MaxConcurrentMigrations:        ptr.To(3),
+				RespectMaintenanceTimeWindow:   ptr.To(true),
+				CandidateDeterminationStrategy: ptr.To("SameRegion"),
+			}
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedEvacuation).To(Equal(expected))
+		})
+
+		It("should not default fields that are set", func() {
+			obj = &ControllerManagerConfiguration{
+				Controllers: ControllerManagerControllerConfiguration{
+					SeedEvacuation: &SeedEvacuationControllerConfiguration{
+						ConcurrentSyncs:                ptr.To(10),
+						SyncPeriod:                     &metav1.Duration{Duration: 5 * time.Minute},
+						MaxConcurrentMigrations:        ptr.To(1),
+						RespectMaintenanceTimeWindow:   ptr.To(false),
+						CandidateDeterminationStrategy: ptr.To("MinimalDistance"),
+					},
+				},
+			}
+			expected := obj.Controllers.SeedEvacuation.DeepCopy()
+			SetObjectDefaults_ControllerManagerConfiguration(obj)
+
+			Expect(obj.Controllers.SeedEvacuation).To(Equal(expected))
+		})
+	})
+
 	Describe("ShootHibernationControllerConfiguration defaulting", func() {
 		It("should default ShootHibernationControllerConfiguration correctly", func() {
 			expected := &ShootHibernationControllerConfiguration{
@@ -79,6 +79,9 @@ type ControllerManagerControllerConfiguration struct {
 	// Quota defines the configuration of the Quota controller.
 	// +optional
 	Quota *QuotaControllerConfiguration `json:"quota,omitempty"`
+	// QuotaUsage defines the configuration of the QuotaUsage controller.
+	// +optional
+	QuotaUsage *QuotaUsageControllerConfiguration `json:"quotaUsage,omitempty"`
 	// SecretBinding defines the configuration of the SecretBinding controller.
 	// +optional
 	SecretBinding *SecretBindingControllerConfiguration `json:"secretBinding,omitempty"`
@@ -91,6 +94,9 @@ type ControllerManagerControllerConfiguration struct {
 	// SeedBackupBucketsCheck defines the configuration of the SeedBackupBucketsCheck controller.
 	// +optional
 	SeedBackupBucketsCheck *SeedBackupBucketsCheckControllerConfiguration `json:"seedBackupBucketsCheck,omitempty"`
+	// SeedEvacuation defines the configuration of the SeedEvacuation controller.
+	// +optional
+	SeedEvacuation *SeedEvacuationControllerConfiguration `json:"seedEvacuation,omitempty"`
 	// ShootMaintenance defines the configuration of the ShootMaintenance controller.
 	ShootMaintenance ShootMaintenanceControllerConfiguration `json:"shootMaintenance"`
 	// ShootQuota defines the configuration of the ShootQuota controller.
@@ -230,6 +236,17 @@ type QuotaControllerConfiguration struct {
 	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
 }
 
+// QuotaUsageControllerConfiguration defines the configuration of the QuotaUsage controller.
+type QuotaUsageControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the usage of Quotas is recomputed.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+}
+
 // SecretBindingControllerConfiguration defines the configuration of the
 // SecretBinding controller.
 type SecretBindingControllerConfiguration struct {
@@ -292,6 +309,29 @@ type SeedBackupBucketsCheckControllerConfiguration struct {
 	ConditionThresholds []ConditionThreshold `json:"conditionThresholds,omitempty"`
 }
 
+// SeedEvacuationControllerConfiguration defines the configuration of the SeedEvacuation controller.
+type SeedEvacuationControllerConfiguration struct {
+	// ConcurrentSyncs is the number of workers used for the controller to work on
+	// events.
+	// +optional
+	ConcurrentSyncs *int `json:"concurrentSyncs,omitempty"`
+	// SyncPeriod is the duration how often the progress of the evacuation of a seed is checked.
+	// +optional
+	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// MaxConcurrentMigrations is the maximum number of shoots which are migrated off a seed at the same time.
+	// Defaults to 3.
+	// +optional
+	MaxConcurrentMigrations *int `json:"maxConcurrentMigrations,omitempty"`
+	// RespectMaintenanceTimeWindow defines whether the migration of a shoot is only started within its maintenance
+	// time window. Defaults to true.
+	// +optional
+	RespectMaintenanceTimeWindow *bool `json:"respectMaintenanceTimeWindow,omitempty"`
+	// CandidateDeterminationStrategy is the strategy of the scheduler which is used for determining the target seeds
+	// of the shoots. Defaults to 'SameRegion'.
+	// +optional
+	CandidateDeterminationStrategy *string `json:"candidateDeterminationStrategy,omitempty"`
+}
+
 // ShootMaintenanceControllerConfiguration defines the configuration of the
 // ShootMaintenance controller.
 type ShootMaintenanceControllerConfiguration struct {
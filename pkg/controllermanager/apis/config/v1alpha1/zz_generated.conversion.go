@@ -162,6 +162,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*QuotaUsageControllerConfiguration)(nil), (*config.QuotaUsageControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_QuotaUsageControllerConfiguration_To_config_QuotaUsageControllerConfiguration(a.(*QuotaUsageControllerConfiguration), b.(*config.QuotaUsageControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.QuotaUsageControllerConfiguration)(nil), (*QuotaUsageControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_QuotaUsageControllerConfiguration_To_v1alpha1_QuotaUsageControllerConfiguration(a.(*config.QuotaUsageControllerConfiguration), b.(*QuotaUsageControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SecretBindingControllerConfiguration)(nil), (*config.SecretBindingControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SecretBindingControllerConfiguration_To_config_SecretBindingControllerConfiguration(a.(*SecretBindingControllerConfiguration), b.(*config.SecretBindingControllerConfiguration), scope)
 	}); err != nil {
@@ -192,6 +202,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SeedEvacuationControllerConfiguration)(nil), (*config.SeedEvacuationControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SeedEvacuationControllerConfiguration_To_config_SeedEvacuationControllerConfiguration(a.(*SeedEvacuationControllerConfiguration), b.(*config.SeedEvacuationControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SeedEvacuationControllerConfiguration)(nil), (*SeedEvacuationControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SeedEvacuationControllerConfiguration_To_v1alpha1_SeedEvacuationControllerConfiguration(a.(*config.SeedEvacuationControllerConfiguration), b.(*SeedEvacuationControllerConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SeedExtensionsCheckControllerConfiguration)(nil), (*config.SeedExtensionsCheckControllerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SeedExtensionsCheckControllerConfiguration_To_config_SeedExtensionsCheckControllerConfiguration(a.(*SeedExtensionsCheckControllerConfiguration), b.(*config.SeedExtensionsCheckControllerConfiguration), scope)
 	}); err != nil {
@@ -500,10 +520,12 @@ func autoConvert_v1alpha1_ControllerManagerControllerConfiguration_To_config_Con
 		out.Project = nil
 	}
 	out.Quota = (*config.QuotaControllerConfiguration)(unsafe.Pointer(in.Quota))
+	out.QuotaUsage = (*config.QuotaUsageControllerConfiguration)(unsafe.Pointer(in.QuotaUsage))
 	out.SecretBinding = (*config.SecretBindingControllerConfiguration)(unsafe.Pointer(in.SecretBinding))
 	out.Seed = (*config.SeedControllerConfiguration)(unsafe.Pointer(in.Seed))
 	out.SeedExtensionsCheck = (*config.SeedExtensionsCheckControllerConfiguration)(unsafe.Pointer(in.SeedExtensionsCheck))
 	out.SeedBackupBucketsCheck = (*config.SeedBackupBucketsCheckControllerConfiguration)(unsafe.Pointer(in.SeedBackupBucketsCheck))
+	out.SeedEvacuation = (*config.SeedEvacuationControllerConfiguration)(unsafe.Pointer(in.SeedEvacuation))
 	if err := Convert_v1alpha1_ShootMaintenanceControllerConfiguration_To_config_ShootMaintenanceControllerConfiguration(&in.ShootMaintenance, &out.ShootMaintenance, s); err != nil {
 		return err
 	}
@@ -542,10 +564,12 @@ func autoConvert_config_ControllerManagerControllerConfiguration_To_v1alpha1_Con
 		out.Project = nil
 	}
 	out.Quota = (*QuotaControllerConfiguration)(unsafe.Pointer(in.Quota))
+	out.QuotaUsage = (*QuotaUsageControllerConfiguration)(unsafe.Pointer(in.QuotaUsage))
 	out.SecretBinding = (*SecretBindingControllerConfiguration)(unsafe.Pointer(in.SecretBinding))
 	out.Seed = (*SeedControllerConfiguration)(unsafe.Pointer(in.Seed))
 	out.SeedExtensionsCheck = (*SeedExtensionsCheckControllerConfiguration)(unsafe.Pointer(in.SeedExtensionsCheck))
 	out.SeedBackupBucketsCheck = (*SeedBackupBucketsCheckControllerConfiguration)(unsafe.Pointer(in.SeedBackupBucketsCheck))
+	out.SeedEvacuation = (*SeedEvacuationControllerConfiguration)(unsafe.Pointer(in.SeedEvacuation))
 	if err := Convert_config_ShootMaintenanceControllerConfiguration_To_v1alpha1_ShootMaintenanceControllerConfiguration(&in.ShootMaintenance, &out.ShootMaintenance, s); err != nil {
 		return err
 	}
@@ -743,6 +767,28 @@ func Convert_config_QuotaControllerConfiguration_To_v1alpha1_QuotaControllerConf
 	return autoConvert_config_QuotaControllerConfiguration_To_v1alpha1_QuotaControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_QuotaUsageControllerConfiguration_To_config_QuotaUsageControllerConfiguration(in *QuotaUsageControllerConfiguration, out *config.QuotaUsageControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_v1alpha1_QuotaUsageControllerConfiguration_To_config_QuotaUsageControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_QuotaUsageControllerConfiguration_To_config_QuotaUsageControllerConfiguration(in *QuotaUsageControllerConfiguration, out *config.QuotaUsageControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_QuotaUsageControllerConfiguration_To_config_QuotaUsageControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_QuotaUsageControllerConfiguration_To_v1alpha1_QuotaUsageControllerConfiguration(in *config.QuotaUsageControllerConfiguration, out *QuotaUsageControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	return nil
+}
+
+// Convert_config_QuotaUsageControllerConfiguration_To_v1alpha1_QuotaUsageControllerConfiguration is an autogenerated conversion function.
+func Convert_config_QuotaUsageControllerConfiguration_To_v1alpha1_QuotaUsageControllerConfiguration(in *config.QuotaUsageControllerConfiguration, out *QuotaUsageControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_QuotaUsageControllerConfiguration_To_v1alpha1_QuotaUsageControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_SecretBindingControllerConfiguration_To_config_SecretBindingControllerConfiguration(in *SecretBindingControllerConfiguration, out *config.SecretBindingControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	return nil
@@ -813,6 +859,34 @@ func Convert_config_SeedControllerConfiguration_To_v1alpha1_SeedControllerConfig
 	return autoConvert_config_SeedControllerConfiguration_To_v1alpha1_SeedControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_SeedEvacuationControllerConfiguration_To_config_SeedEvacuationControllerConfiguration(in *SeedEvacuationControllerConfiguration, out *config.SeedEvacuationControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.MaxConcurrentMigrations = (*int)(unsafe.Pointer(in.MaxConcurrentMigrations))
+	out.RespectMaintenanceTimeWindow = (*bool)(unsafe.Pointer(in.RespectMaintenanceTimeWindow))
+	out.CandidateDeterminationStrategy = (*string)(unsafe.Pointer(in.CandidateDeterminationStrategy))
+	return nil
+}
+
+// Convert_v1alpha1_SeedEvacuationControllerConfiguration_To_config_SeedEvacuationControllerConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SeedEvacuationControllerConfiguration_To_config_SeedEvacuationControllerConfiguration(in *SeedEvacuationControllerConfiguration, out *config.SeedEvacuationControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SeedEvacuationControllerConfiguration_To_config_SeedEvacuationControllerConfiguration(in, out, s)
+}
+
+func autoConvert_config_SeedEvacuationControllerConfiguration_To_v1alpha1_SeedEvacuationControllerConfiguration(in *config.SeedEvacuationControllerConfiguration, out *SeedEvacuationControllerConfiguration, s conversion.Scope) error {
+	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
+	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.MaxConcurrentMigrations = (*int)(unsafe.Pointer(in.MaxConcurrentMigrations))
+	out.RespectMaintenanceTimeWindow = (*bool)(unsafe.Pointer(in.RespectMaintenanceTimeWindow))
+	out.CandidateDeterminationStrategy = (*string)(unsafe.Pointer(in.CandidateDeterminationStrategy))
+	return nil
+}
+
+// Convert_config_SeedEvacuationControllerConfiguration_To_v1alpha1_SeedEvacuationControllerConfiguration is an autogenerated conversion function.
+func Convert_config_SeedEvacuationControllerConfiguration_To_v1alpha1_SeedEvacuationControllerConfiguration(in *config.SeedEvacuationControllerConfiguration, out *SeedEvacuationControllerConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SeedEvacuationControllerConfiguration_To_v1alpha1_SeedEvacuationControllerConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_SeedExtensionsCheckControllerConfiguration_To_config_SeedExtensionsCheckControllerConfiguration(in *SeedExtensionsCheckControllerConfiguration, out *config.SeedExtensionsCheckControllerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
@@ -214,6 +214,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(QuotaControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.QuotaUsage != nil {
+		in, out := &in.QuotaUsage, &out.QuotaUsage
+		*out = new(QuotaUsageControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SecretBinding != nil {
 		in, out := &in.SecretBinding, &out.SecretBinding
 		*out = new(SecretBindingControllerConfiguration)
@@ -234,6 +239,11 @@ func (in *ControllerManagerControllerConfiguration) DeepCopyInto(out *Controller
 		*out = new(SeedBackupBucketsCheckControllerConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SeedEvacuation != nil {
+		in, out := &in.SeedEvacuation, &out.SeedEvacuation
+		*out = new(SeedEvacuationControllerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	in.ShootMaintenance.DeepCopyInto(&out.ShootMaintenance)
 	if in.ShootQuota != nil {
 		in, out := &in.ShootQuota, &out.ShootQuota
@@ -465,6 +475,32 @@ func (in *QuotaControllerConfiguration) DeepCopy() *QuotaControllerConfiguration
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QuotaUsageControllerConfiguration) DeepCopyInto(out *QuotaUsageControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QuotaUsageControllerConfiguration.
+func (in *QuotaUsageControllerConfiguration) DeepCopy() *QuotaUsageControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(QuotaUsageControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretBindingControllerConfiguration) DeepCopyInto(out *SecretBindingControllerConfiguration) {
 	*out = *in
@@ -553,6 +589,47 @@ func (in *SeedControllerConfiguration) DeepCopy() *SeedControllerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SeedEvacuationControllerConfiguration) DeepCopyInto(out *SeedEvacuationControllerConfiguration) {
+	*out = *in
+	if in.ConcurrentSyncs != nil {
+		in, out := &in.ConcurrentSyncs, &out.ConcurrentSyncs
+		*out = new(int)
+		**out = **in
+	}
+	if in.SyncPeriod != nil {
+		in, out := &in.SyncPeriod, &out.SyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxConcurrentMigrations != nil {
+		in, out := &in.MaxConcurrentMigrations, &out.MaxConcurrentMigrations
+		*out = new(int)
+		**out = **in
+	}
+	if in.RespectMaintenanceTimeWindow != nil {
+		in, out := &in.RespectMaintenanceTimeWindow, &out.RespectMaintenanceTimeWindow
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CandidateDeterminationStrategy != nil {
+		in, out := &in.CandidateDeterminationStrategy, &out.CandidateDeterminationStrategy
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SeedEvacuationControllerConfiguration.
+func (in *SeedEvacuationControllerConfiguration) DeepCopy() *SeedEvacuationControllerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(SeedEvacuationControllerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SeedExtensionsCheckControllerConfiguration) DeepCopyInto(out *SeedExtensionsCheckControllerConfiguration) {
 	*out = *in
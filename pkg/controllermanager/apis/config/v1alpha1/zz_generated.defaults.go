@@ -54,6 +54,9 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.Quota != nil {
 		SetDefaults_QuotaControllerConfiguration(in.Controllers.Quota)
 	}
+	if in.Controllers.QuotaUsage != nil {
+		SetDefaults_QuotaUsageControllerConfiguration(in.Controllers.QuotaUsage)
+	}
 	if in.Controllers.SecretBinding != nil {
 		SetDefaults_SecretBindingControllerConfiguration(in.Controllers.SecretBinding)
 	}
@@ -66,6 +69,9 @@ func SetObjectDefaults_ControllerManagerConfiguration(in *ControllerManagerConfi
 	if in.Controllers.SeedBackupBucketsCheck != nil {
 		SetDefaults_SeedBackupBucketsCheckControllerConfiguration(in.Controllers.SeedBackupBucketsCheck)
 	}
+	if in.Controllers.SeedEvacuation != nil {
+		SetDefaults_SeedEvacuationControllerConfiguration(in.Controllers.SeedEvacuation)
+	}
 	SetDefaults_ShootMaintenanceControllerConfiguration(&in.Controllers.ShootMaintenance)
 	if in.Controllers.ShootQuota != nil {
 		SetDefaults_ShootQuotaControllerConfiguration(in.Controllers.ShootQuota)
@@ -21,6 +21,7 @@ import (
 
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/logger"
+	schedulerconfig "github.com/gardener/gardener/pkg/scheduler/apis/config"
 )
 
 // ValidateControllerManagerConfiguration validates the given `ControllerManagerConfiguration`.
@@ -51,6 +52,31 @@ func validateControllerManagerControllerConfiguration(conf config.ControllerMana
 		allErrs = append(allErrs, validateProjectControllerConfiguration(conf.Project, projectFldPath)...)
 	}
 
+	if conf.SeedEvacuation != nil {
+		allErrs = append(allErrs, validateSeedEvacuationControllerConfiguration(conf.SeedEvacuation, fldPath.Child("seedEvacuation"))...)
+	}
+
+	return allErrs
+}
+
+func validateSeedEvacuationControllerConfiguration(conf *config.SeedEvacuationControllerConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if conf.MaxConcurrentMigrations != nil && *conf.MaxConcurrentMigrations < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxConcurrentMigrations"), *conf.MaxConcurrentMigrations, "must be at least 1"))
+	}
+
+	if conf.CandidateDeterminationStrategy != nil {
+		strategies := make([]string, 0, len(schedulerconfig.Strategies))
+		for _, strategy := range schedulerconfig.Strategies {
+			strategies = append(strategies, string(strategy))
+		}
+
+		if !sets.New(strategies...).Has(*conf.CandidateDeterminationStrategy) {
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("candidateDeterminationStrategy"), *conf.CandidateDeterminationStrategy, strategies))
+		}
+	}
+
 	return allErrs
 }
 
@@ -21,6 +21,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	. "github.com/gardener/gardener/pkg/controllermanager/apis/config/validation"
@@ -35,6 +36,35 @@ var _ = Describe("#ValidateControllerManagerConfiguration", func() {
 		}
 	})
 
+	Context("SeedEvacuationControllerConfiguration", func() {
+		BeforeEach(func() {
+			conf.Controllers.SeedEvacuation = &config.SeedEvacuationControllerConfiguration{
+				MaxConcurrentMigrations:        ptr.To(3),
+				CandidateDeterminationStrategy: ptr.To("SameRegion"),
+			}
+		})
+
+		It("should pass because the configuration is valid", func() {
+			Expect(ValidateControllerManagerConfiguration(conf)).To(BeEmpty())
+		})
+
+		It("should fail because the configuration is invalid", func() {
+			conf.Controllers.SeedEvacuation.MaxConcurrentMigrations = ptr.To(0)
+			conf.Controllers.SeedEvacuation.CandidateDeterminationStrategy = ptr.To("foo")
+
+			Expect(ValidateControllerManagerConfiguration(conf)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("controllers.seedEvacuation.maxConcurrentMigrations"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("controllers.seedEvacuation.candidateDeterminationStrategy"),
+				})),
+			))
+		})
+	})
+
 	Context("ProjectControllerConfiguration", func() {
 		Context("ProjectQuotaConfiguration", func() {
 			BeforeEach(func() {
@@ -32,6 +32,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllermanager/controller/managedseedset"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/project"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/quota"
+	quotausage "github.com/gardener/gardener/pkg/controllermanager/controller/quota/usage"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/secretbinding"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/shoot"
@@ -103,6 +104,12 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg *config.Controll
 		return fmt.Errorf("failed adding Quota controller: %w", err)
 	}
 
+	if err := (&quotausage.Reconciler{
+		Config: *cfg.Controllers.QuotaUsage,
+	}).AddToManager(ctx, mgr); err != nil {
+		return fmt.Errorf("failed adding QuotaUsage controller: %w", err)
+	}
+
 	if err := (&secretbinding.Reconciler{
 		Config: *cfg.Controllers.SecretBinding,
 	}).AddToManager(mgr); err != nil {
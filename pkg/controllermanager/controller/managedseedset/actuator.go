@@ -135,6 +135,9 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, managedSeedSe
 		if err := a.createReplica(ctx, log, managedSeedSet, status, ordinal); err != nil {
 			return status, false, err
 		}
+		if managedSeedSet.Spec.Autoscaling != nil {
+			status.LastScaleTime = ptr.To(Now())
+		}
 
 		// Increment Replicas and NextReplicaNumber in status
 		status.Replicas++
@@ -145,7 +148,16 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, managedSeedSe
 	case scalingIn:
 		// Determine the replica to be deleted
 		// From all deletable replicas, choose the one with lowest priority
+		// The replica getter checks with the API reader whether shoots are scheduled onto the replicas' seeds, hence shoots
+		// scheduled after the autoscaler decided to scale down render the replicas non-deletable. In this case, the scale
+		// down is cancelled instead of failing until a replica becomes deletable again.
 		if len(deletableReplicas) == 0 {
+			if managedSeedSet.DeletionTimestamp == nil && managedSeedSet.Spec.Autoscaling != nil && count <= int(managedSeedSet.Spec.Autoscaling.MaxReplicas) {
+				if err := a.cancelScaleDown(ctx, log, managedSeedSet, count); err != nil {
+					return status, false, err
+				}
+				return status, false, nil
+			}
 			return status, false, fmt.Errorf("no deletable replicas found")
 		}
 		sort.Sort(ascendingPriority(deletableReplicas))
@@ -155,6 +167,9 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, managedSeedSe
 		if err := a.deleteReplica(ctx, log, managedSeedSet, status, r); err != nil {
 			return status, false, err
 		}
+		if managedSeedSet.Spec.Autoscaling != nil {
+			status.LastScaleTime = ptr.To(Now())
+		}
 
 		// Decrement ReadyReplicas in status
 		if replicaIsReady(r) {
@@ -192,6 +207,7 @@ const (
 	EventWaitingForManagedSeedRegistered = "WaitingForManagedSeedRegistered"
 	EventWaitingForManagedSeedDeleted    = "WaitingForManagedSeedDeleted"
 	EventWaitingForSeedReady             = "WaitingForSeedReady"
+	EventScalingDownCancelled            = "ScalingDownCancelled"
 )
 
 func (a *actuator) reconcileReplica(
@@ -352,6 +368,23 @@ func (a *actuator) deleteReplica(
 	return nil
 }
 
+func (a *actuator) cancelScaleDown(
+	ctx context.Context,
+	log logr.Logger,
+	managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet,
+	count int,
+) error {
+	log.Info("Cancelling scale down since no replica is deletable anymore", "replicas", count)
+	a.infoEventf(managedSeedSet, EventScalingDownCancelled, "Cancelling scale down to %d replicas since no replica is deletable anymore", *managedSeedSet.Spec.Replicas)
+
+	patch := client.MergeFrom(managedSeedSet.DeepCopy())
+	managedSeedSet.Spec.Replicas = ptr.To(int32(count))
+	if err := a.gardenClient.Patch(ctx, managedSeedSet, patch); err != nil {
+		return fmt.Errorf("could not revert replicas: %w", err)
+	}
+	return nil
+}
+
 func (a *actuator) infoEventf(managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet, reason, fmt string, args ...interface{}) {
 	a.recorder.Eventf(managedSeedSet, corev1.EventTypeNormal, reason, fmt, args...)
 }
@@ -21,6 +21,7 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -539,6 +540,79 @@ var _ = Describe("Actuator", func() {
 			),
 		)
 	})
+
+	Context("autoscaling", func() {
+		var (
+			r1 *mockmanagedseedset.MockReplica
+
+			autoscaledManagedSeedSet = func(replicas, nextReplicaNumber int32) *seedmanagementv1alpha1.ManagedSeedSet {
+				managedSeedSet := managedSeedSet(replicas, nextReplicaNumber, "", "", nil)
+				managedSeedSet.Spec.Autoscaling = &seedmanagementv1alpha1.Autoscaling{
+					MinReplicas: ptr.To(int32(1)),
+					MaxReplicas: 3,
+				}
+				return managedSeedSet
+			}
+		)
+
+		BeforeEach(func() {
+			r1 = mockmanagedseedset.NewMockReplica(ctrl)
+		})
+
+		It("should create the shoot of a new replica and record the scale time", func() {
+			managedSeedSet := autoscaledManagedSeedSet(2, 1)
+			expectReplica(r0, 0, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, false)
+			rg.EXPECT().GetReplicas(ctx, managedSeedSet).Return([]Replica{r0}, nil)
+			rf.EXPECT().NewReplica(managedSeedSet, nil, nil, nil, false).Return(r1)
+			r1.EXPECT().CreateShoot(ctx, gc, 1).Return(nil)
+			r1.EXPECT().GetName().Return(getReplicaName(1))
+			recorder.EXPECT().Eventf(managedSeedSet, corev1.EventTypeNormal, EventCreatingShoot, "Creating Shoot %s", []interface{}{getReplicaFullName(1)})
+
+			s, rf, err := actuator.Reconcile(ctx, log, managedSeedSet)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rf).To(BeFalse())
+			expectedStatus := status(2, 1, 2, getReplicaName(1), seedmanagementv1alpha1.ShootReconcilingReason, now, nil)
+			expectedStatus.LastScaleTime = &now
+			Expect(s).To(Equal(expectedStatus))
+		})
+
+		It("should delete the managed seed of a deletable replica and record the scale time", func() {
+			managedSeedSet := autoscaledManagedSeedSet(1, 2)
+			expectReplica(r0, 0, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, false)
+			expectReplica(r1, 1, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, true)
+			rg.EXPECT().GetReplicas(ctx, managedSeedSet).Return([]Replica{r0, r1}, nil)
+			r1.EXPECT().DeleteManagedSeed(ctx, gc).Return(nil)
+			recorder.EXPECT().Eventf(managedSeedSet, corev1.EventTypeNormal, EventDeletingManagedSeed, "Deleting ManagedSeed %s", []interface{}{getReplicaFullName(1)})
+
+			s, rf, err := actuator.Reconcile(ctx, log, managedSeedSet)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rf).To(BeFalse())
+			expectedStatus := status(2, 1, 2, getReplicaName(1), seedmanagementv1alpha1.ManagedSeedDeletingReason, now, nil)
+			expectedStatus.LastScaleTime = &now
+			Expect(s).To(Equal(expectedStatus))
+		})
+
+		It("should cancel the scale down if shoots were scheduled onto the empty seed in the meantime", func() {
+			managedSeedSet := autoscaledManagedSeedSet(1, 2)
+			// The autoscaler found the seed of replica 1 empty, but a shoot was scheduled onto it before the actuator ran
+			expectReplica(r0, 0, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, false)
+			expectReplica(r1, 1, StatusManagedSeedRegistered, true, gardenerutils.ShootStatusHealthy, false)
+			rg.EXPECT().GetReplicas(ctx, managedSeedSet).Return([]Replica{r0, r1}, nil)
+			recorder.EXPECT().Eventf(managedSeedSet, corev1.EventTypeNormal, EventScalingDownCancelled, "Cancelling scale down to %d replicas since no replica is deletable anymore", []interface{}{int32(1)})
+			gc.EXPECT().Patch(ctx, gomock.AssignableToTypeOf(&seedmanagementv1alpha1.ManagedSeedSet{}), gomock.Any()).DoAndReturn(func(_ context.Context, obj *seedmanagementv1alpha1.ManagedSeedSet, patch client.Patch, _ ...client.PatchOption) error {
+				data, err := patch.Data(obj)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(data).To(MatchJSON(`{"spec":{"replicas":2}}`))
+				return nil
+			})
+
+			s, rf, err := actuator.Reconcile(ctx, log, managedSeedSet)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rf).To(BeFalse())
+			Expect(s).To(Equal(status(2, 2, 2, "", "", now, nil)))
+			Expect(managedSeedSet.Spec.Replicas).To(PointTo(Equal(int32(2))))
+		})
+	})
 })
 
 func getReplicaName(ordinal int) string {
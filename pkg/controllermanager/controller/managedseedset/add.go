@@ -22,6 +22,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -55,6 +56,9 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) erro
 		replicaGetter := NewReplicaGetter(r.Client, mgr.GetAPIReader(), replicaFactory)
 		r.Actuator = NewActuator(r.Client, replicaGetter, replicaFactory, &r.Config, mgr.GetEventRecorderFor(ControllerName+"-controller"))
 	}
+	if r.Autoscaler == nil {
+		r.Autoscaler = NewAutoscaler(r.Client, clock.RealClock{}, mgr.GetEventRecorderFor(ControllerName+"-controller"))
+	}
 
 	c, err := builder.
 		ControllerManagedBy(mgr).
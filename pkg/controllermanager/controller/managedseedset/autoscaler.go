@@ -0,0 +1,221 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package managedseedset
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	seedmanagementv1alpha1constants "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1/constants"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+// Autoscaler adjusts the replicas of ManagedSeedSet resources based on the utilization of their seeds.
+type Autoscaler interface {
+	// Autoscale adjusts the replicas of the given ManagedSeedSet according to its autoscaling policy.
+	Autoscale(context.Context, logr.Logger, *seedmanagementv1alpha1.ManagedSeedSet) error
+}
+
+// autoscaler is a concrete implementation of Autoscaler.
+type autoscaler struct {
+	gardenClient client.Client
+	clock        clock.Clock
+	recorder     record.EventRecorder
+}
+
+// NewAutoscaler creates and returns a new Autoscaler with the given parameters.
+func NewAutoscaler(gardenClient client.Client, clock clock.Clock, recorder record.EventRecorder) Autoscaler {
+	return &autoscaler{
+		gardenClient: gardenClient,
+		clock:        clock,
+		recorder:     recorder,
+	}
+}
+
+// Event reason constants.
+const (
+	EventScalingUp   = "ScalingUp"
+	EventScalingDown = "ScalingDown"
+)
+
+// Autoscale adjusts the replicas of the given ManagedSeedSet according to its autoscaling policy. Replicas are added
+// when the utilization of the set's seeds, i.e. the ratio of the shoots scheduled onto them to their allocatable shoots,
+// reaches the target utilization. A replica is removed only if the set has an empty seed that can be deleted, the
+// scale down cooldown has passed, and the utilization stays below the target without that seed.
+func (a *autoscaler) Autoscale(ctx context.Context, log logr.Logger, managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet) error {
+	autoscaling := managedSeedSet.Spec.Autoscaling
+	if autoscaling == nil || managedSeedSet.DeletionTimestamp != nil {
+		return nil
+	}
+
+	var (
+		minReplicas = ptr.Deref(autoscaling.MinReplicas, 1)
+		maxReplicas = autoscaling.MaxReplicas
+		target      = int64(ptr.Deref(autoscaling.TargetUtilizationPercentage, 80))
+		replicas    = ptr.Deref(managedSeedSet.Spec.Replicas, 1)
+	)
+
+	// Ensure replicas are within the configured bounds
+	if replicas < minReplicas {
+		return a.scale(ctx, log, managedSeedSet, minReplicas, EventScalingUp, "below the minimum replicas")
+	}
+	if replicas > maxReplicas {
+		return a.scale(ctx, log, managedSeedSet, maxReplicas, EventScalingDown, "above the maximum replicas")
+	}
+
+	// Only scale if the set is not currently creating, updating, or deleting replicas
+	status := managedSeedSet.Status
+	if status.ObservedGeneration != managedSeedSet.Generation || status.PendingReplica != nil || status.Replicas != replicas || status.ReadyReplicas != replicas {
+		log.V(1).Info("Skipping autoscaling since ManagedSeedSet is not stable")
+		return nil
+	}
+
+	usage, err := a.getUsage(ctx, managedSeedSet)
+	if err != nil {
+		return err
+	}
+	if usage.allocatable == 0 {
+		log.V(1).Info("Skipping autoscaling since no seed of ManagedSeedSet has allocatable shoots")
+		return nil
+	}
+	log.V(1).Info("Current utilization of ManagedSeedSet", "scheduledShoots", usage.scheduled, "allocatableShoots", usage.allocatable, "emptySeeds", usage.emptySeeds)
+
+	// Scale up if the utilization has reached the target
+	if usage.scheduled*100 >= target*usage.allocatable {
+		if replicas >= maxReplicas {
+			log.V(1).Info("Not scaling up since maximum replicas have been reached", "maxReplicas", maxReplicas)
+			return nil
+		}
+		return a.scale(ctx, log, managedSeedSet, replicas+1, EventScalingUp,
+			fmt.Sprintf("utilization %d/%d has reached the target of %d%%", usage.scheduled, usage.allocatable, target))
+	}
+
+	// Scale down if there is an empty seed that can be removed without reaching the target utilization
+	if replicas <= minReplicas || len(usage.emptySeeds) == 0 {
+		return nil
+	}
+	if status.LastScaleTime != nil {
+		cooldown := ptr.Deref(autoscaling.ScaleDownCooldown, metav1.Duration{}).Duration
+		if remaining := status.LastScaleTime.Add(cooldown).Sub(a.clock.Now()); remaining > 0 {
+			log.V(1).Info("Not scaling down since scale down cooldown has not passed yet", "remaining", remaining)
+			return nil
+		}
+	}
+	// The actuator may delete any of the empty seeds, hence assume that the one with the most allocatable shoots is removed.
+	// If shoots are scheduled onto the empty seeds before the actuator deletes one of them, it cancels the scale down.
+	var maxEmptyAllocatable int64
+	for _, allocatable := range usage.emptySeeds {
+		maxEmptyAllocatable = max(maxEmptyAllocatable, allocatable)
+	}
+	if usage.scheduled*100 >= target*(usage.allocatable-maxEmptyAllocatable) {
+		return nil
+	}
+	return a.scale(ctx, log, managedSeedSet, replicas-1, EventScalingDown,
+		fmt.Sprintf("utilization %d/%d stays below the target of %d%% without an empty seed", usage.scheduled, usage.allocatable-maxEmptyAllocatable, target))
+}
+
+func (a *autoscaler) scale(ctx context.Context, log logr.Logger, managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet, replicas int32, reason, message string) error {
+	log.Info("Scaling ManagedSeedSet", "replicas", replicas, "reason", message)
+	a.recorder.Eventf(managedSeedSet, corev1.EventTypeNormal, reason, "Scaling to %d replicas since %s", replicas, message)
+
+	// The last scale time is maintained by the actuator once it actually creates or deletes a replica, since a scale down
+	// is cancelled if no replica is deletable anymore at that time.
+	patch := client.MergeFrom(managedSeedSet.DeepCopy())
+	managedSeedSet.Spec.Replicas = &replicas
+	if err := a.gardenClient.Patch(ctx, managedSeedSet, patch); err != nil {
+		return fmt.Errorf("could not update replicas: %w", err)
+	}
+
+	return nil
+}
+
+type setUsage struct {
+	// scheduled is the number of shoots scheduled onto seeds with allocatable shoots.
+	scheduled int64
+	// allocatable is the number of allocatable shoots of all seeds.
+	allocatable int64
+	// emptySeeds maps the names of the seeds without scheduled shoots that can be deleted to their allocatable shoots.
+	emptySeeds map[string]int64
+}
+
+func (a *autoscaler) getUsage(ctx context.Context, managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet) (*setUsage, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&managedSeedSet.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := a.gardenClient.List(ctx, shootList, client.InNamespace(managedSeedSet.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+	managedSeedList := &seedmanagementv1alpha1.ManagedSeedList{}
+	if err := a.gardenClient.List(ctx, managedSeedList, client.InNamespace(managedSeedSet.Namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+	seedList := &gardencorev1beta1.SeedList{}
+	if err := a.gardenClient.List(ctx, seedList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+	allShoots := &gardencorev1beta1.ShootList{}
+	if err := a.gardenClient.List(ctx, allShoots); err != nil {
+		return nil, err
+	}
+
+	protected := make(map[string]bool)
+	for _, shoot := range shootList.Items {
+		protected[shoot.Name] = kubernetesutils.HasMetaDataAnnotation(&shoot, seedmanagementv1alpha1constants.AnnotationProtectFromDeletion, "true")
+	}
+	for _, managedSeed := range managedSeedList.Items {
+		protected[managedSeed.Name] = protected[managedSeed.Name] || kubernetesutils.HasMetaDataAnnotation(&managedSeed, seedmanagementv1alpha1constants.AnnotationProtectFromDeletion, "true")
+	}
+
+	shoots := make([]*gardencorev1beta1.Shoot, 0, len(allShoots.Items))
+	for i := range allShoots.Items {
+		shoots = append(shoots, &allShoots.Items[i])
+	}
+	seedUsage := v1beta1helper.CalculateSeedUsage(shoots)
+
+	usage := &setUsage{emptySeeds: make(map[string]int64)}
+	for _, seed := range seedList.Items {
+		// Only seeds that belong to a replica of this set are taken into account
+		isProtected, ok := protected[seed.Name]
+		if !ok {
+			continue
+		}
+
+		var allocatable int64
+		if allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok {
+			allocatable = allocatableShoots.Value()
+			usage.scheduled += int64(seedUsage[seed.Name])
+			usage.allocatable += allocatable
+		}
+		if seedUsage[seed.Name] == 0 && !isProtected {
+			usage.emptySeeds[seed.Name] = allocatable
+		}
+	}
+
+	return usage, nil
+}
@@ -0,0 +1,223 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package managedseedset_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	seedmanagementv1alpha1 "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1"
+	seedmanagementv1alpha1constants "github.com/gardener/gardener/pkg/apis/seedmanagement/v1alpha1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/managedseedset"
+)
+
+var _ = Describe("Autoscaler", func() {
+	var (
+		ctx        context.Context
+		fakeClient client.Client
+		fakeClock  *testclock.FakeClock
+		recorder   *record.FakeRecorder
+		autoscaler Autoscaler
+
+		labels         map[string]string
+		managedSeedSet *seedmanagementv1alpha1.ManagedSeedSet
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithStatusSubresource(&seedmanagementv1alpha1.ManagedSeedSet{}).
+			Build()
+		fakeClock = testclock.NewFakeClock(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+		recorder = record.NewFakeRecorder(10)
+		autoscaler = NewAutoscaler(fakeClient, fakeClock, recorder)
+
+		labels = map[string]string{"name": name}
+		managedSeedSet = &seedmanagementv1alpha1.ManagedSeedSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+			},
+			Spec: seedmanagementv1alpha1.ManagedSeedSetSpec{
+				Replicas: ptr.To(int32(2)),
+				Selector: metav1.LabelSelector{MatchLabels: labels},
+				Autoscaling: &seedmanagementv1alpha1.Autoscaling{
+					MinReplicas:                 ptr.To(int32(1)),
+					MaxReplicas:                 3,
+					TargetUtilizationPercentage: ptr.To(int32(80)),
+					ScaleDownCooldown:           &metav1.Duration{Duration: 30 * time.Minute},
+				},
+			},
+		}
+		Expect(fakeClient.Create(ctx, managedSeedSet)).To(Succeed())
+		managedSeedSet.Status = seedmanagementv1alpha1.ManagedSeedSetStatus{
+			ObservedGeneration: managedSeedSet.Generation,
+			Replicas:           2,
+			ReadyReplicas:      2,
+		}
+		Expect(fakeClient.Status().Update(ctx, managedSeedSet)).To(Succeed())
+
+		for i := 0; i < 2; i++ {
+			createReplica(ctx, fakeClient, fmt.Sprintf("%s-%d", name, i), labels, 10)
+		}
+	})
+
+	scheduleShoots := func(seedName string, count int) {
+		for i := 0; i < count; i++ {
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-shoot-%d", seedName, i), Namespace: "garden-project"},
+				Spec:       gardencorev1beta1.ShootSpec{SeedName: ptr.To(seedName)},
+			})).To(Succeed())
+		}
+	}
+
+	expectReplicas := func(replicas int32, scaled bool) {
+		Expect(autoscaler.Autoscale(ctx, logr.Discard(), managedSeedSet)).To(Succeed())
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(managedSeedSet), managedSeedSet)).To(Succeed())
+		Expect(managedSeedSet.Spec.Replicas).To(Equal(ptr.To(replicas)))
+		// The last scale time is only maintained by the actuator once it actually creates or deletes a replica
+		Expect(managedSeedSet.Status.LastScaleTime).To(BeNil())
+		if scaled {
+			Expect(recorder.Events).To(Receive())
+		} else {
+			Expect(recorder.Events).NotTo(Receive())
+		}
+	}
+
+	It("should do nothing if autoscaling is not configured", func() {
+		managedSeedSet.Spec.Autoscaling = nil
+		scheduleShoots(name+"-0", 10)
+
+		expectReplicas(2, false)
+	})
+
+	It("should scale up to the minimum replicas", func() {
+		managedSeedSet.Spec.Autoscaling.MinReplicas = ptr.To(int32(3))
+
+		expectReplicas(3, true)
+	})
+
+	It("should scale down to the maximum replicas", func() {
+		managedSeedSet.Spec.Autoscaling.MaxReplicas = 1
+
+		expectReplicas(1, true)
+	})
+
+	It("should scale up if the target utilization has been reached", func() {
+		scheduleShoots(name+"-0", 10)
+		scheduleShoots(name+"-1", 6)
+
+		expectReplicas(3, true)
+	})
+
+	It("should not scale up beyond the maximum replicas", func() {
+		managedSeedSet.Spec.Autoscaling.MaxReplicas = 2
+		scheduleShoots(name+"-0", 10)
+		scheduleShoots(name+"-1", 10)
+
+		expectReplicas(2, false)
+	})
+
+	It("should not scale if the ManagedSeedSet has a pending replica", func() {
+		managedSeedSet.Status.PendingReplica = &seedmanagementv1alpha1.PendingReplica{Name: name + "-1"}
+		scheduleShoots(name+"-0", 10)
+		scheduleShoots(name+"-1", 10)
+
+		expectReplicas(2, false)
+	})
+
+	It("should not scale if not all replicas are ready", func() {
+		managedSeedSet.Status.ReadyReplicas = 1
+		scheduleShoots(name+"-0", 10)
+		scheduleShoots(name+"-1", 10)
+
+		expectReplicas(2, false)
+	})
+
+	It("should scale down if there is an empty seed and the cooldown has passed", func() {
+		managedSeedSet.Status.LastScaleTime = &metav1.Time{Time: fakeClock.Now().Add(-time.Hour)}
+		scheduleShoots(name+"-0", 5)
+
+		expectReplicas(1, true)
+	})
+
+	It("should not scale down if the cooldown has not passed yet", func() {
+		managedSeedSet.Status.LastScaleTime = &metav1.Time{Time: fakeClock.Now().Add(-10 * time.Minute)}
+		scheduleShoots(name+"-0", 5)
+
+		expectReplicas(2, false)
+	})
+
+	It("should not scale down if all seeds host shoots", func() {
+		scheduleShoots(name+"-0", 5)
+		scheduleShoots(name+"-1", 1)
+
+		expectReplicas(2, false)
+	})
+
+	It("should not scale down if the empty seed is protected from deletion", func() {
+		scheduleShoots(name+"-0", 5)
+		shoot := &gardencorev1beta1.Shoot{}
+		Expect(fakeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name + "-1"}, shoot)).To(Succeed())
+		metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, seedmanagementv1alpha1constants.AnnotationProtectFromDeletion, "true")
+		Expect(fakeClient.Update(ctx, shoot)).To(Succeed())
+
+		expectReplicas(2, false)
+	})
+
+	It("should not scale down if the target utilization would be reached without the empty seed", func() {
+		scheduleShoots(name+"-0", 8)
+
+		expectReplicas(2, false)
+	})
+
+	It("should not scale down below the minimum replicas", func() {
+		managedSeedSet.Spec.Autoscaling.MinReplicas = ptr.To(int32(2))
+
+		expectReplicas(2, false)
+	})
+})
+
+func createReplica(ctx context.Context, c client.Client, name string, labels map[string]string, allocatableShoots int64) {
+	ExpectWithOffset(1, c.Create(ctx, &gardencorev1beta1.Shoot{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+	})).To(Succeed())
+	ExpectWithOffset(1, c.Create(ctx, &seedmanagementv1alpha1.ManagedSeed{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels},
+	})).To(Succeed())
+	ExpectWithOffset(1, c.Create(ctx, &gardencorev1beta1.Seed{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+		Status: gardencorev1beta1.SeedStatus{
+			Allocatable: corev1.ResourceList{gardencorev1beta1.ResourceShoots: *resource.NewQuantity(allocatableShoots, resource.DecimalSI)},
+		},
+	})).To(Succeed())
+}
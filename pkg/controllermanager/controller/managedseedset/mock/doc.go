@@ -12,6 +12,6 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-//go:generate mockgen -destination=mocks.go -package=mock github.com/gardener/gardener/pkg/controllermanager/controller/managedseedset Actuator,Replica,ReplicaFactory,ReplicaGetter
+//go:generate mockgen -destination=mocks.go -package=mock github.com/gardener/gardener/pkg/controllermanager/controller/managedseedset Actuator,Autoscaler,Replica,ReplicaFactory,ReplicaGetter
 
 package mock
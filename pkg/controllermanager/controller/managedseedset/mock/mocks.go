@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/gardener/gardener/pkg/controllermanager/controller/managedseedset (interfaces: Actuator,Replica,ReplicaFactory,ReplicaGetter)
+// Source: github.com/gardener/gardener/pkg/controllermanager/controller/managedseedset (interfaces: Actuator,Autoscaler,Replica,ReplicaFactory,ReplicaGetter)
 //
 // Generated by this command:
 //
-//	mockgen -destination=mocks.go -package=mock github.com/gardener/gardener/pkg/controllermanager/controller/managedseedset Actuator,Replica,ReplicaFactory,ReplicaGetter
+//	mockgen -destination=mocks.go -package=mock github.com/gardener/gardener/pkg/controllermanager/controller/managedseedset Actuator,Autoscaler,Replica,ReplicaFactory,ReplicaGetter
 //
 
 // Package mock is a generated GoMock package.
@@ -62,6 +62,43 @@ func (mr *MockActuatorMockRecorder) Reconcile(arg0, arg1, arg2 any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockActuator)(nil).Reconcile), arg0, arg1, arg2)
 }
 
+// MockAutoscaler is a mock of Autoscaler interface.
+type MockAutoscaler struct {
+	ctrl     *gomock.Controller
+	recorder *MockAutoscalerMockRecorder
+}
+
+// MockAutoscalerMockRecorder is the mock recorder for MockAutoscaler.
+type MockAutoscalerMockRecorder struct {
+	mock *MockAutoscaler
+}
+
+// NewMockAutoscaler creates a new mock instance.
+func NewMockAutoscaler(ctrl *gomock.Controller) *MockAutoscaler {
+	mock := &MockAutoscaler{ctrl: ctrl}
+	mock.recorder = &MockAutoscalerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAutoscaler) EXPECT() *MockAutoscalerMockRecorder {
+	return m.recorder
+}
+
+// Autoscale mocks base method.
+func (m *MockAutoscaler) Autoscale(arg0 context.Context, arg1 logr.Logger, arg2 *v1alpha1.ManagedSeedSet) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Autoscale", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Autoscale indicates an expected call of Autoscale.
+func (mr *MockAutoscalerMockRecorder) Autoscale(arg0, arg1, arg2 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Autoscale", reflect.TypeOf((*MockAutoscaler)(nil).Autoscale), arg0, arg1, arg2)
+}
+
 // MockReplica is a mock of Replica interface.
 type MockReplica struct {
 	ctrl     *gomock.Controller
@@ -34,9 +34,10 @@ import (
 
 // Reconciler reconciles the ManagedSeedSet.
 type Reconciler struct {
-	Client   client.Client
-	Config   config.ManagedSeedSetControllerConfiguration
-	Actuator Actuator
+	Client     client.Client
+	Config     config.ManagedSeedSetControllerConfiguration
+	Actuator   Actuator
+	Autoscaler Autoscaler
 }
 
 // Reconcile performs the main reconciliation logic.
@@ -70,6 +71,13 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, managedSeed
 		}
 	}
 
+	// Adjust replicas according to the autoscaling policy, if any
+	if managedSeedSet.Spec.Autoscaling != nil && r.Autoscaler != nil {
+		if err := r.Autoscaler.Autoscale(ctx, log, managedSeedSet); err != nil {
+			return reconcile.Result{}, fmt.Errorf("could not autoscale ManagedSeedSet %s: %w", kubernetesutils.ObjectName(managedSeedSet), err)
+		}
+	}
+
 	var status *seedmanagementv1alpha1.ManagedSeedSetStatus
 	defer func() {
 		// Update status, on failure return the update error unless there is another error
@@ -16,6 +16,7 @@ package managedseedset_test
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -42,9 +43,10 @@ var _ = Describe("reconciler", func() {
 	var (
 		ctrl *gomock.Controller
 
-		actuator *mockmanagedseedset.MockActuator
-		c        *mockclient.MockClient
-		sw       *mockclient.MockStatusWriter
+		actuator   *mockmanagedseedset.MockActuator
+		autoscaler *mockmanagedseedset.MockAutoscaler
+		c          *mockclient.MockClient
+		sw         *mockclient.MockStatusWriter
 
 		cfg config.ManagedSeedSetControllerConfiguration
 
@@ -61,6 +63,7 @@ var _ = Describe("reconciler", func() {
 		ctrl = gomock.NewController(GinkgoT())
 
 		actuator = mockmanagedseedset.NewMockActuator(ctrl)
+		autoscaler = mockmanagedseedset.NewMockAutoscaler(ctrl)
 		c = mockclient.NewMockClient(ctrl)
 		sw = mockclient.NewMockStatusWriter(ctrl)
 
@@ -70,7 +73,7 @@ var _ = Describe("reconciler", func() {
 			SyncPeriod: metav1.Duration{Duration: syncPeriod},
 		}
 
-		reconciler = &Reconciler{Client: c, Actuator: actuator, Config: cfg}
+		reconciler = &Reconciler{Client: c, Actuator: actuator, Autoscaler: autoscaler, Config: cfg}
 
 		ctx = context.TODO()
 		request = reconcile.Request{NamespacedName: kubernetesutils.Key(namespace, name)}
@@ -149,6 +152,33 @@ var _ = Describe("reconciler", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(result).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
 			})
+
+			It("should autoscale the ManagedSeedSet before reconciling it, if autoscaling is configured", func() {
+				managedSeedSet.Finalizers = []string{gardencorev1beta1.GardenerName}
+				managedSeedSet.Spec.Autoscaling = &seedmanagementv1alpha1.Autoscaling{MaxReplicas: 3}
+				expectGetManagedSeedSet()
+				gomock.InOrder(
+					autoscaler.EXPECT().Autoscale(gomock.Any(), gomock.Any(), managedSeedSet),
+					actuator.EXPECT().Reconcile(gomock.Any(), gomock.Any(), managedSeedSet).Return(status, false, nil),
+				)
+				expectPatchManagedSeedSetStatus(func(mss *seedmanagementv1alpha1.ManagedSeedSet) {
+					Expect(&mss.Status).To(Equal(status))
+				})
+
+				result, err := reconciler.Reconcile(ctx, request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+			})
+
+			It("should fail if autoscaling the ManagedSeedSet fails", func() {
+				managedSeedSet.Finalizers = []string{gardencorev1beta1.GardenerName}
+				managedSeedSet.Spec.Autoscaling = &seedmanagementv1alpha1.Autoscaling{MaxReplicas: 3}
+				expectGetManagedSeedSet()
+				autoscaler.EXPECT().Autoscale(gomock.Any(), gomock.Any(), managedSeedSet).Return(fmt.Errorf("fake"))
+
+				_, err := reconciler.Reconcile(ctx, request)
+				Expect(err).To(MatchError(ContainSubstring("fake")))
+			})
 		})
 
 		Context("delete", func() {
@@ -0,0 +1,166 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllerutils/mapper"
+)
+
+// ControllerName is the name of this controller.
+const ControllerName = "quota-usage"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) error {
+	if r.Client == nil {
+		r.Client = mgr.GetClient()
+	}
+
+	c, err := builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		For(&gardencorev1beta1.Quota{}, builder.WithPredicates(r.QuotaSpecChangedPredicate())).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+		}).
+		Build(r)
+	if err != nil {
+		return err
+	}
+
+	if err := c.Watch(
+		source.Kind(mgr.GetCache(), &gardencorev1beta1.SecretBinding{}),
+		mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapSecretBindingToQuotas), mapper.UpdateWithOldAndNew, c.GetLogger()),
+		r.SecretBindingQuotasChangedPredicate(),
+	); err != nil {
+		return err
+	}
+
+	return c.Watch(
+		source.Kind(mgr.GetCache(), &gardencorev1beta1.Shoot{}),
+		mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), mapper.MapFunc(r.MapShootToQuotas), mapper.UpdateWithOldAndNew, c.GetLogger()),
+		r.ShootQuotaUsageChangedPredicate(),
+	)
+}
+
+// QuotaSpecChangedPredicate returns a predicate which returns true for all events except for updates which do not
+// change the specification of the Quota (e.g., updates of its status).
+func (r *Reconciler) QuotaSpecChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			quota, ok := e.ObjectNew.(*gardencorev1beta1.Quota)
+			if !ok {
+				return false
+			}
+
+			oldQuota, ok := e.ObjectOld.(*gardencorev1beta1.Quota)
+			if !ok {
+				return false
+			}
+
+			return !apiequality.Semantic.DeepEqual(oldQuota.Spec, quota.Spec)
+		},
+	}
+}
+
+// SecretBindingQuotasChangedPredicate returns a predicate which returns true for all events except for updates which
+// do not change the Quotas referenced by the SecretBinding.
+func (r *Reconciler) SecretBindingQuotasChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			secretBinding, ok := e.ObjectNew.(*gardencorev1beta1.SecretBinding)
+			if !ok {
+				return false
+			}
+
+			oldSecretBinding, ok := e.ObjectOld.(*gardencorev1beta1.SecretBinding)
+			if !ok {
+				return false
+			}
+
+			return !apiequality.Semantic.DeepEqual(oldSecretBinding.Quotas, secretBinding.Quotas)
+		},
+	}
+}
+
+// ShootQuotaUsageChangedPredicate returns a predicate which returns true for all events except for updates which do
+// not change the specification of the Shoot. Each change of the specification is relevant because the Quota status
+// records the generations of the Shoots it was computed for, which are used by admission to detect stale usages.
+func (r *Reconciler) ShootQuotaUsageChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			shoot, ok := e.ObjectNew.(*gardencorev1beta1.Shoot)
+			if !ok {
+				return false
+			}
+
+			oldShoot, ok := e.ObjectOld.(*gardencorev1beta1.Shoot)
+			if !ok {
+				return false
+			}
+
+			return oldShoot.Generation != shoot.Generation
+		},
+	}
+}
+
+// MapSecretBindingToQuotas is a mapper.MapFunc for mapping a SecretBinding to the Quotas it references.
+func (r *Reconciler) MapSecretBindingToQuotas(_ context.Context, _ logr.Logger, _ client.Reader, obj client.Object) []reconcile.Request {
+	secretBinding, ok := obj.(*gardencorev1beta1.SecretBinding)
+	if !ok {
+		return nil
+	}
+
+	return quotaRequests(secretBinding)
+}
+
+// MapShootToQuotas is a mapper.MapFunc for mapping a Shoot to the Quotas referenced by its SecretBinding.
+func (r *Reconciler) MapShootToQuotas(ctx context.Context, log logr.Logger, reader client.Reader, obj client.Object) []reconcile.Request {
+	shoot, ok := obj.(*gardencorev1beta1.Shoot)
+	if !ok || shoot.Spec.SecretBindingName == nil {
+		return nil
+	}
+
+	secretBinding := &gardencorev1beta1.SecretBinding{}
+	if err := reader.Get(ctx, client.ObjectKey{Namespace: shoot.Namespace, Name: *shoot.Spec.SecretBindingName}, secretBinding); err != nil {
+		log.Error(err, "Failed to get SecretBinding for Shoot", "secretBindingName", *shoot.Spec.SecretBindingName)
+		return nil
+	}
+
+	return quotaRequests(secretBinding)
+}
+
+func quotaRequests(secretBinding *gardencorev1beta1.SecretBinding) []reconcile.Request {
+	var requests []reconcile.Request
+	for _, quotaRef := range secretBinding.Quotas {
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: quotaRef.Namespace, Name: quotaRef.Name}})
+	}
+	return requests
+}
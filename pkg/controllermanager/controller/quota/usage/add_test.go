@@ -0,0 +1,187 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage_test
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/quota/usage"
+)
+
+var _ = Describe("Add", func() {
+	var reconciler *Reconciler
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+	})
+
+	Describe("#QuotaSpecChangedPredicate", func() {
+		var (
+			p     predicate.Predicate
+			quota *gardencorev1beta1.Quota
+		)
+
+		BeforeEach(func() {
+			p = reconciler.QuotaSpecChangedPredicate()
+			quota = &gardencorev1beta1.Quota{Spec: gardencorev1beta1.QuotaSpec{Metrics: corev1.ResourceList{"cpu": resource.MustParse("1")}}}
+		})
+
+		It("should return true for create and delete events", func() {
+			Expect(p.Create(event.CreateEvent{Object: quota})).To(BeTrue())
+			Expect(p.Delete(event.DeleteEvent{Object: quota})).To(BeTrue())
+		})
+
+		It("should return false if the spec did not change", func() {
+			newQuota := quota.DeepCopy()
+			newQuota.Status.Hard = corev1.ResourceList{"cpu": resource.MustParse("1")}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: quota, ObjectNew: newQuota})).To(BeFalse())
+		})
+
+		It("should return true if the spec changed", func() {
+			newQuota := quota.DeepCopy()
+			newQuota.Spec.Metrics["cpu"] = resource.MustParse("2")
+			Expect(p.Update(event.UpdateEvent{ObjectOld: quota, ObjectNew: newQuota})).To(BeTrue())
+		})
+	})
+
+	Describe("#SecretBindingQuotasChangedPredicate", func() {
+		var (
+			p             predicate.Predicate
+			secretBinding *gardencorev1beta1.SecretBinding
+		)
+
+		BeforeEach(func() {
+			p = reconciler.SecretBindingQuotasChangedPredicate()
+			secretBinding = &gardencorev1beta1.SecretBinding{Quotas: []corev1.ObjectReference{{Namespace: "garden", Name: "quota"}}}
+		})
+
+		It("should return false if the quotas did not change", func() {
+			newSecretBinding := secretBinding.DeepCopy()
+			newSecretBinding.Labels = map[string]string{"foo": "bar"}
+			Expect(p.Update(event.UpdateEvent{ObjectOld: secretBinding, ObjectNew: newSecretBinding})).To(BeFalse())
+		})
+
+		It("should return true if the quotas changed", func() {
+			newSecretBinding := secretBinding.DeepCopy()
+			newSecretBinding.Quotas = nil
+			Expect(p.Update(event.UpdateEvent{ObjectOld: secretBinding, ObjectNew: newSecretBinding})).To(BeTrue())
+		})
+	})
+
+	Describe("#ShootQuotaUsageChangedPredicate", func() {
+		var (
+			p     predicate.Predicate
+			shoot *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			p = reconciler.ShootQuotaUsageChangedPredicate()
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+				Spec: gardencorev1beta1.ShootSpec{
+					CloudProfileName:  "profile",
+					SecretBindingName: ptr.To("binding"),
+				},
+			}
+		})
+
+		It("should return false if the generation did not change", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Status.IsHibernated = true
+			Expect(p.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: newShoot})).To(BeFalse())
+		})
+
+		It("should return true if the generation changed", func() {
+			newShoot := shoot.DeepCopy()
+			newShoot.Generation++
+			Expect(p.Update(event.UpdateEvent{ObjectOld: shoot, ObjectNew: newShoot})).To(BeTrue())
+		})
+	})
+
+	Describe("mappers", func() {
+		var (
+			ctx           = context.TODO()
+			log           = logr.Discard()
+			fakeClient    client.Client
+			secretBinding *gardencorev1beta1.SecretBinding
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+
+			secretBinding = &gardencorev1beta1.SecretBinding{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "garden-dev", Name: "binding"},
+				Quotas: []corev1.ObjectReference{
+					{Namespace: "garden", Name: "quota1"},
+					{Namespace: "garden-dev", Name: "quota2"},
+				},
+			}
+		})
+
+		Describe("#MapSecretBindingToQuotas", func() {
+			It("should map to all referenced quotas", func() {
+				Expect(reconciler.MapSecretBindingToQuotas(ctx, log, fakeClient, secretBinding)).To(ConsistOf(
+					reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "garden", Name: "quota1"}},
+					reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "garden-dev", Name: "quota2"}},
+				))
+			})
+		})
+
+		Describe("#MapShootToQuotas", func() {
+			var shoot *gardencorev1beta1.Shoot
+
+			BeforeEach(func() {
+				shoot = &gardencorev1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "garden-dev", Name: "shoot"},
+					Spec:       gardencorev1beta1.ShootSpec{SecretBindingName: ptr.To("binding")},
+				}
+			})
+
+			It("should return nothing if the shoot does not reference a secret binding", func() {
+				shoot.Spec.SecretBindingName = nil
+				Expect(reconciler.MapShootToQuotas(ctx, log, fakeClient, shoot)).To(BeEmpty())
+			})
+
+			It("should return nothing if the secret binding does not exist", func() {
+				Expect(reconciler.MapShootToQuotas(ctx, log, fakeClient, shoot)).To(BeEmpty())
+			})
+
+			It("should map to the quotas of the secret binding", func() {
+				Expect(fakeClient.Create(ctx, secretBinding)).To(Succeed())
+
+				Expect(reconciler.MapShootToQuotas(ctx, log, fakeClient, shoot)).To(ConsistOf(
+					reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "garden", Name: "quota1"}},
+					reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "garden-dev", Name: "quota2"}},
+				))
+			})
+		})
+	})
+})
@@ -0,0 +1,181 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/apis/core/helper"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+// Reconciler reconciles Quotas and maintains the observed usage in their status.
+type Reconciler struct {
+	Client client.Client
+	Config config.QuotaUsageControllerConfiguration
+}
+
+// Reconcile computes the usage of the Quota by all Shoots using it and reports it in the Quota status.
+func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	quota := &gardencorev1beta1.Quota{}
+	if err := r.Client.Get(ctx, request.NamespacedName, quota); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if quota.DeletionTimestamp != nil {
+		return reconcile.Result{}, nil
+	}
+
+	status, err := r.computeStatus(ctx, quota)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed computing quota usage: %w", err)
+	}
+
+	if !apiequality.Semantic.DeepEqual(quota.Status, *status) {
+		log.V(1).Info("Updating quota usage")
+		patch := client.MergeFrom(quota.DeepCopy())
+		quota.Status = *status
+		if err := r.Client.Status().Patch(ctx, quota, patch); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed updating quota status: %w", err)
+		}
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) computeStatus(ctx context.Context, quota *gardencorev1beta1.Quota) (*gardencorev1beta1.QuotaStatus, error) {
+	scope, err := helper.QuotaScope(quota.Spec.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	secretBindingList := &gardencorev1beta1.SecretBindingList{}
+	if err := r.Client.List(ctx, secretBindingList); err != nil {
+		return nil, err
+	}
+
+	var (
+		usages        = make(map[string]*gardencorev1beta1.QuotaUsage)
+		cloudProfiles = make(map[string]*gardencorev1beta1.CloudProfile)
+		usageFor      = func(namespace string) *gardencorev1beta1.QuotaUsage {
+			if scope != "project" {
+				namespace = ""
+			}
+
+			if _, ok := usages[namespace]; !ok {
+				usages[namespace] = &gardencorev1beta1.QuotaUsage{Used: make(corev1.ResourceList, len(quota.Spec.Metrics))}
+				if scope == "project" {
+					usages[namespace].Namespace = ptr.To(namespace)
+				}
+				for metric := range quota.Spec.Metrics {
+					usages[namespace].Used[metric] = resource.Quantity{Format: resource.DecimalSI}
+				}
+			}
+
+			return usages[namespace]
+		}
+	)
+
+	// Quotas with scope 'secret' always report a usage, even if no SecretBinding references them yet.
+	if scope != "project" {
+		usageFor("")
+	}
+
+	for _, secretBinding := range secretBindingList.Items {
+		if !referencesQuota(secretBinding, quota) {
+			continue
+		}
+		usageFor(secretBinding.Namespace)
+
+		shootList := &gardencorev1beta1.ShootList{}
+		if err := r.Client.List(ctx, shootList, client.InNamespace(secretBinding.Namespace)); err != nil {
+			return nil, err
+		}
+
+		for _, shoot := range shootList.Items {
+			if ptr.Deref(shoot.Spec.SecretBindingName, "") != secretBinding.Name {
+				continue
+			}
+
+			cloudProfile, ok := cloudProfiles[shoot.Spec.CloudProfileName]
+			if !ok {
+				cloudProfile = &gardencorev1beta1.CloudProfile{}
+				if err := r.Client.Get(ctx, client.ObjectKey{Name: shoot.Spec.CloudProfileName}, cloudProfile); err != nil {
+					return nil, fmt.Errorf("failed reading CloudProfile %s of Shoot %s: %w", shoot.Spec.CloudProfileName, client.ObjectKeyFromObject(&shoot), err)
+				}
+				cloudProfiles[shoot.Spec.CloudProfileName] = cloudProfile
+			}
+
+			shootUsage, err := gardenerutils.ShootQuotaUsage(&shoot, cloudProfile)
+			if err != nil {
+				return nil, fmt.Errorf("failed computing resource usage of Shoot %s: %w", client.ObjectKeyFromObject(&shoot), err)
+			}
+
+			usage := usageFor(shoot.Namespace)
+			for metric := range quota.Spec.Metrics {
+				usage.Used[metric] = gardenerutils.SumQuantities(usage.Used[metric], shootUsage[metric])
+			}
+			usage.Shoots = append(usage.Shoots, gardencorev1beta1.QuotaUsageShoot{Namespace: shoot.Namespace, Name: shoot.Name, Generation: shoot.Generation})
+		}
+	}
+
+	status := &gardencorev1beta1.QuotaStatus{Hard: quota.Spec.Metrics.DeepCopy()}
+	for _, usage := range usages {
+		sort.Slice(usage.Shoots, func(i, j int) bool {
+			if usage.Shoots[i].Namespace != usage.Shoots[j].Namespace {
+				return usage.Shoots[i].Namespace < usage.Shoots[j].Namespace
+			}
+			return usage.Shoots[i].Name < usage.Shoots[j].Name
+		})
+		status.Usages = append(status.Usages, *usage)
+	}
+	sort.Slice(status.Usages, func(i, j int) bool {
+		return ptr.Deref(status.Usages[i].Namespace, "") < ptr.Deref(status.Usages[j].Namespace, "")
+	})
+
+	return status, nil
+}
+
+func referencesQuota(secretBinding gardencorev1beta1.SecretBinding, quota *gardencorev1beta1.Quota) bool {
+	for _, quotaRef := range secretBinding.Quotas {
+		if quotaRef.Name == quota.Name && quotaRef.Namespace == quota.Namespace {
+			return true
+		}
+	}
+	return false
+}
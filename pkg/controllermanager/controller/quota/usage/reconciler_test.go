@@ -0,0 +1,188 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package usage_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/quota/usage"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		reconciler *Reconciler
+
+		cloudProfile *gardencorev1beta1.CloudProfile
+		quota        *gardencorev1beta1.Quota
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).WithStatusSubresource(&gardencorev1beta1.Quota{}).Build()
+		reconciler = &Reconciler{
+			Client: fakeClient,
+			Config: config.QuotaUsageControllerConfiguration{SyncPeriod: &metav1.Duration{Duration: time.Minute}},
+		}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+			Spec: gardencorev1beta1.CloudProfileSpec{
+				MachineTypes: []gardencorev1beta1.MachineType{{
+					Name:   "machine-type",
+					CPU:    resource.MustParse("2"),
+					GPU:    resource.MustParse("0"),
+					Memory: resource.MustParse("4Gi"),
+				}},
+				VolumeTypes: []gardencorev1beta1.VolumeType{{
+					Name:  "volume-type",
+					Class: gardencorev1beta1.VolumeClassStandard,
+				}},
+			},
+		}
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+
+		quota = &gardencorev1beta1.Quota{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "garden", Name: "quota"},
+			Spec: gardencorev1beta1.QuotaSpec{
+				Metrics: corev1.ResourceList{
+					"cpu":    resource.MustParse("100"),
+					"memory": resource.MustParse("100Gi"),
+				},
+				Scope: corev1.ObjectReference{APIVersion: "v1", Kind: "Secret"},
+			},
+		}
+	})
+
+	createShoot := func(namespace, name, secretBindingName string, maximum int32) {
+		ExpectWithOffset(1, fakeClient.Create(ctx, &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name, Generation: 2},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName:  cloudProfile.Name,
+				SecretBindingName: ptr.To(secretBindingName),
+				Provider: gardencorev1beta1.Provider{
+					Workers: []gardencorev1beta1.Worker{{
+						Name:    "worker",
+						Machine: gardencorev1beta1.Machine{Type: "machine-type"},
+						Maximum: maximum,
+						Volume:  &gardencorev1beta1.Volume{Type: ptr.To("volume-type"), VolumeSize: "20Gi"},
+					}},
+				},
+			},
+		})).To(Succeed())
+	}
+
+	createSecretBinding := func(namespace, name string) {
+		ExpectWithOffset(1, fakeClient.Create(ctx, &gardencorev1beta1.SecretBinding{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Quotas:     []corev1.ObjectReference{{Namespace: quota.Namespace, Name: quota.Name}},
+		})).To(Succeed())
+	}
+
+	reconcileQuota := func() {
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(quota)})
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		ExpectWithOffset(1, result).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+		ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(quota), quota)).To(Succeed())
+	}
+
+	It("should do nothing if the quota does not exist", func() {
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(quota)})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+	})
+
+	It("should report zero usage for a quota with scope 'secret' which is not used", func() {
+		Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+
+		reconcileQuota()
+
+		Expect(quota.Status.Hard).To(Equal(quota.Spec.Metrics))
+		Expect(quota.Status.Usages).To(HaveLen(1))
+		Expect(quota.Status.Usages[0].Namespace).To(BeNil())
+		Expect(quota.Status.Usages[0].Used.Cpu().IsZero()).To(BeTrue())
+		Expect(quota.Status.Usages[0].Used.Memory().IsZero()).To(BeTrue())
+		Expect(quota.Status.Usages[0].Shoots).To(BeEmpty())
+	})
+
+	It("should account all shoots in a single usage for a quota with scope 'secret'", func() {
+		Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+		createSecretBinding("garden-a", "binding")
+		createSecretBinding("garden-b", "binding")
+		createShoot("garden-a", "shoot1", "binding", 2)
+		createShoot("garden-b", "shoot2", "binding", 3)
+		createShoot("garden-b", "shoot3", "other-binding", 5)
+
+		reconcileQuota()
+
+		Expect(quota.Status.Usages).To(HaveLen(1))
+		Expect(quota.Status.Usages[0].Namespace).To(BeNil())
+		Expect(quota.Status.Usages[0].Used).To(HaveLen(2))
+		Expect(quota.Status.Usages[0].Used.Cpu().Cmp(resource.MustParse("10"))).To(Equal(0))
+		Expect(quota.Status.Usages[0].Used.Memory().Cmp(resource.MustParse("20Gi"))).To(Equal(0))
+		Expect(quota.Status.Usages[0].Shoots).To(Equal([]gardencorev1beta1.QuotaUsageShoot{
+			{Namespace: "garden-a", Name: "shoot1", Generation: 2},
+			{Namespace: "garden-b", Name: "shoot2", Generation: 2},
+		}))
+	})
+
+	It("should account the shoots per project namespace for a quota with scope 'project'", func() {
+		quota.Spec.Scope = corev1.ObjectReference{APIVersion: "core.gardener.cloud/v1beta1", Kind: "Project"}
+		Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+		createSecretBinding("garden-a", "binding")
+		createSecretBinding("garden-b", "binding")
+		createShoot("garden-a", "shoot1", "binding", 2)
+		createShoot("garden-a", "shoot2", "binding", 1)
+
+		reconcileQuota()
+
+		Expect(quota.Status.Usages).To(HaveLen(2))
+		Expect(quota.Status.Usages[0].Namespace).To(PointTo(Equal("garden-a")))
+		Expect(quota.Status.Usages[0].Used.Cpu().Cmp(resource.MustParse("6"))).To(Equal(0))
+		Expect(quota.Status.Usages[0].Shoots).To(Equal([]gardencorev1beta1.QuotaUsageShoot{
+			{Namespace: "garden-a", Name: "shoot1", Generation: 2},
+			{Namespace: "garden-a", Name: "shoot2", Generation: 2},
+		}))
+		Expect(quota.Status.Usages[1].Namespace).To(PointTo(Equal("garden-b")))
+		Expect(quota.Status.Usages[1].Used.Cpu().IsZero()).To(BeTrue())
+		Expect(quota.Status.Usages[1].Shoots).To(BeEmpty())
+	})
+
+	It("should fail if the machine type of a shoot is unknown", func() {
+		Expect(fakeClient.Create(ctx, quota)).To(Succeed())
+		createSecretBinding("garden-a", "binding")
+		cloudProfile.Spec.MachineTypes = nil
+		Expect(fakeClient.Update(ctx, cloudProfile)).To(Succeed())
+		createShoot("garden-a", "shoot1", "binding", 2)
+
+		_, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(quota)})
+		Expect(err).To(MatchError(ContainSubstring("machineType machine-type not found")))
+	})
+})
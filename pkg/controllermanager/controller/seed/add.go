@@ -22,6 +22,7 @@ import (
 
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/backupbucketscheck"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/evacuation"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/extensionscheck"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/lifecycle"
 	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/secrets"
@@ -35,6 +36,12 @@ func AddToManager(ctx context.Context, mgr manager.Manager, cfg config.Controlle
 		return fmt.Errorf("failed adding backupbuckets check reconciler: %w", err)
 	}
 
+	if err := (&evacuation.Reconciler{
+		Config: *cfg.Controllers.SeedEvacuation,
+	}).AddToManager(mgr); err != nil {
+		return fmt.Errorf("failed adding evacuation reconciler: %w", err)
+	}
+
 	if err := (&extensionscheck.Reconciler{
 		Config: *cfg.Controllers.SeedExtensionsCheck,
 	}).AddToManager(ctx, mgr); err != nil {
@@ -0,0 +1,103 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evacuation_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seed/evacuation"
+)
+
+var _ = Describe("Add", func() {
+	var (
+		reconciler *Reconciler
+		seed       *gardencorev1beta1.Seed
+	)
+
+	BeforeEach(func() {
+		reconciler = &Reconciler{}
+		seed = &gardencorev1beta1.Seed{}
+	})
+
+	Describe("SeedPredicate", func() {
+		var (
+			p             predicate.Predicate
+			evacuatedSeed *gardencorev1beta1.Seed
+		)
+
+		BeforeEach(func() {
+			p = reconciler.SeedPredicate()
+
+			evacuatedSeed = seed.DeepCopy()
+			evacuatedSeed.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: gardencorev1beta1.SeedTaintEvacuation}}
+		})
+
+		Describe("#Create", func() {
+			It("should return false because object is no Seed", func() {
+				Expect(p.Create(event.CreateEvent{})).To(BeFalse())
+			})
+
+			It("should return false because seed does not have the evacuation taint", func() {
+				Expect(p.Create(event.CreateEvent{Object: seed})).To(BeFalse())
+			})
+
+			It("should return true because seed has the evacuation taint", func() {
+				Expect(p.Create(event.CreateEvent{Object: evacuatedSeed})).To(BeTrue())
+			})
+		})
+
+		Describe("#Update", func() {
+			It("should return false because object is no Seed", func() {
+				Expect(p.Update(event.UpdateEvent{})).To(BeFalse())
+			})
+
+			It("should return false because old object is no Seed", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectNew: seed})).To(BeFalse())
+			})
+
+			It("should return false because the evacuation taint is absent in both objects", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: seed})).To(BeFalse())
+			})
+
+			It("should return false because the evacuation taint is present in both objects", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectOld: evacuatedSeed, ObjectNew: evacuatedSeed})).To(BeFalse())
+			})
+
+			It("should return true because the evacuation taint was added", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectOld: seed, ObjectNew: evacuatedSeed})).To(BeTrue())
+			})
+
+			It("should return true because the evacuation taint was removed", func() {
+				Expect(p.Update(event.UpdateEvent{ObjectOld: evacuatedSeed, ObjectNew: seed})).To(BeTrue())
+			})
+		})
+
+		Describe("#Delete", func() {
+			It("should return false", func() {
+				Expect(p.Delete(event.DeleteEvent{Object: evacuatedSeed})).To(BeFalse())
+			})
+		})
+
+		Describe("#Generic", func() {
+			It("should return false", func() {
+				Expect(p.Generic(event.GenericEvent{Object: evacuatedSeed})).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,323 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evacuation
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/clock"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	"github.com/gardener/gardener/pkg/controllermanager/controller/seed/utils"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
+)
+
+// Reconciler reconciles Seeds carrying the evacuation taint. It migrates the Shoots scheduled onto such Seeds to other
+// Seeds and maintains the Evacuated condition.
+type Reconciler struct {
+	Client          client.Client
+	Config          config.SeedEvacuationControllerConfiguration
+	Clock           clock.Clock
+	Recorder        record.EventRecorder
+	Framework       *framework.Framework
+	GardenNamespace string
+}
+
+// Reconcile reconciles Seeds carrying the evacuation taint. It migrates the Shoots scheduled onto such Seeds to other
+// Seeds and maintains the Evacuated condition.
+func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(ctx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	seed := &gardencorev1beta1.Seed{}
+	if err := r.Client.Get(ctx, req.NamespacedName, seed); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	if !isEvacuating(seed) {
+		return reconcile.Result{}, r.removeEvacuatedCondition(ctx, log, seed)
+	}
+
+	shootsOnSeed := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootsOnSeed, client.MatchingFields{core.ShootSeedName: seed.Name}); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing shoots scheduled onto seed: %w", err)
+	}
+
+	shootsWithSeedInStatus := &gardencorev1beta1.ShootList{}
+	if err := r.Client.List(ctx, shootsWithSeedInStatus, client.MatchingFields{core.ShootStatusSeedName: seed.Name}); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing shoots running on seed: %w", err)
+	}
+
+	var (
+		migrating  int
+		started    int
+		failures   []string
+		candidates []*gardencorev1beta1.Shoot
+	)
+
+	for _, shoot := range shootsWithSeedInStatus.Items {
+		if shoot.Spec.SeedName == nil || *shoot.Spec.SeedName == seed.Name {
+			continue
+		}
+
+		migrating++
+		if lastOperationFailed(&shoot) {
+			failures = append(failures, fmt.Sprintf("%s: migration to seed %q failed: %s", client.ObjectKeyFromObject(&shoot), *shoot.Spec.SeedName, shoot.Status.LastOperation.Description))
+		}
+	}
+
+	slices.SortFunc(shootsOnSeed.Items, func(a, b gardencorev1beta1.Shoot) int {
+		return strings.Compare(client.ObjectKeyFromObject(&a).String(), client.ObjectKeyFromObject(&b).String())
+	})
+
+	if seed.Spec.Backup == nil {
+		failures = append(failures, "seed has no backup configured, hence the control planes of its shoots cannot be migrated")
+	} else {
+		for _, shoot := range shootsOnSeed.Items {
+			shoot := shoot.DeepCopy()
+
+			mustWait, err := r.mustWait(ctx, shoot)
+			if err != nil {
+				failures = append(failures, fmt.Sprintf("%s: %s", client.ObjectKeyFromObject(shoot), err))
+				continue
+			}
+			if !mustWait {
+				candidates = append(candidates, shoot)
+			}
+		}
+	}
+
+	for _, shoot := range candidates {
+		if migrating >= ptr.Deref(r.Config.MaxConcurrentMigrations, 1) {
+			break
+		}
+
+		log := log.WithValues("shoot", client.ObjectKeyFromObject(shoot))
+
+		targetSeed, err := r.migrateShoot(ctx, log, seed, shoot)
+		if err != nil {
+			log.Error(err, "Failed migrating shoot off evacuated seed")
+			r.Recorder.Eventf(shoot, corev1.EventTypeWarning, gardencorev1beta1.ShootEventEvacuationFailed, "Failed migrating shoot off evacuated seed %q: %s", seed.Name, err)
+			failures = append(failures, fmt.Sprintf("%s: %s", client.ObjectKeyFromObject(shoot), err))
+			continue
+		}
+
+		log.Info("Started migration of shoot off evacuated seed", "targetSeed", targetSeed)
+		r.Recorder.Eventf(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventEvacuationStarted, "Migrating shoot from evacuated seed %q to seed %q", seed.Name, targetSeed)
+		migrating++
+		started++
+	}
+
+	if err := utils.PatchSeedCondition(ctx, log, r.Client.Status(), seed, r.evacuatedCondition(seed, len(shootsOnSeed.Items)-started, migrating, failures)); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+// mustWait returns true if the migration of the given shoot cannot be started right now. It returns an error if the
+// shoot cannot be migrated at all without manual intervention.
+func (r *Reconciler) mustWait(ctx context.Context, shoot *gardencorev1beta1.Shoot) (bool, error) {
+	if shoot.DeletionTimestamp != nil {
+		return true, nil
+	}
+
+	if lastOperationFailed(shoot) {
+		return true, fmt.Errorf("last operation failed: %s", shoot.Status.LastOperation.Description)
+	}
+
+	if shoot.Status.LastOperation == nil || shoot.Status.LastOperation.State == gardencorev1beta1.LastOperationStateProcessing {
+		return true, nil
+	}
+
+	if ptr.Deref(r.Config.RespectMaintenanceTimeWindow, true) {
+		window, err := gardenerutils.EffectiveShootMaintenanceTimeWindowWithProject(ctx, r.Client, shoot)
+		if err != nil {
+			return true, err
+		}
+		if !window.Contains(r.Clock.Now()) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// migrateShoot determines a target seed for the given shoot and binds the shoot to it. The target seed is selected by
+// the filter and score plugins of the scheduler among the seeds which are eligible for control plane migration.
+func (r *Reconciler) migrateShoot(ctx context.Context, log logr.Logger, sourceSeed *gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot) (string, error) {
+	state, seeds, err := framework.NewCycleState(ctx, log, r.Client, r.GardenNamespace, shoot)
+	if err != nil {
+		return "", fmt.Errorf("failed reading scheduling data: %w", err)
+	}
+
+	controllerRegistrationList := &gardencorev1beta1.ControllerRegistrationList{}
+	if err := r.Client.List(ctx, controllerRegistrationList); err != nil {
+		return "", fmt.Errorf("failed listing controller registrations: %w", err)
+	}
+
+	shootState := &gardencorev1beta1.ShootState{}
+	if err := r.Client.Get(ctx, client.ObjectKeyFromObject(shoot), shootState); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return "", fmt.Errorf("failed reading ShootState: %w", err)
+		}
+		shootState = nil
+	}
+
+	var (
+		eligibleSeeds []gardencorev1beta1.Seed
+		rejections    []string
+	)
+
+	for _, seed := range seeds {
+		if seed.Name == sourceSeed.Name ||
+			isEvacuating(&seed) ||
+			seed.Spec.Backup == nil ||
+			seed.Spec.Provider.Type != sourceSeed.Spec.Provider.Type {
+			continue
+		}
+
+		if err := checkTargetSeed(shoot, shootState, sourceSeed, &seed, controllerRegistrationList); err != nil {
+			rejections = append(rejections, fmt.Sprintf("%s: %s", seed.Name, err))
+			continue
+		}
+		eligibleSeeds = append(eligibleSeeds, seed)
+	}
+
+	if len(eligibleSeeds) == 0 {
+		if len(rejections) > 0 {
+			return "", fmt.Errorf("none of the seeds with provider type %q and backup configuration can host the control plane of the shoot: %s", sourceSeed.Spec.Provider.Type, strings.Join(rejections, "; "))
+		}
+		return "", fmt.Errorf("no seed with provider type %q and backup configuration is available for control plane migration", sourceSeed.Spec.Provider.Type)
+	}
+
+	result, err := r.Framework.Schedule(ctx, log, state, eligibleSeeds)
+	if err != nil {
+		return "", fmt.Errorf("failed determining target seed: %w", err)
+	}
+	targetSeed := result.SelectedSeed().Seed.Name
+
+	shoot.Spec.SeedName = &targetSeed
+	if err := r.Client.SubResource("binding").Update(ctx, shoot); err != nil {
+		return "", fmt.Errorf("failed binding shoot to seed %q: %w", targetSeed, err)
+	}
+
+	return targetSeed, nil
+}
+
+// checkTargetSeed returns an error if the control plane of the given shoot cannot be migrated from the source seed to
+// the target seed. These are the same constraints which are enforced by the 'ShootValidator' admission plugin when
+// changing the seed of a shoot, hence, binding the shoot to a seed violating them would be rejected anyway.
+func checkTargetSeed(shoot *gardencorev1beta1.Shoot, shootState *gardencorev1beta1.ShootState, sourceSeed, targetSeed *gardencorev1beta1.Seed, controllerRegistrationList *gardencorev1beta1.ControllerRegistrationList) error {
+	if missingExtensions := gardenerutils.ComputeMissingExtensionsForMigration(shoot, sourceSeed, targetSeed, controllerRegistrationList); len(missingExtensions) > 0 {
+		return fmt.Errorf("no ControllerRegistration can be deployed to the seed for the following extensions required by the shoot: %s", strings.Join(missingExtensions, ", "))
+	}
+
+	missingKey, err := shootstate.MissingKeyEncryptionKey(shootState, sourceSeed, targetSeed)
+	if err != nil {
+		return err
+	}
+	if missingKey != "" {
+		return fmt.Errorf("the key encryption key %q for the ShootState is not available to the gardenlet of the seed", missingKey)
+	}
+
+	if v1beta1helper.IsMultiZonalShootControlPlane(shoot) && len(targetSeed.Spec.Provider.Zones) < 3 {
+		return fmt.Errorf("seed has only %d zones but at least 3 zones are required for hosting a shoot control plane with failure tolerance type 'zone'", len(targetSeed.Spec.Provider.Zones))
+	}
+
+	if networking := shoot.Spec.Networking; networking != nil {
+		if errs := cidrvalidation.ValidateNetworkDisjointedness(
+			field.NewPath("spec", "networking"),
+			networking.Nodes,
+			networking.Pods,
+			networking.Services,
+			targetSeed.Spec.Networks.Nodes,
+			targetSeed.Spec.Networks.Pods,
+			targetSeed.Spec.Networks.Services,
+			v1beta1helper.IsWorkerless(shoot),
+		); len(errs) > 0 {
+			return fmt.Errorf("networks of the shoot overlap with the networks of the seed: %w", errs.ToAggregate())
+		}
+	}
+
+	return nil
+}
+
+func (r *Reconciler) evacuatedCondition(seed *gardencorev1beta1.Seed, pending, migrating int, failures []string) gardencorev1beta1.Condition {
+	condition := v1beta1helper.GetOrInitConditionWithClock(r.Clock, seed.Status.Conditions, gardencorev1beta1.SeedEvacuated)
+
+	if pending+migrating == 0 {
+		return v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionTrue, "EvacuationSucceeded", "All shoots have been migrated off the seed.")
+	}
+
+	var (
+		reason  = "EvacuationProgressing"
+		message = fmt.Sprintf("%d shoot(s) are still scheduled onto the seed, %d shoot(s) are currently being migrated.", pending, migrating)
+	)
+
+	if len(failures) > 0 {
+		reason = "EvacuationFailing"
+		message += " The following issues prevent the evacuation:"
+		for _, failure := range failures {
+			message += fmt.Sprintf("\n* %s", failure)
+		}
+	}
+
+	return v1beta1helper.UpdatedConditionWithClock(r.Clock, condition, gardencorev1beta1.ConditionFalse, reason, message)
+}
+
+func (r *Reconciler) removeEvacuatedCondition(ctx context.Context, log logr.Logger, seed *gardencorev1beta1.Seed) error {
+	if v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedEvacuated) == nil {
+		return nil
+	}
+
+	patch := client.StrategicMergeFrom(seed.DeepCopy())
+	seed.Status.Conditions = v1beta1helper.RemoveConditions(seed.Status.Conditions, gardencorev1beta1.SeedEvacuated)
+	if err := r.Client.Status().Patch(ctx, seed, patch); err != nil {
+		return fmt.Errorf("failed removing %s condition: %w", gardencorev1beta1.SeedEvacuated, err)
+	}
+
+	log.Info("Removed condition because seed is no longer evacuated", "conditionType", gardencorev1beta1.SeedEvacuated)
+	return nil
+}
+
+func lastOperationFailed(shoot *gardencorev1beta1.Shoot) bool {
+	return shoot.Status.LastOperation != nil && shoot.Status.LastOperation.State == gardencorev1beta1.LastOperationStateFailed
+}
@@ -0,0 +1,468 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package evacuation_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
+	. "github.com/gardener/gardener/pkg/controllermanager/controller/seed/evacuation"
+	schedulerconfig "github.com/gardener/gardener/pkg/scheduler/apis/config"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	const syncPeriod = time.Minute
+
+	var (
+		ctx = context.TODO()
+		c   client.Client
+
+		fakeClock *testclock.FakeClock
+		recorder  *record.FakeRecorder
+		conf      config.SeedEvacuationControllerConfiguration
+
+		reconciler *Reconciler
+		request    reconcile.Request
+
+		seed                   *gardencorev1beta1.Seed
+		targetSeed             *gardencorev1beta1.Seed
+		controllerRegistration *gardencorev1beta1.ControllerRegistration
+	)
+
+	newSeed := func(name string) *gardencorev1beta1.Seed {
+		return &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: gardencorev1beta1.SeedSpec{
+				Provider: gardencorev1beta1.SeedProvider{Type: "local"},
+				Backup:   &gardencorev1beta1.SeedBackup{Provider: "local"},
+			},
+		}
+	}
+
+	newShoot := func(name string) *gardencorev1beta1.Shoot {
+		return &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-foo"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: "profile",
+				SeedName:         ptr.To(seed.Name),
+				Maintenance: &gardencorev1beta1.Maintenance{
+					TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+				},
+			},
+			Status: gardencorev1beta1.ShootStatus{
+				SeedName: ptr.To(seed.Name),
+				LastOperation: &gardencorev1beta1.LastOperation{
+					Type:  gardencorev1beta1.LastOperationTypeReconcile,
+					State: gardencorev1beta1.LastOperationStateSucceeded,
+				},
+			},
+		}
+	}
+
+	createShoot := func(shoot *gardencorev1beta1.Shoot) {
+		status := shoot.Status
+		ExpectWithOffset(1, c.Create(ctx, shoot)).To(Succeed())
+		shoot.Status = status
+		ExpectWithOffset(1, c.Status().Update(ctx, shoot)).To(Succeed())
+	}
+
+	boundSeedName := func(shoot *gardencorev1beta1.Shoot) string {
+		ExpectWithOffset(1, c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+		return ptr.Deref(shoot.Spec.SeedName, "")
+	}
+
+	reconcileAndGetSeed := func() {
+		result, err := reconciler.Reconcile(ctx, request)
+		ExpectWithOffset(1, err).NotTo(HaveOccurred())
+		ExpectWithOffset(1, result).To(Equal(reconcile.Result{RequeueAfter: syncPeriod}))
+		ExpectWithOffset(1, c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		fakeClock = testclock.NewFakeClock(time.Date(2024, time.January, 1, 22, 30, 0, 0, time.UTC))
+		recorder = record.NewFakeRecorder(10)
+
+		seed = newSeed("seed")
+		seed.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: gardencorev1beta1.SeedTaintEvacuation}}
+		targetSeed = newSeed("target")
+		controllerRegistration = &gardencorev1beta1.ControllerRegistration{
+			ObjectMeta: metav1.ObjectMeta{Name: "provider-local"},
+			Spec: gardencorev1beta1.ControllerRegistrationSpec{
+				Resources: []gardencorev1beta1.ControllerResource{
+					{Kind: extensionsv1alpha1.BackupBucketResource, Type: "local"},
+					{Kind: extensionsv1alpha1.BackupEntryResource, Type: "local"},
+					{Kind: extensionsv1alpha1.ControlPlaneResource, Type: "local"},
+				},
+			},
+		}
+
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(seed)}
+
+		c = fakeclient.NewClientBuilder().
+			WithScheme(kubernetes.GardenScheme).
+			WithObjects(seed, targetSeed, controllerRegistration, &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "profile"}}).
+			WithStatusSubresource(&gardencorev1beta1.Seed{}, &gardencorev1beta1.Shoot{}).
+			WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootSeedName, func(obj client.Object) []string {
+				return []string{ptr.Deref(obj.(*gardencorev1beta1.Shoot).Spec.SeedName, "")}
+			}).
+			WithIndex(&gardencorev1beta1.Shoot{}, core.ShootStatusSeedName, func(obj client.Object) []string {
+				return []string{ptr.Deref(obj.(*gardencorev1beta1.Shoot).Status.SeedName, "")}
+			}).
+			WithInterceptorFuncs(interceptor.Funcs{
+				SubResourceUpdate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+					if subResourceName == "binding" {
+						return c.Update(ctx, obj)
+					}
+					return c.SubResource(subResourceName).Update(ctx, obj, opts...)
+				},
+			}).
+			Build()
+
+		conf = config.SeedEvacuationControllerConfiguration{
+			SyncPeriod:                   &metav1.Duration{Duration: syncPeriod},
+			MaxConcurrentMigrations:      ptr.To(1),
+			RespectMaintenanceTimeWindow: ptr.To(true),
+		}
+	})
+
+	JustBeforeEach(func() {
+		f, err := framework.NewFramework(framework.Registry{}, schedulerconfig.Plugins{}, &schedulerconfig.ShootSchedulerConfiguration{})
+		Expect(err).NotTo(HaveOccurred())
+
+		reconciler = &Reconciler{
+			Client:          c,
+			Config:          conf,
+			Clock:           fakeClock,
+			Recorder:        recorder,
+			Framework:       f,
+			GardenNamespace: "garden",
+		}
+	})
+
+	It("should do nothing if Seed is gone", func() {
+		Expect(c.Delete(ctx, seed)).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+	})
+
+	It("should remove the Evacuated condition if the seed is no longer evacuated", func() {
+		seed.Spec.Taints = nil
+		Expect(c.Update(ctx, seed)).To(Succeed())
+		seed.Status.Conditions = []gardencorev1beta1.Condition{
+			{Type: gardencorev1beta1.SeedEvacuated, Status: gardencorev1beta1.ConditionFalse},
+			{Type: gardencorev1beta1.SeedBackupBucketsReady, Status: gardencorev1beta1.ConditionTrue},
+		}
+		Expect(c.Status().Update(ctx, seed)).To(Succeed())
+
+		result, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(reconcile.Result{}))
+
+		Expect(c.Get(ctx, request.NamespacedName, seed)).To(Succeed())
+		Expect(seed.Status.Conditions).To(ConsistOf(OfType(gardencorev1beta1.SeedBackupBucketsReady)))
+	})
+
+	It("should set the Evacuated condition to True if no shoots are left on the seed", func() {
+		reconcileAndGetSeed()
+
+		Expect(seed.Status.Conditions).To(ConsistOf(And(
+			OfType(gardencorev1beta1.SeedEvacuated),
+			WithStatus(gardencorev1beta1.ConditionTrue),
+			WithReason("EvacuationSucceeded"),
+		)))
+	})
+
+	It("should migrate shoots to the selected seed respecting the maximum number of concurrent migrations", func() {
+		shoot1, shoot2 := newShoot("shoot1"), newShoot("shoot2")
+		createShoot(shoot1)
+		createShoot(shoot2)
+
+		reconcileAndGetSeed()
+
+		Expect(boundSeedName(shoot1)).To(Equal(targetSeed.Name))
+		Expect(boundSeedName(shoot2)).To(Equal(seed.Name))
+		Expect(recorder.Events).To(Receive(ContainSubstring(gardencorev1beta1.ShootEventEvacuationStarted)))
+		Expect(seed.Status.Conditions).To(ConsistOf(And(
+			OfType(gardencorev1beta1.SeedEvacuated),
+			WithStatus(gardencorev1beta1.ConditionFalse),
+			WithReason("EvacuationProgressing"),
+			WithMessage("1 shoot(s) are still scheduled onto the seed, 1 shoot(s) are currently being migrated."),
+		)))
+
+		By("Wait for the running migration")
+		reconcileAndGetSeed()
+		Expect(boundSeedName(shoot2)).To(Equal(seed.Name))
+
+		By("Complete the running migration")
+		shoot1.Status.SeedName = ptr.To(targetSeed.Name)
+		Expect(c.Status().Update(ctx, shoot1)).To(Succeed())
+
+		reconcileAndGetSeed()
+		Expect(boundSeedName(shoot2)).To(Equal(targetSeed.Name))
+	})
+
+	It("should not migrate shoots which are being reconciled or deleted", func() {
+		shoot1, shoot2 := newShoot("shoot1"), newShoot("shoot2")
+		shoot1.Status.LastOperation.State = gardencorev1beta1.LastOperationStateProcessing
+		shoot2.Finalizers = []string{"gardener"}
+		createShoot(shoot1)
+		createShoot(shoot2)
+		Expect(c.Delete(ctx, shoot2)).To(Succeed())
+
+		reconcileAndGetSeed()
+
+		Expect(boundSeedName(shoot1)).To(Equal(seed.Name))
+		Expect(boundSeedName(shoot2)).To(Equal(seed.Name))
+		Expect(seed.Status.Conditions).To(ConsistOf(WithReason("EvacuationProgressing")))
+	})
+
+	Context("maintenance time window", func() {
+		var shoot *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			shoot = newShoot("shoot")
+			shoot.Spec.Maintenance.TimeWindow = &gardencorev1beta1.MaintenanceTimeWindow{Begin: "100000+0000", End: "110000+0000"}
+			createShoot(shoot)
+		})
+
+		It("should not migrate shoots outside of their maintenance time window", func() {
+			reconcileAndGetSeed()
+
+			Expect(boundSeedName(shoot)).To(Equal(seed.Name))
+		})
+
+		Context("maintenance time window is not respected", func() {
+			BeforeEach(func() {
+				conf.RespectMaintenanceTimeWindow = ptr.To(false)
+			})
+
+			It("should migrate shoots outside of their maintenance time window", func() {
+				reconcileAndGetSeed()
+
+				Expect(boundSeedName(shoot)).To(Equal(targetSeed.Name))
+			})
+		})
+	})
+
+	Context("failures", func() {
+		var shoot *gardencorev1beta1.Shoot
+
+		BeforeEach(func() {
+			shoot = newShoot("shoot")
+		})
+
+		It("should report shoots whose last operation failed", func() {
+			shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateFailed
+			shoot.Status.LastOperation.Description = "foo"
+			createShoot(shoot)
+
+			reconcileAndGetSeed()
+
+			Expect(boundSeedName(shoot)).To(Equal(seed.Name))
+			Expect(seed.Status.Conditions).To(ConsistOf(And(
+				WithStatus(gardencorev1beta1.ConditionFalse),
+				WithReason("EvacuationFailing"),
+				WithMessageSubstrings("garden-foo/shoot: last operation failed: foo"),
+			)))
+		})
+
+		It("should report failed migrations", func() {
+			shoot.Spec.SeedName = ptr.To(targetSeed.Name)
+			shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{
+				Type:        gardencorev1beta1.LastOperationTypeMigrate,
+				State:       gardencorev1beta1.LastOperationStateFailed,
+				Description: "foo",
+			}
+			createShoot(shoot)
+
+			reconcileAndGetSeed()
+
+			Expect(seed.Status.Conditions).To(ConsistOf(And(
+				WithReason("EvacuationFailing"),
+				WithMessageSubstrings(fmt.Sprintf("garden-foo/shoot: migration to seed %q failed: foo", targetSeed.Name)),
+			)))
+		})
+
+		It("should report that the seed has no backup", func() {
+			seed.Spec.Backup = nil
+			Expect(c.Update(ctx, seed)).To(Succeed())
+			createShoot(shoot)
+
+			reconcileAndGetSeed()
+
+			Expect(boundSeedName(shoot)).To(Equal(seed.Name))
+			Expect(seed.Status.Conditions).To(ConsistOf(And(
+				WithReason("EvacuationFailing"),
+				WithMessageSubstrings("seed has no backup configured"),
+			)))
+		})
+
+		It("should report that no eligible target seed exists", func() {
+			Expect(c.Delete(ctx, targetSeed)).To(Succeed())
+
+			otherProviderSeed := newSeed("other-provider")
+			otherProviderSeed.Spec.Provider.Type = "other"
+			noBackupSeed := newSeed("no-backup")
+			noBackupSeed.Spec.Backup = nil
+			evacuatedSeed := newSeed("evacuated")
+			evacuatedSeed.Spec.Taints = []gardencorev1beta1.SeedTaint{{Key: gardencorev1beta1.SeedTaintEvacuation}}
+
+			for _, obj := range []client.Object{otherProviderSeed, noBackupSeed, evacuatedSeed} {
+				Expect(c.Create(ctx, obj)).To(Succeed())
+			}
+			createShoot(shoot)
+
+			reconcileAndGetSeed()
+
+			Expect(boundSeedName(shoot)).To(Equal(seed.Name))
+			Expect(recorder.Events).To(Receive(ContainSubstring(gardencorev1beta1.ShootEventEvacuationFailed)))
+			Expect(seed.Status.Conditions).To(ConsistOf(And(
+				WithReason("EvacuationFailing"),
+				WithMessageSubstrings(`no seed with provider type "local" and backup configuration is available`),
+			)))
+		})
+
+		It("should report that no ControllerRegistration can be deployed to the target seed for a required extension", func() {
+			controllerRegistration.Spec.Deployment = &gardencorev1beta1.ControllerRegistrationDeployment{
+				SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+			}
+			Expect(c.Update(ctx, controllerRegistration)).To(Succeed())
+			createShoot(shoot)
+
+			reconcileAndGetSeed()
+
+			Expect(boundSeedName(shoot)).To(Equal(seed.Name))
+			Expect(seed.Status.Conditions).To(ConsistOf(And(
+				WithReason("EvacuationFailing"),
+				WithMessageSubstrings(`target: no ControllerRegistration can be deployed to the seed for the following extensions required by the shoot: BackupBucket/local, BackupEntry/local, ControlPlane/local`),
+			)))
+		})
+
+		It("should report that the key encryption key for the ShootState is not available to the target seed", func() {
+			seed.Status.ShootStateEncryptionKeys = []gardencorev1beta1.ShootStateEncryptionKey{
+				{Name: "key1", Fingerprint: "fingerprint1", Primary: true},
+				{Name: "key2", Fingerprint: "fingerprint2"},
+			}
+			Expect(c.Status().Update(ctx, seed)).To(Succeed())
+			targetSeed.Status.ShootStateEncryptionKeys = []gardencorev1beta1.ShootStateEncryptionKey{{Name: "key1", Fingerprint: "fingerprint2"}}
+			Expect(c.Status().Update(ctx, targetSeed)).To(Succeed())
+			createShoot(shoot)
+
+			reconcileAndGetSeed()
+
+			Expect(boundSeedName(shoot)).To(Equal(seed.Name))
+			Expect(seed.Status.Conditions).To(ConsistOf(And(
+				WithReason("EvacuationFailing"),
+				WithMessageSubstrings(`target: the key encryption key "key1" for the ShootState is not available to the gardenlet of the seed`),
+			)))
+		})
+
+		It("should migrate the shoot if the key encryption key used for the existing ShootState is available to the target seed", func() {
+			seed.Status.ShootStateEncryptionKeys = []gardencorev1beta1.ShootStateEncryptionKey{
+				{Name: "key1", Fingerprint: "fingerprint1", Primary: true},
+				{Name: "key2", Fingerprint: "fingerprint2"},
+			}
+			Expect(c.Status().Update(ctx, seed)).To(Succeed())
+			targetSeed.Status.ShootStateEncryptionKeys = []gardencorev1beta1.ShootStateEncryptionKey{{Name: "key2", Fingerprint: "fingerprint2"}}
+			Expect(c.Status().Update(ctx, targetSeed)).To(Succeed())
+			createShoot(shoot)
+			Expect(c.Create(ctx, &gardencorev1beta1.ShootState{
+				ObjectMeta: metav1.ObjectMeta{Name: shoot.Name, Namespace: shoot.Namespace},
+				Spec: gardencorev1beta1.ShootStateSpec{
+					Gardener: []gardencorev1beta1.GardenerResourceData{{
+						Name: "data-encryption-key",
+						Type: "data-encryption-key",
+						Data: runtime.RawExtension{Raw: []byte(`{"keyName":"key2","keyFingerprint":"fingerprint2","wrappedKey":""}`)},
+					}},
+				},
+			})).To(Succeed())
+
+			reconcileAndGetSeed()
+
+			Expect(boundSeedName(shoot)).To(Equal(targetSeed.Name))
+		})
+
+		It("should report that the target seed has not enough zones for a multi-zonal shoot control plane", func() {
+			shoot.Spec.ControlPlane = &gardencorev1beta1.ControlPlane{
+				HighAvailability: &gardencorev1beta1.HighAvailability{
+					FailureTolerance: gardencorev1beta1.FailureTolerance{Type: gardencorev1beta1.FailureToleranceTypeZone},
+				},
+			}
+			createShoot(shoot)
+
+			reconcileAndGetSeed()
+
+			Expect(boundSeedName(shoot)).To(Equal(seed.Name))
+			Expect(seed.Status.Conditions).To(ConsistOf(And(
+				WithReason("EvacuationFailing"),
+				WithMessageSubstrings(`target: seed has only 0 zones but at least 3 zones are required`),
+			)))
+		})
+
+		It("should migrate a multi-zonal shoot control plane to a seed with at least three zones", func() {
+			targetSeed.Spec.Provider.Zones = []string{"a", "b", "c"}
+			Expect(c.Update(ctx, targetSeed)).To(Succeed())
+			shoot.Spec.ControlPlane = &gardencorev1beta1.ControlPlane{
+				HighAvailability: &gardencorev1beta1.HighAvailability{
+					FailureTolerance: gardencorev1beta1.FailureTolerance{Type: gardencorev1beta1.FailureToleranceTypeZone},
+				},
+			}
+			createShoot(shoot)
+
+			reconcileAndGetSeed()
+
+			Expect(boundSeedName(shoot)).To(Equal(targetSeed.Name))
+		})
+
+		It("should report that the networks of the target seed overlap with the shoot networks", func() {
+			targetSeed.Spec.Networks = gardencorev1beta1.SeedNetworks{Pods: "10.1.0.0/16", Services: "10.2.0.0/16"}
+			Expect(c.Update(ctx, targetSeed)).To(Succeed())
+			shoot.Spec.Networking = &gardencorev1beta1.Networking{Services: ptr.To("10.2.0.0/16")}
+			createShoot(shoot)
+
+			reconcileAndGetSeed()
+
+			Expect(boundSeedName(shoot)).To(Equal(seed.Name))
+			Expect(seed.Status.Conditions).To(ConsistOf(And(
+				WithReason("EvacuationFailing"),
+				WithMessageSubstrings(`target: networks of the shoot overlap with the networks of the seed`, `shoot service network intersects with seed service network`),
+			)))
+		})
+	})
+})
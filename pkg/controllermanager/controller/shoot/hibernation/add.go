@@ -26,6 +26,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 )
 
 // ControllerName is the name of this controller.
@@ -61,7 +62,7 @@ func (r *Reconciler) ShootPredicate() predicate.Predicate {
 			if !ok {
 				return false
 			}
-			return len(getShootHibernationSchedules(shoot.Spec.Hibernation)) > 0
+			return len(getShootHibernationSchedules(shoot.Spec.Hibernation)) > 0 || getShootHibernationIdlePolicy(shoot.Spec.Hibernation) != nil
 		},
 		UpdateFunc: func(e event.UpdateEvent) bool {
 			shoot, ok := e.ObjectNew.(*gardencorev1beta1.Shoot)
@@ -79,7 +80,17 @@ func (r *Reconciler) ShootPredicate() predicate.Predicate {
 				newSchedules = getShootHibernationSchedules(shoot.Spec.Hibernation)
 			)
 
-			return !reflect.DeepEqual(oldSchedules, newSchedules) && len(newSchedules) > 0
+			if !reflect.DeepEqual(oldSchedules, newSchedules) && len(newSchedules) > 0 {
+				return true
+			}
+
+			// Shoots with an idle policy are requeued at their idle deadline. The deadline changes when the idle policy
+			// is changed or when the shoot is hibernated or woken up, hence these events must trigger a reconciliation.
+			newIdlePolicy := getShootHibernationIdlePolicy(shoot.Spec.Hibernation)
+			return newIdlePolicy != nil &&
+				(!reflect.DeepEqual(getShootHibernationIdlePolicy(oldShoot.Spec.Hibernation), newIdlePolicy) ||
+					oldShoot.Status.IsHibernated != shoot.Status.IsHibernated ||
+					v1beta1helper.HibernationIsEnabled(oldShoot) != v1beta1helper.HibernationIsEnabled(shoot))
 		},
 	}
 }
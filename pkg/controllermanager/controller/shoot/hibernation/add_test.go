@@ -15,6 +15,8 @@
 package hibernation_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -69,6 +71,11 @@ var _ = Describe("Add", func() {
 			It("should return true because shoot has hibernation schedules", func() {
 				Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
 			})
+
+			It("should return true because shoot has an idle policy", func() {
+				shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{IdlePolicy: &gardencorev1beta1.HibernationIdlePolicy{IdleDuration: metav1.Duration{Duration: time.Hour}}}
+				Expect(p.Create(event.CreateEvent{Object: shoot})).To(BeTrue())
+			})
 		})
 
 		Describe("#Update", func() {
@@ -95,6 +102,50 @@ var _ = Describe("Add", func() {
 				shoot.Spec.Hibernation.Schedules[0].Start = ptr.To("00 20 * * 1,2,3,4,5,6,7")
 				Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
 			})
+
+			Context("idle policy", func() {
+				BeforeEach(func() {
+					shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{IdlePolicy: &gardencorev1beta1.HibernationIdlePolicy{IdleDuration: metav1.Duration{Duration: time.Hour}}}
+				})
+
+				It("should return false because nothing relevant changed", func() {
+					oldShoot := shoot.DeepCopy()
+					shoot.Status.LastActivityTime = &metav1.Time{Time: time.Now()}
+					Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeFalse())
+				})
+
+				It("should return true because the idle policy was added", func() {
+					oldShoot := shoot.DeepCopy()
+					oldShoot.Spec.Hibernation = nil
+					Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+				})
+
+				It("should return true because the idle duration changed", func() {
+					oldShoot := shoot.DeepCopy()
+					shoot.Spec.Hibernation.IdlePolicy.IdleDuration.Duration = 2 * time.Hour
+					Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+				})
+
+				It("should return true because the shoot was woken up", func() {
+					shoot.Status.IsHibernated = true
+					oldShoot := shoot.DeepCopy()
+					shoot.Status.IsHibernated = false
+					Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+				})
+
+				It("should return true because hibernation was disabled", func() {
+					shoot.Spec.Hibernation.Enabled = ptr.To(true)
+					oldShoot := shoot.DeepCopy()
+					shoot.Spec.Hibernation.Enabled = ptr.To(false)
+					Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeTrue())
+				})
+
+				It("should return false because the idle policy was removed", func() {
+					oldShoot := shoot.DeepCopy()
+					shoot.Spec.Hibernation.IdlePolicy = nil
+					Expect(p.Update(event.UpdateEvent{ObjectNew: shoot, ObjectOld: oldShoot})).To(BeFalse())
+				})
+			})
 		})
 	})
 })
@@ -32,6 +32,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	"github.com/gardener/gardener/pkg/controllermanager/apis/config"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
@@ -40,6 +41,9 @@ import (
 const (
 	sevenDays         = 7 * 24 * time.Hour
 	nextScheduleDelta = 100 * time.Millisecond
+	// minimumIdleRequeueDuration is the minimum duration after which shoots with an idle policy are requeued. It
+	// prevents hot loops if a shoot cannot be hibernated although its idle deadline has passed, e.g. because it failed.
+	minimumIdleRequeueDuration = time.Minute
 )
 
 type operation uint8
@@ -78,7 +82,8 @@ func (s *parsedHibernationSchedule) previous(from, to time.Time) *time.Time {
 	return previousActivationTime
 }
 
-// Reconciler reconciles Shoots and hibernates or wakes them up according to their hibernation schedules.
+// Reconciler reconciles Shoots and hibernates or wakes them up according to their hibernation schedules. Shoots with an
+// idle policy are additionally hibernated once no activity was observed for the configured idle duration.
 type Reconciler struct {
 	Client   client.Client
 	Config   config.ShootHibernationControllerConfiguration
@@ -107,9 +112,13 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
-	schedules := getShootHibernationSchedules(shoot.Spec.Hibernation)
-	if len(schedules) == 0 {
-		log.Info("Hibernation schedules have been removed from shoot, stopping reconciliation")
+	var (
+		schedules  = getShootHibernationSchedules(shoot.Spec.Hibernation)
+		idlePolicy = getShootHibernationIdlePolicy(shoot.Spec.Hibernation)
+	)
+
+	if len(schedules) == 0 && idlePolicy == nil {
+		log.Info("Hibernation schedules and idle policy have been removed from shoot, stopping reconciliation")
 		return reconcile.Result{}, nil
 	}
 
@@ -121,7 +130,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	now := r.Clock.Now()
 	if gardenerutils.IsShootFailedAndUpToDate(shoot) {
-		requeueAfter := nextHibernationTimeDuration(parsedSchedules, now)
+		requeueAfter := nextRequeueDuration(parsedSchedules, shoot, now)
 		log.Info("Shoot is in Failed state, requeuing shoot hibernation", "requeueAfter", requeueAfter)
 		return reconcile.Result{RequeueAfter: requeueAfter}, nil
 	}
@@ -137,21 +146,43 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		log.Info("Successfully set hibernation.enabled", "enabled", *shoot.Spec.Hibernation.Enabled)
 	}
 
-	requeueAfter := nextHibernationTimeDuration(parsedSchedules, now)
+	// A manual wake-up is recorded as activity, otherwise the shoot would be hibernated again right after it has been
+	// woken up since its idle deadline has already passed while it was hibernated.
+	if idlePolicy != nil && mostRecentSchedule == nil && isWakingUp(shoot) {
+		if err := r.recordActivity(ctx, shoot, now); err != nil {
+			return reconcile.Result{}, err
+		}
+		log.Info("Recorded wake-up of shoot as activity", "lastActivityTime", shoot.Status.LastActivityTime)
+	}
+
+	// Hibernate the shoot if it has been idle for longer than the duration configured in its idle policy.
+	if deadline := idleDeadline(shoot); deadline != nil && !now.Before(*deadline) {
+		if err := r.setHibernationEnabled(ctx, shoot, true, gardencorev1beta1.ShootEventHibernationEnabled,
+			fmt.Sprintf("Hibernating cluster due to idle policy (no activity for %s)", idlePolicy.IdleDuration.Duration), now); err != nil {
+			return reconcile.Result{}, err
+		}
+		log.Info("Successfully hibernated idle shoot", "lastActivityTime", shoot.Status.LastActivityTime, "idleDuration", idlePolicy.IdleDuration.Duration)
+	}
+
+	requeueAfter := nextRequeueDuration(parsedSchedules, shoot, now)
 	log.Info("Requeuing shoot hibernation", "requeueAfter", requeueAfter)
 	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
 
 func (r *Reconciler) hibernateOrWakeUpShootBasedOnSchedule(ctx context.Context, shoot *gardencorev1beta1.Shoot, schedule *parsedHibernationSchedule, now time.Time) error {
-	patch := client.MergeFrom(shoot.DeepCopy())
 	switch schedule.operation {
 	case hibernate:
-		shoot.Spec.Hibernation.Enabled = ptr.To(true)
-		r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventHibernationEnabled, "Hibernating cluster due to schedule")
+		return r.setHibernationEnabled(ctx, shoot, true, gardencorev1beta1.ShootEventHibernationEnabled, "Hibernating cluster due to schedule", now)
 	case wakeUp:
-		shoot.Spec.Hibernation.Enabled = ptr.To(false)
-		r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.ShootEventHibernationDisabled, "Waking up cluster due to schedule")
+		return r.setHibernationEnabled(ctx, shoot, false, gardencorev1beta1.ShootEventHibernationDisabled, "Waking up cluster due to schedule", now)
 	}
+	return nil
+}
+
+func (r *Reconciler) setHibernationEnabled(ctx context.Context, shoot *gardencorev1beta1.Shoot, enabled bool, reason, message string, now time.Time) error {
+	patch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Spec.Hibernation.Enabled = ptr.To(enabled)
+	r.Recorder.Event(shoot, corev1.EventTypeNormal, reason, message)
 	if err := r.Client.Patch(ctx, shoot, patch); err != nil {
 		return err
 	}
@@ -161,6 +192,17 @@ func (r *Reconciler) hibernateOrWakeUpShootBasedOnSchedule(ctx context.Context,
 	return r.Client.Status().Patch(ctx, shoot, patch)
 }
 
+func (r *Reconciler) recordActivity(ctx context.Context, shoot *gardencorev1beta1.Shoot, now time.Time) error {
+	patch := client.MergeFrom(shoot.DeepCopy())
+	shoot.Status.LastActivityTime = &metav1.Time{Time: now}
+	return r.Client.Status().Patch(ctx, shoot, patch)
+}
+
+// isWakingUp returns true if the shoot is still hibernated although its hibernation has been disabled.
+func isWakingUp(shoot *gardencorev1beta1.Shoot) bool {
+	return shoot.Status.IsHibernated && !v1beta1helper.HibernationIsEnabled(shoot)
+}
+
 // parseHibernationSchedules parses the given HibernationSchedules and returns an array of ParsedHibernationSchedules
 // If the Location of a HibernationSchedule is `nil`, it is defaulted to UTC.
 func parseHibernationSchedules(schedules []gardencorev1beta1.HibernationSchedule) ([]parsedHibernationSchedule, error) {
@@ -218,6 +260,46 @@ func nextHibernationTimeDuration(schedules []parsedHibernationSchedule, now time
 	return timeStamps[0].Add(nextScheduleDelta).Sub(now)
 }
 
+// idleDeadline returns the time at which the shoot is hibernated due to its idle policy. The idle duration is measured
+// from the most recent of the last observed activity, the last hibernation trigger and the creation of the shoot.
+// Nil is returned if the shoot has no idle policy or is already hibernated or being hibernated.
+func idleDeadline(shoot *gardencorev1beta1.Shoot) *time.Time {
+	idlePolicy := getShootHibernationIdlePolicy(shoot.Spec.Hibernation)
+	if idlePolicy == nil || shoot.Status.IsHibernated || v1beta1helper.HibernationIsEnabled(shoot) {
+		return nil
+	}
+
+	lastActivity := shoot.CreationTimestamp.Time
+	for _, t := range []*metav1.Time{shoot.Status.LastActivityTime, shoot.Status.LastHibernationTriggerTime} {
+		if t != nil && t.After(lastActivity) {
+			lastActivity = t.Time
+		}
+	}
+
+	deadline := lastActivity.Add(idlePolicy.IdleDuration.Duration)
+	return &deadline
+}
+
+// nextRequeueDuration returns the time duration after which to requeue the shoot based on its hibernation schedules and
+// its idle policy. Zero is returned if neither of them requires the shoot to be requeued.
+func nextRequeueDuration(schedules []parsedHibernationSchedule, shoot *gardencorev1beta1.Shoot, now time.Time) time.Duration {
+	var requeueAfter time.Duration
+	if len(schedules) > 0 {
+		requeueAfter = nextHibernationTimeDuration(schedules, now)
+	}
+
+	if deadline := idleDeadline(shoot); deadline != nil {
+		// The activity of the shoot is not watched, hence the shoot is requeued at its current idle deadline. If new
+		// activity has been reported in the meantime, the deadline is simply recomputed.
+		idleRequeueAfter := max(deadline.Add(nextScheduleDelta).Sub(now), minimumIdleRequeueDuration)
+		if requeueAfter == 0 || idleRequeueAfter < requeueAfter {
+			requeueAfter = idleRequeueAfter
+		}
+	}
+
+	return requeueAfter
+}
+
 // getScheduleWithMostRecentTime returns the ParsedHibernationSchedule that contains the schedule with the most recent (previous) execution time.
 func getScheduleWithMostRecentTime(schedules []parsedHibernationSchedule, triggerDeadlineDuration *metav1.Duration, shoot *gardencorev1beta1.Shoot, now time.Time) *parsedHibernationSchedule {
 	// If the shoot has just been created or has never been hibernated, use the creation timestamp.
@@ -268,3 +350,10 @@ func getShootHibernationSchedules(hibernation *gardencorev1beta1.Hibernation) []
 	}
 	return hibernation.Schedules
 }
+
+func getShootHibernationIdlePolicy(hibernation *gardencorev1beta1.Hibernation) *gardencorev1beta1.HibernationIdlePolicy {
+	if hibernation == nil {
+		return nil
+	}
+	return hibernation.IdlePolicy
+}
@@ -426,7 +426,132 @@ var _ = Describe("Shoot Hibernation", func() {
 					triggerDeadlineDuration:     shortDeadline,
 					expectedRequeueDurationFunc: requeueAfterBasedOnSchedule(everyDayAt2, "UTC"),
 				}),
+				Entry("when shoot has been idle for longer than the idle duration", testEntry{
+					timeNow: timeWithOffset(weekDayAt7, 0),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt7, -24*time.Hour)()}
+						shoot.Spec.Hibernation.IdlePolicy = &gardencorev1beta1.HibernationIdlePolicy{IdleDuration: metav1.Duration{Duration: time.Hour}}
+						shoot.Status.LastActivityTime = &metav1.Time{Time: timeWithOffset(weekDayAt7, -2*time.Hour)()}
+					},
+					triggerDeadlineDuration:    noDeadLine,
+					triggerHibernationOrWakeup: true,
+					expectedHibernationEnabled: true,
+				}),
+				Entry("when shoot has never reported activity and was created longer than the idle duration ago", testEntry{
+					timeNow: timeWithOffset(weekDayAt7, 0),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt7, -2*time.Hour)()}
+						shoot.Spec.Hibernation.IdlePolicy = &gardencorev1beta1.HibernationIdlePolicy{IdleDuration: metav1.Duration{Duration: time.Hour}}
+					},
+					triggerDeadlineDuration:    noDeadLine,
+					triggerHibernationOrWakeup: true,
+					expectedHibernationEnabled: true,
+				}),
+				Entry("when shoot has recently been active", testEntry{
+					timeNow: timeWithOffset(weekDayAt7, 0),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt7, -24*time.Hour)()}
+						shoot.Spec.Hibernation.IdlePolicy = &gardencorev1beta1.HibernationIdlePolicy{IdleDuration: metav1.Duration{Duration: time.Hour}}
+						shoot.Status.LastActivityTime = &metav1.Time{Time: timeWithOffset(weekDayAt7, -30*time.Minute)()}
+					},
+					triggerDeadlineDuration: noDeadLine,
+					expectedRequeueDurationFunc: func(_ time.Time) time.Duration {
+						return 30*time.Minute + nextScheduleDelta
+					},
+				}),
+				Entry("when shoot has recently been woken up by its schedule", testEntry{
+					timeNow: timeWithOffset(weekDayAt7, 0),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt7, -24*time.Hour)()}
+						shoot.Spec.Hibernation.IdlePolicy = &gardencorev1beta1.HibernationIdlePolicy{IdleDuration: metav1.Duration{Duration: time.Hour}}
+						shoot.Status.LastActivityTime = &metav1.Time{Time: timeWithOffset(weekDayAt7, -12*time.Hour)()}
+						shoot.Status.LastHibernationTriggerTime = &metav1.Time{Time: timeWithOffset(weekDayAt7, -45*time.Minute)()}
+					},
+					triggerDeadlineDuration: noDeadLine,
+					expectedRequeueDurationFunc: func(_ time.Time) time.Duration {
+						return 15*time.Minute + nextScheduleDelta
+					},
+				}),
+				Entry("when shoot with idle policy is already hibernated", testEntry{
+					timeNow: timeWithOffset(weekDayAt7, 0),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt7, -24*time.Hour)()}
+						shoot.Spec.Hibernation.IdlePolicy = &gardencorev1beta1.HibernationIdlePolicy{IdleDuration: metav1.Duration{Duration: time.Hour}}
+						shoot.Status.IsHibernated = true
+					},
+					triggerDeadlineDuration: noDeadLine,
+				}),
+				Entry("when shoot has hibernation schedules and its idle deadline is before the next schedule", testEntry{
+					timeNow: timeWithOffset(weekDayAt0, 0),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt0, -24*time.Hour)()}
+						shoot.Spec.Hibernation.Schedules = []gardencorev1beta1.HibernationSchedule{{Start: &everyDayAt7}}
+						shoot.Spec.Hibernation.IdlePolicy = &gardencorev1beta1.HibernationIdlePolicy{IdleDuration: metav1.Duration{Duration: time.Hour}}
+						shoot.Status.LastHibernationTriggerTime = &metav1.Time{Time: timeWithOffset(weekDayAt7, -24*time.Hour)()}
+						shoot.Status.LastActivityTime = &metav1.Time{Time: timeWithOffset(weekDayAt0, -30*time.Minute)()}
+					},
+					triggerDeadlineDuration: noDeadLine,
+					expectedRequeueDurationFunc: func(_ time.Time) time.Duration {
+						return 30*time.Minute + nextScheduleDelta
+					},
+				}),
+				Entry("when shoot with idle policy is in failed state", testEntry{
+					timeNow: timeWithOffset(weekDayAt7, 0),
+					shootSettings: func(shoot *gardencorev1beta1.Shoot) {
+						shoot.CreationTimestamp = metav1.Time{Time: timeWithOffset(weekDayAt7, -24*time.Hour)()}
+						shoot.Spec.Hibernation.IdlePolicy = &gardencorev1beta1.HibernationIdlePolicy{IdleDuration: metav1.Duration{Duration: time.Hour}}
+						shoot.Status.LastOperation = &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateFailed}
+						shoot.Status.Gardener.Version = version.Get().GitVersion
+					},
+					triggerDeadlineDuration: noDeadLine,
+					expectedRequeueDurationFunc: func(_ time.Time) time.Duration {
+						return minimumIdleRequeueDuration
+					},
+				}),
 			)
+
+			It("should record a manual wake-up as activity and not hibernate the shoot again", func() {
+				fakeClock = testclock.NewFakeClock(mustParseRFC3339Time(weekDayAt7))
+
+				shoot.CreationTimestamp = metav1.Time{Time: fakeClock.Now().Add(-24 * time.Hour)}
+				shoot.Spec.Hibernation.Enabled = ptr.To(false)
+				shoot.Spec.Hibernation.IdlePolicy = &gardencorev1beta1.HibernationIdlePolicy{IdleDuration: metav1.Duration{Duration: time.Hour}}
+				Expect(c.Create(ctx, shoot)).To(Succeed())
+				shoot.Status.IsHibernated = true
+				shoot.Status.LastActivityTime = &metav1.Time{Time: fakeClock.Now().Add(-12 * time.Hour)}
+				shoot.Status.LastHibernationTriggerTime = &metav1.Time{Time: fakeClock.Now().Add(-11 * time.Hour)}
+				Expect(c.Status().Update(ctx, shoot)).To(Succeed())
+
+				reconciler := &Reconciler{Client: c, Recorder: record.NewFakeRecorder(1), Clock: fakeClock}
+				Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})).To(Equal(reconcile.Result{}))
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				Expect(shoot.Status.LastActivityTime.Time).To(BeTemporally("==", fakeClock.Now()))
+
+				By("Complete wake-up")
+				shoot.Status.IsHibernated = false
+				Expect(c.Status().Update(ctx, shoot)).To(Succeed())
+				fakeClock.Step(30 * time.Minute)
+
+				Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})).To(Equal(reconcile.Result{RequeueAfter: 30*time.Minute + nextScheduleDelta}))
+
+				Expect(c.Get(ctx, client.ObjectKeyFromObject(shoot), shoot)).To(Succeed())
+				Expect(shoot.Spec.Hibernation.Enabled).To(PointTo(BeFalse()))
+			})
+
+			It("should emit the same event as for scheduled hibernation when hibernating an idle shoot", func() {
+				fakeClock = testclock.NewFakeClock(mustParseRFC3339Time(weekDayAt7))
+				recorder := record.NewFakeRecorder(1)
+
+				shoot.CreationTimestamp = metav1.Time{Time: fakeClock.Now().Add(-24 * time.Hour)}
+				shoot.Spec.Hibernation.IdlePolicy = &gardencorev1beta1.HibernationIdlePolicy{IdleDuration: metav1.Duration{Duration: time.Hour}}
+				Expect(c.Create(ctx, shoot)).To(Succeed())
+
+				reconciler := &Reconciler{Client: c, Recorder: recorder, Clock: fakeClock}
+				Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(shoot)})).To(Equal(reconcile.Result{}))
+
+				Expect(recorder.Events).To(Receive(HavePrefix("Normal " + gardencorev1beta1.ShootEventHibernationEnabled + " Hibernating cluster due to idle policy")))
+			})
 		})
 	})
 })
@@ -41,6 +41,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/timewindow"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 )
 
@@ -73,10 +74,19 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
-	requeueAfter, nextMaintenance := requeueAfterDuration(shoot)
+	window, err := r.effectiveMaintenanceTimeWindow(ctx, shoot)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	requeueAfter, nextMaintenance := requeueAfterDuration(window)
 
-	if !mustMaintainNow(shoot, r.Clock) {
-		log.V(1).Info("Skipping Shoot because it doesn't need to be maintained now")
+	if !mustMaintainNow(shoot, window, r.Clock) {
+		if window.IsFrozen(r.Clock.Now()) {
+			log.V(1).Info("Skipping Shoot because its maintenance is frozen")
+		} else {
+			log.V(1).Info("Skipping Shoot because it doesn't need to be maintained now")
+		}
 		log.V(1).Info("Scheduled next maintenance for Shoot", "duration", requeueAfter.Round(time.Minute), "nextMaintenance", nextMaintenance.Round(time.Minute))
 		return reconcile.Result{RequeueAfter: requeueAfter}, nil
 	}
@@ -89,10 +99,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	return reconcile.Result{RequeueAfter: requeueAfter}, nil
 }
 
-func requeueAfterDuration(shoot *gardencorev1beta1.Shoot) (time.Duration, time.Time) {
+// effectiveMaintenanceTimeWindow returns the effective maintenance time window of the shoot which additionally honors
+// the maintenance freeze periods of the shoot's project.
+func (r *Reconciler) effectiveMaintenanceTimeWindow(ctx context.Context, shoot *gardencorev1beta1.Shoot) (*timewindow.MaintenanceTimeWindow, error) {
+	return gardenerutils.EffectiveShootMaintenanceTimeWindowWithProject(ctx, r.Client, shoot)
+}
+
+func requeueAfterDuration(window *timewindow.MaintenanceTimeWindow) (time.Duration, time.Time) {
 	var (
 		now             = time.Now()
-		window          = gardenerutils.EffectiveShootMaintenanceTimeWindow(shoot)
 		duration        = window.RandomDurationUntilNext(now, false)
 		nextMaintenance = time.Now().UTC().Add(duration)
 	)
@@ -553,8 +568,8 @@ func shouldKubernetesVersionBeUpdated(kubernetesVersion string, autoUpdate bool,
 	return false, "", false, nil
 }
 
-func mustMaintainNow(shoot *gardencorev1beta1.Shoot, clock clock.Clock) bool {
-	return hasMaintainNowAnnotation(shoot) || gardenerutils.IsNowInEffectiveShootMaintenanceTimeWindow(shoot, clock)
+func mustMaintainNow(shoot *gardencorev1beta1.Shoot, window *timewindow.MaintenanceTimeWindow, clock clock.Clock) bool {
+	return hasMaintainNowAnnotation(shoot) || window.Contains(clock.Now())
 }
 
 func hasMaintainNowAnnotation(shoot *gardencorev1beta1.Shoot) bool {
@@ -15,15 +15,23 @@
 package maintenance
 
 import (
+	"context"
 	"time"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	testclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 )
 
 var _ = Describe("Shoot Maintenance", func() {
@@ -42,6 +50,69 @@ var _ = Describe("Shoot Maintenance", func() {
 	})
 
 	Context("Shoot Maintenance", func() {
+		Describe("#effectiveMaintenanceTimeWindow", func() {
+			var (
+				ctx        = context.Background()
+				fakeClient client.Client
+				reconciler *Reconciler
+				shoot      *gardencorev1beta1.Shoot
+				project    *gardencorev1beta1.Project
+				fakeClock  *testclock.FakeClock
+			)
+
+			BeforeEach(func() {
+				fakeClient = fakeclient.NewClientBuilder().
+					WithScheme(kubernetes.GardenScheme).
+					WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+					Build()
+				fakeClock = testclock.NewFakeClock(time.Date(2024, time.December, 24, 22, 30, 0, 0, time.UTC))
+				reconciler = &Reconciler{Client: fakeClient, Clock: fakeClock}
+
+				shoot = &gardencorev1beta1.Shoot{
+					ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-foo"},
+					Spec: gardencorev1beta1.ShootSpec{
+						Maintenance: &gardencorev1beta1.Maintenance{
+							TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+						},
+					},
+				}
+				project = &gardencorev1beta1.Project{
+					ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+					Spec:       gardencorev1beta1.ProjectSpec{Namespace: ptr.To("garden-foo")},
+				}
+			})
+
+			It("should return the shoot's time window if there is no project", func() {
+				window, err := reconciler.effectiveMaintenanceTimeWindow(ctx, shoot)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(window.FreezePeriods()).To(BeEmpty())
+				Expect(mustMaintainNow(shoot, window, fakeClock)).To(BeTrue())
+			})
+
+			It("should honor the freeze periods of the shoot and the project", func() {
+				shoot.Spec.Maintenance.FreezePeriods = []gardencorev1beta1.MaintenanceFreezePeriod{{
+					Begin: metav1.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC),
+					End:   metav1.Date(2025, time.January, 2, 0, 0, 0, 0, time.UTC),
+				}}
+				project.Spec.MaintenanceFreezePeriods = []gardencorev1beta1.MaintenanceFreezePeriod{{
+					Begin:  metav1.Date(2024, time.December, 20, 0, 0, 0, 0, time.UTC),
+					End:    metav1.Date(2024, time.December, 27, 0, 0, 0, 0, time.UTC),
+					Reason: ptr.To("year-end change freeze"),
+				}}
+				Expect(fakeClient.Create(ctx, project)).To(Succeed())
+
+				window, err := reconciler.effectiveMaintenanceTimeWindow(ctx, shoot)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(window.FreezePeriods()).To(HaveLen(2))
+				Expect(window.IsFrozen(fakeClock.Now())).To(BeTrue())
+				Expect(mustMaintainNow(shoot, window, fakeClock)).To(BeFalse())
+
+				By("Allow maintenance operations triggered explicitly")
+				metav1.SetMetaDataAnnotation(&shoot.ObjectMeta, v1beta1constants.GardenerOperation, v1beta1constants.ShootOperationMaintain)
+				Expect(mustMaintainNow(shoot, window, fakeClock)).To(BeTrue())
+			})
+		})
+
 		Describe("#ExpirationDateExpired", func() {
 			It("should determine that expirationDate applies", func() {
 				applies := ExpirationDateExpired(&expirationDateInThePast)
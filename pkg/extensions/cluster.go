@@ -193,6 +193,10 @@ func GenericTokenKubeconfigSecretNameFromCluster(cluster *Cluster) string {
 // GetShootStateForCluster retrieves the ShootState and the Shoot resources for a given Cluster name by first fetching
 // the *extensionsv1alpha1.Cluster object in the seed, extracting the Shoot resource from it and then fetching the
 // *gardencorev1beta1.ShootState resource from the garden.
+// Note that the ShootState is returned as persisted in the garden. If gardenlet is configured to encrypt the sensitive
+// data in ShootStates, the persisted secrets, the states of the extensions and the resources referenced by them are
+// encrypted, and the key encryption keys are only available to gardenlet. Hence, extensions must not rely on the state
+// returned by this function but on the state which is restored into the '.status.state' of their resources by gardenlet.
 func GetShootStateForCluster(
 	ctx context.Context,
 	gardenClient client.Client,
@@ -90,6 +90,18 @@ const (
 	// beta: v1.89.0
 	// GA: v1.90.0
 	UseGardenerNodeAgent featuregate.Feature = "UseGardenerNodeAgent"
+
+	// InPlaceNodeUpdates allows worker pools of shoots to use the `AutoInPlaceUpdate` update strategy, i.e., to update
+	// the nodes in-place instead of replacing them.
+	// owner: @gardener/gardener-maintainers
+	// alpha: v1.91.0
+	InPlaceNodeUpdates featuregate.Feature = "InPlaceNodeUpdates"
+
+	// CompressManagedResourceSecrets enables the compression (and sharding if necessary) of the data of large secrets
+	// referenced by ManagedResources.
+	// owner: @gardener/gardener-maintainers
+	// alpha: v1.91.0
+	CompressManagedResourceSecrets featuregate.Feature = "CompressManagedResourceSecrets"
 )
 
 // DefaultFeatureGate is the central feature gate map used by all gardener components.
@@ -127,6 +139,8 @@ var AllFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	MachineControllerManagerDeployment: {Default: true, PreRelease: featuregate.GA, LockToDefault: true},
 	APIServerFastRollout:               {Default: true, PreRelease: featuregate.GA, LockToDefault: true},
 	UseGardenerNodeAgent:               {Default: true, PreRelease: featuregate.GA, LockToDefault: true},
+	InPlaceNodeUpdates:                 {Default: false, PreRelease: featuregate.Alpha},
+	CompressManagedResourceSecrets:     {Default: false, PreRelease: featuregate.Alpha},
 }
 
 // GetFeatures returns a feature gate map with the respective specifications. Non-existing feature gates are ignored.
@@ -73,6 +73,11 @@ type GardenletConfiguration struct {
 	Monitoring *MonitoringConfig
 	// NodeToleration contains optional settings for default tolerations.
 	NodeToleration *NodeToleration
+	// ShootStateEncryption contains optional settings for the envelope encryption of sensitive data persisted in
+	// ShootState resources.
+	ShootStateEncryption *ShootStateEncryption
+	// NodeAgent contains optional settings for the gardener-node-agent running on the shoot worker nodes.
+	NodeAgent *NodeAgentConfiguration
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -561,3 +566,31 @@ type NodeToleration struct {
 	// should be added to pods not already tolerating this taint.
 	DefaultUnreachableTolerationSeconds *int64
 }
+
+// ShootStateEncryption contains configuration for the envelope encryption of sensitive data persisted in ShootState
+// resources.
+type ShootStateEncryption struct {
+	// SecretName is the name of a secret in the garden namespace of the seed cluster which contains the key encryption
+	// keys. Each data key of the secret is the name of a key encryption key, its value must be a 32 byte AES key.
+	SecretName string
+	// PrimaryKeyName is the name of the key encryption key in the secret that is used for wrapping the data keys of the
+	// ShootStates. All other keys are only used for unwrapping data keys, e.g., during a restoration or a key rotation.
+	PrimaryKeyName string
+}
+
+// NodeAgentConfiguration contains configuration for the gardener-node-agent running on the shoot worker nodes.
+type NodeAgentConfiguration struct {
+	// ImageVerification contains the policy for verifying images before the gardener-node-agent extracts files from
+	// them.
+	ImageVerification *NodeAgentImageVerification
+}
+
+// NodeAgentImageVerification contains the policy for verifying images before the gardener-node-agent extracts files
+// from them.
+type NodeAgentImageVerification struct {
+	// RequireDigest specifies whether image references must be pinned by digest.
+	RequireDigest bool
+	// PublicKeys is a list of PEM-encoded public keys. If set, an image is only accepted if it carries a cosign signature
+	// which can be verified with at least one of the keys.
+	PublicKeys []string
+}
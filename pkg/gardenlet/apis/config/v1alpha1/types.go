@@ -90,6 +90,13 @@ type GardenletConfiguration struct {
 	// NodeToleration contains optional settings for default tolerations.
 	// +optional
 	NodeToleration *NodeToleration `json:"nodeToleration,omitempty"`
+	// ShootStateEncryption contains optional settings for the envelope encryption of sensitive data persisted in
+	// ShootState resources.
+	// +optional
+	ShootStateEncryption *ShootStateEncryption `json:"shootStateEncryption,omitempty"`
+	// NodeAgent contains optional settings for the gardener-node-agent running on the shoot worker nodes.
+	// +optional
+	NodeAgent *NodeAgentConfiguration `json:"nodeAgent,omitempty"`
 }
 
 // GardenClientConnection specifies the kubeconfig file and the client connection settings
@@ -724,3 +731,34 @@ type NodeToleration struct {
 	// +optional
 	DefaultUnreachableTolerationSeconds *int64 `json:"defaultUnreachableTolerationSeconds,omitempty"`
 }
+
+// ShootStateEncryption contains configuration for the envelope encryption of sensitive data persisted in ShootState
+// resources.
+type ShootStateEncryption struct {
+	// SecretName is the name of a secret in the garden namespace of the seed cluster which contains the key encryption
+	// keys. Each data key of the secret is the name of a key encryption key, its value must be a 32 byte AES key.
+	SecretName string `json:"secretName"`
+	// PrimaryKeyName is the name of the key encryption key in the secret that is used for wrapping the data keys of the
+	// ShootStates. All other keys are only used for unwrapping data keys, e.g., during a restoration or a key rotation.
+	PrimaryKeyName string `json:"primaryKeyName"`
+}
+
+// NodeAgentConfiguration contains configuration for the gardener-node-agent running on the shoot worker nodes.
+type NodeAgentConfiguration struct {
+	// ImageVerification contains the policy for verifying images before the gardener-node-agent extracts files from
+	// them.
+	// +optional
+	ImageVerification *NodeAgentImageVerification `json:"imageVerification,omitempty"`
+}
+
+// NodeAgentImageVerification contains the policy for verifying images before the gardener-node-agent extracts files
+// from them.
+type NodeAgentImageVerification struct {
+	// RequireDigest specifies whether image references must be pinned by digest.
+	// +optional
+	RequireDigest bool `json:"requireDigest,omitempty"`
+	// PublicKeys is a list of PEM-encoded public keys. If set, an image is only accepted if it carries a cosign signature
+	// which can be verified with at least one of the keys.
+	// +optional
+	PublicKeys []string `json:"publicKeys,omitempty"`
+}
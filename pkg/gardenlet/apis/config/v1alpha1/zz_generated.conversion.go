@@ -261,6 +261,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NodeAgentConfiguration)(nil), (*config.NodeAgentConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NodeAgentConfiguration_To_config_NodeAgentConfiguration(a.(*NodeAgentConfiguration), b.(*config.NodeAgentConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.NodeAgentConfiguration)(nil), (*NodeAgentConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_NodeAgentConfiguration_To_v1alpha1_NodeAgentConfiguration(a.(*config.NodeAgentConfiguration), b.(*NodeAgentConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NodeAgentImageVerification)(nil), (*config.NodeAgentImageVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NodeAgentImageVerification_To_config_NodeAgentImageVerification(a.(*NodeAgentImageVerification), b.(*config.NodeAgentImageVerification), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.NodeAgentImageVerification)(nil), (*NodeAgentImageVerification)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_NodeAgentImageVerification_To_v1alpha1_NodeAgentImageVerification(a.(*config.NodeAgentImageVerification), b.(*NodeAgentImageVerification), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NodeToleration)(nil), (*config.NodeToleration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NodeToleration_To_config_NodeToleration(a.(*NodeToleration), b.(*config.NodeToleration), scope)
 	}); err != nil {
@@ -441,6 +461,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ShootStateEncryption)(nil), (*config.ShootStateEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ShootStateEncryption_To_config_ShootStateEncryption(a.(*ShootStateEncryption), b.(*config.ShootStateEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ShootStateEncryption)(nil), (*ShootStateEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ShootStateEncryption_To_v1alpha1_ShootStateEncryption(a.(*config.ShootStateEncryption), b.(*ShootStateEncryption), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*StaleExtensionHealthChecks)(nil), (*config.StaleExtensionHealthChecks)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_StaleExtensionHealthChecks_To_config_StaleExtensionHealthChecks(a.(*StaleExtensionHealthChecks), b.(*config.StaleExtensionHealthChecks), scope)
 	}); err != nil {
@@ -903,6 +933,8 @@ func autoConvert_v1alpha1_GardenletConfiguration_To_config_GardenletConfiguratio
 	out.ExposureClassHandlers = *(*[]config.ExposureClassHandler)(unsafe.Pointer(&in.ExposureClassHandlers))
 	out.Monitoring = (*config.MonitoringConfig)(unsafe.Pointer(in.Monitoring))
 	out.NodeToleration = (*config.NodeToleration)(unsafe.Pointer(in.NodeToleration))
+	out.ShootStateEncryption = (*config.ShootStateEncryption)(unsafe.Pointer(in.ShootStateEncryption))
+	out.NodeAgent = (*config.NodeAgentConfiguration)(unsafe.Pointer(in.NodeAgent))
 	return nil
 }
 
@@ -980,6 +1012,8 @@ func autoConvert_config_GardenletConfiguration_To_v1alpha1_GardenletConfiguratio
 	out.ExposureClassHandlers = *(*[]ExposureClassHandler)(unsafe.Pointer(&in.ExposureClassHandlers))
 	out.Monitoring = (*MonitoringConfig)(unsafe.Pointer(in.Monitoring))
 	out.NodeToleration = (*NodeToleration)(unsafe.Pointer(in.NodeToleration))
+	out.ShootStateEncryption = (*ShootStateEncryption)(unsafe.Pointer(in.ShootStateEncryption))
+	out.NodeAgent = (*NodeAgentConfiguration)(unsafe.Pointer(in.NodeAgent))
 	return nil
 }
 
@@ -1174,6 +1208,48 @@ func Convert_config_NetworkPolicyControllerConfiguration_To_v1alpha1_NetworkPoli
 	return autoConvert_config_NetworkPolicyControllerConfiguration_To_v1alpha1_NetworkPolicyControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_NodeAgentConfiguration_To_config_NodeAgentConfiguration(in *NodeAgentConfiguration, out *config.NodeAgentConfiguration, s conversion.Scope) error {
+	out.ImageVerification = (*config.NodeAgentImageVerification)(unsafe.Pointer(in.ImageVerification))
+	return nil
+}
+
+// Convert_v1alpha1_NodeAgentConfiguration_To_config_NodeAgentConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_NodeAgentConfiguration_To_config_NodeAgentConfiguration(in *NodeAgentConfiguration, out *config.NodeAgentConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NodeAgentConfiguration_To_config_NodeAgentConfiguration(in, out, s)
+}
+
+func autoConvert_config_NodeAgentConfiguration_To_v1alpha1_NodeAgentConfiguration(in *config.NodeAgentConfiguration, out *NodeAgentConfiguration, s conversion.Scope) error {
+	out.ImageVerification = (*NodeAgentImageVerification)(unsafe.Pointer(in.ImageVerification))
+	return nil
+}
+
+// Convert_config_NodeAgentConfiguration_To_v1alpha1_NodeAgentConfiguration is an autogenerated conversion function.
+func Convert_config_NodeAgentConfiguration_To_v1alpha1_NodeAgentConfiguration(in *config.NodeAgentConfiguration, out *NodeAgentConfiguration, s conversion.Scope) error {
+	return autoConvert_config_NodeAgentConfiguration_To_v1alpha1_NodeAgentConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_NodeAgentImageVerification_To_config_NodeAgentImageVerification(in *NodeAgentImageVerification, out *config.NodeAgentImageVerification, s conversion.Scope) error {
+	out.RequireDigest = in.RequireDigest
+	out.PublicKeys = *(*[]string)(unsafe.Pointer(&in.PublicKeys))
+	return nil
+}
+
+// Convert_v1alpha1_NodeAgentImageVerification_To_config_NodeAgentImageVerification is an autogenerated conversion function.
+func Convert_v1alpha1_NodeAgentImageVerification_To_config_NodeAgentImageVerification(in *NodeAgentImageVerification, out *config.NodeAgentImageVerification, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NodeAgentImageVerification_To_config_NodeAgentImageVerification(in, out, s)
+}
+
+func autoConvert_config_NodeAgentImageVerification_To_v1alpha1_NodeAgentImageVerification(in *config.NodeAgentImageVerification, out *NodeAgentImageVerification, s conversion.Scope) error {
+	out.RequireDigest = in.RequireDigest
+	out.PublicKeys = *(*[]string)(unsafe.Pointer(&in.PublicKeys))
+	return nil
+}
+
+// Convert_config_NodeAgentImageVerification_To_v1alpha1_NodeAgentImageVerification is an autogenerated conversion function.
+func Convert_config_NodeAgentImageVerification_To_v1alpha1_NodeAgentImageVerification(in *config.NodeAgentImageVerification, out *NodeAgentImageVerification, s conversion.Scope) error {
+	return autoConvert_config_NodeAgentImageVerification_To_v1alpha1_NodeAgentImageVerification(in, out, s)
+}
+
 func autoConvert_v1alpha1_NodeToleration_To_config_NodeToleration(in *NodeToleration, out *config.NodeToleration, s conversion.Scope) error {
 	out.DefaultNotReadyTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultNotReadyTolerationSeconds))
 	out.DefaultUnreachableTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultUnreachableTolerationSeconds))
@@ -1598,6 +1674,28 @@ func Convert_config_ShootStateControllerConfiguration_To_v1alpha1_ShootStateCont
 	return autoConvert_config_ShootStateControllerConfiguration_To_v1alpha1_ShootStateControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ShootStateEncryption_To_config_ShootStateEncryption(in *ShootStateEncryption, out *config.ShootStateEncryption, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.PrimaryKeyName = in.PrimaryKeyName
+	return nil
+}
+
+// Convert_v1alpha1_ShootStateEncryption_To_config_ShootStateEncryption is an autogenerated conversion function.
+func Convert_v1alpha1_ShootStateEncryption_To_config_ShootStateEncryption(in *ShootStateEncryption, out *config.ShootStateEncryption, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ShootStateEncryption_To_config_ShootStateEncryption(in, out, s)
+}
+
+func autoConvert_config_ShootStateEncryption_To_v1alpha1_ShootStateEncryption(in *config.ShootStateEncryption, out *ShootStateEncryption, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.PrimaryKeyName = in.PrimaryKeyName
+	return nil
+}
+
+// Convert_config_ShootStateEncryption_To_v1alpha1_ShootStateEncryption is an autogenerated conversion function.
+func Convert_config_ShootStateEncryption_To_v1alpha1_ShootStateEncryption(in *config.ShootStateEncryption, out *ShootStateEncryption, s conversion.Scope) error {
+	return autoConvert_config_ShootStateEncryption_To_v1alpha1_ShootStateEncryption(in, out, s)
+}
+
 func autoConvert_v1alpha1_StaleExtensionHealthChecks_To_config_StaleExtensionHealthChecks(in *StaleExtensionHealthChecks, out *config.StaleExtensionHealthChecks, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.Threshold = (*v1.Duration)(unsafe.Pointer(in.Threshold))
@@ -501,6 +501,16 @@ func (in *GardenletConfiguration) DeepCopyInto(out *GardenletConfiguration) {
 		*out = new(NodeToleration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShootStateEncryption != nil {
+		in, out := &in.ShootStateEncryption, &out.ShootStateEncryption
+		*out = new(ShootStateEncryption)
+		**out = **in
+	}
+	if in.NodeAgent != nil {
+		in, out := &in.NodeAgent, &out.NodeAgent
+		*out = new(NodeAgentConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -788,6 +798,48 @@ func (in *NetworkPolicyControllerConfiguration) DeepCopy() *NetworkPolicyControl
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeAgentConfiguration) DeepCopyInto(out *NodeAgentConfiguration) {
+	*out = *in
+	if in.ImageVerification != nil {
+		in, out := &in.ImageVerification, &out.ImageVerification
+		*out = new(NodeAgentImageVerification)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAgentConfiguration.
+func (in *NodeAgentConfiguration) DeepCopy() *NodeAgentConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeAgentConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeAgentImageVerification) DeepCopyInto(out *NodeAgentImageVerification) {
+	*out = *in
+	if in.PublicKeys != nil {
+		in, out := &in.PublicKeys, &out.PublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAgentImageVerification.
+func (in *NodeAgentImageVerification) DeepCopy() *NodeAgentImageVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeAgentImageVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeToleration) DeepCopyInto(out *NodeToleration) {
 	*out = *in
@@ -1277,6 +1329,22 @@ func (in *ShootStateControllerConfiguration) DeepCopy() *ShootStateControllerCon
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ShootStateEncryption) DeepCopyInto(out *ShootStateEncryption) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShootStateEncryption.
+func (in *ShootStateEncryption) DeepCopy() *ShootStateEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(ShootStateEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StaleExtensionHealthChecks) DeepCopyInto(out *StaleExtensionHealthChecks) {
 	*out = *in
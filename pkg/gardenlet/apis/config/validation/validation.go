@@ -19,6 +19,7 @@ import (
 	"net"
 	"time"
 
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -140,6 +141,36 @@ func ValidateGardenletConfiguration(cfg *config.GardenletConfiguration, fldPath
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(ptr.Deref(nodeTolerationCfg.DefaultUnreachableTolerationSeconds, 0), nodeTolerationConfigPath.Child("defaultUnreachableTolerationSeconds"))...)
 	}
 
+	if shootStateEncryption := cfg.ShootStateEncryption; shootStateEncryption != nil {
+		shootStateEncryptionPath := fldPath.Child("shootStateEncryption")
+
+		if len(shootStateEncryption.SecretName) == 0 {
+			allErrs = append(allErrs, field.Required(shootStateEncryptionPath.Child("secretName"), "must provide the name of the secret containing the key encryption keys"))
+		} else {
+			for _, msg := range validation.IsDNS1123Subdomain(shootStateEncryption.SecretName) {
+				allErrs = append(allErrs, field.Invalid(shootStateEncryptionPath.Child("secretName"), shootStateEncryption.SecretName, msg))
+			}
+		}
+
+		if len(shootStateEncryption.PrimaryKeyName) == 0 {
+			allErrs = append(allErrs, field.Required(shootStateEncryptionPath.Child("primaryKeyName"), "must provide the name of the primary key encryption key"))
+		} else {
+			for _, msg := range validation.IsConfigMapKey(shootStateEncryption.PrimaryKeyName) {
+				allErrs = append(allErrs, field.Invalid(shootStateEncryptionPath.Child("primaryKeyName"), shootStateEncryption.PrimaryKeyName, msg))
+			}
+		}
+	}
+
+	if nodeAgent := cfg.NodeAgent; nodeAgent != nil && nodeAgent.ImageVerification != nil {
+		publicKeysPath := fldPath.Child("nodeAgent", "imageVerification", "publicKeys")
+
+		for i, publicKey := range nodeAgent.ImageVerification.PublicKeys {
+			if _, err := cryptoutils.UnmarshalPEMToPublicKey([]byte(publicKey)); err != nil {
+				allErrs = append(allErrs, field.Invalid(publicKeysPath.Index(i), publicKey, fmt.Sprintf("must be a PEM-encoded public key: %v", err)))
+			}
+		}
+	}
+
 	return allErrs
 }
 
@@ -15,11 +15,15 @@
 package validation_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -642,6 +646,89 @@ var _ = Describe("GardenletConfiguration", func() {
 				)
 			})
 		})
+
+		Context("shootStateEncryption", func() {
+			It("should pass with unset encryption configuration", func() {
+				cfg.ShootStateEncryption = nil
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should pass with valid encryption configuration", func() {
+				cfg.ShootStateEncryption = &config.ShootStateEncryption{
+					SecretName:     "shootstate-encryption",
+					PrimaryKeyName: "key-2024-01",
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail with missing fields", func() {
+				cfg.ShootStateEncryption = &config.ShootStateEncryption{}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("shootStateEncryption.secretName"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("shootStateEncryption.primaryKeyName"),
+					})),
+				))
+			})
+
+			It("should fail with invalid fields", func() {
+				cfg.ShootStateEncryption = &config.ShootStateEncryption{
+					SecretName:     "Invalid_Name",
+					PrimaryKeyName: "key/1",
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("shootStateEncryption.secretName"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("shootStateEncryption.primaryKeyName"),
+					})),
+				))
+			})
+		})
+
+		Context("nodeAgent", func() {
+			It("should pass with valid public keys", func() {
+				privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+				Expect(err).NotTo(HaveOccurred())
+				publicKey, err := cryptoutils.MarshalPublicKeyToPEM(privateKey.Public())
+				Expect(err).NotTo(HaveOccurred())
+
+				cfg.NodeAgent = &config.NodeAgentConfiguration{
+					ImageVerification: &config.NodeAgentImageVerification{
+						RequireDigest: true,
+						PublicKeys:    []string{string(publicKey)},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(BeEmpty())
+			})
+
+			It("should fail with invalid public keys", func() {
+				cfg.NodeAgent = &config.NodeAgentConfiguration{
+					ImageVerification: &config.NodeAgentImageVerification{
+						PublicKeys: []string{"foo"},
+					},
+				}
+
+				Expect(ValidateGardenletConfiguration(cfg, nil, false)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("nodeAgent.imageVerification.publicKeys[0]"),
+					})),
+				))
+			})
+		})
 	})
 
 	Describe("#ValidateGardenletConfigurationUpdate", func() {
@@ -88,8 +88,9 @@ func AddToManager(
 	}
 
 	if err := (&backupentry.Reconciler{
-		Config:   *cfg.Controllers.BackupEntry,
-		SeedName: cfg.SeedConfig.Name,
+		Config:               *cfg.Controllers.BackupEntry,
+		SeedName:             cfg.SeedConfig.Name,
+		ShootStateEncryption: cfg.ShootStateEncryption,
 	}).AddToManager(ctx, mgr, gardenCluster, seedCluster); err != nil {
 		return fmt.Errorf("failed adding BackupEntry controller: %w", err)
 	}
@@ -44,6 +44,7 @@ import (
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
@@ -69,6 +70,8 @@ type Reconciler struct {
 	Clock           clock.Clock
 	SeedName        string
 	GardenNamespace string
+	// ShootStateEncryption is the optional configuration for the encryption of sensitive data in ShootStates.
+	ShootStateEncryption *config.ShootStateEncryption
 
 	// RateLimiter allows limiting exponential backoff for testing purposes
 	RateLimiter ratelimiter.RateLimiter
@@ -694,6 +697,18 @@ func (r *Reconciler) reconcileBackupEntryExtension(gardenCtx context.Context, se
 	if err := r.GardenClient.Get(gardenCtx, kubernetesutils.Key(backupEntry.Namespace, shootName), shootState); err != nil {
 		return err
 	}
+
+	var encryption *shootstate.Encryption
+	if r.ShootStateEncryption != nil {
+		var err error
+		if encryption, err = shootstate.GetEncryption(seedCtx, r.SeedClient, r.GardenNamespace, r.ShootStateEncryption.SecretName, r.ShootStateEncryption.PrimaryKeyName); err != nil {
+			return err
+		}
+	}
+	if err := shootstate.Decrypt(shootState, encryption); err != nil {
+		return err
+	}
+
 	return component.Restore(seedCtx, shootState)
 }
 
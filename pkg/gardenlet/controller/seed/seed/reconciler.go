@@ -40,6 +40,7 @@ import (
 	"github.com/gardener/gardener/pkg/utils/flow"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	gardenletutils "github.com/gardener/gardener/pkg/utils/gardener/gardenlet"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
@@ -164,6 +165,12 @@ func (r *Reconciler) updateStatusOperationStart(ctx context.Context, seed *garde
 	seed.Status.ClientCertificateExpirationTimestamp = r.ClientCertificateExpirationTimestamp
 	seed.Status.KubernetesVersion = ptr.To(r.SeedClientSet.Version())
 
+	shootStateEncryptionKeys, err := r.shootStateEncryptionKeys(ctx)
+	if err != nil {
+		return err
+	}
+	seed.Status.ShootStateEncryptionKeys = shootStateEncryptionKeys
+
 	// Initialize capacity and allocatable
 	var capacity, allocatable corev1.ResourceList
 	if r.Config.Resources != nil && len(r.Config.Resources.Capacity) > 0 {
@@ -192,6 +199,22 @@ func (r *Reconciler) updateStatusOperationStart(ctx context.Context, seed *garde
 	return r.GardenClient.Status().Update(ctx, seed)
 }
 
+// shootStateEncryptionKeys returns the names and fingerprints of the key encryption keys available for decrypting
+// ShootStates. They are reported in the seed status so that control plane migrations to seeds lacking the needed key
+// can be rejected.
+func (r *Reconciler) shootStateEncryptionKeys(ctx context.Context) ([]gardencorev1beta1.ShootStateEncryptionKey, error) {
+	if r.Config.ShootStateEncryption == nil {
+		return nil, nil
+	}
+
+	encryption, err := shootstate.GetEncryption(ctx, r.SeedClientSet.Client(), r.GardenNamespace, r.Config.ShootStateEncryption.SecretName, r.Config.ShootStateEncryption.PrimaryKeyName)
+	if err != nil {
+		return nil, err
+	}
+
+	return encryption.Keys(), nil
+}
+
 func (r *Reconciler) updateStatusOperationSuccess(ctx context.Context, seed *gardencorev1beta1.Seed, operationType gardencorev1beta1.LastOperationType) error {
 	var (
 		now                        = metav1.NewTime(r.Clock.Now().UTC())
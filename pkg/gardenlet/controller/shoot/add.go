@@ -85,8 +85,9 @@ func AddToManager(
 		mgr.GetLogger().Info("Adding shoot state reconciler since gardenlet is responsible for an unmanaged seed")
 
 		if err := (&state.Reconciler{
-			Config:   *cfg.Controllers.ShootState,
-			SeedName: cfg.SeedConfig.Name,
+			Config:               *cfg.Controllers.ShootState,
+			SeedName:             cfg.SeedConfig.Name,
+			ShootStateEncryption: cfg.ShootStateEncryption,
 		}).AddToManager(mgr, gardenCluster, seedCluster); err != nil {
 			return fmt.Errorf("failed adding state reconciler: %w", err)
 		}
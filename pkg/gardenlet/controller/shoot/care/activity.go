@@ -0,0 +1,273 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package care
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/common/expfmt"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/clock"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+const (
+	metricDispatchedRequests = "apiserver_flowcontrol_dispatched_requests_total"
+	metricProcessStartTime   = "process_start_time_seconds"
+	labelFlowSchema          = "flow_schema"
+)
+
+var (
+	// userFlowSchemas are the names of the API Priority and Fairness flow schemas which match requests that are neither
+	// issued by service accounts nor by Kubernetes system components, i.e., requests of human users.
+	userFlowSchemas = sets.New("global-default", "catch-all")
+
+	// systemNamespaces are the namespaces whose workload resources are not considered for detecting user activity.
+	systemNamespaces = sets.New(metav1.NamespaceSystem, metav1.NamespacePublic, "kube-node-lease")
+
+	// workloadKinds are the kinds of workload resources whose changes are considered user activity.
+	workloadKinds = []schema.GroupVersionKind{
+		appsv1.SchemeGroupVersion.WithKind("DeploymentList"),
+		appsv1.SchemeGroupVersion.WithKind("StatefulSetList"),
+		appsv1.SchemeGroupVersion.WithKind("DaemonSetList"),
+		batchv1.SchemeGroupVersion.WithKind("JobList"),
+		batchv1.SchemeGroupVersion.WithKind("CronJobList"),
+	}
+)
+
+// Activity contains required information for determining the last user activity in a shoot cluster.
+type Activity struct {
+	log                    logr.Logger
+	shoot                  *gardencorev1beta1.Shoot
+	initializeShootClients ShootClientInit
+	clock                  clock.Clock
+	requestCounters        *APIServerRequestCounters
+}
+
+// NewActivity creates a new instance for determining the last user activity in a shoot cluster. If no request counters
+// are given, requests to the API server of the shoot are not considered.
+func NewActivity(log logr.Logger, shoot *gardencorev1beta1.Shoot, shootClientInit ShootClientInit, clock clock.Clock, requestCounters *APIServerRequestCounters) *Activity {
+	return &Activity{
+		log:                    log,
+		shoot:                  shoot,
+		initializeShootClients: shootClientInit,
+		clock:                  clock,
+		requestCounters:        requestCounters,
+	}
+}
+
+// LastActivity returns the time of the most recent user activity which can be observed in the shoot cluster. Changes
+// to workload resources outside of system namespaces and requests of users to the API server count as activity.
+// Nil is returned if no activity was observed. An error is returned if the activity cannot be determined, e.g., because
+// the API server of the shoot is not reachable.
+func (a *Activity) LastActivity(ctx context.Context) (*time.Time, error) {
+	if a.shoot.Status.IsHibernated {
+		// Activity of hibernated shoots is not observed. A wake-up is recorded as activity by the hibernation controller
+		// of gardener-controller-manager.
+		a.requestCounters.Forget(client.ObjectKeyFromObject(a.shoot))
+		return nil, nil
+	}
+
+	shootClient, apiServerRunning, err := a.initializeShootClients()
+	if err != nil {
+		return nil, fmt.Errorf("could not initialize shoot client: %w", err)
+	}
+	if !apiServerRunning {
+		return nil, fmt.Errorf("API server of shoot is not running")
+	}
+
+	lastActivity, err := a.lastWorkloadChange(ctx, shootClient.Client())
+	if err != nil {
+		return nil, err
+	}
+
+	if a.requestCounters != nil {
+		requested, err := a.userRequestsObserved(ctx, shootClient.RESTClient())
+		if err != nil {
+			return nil, err
+		}
+		if requested {
+			now := a.clock.Now()
+			lastActivity = &now
+		}
+	}
+
+	return lastActivity, nil
+}
+
+func (a *Activity) lastWorkloadChange(ctx context.Context, c client.Client) (*time.Time, error) {
+	var lastChange *time.Time
+
+	for _, gvk := range workloadKinds {
+		list := &metav1.PartialObjectMetadataList{}
+		list.SetGroupVersionKind(gvk)
+		if err := c.List(ctx, list); err != nil {
+			return nil, fmt.Errorf("failed listing %s: %w", gvk.Kind, err)
+		}
+
+		for _, obj := range list.Items {
+			if isSystemWorkload(obj) {
+				continue
+			}
+
+			if t := lastSpecChange(obj); lastChange == nil || t.After(*lastChange) {
+				lastChange = &t
+			}
+		}
+	}
+
+	return lastChange, nil
+}
+
+// isSystemWorkload returns true if the given workload resource is managed by Gardener or by another workload resource,
+// e.g., a Job created by a CronJob, or if it resides in a system namespace.
+func isSystemWorkload(obj metav1.PartialObjectMetadata) bool {
+	return systemNamespaces.Has(obj.Namespace) ||
+		obj.Labels[resourcesv1alpha1.ManagedBy] == resourcesv1alpha1.GardenerManager ||
+		metav1.GetControllerOfNoCopy(&obj) != nil
+}
+
+// lastSpecChange returns the last time when the given object was created or changed. Changes to subresources, e.g.,
+// status updates by controllers or scaling via the scale subresource, are not considered.
+func lastSpecChange(obj metav1.PartialObjectMetadata) time.Time {
+	lastChange := obj.CreationTimestamp.Time
+	for _, entry := range obj.ManagedFields {
+		if entry.Subresource == "" && entry.Time != nil && entry.Time.After(lastChange) {
+			lastChange = entry.Time.Time
+		}
+	}
+	return lastChange
+}
+
+func (a *Activity) userRequestsObserved(ctx context.Context, restClient rest.Interface) (bool, error) {
+	body, err := restClient.Get().AbsPath("/metrics").DoRaw(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed reading metrics of kube-apiserver: %w", err)
+	}
+
+	replica, count, err := userRequestCount(bytes.NewReader(body))
+	if err != nil {
+		return false, fmt.Errorf("failed parsing metrics of kube-apiserver: %w", err)
+	}
+
+	return a.requestCounters.Observe(a.shoot, replica, count, a.clock.Now()), nil
+}
+
+// userRequestCount returns the number of requests of users dispatched by the kube-apiserver which exposed the given
+// metrics. The kube-apiserver replica is identified by its process start time.
+func userRequestCount(r io.Reader) (string, float64, error) {
+	var parser expfmt.TextParser
+	families, err := parser.TextToMetricFamilies(r)
+	if err != nil {
+		return "", 0, err
+	}
+
+	var replica string
+	if family, ok := families[metricProcessStartTime]; ok && len(family.GetMetric()) > 0 {
+		replica = strconv.FormatFloat(family.GetMetric()[0].GetGauge().GetValue(), 'f', -1, 64)
+	}
+
+	var count float64
+	if family, ok := families[metricDispatchedRequests]; ok {
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == labelFlowSchema && userFlowSchemas.Has(label.GetValue()) {
+					count += metric.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+
+	return replica, count, nil
+}
+
+// APIServerRequestCounters remembers the number of user requests which was last observed per kube-apiserver replica of
+// shoot clusters. It is used to detect new requests between subsequent care operations.
+type APIServerRequestCounters struct {
+	lock     sync.Mutex
+	counters map[client.ObjectKey]*shootRequestCounters
+}
+
+type shootRequestCounters struct {
+	uid      types.UID
+	replicas map[string]replicaRequestCounter
+}
+
+type replicaRequestCounter struct {
+	count        float64
+	lastObserved time.Time
+}
+
+// replicaCounterExpiration is the duration after which the request counter of a kube-apiserver replica which was not
+// observed anymore is removed, e.g., because the replica was restarted or replaced.
+const replicaCounterExpiration = 24 * time.Hour
+
+// NewAPIServerRequestCounters returns a new empty APIServerRequestCounters instance.
+func NewAPIServerRequestCounters() *APIServerRequestCounters {
+	return &APIServerRequestCounters{counters: make(map[client.ObjectKey]*shootRequestCounters)}
+}
+
+// Observe records the given request count of the given kube-apiserver replica of a shoot. It returns true if the count
+// increased since the last observation of the same replica. The first observation of a replica counts as activity if
+// any user requests were dispatched since the replica was started, e.g., after a restart of the kube-apiserver.
+func (c *APIServerRequestCounters) Observe(shoot *gardencorev1beta1.Shoot, replica string, count float64, now time.Time) bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	key := client.ObjectKeyFromObject(shoot)
+	counters, ok := c.counters[key]
+	if !ok || counters.uid != shoot.UID {
+		counters = &shootRequestCounters{uid: shoot.UID, replicas: make(map[string]replicaRequestCounter)}
+		c.counters[key] = counters
+	}
+
+	for name, counter := range counters.replicas {
+		if now.Sub(counter.lastObserved) > replicaCounterExpiration {
+			delete(counters.replicas, name)
+		}
+	}
+
+	previous := counters.replicas[replica]
+	counters.replicas[replica] = replicaRequestCounter{count: count, lastObserved: now}
+	return count > previous.count
+}
+
+// Forget removes all recorded request counts of the given shoot. It is a no-op if c is nil.
+func (c *APIServerRequestCounters) Forget(key client.ObjectKey) {
+	if c == nil {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.counters, key)
+}
@@ -0,0 +1,273 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package care_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	fakerestclient "k8s.io/client-go/rest/fake"
+	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	kubernetesfake "github.com/gardener/gardener/pkg/client/kubernetes/fake"
+	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/care"
+)
+
+var _ = Describe("Activity", func() {
+	var (
+		ctx = context.Background()
+
+		now       time.Time
+		fakeClock *testclock.FakeClock
+
+		objects          []client.Object
+		apiServerRunning bool
+		metrics          string
+		requestCounters  *APIServerRequestCounters
+		shootClientInit  func() (kubernetes.Interface, bool, error)
+
+		shoot *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		now = time.Date(2024, 4, 12, 10, 0, 0, 0, time.UTC)
+		fakeClock = testclock.NewFakeClock(now)
+
+		objects = nil
+		apiServerRunning = true
+		metrics = ""
+		requestCounters = nil
+
+		shootClientInit = func() (kubernetes.Interface, bool, error) {
+			fakeClient := fakeclient.NewClientBuilder().WithScheme(kubernetes.ShootScheme).WithObjects(objects...).Build()
+			fakeRESTClient := &fakerestclient.RESTClient{
+				NegotiatedSerializer: serializer.NewCodecFactory(kubernetes.ShootScheme).WithoutConversion(),
+				Client: fakerestclient.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+					if req.URL.Path != "/metrics" {
+						return &http.Response{StatusCode: http.StatusNotFound, Body: io.NopCloser(strings.NewReader(""))}, nil
+					}
+					return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(metrics))}, nil
+				}),
+			}
+			return kubernetesfake.NewClientSetBuilder().WithClient(fakeClient).WithRESTClient(fakeRESTClient).Build(), apiServerRunning, nil
+		}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-project", UID: "1234"},
+			Spec: gardencorev1beta1.ShootSpec{
+				Hibernation: &gardencorev1beta1.Hibernation{
+					IdlePolicy: &gardencorev1beta1.HibernationIdlePolicy{IdleDuration: metav1.Duration{Duration: time.Hour}},
+				},
+			},
+		}
+	})
+
+	lastActivity := func() *time.Time {
+		t, err := NewActivity(logr.Discard(), shoot, shootClientInit, fakeClock, requestCounters).LastActivity(ctx)
+		Expect(err).NotTo(HaveOccurred())
+		return t
+	}
+
+	timeAgo := func(d time.Duration) *metav1.Time {
+		return &metav1.Time{Time: now.Add(-d)}
+	}
+
+	apiServerMetrics := func(startTime, globalDefault, catchAll, serviceAccounts int) string {
+		return fmt.Sprintf(`# TYPE process_start_time_seconds gauge
+process_start_time_seconds %d
+# TYPE apiserver_flowcontrol_dispatched_requests_total counter
+apiserver_flowcontrol_dispatched_requests_total{flow_schema="global-default",priority_level="global-default"} %d
+apiserver_flowcontrol_dispatched_requests_total{flow_schema="catch-all",priority_level="catch-all"} %d
+apiserver_flowcontrol_dispatched_requests_total{flow_schema="service-accounts",priority_level="workload-low"} %d
+`, startTime, globalDefault, catchAll, serviceAccounts)
+	}
+
+	Describe("#LastActivity", func() {
+		It("should not report activity for hibernated shoots", func() {
+			shoot.Spec.Hibernation.Enabled = ptr.To(true)
+			shoot.Status.IsHibernated = true
+
+			Expect(lastActivity()).To(BeNil())
+		})
+
+		It("should not report activity for hibernated shoots which are being woken up", func() {
+			shoot.Spec.Hibernation.Enabled = ptr.To(false)
+			shoot.Status.IsHibernated = true
+
+			Expect(lastActivity()).To(BeNil())
+		})
+
+		It("should fail if the API server is not running", func() {
+			apiServerRunning = false
+			objects = append(objects, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", CreationTimestamp: *timeAgo(time.Minute)}})
+
+			_, err := NewActivity(logr.Discard(), shoot, shootClientInit, fakeClock, requestCounters).LastActivity(ctx)
+			Expect(err).To(MatchError(ContainSubstring("API server of shoot is not running")))
+		})
+
+		It("should not report activity if there are no workloads", func() {
+			Expect(lastActivity()).To(BeNil())
+		})
+
+		It("should report the time of the last workload change", func() {
+			objects = append(objects,
+				&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+					Name:              "app",
+					Namespace:         "default",
+					CreationTimestamp: *timeAgo(48 * time.Hour),
+					ManagedFields: []metav1.ManagedFieldsEntry{
+						{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationUpdate, Time: timeAgo(3 * time.Hour)},
+						{Manager: "kube-controller-manager", Operation: metav1.ManagedFieldsOperationUpdate, Subresource: "status", Time: timeAgo(time.Minute)},
+					},
+				}},
+				&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default", CreationTimestamp: *timeAgo(2 * time.Hour)}},
+				&batchv1.CronJob{ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: "default", CreationTimestamp: *timeAgo(5 * time.Hour)}},
+			)
+
+			Expect(lastActivity()).To(PointTo(BeTemporally("==", timeAgo(2*time.Hour).Time)))
+		})
+
+		It("should ignore system workloads", func() {
+			objects = append(objects,
+				&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", CreationTimestamp: *timeAgo(3 * time.Hour)}},
+				&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "coredns", Namespace: "kube-system", CreationTimestamp: *timeAgo(time.Minute)}},
+				&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{
+					Name:              "agent",
+					Namespace:         "monitoring",
+					CreationTimestamp: *timeAgo(time.Minute),
+					Labels:            map[string]string{"resources.gardener.cloud/managed-by": "gardener"},
+				}},
+				&batchv1.Job{ObjectMeta: metav1.ObjectMeta{
+					Name:              "backup-1234",
+					Namespace:         "default",
+					CreationTimestamp: *timeAgo(time.Minute),
+					OwnerReferences:   []metav1.OwnerReference{{APIVersion: "batch/v1", Kind: "CronJob", Name: "backup", UID: "5678", Controller: ptr.To(true)}},
+				}},
+			)
+
+			Expect(lastActivity()).To(PointTo(BeTemporally("==", timeAgo(3*time.Hour).Time)))
+		})
+
+		Context("API server requests", func() {
+			BeforeEach(func() {
+				requestCounters = NewAPIServerRequestCounters()
+				objects = append(objects, &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", CreationTimestamp: *timeAgo(3 * time.Hour)}})
+			})
+
+			It("should report activity if the number of user requests increased", func() {
+				metrics = apiServerMetrics(1000, 0, 0, 100)
+				Expect(lastActivity()).To(PointTo(BeTemporally("==", timeAgo(3*time.Hour).Time)))
+
+				metrics = apiServerMetrics(1000, 10, 1, 100)
+				Expect(lastActivity()).To(PointTo(Equal(now)))
+
+				fakeClock.Step(time.Minute)
+				now = fakeClock.Now()
+				metrics = apiServerMetrics(1000, 10, 1, 100)
+				Expect(lastActivity()).To(PointTo(BeTemporally("==", timeAgo(3*time.Hour+time.Minute).Time)))
+
+				metrics = apiServerMetrics(1000, 12, 1, 100)
+				Expect(lastActivity()).To(PointTo(Equal(now)))
+			})
+
+			It("should not report activity if only requests of service accounts increased", func() {
+				metrics = apiServerMetrics(1000, 10, 1, 100)
+				Expect(lastActivity()).To(PointTo(Equal(now)))
+
+				metrics = apiServerMetrics(1000, 10, 1, 500)
+				Expect(lastActivity()).To(PointTo(BeTemporally("==", timeAgo(3*time.Hour).Time)))
+			})
+
+			It("should distinguish the counters of different API server replicas", func() {
+				metrics = apiServerMetrics(1000, 10, 1, 100)
+				Expect(lastActivity()).To(PointTo(Equal(now)))
+
+				metrics = apiServerMetrics(2000, 50, 1, 100)
+				Expect(lastActivity()).To(PointTo(Equal(now)))
+
+				fakeClock.Step(time.Minute)
+				now = fakeClock.Now()
+				metrics = apiServerMetrics(1000, 10, 1, 100)
+				Expect(lastActivity()).To(PointTo(BeTemporally("==", timeAgo(3*time.Hour+time.Minute).Time)))
+
+				metrics = apiServerMetrics(2000, 50, 1, 100)
+				Expect(lastActivity()).To(PointTo(BeTemporally("==", timeAgo(3*time.Hour+time.Minute).Time)))
+			})
+
+			It("should report user requests of a restarted API server replica on its first observation", func() {
+				metrics = apiServerMetrics(1000, 10, 1, 100)
+				Expect(lastActivity()).To(PointTo(Equal(now)))
+
+				fakeClock.Step(time.Minute)
+				now = fakeClock.Now()
+				metrics = apiServerMetrics(3000, 2, 0, 100)
+				Expect(lastActivity()).To(PointTo(Equal(now)))
+			})
+
+			It("should forget the counters of hibernated shoots", func() {
+				metrics = apiServerMetrics(1000, 10, 1, 100)
+				Expect(lastActivity()).To(PointTo(Equal(now)))
+
+				shoot.Spec.Hibernation.Enabled = ptr.To(true)
+				shoot.Status.IsHibernated = true
+				Expect(lastActivity()).To(BeNil())
+
+				fakeClock.Step(time.Minute)
+				now = fakeClock.Now()
+				shoot.Spec.Hibernation.Enabled = ptr.To(false)
+				shoot.Status.IsHibernated = false
+				Expect(lastActivity()).To(PointTo(Equal(now)))
+			})
+
+			It("should reset the counters of recreated shoots", func() {
+				metrics = apiServerMetrics(1000, 10, 1, 100)
+				Expect(lastActivity()).To(PointTo(Equal(now)))
+
+				shoot.UID = "5678"
+				Expect(lastActivity()).To(PointTo(Equal(now)))
+			})
+
+			It("should remove the counters of replicas which were not observed for a long time", func() {
+				metrics = apiServerMetrics(1000, 10, 1, 100)
+				Expect(lastActivity()).To(PointTo(Equal(now)))
+
+				fakeClock.Step(25 * time.Hour)
+				now = fakeClock.Now()
+				metrics = apiServerMetrics(2000, 10, 1, 100)
+				Expect(lastActivity()).To(PointTo(Equal(now)))
+
+				metrics = apiServerMetrics(1000, 10, 1, 100)
+				Expect(lastActivity()).To(PointTo(Equal(now)))
+			})
+		})
+	})
+})
@@ -47,6 +47,9 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, gardenCluster cluster.Clu
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
+	if r.apiServerRequestCounters == nil {
+		r.apiServerRequestCounters = NewAPIServerRequestCounters()
+	}
 
 	return builder.
 		ControllerManagedBy(mgr).
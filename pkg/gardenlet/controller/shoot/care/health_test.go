@@ -259,7 +259,7 @@ var _ = Describe("health check", func() {
 			nodeName                   = "node1"
 			oscSecretMeta              = map[string]metav1.ObjectMeta{
 				workerPoolName1: {
-					Name:        operatingsystemconfig.Key(workerPoolName1, kubernetesVersion, nil),
+					Name:        operatingsystemconfig.Key(workerPoolName1, kubernetesVersion, nil, nil),
 					Labels:      map[string]string{"worker.gardener.cloud/pool": workerPoolName1},
 					Annotations: map[string]string{"checksum/data-script": cloudConfigSecretChecksum1},
 				},
@@ -317,7 +317,7 @@ var _ = Describe("health check", func() {
 						meta := m.DeepCopy()
 						// regenerate OSC secret key because it might be different when UseGardenerNodeAgent feature gate is enabled
 						if strings.HasPrefix(meta.Name, "gardener-node-agent") || strings.HasPrefix(meta.Name, "cloud-config") {
-							meta.Name = operatingsystemconfig.Key(workerPoolName, kubernetesVersion, nil)
+							meta.Name = operatingsystemconfig.Key(workerPoolName, kubernetesVersion, nil, nil)
 						}
 
 						list.Items = append(list.Items, corev1.Secret{
@@ -430,7 +430,7 @@ var _ = Describe("health check", func() {
 				true,
 				map[string]metav1.ObjectMeta{
 					workerPoolName1: {
-						Name:        operatingsystemconfig.Key(workerPoolName1, kubernetesVersion, nil),
+						Name:        operatingsystemconfig.Key(workerPoolName1, kubernetesVersion, nil, nil),
 						Annotations: map[string]string{"checksum/data-script": cloudConfigSecretChecksum1},
 						Labels:      map[string]string{"worker.gardener.cloud/pool": workerPoolName1},
 					},
@@ -451,7 +451,7 @@ var _ = Describe("health check", func() {
 				false,
 				map[string]metav1.ObjectMeta{
 					workerPoolName1: {
-						Name:        operatingsystemconfig.Key(workerPoolName1, kubernetesVersion, nil),
+						Name:        operatingsystemconfig.Key(workerPoolName1, kubernetesVersion, nil, nil),
 						Annotations: map[string]string{"checksum/data-script": cloudConfigSecretChecksum1},
 						Labels:      map[string]string{"worker.gardener.cloud/pool": workerPoolName1},
 					},
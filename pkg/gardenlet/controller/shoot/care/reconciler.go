@@ -22,6 +22,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -51,6 +52,8 @@ var (
 	NewGarbageCollector = defaultNewGarbageCollector
 	// NewWebhookRemediator is used to create a new webhook remediation instance.
 	NewWebhookRemediator = defaultNewWebhookRemediator
+	// NewActivityReporter is used to create a new instance for determining the last user activity in a shoot cluster.
+	NewActivityReporter = defaultNewActivityReporter
 )
 
 // Reconciler reconciles Shoot resources and executes care operations, e.g. health checks or garbage collection.
@@ -64,7 +67,8 @@ type Reconciler struct {
 	GardenClusterIdentity string
 	SeedName              string
 
-	gardenSecrets map[string]*corev1.Secret
+	gardenSecrets            map[string]*corev1.Secret
+	apiServerRequestCounters *APIServerRequestCounters
 }
 
 // Reconcile executes care operations, e.g. health checks or garbage collection.
@@ -80,6 +84,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 	if err := r.GardenClient.Get(ctx, req.NamespacedName, shoot); err != nil {
 		if apierrors.IsNotFound(err) {
 			log.V(1).Info("Object is gone, stop reconciling")
+			r.apiServerRequestCounters.Forget(req.NamespacedName)
 			return reconcile.Result{}, nil
 		}
 		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
@@ -94,6 +99,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 
 	// if shoot is no longer managed by this gardenlet (e.g., due to migration to another seed) then don't requeue.
 	if ptr.Deref(shoot.Spec.SeedName, "") != r.SeedName {
+		r.apiServerRequestCounters.Forget(req.NamespacedName)
 		return reconcile.Result{}, nil
 	}
 
@@ -138,6 +144,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		staleExtensionHealthCheckThreshold    = gardenlethelper.StaleExtensionHealthChecksThreshold(r.Config.Controllers.ShootCare.StaleExtensionHealthChecks)
 		initializeShootClients                = shootClientInitializer(careCtx, o)
 		updatedConditions, updatedConstraints []gardencorev1beta1.Condition
+		lastActivity                          *time.Time
 	)
 
 	if err := flow.Parallel(
@@ -187,6 +194,24 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 			}
 			return nil
 		},
+		// Determine the last user activity for shoots which are hibernated when being idle
+		func(ctx context.Context) error {
+			if shoot.Spec.Hibernation == nil || shoot.Spec.Hibernation.IdlePolicy == nil {
+				r.apiServerRequestCounters.Forget(req.NamespacedName)
+				return nil
+			}
+
+			var err error
+			lastActivity, err = NewActivityReporter(log, shoot, initializeShootClients, r.Clock, r.apiServerRequestCounters).LastActivity(ctx)
+			if err != nil {
+				// If the activity cannot be determined, the shoot might be in use. Hence, it is considered active to push
+				// back its idle deadline instead of hibernating it. Errors do not cause the care operation to fail.
+				log.Error(err, "Failed determining the last activity in the shoot cluster, considering it active")
+				now := r.Clock.Now()
+				lastActivity = &now
+			}
+			return nil
+		},
 	)(careCtx); err != nil {
 		return reconcile.Result{}, err
 	}
@@ -206,6 +231,17 @@ func (r *Reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reco
 		}
 	}
 
+	// Update last activity time if newer activity was observed
+	if lastActivity != nil && (shoot.Status.LastActivityTime == nil || lastActivity.After(shoot.Status.LastActivityTime.Time)) {
+		log.V(1).Info("Updating last activity time", "lastActivityTime", lastActivity)
+		patch := client.MergeFrom(shoot.DeepCopy())
+		shoot.Status.LastActivityTime = &metav1.Time{Time: *lastActivity}
+		if err := r.GardenClient.Status().Patch(ctx, shoot, patch); err != nil {
+			log.Error(err, "Error when trying to update the last activity time of the shoot")
+			return reconcile.Result{}, err
+		}
+	}
+
 	return reconcile.Result{RequeueAfter: r.Config.Controllers.ShootCare.SyncPeriod.Duration}, nil
 }
 
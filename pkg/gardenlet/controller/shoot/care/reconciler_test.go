@@ -28,6 +28,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
 	testclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -278,6 +279,71 @@ var _ = Describe("Shoot Care Control", func() {
 				})
 			})
 
+			Context("when the shoot has an idle hibernation policy", func() {
+				var (
+					lastActivity  *time.Time
+					activityError error
+				)
+
+				BeforeEach(func() {
+					lastActivity = nil
+					activityError = nil
+
+					DeferCleanup(test.WithVars(
+						&NewHealthCheck, healthCheckFunc(func(_ ShootConditions) []gardencorev1beta1.Condition { return nil }),
+						&NewConstraintCheck, constraintCheckFunc(func(_ ShootConstraints) []gardencorev1beta1.Condition { return nil }),
+						&NewActivityReporter, activityReporterFunc(func() (*time.Time, error) { return lastActivity, activityError }),
+					))
+				})
+
+				JustBeforeEach(func() {
+					shoot.Spec.Hibernation = &gardencorev1beta1.Hibernation{
+						IdlePolicy: &gardencorev1beta1.HibernationIdlePolicy{IdleDuration: metav1.Duration{Duration: time.Hour}},
+					}
+					Expect(gardenClient.Update(ctx, shoot)).To(Succeed())
+				})
+
+				It("should set the last activity time", func() {
+					lastActivity = ptr.To(fakeClock.Now().Add(-time.Minute).UTC().Truncate(time.Second))
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.LastActivityTime).To(PointTo(MatchFields(IgnoreExtras, Fields{"Time": BeTemporally("==", *lastActivity)})))
+				})
+
+				It("should not set an older last activity time", func() {
+					lastActivity = ptr.To(fakeClock.Now().Add(-time.Hour))
+					shoot.Status.LastActivityTime = &metav1.Time{Time: fakeClock.Now().Add(-time.Minute).UTC().Truncate(time.Second)}
+					Expect(gardenClient.Status().Update(ctx, shoot)).To(Succeed())
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.LastActivityTime).To(PointTo(MatchFields(IgnoreExtras, Fields{"Time": BeTemporally("==", shoot.Status.LastActivityTime.Time)})))
+				})
+
+				It("should not set the last activity time if no activity was observed", func() {
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.LastActivityTime).To(BeNil())
+				})
+
+				It("should consider the shoot active if its activity cannot be determined", func() {
+					activityError = errors.New("fake")
+
+					Expect(reconciler.Reconcile(ctx, req)).To(Equal(reconcile.Result{RequeueAfter: careSyncPeriod}))
+
+					updatedShoot := &gardencorev1beta1.Shoot{}
+					Expect(gardenClient.Get(ctx, client.ObjectKeyFromObject(shoot), updatedShoot)).To(Succeed())
+					Expect(updatedShoot.Status.LastActivityTime).To(PointTo(MatchFields(IgnoreExtras, Fields{"Time": BeTemporally("==", fakeClock.Now().UTC().Truncate(time.Second))})))
+				})
+			})
+
 			Context("when conditions / constraints are returned unchanged", func() {
 				BeforeEach(func() {
 					DeferCleanup(test.WithVars(
@@ -577,6 +643,20 @@ func nopGarbageCollectorFunc() NewGarbageCollectorFunc {
 	}
 }
 
+type activityReporter struct {
+	lastActivity func() (*time.Time, error)
+}
+
+func (a *activityReporter) LastActivity(_ context.Context) (*time.Time, error) {
+	return a.lastActivity()
+}
+
+func activityReporterFunc(lastActivity func() (*time.Time, error)) NewActivityReporterFunc {
+	return func(_ logr.Logger, _ *gardencorev1beta1.Shoot, _ ShootClientInit, _ clock.Clock, _ *APIServerRequestCounters) ActivityReporter {
+		return &activityReporter{lastActivity: lastActivity}
+	}
+}
+
 func consistOfConditionsInUnknownStatus(message string, isWorkerless bool) types.GomegaMatcher {
 	var expectedLength = 4
 	matcher := And(
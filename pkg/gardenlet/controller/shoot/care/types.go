@@ -129,6 +129,19 @@ var defaultNewWebhookRemediator = func(log logr.Logger, shoot *gardencorev1beta1
 	return NewWebhookRemediation(log, shoot, init)
 }
 
+// ActivityReporter is an interface used to determine the last user activity in a shoot cluster.
+type ActivityReporter interface {
+	LastActivity(ctx context.Context) (*time.Time, error)
+}
+
+// NewActivityReporterFunc is a function used to create a new instance for determining the last user activity.
+type NewActivityReporterFunc func(log logr.Logger, shoot *gardencorev1beta1.Shoot, init ShootClientInit, clock clock.Clock, requestCounters *APIServerRequestCounters) ActivityReporter
+
+// defaultNewActivityReporter is the default function to create a new instance for determining the last user activity.
+var defaultNewActivityReporter = func(log logr.Logger, shoot *gardencorev1beta1.Shoot, init ShootClientInit, clock clock.Clock, requestCounters *APIServerRequestCounters) ActivityReporter {
+	return NewActivity(log, shoot, init, clock, requestCounters)
+}
+
 // NewOperationFunc is a function used to create a new `operation.Operation` instance.
 type NewOperationFunc func(
 	ctx context.Context,
@@ -33,6 +33,7 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot/helper"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 )
 
 // ControllerName is the name of this controller.
@@ -77,13 +78,20 @@ var CalculateControllerInfos = helper.CalculateControllerInfos
 // EventHandler returns an event handler.
 func (r *Reconciler) EventHandler(log logr.Logger) handler.EventHandler {
 	return &handler.Funcs{
-		CreateFunc: func(_ context.Context, e event.CreateEvent, q workqueue.RateLimitingInterface) {
+		CreateFunc: func(ctx context.Context, e event.CreateEvent, q workqueue.RateLimitingInterface) {
 			shoot, ok := e.Object.(*gardencorev1beta1.Shoot)
 			if !ok {
 				return
 			}
 
-			enqueueAfter := CalculateControllerInfos(shoot, r.Clock, *r.Config.Controllers.Shoot).EnqueueAfter
+			// The project is only needed for honoring its maintenance freeze periods, hence errors are not critical here.
+			project, _, err := gardenerutils.ProjectAndNamespaceFromReader(ctx, r.GardenClient, shoot.Namespace)
+			if err != nil {
+				log.Error(err, "Failed reading Project for Shoot, ignoring its maintenance freeze periods", "namespace", shoot.Namespace, "name", shoot.Name)
+				project = nil
+			}
+
+			enqueueAfter := CalculateControllerInfos(shoot, project, r.Clock, *r.Config.Controllers.Shoot).EnqueueAfter
 			nextReconciliation := r.Clock.Now().UTC().Add(enqueueAfter)
 
 			log.Info("Scheduling next reconciliation for Shoot",
@@ -20,16 +20,21 @@ import (
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/clock"
 	testclock "k8s.io/utils/clock/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	gardenletconfig "github.com/gardener/gardener/pkg/gardenlet/apis/config"
 	. "github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot"
 	"github.com/gardener/gardener/pkg/gardenlet/controller/shoot/shoot/helper"
@@ -60,16 +65,19 @@ var _ = Describe("Add", func() {
 
 	Describe("#EventHandler", func() {
 		var (
-			hdlr  handler.EventHandler
-			queue *mockworkqueue.MockRateLimitingInterface
-			obj   *gardencorev1beta1.Shoot
-			req   reconcile.Request
+			hdlr         handler.EventHandler
+			gardenClient client.Client
+			queue        *mockworkqueue.MockRateLimitingInterface
+			obj          *gardencorev1beta1.Shoot
+			req          reconcile.Request
 		)
 
 		BeforeEach(func() {
+			gardenClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
 			hdlr = (&Reconciler{
-				Config: cfg,
-				Clock:  cl,
+				GardenClient: gardenClient,
+				Config:       cfg,
+				Clock:        cl,
 			}).EventHandler(log)
 			queue = mockworkqueue.NewMockRateLimitingInterface(gomock.NewController(GinkgoT()))
 			obj = &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "namespace"}}
@@ -78,7 +86,29 @@ var _ = Describe("Add", func() {
 
 		It("should enqueue the object for Create events according to the calculated duration", func() {
 			duration := time.Minute
-			DeferCleanup(test.WithVar(&CalculateControllerInfos, func(*gardencorev1beta1.Shoot, clock.Clock, gardenletconfig.ShootControllerConfiguration) helper.ControllerInfos {
+			DeferCleanup(test.WithVar(&CalculateControllerInfos, func(_ *gardencorev1beta1.Shoot, project *gardencorev1beta1.Project, _ clock.Clock, _ gardenletconfig.ShootControllerConfiguration) helper.ControllerInfos {
+				Expect(project).To(BeNil())
+				return helper.ControllerInfos{
+					EnqueueAfter: duration,
+				}
+			}))
+			queue.EXPECT().AddAfter(req, duration)
+
+			hdlr.Create(ctx, event.CreateEvent{Object: obj}, queue)
+		})
+
+		It("should pass the shoot's project when calculating the duration", func() {
+			project := &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "project"},
+				Spec:       gardencorev1beta1.ProjectSpec{Namespace: &obj.Namespace},
+			}
+			Expect(gardenClient.Create(ctx, project)).To(Succeed())
+			Expect(gardenClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: obj.Namespace, Labels: map[string]string{"project.gardener.cloud/name": project.Name}}})).To(Succeed())
+
+			duration := time.Minute
+			DeferCleanup(test.WithVar(&CalculateControllerInfos, func(_ *gardencorev1beta1.Shoot, p *gardencorev1beta1.Project, _ clock.Clock, _ gardenletconfig.ShootControllerConfiguration) helper.ControllerInfos {
+				Expect(p).NotTo(BeNil())
+				Expect(p.Name).To(Equal(project.Name))
 				return helper.ControllerInfos{
 					EnqueueAfter: duration,
 				}
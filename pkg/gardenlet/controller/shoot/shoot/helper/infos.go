@@ -89,8 +89,13 @@ type ControllerInfos struct {
 // CalculateControllerInfos calculates whether and when a given shoot should be reconciled.
 // These results are supposed to be used/handled immediately. Always call CalculateControllerInfos to re-calculate the
 // results before using them.
-func CalculateControllerInfos(shoot *gardencorev1beta1.Shoot, clock clock.Clock, cfg gardenletconfig.ShootControllerConfiguration) ControllerInfos {
-	respectSyncPeriodOverwrite := ptr.Deref(cfg.RespectSyncPeriodOverwrite, false)
+// The maintenance freeze periods of the given project (may be nil) are honored when computing the shoot's maintenance
+// time window.
+func CalculateControllerInfos(shoot *gardencorev1beta1.Shoot, project *gardencorev1beta1.Project, clock clock.Clock, cfg gardenletconfig.ShootControllerConfiguration) ControllerInfos {
+	var (
+		respectSyncPeriodOverwrite = ptr.Deref(cfg.RespectSyncPeriodOverwrite, false)
+		maintenanceTimeWindow      = gardenerutils.EffectiveShootMaintenanceTimeWindowForProject(shoot, project)
+	)
 
 	i := ControllerInfos{
 		OperationType: ComputeOperationType(shoot),
@@ -103,8 +108,8 @@ func CalculateControllerInfos(shoot *gardencorev1beta1.Shoot, clock clock.Clock,
 		isFailed:                              gardenerutils.IsShootFailedAndUpToDate(shoot),
 		isUpToDate:                            gardenerutils.IsObservedAtLatestGenerationAndSucceeded(shoot),
 		confineSpecUpdateRollout:              v1beta1helper.ShootConfinesSpecUpdateRollout(shoot.Spec.Maintenance),
-		maintenanceTimeWindow:                 gardenerutils.EffectiveShootMaintenanceTimeWindow(shoot),
-		isNowInEffectiveMaintenanceTimeWindow: gardenerutils.IsNowInEffectiveShootMaintenanceTimeWindow(shoot, clock),
+		maintenanceTimeWindow:                 maintenanceTimeWindow,
+		isNowInEffectiveMaintenanceTimeWindow: maintenanceTimeWindow.Contains(clock.Now()),
 		alreadyReconciledDuringThisTimeWindow: gardenerutils.LastReconciliationDuringThisTimeWindow(shoot, clock),
 
 		syncPeriod: gardenerutils.SyncPeriodOfShoot(respectSyncPeriodOverwrite, cfg.SyncPeriod.Duration, shoot),
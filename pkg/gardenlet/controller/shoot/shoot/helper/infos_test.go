@@ -39,9 +39,10 @@ import (
 
 var _ = Describe("CalculateControllerInfos", func() {
 	var (
-		cl    *testclock.FakeClock
-		shoot *gardencorev1beta1.Shoot
-		cfg   gardenletconfig.ShootControllerConfiguration
+		cl      *testclock.FakeClock
+		shoot   *gardencorev1beta1.Shoot
+		project *gardencorev1beta1.Project
+		cfg     gardenletconfig.ShootControllerConfiguration
 
 		timeWindow      timewindow.MaintenanceTimeWindow
 		timeWindowBegin time.Time
@@ -73,6 +74,8 @@ var _ = Describe("CalculateControllerInfos", func() {
 			},
 		}
 
+		project = nil
+
 		timeWindow = *gardenerutils.EffectiveShootMaintenanceTimeWindow(shoot)
 		m := timeWindow.Begin()
 		now := cl.Now().UTC()
@@ -87,7 +90,7 @@ var _ = Describe("CalculateControllerInfos", func() {
 	})
 
 	JustBeforeEach(func() {
-		infos = CalculateControllerInfos(shoot, cl, cfg)
+		infos = CalculateControllerInfos(shoot, project, cl, cfg)
 	})
 
 	Context("shoot creation", func() {
@@ -330,6 +333,34 @@ var _ = Describe("CalculateControllerInfos", func() {
 							Expect(timeWindow.Contains(nextReconciliation)).To(BeTrue())
 						})
 					})
+
+					Context("maintenance is frozen by the project", func() {
+						BeforeEach(func() {
+							project = &gardencorev1beta1.Project{
+								Spec: gardencorev1beta1.ProjectSpec{
+									MaintenanceFreezePeriods: []gardencorev1beta1.MaintenanceFreezePeriod{{
+										Begin: metav1.NewTime(timeWindowBegin.Add(-time.Hour)),
+										End:   metav1.NewTime(timeWindowBegin.Add(25 * time.Hour)),
+									}},
+								},
+							}
+						})
+
+						It("should not reconcile the shoot immediately", func() {
+							Expect(infos.ShouldReconcileNow).To(BeFalse())
+							Expect(infos.ShouldOnlySyncClusterResource).To(BeFalse())
+						})
+
+						It("should requeue the shoot during its next maintenance time window after the freeze period", func() {
+							requeueAfter := infos.RequeueAfter
+							Expect(requeueAfter.Requeue).To(BeFalse())
+							Expect(requeueAfter.RequeueAfter).To(BeNumerically(">", 47*time.Hour))
+							Expect(requeueAfter.RequeueAfter).To(BeNumerically("<", 71*time.Hour))
+
+							nextReconciliation := cl.Now().Add(requeueAfter.RequeueAfter)
+							Expect(timeWindow.Contains(nextReconciliation)).To(BeTrue())
+						})
+					})
 				})
 			}
 
@@ -120,13 +120,16 @@ func (r *Reconciler) reconcileShoot(ctx context.Context, log logr.Logger, shoot
 		}
 	}
 
+	// The status is patched when the operation is prepared, hence check whether this is a retry beforehand.
+	retryAfterError := isRetryAfterError(shoot, operationType)
+
 	o, result, err := r.prepareOperation(ctx, log, shoot)
 	if err != nil || o == nil {
 		return result, err
 	}
 
 	r.Recorder.Event(shoot, corev1.EventTypeNormal, gardencorev1beta1.EventReconciling, fmt.Sprintf("%s Shoot cluster", utils.IifString(isRestoring, "Restoring", "Reconciling")))
-	if flowErr := r.runReconcileShootFlow(ctx, o, operationType); flowErr != nil {
+	if flowErr := r.runReconcileShootFlow(ctx, o, operationType, retryAfterError); flowErr != nil {
 		r.Recorder.Event(shoot, corev1.EventTypeWarning, gardencorev1beta1.EventReconcileError, flowErr.Description)
 		updateErr := r.patchShootStatusOperationError(ctx, shoot, flowErr.Description, operationType, flowErr.LastErrors...)
 		return reconcile.Result{}, errorsutils.WithSuppressed(errors.New(flowErr.Description), updateErr)
@@ -144,7 +147,7 @@ func (r *Reconciler) reconcileShoot(ctx context.Context, log logr.Logger, shoot
 	}
 
 	// determine when the next shoot reconciliation is supposed to happen
-	result = helper.CalculateControllerInfos(shoot, r.Clock, *r.Config.Controllers.Shoot).RequeueAfter
+	result = helper.CalculateControllerInfos(shoot, o.Garden.Project, r.Clock, *r.Config.Controllers.Shoot).RequeueAfter
 	nextReconciliation := r.Clock.Now().UTC().Add(result.RequeueAfter)
 
 	log.Info("Shoot operation finished successfully, scheduling next reconciliation for Shoot", "requeueAfter", result.RequeueAfter, "nextReconciliation", nextReconciliation)
@@ -285,7 +288,7 @@ func (r *Reconciler) prepareOperation(ctx context.Context, log logr.Logger, shoo
 		}
 	}
 
-	i := helper.CalculateControllerInfos(shoot, r.Clock, *r.Config.Controllers.Shoot)
+	i := helper.CalculateControllerInfos(shoot, project, r.Clock, *r.Config.Controllers.Shoot)
 	log.V(1).Info("Calculated infos", "infos", i)
 
 	if !i.ShouldReconcileNow {
@@ -376,6 +379,7 @@ func (r *Reconciler) initializeOperation(
 		WithInternalDomain(gardenObj.InternalDomain).
 		WithDefaultDomains(gardenObj.DefaultDomains).
 		WithServiceAccountIssuerHostname(gardenSecrets[v1beta1constants.GardenRoleShootServiceAccountIssuer]).
+		WithShootStateEncryptionFrom(r.SeedClientSet.Client(), r.Config.ShootStateEncryption).
 		Build(ctx, r.GardenClient)
 	if err != nil {
 		return nil, err
@@ -744,12 +744,16 @@ func (r *Reconciler) runDeleteShootFlow(ctx context.Context, o *operation.Operat
 		f = g.Compile()
 	)
 
-	if err := f.Run(ctx, flow.Opts{
+	trace := &flow.Trace{}
+	err = f.Run(ctx, flow.Opts{
 		Log:              o.Logger,
 		ProgressReporter: r.newProgressReporter(o.ReportShootProgress),
 		ErrorCleaner:     o.CleanShootTaskError,
 		ErrorContext:     errorContext,
-	}); err != nil {
+		Trace:            trace,
+	})
+	o.Logger.V(1).Info("Flow execution trace", "duration", trace.Duration(), "criticalPath", trace.CriticalPath())
+	if err != nil {
 		return v1beta1helper.NewWrappedLastErrors(v1beta1helper.FormatLastErrDescription(err), flow.Errors(err))
 	}
 
@@ -215,7 +215,14 @@ func (r *Reconciler) runMigrateShootFlow(ctx context.Context, o *operation.Opera
 		persistShootState = g.Add(flow.Task{
 			Name: "Persisting ShootState in garden cluster",
 			Fn: func(ctx context.Context) error {
-				return shootstate.Deploy(ctx, r.Clock, botanist.GardenClient, botanist.SeedClientSet.Client(), botanist.Shoot.GetInfo(), false)
+				var encryption *shootstate.Encryption
+				if cfg := r.Config.ShootStateEncryption; cfg != nil {
+					var err error
+					if encryption, err = shootstate.GetEncryption(ctx, botanist.SeedClientSet.Client(), v1beta1constants.GardenNamespace, cfg.SecretName, cfg.PrimaryKeyName); err != nil {
+						return err
+					}
+				}
+				return shootstate.Deploy(ctx, r.Clock, botanist.GardenClient, botanist.SeedClientSet.Client(), botanist.Shoot.GetInfo(), false, encryption)
 			},
 			Dependencies: flow.NewTaskIDs(waitUntilExtensionResourcesMigrated),
 		})
@@ -0,0 +1,108 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/component-base/version"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+const (
+	// completedTasksConfigMapName is the name of the ConfigMap in the control plane namespace of a shoot which records
+	// the tasks of the reconciliation flow which have succeeded in the current retry cycle.
+	completedTasksConfigMapName = "shoot-reconcile-completed-tasks"
+	// dataKeyCompletedTasks is the data key of the completed tasks record.
+	dataKeyCompletedTasks = "completedTasks"
+	// dataKeyGardenerVersion is the data key of the gardenlet version which has recorded the completed tasks.
+	dataKeyGardenerVersion = "gardenerVersion"
+)
+
+// isRetryAfterError returns true if the last operation of the given type has failed with a retryable error, i.e. the
+// shoot is reconciled again in the same retry cycle.
+func isRetryAfterError(shoot *gardencorev1beta1.Shoot, operationType gardencorev1beta1.LastOperationType) bool {
+	lastOperation := shoot.Status.LastOperation
+	return lastOperation != nil &&
+		lastOperation.Type == operationType &&
+		lastOperation.State == gardencorev1beta1.LastOperationStateError
+}
+
+// newFlowResume returns the resume configuration for the reconciliation flow. The tasks which have succeeded in the
+// previous execution are only skipped if the reconciliation is retried after an error and the previous execution was
+// done by the same gardenlet version. Otherwise, the returned configuration only records the succeeded tasks.
+func newFlowResume(ctx context.Context, log logr.Logger, c client.Reader, namespace string, generation int64, retryAfterError bool) *flow.Resume {
+	resume := &flow.Resume{Generation: generation}
+	if !retryAfterError {
+		return resume
+	}
+
+	configMap := &corev1.ConfigMap{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: completedTasksConfigMapName}, configMap); err != nil {
+		if !apierrors.IsNotFound(err) {
+			log.Error(err, "Failed reading completed tasks of previous reconciliation, executing all tasks")
+		}
+		return resume
+	}
+
+	if configMap.Data[dataKeyGardenerVersion] != version.Get().GitVersion {
+		log.Info("Completed tasks of previous reconciliation were recorded by another gardenlet version, executing all tasks")
+		return resume
+	}
+
+	completed := &flow.CompletedTasks{}
+	if err := json.Unmarshal([]byte(configMap.Data[dataKeyCompletedTasks]), completed); err != nil {
+		log.Error(err, "Failed decoding completed tasks of previous reconciliation, executing all tasks")
+		return resume
+	}
+
+	log.Info("Resuming reconciliation, skipping tasks which have already succeeded", "generation", completed.Generation, "completedTasks", len(completed.TaskIDs))
+	resume.Completed = completed
+	return resume
+}
+
+// persistCompletedTasks records the tasks which have succeeded in the reconciliation flow, so that they can be skipped
+// when the reconciliation is retried. The record is removed once the reconciliation has succeeded.
+func persistCompletedTasks(ctx context.Context, c client.Client, namespace string, resume *flow.Resume, flowSucceeded bool) error {
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: completedTasksConfigMapName, Namespace: namespace}}
+
+	if flowSucceeded || resume.Completed == nil || len(resume.Completed.TaskIDs) == 0 {
+		return client.IgnoreNotFound(c.Delete(ctx, configMap))
+	}
+
+	data, err := json.Marshal(resume.Completed)
+	if err != nil {
+		return fmt.Errorf("failed encoding completed tasks: %w", err)
+	}
+
+	_, err = controllerutils.GetAndCreateOrMergePatch(ctx, c, configMap, func() error {
+		configMap.Data = map[string]string{
+			dataKeyCompletedTasks:  string(data),
+			dataKeyGardenerVersion: version.Get().GitVersion,
+		}
+		return nil
+	})
+	return err
+}
@@ -0,0 +1,105 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shoot
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/component-base/version"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("resume", func() {
+	var (
+		ctx        = context.Background()
+		log        = logr.Discard()
+		seedClient client.Client
+		namespace  = "shoot--foo--bar"
+		configMap  *corev1.ConfigMap
+	)
+
+	BeforeEach(func() {
+		seedClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		configMap = &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "shoot-reconcile-completed-tasks", Namespace: namespace}}
+	})
+
+	Describe("#isRetryAfterError", func() {
+		It("should return true if the last operation of the same type has failed", func() {
+			shoot := &gardencorev1beta1.Shoot{Status: gardencorev1beta1.ShootStatus{LastOperation: &gardencorev1beta1.LastOperation{
+				Type:  gardencorev1beta1.LastOperationTypeReconcile,
+				State: gardencorev1beta1.LastOperationStateError,
+			}}}
+
+			Expect(isRetryAfterError(shoot, gardencorev1beta1.LastOperationTypeReconcile)).To(BeTrue())
+			Expect(isRetryAfterError(shoot, gardencorev1beta1.LastOperationTypeRestore)).To(BeFalse())
+
+			shoot.Status.LastOperation.State = gardencorev1beta1.LastOperationStateSucceeded
+			Expect(isRetryAfterError(shoot, gardencorev1beta1.LastOperationTypeReconcile)).To(BeFalse())
+		})
+	})
+
+	Describe("#newFlowResume and #persistCompletedTasks", func() {
+		var completed *flow.CompletedTasks
+
+		BeforeEach(func() {
+			completed = &flow.CompletedTasks{Generation: 2, TaskIDs: flow.TaskIDSlice{"a", "b"}}
+		})
+
+		It("should persist the completed tasks and read them when the reconciliation is retried", func() {
+			Expect(persistCompletedTasks(ctx, seedClient, namespace, &flow.Resume{Generation: 2, Completed: completed}, false)).To(Succeed())
+
+			Expect(newFlowResume(ctx, log, seedClient, namespace, 2, true)).To(Equal(&flow.Resume{Generation: 2, Completed: completed}))
+		})
+
+		It("should not read the completed tasks if the reconciliation is not retried", func() {
+			Expect(persistCompletedTasks(ctx, seedClient, namespace, &flow.Resume{Generation: 2, Completed: completed}, false)).To(Succeed())
+
+			Expect(newFlowResume(ctx, log, seedClient, namespace, 2, false)).To(Equal(&flow.Resume{Generation: 2}))
+		})
+
+		It("should not read the completed tasks if they were recorded by another gardenlet version", func() {
+			configMap.Data = map[string]string{
+				"completedTasks":  `{"generation":2,"taskIDs":["a","b"]}`,
+				"gardenerVersion": "v0.0.0-other",
+			}
+			Expect(seedClient.Create(ctx, configMap)).To(Succeed())
+
+			Expect(newFlowResume(ctx, log, seedClient, namespace, 2, true)).To(Equal(&flow.Resume{Generation: 2}))
+		})
+
+		It("should start from scratch if there is no record", func() {
+			Expect(newFlowResume(ctx, log, seedClient, namespace, 2, true)).To(Equal(&flow.Resume{Generation: 2}))
+		})
+
+		It("should remove the record once the reconciliation has succeeded", func() {
+			configMap.Data = map[string]string{"gardenerVersion": version.Get().GitVersion}
+			Expect(seedClient.Create(ctx, configMap)).To(Succeed())
+
+			Expect(persistCompletedTasks(ctx, seedClient, namespace, &flow.Resume{Generation: 2, Completed: completed}, true)).To(Succeed())
+			Expect(seedClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(BeNotFoundError())
+		})
+	})
+})
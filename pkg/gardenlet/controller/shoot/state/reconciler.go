@@ -41,6 +41,8 @@ type Reconciler struct {
 	Config       config.ShootStateControllerConfiguration
 	Clock        clock.Clock
 	SeedName     string
+	// ShootStateEncryption is the optional configuration for the encryption of sensitive data in ShootStates.
+	ShootStateEncryption *config.ShootStateEncryption
 }
 
 var (
@@ -100,7 +102,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 
 	if nextBackupDue := lastBackup.Add(r.Config.SyncPeriod.Duration); nextBackupDue.Before(r.Clock.Now().UTC()) {
 		log.Info("Performing periodic ShootState backup", "lastBackup", lastBackup.Round(time.Minute), "nextBackupDue", nextBackupDue.Round(time.Minute))
-		if err := shootstate.Deploy(ctx, r.Clock, r.GardenClient, r.SeedClient, shoot, true); err != nil {
+		var encryption *shootstate.Encryption
+		if r.ShootStateEncryption != nil {
+			var err error
+			if encryption, err = shootstate.GetEncryption(ctx, r.SeedClient, v1beta1constants.GardenNamespace, r.ShootStateEncryption.SecretName, r.ShootStateEncryption.PrimaryKeyName); err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+
+		if err := shootstate.Deploy(ctx, r.Clock, r.GardenClient, r.SeedClient, shoot, true, encryption); err != nil {
 			return reconcile.Result{}, fmt.Errorf("failed performing periodic ShootState backup: %w", err)
 		}
 		lastBackup = r.Clock.Now()
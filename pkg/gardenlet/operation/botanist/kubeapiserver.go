@@ -255,6 +255,7 @@ func (b *Botanist) DeployKubeAPIServer(ctx context.Context) error {
 		nodes,
 		b.Shoot.ResourcesToEncrypt,
 		b.Shoot.EncryptedResources,
+		v1beta1helper.GetShootKMSConfig(b.Shoot.GetInfo()),
 		v1beta1helper.GetShootETCDEncryptionKeyRotationPhase(b.Shoot.GetInfo().Status.Credentials),
 		b.Shoot.HibernationEnabled,
 	); err != nil {
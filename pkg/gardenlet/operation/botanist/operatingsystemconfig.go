@@ -37,6 +37,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/extensions/operatingsystemconfig/original/components/nodeagent"
 	nodelocaldnsconstants "github.com/gardener/gardener/pkg/component/networking/nodelocaldns/constants"
 	"github.com/gardener/gardener/pkg/features"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/utils/flow"
 	imagevectorutils "github.com/gardener/gardener/pkg/utils/imagevector"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -81,6 +82,14 @@ func (b *Botanist) DefaultOperatingSystemConfig() (operatingsystemconfig.Interfa
 		valitailEnabled, valiIngressHost = true, b.ComputeValiHost()
 	}
 
+	var imageVerification *nodeagentv1alpha1.ImageVerificationConfig
+	if b.Config != nil && b.Config.NodeAgent != nil && b.Config.NodeAgent.ImageVerification != nil {
+		imageVerification = &nodeagentv1alpha1.ImageVerificationConfig{
+			RequireDigest: b.Config.NodeAgent.ImageVerification.RequireDigest,
+			PublicKeys:    b.Config.NodeAgent.ImageVerification.PublicKeys,
+		}
+	}
+
 	return operatingsystemconfig.New(
 		b.Logger,
 		b.SeedClientSet.Client(),
@@ -90,17 +99,19 @@ func (b *Botanist) DefaultOperatingSystemConfig() (operatingsystemconfig.Interfa
 			KubernetesVersion: b.Shoot.KubernetesVersion,
 			Workers:           b.Shoot.GetInfo().Spec.Provider.Workers,
 			OriginalValues: operatingsystemconfig.OriginalValues{
-				ClusterDNSAddress:   clusterDNSAddress,
-				ClusterDomain:       gardencorev1beta1.DefaultDomain,
-				Images:              oscImages,
-				KubeletConfig:       b.Shoot.GetInfo().Spec.Kubernetes.Kubelet,
-				MachineTypes:        b.Shoot.CloudProfile.Spec.MachineTypes,
-				SSHAccessEnabled:    v1beta1helper.ShootEnablesSSHAccess(b.Shoot.GetInfo()),
-				ValitailEnabled:     valitailEnabled,
-				ValiIngressHostName: valiIngressHost,
-				NodeLocalDNSEnabled: v1beta1helper.IsNodeLocalDNSEnabled(b.Shoot.GetInfo().Spec.SystemComponents),
-				SyncJitterPeriod:    b.Shoot.OSCSyncJitterPeriod,
-				PrimaryIPFamily:     b.Shoot.GetInfo().Spec.Networking.IPFamilies[0],
+				ClusterDNSAddress:         clusterDNSAddress,
+				ClusterDomain:             gardencorev1beta1.DefaultDomain,
+				Images:                    oscImages,
+				KubeletConfig:             b.Shoot.GetInfo().Spec.Kubernetes.Kubelet,
+				MachineTypes:              b.Shoot.CloudProfile.Spec.MachineTypes,
+				SSHAccessEnabled:          v1beta1helper.ShootEnablesSSHAccess(b.Shoot.GetInfo()),
+				ValitailEnabled:           valitailEnabled,
+				ValiIngressHostName:       valiIngressHost,
+				NodeLocalDNSEnabled:       v1beta1helper.IsNodeLocalDNSEnabled(b.Shoot.GetInfo().Spec.SystemComponents),
+				SyncJitterPeriod:          b.Shoot.OSCSyncJitterPeriod,
+				PrimaryIPFamily:           b.Shoot.GetInfo().Spec.Networking.IPFamilies[0],
+				CoordinatedRolloutEnabled: kubernetesutils.HasMetaDataAnnotation(b.Shoot.GetInfo(), v1beta1constants.ShootAlphaWorkerCoordinatedOperatingSystemConfigRollout, "true"),
+				ImageVerification:         imageVerification,
 			},
 		},
 		operatingsystemconfig.DefaultInterval,
@@ -366,7 +377,7 @@ func (b *Botanist) generateOperatingSystemConfigSecretForWorker(
 	if err != nil {
 		return "", nil, fmt.Errorf("failed computing the effective Kubernetes version for pool %q: %w", worker.Name, err)
 	}
-	secretName := operatingsystemconfig.Key(worker.Name, kubernetesVersion, worker.CRI)
+	secretName := operatingsystemconfig.Key(worker.Name, kubernetesVersion, worker.CRI, worker.UpdateStrategy)
 
 	oscSecret, err := NodeAgentOSCSecretFn(ctx, b.SeedClientSet.Client(), oscDataOriginal.Object, secretName, worker.Name)
 	if err != nil {
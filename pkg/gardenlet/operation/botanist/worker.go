@@ -22,6 +22,7 @@ import (
 	"github.com/Masterminds/semver/v3"
 	"github.com/hashicorp/go-multierror"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -144,7 +145,7 @@ func OperatingSystemConfigUpdatedForAllWorkerPools(
 		)
 
 		for _, node := range workerPoolToNodes[worker.Name] {
-			nodeWillBeDeleted, err := nodeToBeDeleted(node, secretOSCKey)
+			nodeWillBeDeleted, err := nodeToBeDeleted(node, secretOSCKey, worker.UpdateStrategy)
 			if err != nil {
 				result = multierror.Append(result, fmt.Errorf("failed checking whether node %q will be deleted: %w", node.Name, err))
 				continue
@@ -157,6 +158,8 @@ func OperatingSystemConfigUpdatedForAllWorkerPools(
 			if nodeChecksum, ok := node.Annotations[nodeagentv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig]; nodeChecksum != secretChecksum {
 				if !ok {
 					result = multierror.Append(result, fmt.Errorf("the last successfully applied operating system config on node %q hasn't been reported yet", node.Name))
+				} else if condition := operatingSystemConfigApplyAttemptsExhausted(node, secretChecksum); condition != nil {
+					result = multierror.Append(result, fmt.Errorf("the last successfully applied operating system config on node %q is outdated (current: %s, desired: %s) and applying the desired config failed permanently: %s", node.Name, nodeChecksum, secretChecksum, condition.Message))
 				} else {
 					result = multierror.Append(result, fmt.Errorf("the last successfully applied operating system config on node %q is outdated (current: %s, desired: %s)", node.Name, nodeChecksum, secretChecksum))
 				}
@@ -167,11 +170,121 @@ func OperatingSystemConfigUpdatedForAllWorkerPools(
 	return result
 }
 
-func nodeToBeDeleted(node corev1.Node, secretOSCKey string) (bool, error) {
+// operatingSystemConfigApplyAttemptsExhausted returns the OperatingSystemConfigApplied condition of the given node if
+// gardener-node-agent gave up applying the operating system config with the given checksum, otherwise nil.
+func operatingSystemConfigApplyAttemptsExhausted(node corev1.Node, checksum string) *corev1.NodeCondition {
+	if node.Annotations[nodeagentv1alpha1.AnnotationKeyChecksumRolledBackOperatingSystemConfig] != checksum {
+		return nil
+	}
+
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == nodeagentv1alpha1.ConditionTypeOperatingSystemConfigApplied && condition.Reason == nodeagentv1alpha1.ConditionReasonApplyAttemptsExhausted {
+			return &condition
+		}
+	}
+
+	return nil
+}
+
+// InPlaceUpdatesForWorkerPools computes the progress of the in-place updates of all provided worker pools using the
+// in-place update strategy. A node counts as updated once it has applied the desired version of its operating system
+// config.
+func InPlaceUpdatesForWorkerPools(
+	controlPlaneVersion *semver.Version,
+	workers []gardencorev1beta1.Worker,
+	workerPoolToNodes map[string][]corev1.Node,
+	workerPoolToOperatingSystemConfigSecretMeta map[string]metav1.ObjectMeta,
+) ([]extensionsv1alpha1.WorkerPoolInPlaceUpdate, error) {
+	var inPlaceUpdates []extensionsv1alpha1.WorkerPoolInPlaceUpdate
+
+	for _, worker := range workers {
+		if !v1beta1helper.IsUpdateStrategyInPlace(worker.UpdateStrategy) {
+			continue
+		}
+
+		kubernetesVersion, err := v1beta1helper.CalculateEffectiveKubernetesVersion(controlPlaneVersion, worker.Kubernetes)
+		if err != nil {
+			return nil, fmt.Errorf("failed computing Kubernetes version of worker pool %q: %w", worker.Name, err)
+		}
+
+		var (
+			nodes          = workerPoolToNodes[worker.Name]
+			secretChecksum = workerPoolToOperatingSystemConfigSecretMeta[worker.Name].Annotations[nodeagentv1alpha1.AnnotationKeyChecksumDownloadedOperatingSystemConfig]
+			inPlaceUpdate  = extensionsv1alpha1.WorkerPoolInPlaceUpdate{
+				Name:              worker.Name,
+				KubernetesVersion: kubernetesVersion.String(),
+				Nodes:             int32(len(nodes)),
+			}
+		)
+
+		for _, node := range nodes {
+			if secretChecksum != "" && node.Annotations[nodeagentv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig] == secretChecksum {
+				inPlaceUpdate.UpdatedNodes++
+			}
+		}
+
+		inPlaceUpdates = append(inPlaceUpdates, inPlaceUpdate)
+	}
+
+	return inPlaceUpdates, nil
+}
+
+// reportInPlaceUpdates reports the progress of the in-place updates of the worker pools in the status of the Worker
+// and mirrors it to the status of the Shoot. The statuses are only updated if the progress has changed.
+func (b *Botanist) reportInPlaceUpdates(ctx context.Context, workerPoolToNodes map[string][]corev1.Node, workerPoolToOperatingSystemConfigSecretMeta map[string]metav1.ObjectMeta) error {
+	inPlaceUpdates, err := InPlaceUpdatesForWorkerPools(b.Shoot.KubernetesVersion, b.Shoot.GetInfo().Spec.Provider.Workers, workerPoolToNodes, workerPoolToOperatingSystemConfigSecretMeta)
+	if err != nil {
+		return err
+	}
+
+	if len(inPlaceUpdates) == 0 && len(b.Shoot.GetInfo().Status.InPlaceUpdates) == 0 {
+		return nil
+	}
+
+	worker := &extensionsv1alpha1.Worker{}
+	if err := b.SeedClientSet.Client().Get(ctx, client.ObjectKey{Name: b.Shoot.GetInfo().Name, Namespace: b.Shoot.SeedNamespace}, worker); err != nil {
+		return fmt.Errorf("failed reading Worker: %w", err)
+	}
+
+	if !apiequality.Semantic.DeepEqual(worker.Status.InPlaceUpdates, inPlaceUpdates) {
+		// The rest of the Worker status is owned by the extension. Hence, the patch must neither contain other fields nor
+		// use optimistic locking, so that it does not conflict with or revert status updates of the extension.
+		patch := client.MergeFrom(worker.DeepCopy())
+		worker.Status.InPlaceUpdates = inPlaceUpdates
+		if err := b.SeedClientSet.Client().Status().Patch(ctx, worker, patch); err != nil {
+			return fmt.Errorf("failed reporting progress of in-place updates in Worker status: %w", err)
+		}
+	}
+
+	var shootInPlaceUpdates []gardencorev1beta1.WorkerPoolInPlaceUpdate
+	for _, inPlaceUpdate := range inPlaceUpdates {
+		shootInPlaceUpdates = append(shootInPlaceUpdates, gardencorev1beta1.WorkerPoolInPlaceUpdate{
+			Name:              inPlaceUpdate.Name,
+			KubernetesVersion: inPlaceUpdate.KubernetesVersion,
+			Nodes:             inPlaceUpdate.Nodes,
+			UpdatedNodes:      inPlaceUpdate.UpdatedNodes,
+		})
+	}
+
+	if apiequality.Semantic.DeepEqual(b.Shoot.GetInfo().Status.InPlaceUpdates, shootInPlaceUpdates) {
+		return nil
+	}
+
+	if err := b.Shoot.UpdateInfoStatus(ctx, b.GardenClient, false, func(shoot *gardencorev1beta1.Shoot) error {
+		shoot.Status.InPlaceUpdates = shootInPlaceUpdates
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed reporting progress of in-place updates in Shoot status: %w", err)
+	}
+
+	return nil
+}
+
+func nodeToBeDeleted(node corev1.Node, secretOSCKey string, updateStrategy *gardencorev1beta1.MachineUpdateStrategy) (bool, error) {
 	if nodeTaintedForNoSchedule(node) {
 		return true, nil
 	}
-	return nodeOSCKeyDifferentFromSecretOSCKey(node, secretOSCKey)
+	return nodeOSCKeyDifferentFromSecretOSCKey(node, secretOSCKey, updateStrategy)
 }
 
 func nodeTaintedForNoSchedule(node corev1.Node) bool {
@@ -188,7 +301,7 @@ func nodeTaintedForNoSchedule(node corev1.Node) bool {
 	return false
 }
 
-func nodeOSCKeyDifferentFromSecretOSCKey(node corev1.Node, secretOSCKey string) (bool, error) {
+func nodeOSCKeyDifferentFromSecretOSCKey(node corev1.Node, secretOSCKey string, updateStrategy *gardencorev1beta1.MachineUpdateStrategy) (bool, error) {
 	kubernetesVersion, err := semver.NewVersion(node.Labels[v1beta1constants.LabelWorkerKubernetesVersion])
 	if err != nil {
 		return false, fmt.Errorf("failed parsing Kubernetes version to semver for node %q: %w", node.Name, err)
@@ -199,7 +312,7 @@ func nodeOSCKeyDifferentFromSecretOSCKey(node corev1.Node, secretOSCKey string)
 		criConfig = &gardencorev1beta1.CRI{Name: gardencorev1beta1.CRIName(v)}
 	}
 
-	return operatingsystemconfig.Key(node.Labels[v1beta1constants.LabelWorkerPool], kubernetesVersion, criConfig) != secretOSCKey, nil
+	return operatingsystemconfig.Key(node.Labels[v1beta1constants.LabelWorkerPool], kubernetesVersion, criConfig, updateStrategy) != secretOSCKey, nil
 }
 
 // exposed for testing
@@ -248,6 +361,10 @@ func (b *Botanist) WaitUntilOperatingSystemConfigUpdatedForAllWorkerPools(ctx co
 			return retry.SevereError(err)
 		}
 
+		if err := b.reportInPlaceUpdates(ctx, workerPoolToNodes, workerPoolToOperatingSystemConfigSecretMeta); err != nil {
+			return retry.SevereError(err)
+		}
+
 		if err := OperatingSystemConfigUpdatedForAllWorkerPools(b.Shoot.GetInfo().Spec.Provider.Workers, workerPoolToNodes, workerPoolToOperatingSystemConfigSecretMeta); err != nil {
 			return retry.MinorError(err)
 		}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/Masterminds/semver/v3"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	gomegatypes "github.com/onsi/gomega/types"
@@ -26,10 +27,12 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	kubernetesmock "github.com/gardener/gardener/pkg/client/kubernetes/mock"
@@ -321,6 +324,29 @@ var _ = Describe("Worker", func() {
 			}},
 			MatchError(ContainSubstring("is outdated")),
 		),
+		Entry("checksum annotation outdated and applying the desired config failed permanently",
+			[]gardencorev1beta1.Worker{{Name: "pool1"}},
+			map[string][]corev1.Node{"pool1": {{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						"checksum/cloud-config-data":             "outdated",
+						"checksum/rolled-back-cloud-config-data": "foo",
+					},
+					Labels: map[string]string{"worker.gardener.cloud/kubernetes-version": "1.24.0"},
+				},
+				Status: corev1.NodeStatus{Conditions: []corev1.NodeCondition{{
+					Type:    "OperatingSystemConfigApplied",
+					Status:  corev1.ConditionFalse,
+					Reason:  "ApplyAttemptsExhausted",
+					Message: "unit foo failed",
+				}}},
+			}}},
+			map[string]metav1.ObjectMeta{"pool1": {
+				Name:        "gardener-node-agent--c63c0",
+				Annotations: map[string]string{"checksum/data-script": "foo"},
+			}},
+			MatchError(ContainSubstring("applying the desired config failed permanently: unit foo failed")),
+		),
 		Entry("skip node marked by MCM for termination",
 			[]gardencorev1beta1.Worker{{Name: "pool1"}},
 			map[string][]corev1.Node{"pool1": {{
@@ -374,6 +400,45 @@ var _ = Describe("Worker", func() {
 		),
 	)
 
+	Describe("#InPlaceUpdatesForWorkerPools", func() {
+		var (
+			controlPlaneVersion    = semver.MustParse("1.28.2")
+			workerPoolToSecretMeta = map[string]metav1.ObjectMeta{
+				"pool1": {Annotations: map[string]string{"checksum/data-script": "desired1"}},
+				"pool2": {Annotations: map[string]string{"checksum/data-script": "desired2"}},
+			}
+			workerPoolToNodes = map[string][]corev1.Node{
+				"pool1": {
+					{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"checksum/cloud-config-data": "desired1"}}},
+					{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"checksum/cloud-config-data": "outdated"}}},
+					{ObjectMeta: metav1.ObjectMeta{}},
+				},
+				"pool2": {
+					{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"checksum/cloud-config-data": "desired2"}}},
+				},
+			}
+		)
+
+		It("should return nothing when no worker pool uses the in-place update strategy", func() {
+			Expect(InPlaceUpdatesForWorkerPools(controlPlaneVersion, []gardencorev1beta1.Worker{
+				{Name: "pool1"},
+				{Name: "pool2", UpdateStrategy: ptr.To(gardencorev1beta1.AutoRollingUpdate)},
+			}, workerPoolToNodes, workerPoolToSecretMeta)).To(BeEmpty())
+		})
+
+		It("should return the progress of the worker pools using the in-place update strategy", func() {
+			Expect(InPlaceUpdatesForWorkerPools(controlPlaneVersion, []gardencorev1beta1.Worker{
+				{Name: "pool1", UpdateStrategy: ptr.To(gardencorev1beta1.AutoInPlaceUpdate)},
+				{Name: "pool2", UpdateStrategy: ptr.To(gardencorev1beta1.AutoInPlaceUpdate), Kubernetes: &gardencorev1beta1.WorkerKubernetes{Version: ptr.To("1.27.5")}},
+				{Name: "pool3", UpdateStrategy: ptr.To(gardencorev1beta1.AutoInPlaceUpdate)},
+			}, workerPoolToNodes, workerPoolToSecretMeta)).To(ConsistOf(
+				extensionsv1alpha1.WorkerPoolInPlaceUpdate{Name: "pool1", KubernetesVersion: "1.28.2", Nodes: 3, UpdatedNodes: 1},
+				extensionsv1alpha1.WorkerPoolInPlaceUpdate{Name: "pool2", KubernetesVersion: "1.27.5", Nodes: 1, UpdatedNodes: 1},
+				extensionsv1alpha1.WorkerPoolInPlaceUpdate{Name: "pool3", KubernetesVersion: "1.28.2"},
+			))
+		})
+	})
+
 	Describe("#WaitUntilOperatingSystemConfigUpdatedForAllWorkerPools", func() {
 		var (
 			seedInterface  *kubernetesmock.MockInterface
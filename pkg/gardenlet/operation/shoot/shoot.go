@@ -40,6 +40,7 @@ import (
 	gardenlethelper "github.com/gardener/gardener/pkg/gardenlet/apis/config/helper"
 	"github.com/gardener/gardener/pkg/utils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
@@ -58,6 +59,9 @@ func NewBuilder() *Builder {
 		serviceAccountIssuerHostname: func() (*string, error) {
 			return nil, fmt.Errorf("service account issuer hostname is required but not set")
 		},
+		shootStateEncryptionFunc: func(context.Context) (*shootstate.Encryption, error) {
+			return nil, nil
+		},
 	}
 }
 
@@ -180,6 +184,18 @@ func (b *Builder) WithServiceAccountIssuerHostname(secret *corev1.Secret) *Build
 	return b
 }
 
+// WithShootStateEncryptionFrom sets the shootStateEncryptionFunc attribute at the Builder which reads the key
+// encryption keys for decrypting the ShootState from the given reader.
+func (b *Builder) WithShootStateEncryptionFrom(reader client.Reader, cfg *config.ShootStateEncryption) *Builder {
+	b.shootStateEncryptionFunc = func(ctx context.Context) (*shootstate.Encryption, error) {
+		if cfg == nil {
+			return nil, nil
+		}
+		return shootstate.GetEncryption(ctx, reader, v1beta1constants.GardenNamespace, cfg.SecretName, cfg.PrimaryKeyName)
+	}
+	return b
+}
+
 // Build initializes a new Shoot object.
 func (b *Builder) Build(ctx context.Context, c client.Reader) (*Shoot, error) {
 	shoot := &Shoot{}
@@ -311,6 +327,14 @@ func (b *Builder) Build(ctx context.Context, c client.Reader) (*Shoot, error) {
 		if err := c.Get(ctx, client.ObjectKeyFromObject(shootState), shootState); err != nil {
 			return nil, err
 		}
+
+		encryption, err := b.shootStateEncryptionFunc(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := shootstate.Decrypt(shootState, encryption); err != nil {
+			return nil, err
+		}
 		shoot.SetShootState(shootState)
 	}
 
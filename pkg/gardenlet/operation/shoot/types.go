@@ -60,6 +60,7 @@ import (
 	"github.com/gardener/gardener/pkg/component/observability/plutono"
 	shootsystem "github.com/gardener/gardener/pkg/component/shoot/system"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
 )
 
 // Builder is an object that builds Shoot objects.
@@ -73,6 +74,7 @@ type Builder struct {
 	projectName                  string
 	internalDomain               *gardenerutils.Domain
 	defaultDomains               []*gardenerutils.Domain
+	shootStateEncryptionFunc     func(context.Context) (*shootstate.Encryption, error)
 }
 
 // Shoot is an object containing information about a Shoot cluster.
@@ -17,6 +17,7 @@ package config
 import (
 	"github.com/Masterminds/semver/v3"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	componentbaseconfig "k8s.io/component-base/config"
 )
 
@@ -85,6 +86,64 @@ type OperatingSystemConfigControllerConfig struct {
 	// KubernetesVersion contains the Kubernetes version of the kubelet, used for annotating the corresponding node
 	// resource with a kubernetes version annotation.
 	KubernetesVersion *semver.Version
+	// HealthGate is the configuration for the health gate which is evaluated after a new or changed operating system
+	// config has been applied. If the apply or the health gate fails, the files and units touched during the
+	// reconciliation are restored to the previously applied operating system config. If not set, no health gate is
+	// evaluated and failed applies are not rolled back.
+	HealthGate *OperatingSystemConfigHealthGate
+	// Rollout is the configuration for coordinating the rollout of disruptive changes of the operating system config
+	// across the nodes of the worker pool. If not set, changes are applied immediately on all nodes.
+	Rollout *OperatingSystemConfigRolloutConfig
+	// ImageVerification is the configuration for verifying the images from which files of the operating system config
+	// are extracted. If not set, images are not verified.
+	ImageVerification *ImageVerificationConfig
+}
+
+// ImageVerificationConfig contains configuration for verifying the images from which files of the operating system
+// config are extracted before they are installed on the node.
+type ImageVerificationConfig struct {
+	// RequireDigest specifies whether image references must be pinned by digest. References without a digest are
+	// rejected if set to true.
+	RequireDigest bool
+	// PublicKeys is a list of PEM-encoded public keys (ECDSA, RSA or Ed25519). If set, an image is only accepted if it
+	// carries a cosign signature which can be verified with at least one of the keys.
+	PublicKeys []string
+}
+
+// OperatingSystemConfigRolloutConfig contains configuration for coordinating the rollout of disruptive changes of the
+// operating system config across the nodes of a worker pool.
+type OperatingSystemConfigRolloutConfig struct {
+	// MaxUnavailable is the maximum number of nodes of the worker pool which may apply disruptive changes (i.e., changes
+	// of units) of the operating system config at the same time. The value can be an absolute number or a percentage of
+	// the nodes of the worker pool (rounded down). At least one node is always allowed to apply the changes.
+	MaxUnavailable intstr.IntOrString
+	// LeaseDuration is the duration for which a node may hold a rollout slot without renewing it. Afterwards, the slot
+	// is considered free again. Defaults to 10m.
+	LeaseDuration *metav1.Duration
+	// Drain contains configuration for draining the node before disruptive changes are applied. If not set, the node
+	// is not drained.
+	Drain *OperatingSystemConfigDrainConfig
+}
+
+// OperatingSystemConfigDrainConfig contains configuration for draining the node before disruptive changes of the
+// operating system config are applied.
+type OperatingSystemConfigDrainConfig struct {
+	// Timeout is the maximum duration to wait for the pods to be evicted from the node. Afterwards, the changes are
+	// applied even if not all pods could be evicted. Defaults to 10m.
+	Timeout *metav1.Duration
+}
+
+// OperatingSystemConfigHealthGate contains configuration for the health gate evaluated after applying an operating
+// system config.
+type OperatingSystemConfigHealthGate struct {
+	// Timeout is the maximum duration to wait for the health gate to pass. Defaults to 2m.
+	Timeout *metav1.Duration
+	// Units is a list of names of systemd units which must be active for the health gate to pass. In addition, all
+	// changed units which are expected to be running are always checked.
+	Units []string
+	// KubeletHealthy specifies whether the kubelet health endpoint must report healthy for the health gate to pass.
+	// Defaults to true.
+	KubeletHealthy *bool
 }
 
 // TokenControllerConfig defines the configuration of the access token controller.
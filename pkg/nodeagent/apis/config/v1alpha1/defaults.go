@@ -20,6 +20,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/logger"
 )
@@ -49,6 +50,31 @@ func SetDefaults_OperatingSystemConfigControllerConfig(obj *OperatingSystemConfi
 	}
 }
 
+// SetDefaults_OperatingSystemConfigHealthGate sets defaults for the OperatingSystemConfigHealthGate object.
+func SetDefaults_OperatingSystemConfigHealthGate(obj *OperatingSystemConfigHealthGate) {
+	if obj.Timeout == nil {
+		obj.Timeout = &metav1.Duration{Duration: 2 * time.Minute}
+	}
+
+	if obj.KubeletHealthy == nil {
+		obj.KubeletHealthy = ptr.To(true)
+	}
+}
+
+// SetDefaults_OperatingSystemConfigRolloutConfig sets defaults for the OperatingSystemConfigRolloutConfig object.
+func SetDefaults_OperatingSystemConfigRolloutConfig(obj *OperatingSystemConfigRolloutConfig) {
+	if obj.LeaseDuration == nil {
+		obj.LeaseDuration = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+}
+
+// SetDefaults_OperatingSystemConfigDrainConfig sets defaults for the OperatingSystemConfigDrainConfig object.
+func SetDefaults_OperatingSystemConfigDrainConfig(obj *OperatingSystemConfigDrainConfig) {
+	if obj.Timeout == nil {
+		obj.Timeout = &metav1.Duration{Duration: 10 * time.Minute}
+	}
+}
+
 // SetDefaults_TokenControllerConfig sets defaults for the TokenControllerConfig object.
 func SetDefaults_TokenControllerConfig(obj *TokenControllerConfig) {
 	if obj.SyncPeriod == nil {
@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	"github.com/gardener/gardener/pkg/logger"
 	. "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
@@ -78,6 +79,69 @@ var _ = Describe("Defaults", func() {
 					Expect(obj.SyncPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
 					Expect(obj.SyncJitterPeriod).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
 				})
+
+				Describe("Health gate", func() {
+					It("should default the object", func() {
+						obj := &OperatingSystemConfigHealthGate{}
+
+						SetDefaults_OperatingSystemConfigHealthGate(obj)
+
+						Expect(obj.Timeout).To(PointTo(Equal(metav1.Duration{Duration: 2 * time.Minute})))
+						Expect(obj.KubeletHealthy).To(PointTo(BeTrue()))
+					})
+
+					It("should not overwrite existing values", func() {
+						obj := &OperatingSystemConfigHealthGate{
+							Timeout:        &metav1.Duration{Duration: time.Second},
+							KubeletHealthy: ptr.To(false),
+						}
+
+						SetDefaults_OperatingSystemConfigHealthGate(obj)
+
+						Expect(obj.Timeout).To(PointTo(Equal(metav1.Duration{Duration: time.Second})))
+						Expect(obj.KubeletHealthy).To(PointTo(BeFalse()))
+					})
+				})
+
+				Describe("Rollout", func() {
+					It("should default the object", func() {
+						obj := &OperatingSystemConfigRolloutConfig{}
+
+						SetDefaults_OperatingSystemConfigRolloutConfig(obj)
+
+						Expect(obj.LeaseDuration).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Minute})))
+					})
+
+					It("should not overwrite existing values", func() {
+						obj := &OperatingSystemConfigRolloutConfig{
+							LeaseDuration: &metav1.Duration{Duration: time.Minute},
+						}
+
+						SetDefaults_OperatingSystemConfigRolloutConfig(obj)
+
+						Expect(obj.LeaseDuration).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+					})
+				})
+
+				Describe("Drain", func() {
+					It("should default the object", func() {
+						obj := &OperatingSystemConfigDrainConfig{}
+
+						SetDefaults_OperatingSystemConfigDrainConfig(obj)
+
+						Expect(obj.Timeout).To(PointTo(Equal(metav1.Duration{Duration: 10 * time.Minute})))
+					})
+
+					It("should not overwrite existing values", func() {
+						obj := &OperatingSystemConfigDrainConfig{
+							Timeout: &metav1.Duration{Duration: time.Minute},
+						}
+
+						SetDefaults_OperatingSystemConfigDrainConfig(obj)
+
+						Expect(obj.Timeout).To(PointTo(Equal(metav1.Duration{Duration: time.Minute})))
+					})
+				})
 			})
 
 			Describe("Token controller", func() {
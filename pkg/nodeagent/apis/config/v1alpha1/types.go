@@ -17,6 +17,7 @@ package v1alpha1
 import (
 	"github.com/Masterminds/semver/v3"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	componentbaseconfigv1alpha1 "k8s.io/component-base/config/v1alpha1"
 )
 
@@ -53,6 +54,66 @@ const (
 	// AnnotationKeyChecksumAppliedOperatingSystemConfig is a constant for an annotation key on a Node describing the
 	// checksum of the last applied operating system configuration.
 	AnnotationKeyChecksumAppliedOperatingSystemConfig = "checksum/cloud-config-data"
+	// AnnotationKeyChecksumRolledBackOperatingSystemConfig is a constant for an annotation key on a Node describing
+	// the checksum of the operating system configuration which has been rolled back after its apply failed. It is
+	// applied again with exponential backoff until it has been rolled back too often.
+	AnnotationKeyChecksumRolledBackOperatingSystemConfig = "checksum/rolled-back-cloud-config-data"
+	// AnnotationKeyRollbacksOperatingSystemConfig is a constant for an annotation key on a Node describing how often
+	// the operating system configuration with the rolled back checksum has been rolled back.
+	AnnotationKeyRollbacksOperatingSystemConfig = "worker.gardener.cloud/osc-rollbacks"
+	// AnnotationKeyLastRollbackTimeOperatingSystemConfig is a constant for an annotation key on a Node describing the
+	// time when the operating system configuration with the rolled back checksum has been rolled back the last time.
+	AnnotationKeyLastRollbackTimeOperatingSystemConfig = "worker.gardener.cloud/osc-last-rollback-time"
+	// AnnotationKeyDrainStartTime is a constant for an annotation key on a Node describing the time when
+	// gardener-node-agent started to drain the node before applying disruptive changes of the operating system config.
+	// The pods are evicted by gardener-resource-manager as long as this annotation is present.
+	AnnotationKeyDrainStartTime = "worker.gardener.cloud/drain-start-time"
+	// AnnotationKeyDrained is a constant for an annotation key on a Node describing that gardener-resource-manager has
+	// evicted all pods from the node after gardener-node-agent started to drain it.
+	AnnotationKeyDrained = "worker.gardener.cloud/drained"
+	// AnnotationKeyCordonedForDrain is a constant for an annotation key on a Node describing that gardener-node-agent
+	// has cordoned the node for draining it. Nodes which have already been cordoned before are not annotated and hence
+	// not uncordoned after the changes have been applied.
+	AnnotationKeyCordonedForDrain = "worker.gardener.cloud/cordoned-for-drain"
+
+	// ConditionTypeOperatingSystemConfigApplied is a constant for the type of the Node condition describing the
+	// outcome of the last application of the operating system config.
+	ConditionTypeOperatingSystemConfigApplied = "OperatingSystemConfigApplied"
+	// ConditionReasonApplied is a constant for the reason of the Node condition when the operating system config has
+	// been applied successfully.
+	ConditionReasonApplied = "Applied"
+	// ConditionReasonRolledBack is a constant for the reason of the Node condition when applying the operating system
+	// config failed and the previously applied operating system config has been restored.
+	ConditionReasonRolledBack = "RolledBack"
+	// ConditionReasonRollbackFailed is a constant for the reason of the Node condition when applying the operating
+	// system config failed and restoring the previously applied operating system config failed as well.
+	ConditionReasonRollbackFailed = "RollbackFailed"
+	// ConditionReasonApplyFailed is a constant for the reason of the Node condition when applying the operating system
+	// config failed and there was no previously applied operating system config to restore.
+	ConditionReasonApplyFailed = "ApplyFailed"
+	// ConditionReasonApplyAttemptsExhausted is a constant for the reason of the Node condition when the operating system
+	// config has been rolled back too often and is not applied again until it changes.
+	ConditionReasonApplyAttemptsExhausted = "ApplyAttemptsExhausted"
+
+	// ConditionTypeOperatingSystemConfigDrifted is a constant for the type of the Node condition describing whether
+	// the files and units on the node drifted from the last applied operating system config.
+	ConditionTypeOperatingSystemConfigDrifted = "OperatingSystemConfigDrifted"
+	// ConditionReasonDriftDetected is a constant for the reason of the Node condition when files or units on the node
+	// drifted from the last applied operating system config.
+	ConditionReasonDriftDetected = "DriftDetected"
+	// ConditionReasonNoDriftDetected is a constant for the reason of the Node condition when all files and units on
+	// the node match the last applied operating system config.
+	ConditionReasonNoDriftDetected = "NoDriftDetected"
+
+	// ConditionTypeUnitsHealthy is a constant for the type of the Node condition describing whether the units with a
+	// health check in the last applied operating system config are healthy.
+	ConditionTypeUnitsHealthy = "UnitsHealthy"
+	// ConditionReasonUnitsHealthy is a constant for the reason of the Node condition when all units with a health
+	// check are healthy.
+	ConditionReasonUnitsHealthy = "UnitsHealthy"
+	// ConditionReasonUnitsUnhealthy is a constant for the reason of the Node condition when at least one unit with a
+	// health check is unhealthy.
+	ConditionReasonUnitsUnhealthy = "UnitsUnhealthy"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -126,6 +187,75 @@ type OperatingSystemConfigControllerConfig struct {
 	// KubernetesVersion contains the Kubernetes version of the kubelet, used for annotating the corresponding node
 	// resource with a kubernetes version annotation.
 	KubernetesVersion *semver.Version `json:"kubernetesVersion"`
+	// HealthGate is the configuration for the health gate which is evaluated after a new or changed operating system
+	// config has been applied. If the apply or the health gate fails, the files and units touched during the
+	// reconciliation are restored to the previously applied operating system config. If not set, no health gate is
+	// evaluated and failed applies are not rolled back.
+	// +optional
+	HealthGate *OperatingSystemConfigHealthGate `json:"healthGate,omitempty"`
+	// Rollout is the configuration for coordinating the rollout of disruptive changes of the operating system config
+	// across the nodes of the worker pool. If not set, changes are applied immediately on all nodes.
+	// +optional
+	Rollout *OperatingSystemConfigRolloutConfig `json:"rollout,omitempty"`
+	// ImageVerification is the configuration for verifying the images from which files of the operating system config
+	// are extracted. If not set, images are not verified.
+	// +optional
+	ImageVerification *ImageVerificationConfig `json:"imageVerification,omitempty"`
+}
+
+// ImageVerificationConfig contains configuration for verifying the images from which files of the operating system
+// config are extracted before they are installed on the node.
+type ImageVerificationConfig struct {
+	// RequireDigest specifies whether image references must be pinned by digest. References without a digest are
+	// rejected if set to true.
+	// +optional
+	RequireDigest bool `json:"requireDigest,omitempty"`
+	// PublicKeys is a list of PEM-encoded public keys (ECDSA, RSA or Ed25519). If set, an image is only accepted if it
+	// carries a cosign signature which can be verified with at least one of the keys.
+	// +optional
+	PublicKeys []string `json:"publicKeys,omitempty"`
+}
+
+// OperatingSystemConfigRolloutConfig contains configuration for coordinating the rollout of disruptive changes of the
+// operating system config across the nodes of a worker pool.
+type OperatingSystemConfigRolloutConfig struct {
+	// MaxUnavailable is the maximum number of nodes of the worker pool which may apply disruptive changes (i.e., changes
+	// of units) of the operating system config at the same time. The value can be an absolute number or a percentage of
+	// the nodes of the worker pool (rounded down). At least one node is always allowed to apply the changes.
+	MaxUnavailable intstr.IntOrString `json:"maxUnavailable"`
+	// LeaseDuration is the duration for which a node may hold a rollout slot without renewing it. Afterwards, the slot
+	// is considered free again. Defaults to 10m.
+	// +optional
+	LeaseDuration *metav1.Duration `json:"leaseDuration,omitempty"`
+	// Drain contains configuration for draining the node before disruptive changes are applied. If not set, the node
+	// is not drained.
+	// +optional
+	Drain *OperatingSystemConfigDrainConfig `json:"drain,omitempty"`
+}
+
+// OperatingSystemConfigDrainConfig contains configuration for draining the node before disruptive changes of the
+// operating system config are applied.
+type OperatingSystemConfigDrainConfig struct {
+	// Timeout is the maximum duration to wait for the pods to be evicted from the node. Afterwards, the changes are
+	// applied even if not all pods could be evicted. Defaults to 10m.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+}
+
+// OperatingSystemConfigHealthGate contains configuration for the health gate evaluated after applying an operating
+// system config.
+type OperatingSystemConfigHealthGate struct {
+	// Timeout is the maximum duration to wait for the health gate to pass. Defaults to 2m.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+	// Units is a list of names of systemd units which must be active for the health gate to pass. In addition, all
+	// changed units which are expected to be running are always checked.
+	// +optional
+	Units []string `json:"units,omitempty"`
+	// KubeletHealthy specifies whether the kubelet health endpoint must report healthy for the health gate to pass.
+	// Defaults to true.
+	// +optional
+	KubeletHealthy *bool `json:"kubeletHealthy,omitempty"`
 }
 
 // TokenControllerConfig defines the configuration of the access token controller.
@@ -58,6 +58,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ImageVerificationConfig)(nil), (*config.ImageVerificationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_ImageVerificationConfig_To_config_ImageVerificationConfig(a.(*ImageVerificationConfig), b.(*config.ImageVerificationConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.ImageVerificationConfig)(nil), (*ImageVerificationConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_ImageVerificationConfig_To_v1alpha1_ImageVerificationConfig(a.(*config.ImageVerificationConfig), b.(*ImageVerificationConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NodeAgentConfiguration)(nil), (*config.NodeAgentConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NodeAgentConfiguration_To_config_NodeAgentConfiguration(a.(*NodeAgentConfiguration), b.(*config.NodeAgentConfiguration), scope)
 	}); err != nil {
@@ -78,6 +88,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*OperatingSystemConfigDrainConfig)(nil), (*config.OperatingSystemConfigDrainConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OperatingSystemConfigDrainConfig_To_config_OperatingSystemConfigDrainConfig(a.(*OperatingSystemConfigDrainConfig), b.(*config.OperatingSystemConfigDrainConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.OperatingSystemConfigDrainConfig)(nil), (*OperatingSystemConfigDrainConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_OperatingSystemConfigDrainConfig_To_v1alpha1_OperatingSystemConfigDrainConfig(a.(*config.OperatingSystemConfigDrainConfig), b.(*OperatingSystemConfigDrainConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OperatingSystemConfigHealthGate)(nil), (*config.OperatingSystemConfigHealthGate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OperatingSystemConfigHealthGate_To_config_OperatingSystemConfigHealthGate(a.(*OperatingSystemConfigHealthGate), b.(*config.OperatingSystemConfigHealthGate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.OperatingSystemConfigHealthGate)(nil), (*OperatingSystemConfigHealthGate)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_OperatingSystemConfigHealthGate_To_v1alpha1_OperatingSystemConfigHealthGate(a.(*config.OperatingSystemConfigHealthGate), b.(*OperatingSystemConfigHealthGate), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OperatingSystemConfigRolloutConfig)(nil), (*config.OperatingSystemConfigRolloutConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_OperatingSystemConfigRolloutConfig_To_config_OperatingSystemConfigRolloutConfig(a.(*OperatingSystemConfigRolloutConfig), b.(*config.OperatingSystemConfigRolloutConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.OperatingSystemConfigRolloutConfig)(nil), (*OperatingSystemConfigRolloutConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_OperatingSystemConfigRolloutConfig_To_v1alpha1_OperatingSystemConfigRolloutConfig(a.(*config.OperatingSystemConfigRolloutConfig), b.(*OperatingSystemConfigRolloutConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Server)(nil), (*config.Server)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Server_To_config_Server(a.(*Server), b.(*config.Server), scope)
 	}); err != nil {
@@ -193,6 +233,28 @@ func Convert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(
 	return autoConvert_config_ControllerConfiguration_To_v1alpha1_ControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_ImageVerificationConfig_To_config_ImageVerificationConfig(in *ImageVerificationConfig, out *config.ImageVerificationConfig, s conversion.Scope) error {
+	out.RequireDigest = in.RequireDigest
+	out.PublicKeys = *(*[]string)(unsafe.Pointer(&in.PublicKeys))
+	return nil
+}
+
+// Convert_v1alpha1_ImageVerificationConfig_To_config_ImageVerificationConfig is an autogenerated conversion function.
+func Convert_v1alpha1_ImageVerificationConfig_To_config_ImageVerificationConfig(in *ImageVerificationConfig, out *config.ImageVerificationConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_ImageVerificationConfig_To_config_ImageVerificationConfig(in, out, s)
+}
+
+func autoConvert_config_ImageVerificationConfig_To_v1alpha1_ImageVerificationConfig(in *config.ImageVerificationConfig, out *ImageVerificationConfig, s conversion.Scope) error {
+	out.RequireDigest = in.RequireDigest
+	out.PublicKeys = *(*[]string)(unsafe.Pointer(&in.PublicKeys))
+	return nil
+}
+
+// Convert_config_ImageVerificationConfig_To_v1alpha1_ImageVerificationConfig is an autogenerated conversion function.
+func Convert_config_ImageVerificationConfig_To_v1alpha1_ImageVerificationConfig(in *config.ImageVerificationConfig, out *ImageVerificationConfig, s conversion.Scope) error {
+	return autoConvert_config_ImageVerificationConfig_To_v1alpha1_ImageVerificationConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_NodeAgentConfiguration_To_config_NodeAgentConfiguration(in *NodeAgentConfiguration, out *config.NodeAgentConfiguration, s conversion.Scope) error {
 	if err := configv1alpha1.Convert_v1alpha1_ClientConnectionConfiguration_To_config_ClientConnectionConfiguration(&in.ClientConnection, &out.ClientConnection, s); err != nil {
 		return err
@@ -266,6 +328,9 @@ func autoConvert_v1alpha1_OperatingSystemConfigControllerConfig_To_config_Operat
 	out.SyncJitterPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncJitterPeriod))
 	out.SecretName = in.SecretName
 	out.KubernetesVersion = (*v3.Version)(unsafe.Pointer(in.KubernetesVersion))
+	out.HealthGate = (*config.OperatingSystemConfigHealthGate)(unsafe.Pointer(in.HealthGate))
+	out.Rollout = (*config.OperatingSystemConfigRolloutConfig)(unsafe.Pointer(in.Rollout))
+	out.ImageVerification = (*config.ImageVerificationConfig)(unsafe.Pointer(in.ImageVerification))
 	return nil
 }
 
@@ -279,6 +344,9 @@ func autoConvert_config_OperatingSystemConfigControllerConfig_To_v1alpha1_Operat
 	out.SyncJitterPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncJitterPeriod))
 	out.SecretName = in.SecretName
 	out.KubernetesVersion = (*v3.Version)(unsafe.Pointer(in.KubernetesVersion))
+	out.HealthGate = (*OperatingSystemConfigHealthGate)(unsafe.Pointer(in.HealthGate))
+	out.Rollout = (*OperatingSystemConfigRolloutConfig)(unsafe.Pointer(in.Rollout))
+	out.ImageVerification = (*ImageVerificationConfig)(unsafe.Pointer(in.ImageVerification))
 	return nil
 }
 
@@ -287,6 +355,74 @@ func Convert_config_OperatingSystemConfigControllerConfig_To_v1alpha1_OperatingS
 	return autoConvert_config_OperatingSystemConfigControllerConfig_To_v1alpha1_OperatingSystemConfigControllerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_OperatingSystemConfigDrainConfig_To_config_OperatingSystemConfigDrainConfig(in *OperatingSystemConfigDrainConfig, out *config.OperatingSystemConfigDrainConfig, s conversion.Scope) error {
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_v1alpha1_OperatingSystemConfigDrainConfig_To_config_OperatingSystemConfigDrainConfig is an autogenerated conversion function.
+func Convert_v1alpha1_OperatingSystemConfigDrainConfig_To_config_OperatingSystemConfigDrainConfig(in *OperatingSystemConfigDrainConfig, out *config.OperatingSystemConfigDrainConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OperatingSystemConfigDrainConfig_To_config_OperatingSystemConfigDrainConfig(in, out, s)
+}
+
+func autoConvert_config_OperatingSystemConfigDrainConfig_To_v1alpha1_OperatingSystemConfigDrainConfig(in *config.OperatingSystemConfigDrainConfig, out *OperatingSystemConfigDrainConfig, s conversion.Scope) error {
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	return nil
+}
+
+// Convert_config_OperatingSystemConfigDrainConfig_To_v1alpha1_OperatingSystemConfigDrainConfig is an autogenerated conversion function.
+func Convert_config_OperatingSystemConfigDrainConfig_To_v1alpha1_OperatingSystemConfigDrainConfig(in *config.OperatingSystemConfigDrainConfig, out *OperatingSystemConfigDrainConfig, s conversion.Scope) error {
+	return autoConvert_config_OperatingSystemConfigDrainConfig_To_v1alpha1_OperatingSystemConfigDrainConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_OperatingSystemConfigHealthGate_To_config_OperatingSystemConfigHealthGate(in *OperatingSystemConfigHealthGate, out *config.OperatingSystemConfigHealthGate, s conversion.Scope) error {
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	out.Units = *(*[]string)(unsafe.Pointer(&in.Units))
+	out.KubeletHealthy = (*bool)(unsafe.Pointer(in.KubeletHealthy))
+	return nil
+}
+
+// Convert_v1alpha1_OperatingSystemConfigHealthGate_To_config_OperatingSystemConfigHealthGate is an autogenerated conversion function.
+func Convert_v1alpha1_OperatingSystemConfigHealthGate_To_config_OperatingSystemConfigHealthGate(in *OperatingSystemConfigHealthGate, out *config.OperatingSystemConfigHealthGate, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OperatingSystemConfigHealthGate_To_config_OperatingSystemConfigHealthGate(in, out, s)
+}
+
+func autoConvert_config_OperatingSystemConfigHealthGate_To_v1alpha1_OperatingSystemConfigHealthGate(in *config.OperatingSystemConfigHealthGate, out *OperatingSystemConfigHealthGate, s conversion.Scope) error {
+	out.Timeout = (*v1.Duration)(unsafe.Pointer(in.Timeout))
+	out.Units = *(*[]string)(unsafe.Pointer(&in.Units))
+	out.KubeletHealthy = (*bool)(unsafe.Pointer(in.KubeletHealthy))
+	return nil
+}
+
+// Convert_config_OperatingSystemConfigHealthGate_To_v1alpha1_OperatingSystemConfigHealthGate is an autogenerated conversion function.
+func Convert_config_OperatingSystemConfigHealthGate_To_v1alpha1_OperatingSystemConfigHealthGate(in *config.OperatingSystemConfigHealthGate, out *OperatingSystemConfigHealthGate, s conversion.Scope) error {
+	return autoConvert_config_OperatingSystemConfigHealthGate_To_v1alpha1_OperatingSystemConfigHealthGate(in, out, s)
+}
+
+func autoConvert_v1alpha1_OperatingSystemConfigRolloutConfig_To_config_OperatingSystemConfigRolloutConfig(in *OperatingSystemConfigRolloutConfig, out *config.OperatingSystemConfigRolloutConfig, s conversion.Scope) error {
+	out.MaxUnavailable = in.MaxUnavailable
+	out.LeaseDuration = (*v1.Duration)(unsafe.Pointer(in.LeaseDuration))
+	out.Drain = (*config.OperatingSystemConfigDrainConfig)(unsafe.Pointer(in.Drain))
+	return nil
+}
+
+// Convert_v1alpha1_OperatingSystemConfigRolloutConfig_To_config_OperatingSystemConfigRolloutConfig is an autogenerated conversion function.
+func Convert_v1alpha1_OperatingSystemConfigRolloutConfig_To_config_OperatingSystemConfigRolloutConfig(in *OperatingSystemConfigRolloutConfig, out *config.OperatingSystemConfigRolloutConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_OperatingSystemConfigRolloutConfig_To_config_OperatingSystemConfigRolloutConfig(in, out, s)
+}
+
+func autoConvert_config_OperatingSystemConfigRolloutConfig_To_v1alpha1_OperatingSystemConfigRolloutConfig(in *config.OperatingSystemConfigRolloutConfig, out *OperatingSystemConfigRolloutConfig, s conversion.Scope) error {
+	out.MaxUnavailable = in.MaxUnavailable
+	out.LeaseDuration = (*v1.Duration)(unsafe.Pointer(in.LeaseDuration))
+	out.Drain = (*OperatingSystemConfigDrainConfig)(unsafe.Pointer(in.Drain))
+	return nil
+}
+
+// Convert_config_OperatingSystemConfigRolloutConfig_To_v1alpha1_OperatingSystemConfigRolloutConfig is an autogenerated conversion function.
+func Convert_config_OperatingSystemConfigRolloutConfig_To_v1alpha1_OperatingSystemConfigRolloutConfig(in *config.OperatingSystemConfigRolloutConfig, out *OperatingSystemConfigRolloutConfig, s conversion.Scope) error {
+	return autoConvert_config_OperatingSystemConfigRolloutConfig_To_v1alpha1_OperatingSystemConfigRolloutConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_Server_To_config_Server(in *Server, out *config.Server, s conversion.Scope) error {
 	out.BindAddress = in.BindAddress
 	out.Port = in.Port
@@ -26,5 +26,14 @@ func SetObjectDefaults_NodeAgentConfiguration(in *NodeAgentConfiguration) {
 	SetDefaults_ClientConnectionConfiguration(&in.ClientConnection)
 	SetDefaults_ServerConfiguration(&in.Server)
 	SetDefaults_OperatingSystemConfigControllerConfig(&in.Controllers.OperatingSystemConfig)
+	if in.Controllers.OperatingSystemConfig.HealthGate != nil {
+		SetDefaults_OperatingSystemConfigHealthGate(in.Controllers.OperatingSystemConfig.HealthGate)
+	}
+	if in.Controllers.OperatingSystemConfig.Rollout != nil {
+		SetDefaults_OperatingSystemConfigRolloutConfig(in.Controllers.OperatingSystemConfig.Rollout)
+		if in.Controllers.OperatingSystemConfig.Rollout.Drain != nil {
+			SetDefaults_OperatingSystemConfigDrainConfig(in.Controllers.OperatingSystemConfig.Rollout.Drain)
+		}
+	}
 	SetDefaults_TokenControllerConfig(&in.Controllers.Token)
 }
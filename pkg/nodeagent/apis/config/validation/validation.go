@@ -15,9 +15,16 @@
 package validation
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
@@ -75,6 +82,95 @@ func validateOperatingSystemConfigControllerConfiguration(conf config.OperatingS
 		allErrs = append(allErrs, field.Invalid(fldPath.Child("kubernetesVersion"), conf.KubernetesVersion, err.Error()))
 	}
 
+	if conf.HealthGate != nil {
+		allErrs = append(allErrs, validateOperatingSystemConfigHealthGate(*conf.HealthGate, fldPath.Child("healthGate"))...)
+	}
+
+	if conf.Rollout != nil {
+		allErrs = append(allErrs, validateOperatingSystemConfigRolloutConfig(*conf.Rollout, fldPath.Child("rollout"))...)
+	}
+
+	if conf.ImageVerification != nil {
+		allErrs = append(allErrs, validateImageVerificationConfig(*conf.ImageVerification, fldPath.Child("imageVerification"))...)
+	}
+
+	return allErrs
+}
+
+func validateImageVerificationConfig(conf config.ImageVerificationConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for i, publicKey := range conf.PublicKeys {
+		idxPath := fldPath.Child("publicKeys").Index(i)
+
+		block, _ := pem.Decode([]byte(publicKey))
+		if block == nil {
+			allErrs = append(allErrs, field.Invalid(idxPath, publicKey, "must be a PEM-encoded public key"))
+			continue
+		}
+
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			allErrs = append(allErrs, field.Invalid(idxPath, publicKey, fmt.Sprintf("public key cannot be parsed: %v", err)))
+			continue
+		}
+
+		switch key.(type) {
+		case *ecdsa.PublicKey, *rsa.PublicKey, ed25519.PublicKey:
+		default:
+			allErrs = append(allErrs, field.Invalid(idxPath, publicKey, fmt.Sprintf("public key type %T is not supported, only ECDSA, RSA and Ed25519 keys are supported", key)))
+		}
+	}
+
+	return allErrs
+}
+
+func validateOperatingSystemConfigHealthGate(conf config.OperatingSystemConfigHealthGate, fldPath *field.Path) field.ErrorList {
+	var (
+		allErrs = field.ErrorList{}
+		units   = sets.New[string]()
+	)
+
+	if conf.Timeout != nil && conf.Timeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), conf.Timeout, "must be greater than 0"))
+	}
+
+	for i, unit := range conf.Units {
+		idxPath := fldPath.Child("units").Index(i)
+
+		if unit == "" {
+			allErrs = append(allErrs, field.Required(idxPath, "must provide a unit name"))
+			continue
+		}
+
+		if units.Has(unit) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, unit))
+		}
+		units.Insert(unit)
+	}
+
+	return allErrs
+}
+
+func validateOperatingSystemConfigRolloutConfig(conf config.OperatingSystemConfigRolloutConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if value, err := intstr.GetScaledValueFromIntOrPercent(&conf.MaxUnavailable, 100, false); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), conf.MaxUnavailable.String(), err.Error()))
+	} else if value < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), conf.MaxUnavailable.String(), "must be greater than or equal to 0"))
+	} else if conf.MaxUnavailable.Type == intstr.String && value > 100 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), conf.MaxUnavailable.String(), "must not be greater than 100%"))
+	}
+
+	if conf.LeaseDuration != nil && conf.LeaseDuration.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("leaseDuration"), conf.LeaseDuration, "must be greater than 0"))
+	}
+
+	if conf.Drain != nil && conf.Drain.Timeout != nil && conf.Drain.Timeout.Duration <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("drain", "timeout"), conf.Drain.Timeout, "must be greater than 0"))
+	}
+
 	return allErrs
 }
 
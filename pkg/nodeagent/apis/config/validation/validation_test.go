@@ -15,6 +15,11 @@
 package validation_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
@@ -22,6 +27,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	. "github.com/gardener/gardener/pkg/nodeagent/apis/config"
@@ -87,6 +93,158 @@ var _ = Describe("#ValidateNodeAgentConfiguration", func() {
 				})),
 			))
 		})
+
+		Context("health gate", func() {
+			BeforeEach(func() {
+				config.Controllers.OperatingSystemConfig.HealthGate = &OperatingSystemConfigHealthGate{
+					Timeout: &metav1.Duration{Duration: time.Minute},
+					Units:   []string{"containerd.service", "kubelet.service"},
+				}
+			})
+
+			It("should pass because the health gate is valid", func() {
+				Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+			})
+
+			It("should fail because timeout is not positive", func() {
+				config.Controllers.OperatingSystemConfig.HealthGate.Timeout.Duration = 0
+
+				Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.operatingSystemConfig.healthGate.timeout"),
+					})),
+				))
+			})
+
+			It("should fail because unit names are empty or duplicated", func() {
+				config.Controllers.OperatingSystemConfig.HealthGate.Units = []string{"kubelet.service", "", "kubelet.service"}
+
+				Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("controllers.operatingSystemConfig.healthGate.units[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("controllers.operatingSystemConfig.healthGate.units[2]"),
+					})),
+				))
+			})
+		})
+
+		Context("rollout", func() {
+			BeforeEach(func() {
+				config.Controllers.OperatingSystemConfig.Rollout = &OperatingSystemConfigRolloutConfig{
+					MaxUnavailable: intstr.FromString("25%"),
+					LeaseDuration:  &metav1.Duration{Duration: time.Minute},
+				}
+			})
+
+			It("should pass because the rollout configuration is valid", func() {
+				Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+			})
+
+			It("should pass because max unavailable is zero", func() {
+				config.Controllers.OperatingSystemConfig.Rollout.MaxUnavailable = intstr.FromInt32(0)
+
+				Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+			})
+
+			It("should fail because max unavailable is negative", func() {
+				config.Controllers.OperatingSystemConfig.Rollout.MaxUnavailable = intstr.FromInt32(-1)
+
+				Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.operatingSystemConfig.rollout.maxUnavailable"),
+					})),
+				))
+			})
+
+			It("should fail because max unavailable is not a valid percentage", func() {
+				config.Controllers.OperatingSystemConfig.Rollout.MaxUnavailable = intstr.FromString("foo")
+
+				Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.operatingSystemConfig.rollout.maxUnavailable"),
+					})),
+				))
+			})
+
+			It("should fail because max unavailable is more than 100%", func() {
+				config.Controllers.OperatingSystemConfig.Rollout.MaxUnavailable = intstr.FromString("101%")
+
+				Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.operatingSystemConfig.rollout.maxUnavailable"),
+					})),
+				))
+			})
+
+			It("should fail because lease duration is not positive", func() {
+				config.Controllers.OperatingSystemConfig.Rollout.LeaseDuration.Duration = 0
+
+				Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.operatingSystemConfig.rollout.leaseDuration"),
+					})),
+				))
+			})
+
+			It("should fail because drain timeout is not positive", func() {
+				config.Controllers.OperatingSystemConfig.Rollout.Drain = &OperatingSystemConfigDrainConfig{Timeout: &metav1.Duration{}}
+
+				Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.operatingSystemConfig.rollout.drain.timeout"),
+					})),
+				))
+			})
+		})
+
+		Context("image verification", func() {
+			var publicKey string
+
+			BeforeEach(func() {
+				privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+				Expect(err).NotTo(HaveOccurred())
+				publicKeyDER, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+				Expect(err).NotTo(HaveOccurred())
+				publicKey = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyDER}))
+
+				config.Controllers.OperatingSystemConfig.ImageVerification = &ImageVerificationConfig{
+					RequireDigest: true,
+					PublicKeys:    []string{publicKey},
+				}
+			})
+
+			It("should pass because the image verification configuration is valid", func() {
+				Expect(ValidateNodeAgentConfiguration(config)).To(BeEmpty())
+			})
+
+			It("should fail because public keys are not PEM-encoded or cannot be parsed", func() {
+				config.Controllers.OperatingSystemConfig.ImageVerification.PublicKeys = append(config.Controllers.OperatingSystemConfig.ImageVerification.PublicKeys,
+					"foo",
+					string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: []byte("bar")})),
+				)
+
+				Expect(ValidateNodeAgentConfiguration(config)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.operatingSystemConfig.imageVerification.publicKeys[1]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("controllers.operatingSystemConfig.imageVerification.publicKeys[2]"),
+					})),
+				))
+			})
+		})
 	})
 
 	Context("Token Controller", func() {
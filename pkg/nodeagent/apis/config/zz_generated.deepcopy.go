@@ -76,6 +76,27 @@ func (in *ControllerConfiguration) DeepCopy() *ControllerConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImageVerificationConfig) DeepCopyInto(out *ImageVerificationConfig) {
+	*out = *in
+	if in.PublicKeys != nil {
+		in, out := &in.PublicKeys, &out.PublicKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageVerificationConfig.
+func (in *ImageVerificationConfig) DeepCopy() *ImageVerificationConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ImageVerificationConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeAgentConfiguration) DeepCopyInto(out *NodeAgentConfiguration) {
 	*out = *in
@@ -140,6 +161,21 @@ func (in *OperatingSystemConfigControllerConfig) DeepCopyInto(out *OperatingSyst
 		*out = new(v3.Version)
 		**out = **in
 	}
+	if in.HealthGate != nil {
+		in, out := &in.HealthGate, &out.HealthGate
+		*out = new(OperatingSystemConfigHealthGate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(OperatingSystemConfigRolloutConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ImageVerification != nil {
+		in, out := &in.ImageVerification, &out.ImageVerification
+		*out = new(ImageVerificationConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -153,6 +189,85 @@ func (in *OperatingSystemConfigControllerConfig) DeepCopy() *OperatingSystemConf
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatingSystemConfigDrainConfig) DeepCopyInto(out *OperatingSystemConfigDrainConfig) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatingSystemConfigDrainConfig.
+func (in *OperatingSystemConfigDrainConfig) DeepCopy() *OperatingSystemConfigDrainConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatingSystemConfigDrainConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatingSystemConfigHealthGate) DeepCopyInto(out *OperatingSystemConfigHealthGate) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Units != nil {
+		in, out := &in.Units, &out.Units
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.KubeletHealthy != nil {
+		in, out := &in.KubeletHealthy, &out.KubeletHealthy
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatingSystemConfigHealthGate.
+func (in *OperatingSystemConfigHealthGate) DeepCopy() *OperatingSystemConfigHealthGate {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatingSystemConfigHealthGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperatingSystemConfigRolloutConfig) DeepCopyInto(out *OperatingSystemConfigRolloutConfig) {
+	*out = *in
+	out.MaxUnavailable = in.MaxUnavailable
+	if in.LeaseDuration != nil {
+		in, out := &in.LeaseDuration, &out.LeaseDuration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Drain != nil {
+		in, out := &in.Drain, &out.Drain
+		*out = new(OperatingSystemConfigDrainConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperatingSystemConfigRolloutConfig.
+func (in *OperatingSystemConfigRolloutConfig) DeepCopy() *OperatingSystemConfigRolloutConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(OperatingSystemConfigRolloutConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Server) DeepCopyInto(out *Server) {
 	*out = *in
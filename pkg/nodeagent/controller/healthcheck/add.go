@@ -22,6 +22,7 @@ import (
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/defaults"
 	"github.com/containerd/containerd/namespaces"
+	"github.com/spf13/afero"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/clock"
@@ -51,6 +52,12 @@ func (r *Reconciler) AddToManager(mgr manager.Manager, nodePredicate predicate.P
 	if r.DBus == nil {
 		r.DBus = dbus.New(mgr.GetLogger().WithValues("controller", ControllerName))
 	}
+	if r.FS.Fs == nil {
+		r.FS = afero.Afero{Fs: afero.NewOsFs()}
+	}
+	if r.CommandRunner == nil {
+		r.CommandRunner = RunCommand
+	}
 	if len(r.HealthCheckers) == 0 {
 		if err := r.setDefaultHealthChecks(); err != nil {
 			return err
@@ -16,25 +16,40 @@ package healthcheck
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/spf13/afero"
 	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
 	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
-// Reconciler checks for containerd and kubelet health and restarts them if required.
+// Reconciler checks for containerd and kubelet health and restarts them if required. In addition, it checks the health
+// of all units with a health check in the last applied operating system config.
 type Reconciler struct {
 	Client                     client.Client
 	Recorder                   record.EventRecorder
 	DBus                       dbus.DBus
+	FS                         afero.Afero
+	CommandRunner              CommandRunner
 	HealthCheckers             []HealthChecker
 	HealthCheckIntervalSeconds int32
+
+	unitHealthCheckers map[string]*UnitHealthChecker
 }
 
 // Reconcile executes all defined healtchecks
@@ -47,15 +62,94 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, err
 	}
 
+	unitHealthCheckers, err := r.syncUnitHealthCheckers()
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed reading health checks of units: %w", err)
+	}
+
 	var taskFns []flow.TaskFn
 	for _, healthChecker := range r.HealthCheckers {
 		f := healthChecker
 		taskFns = append(taskFns, func(ctx context.Context) error { return f.Check(ctx, node.DeepCopy()) })
 	}
+	for _, unitHealthChecker := range unitHealthCheckers {
+		f := unitHealthChecker
+		taskFns = append(taskFns, func(ctx context.Context) error { return f.Check(ctx, node.DeepCopy()) })
+	}
 
-	if err := flow.Parallel(taskFns...)(ctx); err != nil {
-		return reconcile.Result{}, err
+	checkErr := flow.Parallel(taskFns...)(ctx)
+
+	if err := r.updateUnitsHealthyCondition(ctx, node, unitHealthCheckers); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed updating node condition: %w", err)
+	}
+
+	if checkErr != nil {
+		return reconcile.Result{}, checkErr
 	}
 
 	return reconcile.Result{RequeueAfter: time.Duration(r.HealthCheckIntervalSeconds) * time.Second}, nil
 }
+
+// syncUnitHealthCheckers creates the health checkers for all enabled units with a health check in the last applied
+// operating system config. Existing health checkers are kept (together with their state) as long as the health check
+// of the unit does not change.
+func (r *Reconciler) syncUnitHealthCheckers() ([]*UnitHealthChecker, error) {
+	units, err := operatingsystemconfig.ReadLastAppliedUnits(r.FS)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		unitHealthCheckers []*UnitHealthChecker
+		checkerByUnitName  = make(map[string]*UnitHealthChecker)
+	)
+
+	for _, unit := range units {
+		if unit.HealthCheck == nil || !ptr.Deref(unit.Enable, true) || ptr.Deref(unit.Command, "") == extensionsv1alpha1.CommandStop {
+			continue
+		}
+
+		unitHealthChecker, ok := r.unitHealthCheckers[unit.Name]
+		if !ok || !apiequality.Semantic.DeepEqual(unitHealthChecker.healthCheck, *unit.HealthCheck) {
+			unitHealthChecker = NewUnitHealthChecker(unit.Name, *unit.HealthCheck, r.DBus, r.Recorder, r.CommandRunner)
+		}
+
+		checkerByUnitName[unit.Name] = unitHealthChecker
+		unitHealthCheckers = append(unitHealthCheckers, unitHealthChecker)
+	}
+
+	r.unitHealthCheckers = checkerByUnitName
+	return unitHealthCheckers, nil
+}
+
+// updateUnitsHealthyCondition reports the result of the unit health checks via a condition on the node. The condition
+// is only maintained if there are units with health checks (or if it has been reported before).
+func (r *Reconciler) updateUnitsHealthyCondition(ctx context.Context, node *corev1.Node, unitHealthCheckers []*UnitHealthChecker) error {
+	conditionIndex := slices.IndexFunc(node.Status.Conditions, func(c corev1.NodeCondition) bool {
+		return c.Type == nodeagentv1alpha1.ConditionTypeUnitsHealthy
+	})
+	if len(unitHealthCheckers) == 0 && conditionIndex == -1 {
+		return nil
+	}
+
+	var (
+		status         = corev1.ConditionTrue
+		reason         = nodeagentv1alpha1.ConditionReasonUnitsHealthy
+		message        = "All units with health checks are healthy"
+		unhealthyUnits []string
+	)
+
+	for _, unitHealthChecker := range unitHealthCheckers {
+		if err := unitHealthChecker.LastError(); err != nil {
+			unhealthyUnits = append(unhealthyUnits, fmt.Sprintf("%s: %s", unitHealthChecker.Name(), err.Error()))
+		}
+	}
+
+	if len(unhealthyUnits) > 0 {
+		status = corev1.ConditionFalse
+		reason = nodeagentv1alpha1.ConditionReasonUnitsUnhealthy
+		message = "Units are unhealthy: " + strings.Join(unhealthyUnits, "; ")
+	}
+
+	return nodeagent.UpdateNodeCondition(ctx, r.Client, node, nodeagentv1alpha1.ConditionTypeUnitsHealthy, status, reason, message)
+}
@@ -0,0 +1,149 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx = context.Background()
+
+		fakeClient client.Client
+		fs         afero.Afero
+		fakeDBus   *fakedbus.DBus
+		reconciler *Reconciler
+
+		node *corev1.Node
+		osc  *extensionsv1alpha1.OperatingSystemConfig
+	)
+
+	BeforeEach(func() {
+		node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(node).WithStatusSubresource(node).Build()
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+		fakeDBus = fakedbus.New()
+
+		reconciler = &Reconciler{
+			Client:                     fakeClient,
+			Recorder:                   record.NewFakeRecorder(10),
+			DBus:                       fakeDBus,
+			FS:                         fs,
+			CommandRunner:              func(context.Context, []string) error { return nil },
+			HealthCheckIntervalSeconds: 30,
+		}
+
+		osc = &extensionsv1alpha1.OperatingSystemConfig{
+			TypeMeta: metav1.TypeMeta{APIVersion: extensionsv1alpha1.SchemeGroupVersion.String(), Kind: "OperatingSystemConfig"},
+			Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+				Units: []extensionsv1alpha1.Unit{
+					{Name: "foo.service", HealthCheck: &extensionsv1alpha1.UnitHealthCheck{FailureThreshold: ptr.To[int32](5)}},
+					{Name: "bar.service", Enable: ptr.To(false), HealthCheck: &extensionsv1alpha1.UnitHealthCheck{}},
+					{Name: "baz.service"},
+				},
+			},
+			Status: extensionsv1alpha1.OperatingSystemConfigStatus{
+				ExtensionUnits: []extensionsv1alpha1.Unit{
+					{Name: "gpu.service", HealthCheck: &extensionsv1alpha1.UnitHealthCheck{FailureThreshold: ptr.To[int32](5)}},
+				},
+			},
+		}
+	})
+
+	writeLastApplied := func() {
+		oscRaw, err := yaml.Marshal(osc)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fs.WriteFile(nodeagentv1alpha1.BaseDir+"/last-applied-osc.yaml", oscRaw, 0644)).To(Succeed())
+	}
+
+	reconcileAndGetCondition := func() *corev1.NodeCondition {
+		Expect(reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)})).WithOffset(1).To(Equal(reconcile.Result{RequeueAfter: 30 * time.Second}))
+		ExpectWithOffset(1, fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == nodeagentv1alpha1.ConditionTypeUnitsHealthy {
+				return &condition
+			}
+		}
+		return nil
+	}
+
+	It("should not report a condition if no OSC has been applied yet", func() {
+		Expect(reconcileAndGetCondition()).To(BeNil())
+	})
+
+	It("should report that all units are healthy", func() {
+		writeLastApplied()
+
+		condition := reconcileAndGetCondition()
+		Expect(condition).NotTo(BeNil())
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+		Expect(condition.Reason).To(Equal(nodeagentv1alpha1.ConditionReasonUnitsHealthy))
+	})
+
+	It("should report unhealthy units and keep their state across reconciliations", func() {
+		writeLastApplied()
+		fakeDBus.SetActiveState("gpu.service", "failed")
+		fakeDBus.SetActiveState("bar.service", "inactive")
+
+		for i := 0; i < 4; i++ {
+			condition := reconcileAndGetCondition()
+			Expect(condition).NotTo(BeNil())
+			Expect(condition.Status).To(Equal(corev1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(nodeagentv1alpha1.ConditionReasonUnitsUnhealthy))
+			Expect(condition.Message).To(Equal(`Units are unhealthy: gpu.service: unit is not active but "failed"`))
+		}
+		Expect(fakeDBus.Actions).To(BeEmpty())
+
+		reconcileAndGetCondition()
+		Expect(fakeDBus.Actions).To(ConsistOf(fakedbus.SystemdAction{Action: fakedbus.ActionRestart, UnitNames: []string{"gpu.service"}}))
+
+		fakeDBus.SetActiveState("gpu.service", "active")
+		condition := reconcileAndGetCondition()
+		Expect(condition.Status).To(Equal(corev1.ConditionTrue))
+	})
+
+	It("should mark the condition as healthy if the health checks were removed", func() {
+		writeLastApplied()
+		fakeDBus.SetActiveState("gpu.service", "failed")
+		Expect(reconcileAndGetCondition().Status).To(Equal(corev1.ConditionFalse))
+
+		osc.Status.ExtensionUnits = nil
+		osc.Spec.Units[0].HealthCheck = nil
+		writeLastApplied()
+
+		Expect(reconcileAndGetCondition().Status).To(Equal(corev1.ConditionTrue))
+	})
+})
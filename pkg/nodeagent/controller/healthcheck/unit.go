@@ -0,0 +1,171 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os/exec"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/dbus"
+)
+
+const (
+	defaultUnitHealthCheckTimeout          = 10 * time.Second
+	defaultUnitHealthCheckFailureThreshold = 3
+
+	unitActiveStateActive = "active"
+	maxCommandOutputLen   = 256
+)
+
+// CommandRunner runs the given command line and returns an error if it does not exit with code 0.
+type CommandRunner func(ctx context.Context, command []string) error
+
+// RunCommand runs the given command line on the host.
+func RunCommand(ctx context.Context, command []string) error {
+	output, err := exec.CommandContext(ctx, command[0], command[1:]...).CombinedOutput()
+	if err != nil {
+		out := strings.TrimSpace(string(output))
+		if len(out) > maxCommandOutputLen {
+			out = out[:maxCommandOutputLen] + "..."
+		}
+		return fmt.Errorf("command %q failed: %w, output: %s", strings.Join(command, " "), err, out)
+	}
+	return nil
+}
+
+// UnitHealthChecker checks the health of a systemd unit based on the health check declared for it in the
+// OperatingSystemConfig. It restarts the unit if the check failed for the configured number of consecutive times.
+type UnitHealthChecker struct {
+	unitName    string
+	healthCheck extensionsv1alpha1.UnitHealthCheck
+
+	dbus       dbus.DBus
+	recorder   record.EventRecorder
+	httpClient *http.Client
+	runCommand CommandRunner
+
+	consecutiveFailures int32
+	lastError           error
+}
+
+// NewUnitHealthChecker creates a new instance of a health check for the given unit.
+func NewUnitHealthChecker(unitName string, healthCheck extensionsv1alpha1.UnitHealthCheck, dbus dbus.DBus, recorder record.EventRecorder, runCommand CommandRunner) *UnitHealthChecker {
+	return &UnitHealthChecker{
+		unitName:    unitName,
+		healthCheck: healthCheck,
+		dbus:        dbus,
+		recorder:    recorder,
+		httpClient:  &http.Client{},
+		runCommand:  runCommand,
+	}
+}
+
+// Name returns the name of this health check.
+func (u *UnitHealthChecker) Name() string {
+	return u.unitName
+}
+
+// LastError returns the error of the last check, or nil if the unit was healthy.
+func (u *UnitHealthChecker) LastError() error {
+	return u.lastError
+}
+
+// Check performs the actual health check for the unit.
+func (u *UnitHealthChecker) Check(ctx context.Context, node *corev1.Node) error {
+	log := logf.FromContext(ctx).WithName(u.Name())
+
+	err := u.probe(ctx)
+	if err == nil {
+		if u.lastError != nil {
+			log.Info("Unit is healthy again")
+			u.recorder.Eventf(node, corev1.EventTypeNormal, u.unitName, "Unit %s is healthy", u.unitName)
+		}
+		u.consecutiveFailures, u.lastError = 0, nil
+		return nil
+	}
+
+	if u.lastError == nil {
+		log.Error(err, "Unit is unhealthy")
+		u.recorder.Eventf(node, corev1.EventTypeWarning, u.unitName, "Unit %s is unhealthy: %s", u.unitName, err.Error())
+	}
+	u.consecutiveFailures++
+	u.lastError = err
+
+	failureThreshold := ptr.Deref(u.healthCheck.FailureThreshold, defaultUnitHealthCheckFailureThreshold)
+	if u.consecutiveFailures < failureThreshold {
+		return nil
+	}
+
+	log.Error(err, "Unit is unhealthy, restarting it", "consecutiveFailures", u.consecutiveFailures)
+	u.recorder.Eventf(node, corev1.EventTypeWarning, u.unitName, "Unit %s failed its health check %d times in a row, restarting it: %s", u.unitName, u.consecutiveFailures, err.Error())
+	if err := u.dbus.Restart(ctx, u.recorder, node, u.unitName); err != nil {
+		return fmt.Errorf("failed restarting unit %s: %w", u.unitName, err)
+	}
+
+	u.consecutiveFailures = 0
+	return nil
+}
+
+func (u *UnitHealthChecker) probe(ctx context.Context) error {
+	timeout := defaultUnitHealthCheckTimeout
+	if u.healthCheck.TimeoutSeconds != nil {
+		timeout = time.Duration(*u.healthCheck.TimeoutSeconds) * time.Second
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	switch {
+	case u.healthCheck.HTTPGet != nil:
+		request, err := http.NewRequestWithContext(ctx, http.MethodGet, u.healthCheck.HTTPGet.URL, nil)
+		if err != nil {
+			return fmt.Errorf("failed creating request: %w", err)
+		}
+
+		response, err := u.httpClient.Do(request)
+		if err != nil {
+			return fmt.Errorf("HTTP request to %s failed: %w", u.healthCheck.HTTPGet.URL, err)
+		}
+		defer response.Body.Close()
+
+		if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusBadRequest {
+			return fmt.Errorf("HTTP request to %s returned status code %d", u.healthCheck.HTTPGet.URL, response.StatusCode)
+		}
+		return nil
+
+	case u.healthCheck.Exec != nil:
+		return u.runCommand(ctx, u.healthCheck.Exec.Command)
+
+	default:
+		activeState, err := u.dbus.ActiveState(ctx, u.unitName)
+		if err != nil {
+			return fmt.Errorf("failed getting active state: %w", err)
+		}
+		if activeState != unitActiveStateActive {
+			return fmt.Errorf("unit is not active but %q", activeState)
+		}
+		return nil
+	}
+}
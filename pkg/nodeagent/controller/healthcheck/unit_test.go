@@ -0,0 +1,160 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package healthcheck_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/healthcheck"
+	fakedbus "github.com/gardener/gardener/pkg/nodeagent/dbus/fake"
+)
+
+var _ = Describe("UnitHealthChecker", func() {
+	var (
+		ctx  = context.Background()
+		node *corev1.Node
+
+		fakeDBus   *fakedbus.DBus
+		recorder   *record.FakeRecorder
+		commandErr error
+		runCommand CommandRunner
+	)
+
+	BeforeEach(func() {
+		node = &corev1.Node{}
+		fakeDBus = fakedbus.New()
+		recorder = record.NewFakeRecorder(10)
+		commandErr = nil
+		runCommand = func(_ context.Context, _ []string) error { return commandErr }
+	})
+
+	restartActions := func(times int) []fakedbus.SystemdAction {
+		var actions []fakedbus.SystemdAction
+		for i := 0; i < times; i++ {
+			actions = append(actions, fakedbus.SystemdAction{Action: fakedbus.ActionRestart, UnitNames: []string{"foo.service"}})
+		}
+		return actions
+	}
+
+	Context("active state", func() {
+		var checker *UnitHealthChecker
+
+		BeforeEach(func() {
+			checker = NewUnitHealthChecker("foo.service", extensionsv1alpha1.UnitHealthCheck{FailureThreshold: ptr.To[int32](2)}, fakeDBus, recorder, runCommand)
+		})
+
+		It("should be healthy if the unit is active", func() {
+			Expect(checker.Check(ctx, node)).To(Succeed())
+			Expect(checker.LastError()).NotTo(HaveOccurred())
+			Expect(fakeDBus.Actions).To(BeEmpty())
+		})
+
+		It("should restart the unit after reaching the failure threshold", func() {
+			fakeDBus.SetActiveState("foo.service", "failed")
+
+			Expect(checker.Check(ctx, node)).To(Succeed())
+			Expect(checker.LastError()).To(MatchError(`unit is not active but "failed"`))
+			Expect(fakeDBus.Actions).To(BeEmpty())
+
+			Expect(checker.Check(ctx, node)).To(Succeed())
+			Expect(fakeDBus.Actions).To(Equal(restartActions(1)))
+
+			Expect(checker.Check(ctx, node)).To(Succeed())
+			Expect(fakeDBus.Actions).To(Equal(restartActions(1)))
+			Expect(checker.LastError()).To(HaveOccurred())
+
+			fakeDBus.SetActiveState("foo.service", "active")
+			Expect(checker.Check(ctx, node)).To(Succeed())
+			Expect(checker.LastError()).NotTo(HaveOccurred())
+			Expect(recorder.Events).To(Receive(ContainSubstring("Unit foo.service is unhealthy")))
+			Expect(recorder.Events).To(Receive(ContainSubstring("failed its health check 2 times in a row, restarting it")))
+			Expect(recorder.Events).To(Receive(ContainSubstring("Unit foo.service is healthy")))
+		})
+	})
+
+	Context("HTTP GET", func() {
+		var (
+			statusCode int
+			server     *httptest.Server
+			checker    *UnitHealthChecker
+		)
+
+		BeforeEach(func() {
+			statusCode = http.StatusOK
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(statusCode)
+			}))
+			DeferCleanup(server.Close)
+
+			checker = NewUnitHealthChecker("foo.service", extensionsv1alpha1.UnitHealthCheck{
+				HTTPGet:          &extensionsv1alpha1.UnitHealthCheckHTTPGet{URL: server.URL + "/healthz"},
+				FailureThreshold: ptr.To[int32](1),
+			}, fakeDBus, recorder, runCommand)
+		})
+
+		It("should be healthy if the endpoint responds successfully", func() {
+			Expect(checker.Check(ctx, node)).To(Succeed())
+			Expect(checker.LastError()).NotTo(HaveOccurred())
+		})
+
+		It("should restart the unit if the endpoint responds with an error", func() {
+			statusCode = http.StatusInternalServerError
+
+			Expect(checker.Check(ctx, node)).To(Succeed())
+			Expect(checker.LastError()).To(MatchError(ContainSubstring("returned status code 500")))
+			Expect(fakeDBus.Actions).To(Equal(restartActions(1)))
+		})
+	})
+
+	Context("exec", func() {
+		var checker *UnitHealthChecker
+
+		BeforeEach(func() {
+			checker = NewUnitHealthChecker("foo.service", extensionsv1alpha1.UnitHealthCheck{
+				Exec: &extensionsv1alpha1.UnitHealthCheckExec{Command: []string{"/opt/bin/foo", "health"}},
+			}, fakeDBus, recorder, runCommand)
+		})
+
+		It("should restart the unit after three failures by default", func() {
+			commandErr = fmt.Errorf("exit status 1")
+
+			for i := 0; i < 3; i++ {
+				Expect(checker.Check(ctx, node)).To(Succeed())
+			}
+			Expect(checker.LastError()).To(MatchError("exit status 1"))
+			Expect(fakeDBus.Actions).To(Equal(restartActions(1)))
+		})
+	})
+
+	Describe("#RunCommand", func() {
+		It("should succeed if the command exits with code 0", func() {
+			Expect(RunCommand(ctx, []string{"true"})).To(Succeed())
+		})
+
+		It("should fail and contain the output if the command exits with a non-zero code", func() {
+			Expect(RunCommand(ctx, []string{"sh", "-c", "echo broken; exit 1"})).To(MatchError(ContainSubstring("output: broken")))
+		})
+	})
+})
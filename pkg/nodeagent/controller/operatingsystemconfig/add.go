@@ -17,6 +17,7 @@ package operatingsystemconfig
 import (
 	"bytes"
 	"context"
+	"fmt"
 	"slices"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -40,6 +42,7 @@ import (
 	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
 	"github.com/gardener/gardener/pkg/nodeagent/registry"
+	"github.com/gardener/gardener/pkg/nodeagent/registry/verification"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 )
 
@@ -51,6 +54,9 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) erro
 	if r.Client == nil {
 		r.Client = mgr.GetClient()
 	}
+	if r.APIReader == nil {
+		r.APIReader = mgr.GetAPIReader()
+	}
 	if r.Recorder == nil {
 		r.Recorder = mgr.GetEventRecorderFor(ControllerName)
 	}
@@ -61,7 +67,21 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager) erro
 		r.FS = afero.Afero{Fs: afero.NewOsFs()}
 	}
 	if r.Extractor == nil {
-		r.Extractor = registry.NewExtractor()
+		var verificationPolicy *verification.Policy
+		if r.Config.ImageVerification != nil {
+			publicKeys, err := verification.ParsePublicKeys(r.Config.ImageVerification.PublicKeys)
+			if err != nil {
+				return fmt.Errorf("failed parsing public keys for image verification: %w", err)
+			}
+			verificationPolicy = &verification.Policy{RequireDigest: r.Config.ImageVerification.RequireDigest, PublicKeys: publicKeys}
+		}
+		r.Extractor = registry.NewExtractor(verificationPolicy)
+	}
+	if r.Clock == nil {
+		r.Clock = clock.RealClock{}
+	}
+	if r.KubeletHealthEndpoint == "" {
+		r.KubeletHealthEndpoint = DefaultKubeletHealthEndpoint
 	}
 
 	return builder.
@@ -53,6 +53,36 @@ func extractOSCFromSecret(secret *corev1.Secret) (*extensionsv1alpha1.OperatingS
 	return osc, oscRaw, secret.Annotations[nodeagentv1alpha1.AnnotationKeyChecksumDownloadedOperatingSystemConfig], nil
 }
 
+// readLastAppliedOperatingSystemConfig reads the last applied operating system config from the disk. It returns nil if
+// no operating system config has been applied yet.
+func readLastAppliedOperatingSystemConfig(fs afero.Afero) (*extensionsv1alpha1.OperatingSystemConfig, error) {
+	oscRaw, err := fs.ReadFile(lastAppliedOperatingSystemConfigFilePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error reading last applied OSC from file path %s: %w", lastAppliedOperatingSystemConfigFilePath, err)
+	}
+
+	osc := &extensionsv1alpha1.OperatingSystemConfig{}
+	if err := runtime.DecodeInto(decoder, oscRaw, osc); err != nil {
+		return nil, fmt.Errorf("unable to decode the last applied OSC read from file path %s: %w", lastAppliedOperatingSystemConfigFilePath, err)
+	}
+
+	return osc, nil
+}
+
+// ReadLastAppliedUnits reads the units of the last applied operating system config from the disk. Units contained in
+// both the spec and the status (extension units) are merged. It returns nil if no operating system config has been
+// applied yet.
+func ReadLastAppliedUnits(fs afero.Afero) ([]extensionsv1alpha1.Unit, error) {
+	osc, err := readLastAppliedOperatingSystemConfig(fs)
+	if err != nil || osc == nil {
+		return nil, err
+	}
+	return mergeUnits(osc.Spec.Units, osc.Status.ExtensionUnits), nil
+}
+
 type operatingSystemConfigChanges struct {
 	units units
 	files files
@@ -157,7 +187,7 @@ func computeUnitDiffs(oldUnits, newUnits []extensionsv1alpha1.Unit, fileDiffs fi
 				Unit:    newUnit,
 				dropIns: dropIns{changed: newUnit.DropIns},
 			})
-		} else if !apiequality.Semantic.DeepEqual(oldUnits[oldUnitIndex], newUnit) || fileContentChanged {
+		} else if !apiequality.Semantic.DeepEqual(withoutHealthCheck(oldUnits[oldUnitIndex]), withoutHealthCheck(newUnit)) || fileContentChanged {
 			var d dropIns
 
 			for _, oldDropIn := range oldUnits[oldUnitIndex].DropIns {
@@ -189,6 +219,14 @@ func computeUnitDiffs(oldUnits, newUnits []extensionsv1alpha1.Unit, fileDiffs fi
 	return u
 }
 
+// withoutHealthCheck returns the given unit without its health check. Health checks are evaluated by the health check
+// controller which rebuilds its checkers from the last applied operating system config, hence a change to only the health
+// check of a unit must not restart it.
+func withoutHealthCheck(unit extensionsv1alpha1.Unit) extensionsv1alpha1.Unit {
+	unit.HealthCheck = nil
+	return unit
+}
+
 func computeFileDiffs(oldFiles, newFiles []extensionsv1alpha1.File) files {
 	var f files
 
@@ -236,6 +274,9 @@ func mergeUnits(specUnits, statusUnits []extensionsv1alpha1.Unit) []extensionsv1
 		if unit.Content != nil {
 			out[unitIndex].Content = unit.Content
 		}
+		if unit.HealthCheck != nil {
+			out[unitIndex].HealthCheck = unit.HealthCheck
+		}
 		out[unitIndex].DropIns = append(out[unitIndex].DropIns, unit.DropIns...)
 		out[unitIndex].FilePaths = append(out[unitIndex].FilePaths, unit.FilePaths...)
 	}
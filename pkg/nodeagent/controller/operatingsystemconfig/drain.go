@@ -0,0 +1,120 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+const defaultDrainTimeout = 10 * time.Minute
+
+// drainNode cordons the node and marks it for being drained. The pods are evicted by gardener-resource-manager since
+// gardener-node-agent is not permitted to list and evict pods. It returns true once gardener-resource-manager has
+// reported that all pods have been evicted or the drain timeout has expired. If the node has already been cordoned by
+// somebody else, it is only drained, and it is not uncordoned afterwards.
+func (r *Reconciler) drainNode(ctx context.Context, log logr.Logger, nodeName string) (bool, error) {
+	node := &corev1.Node{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+		return false, fmt.Errorf("unable to fetch node %q: %w", nodeName, err)
+	}
+
+	startTime, err := time.Parse(time.RFC3339, node.Annotations[nodeagentv1alpha1.AnnotationKeyDrainStartTime])
+	if err != nil || !node.Spec.Unschedulable {
+		startTime = r.Clock.Now().UTC()
+
+		patch := client.MergeFrom(node.DeepCopy())
+		if node.Spec.Unschedulable {
+			log.Info("Node has already been cordoned, draining it")
+		} else {
+			log.Info("Cordoning node before draining it")
+			node.Spec.Unschedulable = true
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentv1alpha1.AnnotationKeyCordonedForDrain, "true")
+		}
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentv1alpha1.AnnotationKeyDrainStartTime, startTime.Format(time.RFC3339))
+		delete(node.Annotations, nodeagentv1alpha1.AnnotationKeyDrained)
+		if err := r.Client.Patch(ctx, node, patch); err != nil {
+			return false, fmt.Errorf("failed cordoning node %q: %w", nodeName, err)
+		}
+	}
+
+	if node.Annotations[nodeagentv1alpha1.AnnotationKeyDrained] == "true" {
+		log.Info("Node has been drained successfully")
+		return true, nil
+	}
+
+	if timeout := r.drainTimeout(); r.Clock.Now().After(startTime.Add(timeout)) {
+		log.Info("Drain timeout expired, continuing with remaining pods on the node", "timeout", timeout)
+		return true, nil
+	}
+
+	log.Info("Waiting for pods to be evicted from the node")
+	return false, nil
+}
+
+// uncordonNodeIfReady uncordons the node as soon as it is ready again if it has been cordoned by drainNode before.
+// Nodes which have been cordoned by somebody else stay cordoned.
+func (r *Reconciler) uncordonNodeIfReady(ctx context.Context, log logr.Logger, nodeName string) (reconcile.Result, error) {
+	node := &corev1.Node{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+		return reconcile.Result{}, fmt.Errorf("unable to fetch node %q: %w", nodeName, err)
+	}
+
+	if _, ok := node.Annotations[nodeagentv1alpha1.AnnotationKeyDrainStartTime]; !ok {
+		return reconcile.Result{}, nil
+	}
+
+	if !nodeReady(node) {
+		log.Info("Node is not ready yet, keeping it cordoned")
+		return reconcile.Result{RequeueAfter: rolloutRequeueInterval}, nil
+	}
+
+	cordonedForDrain := node.Annotations[nodeagentv1alpha1.AnnotationKeyCordonedForDrain] == "true"
+
+	patch := client.MergeFrom(node.DeepCopy())
+	if cordonedForDrain {
+		node.Spec.Unschedulable = false
+	}
+	delete(node.Annotations, nodeagentv1alpha1.AnnotationKeyDrainStartTime)
+	delete(node.Annotations, nodeagentv1alpha1.AnnotationKeyDrained)
+	delete(node.Annotations, nodeagentv1alpha1.AnnotationKeyCordonedForDrain)
+	if err := r.Client.Patch(ctx, node, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed uncordoning node %q: %w", nodeName, err)
+	}
+
+	if !cordonedForDrain {
+		log.Info("Node is ready again, keeping it cordoned since it has not been cordoned for draining it")
+		return reconcile.Result{}, nil
+	}
+
+	log.Info("Node is ready again, uncordoned node")
+	return reconcile.Result{}, nil
+}
+
+func (r *Reconciler) drainTimeout() time.Duration {
+	if r.Config.Rollout.Drain.Timeout != nil {
+		return r.Config.Rollout.Drain.Timeout.Duration
+	}
+	return defaultDrainTimeout
+}
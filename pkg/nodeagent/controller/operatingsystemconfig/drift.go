@@ -0,0 +1,188 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatingsystemconfig
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	extensionsv1alpha1helper "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1/helper"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+// maxDriftedPathsInConditionMessage is the maximum number of drifted paths listed in the message of the
+// OperatingSystemConfigDrifted condition.
+const maxDriftedPathsInConditionMessage = 10
+
+// detectDrift compares the files and units on the disk with the last applied operating system config and maintains
+// the OperatingSystemConfigDrifted condition on the node. Drifted files whose drift policy is 'Restore' are restored
+// to their desired state.
+func (r *Reconciler) detectDrift(ctx context.Context, log logr.Logger, node *metav1.PartialObjectMetadata) error {
+	if node == nil {
+		return nil
+	}
+
+	osc, err := readLastAppliedOperatingSystemConfig(r.FS)
+	if err != nil || osc == nil {
+		return err
+	}
+
+	var (
+		driftedPaths   []string
+		filesToRestore []extensionsv1alpha1.File
+	)
+	for _, file := range collectAllFiles(osc) {
+		drifted, err := r.fileDrifted(file)
+		if err != nil {
+			return err
+		}
+		if !drifted {
+			continue
+		}
+
+		if ptr.Deref(file.DriftPolicy, extensionsv1alpha1.FileDriftPolicyReport) == extensionsv1alpha1.FileDriftPolicyRestore {
+			filesToRestore = append(filesToRestore, file)
+			continue
+		}
+		driftedPaths = append(driftedPaths, file.Path)
+	}
+
+	if len(filesToRestore) > 0 {
+		log.Info("Restoring drifted files", "count", len(filesToRestore))
+		if err := r.applyChangedFiles(ctx, log, filesToRestore); err != nil {
+			return fmt.Errorf("failed restoring drifted files: %w", err)
+		}
+
+		restoredPaths := make([]string, 0, len(filesToRestore))
+		for _, file := range filesToRestore {
+			restoredPaths = append(restoredPaths, file.Path)
+		}
+		r.Recorder.Event(node, corev1.EventTypeNormal, "OSCDriftRestored", "Restored drifted files: "+strings.Join(restoredPaths, ", "))
+	}
+
+	driftedUnitPaths, err := r.driftedUnitPaths(mergeUnits(osc.Spec.Units, osc.Status.ExtensionUnits))
+	if err != nil {
+		return err
+	}
+	driftedPaths = append(driftedPaths, driftedUnitPaths...)
+
+	if len(driftedPaths) == 0 {
+		return r.updateNodeCondition(ctx, node.Name, nodeagentv1alpha1.ConditionTypeOperatingSystemConfigDrifted, corev1.ConditionFalse,
+			nodeagentv1alpha1.ConditionReasonNoDriftDetected, "Files and units on the node match the last applied operating system config")
+	}
+
+	log.Info("Detected drift of files or units from the last applied operating system config", "paths", driftedPaths)
+	return r.updateNodeCondition(ctx, node.Name, nodeagentv1alpha1.ConditionTypeOperatingSystemConfigDrifted, corev1.ConditionTrue,
+		nodeagentv1alpha1.ConditionReasonDriftDetected, driftMessage(driftedPaths))
+}
+
+// fileDrifted returns true if the file is missing on the disk or if its permissions or (in case of inline content) its
+// content differ from the desired state. The content of files extracted from images is not compared.
+func (r *Reconciler) fileDrifted(file extensionsv1alpha1.File) (bool, error) {
+	permissions := defaultFilePermissions
+	if file.Permissions != nil {
+		permissions = fs.FileMode(*file.Permissions)
+	}
+
+	var content []byte
+	if file.Content.Inline != nil {
+		data, err := extensionsv1alpha1helper.Decode(file.Content.Inline.Encoding, []byte(file.Content.Inline.Data))
+		if err != nil {
+			return false, fmt.Errorf("unable to decode data of file %q: %w", file.Path, err)
+		}
+		content = data
+	}
+
+	return r.pathDrifted(file.Path, content, permissions)
+}
+
+func (r *Reconciler) driftedUnitPaths(units []extensionsv1alpha1.Unit) ([]string, error) {
+	var paths []string
+
+	for _, unit := range units {
+		unitFilePath := path.Join(etcSystemdSystem, unit.Name)
+
+		if unit.Content != nil {
+			drifted, err := r.pathDrifted(unitFilePath, []byte(*unit.Content), defaultFilePermissions)
+			if err != nil {
+				return nil, err
+			}
+			if drifted {
+				paths = append(paths, unitFilePath)
+			}
+		}
+
+		for _, dropIn := range unit.DropIns {
+			dropInFilePath := path.Join(unitFilePath+".d", dropIn.Name)
+
+			drifted, err := r.pathDrifted(dropInFilePath, []byte(dropIn.Content), defaultFilePermissions)
+			if err != nil {
+				return nil, err
+			}
+			if drifted {
+				paths = append(paths, dropInFilePath)
+			}
+		}
+	}
+
+	return paths, nil
+}
+
+// pathDrifted returns true if the file with the given path does not exist or if its permissions differ from the given
+// permissions. The content is only compared if the given content is not nil.
+func (r *Reconciler) pathDrifted(filePath string, content []byte, permissions fs.FileMode) (bool, error) {
+	info, err := r.FS.Stat(filePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return true, nil
+		}
+		return false, fmt.Errorf("unable to stat file %q: %w", filePath, err)
+	}
+
+	if info.Mode().Perm() != permissions.Perm() {
+		return true, nil
+	}
+
+	if content == nil {
+		return false, nil
+	}
+
+	actualContent, err := r.FS.ReadFile(filePath)
+	if err != nil {
+		return false, fmt.Errorf("unable to read file %q: %w", filePath, err)
+	}
+
+	return !bytes.Equal(actualContent, content), nil
+}
+
+func driftMessage(paths []string) string {
+	message := "Files or units on the node drifted from the last applied operating system config: "
+	if len(paths) <= maxDriftedPathsInConditionMessage {
+		return message + strings.Join(paths, ", ")
+	}
+	return message + fmt.Sprintf("%s and %d more", strings.Join(paths[:maxDriftedPathsInConditionMessage], ", "), len(paths)-maxDriftedPathsInConditionMessage)
+}
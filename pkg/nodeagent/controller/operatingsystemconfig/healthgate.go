@@ -0,0 +1,123 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	retryutils "github.com/gardener/gardener/pkg/utils/retry"
+)
+
+const (
+	// DefaultKubeletHealthEndpoint is the default health endpoint of the kubelet which is checked by the health gate.
+	DefaultKubeletHealthEndpoint = "http://127.0.0.1:10248/healthz"
+
+	defaultHealthGateTimeout  = 2 * time.Minute
+	healthGateRetryInterval   = 5 * time.Second
+	unitActiveStateActive     = "active"
+	unitActiveStateFailed     = "failed"
+	unitActiveStateActivating = "activating"
+)
+
+// waitForHealthGate waits until the health gate passes, i.e. until all units configured in the health gate are active,
+// none of the changed units which are expected to be running has failed or is still activating, and (if configured)
+// the kubelet reports healthy.
+func (r *Reconciler) waitForHealthGate(ctx context.Context, changes *operatingSystemConfigChanges) error {
+	var (
+		requiredActiveUnits = sets.New(r.Config.HealthGate.Units...)
+		changedUnits        = sets.New[string]()
+		timeout             = defaultHealthGateTimeout
+	)
+
+	for _, unit := range changes.units.changed {
+		if unit.Name == nodeagentv1alpha1.UnitName || unit.Name == nodeagentv1alpha1.InitUnitName {
+			continue
+		}
+
+		if !ptr.Deref(unit.Enable, true) || (unit.Command != nil && *unit.Command == extensionsv1alpha1.CommandStop) {
+			continue
+		}
+
+		changedUnits.Insert(unit.Name)
+	}
+
+	if r.Config.HealthGate.Timeout != nil {
+		timeout = r.Config.HealthGate.Timeout.Duration
+	}
+
+	return retryutils.UntilTimeout(ctx, healthGateRetryInterval, timeout, func(ctx context.Context) (bool, error) {
+		for _, unitName := range sets.List(requiredActiveUnits) {
+			activeState, err := r.DBus.ActiveState(ctx, unitName)
+			if err != nil {
+				return retryutils.MinorError(err)
+			}
+
+			if activeState != unitActiveStateActive {
+				return retryutils.MinorError(fmt.Errorf("unit %q is not active yet (active state: %s)", unitName, activeState))
+			}
+		}
+
+		// Changed units are not required to be active since they might be oneshot units which become inactive after
+		// they have run successfully.
+		for _, unitName := range sets.List(changedUnits.Difference(requiredActiveUnits)) {
+			activeState, err := r.DBus.ActiveState(ctx, unitName)
+			if err != nil {
+				return retryutils.MinorError(err)
+			}
+
+			switch activeState {
+			case unitActiveStateFailed:
+				return retryutils.MinorError(fmt.Errorf("unit %q has failed", unitName))
+			case unitActiveStateActivating:
+				return retryutils.MinorError(fmt.Errorf("unit %q is still activating", unitName))
+			}
+		}
+
+		if ptr.Deref(r.Config.HealthGate.KubeletHealthy, true) {
+			if err := r.checkKubeletHealth(ctx); err != nil {
+				return retryutils.MinorError(err)
+			}
+		}
+
+		return retryutils.Ok()
+	})
+}
+
+func (r *Reconciler) checkKubeletHealth(ctx context.Context) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, r.KubeletHealthEndpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed creating request to kubelet health endpoint: %w", err)
+	}
+
+	response, err := (&http.Client{Timeout: 10 * time.Second}).Do(request)
+	if err != nil {
+		return fmt.Errorf("failed checking kubelet health: %w", err)
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return fmt.Errorf("kubelet is not healthy yet (status code: %d)", response.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,273 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatingsystemconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+// SystemdAction is an action the controller performs for a systemd unit.
+type SystemdAction string
+
+const (
+	// SystemdActionEnable means that the unit is enabled.
+	SystemdActionEnable SystemdAction = "enable"
+	// SystemdActionDisable means that the unit is disabled.
+	SystemdActionDisable SystemdAction = "disable"
+	// SystemdActionRestart means that the unit is restarted.
+	SystemdActionRestart SystemdAction = "restart"
+	// SystemdActionStop means that the unit is stopped.
+	SystemdActionStop SystemdAction = "stop"
+)
+
+// Plan describes the changes the controller would apply to the node for an OperatingSystemConfig, i.e., the result of
+// comparing it with the last applied OperatingSystemConfig.
+type Plan struct {
+	// ChangedFiles are the paths of new or changed files.
+	ChangedFiles []string `json:"changedFiles,omitempty"`
+	// DeletedFiles are the paths of files which are no longer needed.
+	DeletedFiles []string `json:"deletedFiles,omitempty"`
+	// ChangedUnits are the new or changed units.
+	ChangedUnits []PlannedUnit `json:"changedUnits,omitempty"`
+	// DeletedUnits are the units which are no longer needed.
+	DeletedUnits []PlannedUnit `json:"deletedUnits,omitempty"`
+	// DaemonReload states whether the systemd daemon would be reloaded.
+	DaemonReload bool `json:"daemonReload"`
+	// Disruptive states whether the changes restart or stop units.
+	Disruptive bool `json:"disruptive"`
+}
+
+// PlannedUnit describes the changes for a systemd unit.
+type PlannedUnit struct {
+	// Name is the name of the unit.
+	Name string `json:"name"`
+	// ChangedDropIns are the names of new or changed drop-in files.
+	ChangedDropIns []string `json:"changedDropIns,omitempty"`
+	// DeletedDropIns are the names of drop-in files which are no longer needed.
+	DeletedDropIns []string `json:"deletedDropIns,omitempty"`
+	// Actions are the systemd actions performed for the unit (in this order).
+	Actions []SystemdAction `json:"actions,omitempty"`
+}
+
+// DecodeOperatingSystemConfig decodes an OperatingSystemConfig. The data can either be the OperatingSystemConfig
+// itself or the Secret (as used by the controller) containing it.
+func DecodeOperatingSystemConfig(data []byte) (*extensionsv1alpha1.OperatingSystemConfig, error) {
+	typeMeta := &metav1.TypeMeta{}
+	if err := yaml.Unmarshal(data, typeMeta); err != nil {
+		return nil, fmt.Errorf("unable to decode type meta: %w", err)
+	}
+
+	if typeMeta.Kind == "Secret" {
+		secret := &corev1.Secret{}
+		if err := yaml.Unmarshal(data, secret); err != nil {
+			return nil, fmt.Errorf("unable to decode secret: %w", err)
+		}
+
+		osc, _, _, err := extractOSCFromSecret(secret)
+		return osc, err
+	}
+
+	osc := &extensionsv1alpha1.OperatingSystemConfig{}
+	if err := runtime.DecodeInto(decoder, data, osc); err != nil {
+		return nil, fmt.Errorf("unable to decode OSC: %w", err)
+	}
+	return osc, nil
+}
+
+// ComputePlan computes the changes the controller would apply to the node for the given OperatingSystemConfig without
+// applying them.
+func ComputePlan(fs afero.Afero, osc *extensionsv1alpha1.OperatingSystemConfig) (*Plan, error) {
+	changes, err := computeOperatingSystemConfigChanges(fs, osc)
+	if err != nil {
+		return nil, fmt.Errorf("failed calculating the OSC changes: %w", err)
+	}
+
+	plan := &Plan{Disruptive: isDisruptive(changes)}
+
+	for _, file := range changes.files.changed {
+		plan.ChangedFiles = append(plan.ChangedFiles, file.Path)
+	}
+	for _, file := range changes.files.deleted {
+		plan.DeletedFiles = append(plan.DeletedFiles, file.Path)
+	}
+
+	for _, unit := range changes.units.changed {
+		plannedUnit := PlannedUnit{Name: unit.Name, Actions: changedUnitActions(unit.Unit)}
+		for _, dropIn := range unit.dropIns.changed {
+			plannedUnit.ChangedDropIns = append(plannedUnit.ChangedDropIns, dropIn.Name)
+		}
+		for _, dropIn := range unit.dropIns.deleted {
+			plannedUnit.DeletedDropIns = append(plannedUnit.DeletedDropIns, dropIn.Name)
+		}
+		plan.ChangedUnits = append(plan.ChangedUnits, plannedUnit)
+	}
+	for _, unit := range changes.units.deleted {
+		plan.DeletedUnits = append(plan.DeletedUnits, PlannedUnit{Name: unit.Name, Actions: []SystemdAction{SystemdActionDisable, SystemdActionStop}})
+	}
+
+	// The systemd daemon is reloaded whenever changes are applied.
+	plan.DaemonReload = !plan.IsEmpty()
+
+	return plan, nil
+}
+
+// changedUnitActions returns the systemd actions which are performed for a new or changed unit.
+func changedUnitActions(unit extensionsv1alpha1.Unit) []SystemdAction {
+	return []SystemdAction{enablementAction(unit), commandAction(unit)}
+}
+
+// enablementAction returns whether a new or changed unit is enabled or disabled.
+func enablementAction(unit extensionsv1alpha1.Unit) SystemdAction {
+	// TODO(rfranzke): Remove this when UseGardenerNodeAgent feature gate gets removed.
+	// Never enable `gardener-node-init.service` to avoid undesired restarts of `gardener-node-agent`
+	if unit.Name == nodeagentv1alpha1.InitUnitName {
+		return SystemdActionDisable
+	}
+
+	if unit.Name == nodeagentv1alpha1.UnitName || ptr.Deref(unit.Enable, true) {
+		return SystemdActionEnable
+	}
+	return SystemdActionDisable
+}
+
+// commandAction returns whether a new or changed unit is restarted or stopped.
+func commandAction(unit extensionsv1alpha1.Unit) SystemdAction {
+	// TODO(rfranzke): Remove this when UseGardenerNodeAgent feature gate gets removed.
+	// Never start `gardener-node-init.service` to avoid undesired restarts of `gardener-node-agent`
+	if unit.Name == nodeagentv1alpha1.InitUnitName {
+		return SystemdActionStop
+	}
+
+	if !ptr.Deref(unit.Enable, true) || (unit.Command != nil && *unit.Command == extensionsv1alpha1.CommandStop) {
+		return SystemdActionStop
+	}
+	return SystemdActionRestart
+}
+
+// IsEmpty returns true if the plan does not contain any changes.
+func (p *Plan) IsEmpty() bool {
+	return len(p.ChangedFiles) == 0 && len(p.DeletedFiles) == 0 && len(p.ChangedUnits) == 0 && len(p.DeletedUnits) == 0
+}
+
+// Print writes a human-readable representation of the plan to the given writer.
+func (p *Plan) Print(w io.Writer) error {
+	var b strings.Builder
+
+	if p.IsEmpty() {
+		b.WriteString("No changes, the operating system config is up to date.\n")
+		_, err := io.WriteString(w, b.String())
+		return err
+	}
+
+	for _, file := range p.ChangedFiles {
+		fmt.Fprintf(&b, "~ file %s\n", file)
+	}
+	for _, file := range p.DeletedFiles {
+		fmt.Fprintf(&b, "- file %s\n", file)
+	}
+	for _, unit := range p.ChangedUnits {
+		fmt.Fprintf(&b, "~ unit %s (%s)\n", unit.Name, joinActions(unit.Actions))
+		for _, dropIn := range unit.ChangedDropIns {
+			fmt.Fprintf(&b, "    ~ drop-in %s\n", path.Join(etcSystemdSystem, unit.Name+".d", dropIn))
+		}
+		for _, dropIn := range unit.DeletedDropIns {
+			fmt.Fprintf(&b, "    - drop-in %s\n", path.Join(etcSystemdSystem, unit.Name+".d", dropIn))
+		}
+	}
+	for _, unit := range p.DeletedUnits {
+		fmt.Fprintf(&b, "- unit %s (%s)\n", unit.Name, joinActions(unit.Actions))
+	}
+
+	fmt.Fprintf(&b, "\nFiles: %d changed, %d deleted. Units: %d changed, %d deleted.\n", len(p.ChangedFiles), len(p.DeletedFiles), len(p.ChangedUnits), len(p.DeletedUnits))
+	if p.DaemonReload {
+		b.WriteString("The systemd daemon would be reloaded.\n")
+	}
+	if p.Disruptive {
+		b.WriteString("The changes are disruptive (units are restarted or stopped).\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+func joinActions(actions []SystemdAction) string {
+	out := make([]string, 0, len(actions))
+	for _, action := range actions {
+		out = append(out, string(action))
+	}
+	return strings.Join(out, ", ")
+}
+
+// PlanHandler is an http.Handler serving the plan for the OperatingSystemConfig currently stored in the secret read by
+// the controller. The plan is rendered as JSON, or in a human-readable format if the query parameter `format=text` is
+// set.
+type PlanHandler struct {
+	// Reader is used for reading the secret containing the OperatingSystemConfig.
+	Reader client.Reader
+	// FS is the file system the last applied OperatingSystemConfig is read from.
+	FS afero.Afero
+	// SecretName is the name of the secret containing the OperatingSystemConfig.
+	SecretName string
+}
+
+func (h *PlanHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if h.Reader == nil {
+		http.Error(w, "not ready yet", http.StatusServiceUnavailable)
+		return
+	}
+
+	secret := &corev1.Secret{}
+	if err := h.Reader.Get(req.Context(), client.ObjectKey{Name: h.SecretName, Namespace: metav1.NamespaceSystem}, secret); err != nil {
+		http.Error(w, fmt.Sprintf("failed reading secret %s: %v", h.SecretName, err), http.StatusInternalServerError)
+		return
+	}
+
+	osc, _, _, err := extractOSCFromSecret(secret)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed extracting OSC from secret: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	plan, err := ComputePlan(h.FS, osc)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if req.URL.Query().Get("format") == "text" {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_ = plan.Print(w)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(plan)
+}
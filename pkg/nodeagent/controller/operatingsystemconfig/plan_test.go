@@ -0,0 +1,235 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatingsystemconfig_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/yaml"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	. "github.com/gardener/gardener/pkg/nodeagent/controller/operatingsystemconfig"
+)
+
+var _ = Describe("Plan", func() {
+	var (
+		fs     afero.Afero
+		oldOSC *extensionsv1alpha1.OperatingSystemConfig
+		newOSC *extensionsv1alpha1.OperatingSystemConfig
+	)
+
+	BeforeEach(func() {
+		fs = afero.Afero{Fs: afero.NewMemMapFs()}
+
+		oldOSC = &extensionsv1alpha1.OperatingSystemConfig{
+			TypeMeta: metav1.TypeMeta{APIVersion: extensionsv1alpha1.SchemeGroupVersion.String(), Kind: "OperatingSystemConfig"},
+			Spec: extensionsv1alpha1.OperatingSystemConfigSpec{
+				Files: []extensionsv1alpha1.File{
+					{Path: "/etc/foo", Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "foo"}}},
+					{Path: "/etc/bar", Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "bar"}}},
+				},
+				Units: []extensionsv1alpha1.Unit{
+					{Name: "foo.service", Content: ptr.To("[Unit]\nDescription=foo"), FilePaths: []string{"/etc/foo"}},
+					{Name: "bar.service", Content: ptr.To("[Unit]\nDescription=bar")},
+				},
+			},
+		}
+
+		newOSC = oldOSC.DeepCopy()
+		newOSC.Spec.Files = []extensionsv1alpha1.File{
+			{Path: "/etc/foo", Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "foo-changed"}}},
+			{Path: "/etc/baz", Content: extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Data: "baz"}}},
+		}
+		newOSC.Spec.Units = []extensionsv1alpha1.Unit{
+			{Name: "foo.service", Content: ptr.To("[Unit]\nDescription=foo"), FilePaths: []string{"/etc/foo"}, DropIns: []extensionsv1alpha1.DropIn{{Name: "override.conf", Content: "[Service]"}}},
+			{Name: "baz.service", Command: ptr.To(extensionsv1alpha1.CommandStop), Content: ptr.To("[Unit]\nDescription=baz")},
+		}
+	})
+
+	writeLastApplied := func() {
+		oldOSCRaw, err := yaml.Marshal(oldOSC)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(fs.WriteFile(nodeagentv1alpha1.BaseDir+"/last-applied-osc.yaml", oldOSCRaw, 0644)).To(Succeed())
+	}
+
+	Describe("#ComputePlan", func() {
+		It("should plan to apply everything if no OSC has been applied yet", func() {
+			plan, err := ComputePlan(fs, oldOSC)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(plan).To(Equal(&Plan{
+				ChangedFiles: []string{"/etc/foo", "/etc/bar"},
+				ChangedUnits: []PlannedUnit{
+					{Name: "foo.service", Actions: []SystemdAction{SystemdActionEnable, SystemdActionRestart}},
+					{Name: "bar.service", Actions: []SystemdAction{SystemdActionEnable, SystemdActionRestart}},
+				},
+				DaemonReload: true,
+				Disruptive:   true,
+			}))
+		})
+
+		It("should compute the changes compared to the last applied OSC", func() {
+			writeLastApplied()
+
+			plan, err := ComputePlan(fs, newOSC)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(plan).To(Equal(&Plan{
+				ChangedFiles: []string{"/etc/foo", "/etc/baz"},
+				DeletedFiles: []string{"/etc/bar"},
+				ChangedUnits: []PlannedUnit{
+					{Name: "foo.service", ChangedDropIns: []string{"override.conf"}, Actions: []SystemdAction{SystemdActionEnable, SystemdActionRestart}},
+					{Name: "baz.service", Actions: []SystemdAction{SystemdActionEnable, SystemdActionStop}},
+				},
+				DeletedUnits: []PlannedUnit{
+					{Name: "bar.service", Actions: []SystemdAction{SystemdActionDisable, SystemdActionStop}},
+				},
+				DaemonReload: true,
+				Disruptive:   true,
+			}))
+		})
+
+		It("should return an empty plan if nothing has changed", func() {
+			writeLastApplied()
+
+			plan, err := ComputePlan(fs, oldOSC)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(plan.IsEmpty()).To(BeTrue())
+			Expect(plan.DaemonReload).To(BeFalse())
+		})
+	})
+
+	Describe("#DecodeOperatingSystemConfig", func() {
+		It("should decode an OSC", func() {
+			data, err := yaml.Marshal(newOSC)
+			Expect(err).NotTo(HaveOccurred())
+
+			osc, err := DecodeOperatingSystemConfig(data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(osc.Spec).To(Equal(newOSC.Spec))
+		})
+
+		It("should decode the OSC from a secret", func() {
+			oscRaw, err := yaml.Marshal(newOSC)
+			Expect(err).NotTo(HaveOccurred())
+			data, err := yaml.Marshal(&corev1.Secret{
+				TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+				Data:     map[string][]byte{nodeagentv1alpha1.DataKeyOperatingSystemConfig: oscRaw},
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			osc, err := DecodeOperatingSystemConfig(data)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(osc.Spec).To(Equal(newOSC.Spec))
+		})
+
+		It("should fail if the secret does not contain an OSC", func() {
+			data, err := yaml.Marshal(&corev1.Secret{TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"}})
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(DecodeOperatingSystemConfig(data)).Error().To(MatchError(ContainSubstring("no osc.yaml key found")))
+		})
+	})
+
+	Describe("#Print", func() {
+		It("should print a human-readable representation of the plan", func() {
+			writeLastApplied()
+			plan, err := ComputePlan(fs, newOSC)
+			Expect(err).NotTo(HaveOccurred())
+
+			var out bytes.Buffer
+			Expect(plan.Print(&out)).To(Succeed())
+			Expect(out.String()).To(Equal(`~ file /etc/foo
+~ file /etc/baz
+- file /etc/bar
+~ unit foo.service (enable, restart)
+    ~ drop-in /etc/systemd/system/foo.service.d/override.conf
+~ unit baz.service (enable, stop)
+- unit bar.service (disable, stop)
+
+Files: 2 changed, 1 deleted. Units: 2 changed, 1 deleted.
+The systemd daemon would be reloaded.
+The changes are disruptive (units are restarted or stopped).
+`))
+		})
+
+		It("should print that there are no changes", func() {
+			var out bytes.Buffer
+			Expect((&Plan{}).Print(&out)).To(Succeed())
+			Expect(out.String()).To(Equal("No changes, the operating system config is up to date.\n"))
+		})
+	})
+
+	Describe("PlanHandler", func() {
+		var handler *PlanHandler
+
+		BeforeEach(func() {
+			oscRaw, err := yaml.Marshal(newOSC)
+			Expect(err).NotTo(HaveOccurred())
+
+			handler = &PlanHandler{
+				Reader: fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).WithObjects(&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "osc-secret", Namespace: metav1.NamespaceSystem},
+					Data:       map[string][]byte{nodeagentv1alpha1.DataKeyOperatingSystemConfig: oscRaw},
+				}).Build(),
+				FS:         fs,
+				SecretName: "osc-secret",
+			}
+			writeLastApplied()
+		})
+
+		It("should serve the plan as JSON", func() {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/operatingsystemconfig/diff", nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Header().Get("Content-Type")).To(Equal("application/json"))
+
+			plan := &Plan{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), plan)).To(Succeed())
+			Expect(plan.ChangedFiles).To(ConsistOf("/etc/foo", "/etc/baz"))
+			Expect(plan.DeletedUnits).To(ConsistOf(PlannedUnit{Name: "bar.service", Actions: []SystemdAction{SystemdActionDisable, SystemdActionStop}}))
+		})
+
+		It("should serve the plan in text format", func() {
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/operatingsystemconfig/diff?format=text", nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+			Expect(recorder.Body.String()).To(ContainSubstring("- unit bar.service (disable, stop)"))
+		})
+
+		It("should fail if the secret does not exist", func() {
+			handler.SecretName = "does-not-exist"
+
+			recorder := httptest.NewRecorder()
+			handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/debug/operatingsystemconfig/diff", nil))
+
+			Expect(recorder.Code).To(Equal(http.StatusInternalServerError))
+		})
+	})
+})
@@ -32,7 +32,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/tools/record"
-	"k8s.io/utils/ptr"
+	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
@@ -47,6 +47,7 @@ import (
 	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
 	"github.com/gardener/gardener/pkg/nodeagent/dbus"
 	"github.com/gardener/gardener/pkg/nodeagent/registry"
+	"github.com/gardener/gardener/pkg/nodeagent/registry/verification"
 	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
@@ -56,14 +57,18 @@ const lastAppliedOperatingSystemConfigFilePath = nodeagentv1alpha1.BaseDir + "/l
 // node.
 type Reconciler struct {
 	Client        client.Client
+	APIReader     client.Reader
 	Config        config.OperatingSystemConfigControllerConfig
 	Recorder      record.EventRecorder
 	DBus          dbus.DBus
 	FS            afero.Afero
 	Extractor     registry.Extractor
 	CancelContext context.CancelFunc
+	Clock         clock.Clock
 	HostName      string
 	NodeName      string
+	// KubeletHealthEndpoint is the endpoint used by the health gate for checking the health of the kubelet.
+	KubeletHealthEndpoint string
 }
 
 // Reconcile decodes the OperatingSystemConfig resources from secrets and applies the systemd units and files to the
@@ -71,7 +76,12 @@ type Reconciler struct {
 func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
 	log := logf.FromContext(ctx)
 
-	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, controllerutils.DefaultReconciliationTimeout)
+	reconciliationTimeout := controllerutils.DefaultReconciliationTimeout
+	if r.Config.HealthGate != nil && r.Config.HealthGate.Timeout != nil {
+		reconciliationTimeout += r.Config.HealthGate.Timeout.Duration
+	}
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(ctx, reconciliationTimeout)
 	defer cancel()
 
 	secret := &corev1.Secret{}
@@ -99,39 +109,90 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	if node != nil && node.Annotations[nodeagentv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig] == oscChecksum {
-		log.Info("Configuration on this node is up to date, nothing to be done")
-		return reconcile.Result{}, nil
-	}
+		log.Info("Configuration on this node is up to date, checking for drift")
+		if err := r.detectDrift(ctx, log, node); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed detecting drift: %w", err)
+		}
 
-	log.Info("Applying new or changed files")
-	if err := r.applyChangedFiles(ctx, log, oscChanges.files.changed); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed applying changed files: %w", err)
-	}
+		if result, err := r.releaseRolloutSlotIfReady(ctx, log, node); err != nil || result.RequeueAfter > 0 {
+			return result, err
+		}
 
-	log.Info("Applying new or changed units")
-	if err := r.applyChangedUnits(ctx, log, oscChanges.units.changed); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed applying changed units: %w", err)
+		return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
 	}
 
-	log.Info("Removing no longer needed units")
-	if err := r.removeDeletedUnits(ctx, log, node, oscChanges.units.deleted); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed removing deleted units: %w", err)
+	if node != nil && node.Annotations[nodeagentv1alpha1.AnnotationKeyChecksumRolledBackOperatingSystemConfig] == oscChecksum {
+		retryAfter, retry := r.rollbackRetryAfter(node)
+		if !retry {
+			log.Info("Configuration has been rolled back too often after its apply failed, skipping it until it changes")
+			if result, err := r.releaseRolloutSlotIfReady(ctx, log, node); err != nil || result.RequeueAfter > 0 {
+				return result, err
+			}
+			return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+		}
+
+		if retryAfter > 0 {
+			log.Info("Configuration has been rolled back after its apply failed, waiting before applying it again", "retryAfter", retryAfter)
+			if result, err := r.releaseRolloutSlotIfReady(ctx, log, node); err != nil || result.RequeueAfter > 0 {
+				return result, err
+			}
+			return reconcile.Result{RequeueAfter: retryAfter}, nil
+		}
+
+		log.Info("Applying configuration again which has been rolled back after its apply failed", "rollbacks", rollbackCount(node))
 	}
 
-	log.Info("Reloading systemd daemon")
-	if err := r.DBus.DaemonReload(ctx); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed reloading systemd daemon: %w", err)
+	if r.Config.Rollout != nil && node != nil && isDisruptive(oscChanges) {
+		log.Info("Changes are disruptive, acquiring rollout slot of worker pool")
+		acquired, err := r.acquireRolloutSlot(ctx, log, node)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed acquiring rollout slot: %w", err)
+		}
+		if !acquired {
+			log.Info("Waiting for a free rollout slot, requeuing", "requeueAfter", rolloutRequeueInterval)
+			return reconcile.Result{RequeueAfter: rolloutRequeueInterval}, nil
+		}
+
+		if r.Config.Rollout.Drain != nil {
+			drained, err := r.drainNode(ctx, log, node.Name)
+			if err != nil {
+				return reconcile.Result{}, fmt.Errorf("failed draining node: %w", err)
+			}
+			if !drained {
+				log.Info("Waiting for node to be drained, requeuing", "requeueAfter", rolloutRequeueInterval)
+				return reconcile.Result{RequeueAfter: rolloutRequeueInterval}, nil
+			}
+		}
 	}
 
-	log.Info("Executing unit commands (start/stop)")
-	mustRestartGardenerNodeAgent, err := r.executeUnitCommands(ctx, log, node, oscChanges.units.changed)
-	if err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed executing unit commands: %w", err)
+	var snapshot *oscSnapshot
+	if r.Config.HealthGate != nil {
+		log.Info("Taking snapshot of files and units touched by the changes")
+		snapshot, err = r.takeSnapshot(oscChanges)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed taking snapshot of files and units: %w", err)
+		}
 	}
 
-	log.Info("Removing no longer needed files")
-	if err := r.removeDeletedFiles(log, oscChanges.files.deleted); err != nil {
-		return reconcile.Result{}, fmt.Errorf("failed removing deleted files: %w", err)
+	mustRestartGardenerNodeAgent, err := r.applyChanges(ctx, log, node, oscChanges)
+	var verificationErr *verification.Error
+	if errors.As(err, &verificationErr) && node != nil {
+		r.Recorder.Event(node, corev1.EventTypeWarning, "ImageVerificationFailed", verificationErr.Error())
+	}
+	if err == nil && r.Config.HealthGate != nil {
+		log.Info("Waiting for health gate to pass")
+		if err = r.waitForHealthGate(ctx, oscChanges); err != nil {
+			err = fmt.Errorf("health gate failed: %w", err)
+		}
+	}
+	if err != nil {
+		if releaseErr := r.releaseRolloutSlot(ctx, log, node); releaseErr != nil {
+			log.Error(releaseErr, "Failed releasing rollout slot after applying the changes failed")
+		}
+		if r.Config.HealthGate == nil {
+			return reconcile.Result{}, err
+		}
+		return reconcile.Result{}, r.rollback(ctx, log, node, snapshot, oscChecksum, err)
 	}
 
 	log.Info("Successfully applied operating system config",
@@ -141,6 +202,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		"deletedUnits", len(oscChanges.units.deleted),
 	)
 
+	if r.Config.Rollout != nil && node != nil {
+		if err := r.markRolloutSlotApplied(ctx, node); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed recording apply time on rollout slot: %w", err)
+		}
+	}
+
 	log.Info("Persisting current operating system config as 'last-applied' file to the disk", "path", lastAppliedOperatingSystemConfigFilePath)
 	if err := r.FS.WriteFile(lastAppliedOperatingSystemConfigFilePath, oscRaw, 0644); err != nil {
 		return reconcile.Result{}, fmt.Errorf("unable to write current OSC to file path %q: %w", lastAppliedOperatingSystemConfigFilePath, err)
@@ -166,14 +233,70 @@ func (r *Reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	}
 
 	r.Recorder.Event(node, corev1.EventTypeNormal, "OSCApplied", "Operating system config has been applied successfully")
+	if r.Config.HealthGate != nil {
+		if err := r.updateNodeCondition(ctx, node.Name, nodeagentv1alpha1.ConditionTypeOperatingSystemConfigApplied, corev1.ConditionTrue, nodeagentv1alpha1.ConditionReasonApplied, "Operating system config has been applied successfully"); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed updating node condition: %w", err)
+		}
+	}
+
 	patch := client.MergeFrom(node.DeepCopy())
 	metav1.SetMetaDataLabel(&node.ObjectMeta, v1beta1constants.LabelWorkerKubernetesVersion, r.Config.KubernetesVersion.String())
 	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentv1alpha1.AnnotationKeyChecksumAppliedOperatingSystemConfig, oscChecksum)
+	delete(node.Annotations, nodeagentv1alpha1.AnnotationKeyChecksumRolledBackOperatingSystemConfig)
+	delete(node.Annotations, nodeagentv1alpha1.AnnotationKeyRollbacksOperatingSystemConfig)
+	delete(node.Annotations, nodeagentv1alpha1.AnnotationKeyLastRollbackTimeOperatingSystemConfig)
 
 	// TODO(rfranzke): Remove this after v1.90 has been released.
 	delete(node.Annotations, v1beta1constants.LabelWorkerKubernetesVersion)
 
-	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, r.Client.Patch(ctx, node, patch)
+	if err := r.Client.Patch(ctx, node, patch); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed patching node: %w", err)
+	}
+
+	if err := r.detectDrift(ctx, log, node); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed detecting drift: %w", err)
+	}
+
+	if result, err := r.releaseRolloutSlotIfReady(ctx, log, node); err != nil || result.RequeueAfter > 0 {
+		return result, err
+	}
+
+	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
+}
+
+func (r *Reconciler) applyChanges(ctx context.Context, log logr.Logger, node client.Object, oscChanges *operatingSystemConfigChanges) (bool, error) {
+	log.Info("Applying new or changed files")
+	if err := r.applyChangedFiles(ctx, log, oscChanges.files.changed); err != nil {
+		return false, fmt.Errorf("failed applying changed files: %w", err)
+	}
+
+	log.Info("Applying new or changed units")
+	if err := r.applyChangedUnits(ctx, log, oscChanges.units.changed); err != nil {
+		return false, fmt.Errorf("failed applying changed units: %w", err)
+	}
+
+	log.Info("Removing no longer needed units")
+	if err := r.removeDeletedUnits(ctx, log, node, oscChanges.units.deleted); err != nil {
+		return false, fmt.Errorf("failed removing deleted units: %w", err)
+	}
+
+	log.Info("Reloading systemd daemon")
+	if err := r.DBus.DaemonReload(ctx); err != nil {
+		return false, fmt.Errorf("failed reloading systemd daemon: %w", err)
+	}
+
+	log.Info("Executing unit commands (start/stop)")
+	mustRestartGardenerNodeAgent, err := r.executeUnitCommands(ctx, log, node, oscChanges.units.changed)
+	if err != nil {
+		return false, fmt.Errorf("failed executing unit commands: %w", err)
+	}
+
+	log.Info("Removing no longer needed files")
+	if err := r.removeDeletedFiles(log, oscChanges.files.deleted); err != nil {
+		return false, fmt.Errorf("failed removing deleted files: %w", err)
+	}
+
+	return mustRestartGardenerNodeAgent, nil
 }
 
 func (r *Reconciler) getNode(ctx context.Context) (*metav1.PartialObjectMetadata, error) {
@@ -328,13 +451,7 @@ func (r *Reconciler) applyChangedUnits(ctx context.Context, log logr.Logger, uni
 			}
 		}
 
-		// TODO(rfranzke): Remove this when UseGardenerNodeAgent feature gate gets removed.
-		// Never enable `gardener-node-init.service` to avoid undesired restarts of `gardener-node-agent`
-		if unit.Name == nodeagentv1alpha1.InitUnitName {
-			unit.Enable = ptr.To(false)
-		}
-
-		if unit.Name == nodeagentv1alpha1.UnitName || ptr.Deref(unit.Enable, true) {
+		if enablementAction(unit.Unit) == SystemdActionEnable {
 			if err := r.DBus.Enable(ctx, unit.Name); err != nil {
 				return fmt.Errorf("unable to enable unit %q: %w", unit.Name, err)
 			}
@@ -392,19 +509,13 @@ func (r *Reconciler) executeUnitCommands(ctx context.Context, log logr.Logger, n
 	for _, u := range units {
 		unit := u
 
-		// TODO(rfranzke): Remove this when UseGardenerNodeAgent feature gate gets removed.
-		// Never start `gardener-node-init.service` to avoid undesired restarts of `gardener-node-agent`
-		if unit.Name == nodeagentv1alpha1.InitUnitName {
-			unit.Enable = ptr.To(false)
-		}
-
 		if unit.Name == nodeagentv1alpha1.UnitName {
 			mustRestartGardenerNodeAgent = true
 			continue
 		}
 
 		fns = append(fns, func(ctx context.Context) error {
-			if !ptr.Deref(unit.Enable, true) || (unit.Command != nil && *unit.Command == extensionsv1alpha1.CommandStop) {
+			if commandAction(unit.Unit) == SystemdActionStop {
 				if err := r.DBus.Stop(ctx, r.Recorder, node, unit.Name); err != nil {
 					return fmt.Errorf("unable to stop unit %q: %w", unit.Name, err)
 				}
@@ -0,0 +1,356 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/afero"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+// oscSnapshot contains the state of the files and units which are touched when applying the changes of an operating
+// system config. It is taken before the changes are applied and used to restore the previously applied operating
+// system config in case the apply or the health gate fails.
+type oscSnapshot struct {
+	files          []fileSnapshot
+	dropInDirs     []string
+	unitNames      []string
+	lastAppliedOSC *extensionsv1alpha1.OperatingSystemConfig
+}
+
+type fileSnapshot struct {
+	path        string
+	exists      bool
+	content     []byte
+	permissions os.FileMode
+}
+
+// takeSnapshot captures the state of all files and units touched by the given changes. It returns nil if there is no
+// previously applied operating system config which could be restored.
+func (r *Reconciler) takeSnapshot(changes *operatingSystemConfigChanges) (*oscSnapshot, error) {
+	lastAppliedOSC, err := readLastAppliedOperatingSystemConfig(r.FS)
+	if err != nil || lastAppliedOSC == nil {
+		return nil, err
+	}
+
+	s := &oscSnapshot{lastAppliedOSC: lastAppliedOSC}
+
+	filePaths := sets.New[string]()
+	for _, file := range append(slices.Clone(changes.files.changed), changes.files.deleted...) {
+		filePaths.Insert(file.Path)
+	}
+
+	unitNames := sets.New[string]()
+	for _, unit := range changes.units.changed {
+		unitNames.Insert(unit.Name)
+	}
+	for _, unit := range changes.units.deleted {
+		unitNames.Insert(unit.Name)
+	}
+
+	for _, unitName := range sets.List(unitNames) {
+		unitFilePath := path.Join(etcSystemdSystem, unitName)
+		filePaths.Insert(unitFilePath)
+
+		dropInDirectory := unitFilePath + ".d"
+		s.dropInDirs = append(s.dropInDirs, dropInDirectory)
+
+		if err := r.FS.Walk(dropInDirectory, func(filePath string, info fs.FileInfo, err error) error {
+			if err != nil {
+				if errors.Is(err, afero.ErrFileNotFound) {
+					return nil
+				}
+				return err
+			}
+			if !info.IsDir() {
+				filePaths.Insert(filePath)
+			}
+			return nil
+		}); err != nil {
+			return nil, fmt.Errorf("unable to walk drop-in directory %q for unit %q: %w", dropInDirectory, unitName, err)
+		}
+	}
+
+	for _, filePath := range sets.List(filePaths) {
+		fileSnapshot, err := r.snapshotFile(filePath)
+		if err != nil {
+			return nil, err
+		}
+		s.files = append(s.files, fileSnapshot)
+	}
+
+	s.unitNames = sets.List(unitNames)
+	return s, nil
+}
+
+func (r *Reconciler) snapshotFile(filePath string) (fileSnapshot, error) {
+	info, err := r.FS.Stat(filePath)
+	if err != nil {
+		if errors.Is(err, afero.ErrFileNotFound) {
+			return fileSnapshot{path: filePath}, nil
+		}
+		return fileSnapshot{}, fmt.Errorf("unable to stat file %q: %w", filePath, err)
+	}
+
+	content, err := r.FS.ReadFile(filePath)
+	if err != nil {
+		return fileSnapshot{}, fmt.Errorf("unable to read file %q: %w", filePath, err)
+	}
+
+	return fileSnapshot{path: filePath, exists: true, content: content, permissions: info.Mode().Perm()}, nil
+}
+
+// restoreSnapshot restores the files and units captured in the given snapshot. Units which were not part of the
+// previously applied operating system config are disabled and stopped, all other units are enabled/disabled and
+// restarted/stopped according to the previously applied operating system config.
+func (r *Reconciler) restoreSnapshot(ctx context.Context, log logr.Logger, node client.Object, s *oscSnapshot) error {
+	var (
+		result      error
+		oldUnits    = mergeUnits(s.lastAppliedOSC.Spec.Units, s.lastAppliedOSC.Status.ExtensionUnits)
+		unitsToStop []string
+		unitsToKeep []extensionsv1alpha1.Unit
+	)
+
+	for _, unitName := range s.unitNames {
+		// gardener-node-agent never restarts itself during a rollback, the restored unit file takes effect with its
+		// next restart.
+		if unitName == nodeagentv1alpha1.UnitName || unitName == nodeagentv1alpha1.InitUnitName {
+			continue
+		}
+
+		if idx := slices.IndexFunc(oldUnits, func(unit extensionsv1alpha1.Unit) bool { return unit.Name == unitName }); idx != -1 {
+			unitsToKeep = append(unitsToKeep, oldUnits[idx])
+		} else {
+			unitsToStop = append(unitsToStop, unitName)
+		}
+	}
+
+	for _, unitName := range unitsToStop {
+		if err := r.DBus.Disable(ctx, unitName); err != nil {
+			result = multierror.Append(result, fmt.Errorf("unable to disable unit %q: %w", unitName, err))
+		}
+		if err := r.DBus.Stop(ctx, r.Recorder, node, unitName); err != nil {
+			result = multierror.Append(result, fmt.Errorf("unable to stop unit %q: %w", unitName, err))
+		}
+		log.Info("Disabled and stopped unit which was not part of the previously applied operating system config", "unitName", unitName)
+	}
+
+	for _, dropInDirectory := range s.dropInDirs {
+		if err := r.FS.RemoveAll(dropInDirectory); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+			result = multierror.Append(result, fmt.Errorf("unable to delete drop-in directory %q: %w", dropInDirectory, err))
+		}
+	}
+
+	for _, file := range s.files {
+		if !file.exists {
+			if err := r.FS.Remove(file.path); err != nil && !errors.Is(err, afero.ErrFileNotFound) {
+				result = multierror.Append(result, fmt.Errorf("unable to delete file %q: %w", file.path, err))
+			}
+			continue
+		}
+
+		if err := r.FS.MkdirAll(filepath.Dir(file.path), fs.ModeDir); err != nil {
+			result = multierror.Append(result, fmt.Errorf("unable to create directory for file %q: %w", file.path, err))
+			continue
+		}
+		if err := r.FS.WriteFile(file.path, file.content, file.permissions); err != nil {
+			result = multierror.Append(result, fmt.Errorf("unable to restore file %q: %w", file.path, err))
+			continue
+		}
+		// ensure file permissions are restored in case the file still existed with different permissions
+		if err := r.FS.Chmod(file.path, file.permissions); err != nil {
+			result = multierror.Append(result, fmt.Errorf("unable to restore permissions of file %q: %w", file.path, err))
+		}
+	}
+	log.Info("Restored files and units of the previously applied operating system config", "files", len(s.files))
+
+	if err := r.DBus.DaemonReload(ctx); err != nil {
+		return multierror.Append(result, fmt.Errorf("failed reloading systemd daemon: %w", err))
+	}
+
+	var fns []flow.TaskFn
+	for _, u := range unitsToKeep {
+		unit := u
+
+		if ptr.Deref(unit.Enable, true) {
+			if err := r.DBus.Enable(ctx, unit.Name); err != nil {
+				result = multierror.Append(result, fmt.Errorf("unable to enable unit %q: %w", unit.Name, err))
+			}
+		} else {
+			if err := r.DBus.Disable(ctx, unit.Name); err != nil {
+				result = multierror.Append(result, fmt.Errorf("unable to disable unit %q: %w", unit.Name, err))
+			}
+		}
+
+		fns = append(fns, func(ctx context.Context) error {
+			if !ptr.Deref(unit.Enable, true) || (unit.Command != nil && *unit.Command == extensionsv1alpha1.CommandStop) {
+				if err := r.DBus.Stop(ctx, r.Recorder, node, unit.Name); err != nil {
+					return fmt.Errorf("unable to stop unit %q: %w", unit.Name, err)
+				}
+				return nil
+			}
+
+			if err := r.DBus.Restart(ctx, r.Recorder, node, unit.Name); err != nil {
+				return fmt.Errorf("unable to restart unit %q: %w", unit.Name, err)
+			}
+			return nil
+		})
+	}
+
+	if err := flow.Parallel(fns...)(ctx); err != nil {
+		result = multierror.Append(result, err)
+	}
+
+	return result
+}
+
+const (
+	// maxRollbacks is the number of rollbacks of an operating system config after which it is not applied again until
+	// it changes.
+	maxRollbacks = 5
+	// rollbackBaseBackoff is the duration after which an operating system config is applied again after it has been
+	// rolled back for the first time. It doubles with each further rollback.
+	rollbackBaseBackoff = time.Minute
+)
+
+// rollback restores the previously applied operating system config captured in the given snapshot after the apply or
+// the health gate failed. The outcome is reported via an event and a condition on the node. After a successful
+// rollback, the checksum of the failed operating system config, the number of its rollbacks and the time of the
+// rollback are recorded on the node so that it is applied again with exponential backoff, see rollbackBackoff. No
+// error is returned to prevent an endless loop of applies and rollbacks.
+func (r *Reconciler) rollback(ctx context.Context, log logr.Logger, node *metav1.PartialObjectMetadata, s *oscSnapshot, oscChecksum string, applyErr error) error {
+	if s == nil {
+		log.Error(applyErr, "Failed applying operating system config, there is no previously applied config to restore")
+		r.reportApplyOutcome(ctx, log, node, corev1.ConditionFalse, nodeagentv1alpha1.ConditionReasonApplyFailed,
+			fmt.Sprintf("Failed applying operating system config and there is no previously applied config to restore: %v", applyErr))
+		return applyErr
+	}
+
+	log.Error(applyErr, "Failed applying operating system config, restoring previously applied config")
+	if err := r.restoreSnapshot(ctx, log, node, s); err != nil {
+		r.reportApplyOutcome(ctx, log, node, corev1.ConditionFalse, nodeagentv1alpha1.ConditionReasonRollbackFailed,
+			fmt.Sprintf("Failed applying operating system config (%v) and failed restoring previously applied config: %v", applyErr, err))
+		return fmt.Errorf("failed restoring previously applied operating system config after apply failure (%v): %w", applyErr, err)
+	}
+
+	if node == nil {
+		// Without a node, the failed operating system config cannot be recorded, hence the error is returned to retry
+		// the apply with backoff.
+		return fmt.Errorf("restored previously applied operating system config after apply failure: %w", applyErr)
+	}
+
+	rollbacks := 1
+	if node.Annotations[nodeagentv1alpha1.AnnotationKeyChecksumRolledBackOperatingSystemConfig] == oscChecksum {
+		rollbacks = rollbackCount(node) + 1
+	}
+
+	if rollbacks >= maxRollbacks {
+		r.reportApplyOutcome(ctx, log, node, corev1.ConditionFalse, nodeagentv1alpha1.ConditionReasonApplyAttemptsExhausted,
+			fmt.Sprintf("Failed applying operating system config %d times, previously applied config has been restored and the config is not applied again until it changes: %v", rollbacks, applyErr))
+	} else {
+		r.reportApplyOutcome(ctx, log, node, corev1.ConditionFalse, nodeagentv1alpha1.ConditionReasonRolledBack,
+			fmt.Sprintf("Failed applying operating system config, previously applied config has been restored and the config is applied again in %s: %v", rollbackBackoff(rollbacks), applyErr))
+	}
+
+	patch := client.MergeFrom(node.DeepCopy())
+	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentv1alpha1.AnnotationKeyChecksumRolledBackOperatingSystemConfig, oscChecksum)
+	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentv1alpha1.AnnotationKeyRollbacksOperatingSystemConfig, strconv.Itoa(rollbacks))
+	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentv1alpha1.AnnotationKeyLastRollbackTimeOperatingSystemConfig, r.Clock.Now().UTC().Format(time.RFC3339))
+	if err := r.Client.Patch(ctx, node, patch); err != nil {
+		return fmt.Errorf("failed recording rolled back operating system config on node: %w", err)
+	}
+
+	log.Info("Restored previously applied operating system config", "checksum", oscChecksum, "rollbacks", rollbacks)
+	return nil
+}
+
+// rollbackRetryAfter returns the duration after which the rolled back operating system config shall be applied again.
+// It returns false if the config has been rolled back too often and must not be applied again until it changes.
+func (r *Reconciler) rollbackRetryAfter(node *metav1.PartialObjectMetadata) (time.Duration, bool) {
+	rollbacks := rollbackCount(node)
+	if rollbacks >= maxRollbacks {
+		return 0, false
+	}
+
+	lastRollbackTime, err := time.Parse(time.RFC3339, node.Annotations[nodeagentv1alpha1.AnnotationKeyLastRollbackTimeOperatingSystemConfig])
+	if err != nil {
+		return 0, true
+	}
+
+	return max(lastRollbackTime.Add(rollbackBackoff(rollbacks)).Sub(r.Clock.Now()), 0), true
+}
+
+// rollbackBackoff returns the duration to wait before applying an operating system config again after it has been
+// rolled back the given number of times.
+func rollbackBackoff(rollbacks int) time.Duration {
+	return rollbackBaseBackoff << max(rollbacks-1, 0)
+}
+
+func rollbackCount(node *metav1.PartialObjectMetadata) int {
+	rollbacks, err := strconv.Atoi(node.Annotations[nodeagentv1alpha1.AnnotationKeyRollbacksOperatingSystemConfig])
+	if err != nil {
+		return 0
+	}
+	return rollbacks
+}
+
+// reportApplyOutcome records an event and maintains the OperatingSystemConfigApplied condition on the node. Failures
+// are only logged since they must not prevent the reconciliation from continuing.
+func (r *Reconciler) reportApplyOutcome(ctx context.Context, log logr.Logger, node *metav1.PartialObjectMetadata, status corev1.ConditionStatus, reason, message string) {
+	if node == nil {
+		return
+	}
+
+	eventType := corev1.EventTypeNormal
+	if status != corev1.ConditionTrue {
+		eventType = corev1.EventTypeWarning
+	}
+	r.Recorder.Event(node, eventType, "OSC"+reason, message)
+
+	if err := r.updateNodeCondition(ctx, node.Name, nodeagentv1alpha1.ConditionTypeOperatingSystemConfigApplied, status, reason, message); err != nil {
+		log.Error(err, "Failed updating node condition", "conditionType", nodeagentv1alpha1.ConditionTypeOperatingSystemConfigApplied)
+	}
+}
+
+// updateNodeCondition fetches the node and maintains the condition with the given type on it.
+func (r *Reconciler) updateNodeCondition(ctx context.Context, nodeName, conditionType string, status corev1.ConditionStatus, reason, message string) error {
+	node := &corev1.Node{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+		return fmt.Errorf("unable to fetch node %q: %w", nodeName, err)
+	}
+
+	return nodeagent.UpdateNodeCondition(ctx, r.Client, node, corev1.NodeConditionType(conditionType), status, reason, message)
+}
@@ -0,0 +1,357 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package operatingsystemconfig
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/go-logr/logr"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+)
+
+const (
+	// rolloutLeaseNamePrefix is the prefix of the names of the Leases used as rollout slots of a worker pool.
+	rolloutLeaseNamePrefix = "gardener-node-agent-rollout-"
+	// labelValueRolloutLease is the value of the app label of the Leases used as rollout slots.
+	labelValueRolloutLease = "gardener-node-agent-rollout"
+	// annotationKeyRolloutAppliedTime is the key of an annotation on the Lease of a rollout slot describing the time when
+	// the changes have been applied on the node holding the slot.
+	annotationKeyRolloutAppliedTime = "worker.gardener.cloud/rollout-applied-time"
+	// rolloutRequeueInterval is the interval after which the reconciliation is requeued while waiting for a free
+	// rollout slot or for the node to become ready again.
+	rolloutRequeueInterval      = 15 * time.Second
+	defaultRolloutLeaseDuration = 10 * time.Minute
+)
+
+// isDisruptive returns true if applying the given changes restarts or stops units. Changes of files which do not
+// belong to any unit are not considered disruptive.
+func isDisruptive(changes *operatingSystemConfigChanges) bool {
+	return len(changes.units.changed) > 0 || len(changes.units.deleted) > 0
+}
+
+// acquireRolloutSlot tries to acquire one of the rollout slots of the worker pool the node belongs to. The number of
+// slots is determined by the configured maximum number of unavailable nodes. It returns true if the node holds a slot
+// (either because it already held one or because it has just acquired one).
+func (r *Reconciler) acquireRolloutSlot(ctx context.Context, log logr.Logger, node *metav1.PartialObjectMetadata) (bool, error) {
+	pool := node.Labels[v1beta1constants.LabelWorkerPool]
+	if pool == "" {
+		log.Info("Node does not belong to a worker pool, skipping rollout coordination")
+		return true, nil
+	}
+
+	slots, err := r.rolloutSlots(ctx, pool)
+	if err != nil {
+		return false, err
+	}
+
+	leases, err := r.rolloutLeases(ctx, pool)
+	if err != nil {
+		return false, err
+	}
+
+	var activeHolders int
+	for _, lease := range leases {
+		if ptr.Deref(lease.Spec.HolderIdentity, "") == node.Name {
+			log.Info("Node already holds a rollout slot", "lease", client.ObjectKeyFromObject(&lease))
+			return true, r.renewRolloutLease(ctx, &lease)
+		}
+		if r.isRolloutLeaseHeld(&lease) {
+			activeHolders++
+		}
+	}
+
+	if activeHolders >= slots {
+		log.Info("All rollout slots of the worker pool are taken", "workerPool", pool, "slots", slots)
+		return false, nil
+	}
+
+	for i := 0; i < slots; i++ {
+		name := rolloutLeaseName(pool, i)
+
+		lease, ok := leases[name]
+		if !ok {
+			lease := r.newRolloutLease(name, pool, node.Name)
+			if err := r.Client.Create(ctx, lease); err != nil {
+				if apierrors.IsAlreadyExists(err) {
+					continue
+				}
+				return false, fmt.Errorf("failed creating rollout lease %s: %w", client.ObjectKeyFromObject(lease), err)
+			}
+
+			log.Info("Acquired rollout slot", "lease", client.ObjectKeyFromObject(lease))
+			return true, nil
+		}
+
+		if r.isRolloutLeaseHeld(&lease) {
+			continue
+		}
+
+		now := metav1.NewMicroTime(r.Clock.Now())
+		delete(lease.Annotations, annotationKeyRolloutAppliedTime)
+		lease.Spec.HolderIdentity = &node.Name
+		lease.Spec.LeaseDurationSeconds = ptr.To(int32(r.rolloutLeaseDuration().Seconds()))
+		lease.Spec.AcquireTime = &now
+		lease.Spec.RenewTime = &now
+		if err := r.Client.Update(ctx, &lease); err != nil {
+			if apierrors.IsConflict(err) {
+				continue
+			}
+			return false, fmt.Errorf("failed acquiring rollout lease %s: %w", client.ObjectKeyFromObject(&lease), err)
+		}
+
+		log.Info("Acquired rollout slot", "lease", client.ObjectKeyFromObject(&lease))
+		return true, nil
+	}
+
+	log.Info("No free rollout slot of the worker pool could be acquired", "workerPool", pool, "slots", slots)
+	return false, nil
+}
+
+// markRolloutSlotApplied records the time when the changes have been applied on the Lease of the rollout slot held by
+// the node. The slot is only released once the node reports a Ready heartbeat which is newer than this time.
+func (r *Reconciler) markRolloutSlotApplied(ctx context.Context, node *metav1.PartialObjectMetadata) error {
+	lease, err := r.heldRolloutLease(ctx, node)
+	if err != nil || lease == nil {
+		return err
+	}
+
+	metav1.SetMetaDataAnnotation(&lease.ObjectMeta, annotationKeyRolloutAppliedTime, r.Clock.Now().UTC().Format(time.RFC3339))
+	return r.renewRolloutLease(ctx, lease)
+}
+
+// releaseRolloutSlotIfReady releases the rollout slot held by the node as soon as it is ready again, i.e., once the
+// kubelet has reported a Ready heartbeat after the changes have been applied. As long as it is not ready, the slot is
+// renewed and the reconciliation is requeued. If the node has been drained, it is uncordoned once it is ready again.
+func (r *Reconciler) releaseRolloutSlotIfReady(ctx context.Context, log logr.Logger, node *metav1.PartialObjectMetadata) (reconcile.Result, error) {
+	if r.Config.Rollout == nil || node == nil {
+		return reconcile.Result{}, nil
+	}
+
+	lease, err := r.heldRolloutLease(ctx, node)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if lease != nil {
+		ready, err := r.isNodeReadySince(ctx, node.Name, rolloutAppliedTime(lease))
+		if err != nil {
+			return reconcile.Result{}, err
+		}
+
+		if !ready {
+			log.Info("Node has not reported to be ready since the changes have been applied yet, keeping rollout slot", "lease", client.ObjectKeyFromObject(lease))
+			return reconcile.Result{RequeueAfter: rolloutRequeueInterval}, r.renewRolloutLease(ctx, lease)
+		}
+
+		if err := r.releaseRolloutLease(ctx, lease); err != nil {
+			return reconcile.Result{}, err
+		}
+
+		log.Info("Node is ready again, released rollout slot", "lease", client.ObjectKeyFromObject(lease))
+	}
+
+	if r.Config.Rollout.Drain != nil {
+		return r.uncordonNodeIfReady(ctx, log, node.Name)
+	}
+
+	return reconcile.Result{}, nil
+}
+
+// releaseRolloutSlot releases the rollout slot held by the node immediately, e.g., after applying the changes has failed
+// or they have been rolled back, so that other nodes of the worker pool do not have to wait for the expiration of the
+// Lease.
+func (r *Reconciler) releaseRolloutSlot(ctx context.Context, log logr.Logger, node *metav1.PartialObjectMetadata) error {
+	if r.Config.Rollout == nil || node == nil {
+		return nil
+	}
+
+	lease, err := r.heldRolloutLease(ctx, node)
+	if err != nil || lease == nil {
+		return err
+	}
+
+	if err := r.releaseRolloutLease(ctx, lease); err != nil {
+		return err
+	}
+
+	log.Info("Released rollout slot", "lease", client.ObjectKeyFromObject(lease))
+	return nil
+}
+
+// heldRolloutLease returns the Lease of the rollout slot held by the node, or nil if it does not hold any slot.
+func (r *Reconciler) heldRolloutLease(ctx context.Context, node *metav1.PartialObjectMetadata) (*coordinationv1.Lease, error) {
+	pool := node.Labels[v1beta1constants.LabelWorkerPool]
+	if pool == "" {
+		return nil, nil
+	}
+
+	leases, err := r.rolloutLeases(ctx, pool)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, lease := range leases {
+		if ptr.Deref(lease.Spec.HolderIdentity, "") == node.Name {
+			return &lease, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (r *Reconciler) releaseRolloutLease(ctx context.Context, lease *coordinationv1.Lease) error {
+	delete(lease.Annotations, annotationKeyRolloutAppliedTime)
+	lease.Spec.HolderIdentity = nil
+	lease.Spec.AcquireTime = nil
+	lease.Spec.RenewTime = nil
+	if err := r.Client.Update(ctx, lease); err != nil {
+		return fmt.Errorf("failed releasing rollout lease %s: %w", client.ObjectKeyFromObject(lease), err)
+	}
+	return nil
+}
+
+// rolloutSlots computes the number of rollout slots of the given worker pool based on the configured maximum number of
+// unavailable nodes. At least one slot is always available.
+func (r *Reconciler) rolloutSlots(ctx context.Context, pool string) (int, error) {
+	nodeList := &metav1.PartialObjectMetadataList{}
+	nodeList.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("NodeList"))
+	if err := r.Client.List(ctx, nodeList, client.MatchingLabels{v1beta1constants.LabelWorkerPool: pool}); err != nil {
+		return 0, fmt.Errorf("failed listing nodes of worker pool %q: %w", pool, err)
+	}
+
+	slots, err := intstr.GetScaledValueFromIntOrPercent(&r.Config.Rollout.MaxUnavailable, len(nodeList.Items), false)
+	if err != nil {
+		return 0, fmt.Errorf("failed computing number of rollout slots: %w", err)
+	}
+
+	return max(slots, 1), nil
+}
+
+func (r *Reconciler) rolloutLeases(ctx context.Context, pool string) (map[string]coordinationv1.Lease, error) {
+	leaseList := &coordinationv1.LeaseList{}
+	if err := r.APIReader.List(ctx, leaseList, client.InNamespace(metav1.NamespaceSystem), client.MatchingLabels{
+		v1beta1constants.LabelApp:        labelValueRolloutLease,
+		v1beta1constants.LabelWorkerPool: pool,
+	}); err != nil {
+		return nil, fmt.Errorf("failed listing rollout leases of worker pool %q: %w", pool, err)
+	}
+
+	leases := make(map[string]coordinationv1.Lease, len(leaseList.Items))
+	for _, lease := range leaseList.Items {
+		leases[lease.Name] = lease
+	}
+
+	return leases, nil
+}
+
+func (r *Reconciler) newRolloutLease(name, pool, nodeName string) *coordinationv1.Lease {
+	now := metav1.NewMicroTime(r.Clock.Now())
+
+	return &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceSystem,
+			Labels: map[string]string{
+				v1beta1constants.LabelApp:        labelValueRolloutLease,
+				v1beta1constants.LabelWorkerPool: pool,
+			},
+		},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       &nodeName,
+			LeaseDurationSeconds: ptr.To(int32(r.rolloutLeaseDuration().Seconds())),
+			AcquireTime:          &now,
+			RenewTime:            &now,
+		},
+	}
+}
+
+func (r *Reconciler) renewRolloutLease(ctx context.Context, lease *coordinationv1.Lease) error {
+	lease.Spec.RenewTime = ptr.To(metav1.NewMicroTime(r.Clock.Now()))
+	lease.Spec.LeaseDurationSeconds = ptr.To(int32(r.rolloutLeaseDuration().Seconds()))
+	if err := r.Client.Update(ctx, lease); err != nil {
+		return fmt.Errorf("failed renewing rollout lease %s: %w", client.ObjectKeyFromObject(lease), err)
+	}
+	return nil
+}
+
+func (r *Reconciler) isRolloutLeaseHeld(lease *coordinationv1.Lease) bool {
+	if ptr.Deref(lease.Spec.HolderIdentity, "") == "" || lease.Spec.RenewTime == nil {
+		return false
+	}
+
+	leaseDuration := time.Duration(ptr.Deref(lease.Spec.LeaseDurationSeconds, 0)) * time.Second
+	return r.Clock.Now().Before(lease.Spec.RenewTime.Add(leaseDuration))
+}
+
+func (r *Reconciler) rolloutLeaseDuration() time.Duration {
+	if r.Config.Rollout.LeaseDuration != nil {
+		return r.Config.Rollout.LeaseDuration.Duration
+	}
+	return defaultRolloutLeaseDuration
+}
+
+// isNodeReadySince returns true if the Ready condition of the node is true and the kubelet has reported it after the
+// given time. This prevents releasing the slot based on a stale Ready condition reported before the changes have been
+// applied.
+func (r *Reconciler) isNodeReadySince(ctx context.Context, nodeName string, since time.Time) (bool, error) {
+	node := &corev1.Node{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Name: nodeName}, node); err != nil {
+		return false, fmt.Errorf("unable to fetch node %q: %w", nodeName, err)
+	}
+
+	return nodeReadySince(node, since), nil
+}
+
+func nodeReady(node *corev1.Node) bool {
+	return nodeReadySince(node, time.Time{})
+}
+
+func nodeReadySince(node *corev1.Node, since time.Time) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == corev1.NodeReady {
+			return condition.Status == corev1.ConditionTrue && condition.LastHeartbeatTime.Time.After(since)
+		}
+	}
+
+	return false
+}
+
+// rolloutAppliedTime returns the time when the changes have been applied on the node holding the given Lease. If it
+// has not been recorded, the time when the slot was acquired is returned.
+func rolloutAppliedTime(lease *coordinationv1.Lease) time.Time {
+	if appliedTime, err := time.Parse(time.RFC3339, lease.Annotations[annotationKeyRolloutAppliedTime]); err == nil {
+		return appliedTime
+	}
+	if lease.Spec.AcquireTime != nil {
+		return lease.Spec.AcquireTime.Time
+	}
+	return time.Time{}
+}
+
+func rolloutLeaseName(pool string, index int) string {
+	return rolloutLeaseNamePrefix + pool + "-" + strconv.Itoa(index)
+}
@@ -44,6 +44,8 @@ type DBus interface {
 	Restart(ctx context.Context, recorder record.EventRecorder, node runtime.Object, unitName string) error
 	// Reboot this machines, is the same as executing "systemctl reboot".
 	Reboot() error
+	// ActiveState returns the active state of the given unit, same as executing "systemctl is-active unit".
+	ActiveState(ctx context.Context, unitName string) (string, error)
 }
 
 type db struct {
@@ -117,6 +119,26 @@ func (d *db) Restart(ctx context.Context, recorder record.EventRecorder, node ru
 	return d.runCommand(ctx, recorder, node, unitName, dbc.RestartUnitContext, "SystemDUnitRestart", "restart")
 }
 
+func (_ *db) ActiveState(ctx context.Context, unitName string) (string, error) {
+	dbc, err := dbus.NewWithContext(ctx)
+	if err != nil {
+		return "", fmt.Errorf("unable to connect to dbus: %w", err)
+	}
+	defer dbc.Close()
+
+	property, err := dbc.GetUnitPropertyContext(ctx, unitName, "ActiveState")
+	if err != nil {
+		return "", fmt.Errorf("unable to get active state of unit %s: %w", unitName, err)
+	}
+
+	activeState, ok := property.Value.Value().(string)
+	if !ok {
+		return "", fmt.Errorf("unexpected type %T of active state of unit %s", property.Value.Value(), unitName)
+	}
+
+	return activeState, nil
+}
+
 func (_ *db) DaemonReload(ctx context.Context) error {
 	dbc, err := dbus.NewWithContext(ctx)
 	if err != nil {
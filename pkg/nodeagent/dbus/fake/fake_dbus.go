@@ -54,7 +54,8 @@ type SystemdAction struct {
 type DBus struct {
 	Actions []SystemdAction
 
-	mutex sync.Mutex
+	mutex        sync.Mutex
+	activeStates map[string]string
 }
 
 var _ dbus.DBus = &DBus{}
@@ -147,3 +148,25 @@ func (d *DBus) Stop(_ context.Context, _ record.EventRecorder, _ runtime.Object,
 	})
 	return nil
 }
+
+// ActiveState implements dbus.DBus. It returns "active" for all units whose state was not set via SetActiveState.
+func (d *DBus) ActiveState(_ context.Context, unitName string) (string, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if activeState, ok := d.activeStates[unitName]; ok {
+		return activeState, nil
+	}
+	return "active", nil
+}
+
+// SetActiveState sets the active state which is returned by ActiveState for the given unit.
+func (d *DBus) SetActiveState(unitName, activeState string) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	if d.activeStates == nil {
+		d.activeStates = make(map[string]string)
+	}
+	d.activeStates[unitName] = activeState
+}
@@ -0,0 +1,56 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodeagent
+
+import (
+	"context"
+	"slices"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// UpdateNodeCondition maintains the condition with the given type on the node. The node is not patched if status,
+// reason and message of the condition did not change. The last transition time is only updated if the status changes.
+func UpdateNodeCondition(ctx context.Context, c client.Client, node *corev1.Node, conditionType corev1.NodeConditionType, status corev1.ConditionStatus, reason, message string) error {
+	var (
+		now       = metav1.Now()
+		condition = corev1.NodeCondition{
+			Type:               conditionType,
+			Status:             status,
+			LastHeartbeatTime:  now,
+			LastTransitionTime: now,
+			Reason:             reason,
+			Message:            message,
+		}
+		patch = client.StrategicMergeFrom(node.DeepCopy())
+	)
+
+	if idx := slices.IndexFunc(node.Status.Conditions, func(c corev1.NodeCondition) bool { return c.Type == conditionType }); idx != -1 {
+		existing := node.Status.Conditions[idx]
+		if existing.Status == status && existing.Reason == reason && existing.Message == message {
+			return nil
+		}
+		if existing.Status == status {
+			condition.LastTransitionTime = existing.LastTransitionTime
+		}
+		node.Status.Conditions[idx] = condition
+	} else {
+		node.Status.Conditions = append(node.Status.Conditions, condition)
+	}
+
+	return c.Status().Patch(ctx, node, patch)
+}
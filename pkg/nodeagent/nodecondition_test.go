@@ -0,0 +1,98 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package nodeagent_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	. "github.com/gardener/gardener/pkg/nodeagent"
+)
+
+var _ = Describe("NodeCondition", func() {
+	Describe("#UpdateNodeCondition", func() {
+		var (
+			ctx        = context.Background()
+			fakeClient client.Client
+
+			conditionType corev1.NodeConditionType = "Foo"
+			node          *corev1.Node
+		)
+
+		BeforeEach(func() {
+			node = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node"}}
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetesscheme.Scheme).WithObjects(node).WithStatusSubresource(node).Build()
+		})
+
+		It("should add the condition if it does not exist", func() {
+			Expect(UpdateNodeCondition(ctx, fakeClient, node, conditionType, corev1.ConditionTrue, "Reason", "message")).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Status.Conditions).To(ConsistOf(And(
+				HaveField("Type", conditionType),
+				HaveField("Status", corev1.ConditionTrue),
+				HaveField("Reason", "Reason"),
+				HaveField("Message", "message"),
+			)))
+		})
+
+		It("should keep the last transition time if the status does not change", func() {
+			lastTransitionTime := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+			node.Status.Conditions = []corev1.NodeCondition{{Type: conditionType, Status: corev1.ConditionTrue, Reason: "Reason", Message: "old", LastTransitionTime: lastTransitionTime}}
+			Expect(fakeClient.Status().Update(ctx, node)).To(Succeed())
+
+			Expect(UpdateNodeCondition(ctx, fakeClient, node, conditionType, corev1.ConditionTrue, "Reason", "new")).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Status.Conditions).To(ConsistOf(And(
+				HaveField("Message", "new"),
+				HaveField("LastTransitionTime", Equal(lastTransitionTime)),
+			)))
+		})
+
+		It("should update the last transition time if the status changes", func() {
+			lastTransitionTime := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+			node.Status.Conditions = []corev1.NodeCondition{{Type: conditionType, Status: corev1.ConditionTrue, Reason: "Reason", Message: "message", LastTransitionTime: lastTransitionTime}}
+			Expect(fakeClient.Status().Update(ctx, node)).To(Succeed())
+
+			Expect(UpdateNodeCondition(ctx, fakeClient, node, conditionType, corev1.ConditionFalse, "Reason", "message")).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.Status.Conditions).To(ConsistOf(And(
+				HaveField("Status", corev1.ConditionFalse),
+				HaveField("LastTransitionTime", Not(Equal(lastTransitionTime))),
+			)))
+		})
+
+		It("should not patch the node if the condition did not change", func() {
+			node.Status.Conditions = []corev1.NodeCondition{{Type: conditionType, Status: corev1.ConditionTrue, Reason: "Reason", Message: "message"}}
+			Expect(fakeClient.Status().Update(ctx, node)).To(Succeed())
+			resourceVersion := node.ResourceVersion
+
+			Expect(UpdateNodeCondition(ctx, fakeClient, node, conditionType, corev1.ConditionTrue, "Reason", "message")).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			Expect(node.ResourceVersion).To(Equal(resourceVersion))
+		})
+	})
+})
@@ -35,13 +35,17 @@ import (
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 
 	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/nodeagent/registry/verification"
 )
 
-type containerdExtractor struct{}
+type containerdExtractor struct {
+	verificationPolicy *verification.Policy
+}
 
-// NewExtractor creates a new instance of containerd extractor.
-func NewExtractor() Extractor {
-	return &containerdExtractor{}
+// NewExtractor creates a new instance of containerd extractor. If a verification policy is given, images are verified
+// according to it before files are extracted from them.
+func NewExtractor(verificationPolicy *verification.Policy) Extractor {
+	return &containerdExtractor{verificationPolicy: verificationPolicy}
 }
 
 // CopyFromImage copies a file from a given image reference to the destination file.
@@ -71,7 +75,12 @@ func (e *containerdExtractor) CopyFromImage(ctx context.Context, imageRef string
 		Hosts: config.ConfigureHosts(ctx, config.HostOptions{HostDir: config.HostDirFromRoot("/etc/containerd/certs.d")}),
 	})
 
-	image, err := client.Pull(ctx, imageRef, containerd.WithPullSnapshotter(containerd.DefaultSnapshotter), containerd.WithResolver(resolver), containerd.WithPullUnpack)
+	pullRef, err := verification.VerifyImage(ctx, resolver, imageRef, e.verificationPolicy)
+	if err != nil {
+		return err
+	}
+
+	image, err := client.Pull(ctx, pullRef, containerd.WithPullSnapshotter(containerd.DefaultSnapshotter), containerd.WithResolver(resolver), containerd.WithPullUnpack)
 	if err != nil {
 		return fmt.Errorf("error pulling image: %w", err)
 	}
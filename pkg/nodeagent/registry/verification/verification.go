@@ -0,0 +1,249 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verification
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/containerd/containerd/reference/docker"
+	"github.com/containerd/containerd/remotes"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/sigstore/sigstore/pkg/cryptoutils"
+	"github.com/sigstore/sigstore/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/signature/payload"
+)
+
+const (
+	// cosignSignatureAnnotation is the annotation of a layer of a cosign signature manifest which contains the
+	// base64-encoded signature of the layer's payload.
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+	// maxSignatureBlobSize is the maximum size of signature manifests and payloads which are fetched from the registry.
+	maxSignatureBlobSize = 1 << 20
+)
+
+// Policy describes how images are verified before files are extracted from them.
+type Policy struct {
+	// RequireDigest specifies whether image references must be pinned by digest.
+	RequireDigest bool
+	// PublicKeys is a list of public keys. If set, an image is only accepted if it carries a cosign signature which can
+	// be verified with at least one of the keys.
+	PublicKeys []crypto.PublicKey
+}
+
+func (p *Policy) enabled() bool {
+	return p != nil && (p.RequireDigest || len(p.PublicKeys) > 0)
+}
+
+// Error is returned if an image does not satisfy the verification policy.
+type Error struct {
+	// ImageRef is the reference of the image which failed verification.
+	ImageRef string
+	// Reason describes why the verification failed.
+	Reason string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("verification of image %q failed: %s", e.ImageRef, e.Reason)
+}
+
+// ParsePublicKeys parses the given PEM-encoded public keys. ECDSA, RSA and Ed25519 keys are supported.
+func ParsePublicKeys(pemEncodedKeys []string) ([]crypto.PublicKey, error) {
+	publicKeys := make([]crypto.PublicKey, 0, len(pemEncodedKeys))
+
+	for i, pemEncodedKey := range pemEncodedKeys {
+		key, err := cryptoutils.UnmarshalPEMToPublicKey([]byte(pemEncodedKey))
+		if err != nil {
+			return nil, fmt.Errorf("public key at index %d is not PEM-encoded or cannot be parsed: %w", i, err)
+		}
+
+		if _, err := signature.LoadVerifier(key, crypto.SHA256); err != nil {
+			return nil, fmt.Errorf("public key at index %d has unsupported type %T: %w", i, key, err)
+		}
+
+		publicKeys = append(publicKeys, key)
+	}
+
+	return publicKeys, nil
+}
+
+// SignatureTag returns the tag under which cosign stores the signatures of the image manifest with the given digest.
+func SignatureTag(manifestDigest string) string {
+	return strings.Replace(manifestDigest, ":", "-", 1) + ".sig"
+}
+
+// VerifyImage verifies the given image reference according to the given policy. It returns the digest-pinned reference
+// of the verified image which must be used for pulling it, so that the pulled content cannot differ from the verified
+// one. If the policy is not enabled, the image reference is returned unchanged.
+func VerifyImage(ctx context.Context, resolver remotes.Resolver, imageRef string, policy *Policy) (string, error) {
+	if !policy.enabled() {
+		return imageRef, nil
+	}
+
+	named, err := docker.ParseDockerRef(imageRef)
+	if err != nil {
+		return "", &Error{ImageRef: imageRef, Reason: fmt.Sprintf("reference cannot be parsed: %v", err)}
+	}
+
+	if _, ok := named.(docker.Digested); !ok && policy.RequireDigest {
+		return "", &Error{ImageRef: imageRef, Reason: "reference is not pinned by digest"}
+	}
+
+	_, desc, err := resolver.Resolve(ctx, named.String())
+	if err != nil {
+		return "", fmt.Errorf("failed resolving image %q: %w", imageRef, err)
+	}
+	pinnedRef := named.Name() + "@" + desc.Digest.String()
+
+	if len(policy.PublicKeys) == 0 {
+		return pinnedRef, nil
+	}
+
+	if err := verifySignatures(ctx, resolver, named.Name(), desc.Digest.String(), policy.PublicKeys); err != nil {
+		var verificationErr *Error
+		if errors.As(err, &verificationErr) {
+			verificationErr.ImageRef = imageRef
+		}
+		return "", err
+	}
+
+	return pinnedRef, nil
+}
+
+func verifySignatures(ctx context.Context, resolver remotes.Resolver, repository, manifestDigest string, publicKeys []crypto.PublicKey) error {
+	signatureRef := repository + ":" + SignatureTag(manifestDigest)
+
+	_, signatureManifestDesc, err := resolver.Resolve(ctx, signatureRef)
+	if err != nil {
+		return &Error{Reason: fmt.Sprintf("no signature found at %q: %v", signatureRef, err)}
+	}
+
+	fetcher, err := resolver.Fetcher(ctx, signatureRef)
+	if err != nil {
+		return fmt.Errorf("failed creating fetcher for %q: %w", signatureRef, err)
+	}
+
+	signatureManifestRaw, err := fetchBlob(ctx, fetcher, signatureManifestDesc)
+	if err != nil {
+		return fmt.Errorf("failed fetching signature manifest %q: %w", signatureRef, err)
+	}
+
+	signatureManifest := &ocispec.Manifest{}
+	if err := json.Unmarshal(signatureManifestRaw, signatureManifest); err != nil {
+		return &Error{Reason: fmt.Sprintf("signature manifest %q cannot be decoded: %v", signatureRef, err)}
+	}
+
+	var errs []error
+	for _, layer := range signatureManifest.Layers {
+		signatureBase64, ok := layer.Annotations[cosignSignatureAnnotation]
+		if !ok {
+			continue
+		}
+
+		payloadRaw, err := fetchBlob(ctx, fetcher, layer)
+		if err != nil {
+			return fmt.Errorf("failed fetching signature payload %s: %w", layer.Digest, err)
+		}
+
+		if err := VerifySignature(payloadRaw, signatureBase64, manifestDigest, publicKeys); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		return nil
+	}
+
+	if len(errs) == 0 {
+		return &Error{Reason: fmt.Sprintf("signature manifest %q does not contain any signatures", signatureRef)}
+	}
+	return &Error{Reason: fmt.Sprintf("none of the signatures could be verified: %v", errors.Join(errs...))}
+}
+
+// VerifySignature verifies the base64-encoded cosign signature of the given simple signing payload with the given
+// public keys. In addition, it checks that the payload refers to the image manifest with the given digest.
+func VerifySignature(payloadRaw []byte, signatureBase64, manifestDigest string, publicKeys []crypto.PublicKey) error {
+	signatureRaw, err := base64.StdEncoding.DecodeString(signatureBase64)
+	if err != nil {
+		return fmt.Errorf("signature is not base64-encoded: %w", err)
+	}
+
+	var verified bool
+	for _, publicKey := range publicKeys {
+		verifier, err := signature.LoadVerifier(publicKey, crypto.SHA256)
+		if err != nil {
+			return fmt.Errorf("failed loading verifier for public key: %w", err)
+		}
+
+		if verifier.VerifySignature(bytes.NewReader(signatureRaw), bytes.NewReader(payloadRaw)) == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return errors.New("signature cannot be verified with any of the public keys")
+	}
+
+	simpleSigning := &payload.SimpleContainerImage{}
+	if err := json.Unmarshal(payloadRaw, simpleSigning); err != nil {
+		return fmt.Errorf("signature payload cannot be decoded: %w", err)
+	}
+
+	if simpleSigning.Critical.Type != payload.CosignSignatureType {
+		return fmt.Errorf("signature payload has unexpected type %q", simpleSigning.Critical.Type)
+	}
+
+	if simpleSigning.Critical.Image.DockerManifestDigest != manifestDigest {
+		return fmt.Errorf("signature payload refers to manifest %q instead of %q", simpleSigning.Critical.Image.DockerManifestDigest, manifestDigest)
+	}
+
+	return nil
+}
+
+// fetchBlob fetches the blob described by the given descriptor and checks that its content matches the digest.
+func fetchBlob(ctx context.Context, fetcher remotes.Fetcher, desc ocispec.Descriptor) ([]byte, error) {
+	if desc.Size > maxSignatureBlobSize {
+		return nil, fmt.Errorf("blob %s exceeds the maximum size of %d bytes", desc.Digest, maxSignatureBlobSize)
+	}
+
+	reader, err := fetcher.Fetch(ctx, desc)
+	if err != nil {
+		return nil, err
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(io.LimitReader(reader, maxSignatureBlobSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxSignatureBlobSize {
+		return nil, fmt.Errorf("blob %s exceeds the maximum size of %d bytes", desc.Digest, maxSignatureBlobSize)
+	}
+
+	hash := sha256.Sum256(data)
+	if actual := "sha256:" + hex.EncodeToString(hash[:]); actual != desc.Digest.String() {
+		return nil, &Error{Reason: fmt.Sprintf("content of blob %s does not match its digest (actual: %s)", desc.Digest, actual)}
+	}
+
+	return data, nil
+}
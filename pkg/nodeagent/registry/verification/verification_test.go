@@ -0,0 +1,207 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package verification_test
+
+import (
+	"bytes"
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+
+	"github.com/containerd/containerd/remotes"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+
+	. "github.com/gardener/gardener/pkg/nodeagent/registry/verification"
+)
+
+var _ = Describe("Verification", func() {
+	var (
+		ctx = context.Background()
+
+		privateKey *ecdsa.PrivateKey
+		publicKey  string
+
+		resolver       *fakeResolver
+		manifestDigest string
+		policy         *Policy
+	)
+
+	BeforeEach(func() {
+		var err error
+		privateKey, err = ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		Expect(err).NotTo(HaveOccurred())
+		publicKeyDER, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+		Expect(err).NotTo(HaveOccurred())
+		publicKey = string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKeyDER}))
+
+		resolver = &fakeResolver{refs: map[string]ocispec.Descriptor{}, blobs: map[string][]byte{}}
+		manifestDigest = resolver.addRef("example.com/kubelet:v1.28.2", []byte(`{"kind":"image-manifest"}`))
+
+		publicKeys, err := ParsePublicKeys([]string{publicKey})
+		Expect(err).NotTo(HaveOccurred())
+		policy = &Policy{PublicKeys: publicKeys}
+	})
+
+	Describe("#ParsePublicKeys", func() {
+		It("should fail for keys which are not PEM-encoded", func() {
+			Expect(ParsePublicKeys([]string{publicKey, "foo"})).Error().To(MatchError(ContainSubstring("public key at index 1 is not PEM-encoded")))
+		})
+	})
+
+	Describe("#SignatureTag", func() {
+		It("should return the cosign signature tag", func() {
+			Expect(SignatureTag("sha256:abc")).To(Equal("sha256-abc.sig"))
+		})
+	})
+
+	Describe("#VerifySignature", func() {
+		It("should succeed for a valid signature", func() {
+			payload := simpleSigningPayload(manifestDigest)
+			Expect(VerifySignature(payload, sign(privateKey, payload), manifestDigest, policy.PublicKeys)).To(Succeed())
+		})
+
+		It("should fail if the signature was created with another key", func() {
+			otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+
+			payload := simpleSigningPayload(manifestDigest)
+			Expect(VerifySignature(payload, sign(otherKey, payload), manifestDigest, policy.PublicKeys)).To(MatchError(ContainSubstring("cannot be verified with any of the public keys")))
+		})
+
+		It("should fail if the payload refers to another manifest", func() {
+			payload := simpleSigningPayload("sha256:other")
+			Expect(VerifySignature(payload, sign(privateKey, payload), manifestDigest, policy.PublicKeys)).To(MatchError(ContainSubstring("refers to manifest")))
+		})
+	})
+
+	Describe("#VerifyImage", func() {
+		It("should return the reference unchanged if the policy is not enabled", func() {
+			Expect(VerifyImage(ctx, resolver, "example.com/kubelet:v1.28.2", nil)).To(Equal("example.com/kubelet:v1.28.2"))
+		})
+
+		It("should fail if a digest is required but the reference is not pinned", func() {
+			policy.RequireDigest = true
+
+			_, err := VerifyImage(ctx, resolver, "example.com/kubelet:v1.28.2", policy)
+			Expect(err).To(BeAssignableToTypeOf(&Error{}))
+			Expect(err).To(MatchError(ContainSubstring("reference is not pinned by digest")))
+		})
+
+		It("should fail if the image is not signed", func() {
+			_, err := VerifyImage(ctx, resolver, "example.com/kubelet:v1.28.2", policy)
+			Expect(err).To(BeAssignableToTypeOf(&Error{}))
+			Expect(err).To(MatchError(ContainSubstring("no signature found")))
+		})
+
+		It("should fail if the signature cannot be verified", func() {
+			otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			Expect(err).NotTo(HaveOccurred())
+			resolver.addSignature(manifestDigest, otherKey)
+
+			_, err = VerifyImage(ctx, resolver, "example.com/kubelet:v1.28.2", policy)
+			Expect(err).To(BeAssignableToTypeOf(&Error{}))
+			Expect(err).To(MatchError(ContainSubstring("none of the signatures could be verified")))
+		})
+
+		It("should return the digest-pinned reference if the signature is valid", func() {
+			resolver.addSignature(manifestDigest, privateKey)
+
+			Expect(VerifyImage(ctx, resolver, "example.com/kubelet:v1.28.2", policy)).To(Equal("example.com/kubelet@" + manifestDigest))
+		})
+
+		It("should accept digest-pinned references with a valid signature", func() {
+			policy.RequireDigest = true
+			resolver.addSignature(manifestDigest, privateKey)
+			resolver.refs["example.com/kubelet@"+manifestDigest] = resolver.refs["example.com/kubelet:v1.28.2"]
+
+			Expect(VerifyImage(ctx, resolver, "example.com/kubelet@"+manifestDigest, policy)).To(Equal("example.com/kubelet@" + manifestDigest))
+		})
+	})
+})
+
+func simpleSigningPayload(manifestDigest string) []byte {
+	return []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"example.com/kubelet"},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`, manifestDigest))
+}
+
+func sign(privateKey *ecdsa.PrivateKey, payload []byte) string {
+	hash := sha256.Sum256(payload)
+	signature, err := privateKey.Sign(rand.Reader, hash[:], crypto.SHA256)
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	return base64.StdEncoding.EncodeToString(signature)
+}
+
+func digestOf(data []byte) string {
+	hash := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(hash[:])
+}
+
+type fakeResolver struct {
+	remotes.Resolver
+
+	refs  map[string]ocispec.Descriptor
+	blobs map[string][]byte
+}
+
+func (r *fakeResolver) addRef(ref string, content []byte) string {
+	desc := r.addBlob(content, nil)
+	r.refs[ref] = desc
+	return desc.Digest.String()
+}
+
+func (r *fakeResolver) addBlob(content []byte, annotations map[string]string) ocispec.Descriptor {
+	desc := ocispec.Descriptor{Size: int64(len(content)), Annotations: annotations}
+	ExpectWithOffset(2, json.Unmarshal([]byte(fmt.Sprintf("%q", digestOf(content))), &desc.Digest)).To(Succeed())
+	r.blobs[desc.Digest.String()] = content
+	return desc
+}
+
+func (r *fakeResolver) addSignature(manifestDigest string, privateKey *ecdsa.PrivateKey) {
+	payload := simpleSigningPayload(manifestDigest)
+	layer := r.addBlob(payload, map[string]string{"dev.cosignproject.cosign/signature": sign(privateKey, payload)})
+
+	manifest, err := json.Marshal(ocispec.Manifest{Layers: []ocispec.Descriptor{layer}})
+	ExpectWithOffset(1, err).NotTo(HaveOccurred())
+	r.addRef("example.com/kubelet:"+SignatureTag(manifestDigest), manifest)
+}
+
+func (r *fakeResolver) Resolve(_ context.Context, ref string) (string, ocispec.Descriptor, error) {
+	desc, ok := r.refs[ref]
+	if !ok {
+		return "", ocispec.Descriptor{}, fmt.Errorf("%s not found", ref)
+	}
+	return ref, desc, nil
+}
+
+func (r *fakeResolver) Fetcher(_ context.Context, _ string) (remotes.Fetcher, error) {
+	return remotes.FetcherFunc(func(_ context.Context, desc ocispec.Descriptor) (io.ReadCloser, error) {
+		blob, ok := r.blobs[desc.Digest.String()]
+		if !ok {
+			return nil, fmt.Errorf("blob %s not found", desc.Digest)
+		}
+		return io.NopCloser(bytes.NewReader(blob)), nil
+	}), nil
+}
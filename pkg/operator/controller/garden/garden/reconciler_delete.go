@@ -274,8 +274,10 @@ func (r *Reconciler) delete(
 			Dependencies: flow.NewTaskIDs(destroyGardenerResourceManager),
 		})
 		_ = g.Add(flow.Task{
-			Name:         "Cleaning up secrets",
-			Fn:           secretsManager.Cleanup,
+			Name: "Cleaning up secrets",
+			Fn: func(ctx context.Context) error {
+				return secretsManager.Cleanup(ctx)
+			},
 			Dependencies: flow.NewTaskIDs(destroyGardenerResourceManager),
 		})
 	)
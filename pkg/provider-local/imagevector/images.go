@@ -7,6 +7,8 @@
 package imagevector
 
 const (
+	// ImageNameLocalKmsPlugin is a constant for an image in the image vector with name 'local-kms-plugin'.
+	ImageNameLocalKmsPlugin = "local-kms-plugin"
 	// ImageNameLocalPathHelper is a constant for an image in the image vector with name 'local-path-helper'.
 	ImageNameLocalPathHelper = "local-path-helper"
 	// ImageNameLocalPathProvisioner is a constant for an image in the image vector with name 'local-path-provisioner'.
@@ -0,0 +1,110 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kms
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"io"
+
+	"k8s.io/kms/pkg/service"
+)
+
+// Service is a mock implementation of a KMS v2 service which is only meant for testing purposes. It does not talk to
+// an external key management system but derives the key encryption keys from a secret and the key ID with HMAC-SHA256.
+// This way, data encrypted with a previous key ID can still be decrypted after the key ID was changed (e.g., during an
+// ETCD encryption key rotation) without the need to store any state.
+type Service struct {
+	secret []byte
+	keyID  string
+}
+
+var _ service.Service = &Service{}
+
+// NewService returns a new mock KMS v2 service which encrypts data with the key derived from the given secret and key
+// ID.
+func NewService(secret []byte, keyID string) (*Service, error) {
+	if len(secret) == 0 {
+		return nil, fmt.Errorf("secret must not be empty")
+	}
+	if len(keyID) == 0 {
+		return nil, fmt.Errorf("key ID must not be empty")
+	}
+
+	return &Service{secret: secret, keyID: keyID}, nil
+}
+
+// Encrypt encrypts the given data with the key for the current key ID.
+func (s *Service) Encrypt(_ context.Context, _ string, data []byte) (*service.EncryptResponse, error) {
+	aead, err := s.aeadForKeyID(s.keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("failed generating nonce: %w", err)
+	}
+
+	return &service.EncryptResponse{
+		Ciphertext: aead.Seal(nonce, nonce, data, nil),
+		KeyID:      s.keyID,
+	}, nil
+}
+
+// Decrypt decrypts the given ciphertext with the key for the key ID of the request.
+func (s *Service) Decrypt(_ context.Context, _ string, req *service.DecryptRequest) ([]byte, error) {
+	aead, err := s.aeadForKeyID(req.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.Ciphertext) < aead.NonceSize() {
+		return nil, fmt.Errorf("ciphertext is too short")
+	}
+
+	nonce, ciphertext := req.Ciphertext[:aead.NonceSize()], req.Ciphertext[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
+
+// Status returns the status of the service including the current key ID.
+func (s *Service) Status(_ context.Context) (*service.StatusResponse, error) {
+	return &service.StatusResponse{
+		Version: "v2",
+		Healthz: "ok",
+		KeyID:   s.keyID,
+	}, nil
+}
+
+func (s *Service) aeadForKeyID(keyID string) (cipher.AEAD, error) {
+	if len(keyID) == 0 {
+		return nil, fmt.Errorf("key ID must not be empty")
+	}
+
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(keyID))
+
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
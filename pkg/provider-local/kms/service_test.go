@@ -0,0 +1,106 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kms_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/kms/pkg/service"
+
+	. "github.com/gardener/gardener/pkg/provider-local/kms"
+)
+
+var _ = Describe("Service", func() {
+	var (
+		ctx       = context.Background()
+		secret    = []byte("some-secret")
+		plaintext = []byte("some-data")
+
+		svc *Service
+	)
+
+	BeforeEach(func() {
+		var err error
+		svc, err = NewService(secret, "1")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("#NewService", func() {
+		It("should fail if the secret is empty", func() {
+			_, err := NewService(nil, "1")
+			Expect(err).To(MatchError("secret must not be empty"))
+		})
+
+		It("should fail if the key ID is empty", func() {
+			_, err := NewService(secret, "")
+			Expect(err).To(MatchError("key ID must not be empty"))
+		})
+	})
+
+	Describe("#Status", func() {
+		It("should report the current key ID", func() {
+			Expect(svc.Status(ctx)).To(Equal(&service.StatusResponse{Version: "v2", Healthz: "ok", KeyID: "1"}))
+		})
+	})
+
+	Describe("#Encrypt and #Decrypt", func() {
+		It("should encrypt and decrypt the data", func() {
+			resp, err := svc.Encrypt(ctx, "uid", plaintext)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp.KeyID).To(Equal("1"))
+			Expect(resp.Ciphertext).NotTo(ContainSubstring(string(plaintext)))
+
+			Expect(svc.Decrypt(ctx, "uid", &service.DecryptRequest{Ciphertext: resp.Ciphertext, KeyID: resp.KeyID})).To(Equal(plaintext))
+		})
+
+		It("should decrypt data encrypted with a previous key ID", func() {
+			resp, err := svc.Encrypt(ctx, "uid", plaintext)
+			Expect(err).NotTo(HaveOccurred())
+
+			rotatedSvc, err := NewService(secret, "2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rotatedSvc.Decrypt(ctx, "uid", &service.DecryptRequest{Ciphertext: resp.Ciphertext, KeyID: resp.KeyID})).To(Equal(plaintext))
+
+			rotatedResp, err := rotatedSvc.Encrypt(ctx, "uid", plaintext)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rotatedResp.KeyID).To(Equal("2"))
+		})
+
+		It("should fail decrypting data with the wrong key ID", func() {
+			resp, err := svc.Encrypt(ctx, "uid", plaintext)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = svc.Decrypt(ctx, "uid", &service.DecryptRequest{Ciphertext: resp.Ciphertext, KeyID: "2"})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail decrypting data with a different secret", func() {
+			resp, err := svc.Encrypt(ctx, "uid", plaintext)
+			Expect(err).NotTo(HaveOccurred())
+
+			otherSvc, err := NewService([]byte("other-secret"), "1")
+			Expect(err).NotTo(HaveOccurred())
+			_, err = otherSvc.Decrypt(ctx, "uid", &service.DecryptRequest{Ciphertext: resp.Ciphertext, KeyID: resp.KeyID})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should fail decrypting too short ciphertexts", func() {
+			_, err := svc.Decrypt(ctx, "uid", &service.DecryptRequest{Ciphertext: []byte("foo"), KeyID: "1"})
+			Expect(err).To(MatchError("ciphertext is too short"))
+		})
+	})
+})
@@ -0,0 +1,126 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/component-base/version"
+
+	"github.com/gardener/gardener/extensions/pkg/webhook"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/component/apiserver"
+	"github.com/gardener/gardener/pkg/provider-local/imagevector"
+	"github.com/gardener/gardener/pkg/provider-local/local"
+)
+
+const (
+	// KMSCredentialsDataKey is the data key in the KMS credentials secret whose value is used by the local KMS plugin
+	// for deriving the key encryption keys.
+	KMSCredentialsDataKey = "key"
+
+	containerNameKMSPlugin        = "kms-plugin"
+	volumeNameKMSCredentials      = "kms-credentials"
+	volumeMountPathKMSCredentials = "/var/run/secrets/kms-credentials"
+	kmsKeyIDBeforeInitialRotation = "0"
+)
+
+// ensureKMSPlugin injects the local KMS plugin as sidecar container into the kube-apiserver pod if the shoot uses the
+// KMS of type `local` for the encryption of resources.
+func ensureKMSPlugin(podSpec *corev1.PodSpec, shoot *gardencorev1beta1.Shoot) error {
+	kmsConfig := v1beta1helper.GetShootKMSConfig(shoot)
+	if kmsConfig == nil || kmsConfig.Type != local.Type {
+		return nil
+	}
+
+	credentialsSecretName, err := kmsCredentialsSecretName(shoot, kmsConfig)
+	if err != nil {
+		return err
+	}
+
+	image, err := imagevector.ImageVector().FindImage(imagevector.ImageNameLocalKmsPlugin)
+	if err != nil {
+		return err
+	}
+	image.WithOptionalTag(version.Get().GitVersion)
+
+	podSpec.Containers = webhook.EnsureContainerWithName(podSpec.Containers, corev1.Container{
+		Name:  containerNameKMSPlugin,
+		Image: image.String(),
+		Args: []string{
+			"--socket-path=" + filepath.Join(apiserver.VolumeMountPathKMSPluginSocket, apiserver.KMSPluginSocketFileName),
+			"--key-file=" + filepath.Join(volumeMountPathKMSCredentials, KMSCredentialsDataKey),
+			"--key-id=" + kmsKeyID(shoot),
+		},
+		Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse("10m"),
+				corev1.ResourceMemory: resource.MustParse("32Mi"),
+			},
+		},
+		VolumeMounts: []corev1.VolumeMount{
+			{
+				Name:      apiserver.VolumeNameKMSPluginSocket,
+				MountPath: apiserver.VolumeMountPathKMSPluginSocket,
+			},
+			{
+				Name:      volumeNameKMSCredentials,
+				MountPath: volumeMountPathKMSCredentials,
+				ReadOnly:  true,
+			},
+		},
+	})
+	podSpec.Volumes = webhook.EnsureVolumeWithName(podSpec.Volumes, corev1.Volume{
+		Name: volumeNameKMSCredentials,
+		VolumeSource: corev1.VolumeSource{
+			Secret: &corev1.SecretVolumeSource{
+				SecretName: credentialsSecretName,
+			},
+		},
+	})
+
+	return nil
+}
+
+func kmsCredentialsSecretName(shoot *gardencorev1beta1.Shoot, kmsConfig *gardencorev1beta1.KMSConfig) (string, error) {
+	if kmsConfig.CredentialsResourceName == nil {
+		return "", fmt.Errorf("KMS of type %q requires credentials", local.Type)
+	}
+
+	credentialsResource := v1beta1helper.GetResourceByName(shoot.Spec.Resources, *kmsConfig.CredentialsResourceName)
+	if credentialsResource == nil {
+		return "", fmt.Errorf("KMS credentials resource %q not found in shoot resources", *kmsConfig.CredentialsResourceName)
+	}
+
+	return v1beta1constants.ReferencedResourcesPrefix + credentialsResource.ResourceRef.Name, nil
+}
+
+// kmsKeyID computes the key ID which is used by the local KMS plugin for encrypting data. It changes with each ETCD
+// encryption key rotation, hence all resources get encrypted with a new key when they are rewritten during the
+// rotation. Since the key encryption keys are derived from the credentials and the key ID, the plugin is still able to
+// decrypt data which was encrypted with a previous key ID.
+func kmsKeyID(shoot *gardencorev1beta1.Shoot) string {
+	if credentials := shoot.Status.Credentials; credentials != nil && credentials.Rotation != nil &&
+		credentials.Rotation.ETCDEncryptionKey != nil && credentials.Rotation.ETCDEncryptionKey.LastInitiationTime != nil {
+		return strconv.FormatInt(credentials.Rotation.ETCDEncryptionKey.LastInitiationTime.Unix(), 10)
+	}
+	return kmsKeyIDBeforeInitialRotation
+}
@@ -0,0 +1,145 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package controlplane_test
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
+	extensionscontextwebhook "github.com/gardener/gardener/extensions/pkg/webhook/context"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/provider-local/webhook/controlplane"
+)
+
+var _ = Describe("KMS plugin", func() {
+	var (
+		ctx        = context.Background()
+		shoot      *gardencorev1beta1.Shoot
+		deployment *appsv1.Deployment
+	)
+
+	BeforeEach(func() {
+		shoot = &gardencorev1beta1.Shoot{
+			Spec: gardencorev1beta1.ShootSpec{
+				Kubernetes: gardencorev1beta1.Kubernetes{
+					KubeAPIServer: &gardencorev1beta1.KubeAPIServerConfig{
+						EncryptionConfig: &gardencorev1beta1.EncryptionConfig{
+							KMS: &gardencorev1beta1.KMSConfig{
+								Type:                    "local",
+								CredentialsResourceName: ptr.To("kms-credentials"),
+							},
+						},
+					},
+				},
+				Resources: []gardencorev1beta1.NamedResourceReference{{
+					Name:        "kms-credentials",
+					ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "my-kms-credentials"},
+				}},
+			},
+		}
+
+		deployment = &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "kube-apiserver", Namespace: "shoot--foo--bar"},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "kube-apiserver"}},
+					},
+				},
+			},
+		}
+	})
+
+	ensure := func() error {
+		gctx := extensionscontextwebhook.NewInternalGardenContext(&extensionscontroller.Cluster{Shoot: shoot})
+		return NewEnsurer(logr.Discard(), false).EnsureKubeAPIServerDeployment(ctx, gctx, deployment, nil)
+	}
+
+	It("should not inject the KMS plugin if no KMS is configured", func() {
+		shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig = nil
+
+		Expect(ensure()).To(Succeed())
+		Expect(deployment.Spec.Template.Spec.Containers).To(HaveLen(1))
+		Expect(deployment.Spec.Template.Spec.Volumes).To(BeEmpty())
+	})
+
+	It("should not inject the KMS plugin if the KMS is of another type", func() {
+		shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig.KMS.Type = "other"
+
+		Expect(ensure()).To(Succeed())
+		Expect(deployment.Spec.Template.Spec.Containers).To(HaveLen(1))
+	})
+
+	It("should inject the KMS plugin with the initial key ID", func() {
+		Expect(ensure()).To(Succeed())
+
+		Expect(deployment.Spec.Template.Spec.Containers).To(HaveLen(2))
+		container := deployment.Spec.Template.Spec.Containers[1]
+		Expect(container.Name).To(Equal("kms-plugin"))
+		Expect(container.Image).To(ContainSubstring("local-kms-plugin"))
+		Expect(container.Args).To(ConsistOf(
+			"--socket-path=/var/run/kms-plugin/socket.sock",
+			"--key-file=/var/run/secrets/kms-credentials/key",
+			"--key-id=0",
+		))
+		Expect(container.VolumeMounts).To(ConsistOf(
+			corev1.VolumeMount{Name: "kms-plugin-socket", MountPath: "/var/run/kms-plugin"},
+			corev1.VolumeMount{Name: "kms-credentials", MountPath: "/var/run/secrets/kms-credentials", ReadOnly: true},
+		))
+		Expect(deployment.Spec.Template.Spec.Volumes).To(ConsistOf(corev1.Volume{
+			Name: "kms-credentials",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{SecretName: "ref-my-kms-credentials"},
+			},
+		}))
+	})
+
+	It("should derive the key ID from the last initiation time of the ETCD encryption key rotation", func() {
+		lastInitiationTime := metav1.NewTime(time.Unix(1700000000, 0))
+		shoot.Status.Credentials = &gardencorev1beta1.ShootCredentials{
+			Rotation: &gardencorev1beta1.ShootCredentialsRotation{
+				ETCDEncryptionKey: &gardencorev1beta1.ETCDEncryptionKeyRotation{
+					Phase:              gardencorev1beta1.RotationPreparing,
+					LastInitiationTime: &lastInitiationTime,
+				},
+			},
+		}
+
+		Expect(ensure()).To(Succeed())
+		Expect(deployment.Spec.Template.Spec.Containers[1].Args).To(ContainElement("--key-id=1700000000"))
+	})
+
+	It("should fail if no credentials are configured", func() {
+		shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig.KMS.CredentialsResourceName = nil
+
+		Expect(ensure()).To(MatchError(`KMS of type "local" requires credentials`))
+	})
+
+	It("should fail if the credentials resource is not referenced", func() {
+		shoot.Spec.Resources = nil
+
+		Expect(ensure()).To(MatchError(`KMS credentials resource "kms-credentials" not found in shoot resources`))
+	})
+})
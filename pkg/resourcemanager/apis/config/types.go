@@ -140,6 +140,23 @@ type HealthControllerConfig struct {
 	ConcurrentSyncs *int
 	// SyncPeriod is the duration how often the controller performs its reconciliation.
 	SyncPeriod *metav1.Duration
+	// HealthRules are CEL-based health rules for resources of arbitrary kinds. They are evaluated in addition to the
+	// built-in health checks.
+	HealthRules []HealthRule
+}
+
+// HealthRule is a CEL-based health rule for resources of a given kind.
+type HealthRule struct {
+	// Group is the API group of the resources.
+	Group string
+	// Kind is the kind of the resources.
+	Kind string
+	// Healthy is a CEL expression which is evaluated against the object (accessible via `self`). The object is
+	// considered healthy if the expression evaluates to true, e.g., `self.status.phase == 'Ready'`.
+	Healthy string
+	// Progressing is a CEL expression which is evaluated against the object (accessible via `self`). The object is
+	// considered progressing if the expression evaluates to true.
+	Progressing *string
 }
 
 // ManagedResourceControllerConfig is the configuration for the managed resource controller.
@@ -153,6 +153,26 @@ type HealthControllerConfig struct {
 	// SyncPeriod is the duration how often the controller performs its reconciliation.
 	// +optional
 	SyncPeriod *metav1.Duration `json:"syncPeriod,omitempty"`
+	// HealthRules are CEL-based health rules for resources of arbitrary kinds. They are evaluated in addition to the
+	// built-in health checks.
+	// +optional
+	HealthRules []HealthRule `json:"healthRules,omitempty"`
+}
+
+// HealthRule is a CEL-based health rule for resources of a given kind.
+type HealthRule struct {
+	// Group is the API group of the resources.
+	// +optional
+	Group string `json:"group,omitempty"`
+	// Kind is the kind of the resources.
+	Kind string `json:"kind"`
+	// Healthy is a CEL expression which is evaluated against the object (accessible via `self`). The object is
+	// considered healthy if the expression evaluates to true, e.g., `self.status.phase == 'Ready'`.
+	Healthy string `json:"healthy"`
+	// Progressing is a CEL expression which is evaluated against the object (accessible via `self`). The object is
+	// considered progressing if the expression evaluates to true.
+	// +optional
+	Progressing *string `json:"progressing,omitempty"`
 }
 
 // ManagedResourceControllerConfig is the configuration for the managed resource controller.
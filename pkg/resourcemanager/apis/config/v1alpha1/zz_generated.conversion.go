@@ -98,6 +98,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*HealthRule)(nil), (*config.HealthRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_HealthRule_To_config_HealthRule(a.(*HealthRule), b.(*config.HealthRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.HealthRule)(nil), (*HealthRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_HealthRule_To_v1alpha1_HealthRule(a.(*config.HealthRule), b.(*HealthRule), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*HighAvailabilityConfigWebhookConfig)(nil), (*config.HighAvailabilityConfigWebhookConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_HighAvailabilityConfigWebhookConfig_To_config_HighAvailabilityConfigWebhookConfig(a.(*HighAvailabilityConfigWebhookConfig), b.(*config.HighAvailabilityConfigWebhookConfig), scope)
 	}); err != nil {
@@ -464,6 +474,7 @@ func Convert_config_HTTPSServer_To_v1alpha1_HTTPSServer(in *config.HTTPSServer,
 func autoConvert_v1alpha1_HealthControllerConfig_To_config_HealthControllerConfig(in *HealthControllerConfig, out *config.HealthControllerConfig, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.HealthRules = *(*[]config.HealthRule)(unsafe.Pointer(&in.HealthRules))
 	return nil
 }
 
@@ -475,6 +486,7 @@ func Convert_v1alpha1_HealthControllerConfig_To_config_HealthControllerConfig(in
 func autoConvert_config_HealthControllerConfig_To_v1alpha1_HealthControllerConfig(in *config.HealthControllerConfig, out *HealthControllerConfig, s conversion.Scope) error {
 	out.ConcurrentSyncs = (*int)(unsafe.Pointer(in.ConcurrentSyncs))
 	out.SyncPeriod = (*v1.Duration)(unsafe.Pointer(in.SyncPeriod))
+	out.HealthRules = *(*[]HealthRule)(unsafe.Pointer(&in.HealthRules))
 	return nil
 }
 
@@ -483,6 +495,32 @@ func Convert_config_HealthControllerConfig_To_v1alpha1_HealthControllerConfig(in
 	return autoConvert_config_HealthControllerConfig_To_v1alpha1_HealthControllerConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_HealthRule_To_config_HealthRule(in *HealthRule, out *config.HealthRule, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Kind = in.Kind
+	out.Healthy = in.Healthy
+	out.Progressing = (*string)(unsafe.Pointer(in.Progressing))
+	return nil
+}
+
+// Convert_v1alpha1_HealthRule_To_config_HealthRule is an autogenerated conversion function.
+func Convert_v1alpha1_HealthRule_To_config_HealthRule(in *HealthRule, out *config.HealthRule, s conversion.Scope) error {
+	return autoConvert_v1alpha1_HealthRule_To_config_HealthRule(in, out, s)
+}
+
+func autoConvert_config_HealthRule_To_v1alpha1_HealthRule(in *config.HealthRule, out *HealthRule, s conversion.Scope) error {
+	out.Group = in.Group
+	out.Kind = in.Kind
+	out.Healthy = in.Healthy
+	out.Progressing = (*string)(unsafe.Pointer(in.Progressing))
+	return nil
+}
+
+// Convert_config_HealthRule_To_v1alpha1_HealthRule is an autogenerated conversion function.
+func Convert_config_HealthRule_To_v1alpha1_HealthRule(in *config.HealthRule, out *HealthRule, s conversion.Scope) error {
+	return autoConvert_config_HealthRule_To_v1alpha1_HealthRule(in, out, s)
+}
+
 func autoConvert_v1alpha1_HighAvailabilityConfigWebhookConfig_To_config_HighAvailabilityConfigWebhookConfig(in *HighAvailabilityConfigWebhookConfig, out *config.HighAvailabilityConfigWebhookConfig, s conversion.Scope) error {
 	out.Enabled = in.Enabled
 	out.DefaultNotReadyTolerationSeconds = (*int64)(unsafe.Pointer(in.DefaultNotReadyTolerationSeconds))
@@ -143,6 +143,13 @@ func (in *HealthControllerConfig) DeepCopyInto(out *HealthControllerConfig) {
 		*out = new(v1.Duration)
 		**out = **in
 	}
+	if in.HealthRules != nil {
+		in, out := &in.HealthRules, &out.HealthRules
+		*out = make([]HealthRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -156,6 +163,27 @@ func (in *HealthControllerConfig) DeepCopy() *HealthControllerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthRule) DeepCopyInto(out *HealthRule) {
+	*out = *in
+	if in.Progressing != nil {
+		in, out := &in.Progressing, &out.Progressing
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthRule.
+func (in *HealthRule) DeepCopy() *HealthRule {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HighAvailabilityConfigWebhookConfig) DeepCopyInto(out *HighAvailabilityConfigWebhookConfig) {
 	*out = *in
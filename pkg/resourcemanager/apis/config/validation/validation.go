@@ -106,6 +106,7 @@ func validateResourceManagerControllerConfiguration(conf config.ResourceManagerC
 
 	allErrs = append(allErrs, validateConcurrentSyncs(conf.Health.ConcurrentSyncs, fldPath.Child("health"))...)
 	allErrs = append(allErrs, validateSyncPeriod(conf.Health.SyncPeriod, fldPath.Child("health"))...)
+	allErrs = append(allErrs, validateHealthRules(conf.Health.HealthRules, fldPath.Child("health", "healthRules"))...)
 
 	allErrs = append(allErrs, validateManagedResourceControllerConfiguration(conf.ManagedResource, fldPath.Child("managedResources"))...)
 
@@ -118,6 +119,33 @@ func validateResourceManagerControllerConfiguration(conf config.ResourceManagerC
 	return allErrs
 }
 
+func validateHealthRules(rules []config.HealthRule, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	groupKinds := sets.New[string]()
+	for i, rule := range rules {
+		idxPath := fldPath.Index(i)
+
+		if len(rule.Kind) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("kind"), "must provide a kind"))
+		}
+		if len(rule.Healthy) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("healthy"), "must provide a health rule"))
+		}
+		if rule.Progressing != nil && len(*rule.Progressing) == 0 {
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("progressing"), *rule.Progressing, "must not be empty if specified"))
+		}
+
+		groupKind := rule.Kind + "." + rule.Group
+		if groupKinds.Has(groupKind) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, groupKind))
+		}
+		groupKinds.Insert(groupKind)
+	}
+
+	return allErrs
+}
+
 func validateManagedResourceControllerConfiguration(conf config.ManagedResourceControllerConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
@@ -260,6 +260,42 @@ var _ = Describe("Validation", func() {
 						})),
 					))
 				})
+
+				It("should allow valid health rules", func() {
+					conf.Controllers.Health.HealthRules = []config.HealthRule{
+						{Group: "example.com", Kind: "Foo", Healthy: "self.status.ready", Progressing: ptr.To("self.status.updating")},
+						{Group: "example.com", Kind: "Bar", Healthy: "self.status.ready"},
+					}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(BeEmpty())
+				})
+
+				It("should return errors because health rules are invalid", func() {
+					conf.Controllers.Health.HealthRules = []config.HealthRule{
+						{Group: "example.com", Kind: "Foo", Healthy: "self.status.ready"},
+						{Group: "example.com", Progressing: ptr.To("")},
+						{Group: "example.com", Kind: "Foo", Healthy: "self.status.ready"},
+					}
+
+					Expect(ValidateResourceManagerConfiguration(conf)).To(ConsistOf(
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("controllers.health.healthRules[1].kind"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeRequired),
+							"Field": Equal("controllers.health.healthRules[1].healthy"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeInvalid),
+							"Field": Equal("controllers.health.healthRules[1].progressing"),
+						})),
+						PointTo(MatchFields(IgnoreExtras, Fields{
+							"Type":  Equal(field.ErrorTypeDuplicate),
+							"Field": Equal("controllers.health.healthRules[2]"),
+						})),
+					))
+				})
 			})
 
 			Context("managed resources", func() {
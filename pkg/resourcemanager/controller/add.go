@@ -35,6 +35,7 @@ import (
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/managedresource"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/networkpolicy"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/node"
+	nodedrain "github.com/gardener/gardener/pkg/resourcemanager/controller/node/drain"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/secret"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/tokeninvalidator"
 	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
@@ -119,6 +120,12 @@ func AddToManager(ctx context.Context, mgr manager.Manager, sourceCluster, targe
 		}).AddToManager(mgr, targetCluster); err != nil {
 			return fmt.Errorf("failed adding node controller: %w", err)
 		}
+
+		if err := (&nodedrain.Reconciler{
+			Config: cfg.Controllers.Node,
+		}).AddToManager(mgr, targetCluster); err != nil {
+			return fmt.Errorf("failed adding node drain controller: %w", err)
+		}
 	}
 
 	return nil
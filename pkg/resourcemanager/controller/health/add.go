@@ -24,14 +24,21 @@ import (
 	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/health/health"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/health/progressing"
+	"github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
 	resourcemanagerpredicate "github.com/gardener/gardener/pkg/resourcemanager/predicate"
 )
 
 // AddToManager adds all health controllers to the given manager.
 func AddToManager(ctx context.Context, mgr manager.Manager, sourceCluster, targetCluster cluster.Cluster, cfg config.ResourceManagerConfiguration) error {
+	healthRules, err := utils.NewHealthRules(cfg.Controllers.Health.HealthRules)
+	if err != nil {
+		return fmt.Errorf("failed compiling health rules: %w", err)
+	}
+
 	if err := (&health.Reconciler{
 		Config:      cfg.Controllers.Health,
 		ClassFilter: resourcemanagerpredicate.NewClassFilter(*cfg.Controllers.ResourceClass),
+		HealthRules: healthRules,
 	}).AddToManager(ctx, mgr, sourceCluster, targetCluster, *cfg.Controllers.ClusterID); err != nil {
 		return fmt.Errorf("failed adding health reconciler: %w", err)
 	}
@@ -39,6 +46,7 @@ func AddToManager(ctx context.Context, mgr manager.Manager, sourceCluster, targe
 	if err := (&progressing.Reconciler{
 		Config:      cfg.Controllers.Health,
 		ClassFilter: resourcemanagerpredicate.NewClassFilter(*cfg.Controllers.ResourceClass),
+		HealthRules: healthRules,
 	}).AddToManager(ctx, mgr, sourceCluster, targetCluster, *cfg.Controllers.ClusterID); err != nil {
 		return fmt.Errorf("failed adding progressing reconciler: %w", err)
 	}
@@ -59,6 +59,14 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, sour
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
+	if r.HealthRules == nil {
+		healthRules, err := utils.NewHealthRules(r.Config.HealthRules)
+		if err != nil {
+			return err
+		}
+		r.HealthRules = healthRules
+	}
+	r.targetCache = targetCluster.GetCache()
 
 	c, err := builder.
 		ControllerManagedBy(mgr).
@@ -110,7 +118,7 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, sour
 		if err := c.Watch(
 			source.Kind(targetCluster.GetCache(), obj),
 			mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), utils.MapToOriginManagedResource(clusterID), mapper.UpdateWithNew, c.GetLogger()),
-			utils.HealthStatusChanged(c.GetLogger()),
+			utils.HealthStatusChanged(c.GetLogger(), r.HealthRules, gvk.GroupKind()),
 		); err != nil {
 			return fmt.Errorf("error starting watch for GVK %s: %w", gvk.String(), err)
 		}
@@ -23,6 +23,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/clock"
@@ -47,7 +48,10 @@ type Reconciler struct {
 	Config       config.HealthControllerConfig
 	Clock        clock.Clock
 	ClassFilter  *resourcemanagerpredicate.ClassFilter
+	HealthRules  *utils.HealthRules
 
+	// targetCache is used for reading unstructured objects which are watched because of configured health rules.
+	targetCache client.Reader
 	// ensureWatchForGVK ensures that the controller is watching the given object to reconcile corresponding
 	// ManagedResources on health status changes.
 	ensureWatchForGVK func(gvk schema.GroupVersionKind, obj client.Object) error
@@ -117,7 +121,7 @@ func (r *Reconciler) executeHealthChecks(ctx context.Context, log logr.Logger, m
 			objectLog = log.WithValues("object", objectKey, "objectGVK", objectGVK)
 		)
 
-		obj, err := newObjectForHealthCheck(objectLog, r.TargetScheme, objectGVK)
+		obj, err := utils.NewObjectForHealthCheck(objectLog, r.TargetScheme, objectGVK, r.HealthRules)
 		if err != nil {
 			return reconcile.Result{}, fmt.Errorf("failed to construct new object for reference: %w", err)
 		}
@@ -127,7 +131,12 @@ func (r *Reconciler) executeHealthChecks(ctx context.Context, log logr.Logger, m
 			return reconcile.Result{}, err
 		}
 
-		if err := r.TargetClient.Get(healthCheckCtx, objectKey, obj); err != nil {
+		var reader client.Reader = r.TargetClient
+		if _, ok := obj.(*unstructured.Unstructured); ok {
+			reader = r.targetCache
+		}
+
+		if err := reader.Get(healthCheckCtx, objectKey, obj); err != nil {
 			if !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
 				return reconcile.Result{}, err
 			}
@@ -150,7 +159,18 @@ func (r *Reconciler) executeHealthChecks(ctx context.Context, log logr.Logger, m
 			return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
 		}
 
-		if checked, err := utils.CheckHealth(obj); err != nil {
+		// objects which are only watched metadata-only have to be fetched entirely for evaluating health rules
+		// specified via annotations
+		if _, ok := obj.(*metav1.PartialObjectMetadata); ok && r.HealthRules.HealthyExpression(objectGVK.GroupKind(), obj) != "" {
+			fullObj := &unstructured.Unstructured{}
+			fullObj.SetGroupVersionKind(objectGVK)
+			if err := r.TargetClient.Get(healthCheckCtx, objectKey, fullObj); err != nil {
+				return reconcile.Result{}, err
+			}
+			obj = fullObj
+		}
+
+		if checked, err := utils.CheckHealthWithRules(r.HealthRules, objectGVK.GroupKind(), obj); err != nil {
 			var (
 				reason  = ref.Kind + "Unhealthy"
 				message = fmt.Sprintf("%s %q is unhealthy: %v", ref.Kind, objectKey.String(), err)
@@ -195,25 +215,3 @@ func (r *Reconciler) executeHealthChecks(ctx context.Context, log logr.Logger, m
 	log.Info("Finished ManagedResource health checks", "status", "healthy")
 	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
 }
-
-func newObjectForHealthCheck(log logr.Logger, scheme *runtime.Scheme, gvk schema.GroupVersionKind) (client.Object, error) {
-	// Create a typed object if GVK is registered in scheme. This object will be fully watched in the target cluster.
-	// If we don't know the GVK, we definitely don't have a dedicated health check for it.
-	// I.e., we only care about whether the object is present or not.
-	// Hence, we can use metadata-only requests/watches instead of watching the entire object, which saves bandwidth and
-	// memory.
-	// If the target cache is disabled, no watches will be started.
-	typedObject, err := scheme.New(gvk)
-	if err != nil {
-		if !runtime.IsNotRegisteredError(err) {
-			return nil, err
-		}
-
-		log.V(1).Info("Falling back to metadata-only object for health checks (not registered in the target scheme)", "groupVersionKind", gvk, "err", err.Error())
-		obj := &metav1.PartialObjectMetadata{}
-		obj.SetGroupVersionKind(gvk)
-		return obj, nil
-	}
-
-	return typedObject.(client.Object), nil
-}
@@ -29,6 +29,7 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
@@ -56,9 +57,20 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, sour
 	if r.TargetClient == nil {
 		r.TargetClient = targetCluster.GetClient()
 	}
+	if r.TargetScheme == nil {
+		r.TargetScheme = targetCluster.GetScheme()
+	}
 	if r.Clock == nil {
 		r.Clock = clock.RealClock{}
 	}
+	if r.HealthRules == nil {
+		healthRules, err := utils.NewHealthRules(r.Config.HealthRules)
+		if err != nil {
+			return err
+		}
+		r.HealthRules = healthRules
+	}
+	r.targetCache = targetCluster.GetCache()
 
 	c, err := builder.
 		ControllerManagedBy(mgr).
@@ -100,10 +112,15 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, sour
 			continue
 		}
 
+		gvk, err := apiutil.GVKForObject(obj, targetCluster.GetScheme())
+		if err != nil {
+			return err
+		}
+
 		if err := c.Watch(
 			source.Kind(targetCluster.GetCache(), obj),
 			mapper.EnqueueRequestsFrom(ctx, mgr.GetCache(), utils.MapToOriginManagedResource(clusterID), mapper.UpdateWithNew, c.GetLogger()),
-			r.ProgressingStatusChanged(ctx),
+			r.ProgressingStatusChanged(ctx, gvk.GroupKind()),
 		); err != nil {
 			return err
 		}
@@ -129,7 +146,7 @@ func (r *Reconciler) AddToManager(ctx context.Context, mgr manager.Manager, sour
 
 // ProgressingStatusChanged returns a predicate that filters for events that indicate a change in the object's
 // progressing status.
-func (r *Reconciler) ProgressingStatusChanged(ctx context.Context) predicate.Predicate {
+func (r *Reconciler) ProgressingStatusChanged(ctx context.Context, groupKind schema.GroupKind) predicate.Predicate {
 	return predicate.Funcs{
 		CreateFunc: func(_ event.CreateEvent) bool { return false },
 		UpdateFunc: func(e event.UpdateEvent) bool {
@@ -138,8 +155,8 @@ func (r *Reconciler) ProgressingStatusChanged(ctx context.Context) predicate.Pre
 				return true
 			}
 
-			oldProgressing, _, _ := r.checkProgressing(ctx, e.ObjectOld)
-			newProgressing, _, _ := r.checkProgressing(ctx, e.ObjectNew)
+			oldProgressing, _, _ := r.checkProgressing(ctx, groupKind, e.ObjectOld)
+			newProgressing, _, _ := r.checkProgressing(ctx, groupKind, e.ObjectNew)
 
 			return oldProgressing != newProgressing
 		},
@@ -23,6 +23,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -50,7 +51,7 @@ var _ = Describe("Add", func() {
 		var p predicate.Predicate
 
 		BeforeEach(func() {
-			p = reconciler.ProgressingStatusChanged(ctx)
+			p = reconciler.ProgressingStatusChanged(ctx, schema.GroupKind{Group: appsv1.GroupName, Kind: "Deployment"})
 		})
 
 		Describe("#Create", func() {
@@ -25,6 +25,10 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/clock"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -45,9 +49,14 @@ import (
 type Reconciler struct {
 	SourceClient client.Client
 	TargetClient client.Client
+	TargetScheme *runtime.Scheme
 	Config       config.HealthControllerConfig
 	Clock        clock.Clock
 	ClassFilter  *resourcemanagerpredicate.ClassFilter
+	HealthRules  *utils.HealthRules
+
+	// targetCache is used for reading unstructured objects which are watched because of configured health rules.
+	targetCache client.Reader
 }
 
 // Reconcile performs the progressing checks.
@@ -106,37 +115,18 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 	conditionResourcesProgressing := v1beta1helper.GetOrInitConditionWithClock(r.Clock, mr.Status.Conditions, resourcesv1alpha1.ResourcesProgressing)
 
 	for _, ref := range mr.Status.Resources {
-		// Skip API groups that are irrelevant for progressing checks.
-		if !sets.New(appsv1.GroupName, monitoring.GroupName, certv1alpha1.GroupName).Has(ref.GroupVersionKind().Group) {
-			continue
-		}
-
-		var obj client.Object
-		switch ref.Kind {
-		case "Deployment":
-			obj = &appsv1.Deployment{}
-		case "StatefulSet":
-			obj = &appsv1.StatefulSet{}
-		case "DaemonSet":
-			obj = &appsv1.DaemonSet{}
-		case "Prometheus":
-			obj = &monitoringv1.Prometheus{}
-		case "Alertmanager":
-			obj = &monitoringv1.Alertmanager{}
-		case "Certificate":
-			obj = &certv1alpha1.Certificate{}
-		case "Issuer":
-			obj = &certv1alpha1.Issuer{}
-		default:
-			continue
-		}
-
 		var (
+			objectGVK = ref.GroupVersionKind()
 			objectKey = client.ObjectKey{Namespace: ref.Namespace, Name: ref.Name}
-			objectLog = log.WithValues("object", objectKey, "objectGVK", ref.GroupVersionKind())
+			objectLog = log.WithValues("object", objectKey, "objectGVK", objectGVK)
 		)
 
-		if err := r.TargetClient.Get(checkCtx, objectKey, obj); err != nil {
+		obj, reader, err := r.newObjectForProgressingCheck(objectLog, objectGVK)
+		if err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to construct new object for reference: %w", err)
+		}
+
+		if err := reader.Get(checkCtx, objectKey, obj); err != nil {
 			if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
 				// missing objects already handled by health controller, skip
 				continue
@@ -144,7 +134,25 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 			return reconcile.Result{}, err
 		}
 
-		if progressing, description, err := r.checkProgressing(ctx, obj); err != nil {
+		if _, ok := obj.(*metav1.PartialObjectMetadata); ok {
+			if r.HealthRules.ProgressingExpression(objectGVK.GroupKind(), obj) == "" {
+				continue
+			}
+
+			// objects which are only watched metadata-only have to be fetched entirely for evaluating progressing rules
+			// specified via annotations
+			fullObj := &unstructured.Unstructured{}
+			fullObj.SetGroupVersionKind(objectGVK)
+			if err := r.TargetClient.Get(checkCtx, objectKey, fullObj); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return reconcile.Result{}, err
+			}
+			obj = fullObj
+		}
+
+		if progressing, description, err := r.checkProgressing(ctx, objectGVK.GroupKind(), obj); err != nil {
 			return reconcile.Result{}, err
 		} else if progressing {
 			var (
@@ -186,9 +194,44 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 	return reconcile.Result{RequeueAfter: r.Config.SyncPeriod.Duration}, nil
 }
 
+// newObjectForProgressingCheck returns a new object for the given GroupVersionKind and the reader which should be used
+// for fetching it. Kinds with built-in progressing checks are read as typed objects. Objects of all other kinds are
+// read in the same way as the health controller does (see utils.NewObjectForHealthCheck), i.e., they are served from
+// the cache which is already populated by the health controller.
+func (r *Reconciler) newObjectForProgressingCheck(log logr.Logger, gvk schema.GroupVersionKind) (client.Object, client.Reader, error) {
+	if sets.New(appsv1.GroupName, monitoring.GroupName, certv1alpha1.GroupName).Has(gvk.Group) {
+		switch gvk.Kind {
+		case "Deployment":
+			return &appsv1.Deployment{}, r.TargetClient, nil
+		case "StatefulSet":
+			return &appsv1.StatefulSet{}, r.TargetClient, nil
+		case "DaemonSet":
+			return &appsv1.DaemonSet{}, r.TargetClient, nil
+		case "Prometheus":
+			return &monitoringv1.Prometheus{}, r.TargetClient, nil
+		case "Alertmanager":
+			return &monitoringv1.Alertmanager{}, r.TargetClient, nil
+		case "Certificate":
+			return &certv1alpha1.Certificate{}, r.TargetClient, nil
+		case "Issuer":
+			return &certv1alpha1.Issuer{}, r.TargetClient, nil
+		}
+	}
+
+	obj, err := utils.NewObjectForHealthCheck(log, r.TargetScheme, gvk, r.HealthRules)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if _, ok := obj.(*unstructured.Unstructured); ok {
+		return obj, r.targetCache, nil
+	}
+	return obj, r.TargetClient, nil
+}
+
 // checkProgressing checks whether the given object is progressing. It returns a bool indicating whether the object is
 // progressing, a reason for it if so and an error if the check failed.
-func (r *Reconciler) checkProgressing(ctx context.Context, obj client.Object) (bool, string, error) {
+func (r *Reconciler) checkProgressing(ctx context.Context, groupKind schema.GroupKind, obj client.Object) (bool, string, error) {
 	if obj.GetAnnotations()[resourcesv1alpha1.SkipHealthCheck] == "true" {
 		return false, "", nil
 	}
@@ -234,5 +277,9 @@ func (r *Reconciler) checkProgressing(ctx context.Context, obj client.Object) (b
 		progressing, reason = health.IsCertificateIssuerProgressing(o)
 	}
 
+	if !progressing && r.HealthRules != nil {
+		return r.HealthRules.CheckProgressing(groupKind, obj)
+	}
+
 	return progressing, reason, nil
 }
@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -29,7 +30,8 @@ import (
 )
 
 // HealthStatusChanged returns a predicate that filters for events that indicate a change in the object's health status.
-func HealthStatusChanged(log logr.Logger) predicate.Predicate {
+// The given health rules are considered in addition to the built-in health checks.
+func HealthStatusChanged(log logr.Logger, healthRules *HealthRules, groupKind schema.GroupKind) predicate.Predicate {
 	return predicate.Funcs{
 		CreateFunc: func(e event.CreateEvent) bool {
 			return e.Object.GetAnnotations()[resourcesv1alpha1.SkipHealthCheck] != "true"
@@ -44,7 +46,7 @@ func HealthStatusChanged(log logr.Logger) predicate.Predicate {
 			}
 
 			var oldHealthy, newHealthy bool
-			checked, oldErr := CheckHealth(e.ObjectOld)
+			checked, oldErr := CheckHealthWithRules(healthRules, groupKind, e.ObjectOld)
 			if !checked {
 				if oldErr != nil {
 					log.Error(oldErr, "Error determining health status of old object", "object", e.ObjectOld)
@@ -53,7 +55,7 @@ func HealthStatusChanged(log logr.Logger) predicate.Predicate {
 			}
 			oldHealthy = oldErr != nil
 
-			checked, newErr := CheckHealth(e.ObjectNew)
+			checked, newErr := CheckHealthWithRules(healthRules, groupKind, e.ObjectNew)
 			if !checked {
 				if newErr != nil {
 					log.Error(newErr, "Error determining health status of new object", "object", e.ObjectNew)
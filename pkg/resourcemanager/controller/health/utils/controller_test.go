@@ -23,6 +23,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -30,6 +31,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
 	. "github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
 )
 
@@ -41,7 +43,7 @@ var _ = Describe("HealthStatusChanged", func() {
 
 	BeforeEach(func() {
 		log = logger.MustNewZapLogger(logger.DebugLevel, logger.FormatJSON, logzap.WriteTo(GinkgoWriter))
-		p = HealthStatusChanged(log)
+		p = HealthStatusChanged(log, nil, appsv1.SchemeGroupVersion.WithKind("Deployment").GroupKind())
 	})
 
 	Context("metadata-only events", func() {
@@ -161,6 +163,39 @@ var _ = Describe("HealthStatusChanged", func() {
 		})
 	})
 
+	Context("typed events with health rule", func() {
+		var (
+			healthy, unhealthy *appsv1.Deployment
+		)
+
+		BeforeEach(func() {
+			healthRules, err := NewHealthRules([]config.HealthRule{{
+				Group:   appsv1.GroupName,
+				Kind:    "Deployment",
+				Healthy: "!has(self.spec.minReadySeconds)",
+			}})
+			Expect(err).NotTo(HaveOccurred())
+			p = HealthStatusChanged(log, healthRules, schema.GroupKind{Group: appsv1.GroupName, Kind: "Deployment"})
+
+			// typed objects received from the cache don't have their TypeMeta set
+			healthy = &appsv1.Deployment{
+				Status: appsv1.DeploymentStatus{Conditions: []appsv1.DeploymentCondition{{
+					Type:   appsv1.DeploymentAvailable,
+					Status: corev1.ConditionTrue,
+				}}},
+			}
+			healthy.SetResourceVersion("1")
+			unhealthy = healthy.DeepCopy()
+			unhealthy.Spec.MinReadySeconds = 5
+			unhealthy.SetResourceVersion("2")
+		})
+
+		It("should return true for Update, if the health status according to the rule has changed", func() {
+			Expect(p.Update(event.UpdateEvent{ObjectOld: healthy, ObjectNew: unhealthy})).To(BeTrue())
+			Expect(p.Update(event.UpdateEvent{ObjectOld: unhealthy, ObjectNew: healthy})).To(BeTrue())
+		})
+	})
+
 	Describe("#MapToOriginManagedResource", func() {
 		var (
 			ctx = context.TODO()
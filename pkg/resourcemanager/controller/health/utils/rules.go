@@ -0,0 +1,271 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/google/cel-go/cel"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/lru"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+)
+
+// ruleCostLimit limits the cost of evaluating a single health rule to prevent expensive expressions from blocking the
+// controllers.
+const ruleCostLimit = 1000000
+
+// annotationProgramCacheSize is the maximum number of compiled programs for rules specified via annotations which are
+// cached. Annotations are controlled by the users of the ManagedResources, hence their number is not bounded.
+const annotationProgramCacheSize = 256
+
+// HealthRules evaluates CEL-based health rules. Rules are either configured for all resources of a given kind or
+// specified via annotations on single objects (resourcesv1alpha1.HealthRule, resourcesv1alpha1.ProgressingRule).
+// Annotations take precedence over configured rules.
+type HealthRules struct {
+	env   *cel.Env
+	rules map[schema.GroupKind]config.HealthRule
+
+	// programs contains the compiled programs of the configured rules. It is not modified after construction.
+	programs map[string]cel.Program
+	// annotationPrograms caches the compiled programs of rules specified via annotations.
+	annotationPrograms *lru.Cache
+}
+
+// NewHealthRules compiles the given health rules and returns a new HealthRules object.
+func NewHealthRules(rules []config.HealthRule) (*HealthRules, error) {
+	env, err := cel.NewEnv(cel.Variable("self", cel.DynType))
+	if err != nil {
+		return nil, fmt.Errorf("failed creating CEL environment: %w", err)
+	}
+
+	h := &HealthRules{
+		env:                env,
+		rules:              make(map[schema.GroupKind]config.HealthRule, len(rules)),
+		programs:           make(map[string]cel.Program),
+		annotationPrograms: lru.New(annotationProgramCacheSize),
+	}
+
+	for _, rule := range rules {
+		prg, err := h.compile(rule.Healthy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid health rule for %s/%s: %w", rule.Group, rule.Kind, err)
+		}
+		h.programs[rule.Healthy] = prg
+
+		if rule.Progressing != nil {
+			prg, err := h.compile(*rule.Progressing)
+			if err != nil {
+				return nil, fmt.Errorf("invalid progressing rule for %s/%s: %w", rule.Group, rule.Kind, err)
+			}
+			h.programs[*rule.Progressing] = prg
+		}
+
+		h.rules[schema.GroupKind{Group: rule.Group, Kind: rule.Kind}] = rule
+	}
+
+	return h, nil
+}
+
+// HasRule returns true if a health rule is configured for resources of the given kind.
+func (h *HealthRules) HasRule(groupKind schema.GroupKind) bool {
+	_, ok := h.rules[groupKind]
+	return ok
+}
+
+// HealthyExpression returns the CEL expression for checking the health of the given object. It returns an empty
+// string if there is no health rule for the object.
+func (h *HealthRules) HealthyExpression(groupKind schema.GroupKind, obj metav1.Object) string {
+	if expression, ok := obj.GetAnnotations()[resourcesv1alpha1.HealthRule]; ok {
+		return expression
+	}
+	return h.rules[groupKind].Healthy
+}
+
+// ProgressingExpression returns the CEL expression for checking whether the given object is progressing. It returns an
+// empty string if there is no progressing rule for the object.
+func (h *HealthRules) ProgressingExpression(groupKind schema.GroupKind, obj metav1.Object) string {
+	if expression, ok := obj.GetAnnotations()[resourcesv1alpha1.ProgressingRule]; ok {
+		return expression
+	}
+	if rule, ok := h.rules[groupKind]; ok && rule.Progressing != nil {
+		return *rule.Progressing
+	}
+	return ""
+}
+
+// CheckHealth checks whether the given object is healthy according to its health rule.
+// It returns a bool indicating whether the object was actually checked and an error if the health check failed.
+func (h *HealthRules) CheckHealth(groupKind schema.GroupKind, obj client.Object) (bool, error) {
+	if obj.GetAnnotations()[resourcesv1alpha1.SkipHealthCheck] == "true" {
+		return false, nil
+	}
+
+	expression := h.HealthyExpression(groupKind, obj)
+	if expression == "" {
+		return false, nil
+	}
+
+	healthy, err := h.evaluate(expression, obj)
+	if err != nil {
+		return true, err
+	}
+	if !healthy {
+		return true, fmt.Errorf("health rule %q is not satisfied", expression)
+	}
+	return true, nil
+}
+
+// CheckProgressing checks whether the given object is progressing according to its progressing rule. It returns a
+// bool indicating whether the object is progressing, a reason for it if so and an error if the check failed.
+func (h *HealthRules) CheckProgressing(groupKind schema.GroupKind, obj client.Object) (bool, string, error) {
+	if obj.GetAnnotations()[resourcesv1alpha1.SkipHealthCheck] == "true" {
+		return false, "", nil
+	}
+
+	expression := h.ProgressingExpression(groupKind, obj)
+	if expression == "" {
+		return false, "", nil
+	}
+
+	progressing, err := h.evaluate(expression, obj)
+	if err != nil {
+		return false, "", err
+	}
+	if progressing {
+		return true, fmt.Sprintf("progressing rule %q is satisfied", expression), nil
+	}
+	return false, "", nil
+}
+
+func (h *HealthRules) evaluate(expression string, obj client.Object) (bool, error) {
+	if _, ok := obj.(*metav1.PartialObjectMetadata); ok {
+		return false, fmt.Errorf("cannot evaluate rule %q against metadata-only object", expression)
+	}
+
+	prg, err := h.program(expression)
+	if err != nil {
+		return false, err
+	}
+
+	var content map[string]interface{}
+	if u, ok := obj.(*unstructured.Unstructured); ok {
+		content = u.Object
+	} else {
+		content, err = runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return false, fmt.Errorf("failed converting object to unstructured: %w", err)
+		}
+	}
+
+	out, _, err := prg.Eval(map[string]interface{}{"self": content})
+	if err != nil {
+		return false, fmt.Errorf("failed evaluating rule %q: %w", expression, err)
+	}
+
+	result, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("rule %q evaluated to %v instead of a boolean", expression, out.Value())
+	}
+	return result, nil
+}
+
+// program returns the compiled program for the given expression. Programs of rules specified via annotations are
+// compiled on demand and kept in a bounded cache.
+func (h *HealthRules) program(expression string) (cel.Program, error) {
+	if prg, ok := h.programs[expression]; ok {
+		return prg, nil
+	}
+	if prg, ok := h.annotationPrograms.Get(expression); ok {
+		return prg.(cel.Program), nil
+	}
+
+	prg, err := h.compile(expression)
+	if err != nil {
+		return nil, err
+	}
+
+	h.annotationPrograms.Add(expression, prg)
+	return prg, nil
+}
+
+func (h *HealthRules) compile(expression string) (cel.Program, error) {
+	ast, issues := h.env.Compile(expression)
+	if issues.Err() != nil {
+		return nil, fmt.Errorf("failed compiling rule %q: %w", expression, issues.Err())
+	}
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, fmt.Errorf("rule %q must evaluate to a boolean, got %s", expression, ast.OutputType())
+	}
+
+	prg, err := h.env.Program(ast, cel.CostLimit(ruleCostLimit))
+	if err != nil {
+		return nil, fmt.Errorf("failed creating program for rule %q: %w", expression, err)
+	}
+
+	return prg, nil
+}
+
+// CheckHealthWithRules checks whether the given object is healthy. It performs the built-in health checks (see
+// CheckHealth) and evaluates the health rule for the object (if any).
+// It returns a bool indicating whether the object was actually checked and an error if any health check failed.
+func CheckHealthWithRules(healthRules *HealthRules, groupKind schema.GroupKind, obj client.Object) (bool, error) {
+	checked, err := CheckHealth(obj)
+	if err != nil || healthRules == nil {
+		return checked, err
+	}
+
+	ruleChecked, err := healthRules.CheckHealth(groupKind, obj)
+	return checked || ruleChecked, err
+}
+
+// NewObjectForHealthCheck returns a new object for the given GroupVersionKind which can be used for fetching the
+// object for health checks.
+// It creates a typed object if the GroupVersionKind is registered in the scheme. This object will be fully watched in
+// the target cluster. If a health rule is configured for the kind, it creates an unstructured object, which will be
+// fully watched as well.
+// Otherwise, there is no dedicated health check for the kind, i.e., we only care about whether the object is present or
+// not. Hence, metadata-only requests/watches are used instead of watching the entire object, which saves bandwidth and
+// memory. Health rules specified via annotations on such objects require fetching the entire object.
+// If the target cache is disabled, no watches will be started.
+func NewObjectForHealthCheck(log logr.Logger, scheme *runtime.Scheme, gvk schema.GroupVersionKind, healthRules *HealthRules) (client.Object, error) {
+	typedObject, err := scheme.New(gvk)
+	if err != nil {
+		if !runtime.IsNotRegisteredError(err) {
+			return nil, err
+		}
+
+		if healthRules != nil && healthRules.HasRule(gvk.GroupKind()) {
+			log.V(1).Info("Using unstructured object for health checks (health rule configured)", "groupVersionKind", gvk)
+			obj := &unstructured.Unstructured{}
+			obj.SetGroupVersionKind(gvk)
+			return obj, nil
+		}
+
+		log.V(1).Info("Falling back to metadata-only object for health checks (not registered in the target scheme)", "groupVersionKind", gvk, "err", err.Error())
+		obj := &metav1.PartialObjectMetadata{}
+		obj.SetGroupVersionKind(gvk)
+		return obj, nil
+	}
+
+	return typedObject.(client.Object), nil
+}
@@ -0,0 +1,220 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils_test
+
+import (
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubernetesscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	logzap "sigs.k8s.io/controller-runtime/pkg/log/zap"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	"github.com/gardener/gardener/pkg/logger"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/health/utils"
+)
+
+var _ = Describe("HealthRules", func() {
+	var (
+		fooGroupKind = schema.GroupKind{Group: "example.com", Kind: "Foo"}
+		barGroupKind = schema.GroupKind{Group: "example.com", Kind: "Bar"}
+
+		healthRules *HealthRules
+		obj         *unstructured.Unstructured
+	)
+
+	BeforeEach(func() {
+		var err error
+		healthRules, err = NewHealthRules([]config.HealthRule{{
+			Group:       fooGroupKind.Group,
+			Kind:        fooGroupKind.Kind,
+			Healthy:     "self.status.ready == true",
+			Progressing: ptr.To("self.metadata.generation != self.status.observedGeneration"),
+		}})
+		Expect(err).NotTo(HaveOccurred())
+
+		obj = &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "Foo",
+			"metadata": map[string]interface{}{
+				"name":       "foo",
+				"generation": int64(2),
+			},
+			"status": map[string]interface{}{
+				"ready":              true,
+				"observedGeneration": int64(2),
+			},
+		}}
+	})
+
+	Describe("#NewHealthRules", func() {
+		It("should fail if a health rule cannot be compiled", func() {
+			_, err := NewHealthRules([]config.HealthRule{{Kind: "Foo", Healthy: "self.status."}})
+			Expect(err).To(MatchError(ContainSubstring("invalid health rule for /Foo")))
+		})
+
+		It("should fail if a progressing rule does not evaluate to a boolean", func() {
+			_, err := NewHealthRules([]config.HealthRule{{Kind: "Foo", Healthy: "true", Progressing: ptr.To("'foo'")}})
+			Expect(err).To(MatchError(ContainSubstring("must evaluate to a boolean")))
+		})
+	})
+
+	Describe("#CheckHealth", func() {
+		It("should not check objects without rule", func() {
+			checked, err := healthRules.CheckHealth(barGroupKind, obj)
+			Expect(checked).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not check objects with skip-health-check annotation", func() {
+			obj.SetAnnotations(map[string]string{resourcesv1alpha1.SkipHealthCheck: "true"})
+
+			checked, err := healthRules.CheckHealth(fooGroupKind, obj)
+			Expect(checked).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should succeed if the configured rule is satisfied", func() {
+			checked, err := healthRules.CheckHealth(fooGroupKind, obj)
+			Expect(checked).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail if the configured rule is not satisfied", func() {
+			Expect(unstructured.SetNestedField(obj.Object, false, "status", "ready")).To(Succeed())
+
+			checked, err := healthRules.CheckHealth(fooGroupKind, obj)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("is not satisfied")))
+		})
+
+		It("should fail if the rule cannot be evaluated", func() {
+			unstructured.RemoveNestedField(obj.Object, "status")
+
+			checked, err := healthRules.CheckHealth(fooGroupKind, obj)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("failed evaluating rule")))
+		})
+
+		It("should prefer the rule from the annotation", func() {
+			obj.SetAnnotations(map[string]string{resourcesv1alpha1.HealthRule: "self.metadata.name == 'bar'"})
+
+			checked, err := healthRules.CheckHealth(fooGroupKind, obj)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("is not satisfied")))
+		})
+
+		It("should evaluate rules from annotations for typed objects", func() {
+			deployment := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{
+				Name:        "foo",
+				Annotations: map[string]string{resourcesv1alpha1.HealthRule: "self.spec.replicas == 2"},
+			}, Spec: appsv1.DeploymentSpec{Replicas: ptr.To[int32](2)}}
+
+			checked, err := healthRules.CheckHealth(schema.GroupKind{Group: "apps", Kind: "Deployment"}, deployment)
+			Expect(checked).To(BeTrue())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should fail for metadata-only objects", func() {
+			metadata := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{
+				Annotations: map[string]string{resourcesv1alpha1.HealthRule: "true"},
+			}}
+
+			checked, err := healthRules.CheckHealth(barGroupKind, metadata)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("metadata-only object")))
+		})
+	})
+
+	Describe("#CheckProgressing", func() {
+		It("should not be progressing if the rule is not satisfied", func() {
+			progressing, description, err := healthRules.CheckProgressing(fooGroupKind, obj)
+			Expect(progressing).To(BeFalse())
+			Expect(description).To(BeEmpty())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should be progressing if the rule is satisfied", func() {
+			obj.SetGeneration(3)
+
+			progressing, description, err := healthRules.CheckProgressing(fooGroupKind, obj)
+			Expect(progressing).To(BeTrue())
+			Expect(description).To(ContainSubstring("is satisfied"))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should not be progressing if there is no rule", func() {
+			obj.SetGeneration(3)
+
+			progressing, _, err := healthRules.CheckProgressing(barGroupKind, obj)
+			Expect(progressing).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("#CheckHealthWithRules", func() {
+		It("should only perform the built-in checks if there are no rules", func() {
+			checked, err := CheckHealthWithRules(nil, fooGroupKind, obj)
+			Expect(checked).To(BeFalse())
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should evaluate the rules", func() {
+			Expect(unstructured.SetNestedField(obj.Object, false, "status", "ready")).To(Succeed())
+
+			checked, err := CheckHealthWithRules(healthRules, fooGroupKind, obj)
+			Expect(checked).To(BeTrue())
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Describe("#NewObjectForHealthCheck", func() {
+		var (
+			log    logr.Logger
+			scheme *runtime.Scheme
+		)
+
+		BeforeEach(func() {
+			log = logger.MustNewZapLogger(logger.DebugLevel, logger.FormatJSON, logzap.WriteTo(GinkgoWriter))
+			scheme = kubernetesscheme.Scheme
+		})
+
+		It("should return a typed object for registered kinds", func() {
+			obj, err := NewObjectForHealthCheck(log, scheme, appsv1.SchemeGroupVersion.WithKind("Deployment"), healthRules)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(obj).To(BeAssignableToTypeOf(&appsv1.Deployment{}))
+		})
+
+		It("should return an unstructured object for kinds with health rule", func() {
+			obj, err := NewObjectForHealthCheck(log, scheme, fooGroupKind.WithVersion("v1"), healthRules)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(obj).To(BeAssignableToTypeOf(&unstructured.Unstructured{}))
+			Expect(obj.GetObjectKind().GroupVersionKind()).To(Equal(fooGroupKind.WithVersion("v1")))
+		})
+
+		It("should return a metadata-only object for other kinds", func() {
+			obj, err := NewObjectForHealthCheck(log, scheme, barGroupKind.WithVersion("v1"), healthRules)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(obj).To(BeAssignableToTypeOf(&metav1.PartialObjectMetadata{}))
+		})
+	})
+})
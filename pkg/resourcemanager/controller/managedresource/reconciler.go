@@ -177,15 +177,27 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 			return reconcile.Result{}, fmt.Errorf("could not read secret '%s': %+v", secret.Name, err)
 		}
 
+		// Decompress the secret's data (if necessary) before decoding the objects and calculating the checksum, so that
+		// the checksum does not depend on whether (or how) the data was compressed.
+		secretData, err := resourcesv1alpha1helper.SecretData(secret)
+		if err != nil {
+			conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, "CannotDecompressSecret", err.Error())
+			if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
+				return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
+			}
+
+			return reconcile.Result{}, fmt.Errorf("could not decompress data of secret '%s': %+v", secret.Name, err)
+		}
+
 		// Sort secret's data key to keep consistent ordering while calculating checksum
-		secretKeys := make([]string, 0, len(secret.Data))
-		for secretKey := range secret.Data {
+		secretKeys := make([]string, 0, len(secretData))
+		for secretKey := range secretData {
 			secretKeys = append(secretKeys, secretKey)
 		}
 		slices.Sort(secretKeys)
 
 		for _, secretKey := range secretKeys {
-			value := secret.Data[secretKey]
+			value := secretData[secretKey]
 			var (
 				decoder    = yaml.NewYAMLOrJSONDecoder(bytes.NewReader(value), 1024)
 				decodedObj map[string]interface{}
@@ -250,6 +262,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 						obj:                       obj,
 						forceOverwriteLabels:      forceOverwriteLabels,
 						forceOverwriteAnnotations: forceOverwriteAnnotations,
+						serverSideApply:           mr.Spec.ServerSideApply,
 					}
 					objectReference = resourcesv1alpha1.ObjectReference{
 						ObjectReference: corev1.ObjectReference{
@@ -304,7 +317,7 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 		reason := resourcesv1alpha1.ConditionApplyProgressing
 		msg := "The resources are currently being reconciled."
 		switch conditionResourcesApplied.Reason {
-		case resourcesv1alpha1.ConditionApplyFailed, resourcesv1alpha1.ConditionDeletionFailed, resourcesv1alpha1.ConditionDeletionPending:
+		case resourcesv1alpha1.ConditionApplyFailed, resourcesv1alpha1.ConditionApplyConflict, resourcesv1alpha1.ConditionDeletionFailed, resourcesv1alpha1.ConditionDeletionPending:
 			// keep condition reason and message if last reconciliation failed
 			reason = conditionResourcesApplied.Reason
 			msg = conditionResourcesApplied.Message
@@ -354,7 +367,12 @@ func (r *Reconciler) reconcile(ctx context.Context, log logr.Logger, mr *resourc
 
 	injectLabels := mergeMaps(mr.Spec.InjectLabels, map[string]string{resourcesv1alpha1.ManagedBy: *r.Config.ManagedByLabelValue})
 	if err := r.applyNewResources(reconcileCtx, log, origin, newResourcesObjects, injectLabels, equivalences); err != nil {
-		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, resourcesv1alpha1.ConditionApplyFailed, err.Error())
+		reason := resourcesv1alpha1.ConditionApplyFailed
+		if conflictErr := (&applyConflictError{}); errors.As(err, &conflictErr) {
+			reason = resourcesv1alpha1.ConditionApplyConflict
+		}
+
+		conditionResourcesApplied = v1beta1helper.UpdatedConditionWithClock(r.Clock, conditionResourcesApplied, gardencorev1beta1.ConditionFalse, reason, err.Error())
 		if err := updateConditions(ctx, r.SourceClient, mr, conditionResourcesApplied); err != nil {
 			return reconcile.Result{}, fmt.Errorf("could not update the ManagedResource status: %w", err)
 		}
@@ -482,6 +500,8 @@ func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, ori
 		return fmt.Errorf("failed to compute all HPA and HVPA target ref object keys: %w", err)
 	}
 
+	var conflicts []string
+
 	for _, obj := range newResourcesObjects {
 		var (
 			current            = obj.obj.DeepCopy()
@@ -494,6 +514,26 @@ func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, ori
 
 		resourceLogger.V(1).Info("Applying")
 
+		if obj.serverSideApply != nil && !ignore(obj.obj) {
+			if err := injectLabels(obj.obj, labelsToInject); err != nil {
+				return fmt.Errorf("error injecting labels into object %q: %s", resource, err)
+			}
+
+			if err := r.applyServerSide(ctx, resourceLogger, origin, obj.obj, obj.serverSideApply, scaledHorizontally, scaledVertically); err != nil {
+				// collect conflicts and continue with the remaining objects, so that a single conflicting field does not
+				// block the rollout of all other resources
+				if conflictErr := (&applyConflictError{}); errors.As(err, &conflictErr) {
+					for _, conflict := range conflictErr.conflicts {
+						conflicts = append(conflicts, fmt.Sprintf("%s: %s", resource, conflict))
+					}
+					continue
+				}
+
+				return fmt.Errorf("error during server-side apply of object %q: %s", resource, err)
+			}
+			continue
+		}
+
 		operationResult, err := controllerutils.TypedCreateOrUpdate(ctx, r.TargetClient, r.TargetScheme, current, ptr.Deref(r.Config.AlwaysUpdate, false), func() error {
 			metadata, err := meta.Accessor(obj.obj)
 			if err != nil {
@@ -540,6 +580,10 @@ func (r *Reconciler) applyNewResources(ctx context.Context, log logr.Logger, ori
 		}
 	}
 
+	if len(conflicts) > 0 {
+		return &applyConflictError{conflicts: conflicts}
+	}
+
 	return nil
 }
 
@@ -1020,6 +1064,7 @@ type object struct {
 	oldInformation            resourcesv1alpha1.ObjectReference
 	forceOverwriteLabels      bool
 	forceOverwriteAnnotations bool
+	serverSideApply           *resourcesv1alpha1.ServerSideApply
 }
 
 type decodingError struct {
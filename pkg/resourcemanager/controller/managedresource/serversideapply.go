@@ -0,0 +1,393 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package managedresource
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/util/csaupgrade"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+// legacyFieldManagers are the names of the field managers which own the fields of resources updated by the
+// ManagedResource controller in the default (merge) mode. When switching a ManagedResource to server-side apply, the
+// ownership of these fields is handed over to resourcesv1alpha1.FieldManagerServerSideApply.
+// Without an explicit field manager, the API server derives the manager name from the user agent of the request, which
+// defaults to the name of the binary.
+var legacyFieldManagers = sets.New("gardener-resource-manager", strings.SplitN(rest.DefaultKubernetesUserAgent(), "/", 2)[0])
+
+// applyConflictError is returned when applying resources via server-side apply resulted in conflicts with other field
+// managers.
+type applyConflictError struct {
+	conflicts []string
+}
+
+func (e *applyConflictError) Error() string {
+	return fmt.Sprintf("conflicts with other field managers: %s", strings.Join(e.conflicts, "; "))
+}
+
+// applyServerSide applies the desired object via server-side apply. Fields which must not be overwritten (replicas of
+// horizontally scaled workloads, resource requirements of vertically scaled workloads) are not part of the applied
+// configuration if they are owned by other field managers, hence their ownership and values remain with them. When
+// the object is created, these fields are set to their desired values.
+func (r *Reconciler) applyServerSide(ctx context.Context, log logr.Logger, origin string, desired *unstructured.Unstructured, serverSideApply *resourcesv1alpha1.ServerSideApply, preserveReplicas, preserveResources bool) error {
+	obj := desired.DeepCopy()
+	delete(obj.Object, "status")
+	obj.SetResourceVersion("")
+	obj.SetManagedFields(nil)
+	obj.SetCreationTimestamp(metav1.Time{})
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	if annotations[resourcesv1alpha1.PreserveReplicas] == "true" {
+		preserveReplicas = true
+	}
+	if annotations[resourcesv1alpha1.PreserveResources] == "true" {
+		preserveResources = true
+	}
+	annotations[descriptionAnnotation] = descriptionAnnotationText
+	annotations[resourcesv1alpha1.OriginAnnotation] = origin
+	obj.SetAnnotations(annotations)
+
+	current := &unstructured.Unstructured{}
+	current.SetGroupVersionKind(obj.GroupVersionKind())
+	if err := r.TargetClient.Get(ctx, client.ObjectKeyFromObject(obj), current); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		current = nil
+	}
+
+	if current != nil {
+		preservedFields := preservedFieldPaths(current, preserveReplicas, preserveResources)
+
+		if err := r.handOverFieldOwnership(ctx, log, current, preservedFields); err != nil {
+			return fmt.Errorf("failed handing over ownership of fields to field manager %s: %w", resourcesv1alpha1.FieldManagerServerSideApply, err)
+		}
+
+		if len(preservedFields) > 0 {
+			fieldsOwnedByOthers, err := fieldsOwnedByOtherManagers(current)
+			if err != nil {
+				return fmt.Errorf("failed reading managed fields: %w", err)
+			}
+
+			if preserveReplicas && fieldsOwnedByOthers.Has(replicasPath) {
+				unstructured.RemoveNestedField(obj.Object, "spec", "replicas")
+			}
+			if preserveResources {
+				if err := removeResourceRequirements(obj, fieldsOwnedByOthers); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	patchOptions := []client.PatchOption{client.FieldOwner(resourcesv1alpha1.FieldManagerServerSideApply)}
+	if ptr.Deref(serverSideApply.ForceConflicts, false) {
+		patchOptions = append(patchOptions, client.ForceOwnership)
+	}
+
+	if err := r.TargetClient.Patch(ctx, obj, client.Apply, patchOptions...); err != nil {
+		if conflicts := fieldManagerConflicts(err); len(conflicts) > 0 {
+			return &applyConflictError{conflicts: conflicts}
+		}
+
+		if apierrors.IsInvalid(err) && current != nil && deleteOnInvalidUpdate(desired, err) {
+			if deleteErr := r.TargetClient.Delete(ctx, current); client.IgnoreNotFound(deleteErr) != nil {
+				return fmt.Errorf("error deleting object after 'invalid' update error: %w", deleteErr)
+			}
+			// return error directly, so that the create after delete will be retried
+			return fmt.Errorf("deleted object because of 'invalid' update error, and 'delete-on-invalid-update' annotation on object or the resource is an immutable ConfigMap/Secret: %w", err)
+		}
+
+		return err
+	}
+
+	switch {
+	case current == nil:
+		log.Info("Created resource because it was not existing before")
+	case current.GetResourceVersion() != obj.GetResourceVersion():
+		log.Info("Updated resource because its actual state differed from the desired state")
+	default:
+		log.V(1).Info("Resource was neither created nor updated because its actual state matches with the desired state")
+	}
+
+	return nil
+}
+
+// handOverFieldOwnership moves the ownership of the fields managed by the legacy field managers (i.e., fields which
+// were set while the ManagedResource was reconciled in the merge mode) to the server-side apply field manager. This
+// prevents conflicts with our own previous updates and ensures that fields which are no longer desired get removed.
+// The ownership of the given preserved fields remains with the legacy field managers, otherwise they would be removed
+// by the next apply which does not contain them.
+func (r *Reconciler) handOverFieldOwnership(ctx context.Context, log logr.Logger, current *unstructured.Unstructured, preservedFields []fieldpath.Path) error {
+	upgraded := current.DeepCopy()
+	if err := csaupgrade.UpgradeManagedFields(upgraded, legacyFieldManagers, resourcesv1alpha1.FieldManagerServerSideApply); err != nil {
+		return err
+	}
+
+	managedFields, err := retainFieldOwnership(current.GetManagedFields(), upgraded.GetManagedFields(), preservedFields)
+	if err != nil {
+		return err
+	}
+
+	if apiequality.Semantic.DeepEqual(current.GetManagedFields(), managedFields) {
+		return nil
+	}
+
+	// Use "replace" for the resource version instead of "test" so that the request fails with a conflict if the object
+	// has been changed in the meantime, see csaupgrade.UpgradeManagedFieldsPatch.
+	patch, err := json.Marshal([]map[string]interface{}{
+		{"op": "replace", "path": "/metadata/managedFields", "value": managedFields},
+		{"op": "replace", "path": "/metadata/resourceVersion", "value": current.GetResourceVersion()},
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info("Handing over ownership of fields to server-side apply field manager", "fieldManager", resourcesv1alpha1.FieldManagerServerSideApply)
+	return r.TargetClient.Patch(ctx, current, client.RawPatch(types.JSONPatchType, patch))
+}
+
+// retainFieldOwnership takes the ownership of the given fields away from the server-side apply field manager in the
+// upgraded managed fields and gives it back to the legacy field managers which owned them originally.
+func retainFieldOwnership(original, upgraded []metav1.ManagedFieldsEntry, paths []fieldpath.Path) ([]metav1.ManagedFieldsEntry, error) {
+	if len(paths) == 0 {
+		return upgraded, nil
+	}
+
+	var (
+		retained        = &fieldpath.Set{}
+		retainedEntries []metav1.ManagedFieldsEntry
+	)
+
+	for _, entry := range original {
+		if !isLegacyManagedFieldsEntry(entry) || entry.FieldsV1 == nil {
+			continue
+		}
+
+		fields, err := decodeManagedFields(entry)
+		if err != nil {
+			return nil, err
+		}
+
+		owned := &fieldpath.Set{}
+		for _, path := range paths {
+			if fields.Has(path) {
+				owned.Insert(path)
+			}
+		}
+		if owned.Empty() {
+			continue
+		}
+
+		raw, err := owned.ToJSON()
+		if err != nil {
+			return nil, err
+		}
+		entry.FieldsV1 = &metav1.FieldsV1{Raw: raw}
+		retainedEntries = append(retainedEntries, entry)
+		retained = retained.Union(owned)
+	}
+
+	if retained.Empty() {
+		return upgraded, nil
+	}
+
+	var managedFields []metav1.ManagedFieldsEntry
+	for _, entry := range upgraded {
+		if entry.Manager == resourcesv1alpha1.FieldManagerServerSideApply && entry.Operation == metav1.ManagedFieldsOperationApply && entry.FieldsV1 != nil {
+			fields, err := decodeManagedFields(entry)
+			if err != nil {
+				return nil, err
+			}
+
+			raw, err := fields.Difference(retained).ToJSON()
+			if err != nil {
+				return nil, err
+			}
+			entry.FieldsV1 = &metav1.FieldsV1{Raw: raw}
+		}
+
+		managedFields = append(managedFields, entry)
+	}
+
+	return append(managedFields, retainedEntries...), nil
+}
+
+// fieldsOwnedByOtherManagers returns the fields of the given object which are owned by field managers other than the
+// server-side apply field manager.
+func fieldsOwnedByOtherManagers(obj *unstructured.Unstructured) (*fieldpath.Set, error) {
+	fields := &fieldpath.Set{}
+
+	for _, entry := range obj.GetManagedFields() {
+		if (entry.Manager == resourcesv1alpha1.FieldManagerServerSideApply && entry.Operation == metav1.ManagedFieldsOperationApply) || entry.FieldsV1 == nil {
+			continue
+		}
+
+		entryFields, err := decodeManagedFields(entry)
+		if err != nil {
+			return nil, err
+		}
+		fields = fields.Union(entryFields)
+	}
+
+	return fields, nil
+}
+
+func isLegacyManagedFieldsEntry(entry metav1.ManagedFieldsEntry) bool {
+	return legacyFieldManagers.Has(entry.Manager) && entry.Operation == metav1.ManagedFieldsOperationUpdate && entry.Subresource == ""
+}
+
+func decodeManagedFields(entry metav1.ManagedFieldsEntry) (*fieldpath.Set, error) {
+	fields := &fieldpath.Set{}
+	if err := fields.FromJSON(bytes.NewReader(entry.FieldsV1.Raw)); err != nil {
+		return nil, fmt.Errorf("failed decoding fields of field manager %s: %w", entry.Manager, err)
+	}
+	return fields, nil
+}
+
+// fieldManagerConflicts returns the field manager conflicts contained in the given error.
+func fieldManagerConflicts(err error) []string {
+	if !apierrors.IsConflict(err) {
+		return nil
+	}
+
+	var apiStatus apierrors.APIStatus
+	if !errors.As(err, &apiStatus) || apiStatus.Status().Details == nil {
+		return nil
+	}
+
+	var conflicts []string
+	for _, cause := range apiStatus.Status().Details.Causes {
+		if cause.Type == metav1.CauseTypeFieldManagerConflict {
+			conflicts = append(conflicts, cause.Message)
+		}
+	}
+	return conflicts
+}
+
+var (
+	replicasPath = fieldpath.MakePathOrDie("spec", "replicas")
+
+	resourceRequirements = []string{"requests", "limits"}
+	resourceNames        = []string{"cpu", "memory"}
+)
+
+// preservedFieldPaths returns the paths of the fields of the given object which must not be overwritten.
+func preservedFieldPaths(obj *unstructured.Unstructured, preserveReplicas, preserveResources bool) []fieldpath.Path {
+	var paths []fieldpath.Path
+
+	if preserveReplicas {
+		paths = append(paths, replicasPath)
+	}
+
+	if podTemplatePath := podTemplatePathForKind(obj.GetKind()); preserveResources && podTemplatePath != nil {
+		containers, _, _ := unstructured.NestedSlice(obj.Object, append(podTemplatePath, "spec", "containers")...)
+		for _, c := range containers {
+			container, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			containerName, _, _ := unstructured.NestedString(container, "name")
+			for _, requirement := range resourceRequirements {
+				for _, resourceName := range resourceNames {
+					paths = append(paths, resourceRequirementPath(podTemplatePath, containerName, requirement, resourceName))
+				}
+			}
+		}
+	}
+
+	return paths
+}
+
+func podTemplatePathForKind(kind string) []string {
+	switch kind {
+	case "Deployment", "StatefulSet", "DaemonSet", "Job":
+		return []string{"spec", "template"}
+	case "CronJob":
+		return []string{"spec", "jobTemplate", "spec", "template"}
+	default:
+		return nil
+	}
+}
+
+func resourceRequirementPath(podTemplatePath []string, containerName, requirement, resourceName string) fieldpath.Path {
+	var parts []interface{}
+	for _, part := range podTemplatePath {
+		parts = append(parts, part)
+	}
+	parts = append(parts, "spec", "containers", fieldpath.KeyByFields("name", containerName), "resources", requirement, resourceName)
+	return fieldpath.MakePathOrDie(parts...)
+}
+
+// removeResourceRequirements removes the CPU and memory requests and limits of all containers in the pod template of
+// the given workload which are contained in the given set of fields.
+func removeResourceRequirements(obj *unstructured.Unstructured, fields *fieldpath.Set) error {
+	podTemplatePath := podTemplatePathForKind(obj.GetKind())
+	if podTemplatePath == nil {
+		return nil
+	}
+
+	containersPath := append(podTemplatePath, "spec", "containers")
+	containers, found, err := unstructured.NestedSlice(obj.Object, containersPath...)
+	if err != nil || !found {
+		return err
+	}
+
+	for _, c := range containers {
+		container, ok := c.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		containerName, _, _ := unstructured.NestedString(container, "name")
+		for _, requirement := range resourceRequirements {
+			for _, resourceName := range resourceNames {
+				if fields.Has(resourceRequirementPath(podTemplatePath, containerName, requirement, resourceName)) {
+					unstructured.RemoveNestedField(container, "resources", requirement, resourceName)
+				}
+			}
+			if values, found, _ := unstructured.NestedMap(container, "resources", requirement); found && len(values) == 0 {
+				unstructured.RemoveNestedField(container, "resources", requirement)
+			}
+		}
+		if values, found, _ := unstructured.NestedMap(container, "resources"); found && len(values) == 0 {
+			unstructured.RemoveNestedField(container, "resources")
+		}
+	}
+
+	return unstructured.SetNestedSlice(obj.Object, containers, containersPath...)
+}
@@ -0,0 +1,205 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package managedresource
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/structured-merge-diff/v4/fieldpath"
+
+	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+)
+
+var _ = Describe("ServerSideApply", func() {
+	Describe("#removeResourceRequirements", func() {
+		var container map[string]interface{}
+
+		BeforeEach(func() {
+			container = map[string]interface{}{
+				"name": "foo",
+				"resources": map[string]interface{}{
+					"requests": map[string]interface{}{"cpu": "100m", "memory": "100Mi", "ephemeral-storage": "1Gi"},
+					"limits":   map[string]interface{}{"memory": "200Mi"},
+				},
+			}
+		})
+
+		It("should remove the given cpu and memory requirements from the containers of a Deployment", func() {
+			obj := &unstructured.Unstructured{Object: map[string]interface{}{
+				"kind": "Deployment",
+				"spec": map[string]interface{}{"template": map[string]interface{}{"spec": map[string]interface{}{
+					"containers": []interface{}{container},
+				}}},
+			}}
+
+			Expect(removeResourceRequirements(obj, fieldpath.NewSet(preservedFieldPaths(obj, false, true)...))).To(Succeed())
+
+			containers, _, err := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "containers")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(containers).To(ConsistOf(map[string]interface{}{
+				"name": "foo",
+				"resources": map[string]interface{}{
+					"requests": map[string]interface{}{"ephemeral-storage": "1Gi"},
+				},
+			}))
+		})
+
+		It("should remove the given cpu and memory requirements from the containers of a CronJob", func() {
+			delete(container["resources"].(map[string]interface{})["requests"].(map[string]interface{}), "ephemeral-storage")
+
+			obj := &unstructured.Unstructured{Object: map[string]interface{}{
+				"kind": "CronJob",
+				"spec": map[string]interface{}{"jobTemplate": map[string]interface{}{"spec": map[string]interface{}{"template": map[string]interface{}{"spec": map[string]interface{}{
+					"containers": []interface{}{container},
+				}}}}},
+			}}
+
+			Expect(removeResourceRequirements(obj, fieldpath.NewSet(preservedFieldPaths(obj, false, true)...))).To(Succeed())
+
+			containers, _, err := unstructured.NestedSlice(obj.Object, "spec", "jobTemplate", "spec", "template", "spec", "containers")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(containers).To(ConsistOf(map[string]interface{}{"name": "foo"}))
+		})
+
+		It("should only remove the requirements contained in the given fields", func() {
+			obj := &unstructured.Unstructured{Object: map[string]interface{}{
+				"kind": "Deployment",
+				"spec": map[string]interface{}{"template": map[string]interface{}{"spec": map[string]interface{}{
+					"containers": []interface{}{container},
+				}}},
+			}}
+
+			fields := fieldpath.NewSet(fieldpath.MakePathOrDie("spec", "template", "spec", "containers", fieldpath.KeyByFields("name", "foo"), "resources", "limits", "memory"))
+			Expect(removeResourceRequirements(obj, fields)).To(Succeed())
+
+			containers, _, err := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "containers")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(containers).To(ConsistOf(map[string]interface{}{
+				"name": "foo",
+				"resources": map[string]interface{}{
+					"requests": map[string]interface{}{"cpu": "100m", "memory": "100Mi", "ephemeral-storage": "1Gi"},
+				},
+			}))
+		})
+
+		It("should do nothing for other kinds", func() {
+			obj := &unstructured.Unstructured{Object: map[string]interface{}{
+				"kind": "ConfigMap",
+				"data": map[string]interface{}{"foo": "bar"},
+			}}
+			expected := obj.DeepCopy()
+
+			Expect(removeResourceRequirements(obj, &fieldpath.Set{})).To(Succeed())
+			Expect(obj).To(Equal(expected))
+		})
+	})
+
+	Describe("#retainFieldOwnership", func() {
+		It("should give the ownership of the preserved fields back to the legacy field managers", func() {
+			legacyEntry := metav1.ManagedFieldsEntry{
+				Manager:    "gardener-resource-manager",
+				Operation:  metav1.ManagedFieldsOperationUpdate,
+				APIVersion: "apps/v1",
+				FieldsType: "FieldsV1",
+				FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:paused":{},"f:replicas":{}}}`)},
+			}
+			upgradedEntry := *legacyEntry.DeepCopy()
+			upgradedEntry.Manager = resourcesv1alpha1.FieldManagerServerSideApply
+			upgradedEntry.Operation = metav1.ManagedFieldsOperationApply
+			otherEntry := metav1.ManagedFieldsEntry{
+				Manager:    "other",
+				Operation:  metav1.ManagedFieldsOperationUpdate,
+				APIVersion: "apps/v1",
+				FieldsType: "FieldsV1",
+				FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:minReadySeconds":{}}}`)},
+			}
+
+			managedFields, err := retainFieldOwnership(
+				[]metav1.ManagedFieldsEntry{legacyEntry, otherEntry},
+				[]metav1.ManagedFieldsEntry{upgradedEntry, otherEntry},
+				preservedFieldPaths(&unstructured.Unstructured{}, true, false),
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(managedFields).To(HaveLen(3))
+			Expect(managedFields[0].Manager).To(Equal(resourcesv1alpha1.FieldManagerServerSideApply))
+			Expect(string(managedFields[0].FieldsV1.Raw)).To(Equal(`{"f:spec":{"f:paused":{}}}`))
+			Expect(managedFields[1]).To(Equal(otherEntry))
+			Expect(managedFields[2].Manager).To(Equal("gardener-resource-manager"))
+			Expect(managedFields[2].Operation).To(Equal(metav1.ManagedFieldsOperationUpdate))
+			Expect(string(managedFields[2].FieldsV1.Raw)).To(Equal(`{"f:spec":{"f:replicas":{}}}`))
+		})
+
+		It("should return the upgraded managed fields if no preserved field is owned by the legacy field managers", func() {
+			upgraded := []metav1.ManagedFieldsEntry{{
+				Manager:   resourcesv1alpha1.FieldManagerServerSideApply,
+				Operation: metav1.ManagedFieldsOperationApply,
+				FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:paused":{}}}`)},
+			}}
+
+			Expect(retainFieldOwnership(nil, upgraded, preservedFieldPaths(&unstructured.Unstructured{}, true, false))).To(Equal(upgraded))
+		})
+	})
+
+	Describe("#fieldsOwnedByOtherManagers", func() {
+		It("should return the fields not owned by the server-side apply field manager", func() {
+			obj := &unstructured.Unstructured{}
+			obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+				{
+					Manager:   resourcesv1alpha1.FieldManagerServerSideApply,
+					Operation: metav1.ManagedFieldsOperationApply,
+					FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:paused":{}}}`)},
+				},
+				{
+					Manager:     "horizontal-pod-autoscaler",
+					Operation:   metav1.ManagedFieldsOperationUpdate,
+					Subresource: "scale",
+					FieldsV1:    &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:replicas":{}}}`)},
+				},
+			})
+
+			fields, err := fieldsOwnedByOtherManagers(obj)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(fields.Has(fieldpath.MakePathOrDie("spec", "replicas"))).To(BeTrue())
+			Expect(fields.Has(fieldpath.MakePathOrDie("spec", "paused"))).To(BeFalse())
+		})
+	})
+
+	Describe("#fieldManagerConflicts", func() {
+		It("should return nil for non-conflict errors", func() {
+			Expect(fieldManagerConflicts(fmt.Errorf("fake"))).To(BeNil())
+			Expect(fieldManagerConflicts(apierrors.NewNotFound(schema.GroupResource{Resource: "configmaps"}, "foo"))).To(BeNil())
+		})
+
+		It("should return nil for optimistic locking conflicts", func() {
+			Expect(fieldManagerConflicts(apierrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, "foo", fmt.Errorf("fake")))).To(BeNil())
+		})
+
+		It("should return the field manager conflicts", func() {
+			err := apierrors.NewApplyConflict([]metav1.StatusCause{
+				{Type: metav1.CauseTypeFieldManagerConflict, Message: `conflict with "kubectl-edit": .data.foo`, Field: ".data.foo"},
+				{Type: metav1.CauseTypeFieldValueInvalid, Message: "invalid"},
+			}, "Apply failed with 1 conflict")
+
+			Expect(fieldManagerConflicts(fmt.Errorf("wrapped: %w", err))).To(ConsistOf(`conflict with "kubectl-edit": .data.foo`))
+		})
+	})
+})
@@ -0,0 +1,73 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drain
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+)
+
+// ControllerName is the name of the controller.
+const ControllerName = "node-drain"
+
+// AddToManager adds Reconciler to the given manager.
+func (r *Reconciler) AddToManager(mgr manager.Manager, targetCluster cluster.Cluster) error {
+	if r.TargetClient == nil {
+		r.TargetClient = targetCluster.GetClient()
+	}
+	if r.Recorder == nil {
+		r.Recorder = targetCluster.GetEventRecorderFor("gardener-" + ControllerName + "-controller")
+	}
+
+	return builder.
+		ControllerManagedBy(mgr).
+		Named(ControllerName).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: ptr.Deref(r.Config.ConcurrentSyncs, 0),
+		}).
+		WatchesRawSource(
+			source.Kind(targetCluster.GetCache(), &corev1.Node{}),
+			&handler.EnqueueRequestForObject{},
+			builder.WithPredicates(r.NodePredicate()),
+		).
+		Complete(r)
+}
+
+// NodePredicate returns a predicate that filters for Node objects which gardener-node-agent has started to drain.
+func (r *Reconciler) NodePredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(NodeMustBeDrained)
+}
+
+// NodeMustBeDrained returns true if the given Node has been cordoned and marked for draining by gardener-node-agent,
+// and if it has not been drained yet.
+func NodeMustBeDrained(obj client.Object) bool {
+	node, ok := obj.(*corev1.Node)
+	if !ok {
+		return false
+	}
+
+	_, drainStarted := node.Annotations[nodeagentv1alpha1.AnnotationKeyDrainStartTime]
+	return node.Spec.Unschedulable && drainStarted && node.Annotations[nodeagentv1alpha1.AnnotationKeyDrained] != "true"
+}
@@ -0,0 +1,72 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drain_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/node/drain"
+)
+
+var _ = Describe("Add", func() {
+	Describe("#NodePredicate", func() {
+		var (
+			p    predicate.Predicate
+			node *corev1.Node
+		)
+
+		BeforeEach(func() {
+			p = (&Reconciler{}).NodePredicate()
+			node = &corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{"worker.gardener.cloud/drain-start-time": "2024-01-01T00:00:00Z"},
+				},
+				Spec: corev1.NodeSpec{Unschedulable: true},
+			}
+		})
+
+		It("should return false if the object is not a Node", func() {
+			Expect(p.Create(event.CreateEvent{Object: &corev1.ConfigMap{}})).To(BeFalse())
+		})
+
+		It("should return true if the Node is cordoned and marked for draining", func() {
+			Expect(p.Create(event.CreateEvent{Object: node})).To(BeTrue())
+			Expect(p.Update(event.UpdateEvent{ObjectNew: node})).To(BeTrue())
+		})
+
+		It("should return false if the Node is not cordoned", func() {
+			node.Spec.Unschedulable = false
+
+			Expect(p.Update(event.UpdateEvent{ObjectNew: node})).To(BeFalse())
+		})
+
+		It("should return false if the Node is not marked for draining", func() {
+			delete(node.Annotations, "worker.gardener.cloud/drain-start-time")
+
+			Expect(p.Update(event.UpdateEvent{ObjectNew: node})).To(BeFalse())
+		})
+
+		It("should return false if the Node has already been drained", func() {
+			node.Annotations["worker.gardener.cloud/drained"] = "true"
+
+			Expect(p.Update(event.UpdateEvent{ObjectNew: node})).To(BeFalse())
+		})
+	})
+})
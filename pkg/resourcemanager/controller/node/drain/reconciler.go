@@ -0,0 +1,132 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drain
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/controllerutils"
+	nodeagentv1alpha1 "github.com/gardener/gardener/pkg/nodeagent/apis/config/v1alpha1"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+)
+
+// Reconciler evicts the pods from Nodes which gardener-node-agent has cordoned for applying disruptive changes of
+// the operating system config. gardener-node-agent is not permitted to list and evict pods in the cluster, hence it
+// delegates draining its node to this controller.
+type Reconciler struct {
+	TargetClient client.Client
+	Config       config.NodeControllerConfig
+	Recorder     record.EventRecorder
+}
+
+// Reconcile evicts the pods running on the Node and marks the Node as drained once all pods are gone.
+func (r *Reconciler) Reconcile(reconcileCtx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	log := logf.FromContext(reconcileCtx)
+
+	ctx, cancel := controllerutils.GetMainReconciliationContext(reconcileCtx, controllerutils.DefaultReconciliationTimeout)
+	defer cancel()
+
+	node := &corev1.Node{}
+	if err := r.TargetClient.Get(ctx, req.NamespacedName, node); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.V(1).Info("Object is gone, stop reconciling")
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("error retrieving object from store: %w", err)
+	}
+
+	// Predicates only filter watch events but don't filter when an object (or rather a reconcile.Request) is already in
+	// the queue. Though, gardener-node-agent might have uncordoned the node while the controller is in backoff.
+	// Hence, we should always check whether there is work left to do in the controller in addition to predicates.
+	if !NodeMustBeDrained(node) {
+		return reconcile.Result{}, nil
+	}
+
+	podList := &corev1.PodList{}
+	if err := r.TargetClient.List(ctx, podList, client.MatchingFields{indexer.PodNodeName: node.Name}); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed listing pods on node: %w", err)
+	}
+
+	var remainingPods int
+	for _, p := range podList.Items {
+		pod := p
+		if !mustEvictPod(&pod) {
+			continue
+		}
+
+		remainingPods++
+		if pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		if err := r.TargetClient.SubResource("eviction").Create(ctx, &pod, &policyv1.Eviction{
+			ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace},
+		}); err != nil {
+			switch {
+			case apierrors.IsNotFound(err):
+				remainingPods--
+			case apierrors.IsTooManyRequests(err):
+				log.Info("Eviction of pod is currently not allowed, retrying later", "pod", client.ObjectKeyFromObject(&pod), "reason", err.Error())
+			default:
+				return reconcile.Result{}, fmt.Errorf("failed evicting pod %s: %w", client.ObjectKeyFromObject(&pod), err)
+			}
+			continue
+		}
+
+		log.Info("Evicted pod", "pod", client.ObjectKeyFromObject(&pod))
+	}
+
+	if remainingPods > 0 {
+		backoff := r.Config.Backoff.Duration
+		log.Info("Waiting for pods to be evicted from the node", "remainingPods", remainingPods, "backoff", backoff)
+		return reconcile.Result{RequeueAfter: backoff}, nil
+	}
+
+	log.Info("All pods have been evicted, marking node as drained")
+	r.Recorder.Event(node, corev1.EventTypeNormal, "NodeDrained", "All pods have been evicted from the node")
+
+	patch := client.MergeFrom(node.DeepCopy())
+	metav1.SetMetaDataAnnotation(&node.ObjectMeta, nodeagentv1alpha1.AnnotationKeyDrained, "true")
+	return reconcile.Result{}, r.TargetClient.Patch(ctx, node, patch)
+}
+
+// mustEvictPod returns false for pods which are not evicted when draining a node, i.e., terminated pods, static pods
+// and pods managed by a DaemonSet.
+func mustEvictPod(pod *corev1.Pod) bool {
+	if pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+		return false
+	}
+
+	if _, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; ok {
+		return false
+	}
+
+	if owner := metav1.GetControllerOf(pod); owner != nil && owner.Kind == "DaemonSet" {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,135 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drain_test
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/resourcemanager/apis/config"
+	. "github.com/gardener/gardener/pkg/resourcemanager/controller/node/drain"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Reconciler", func() {
+	var (
+		ctx        = context.TODO()
+		fakeClient client.Client
+		reconciler *Reconciler
+
+		node    *corev1.Node
+		request reconcile.Request
+	)
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithIndex(&corev1.Pod{}, indexer.PodNodeName, func(obj client.Object) []string {
+				return []string{obj.(*corev1.Pod).Spec.NodeName}
+			}).
+			Build()
+
+		reconciler = &Reconciler{
+			TargetClient: fakeClient,
+			Config:       config.NodeControllerConfig{Backoff: &metav1.Duration{Duration: time.Minute}},
+			Recorder:     record.NewFakeRecorder(1),
+		}
+
+		node = &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "node",
+				Annotations: map[string]string{"worker.gardener.cloud/drain-start-time": "2024-01-01T00:00:00Z"},
+			},
+			Spec: corev1.NodeSpec{Unschedulable: true},
+		}
+		Expect(fakeClient.Create(ctx, node)).To(Succeed())
+
+		request = reconcile.Request{NamespacedName: client.ObjectKeyFromObject(node)}
+	})
+
+	newPod := func(name, nodeName string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       corev1.PodSpec{NodeName: nodeName},
+		}
+	}
+
+	It("should do nothing if the node is gone", func() {
+		Expect(fakeClient.Delete(ctx, node)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+	})
+
+	It("should do nothing if the node is not marked for draining", func() {
+		pod := newPod("pod", node.Name)
+		Expect(fakeClient.Create(ctx, pod)).To(Succeed())
+
+		patch := client.MergeFrom(node.DeepCopy())
+		node.Spec.Unschedulable = false
+		Expect(fakeClient.Patch(ctx, node, patch)).To(Succeed())
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)).To(Succeed())
+	})
+
+	It("should evict the pods running on the node and requeue", func() {
+		var (
+			pod             = newPod("pod", node.Name)
+			podOnOtherNode  = newPod("pod-other-node", "other-node")
+			daemonPod       = newPod("daemon-pod", node.Name)
+			staticPod       = newPod("static-pod", node.Name)
+			terminatedPod   = newPod("terminated-pod", node.Name)
+			evictablePodKey = client.ObjectKeyFromObject(pod)
+		)
+
+		daemonPod.OwnerReferences = []metav1.OwnerReference{{APIVersion: appsv1.SchemeGroupVersion.String(), Kind: "DaemonSet", Name: "daemonset", UID: "uid", Controller: ptr.To(true)}}
+		staticPod.Annotations = map[string]string{corev1.MirrorPodAnnotationKey: "foo"}
+		terminatedPod.Status.Phase = corev1.PodSucceeded
+
+		for _, obj := range []client.Object{pod, podOnOtherNode, daemonPod, staticPod, terminatedPod} {
+			Expect(fakeClient.Create(ctx, obj)).To(Succeed())
+		}
+
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{RequeueAfter: time.Minute}))
+
+		Expect(fakeClient.Get(ctx, evictablePodKey, &corev1.Pod{})).To(BeNotFoundError())
+		for _, obj := range []client.Object{podOnOtherNode, daemonPod, staticPod, terminatedPod} {
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(obj), obj)).To(Succeed())
+		}
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		Expect(node.Annotations).NotTo(HaveKey("worker.gardener.cloud/drained"))
+	})
+
+	It("should mark the node as drained once all pods are gone", func() {
+		Expect(reconciler.Reconcile(ctx, request)).To(Equal(reconcile.Result{}))
+
+		Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+		Expect(node.Annotations).To(HaveKeyWithValue("worker.gardener.cloud/drained", "true"))
+	})
+})
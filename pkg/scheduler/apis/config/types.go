@@ -113,6 +113,70 @@ type ShootSchedulerConfiguration struct {
 	ConcurrentSyncs int
 	// Strategy defines how seeds for shoots, that do not specify a seed explicitly, are being determined
 	Strategy CandidateDeterminationStrategy
+	// CapacityScoring configures the 'CapacityScore' plugin which scores seed candidates based on their allocatable
+	// capacity and the estimated control plane footprint of the shoots already scheduled onto them. If set, the plugin
+	// is enabled by default.
+	CapacityScoring *CapacityScoringConfiguration
+	// Plugins configures the filter and score plugins of the scheduling framework. The configured plugins are merged
+	// with the default plugins.
+	Plugins *Plugins
+	// SchedulingPreview configures the endpoint which previews where a shoot would be scheduled to and explains why
+	// seeds were rejected.
+	SchedulingPreview *SchedulingPreviewConfiguration
+}
+
+// SchedulingPreviewConfiguration defines the configuration of the scheduling preview endpoint.
+type SchedulingPreviewConfiguration struct {
+	// Enabled defines whether the scheduling preview endpoint is served.
+	Enabled bool
+	// Port is the port on which the scheduling preview endpoint is served. The endpoint is only bound to localhost.
+	Port int
+}
+
+// Plugins configures the filter and score plugins of the scheduling framework.
+type Plugins struct {
+	// Filter configures the plugins which filter out seeds that are not suitable for a shoot. Filter plugins are
+	// called in the configured order.
+	Filter PluginSet
+	// Score configures the plugins which rank the remaining seed candidates. The seed with the highest sum of all
+	// weighted scores is chosen.
+	Score PluginSet
+}
+
+// PluginSet specifies enabled and disabled plugins of an extension point.
+type PluginSet struct {
+	// Enabled specifies plugins which should be enabled in addition to the default plugins. If a default plugin is
+	// listed, only its weight is changed. Other plugins are called after the default plugins in the given order.
+	Enabled []Plugin
+	// Disabled specifies default plugins which should be disabled. '*' disables all default plugins, which allows to
+	// re-enable them in a different order.
+	Disabled []Plugin
+}
+
+// Plugin specifies a plugin name and its weight.
+type Plugin struct {
+	// Name is the name of the plugin.
+	Name string
+	// Weight is the weight of the plugin, only used for score plugins. Defaults to 1.
+	Weight *int64
+}
+
+// CapacityScoringConfiguration defines the configuration of the capacity-aware seed scoring. All weights are expressed
+// in percent of a standard shoot control plane, i.e., a control plane with a weight of 100 consumes exactly one unit of
+// the seed's allocatable shoots.
+type CapacityScoringConfiguration struct {
+	// PurposeWeights maps shoot purposes to the weight of their control planes. Shoots without a purpose are weighted
+	// like 'evaluation' shoots. Purposes which are not listed have a weight of 100.
+	PurposeWeights map[string]int64
+	// HighAvailabilityWeights maps failure tolerance types of highly available control planes to an additional weight
+	// which is added to the purpose weight.
+	HighAvailabilityWeights map[string]int64
+	// WorkerNodeWeight is the additional weight per worker node (sum of the maximum number of nodes of all worker
+	// pools) of a shoot.
+	WorkerNodeWeight *int64
+	// DefaultSeedCapacity is the number of allocatable shoots which is assumed for seeds that do not report an
+	// allocatable capacity for shoots in their status.
+	DefaultSeedCapacity *int64
 }
 
 // ServerConfiguration contains details for the HTTP(S) servers.
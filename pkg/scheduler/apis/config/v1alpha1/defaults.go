@@ -56,6 +56,53 @@ func SetDefaults_SchedulerControllerConfiguration(obj *SchedulerControllerConfig
 	}
 }
 
+// SetDefaults_CapacityScoringConfiguration sets defaults for the capacity-aware seed scoring.
+func SetDefaults_CapacityScoringConfiguration(obj *CapacityScoringConfiguration) {
+	if obj.PurposeWeights == nil {
+		obj.PurposeWeights = map[string]int64{}
+	}
+	for purpose, weight := range map[string]int64{
+		"evaluation":     100,
+		"testing":        100,
+		"development":    100,
+		"production":     200,
+		"infrastructure": 200,
+	} {
+		if _, ok := obj.PurposeWeights[purpose]; !ok {
+			obj.PurposeWeights[purpose] = weight
+		}
+	}
+
+	if obj.HighAvailabilityWeights == nil {
+		obj.HighAvailabilityWeights = map[string]int64{}
+	}
+	for failureToleranceType, weight := range map[string]int64{
+		"node": 50,
+		"zone": 100,
+	} {
+		if _, ok := obj.HighAvailabilityWeights[failureToleranceType]; !ok {
+			obj.HighAvailabilityWeights[failureToleranceType] = weight
+		}
+	}
+
+	if obj.WorkerNodeWeight == nil {
+		workerNodeWeight := int64(1)
+		obj.WorkerNodeWeight = &workerNodeWeight
+	}
+
+	if obj.DefaultSeedCapacity == nil {
+		defaultSeedCapacity := int64(250)
+		obj.DefaultSeedCapacity = &defaultSeedCapacity
+	}
+}
+
+// SetDefaults_SchedulingPreviewConfiguration sets defaults for the scheduling preview endpoint.
+func SetDefaults_SchedulingPreviewConfiguration(obj *SchedulingPreviewConfiguration) {
+	if obj.Port == 0 {
+		obj.Port = 19252
+	}
+}
+
 // SetDefaults_ClientConnectionConfiguration sets defaults for the garden client connection.
 func SetDefaults_ClientConnectionConfiguration(obj *componentbaseconfigv1alpha1.ClientConnectionConfiguration) {
 	if obj.QPS == 0.0 {
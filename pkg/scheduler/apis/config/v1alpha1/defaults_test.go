@@ -100,6 +100,89 @@ var _ = Describe("Defaults", func() {
 		})
 	})
 
+	Describe("CapacityScoringConfiguration defaulting", func() {
+		It("should not default the capacity scoring configuration if it is not set", func() {
+			schedulerv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Shoot.CapacityScoring).To(BeNil())
+		})
+
+		It("should default the capacity scoring configuration", func() {
+			obj.Schedulers.Shoot = &schedulerv1alpha1.ShootSchedulerConfiguration{
+				CapacityScoring: &schedulerv1alpha1.CapacityScoringConfiguration{},
+			}
+
+			schedulerv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Shoot.CapacityScoring).To(Equal(&schedulerv1alpha1.CapacityScoringConfiguration{
+				PurposeWeights: map[string]int64{
+					"evaluation":     100,
+					"testing":        100,
+					"development":    100,
+					"production":     200,
+					"infrastructure": 200,
+				},
+				HighAvailabilityWeights: map[string]int64{
+					"node": 50,
+					"zone": 100,
+				},
+				WorkerNodeWeight:    ptr.To[int64](1),
+				DefaultSeedCapacity: ptr.To[int64](250),
+			}))
+		})
+
+		It("should not overwrite already set values for the capacity scoring configuration", func() {
+			obj.Schedulers.Shoot = &schedulerv1alpha1.ShootSchedulerConfiguration{
+				CapacityScoring: &schedulerv1alpha1.CapacityScoringConfiguration{
+					PurposeWeights:          map[string]int64{"production": 400},
+					HighAvailabilityWeights: map[string]int64{"zone": 300},
+					WorkerNodeWeight:        ptr.To[int64](0),
+					DefaultSeedCapacity:     ptr.To[int64](100),
+				},
+			}
+
+			schedulerv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Shoot.CapacityScoring).To(Equal(&schedulerv1alpha1.CapacityScoringConfiguration{
+				PurposeWeights: map[string]int64{
+					"evaluation":     100,
+					"testing":        100,
+					"development":    100,
+					"production":     400,
+					"infrastructure": 200,
+				},
+				HighAvailabilityWeights: map[string]int64{
+					"node": 50,
+					"zone": 300,
+				},
+				WorkerNodeWeight:    ptr.To[int64](0),
+				DefaultSeedCapacity: ptr.To[int64](100),
+			}))
+		})
+	})
+
+	Describe("SchedulingPreviewConfiguration defaulting", func() {
+		It("should default the scheduling preview configuration", func() {
+			obj.Schedulers.Shoot = &schedulerv1alpha1.ShootSchedulerConfiguration{
+				SchedulingPreview: &schedulerv1alpha1.SchedulingPreviewConfiguration{Enabled: true},
+			}
+
+			schedulerv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Shoot.SchedulingPreview).To(Equal(&schedulerv1alpha1.SchedulingPreviewConfiguration{Enabled: true, Port: 19252}))
+		})
+
+		It("should not overwrite already set values for the scheduling preview configuration", func() {
+			obj.Schedulers.Shoot = &schedulerv1alpha1.ShootSchedulerConfiguration{
+				SchedulingPreview: &schedulerv1alpha1.SchedulingPreviewConfiguration{Enabled: true, Port: 1234},
+			}
+
+			schedulerv1alpha1.SetObjectDefaults_SchedulerConfiguration(obj)
+
+			Expect(obj.Schedulers.Shoot.SchedulingPreview).To(Equal(&schedulerv1alpha1.SchedulingPreviewConfiguration{Enabled: true, Port: 1234}))
+		})
+	})
+
 	Describe("ServerConfiguration defaulting", func() {
 		It("should not overwrite already set values for ServerConfiguration", func() {
 			serverConfiguration := &schedulerv1alpha1.ServerConfiguration{
@@ -36,6 +36,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CapacityScoringConfiguration)(nil), (*config.CapacityScoringConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CapacityScoringConfiguration_To_config_CapacityScoringConfiguration(a.(*CapacityScoringConfiguration), b.(*config.CapacityScoringConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.CapacityScoringConfiguration)(nil), (*CapacityScoringConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_CapacityScoringConfiguration_To_v1alpha1_CapacityScoringConfiguration(a.(*config.CapacityScoringConfiguration), b.(*CapacityScoringConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Plugin)(nil), (*config.Plugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Plugin_To_config_Plugin(a.(*Plugin), b.(*config.Plugin), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Plugin)(nil), (*Plugin)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Plugin_To_v1alpha1_Plugin(a.(*config.Plugin), b.(*Plugin), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PluginSet)(nil), (*config.PluginSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_PluginSet_To_config_PluginSet(a.(*PluginSet), b.(*config.PluginSet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.PluginSet)(nil), (*PluginSet)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_PluginSet_To_v1alpha1_PluginSet(a.(*config.PluginSet), b.(*PluginSet), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*Plugins)(nil), (*config.Plugins)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Plugins_To_config_Plugins(a.(*Plugins), b.(*config.Plugins), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.Plugins)(nil), (*Plugins)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_Plugins_To_v1alpha1_Plugins(a.(*config.Plugins), b.(*Plugins), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SchedulerConfiguration)(nil), (*config.SchedulerConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_SchedulerConfiguration_To_config_SchedulerConfiguration(a.(*SchedulerConfiguration), b.(*config.SchedulerConfiguration), scope)
 	}); err != nil {
@@ -56,6 +96,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*SchedulingPreviewConfiguration)(nil), (*config.SchedulingPreviewConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_SchedulingPreviewConfiguration_To_config_SchedulingPreviewConfiguration(a.(*SchedulingPreviewConfiguration), b.(*config.SchedulingPreviewConfiguration), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*config.SchedulingPreviewConfiguration)(nil), (*SchedulingPreviewConfiguration)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_config_SchedulingPreviewConfiguration_To_v1alpha1_SchedulingPreviewConfiguration(a.(*config.SchedulingPreviewConfiguration), b.(*SchedulingPreviewConfiguration), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Server)(nil), (*config.Server)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_Server_To_config_Server(a.(*Server), b.(*config.Server), scope)
 	}); err != nil {
@@ -109,6 +159,106 @@ func Convert_config_BackupBucketSchedulerConfiguration_To_v1alpha1_BackupBucketS
 	return autoConvert_config_BackupBucketSchedulerConfiguration_To_v1alpha1_BackupBucketSchedulerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_CapacityScoringConfiguration_To_config_CapacityScoringConfiguration(in *CapacityScoringConfiguration, out *config.CapacityScoringConfiguration, s conversion.Scope) error {
+	out.PurposeWeights = *(*map[string]int64)(unsafe.Pointer(&in.PurposeWeights))
+	out.HighAvailabilityWeights = *(*map[string]int64)(unsafe.Pointer(&in.HighAvailabilityWeights))
+	out.WorkerNodeWeight = (*int64)(unsafe.Pointer(in.WorkerNodeWeight))
+	out.DefaultSeedCapacity = (*int64)(unsafe.Pointer(in.DefaultSeedCapacity))
+	return nil
+}
+
+// Convert_v1alpha1_CapacityScoringConfiguration_To_config_CapacityScoringConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_CapacityScoringConfiguration_To_config_CapacityScoringConfiguration(in *CapacityScoringConfiguration, out *config.CapacityScoringConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CapacityScoringConfiguration_To_config_CapacityScoringConfiguration(in, out, s)
+}
+
+func autoConvert_config_CapacityScoringConfiguration_To_v1alpha1_CapacityScoringConfiguration(in *config.CapacityScoringConfiguration, out *CapacityScoringConfiguration, s conversion.Scope) error {
+	out.PurposeWeights = *(*map[string]int64)(unsafe.Pointer(&in.PurposeWeights))
+	out.HighAvailabilityWeights = *(*map[string]int64)(unsafe.Pointer(&in.HighAvailabilityWeights))
+	out.WorkerNodeWeight = (*int64)(unsafe.Pointer(in.WorkerNodeWeight))
+	out.DefaultSeedCapacity = (*int64)(unsafe.Pointer(in.DefaultSeedCapacity))
+	return nil
+}
+
+// Convert_config_CapacityScoringConfiguration_To_v1alpha1_CapacityScoringConfiguration is an autogenerated conversion function.
+func Convert_config_CapacityScoringConfiguration_To_v1alpha1_CapacityScoringConfiguration(in *config.CapacityScoringConfiguration, out *CapacityScoringConfiguration, s conversion.Scope) error {
+	return autoConvert_config_CapacityScoringConfiguration_To_v1alpha1_CapacityScoringConfiguration(in, out, s)
+}
+
+func autoConvert_v1alpha1_Plugin_To_config_Plugin(in *Plugin, out *config.Plugin, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Weight = (*int64)(unsafe.Pointer(in.Weight))
+	return nil
+}
+
+// Convert_v1alpha1_Plugin_To_config_Plugin is an autogenerated conversion function.
+func Convert_v1alpha1_Plugin_To_config_Plugin(in *Plugin, out *config.Plugin, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Plugin_To_config_Plugin(in, out, s)
+}
+
+func autoConvert_config_Plugin_To_v1alpha1_Plugin(in *config.Plugin, out *Plugin, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Weight = (*int64)(unsafe.Pointer(in.Weight))
+	return nil
+}
+
+// Convert_config_Plugin_To_v1alpha1_Plugin is an autogenerated conversion function.
+func Convert_config_Plugin_To_v1alpha1_Plugin(in *config.Plugin, out *Plugin, s conversion.Scope) error {
+	return autoConvert_config_Plugin_To_v1alpha1_Plugin(in, out, s)
+}
+
+func autoConvert_v1alpha1_PluginSet_To_config_PluginSet(in *PluginSet, out *config.PluginSet, s conversion.Scope) error {
+	out.Enabled = *(*[]config.Plugin)(unsafe.Pointer(&in.Enabled))
+	out.Disabled = *(*[]config.Plugin)(unsafe.Pointer(&in.Disabled))
+	return nil
+}
+
+// Convert_v1alpha1_PluginSet_To_config_PluginSet is an autogenerated conversion function.
+func Convert_v1alpha1_PluginSet_To_config_PluginSet(in *PluginSet, out *config.PluginSet, s conversion.Scope) error {
+	return autoConvert_v1alpha1_PluginSet_To_config_PluginSet(in, out, s)
+}
+
+func autoConvert_config_PluginSet_To_v1alpha1_PluginSet(in *config.PluginSet, out *PluginSet, s conversion.Scope) error {
+	out.Enabled = *(*[]Plugin)(unsafe.Pointer(&in.Enabled))
+	out.Disabled = *(*[]Plugin)(unsafe.Pointer(&in.Disabled))
+	return nil
+}
+
+// Convert_config_PluginSet_To_v1alpha1_PluginSet is an autogenerated conversion function.
+func Convert_config_PluginSet_To_v1alpha1_PluginSet(in *config.PluginSet, out *PluginSet, s conversion.Scope) error {
+	return autoConvert_config_PluginSet_To_v1alpha1_PluginSet(in, out, s)
+}
+
+func autoConvert_v1alpha1_Plugins_To_config_Plugins(in *Plugins, out *config.Plugins, s conversion.Scope) error {
+	if err := Convert_v1alpha1_PluginSet_To_config_PluginSet(&in.Filter, &out.Filter, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_PluginSet_To_config_PluginSet(&in.Score, &out.Score, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha1_Plugins_To_config_Plugins is an autogenerated conversion function.
+func Convert_v1alpha1_Plugins_To_config_Plugins(in *Plugins, out *config.Plugins, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Plugins_To_config_Plugins(in, out, s)
+}
+
+func autoConvert_config_Plugins_To_v1alpha1_Plugins(in *config.Plugins, out *Plugins, s conversion.Scope) error {
+	if err := Convert_config_PluginSet_To_v1alpha1_PluginSet(&in.Filter, &out.Filter, s); err != nil {
+		return err
+	}
+	if err := Convert_config_PluginSet_To_v1alpha1_PluginSet(&in.Score, &out.Score, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_config_Plugins_To_v1alpha1_Plugins is an autogenerated conversion function.
+func Convert_config_Plugins_To_v1alpha1_Plugins(in *config.Plugins, out *Plugins, s conversion.Scope) error {
+	return autoConvert_config_Plugins_To_v1alpha1_Plugins(in, out, s)
+}
+
 func autoConvert_v1alpha1_SchedulerConfiguration_To_config_SchedulerConfiguration(in *SchedulerConfiguration, out *config.SchedulerConfiguration, s conversion.Scope) error {
 	if err := configv1alpha1.Convert_v1alpha1_ClientConnectionConfiguration_To_config_ClientConnectionConfiguration(&in.ClientConnection, &out.ClientConnection, s); err != nil {
 		return err
@@ -209,6 +359,28 @@ func Convert_config_SchedulerControllerConfiguration_To_v1alpha1_SchedulerContro
 	return autoConvert_config_SchedulerControllerConfiguration_To_v1alpha1_SchedulerControllerConfiguration(in, out, s)
 }
 
+func autoConvert_v1alpha1_SchedulingPreviewConfiguration_To_config_SchedulingPreviewConfiguration(in *SchedulingPreviewConfiguration, out *config.SchedulingPreviewConfiguration, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Port = in.Port
+	return nil
+}
+
+// Convert_v1alpha1_SchedulingPreviewConfiguration_To_config_SchedulingPreviewConfiguration is an autogenerated conversion function.
+func Convert_v1alpha1_SchedulingPreviewConfiguration_To_config_SchedulingPreviewConfiguration(in *SchedulingPreviewConfiguration, out *config.SchedulingPreviewConfiguration, s conversion.Scope) error {
+	return autoConvert_v1alpha1_SchedulingPreviewConfiguration_To_config_SchedulingPreviewConfiguration(in, out, s)
+}
+
+func autoConvert_config_SchedulingPreviewConfiguration_To_v1alpha1_SchedulingPreviewConfiguration(in *config.SchedulingPreviewConfiguration, out *SchedulingPreviewConfiguration, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Port = in.Port
+	return nil
+}
+
+// Convert_config_SchedulingPreviewConfiguration_To_v1alpha1_SchedulingPreviewConfiguration is an autogenerated conversion function.
+func Convert_config_SchedulingPreviewConfiguration_To_v1alpha1_SchedulingPreviewConfiguration(in *config.SchedulingPreviewConfiguration, out *SchedulingPreviewConfiguration, s conversion.Scope) error {
+	return autoConvert_config_SchedulingPreviewConfiguration_To_v1alpha1_SchedulingPreviewConfiguration(in, out, s)
+}
+
 func autoConvert_v1alpha1_Server_To_config_Server(in *Server, out *config.Server, s conversion.Scope) error {
 	out.BindAddress = in.BindAddress
 	out.Port = in.Port
@@ -256,6 +428,9 @@ func Convert_config_ServerConfiguration_To_v1alpha1_ServerConfiguration(in *conf
 func autoConvert_v1alpha1_ShootSchedulerConfiguration_To_config_ShootSchedulerConfiguration(in *ShootSchedulerConfiguration, out *config.ShootSchedulerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.Strategy = config.CandidateDeterminationStrategy(in.Strategy)
+	out.CapacityScoring = (*config.CapacityScoringConfiguration)(unsafe.Pointer(in.CapacityScoring))
+	out.Plugins = (*config.Plugins)(unsafe.Pointer(in.Plugins))
+	out.SchedulingPreview = (*config.SchedulingPreviewConfiguration)(unsafe.Pointer(in.SchedulingPreview))
 	return nil
 }
 
@@ -267,6 +442,9 @@ func Convert_v1alpha1_ShootSchedulerConfiguration_To_config_ShootSchedulerConfig
 func autoConvert_config_ShootSchedulerConfiguration_To_v1alpha1_ShootSchedulerConfiguration(in *config.ShootSchedulerConfiguration, out *ShootSchedulerConfiguration, s conversion.Scope) error {
 	out.ConcurrentSyncs = in.ConcurrentSyncs
 	out.Strategy = CandidateDeterminationStrategy(in.Strategy)
+	out.CapacityScoring = (*CapacityScoringConfiguration)(unsafe.Pointer(in.CapacityScoring))
+	out.Plugins = (*Plugins)(unsafe.Pointer(in.Plugins))
+	out.SchedulingPreview = (*SchedulingPreviewConfiguration)(unsafe.Pointer(in.SchedulingPreview))
 	return nil
 }
 
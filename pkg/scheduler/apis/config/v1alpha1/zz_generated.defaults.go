@@ -29,4 +29,12 @@ func SetObjectDefaults_SchedulerConfiguration(in *SchedulerConfiguration) {
 	}
 	SetDefaults_ServerConfiguration(&in.Server)
 	SetDefaults_SchedulerControllerConfiguration(&in.Schedulers)
+	if in.Schedulers.Shoot != nil {
+		if in.Schedulers.Shoot.CapacityScoring != nil {
+			SetDefaults_CapacityScoringConfiguration(in.Schedulers.Shoot.CapacityScoring)
+		}
+		if in.Schedulers.Shoot.SchedulingPreview != nil {
+			SetDefaults_SchedulingPreviewConfiguration(in.Schedulers.Shoot.SchedulingPreview)
+		}
+	}
 }
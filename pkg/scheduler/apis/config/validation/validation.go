@@ -57,6 +57,8 @@ func validateSchedulerControllerConfiguration(schedulers schedulerconfig.Schedul
 	if schedulers.Shoot != nil {
 		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(int64(schedulers.Shoot.ConcurrentSyncs), fldPath.Child("shoot", "concurrentSyncs"))...)
 		allErrs = append(allErrs, validateStrategy(schedulers.Shoot.Strategy, fldPath.Child("shoot", "strategy"))...)
+		allErrs = append(allErrs, validateCapacityScoring(schedulers.Shoot.CapacityScoring, fldPath.Child("shoot", "capacityScoring"))...)
+		allErrs = append(allErrs, validatePlugins(schedulers.Shoot.Plugins, fldPath.Child("shoot", "plugins"))...)
 	}
 
 	return allErrs
@@ -79,3 +81,77 @@ func validateStrategy(strategy schedulerconfig.CandidateDeterminationStrategy, f
 
 	return allErrs
 }
+
+func validateCapacityScoring(capacityScoring *schedulerconfig.CapacityScoringConfiguration, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if capacityScoring == nil {
+		return allErrs
+	}
+
+	for purpose, weight := range capacityScoring.PurposeWeights {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(weight, fldPath.Child("purposeWeights").Key(purpose))...)
+	}
+
+	for failureToleranceType, weight := range capacityScoring.HighAvailabilityWeights {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(weight, fldPath.Child("highAvailabilityWeights").Key(failureToleranceType))...)
+	}
+
+	if capacityScoring.WorkerNodeWeight != nil {
+		allErrs = append(allErrs, apivalidation.ValidateNonnegativeField(*capacityScoring.WorkerNodeWeight, fldPath.Child("workerNodeWeight"))...)
+	}
+
+	if capacityScoring.DefaultSeedCapacity != nil && *capacityScoring.DefaultSeedCapacity <= 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("defaultSeedCapacity"), *capacityScoring.DefaultSeedCapacity, "must be greater than 0"))
+	}
+
+	return allErrs
+}
+
+func validatePlugins(plugins *schedulerconfig.Plugins, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if plugins == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, validatePluginSet(plugins.Filter, false, fldPath.Child("filter"))...)
+	allErrs = append(allErrs, validatePluginSet(plugins.Score, true, fldPath.Child("score"))...)
+
+	return allErrs
+}
+
+func validatePluginSet(pluginSet schedulerconfig.PluginSet, weighted bool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	enabledNames := sets.New[string]()
+	for i, plugin := range pluginSet.Enabled {
+		idxPath := fldPath.Child("enabled").Index(i)
+
+		switch {
+		case plugin.Name == "":
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "plugin name must not be empty"))
+		case plugin.Name == "*":
+			allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), plugin.Name, "wildcard is only allowed for disabled plugins"))
+		case enabledNames.Has(plugin.Name):
+			allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), plugin.Name))
+		}
+		enabledNames.Insert(plugin.Name)
+
+		if plugin.Weight != nil {
+			if !weighted {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("weight"), "weight is only supported for score plugins"))
+			} else if *plugin.Weight <= 0 {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("weight"), *plugin.Weight, "must be greater than 0"))
+			}
+		}
+	}
+
+	for i, plugin := range pluginSet.Disabled {
+		if plugin.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("disabled").Index(i).Child("name"), "plugin name must not be empty"))
+		}
+	}
+
+	return allErrs
+}
@@ -20,6 +20,7 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	schedulerconfig "github.com/gardener/gardener/pkg/scheduler/apis/config"
 )
@@ -102,6 +103,102 @@ var _ = Describe("gardener-scheduler", func() {
 					"Field": Equal("schedulers.shoot.concurrentSyncs"),
 				}))))
 			})
+
+			It("should pass because the capacity scoring configuration is valid", func() {
+				validConfiguration := defaultAdmissionConfiguration
+				validConfiguration.Schedulers.Shoot.CapacityScoring = &schedulerconfig.CapacityScoringConfiguration{
+					PurposeWeights:          map[string]int64{"production": 200},
+					HighAvailabilityWeights: map[string]int64{"zone": 100},
+					WorkerNodeWeight:        ptr.To[int64](0),
+					DefaultSeedCapacity:     ptr.To[int64](250),
+				}
+
+				Expect(ValidateConfiguration(&validConfiguration)).To(BeEmpty())
+			})
+
+			It("should fail because the capacity scoring configuration contains invalid values", func() {
+				invalidConfiguration := defaultAdmissionConfiguration
+				invalidConfiguration.Schedulers.Shoot.CapacityScoring = &schedulerconfig.CapacityScoringConfiguration{
+					PurposeWeights:          map[string]int64{"production": -1},
+					HighAvailabilityWeights: map[string]int64{"zone": -1},
+					WorkerNodeWeight:        ptr.To[int64](-1),
+					DefaultSeedCapacity:     ptr.To[int64](0),
+				}
+
+				Expect(ValidateConfiguration(&invalidConfiguration)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.shoot.capacityScoring.purposeWeights[production]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.shoot.capacityScoring.highAvailabilityWeights[zone]"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.shoot.capacityScoring.workerNodeWeight"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.shoot.capacityScoring.defaultSeedCapacity"),
+					})),
+				))
+			})
+
+			It("should pass because the plugin configuration is valid", func() {
+				validConfiguration := defaultAdmissionConfiguration
+				validConfiguration.Schedulers.Shoot.Plugins = &schedulerconfig.Plugins{
+					Filter: schedulerconfig.PluginSet{
+						Enabled:  []schedulerconfig.Plugin{{Name: "SeedUsable"}, {Name: "Custom"}},
+						Disabled: []schedulerconfig.Plugin{{Name: "*"}},
+					},
+					Score: schedulerconfig.PluginSet{
+						Enabled: []schedulerconfig.Plugin{{Name: "CapacityScore", Weight: ptr.To[int64](2)}},
+					},
+				}
+
+				Expect(ValidateConfiguration(&validConfiguration)).To(BeEmpty())
+			})
+
+			It("should fail because the plugin configuration is invalid", func() {
+				invalidConfiguration := defaultAdmissionConfiguration
+				invalidConfiguration.Schedulers.Shoot.Plugins = &schedulerconfig.Plugins{
+					Filter: schedulerconfig.PluginSet{
+						Enabled:  []schedulerconfig.Plugin{{Name: ""}, {Name: "*"}, {Name: "Custom", Weight: ptr.To[int64](1)}},
+						Disabled: []schedulerconfig.Plugin{{Name: ""}},
+					},
+					Score: schedulerconfig.PluginSet{
+						Enabled: []schedulerconfig.Plugin{{Name: "CapacityScore"}, {Name: "CapacityScore", Weight: ptr.To[int64](0)}},
+					},
+				}
+
+				Expect(ValidateConfiguration(&invalidConfiguration)).To(ConsistOf(
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("schedulers.shoot.plugins.filter.enabled[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.shoot.plugins.filter.enabled[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("schedulers.shoot.plugins.filter.enabled[2].weight"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeRequired),
+						"Field": Equal("schedulers.shoot.plugins.filter.disabled[0].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeDuplicate),
+						"Field": Equal("schedulers.shoot.plugins.score.enabled[1].name"),
+					})),
+					PointTo(MatchFields(IgnoreExtras, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("schedulers.shoot.plugins.score.enabled[1].weight"),
+					})),
+				))
+			})
 		})
 	})
 })
@@ -21,12 +21,15 @@ import (
 
 	"github.com/gardener/gardener/pkg/scheduler/apis/config"
 	"github.com/gardener/gardener/pkg/scheduler/controller/shoot"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
 )
 
-// AddToManager adds all scheduler controllers to the given manager.
-func AddToManager(mgr manager.Manager, cfg *config.SchedulerConfiguration) error {
+// AddToManager adds all scheduler controllers to the given manager. The Shoot controller uses the given scheduling
+// framework for determining seeds.
+func AddToManager(mgr manager.Manager, cfg *config.SchedulerConfiguration, shootFramework *framework.Framework) error {
 	if err := (&shoot.Reconciler{
-		Config: cfg.Schedulers.Shoot,
+		Config:    cfg.Schedulers.Shoot,
+		Framework: shootFramework,
 	}).AddToManager(mgr); err != nil {
 		return fmt.Errorf("failed adding Shoot controller: %w", err)
 	}
@@ -15,6 +15,8 @@
 package shoot
 
 import (
+	"fmt"
+
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -25,6 +27,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	predicateutils "github.com/gardener/gardener/pkg/controllerutils/predicate"
+	"github.com/gardener/gardener/pkg/scheduler/framework/plugins"
 )
 
 // ControllerName is the name of this controller.
@@ -41,6 +44,12 @@ func (r *Reconciler) AddToManager(mgr manager.Manager) error {
 	if r.GardenNamespace == "" {
 		r.GardenNamespace = v1beta1constants.GardenNamespace
 	}
+	if r.Framework == nil {
+		var err error
+		if r.Framework, err = plugins.NewFramework(r.Config, nil); err != nil {
+			return fmt.Errorf("failed creating scheduling framework: %w", err)
+		}
+	}
 
 	return builder.
 		ControllerManagedBy(mgr).
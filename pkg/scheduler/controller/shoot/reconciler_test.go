@@ -20,7 +20,7 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
-	gomegatypes "github.com/onsi/gomega/types"
+	. "github.com/onsi/gomega/gstruct"
 	"go.uber.org/mock/gomock"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -32,6 +32,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	"github.com/gardener/gardener/pkg/scheduler/framework/plugins"
 )
 
 var _ = Describe("Scheduler_Control", func() {
@@ -133,9 +134,13 @@ var _ = Describe("Scheduler_Control", func() {
 	})
 
 	JustBeforeEach(func() {
+		shootFramework, err := plugins.NewFramework(schedulerConfiguration.Schedulers.Shoot, nil)
+		Expect(err).NotTo(HaveOccurred())
+
 		reconciler = &Reconciler{
-			Client: fakeGardenClient,
-			Config: schedulerConfiguration.Schedulers.Shoot,
+			Client:    fakeGardenClient,
+			Config:    schedulerConfiguration.Schedulers.Shoot,
+			Framework: shootFramework,
 		}
 	})
 
@@ -159,7 +164,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(seed.Name))
 		})
@@ -179,7 +184,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, &secondShoot)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
 		})
@@ -196,7 +201,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, &secondSeed)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
 		})
@@ -209,7 +214,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -227,7 +232,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).To(MatchError("none of the 1 seeds has at least 3 zones for hosting a shoot control plane with failure tolerance type 'zone'"))
 			Expect(bestSeed).To(BeNil())
 		})
@@ -247,7 +252,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).To(MatchError("none of the 1 seeds has at least 3 zones for hosting a shoot control plane with failure tolerance type 'zone'"))
 			Expect(bestSeed).To(BeNil())
 		})
@@ -263,7 +268,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, &multiZonalSeed)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(multiZonalSeed.Name))
 		})
@@ -277,7 +282,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, &multiZonalSeed)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(multiZonalSeed.Name))
 		})
@@ -306,7 +311,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed).NotTo(BeNil())
 		})
@@ -320,7 +325,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed).NotTo(BeNil())
 		})
@@ -334,7 +339,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed).NotTo(BeNil())
 		})
@@ -354,7 +359,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed).NotTo(BeNil())
 		})
@@ -365,7 +370,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -382,7 +387,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -400,7 +405,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -421,7 +426,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(seedName))
 		})
@@ -433,7 +438,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(seedName))
 			// verify that shoot is in another region than the seed
@@ -461,7 +466,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, &secondSeed)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, &thirdSeed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
 			// verify that shoot is in another region than the chosen seed
@@ -483,7 +488,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, &secondShoot)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
 		})
@@ -523,7 +528,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, oldSeedEnvironment1)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, newSeedEnvironment2)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, testShoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, testShoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(newSeedEnvironment2.Name))
 		})
@@ -573,7 +578,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, newSeedEnvironment2)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, newSeedEnvironment3)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, testShoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, testShoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(newSeedEnvironment3.Name))
 		})
@@ -604,10 +609,60 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, &secondShoot)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, &thirdShoot)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
 		})
+
+		It("should pick the candidate with the highest capacity score if capacity scoring is configured", func() {
+			schedulerConfiguration.Schedulers.Shoot.CapacityScoring = &config.CapacityScoringConfiguration{
+				PurposeWeights:      map[string]int64{"evaluation": 100, "production": 200},
+				WorkerNodeWeight:    ptr.To[int64](1),
+				DefaultSeedCapacity: ptr.To[int64](250),
+			}
+
+			var err error
+			reconciler.Framework, err = plugins.NewFramework(schedulerConfiguration.Schedulers.Shoot, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			seed.Status.Allocatable = corev1.ResourceList{
+				gardencorev1beta1.ResourceShoots: resource.MustParse("10"),
+			}
+
+			secondSeed := seedBase
+			secondSeed.Name = "seed-2"
+			secondSeed.Status.Allocatable = corev1.ResourceList{
+				gardencorev1beta1.ResourceShoots: resource.MustParse("3"),
+			}
+
+			// first seed hosts more shoots than seed-2 but still has more capacity left -> expect seed-1 to be selected
+			secondShoot := shootBase
+			secondShoot.Name = "shoot-2"
+			secondShoot.Spec.Purpose = ptr.To(gardencorev1beta1.ShootPurposeProduction)
+			secondShoot.Spec.SeedName = &seed.Name
+
+			thirdShoot := shootBase
+			thirdShoot.Name = "shoot-3"
+			thirdShoot.Spec.Purpose = ptr.To(gardencorev1beta1.ShootPurposeProduction)
+			thirdShoot.Spec.SeedName = &seed.Name
+
+			fourthShoot := shootBase
+			fourthShoot.Name = "shoot-4"
+			fourthShoot.Spec.SeedName = &secondSeed.Name
+
+			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &secondSeed)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &secondShoot)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &thirdShoot)).To(Succeed())
+			Expect(fakeGardenClient.Create(ctx, &fourthShoot)).To(Succeed())
+
+			bestSeed, score, err := reconciler.determineSeed(ctx, log, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(bestSeed.Name).To(Equal(seed.Name))
+			Expect(score).To(PointTo(Equal(int64(50))))
+		})
 	})
 
 	Context("SEED DETERMINATION - Shoot does not reference a Seed - find an adequate one using default seed determination strategy", func() {
@@ -625,7 +680,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(seedName))
 		})
@@ -641,7 +696,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(seedName))
 		})
@@ -660,7 +715,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, &secondShoot)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(bestSeed.Name).To(Equal(secondSeed.Name))
 		})
@@ -677,7 +732,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -689,7 +744,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -706,7 +761,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, shoot)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, &secondShoot)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -718,7 +773,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -729,7 +784,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -745,7 +800,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -762,7 +817,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -772,7 +827,7 @@ var _ = Describe("Scheduler_Control", func() {
 
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -788,7 +843,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -799,7 +854,7 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
@@ -814,122 +869,9 @@ var _ = Describe("Scheduler_Control", func() {
 			Expect(fakeGardenClient.Create(ctx, cloudProfile)).To(Succeed())
 			Expect(fakeGardenClient.Create(ctx, seed)).To(Succeed())
 
-			bestSeed, err := reconciler.determineSeed(ctx, log, shoot)
+			bestSeed, _, err := reconciler.determineSeed(ctx, log, shoot)
 			Expect(err).To(HaveOccurred())
 			Expect(bestSeed).To(BeNil())
 		})
 	})
-
-	Context("#DetermineBestSeedCandidate", func() {
-		BeforeEach(func() {
-			seed = seedBase.DeepCopy()
-			shoot = shootBase.DeepCopy()
-			schedulerConfiguration = *schedulerConfigurationBase.DeepCopy()
-			// no seed referenced
-			shoot.Spec.SeedName = nil
-			schedulerConfiguration.Schedulers.Shoot.Strategy = config.MinimalDistance
-		})
-
-		It("should find two seeds candidates having the same amount of matching characters", func() {
-			oldSeedEnvironment1 := *seed
-			oldSeedEnvironment1.Spec.Provider.Type = "some-type"
-			oldSeedEnvironment1.Spec.Provider.Region = "eu-de-200"
-			oldSeedEnvironment1.Name = "seed1"
-
-			newSeedEnvironment2 := *seed
-			newSeedEnvironment2.Spec.Provider.Type = "some-type"
-			newSeedEnvironment2.Spec.Provider.Region = "eu-de-2111"
-			newSeedEnvironment2.Name = "seed2"
-
-			otherSeedEnvironment2 := *seed
-			otherSeedEnvironment2.Spec.Provider.Type = "some-type"
-			otherSeedEnvironment2.Spec.Provider.Region = "eu-nl-1"
-			otherSeedEnvironment2.Name = "xyz"
-
-			// shoot
-			testShoot := shoot
-			testShoot.Spec.Region = "eu-de-2xzxzzx"
-			testShoot.Spec.CloudProfileName = "cloudprofile2"
-			testShoot.Spec.Provider.Type = "some-type"
-
-			candidates, err := applyStrategy(log, testShoot, []gardencorev1beta1.Seed{newSeedEnvironment2, oldSeedEnvironment1, otherSeedEnvironment2}, schedulerConfiguration.Schedulers.Shoot.Strategy, nil)
-			Expect(err).NotTo(HaveOccurred())
-			Expect(candidates).To(HaveLen(2))
-			Expect(candidates[0].Name).To(Equal(newSeedEnvironment2.Name))
-			Expect(candidates[1].Name).To(Equal(oldSeedEnvironment1.Name))
-		})
-
-		It("should find single seed candidate", func() {
-			oldSeedEnvironment1 := *seed
-			oldSeedEnvironment1.Spec.Provider.Type = "some-type"
-			oldSeedEnvironment1.Spec.Provider.Region = "eu-de-200"
-			oldSeedEnvironment1.Name = "seed1"
-
-			newSeedEnvironment2 := *seed
-			newSeedEnvironment2.Spec.Provider.Type = "some-type"
-			newSeedEnvironment2.Spec.Provider.Region = "eu-de-2111"
-			newSeedEnvironment2.Name = "seed2"
-
-			otherSeedEnvironment2 := *seed
-			otherSeedEnvironment2.Spec.Provider.Type = "some-type"
-			otherSeedEnvironment2.Spec.Provider.Region = "eu-nl-1"
-			otherSeedEnvironment2.Name = "xyz"
-
-			// shoot
-			testShoot := shoot
-			testShoot.Spec.Region = "eu-de-20"
-			testShoot.Spec.CloudProfileName = "cloudprofile2"
-			testShoot.Spec.Provider.Type = "some-type"
-
-			candidates, err := applyStrategy(log, testShoot, []gardencorev1beta1.Seed{newSeedEnvironment2, oldSeedEnvironment1, otherSeedEnvironment2}, schedulerConfiguration.Schedulers.Shoot.Strategy, nil)
-			Expect(err).NotTo(HaveOccurred())
-			Expect(candidates).To(HaveLen(1))
-			Expect(candidates[0].Name).To(Equal(oldSeedEnvironment1.Name))
-		})
-	})
 })
-
-var _ = DescribeTable("condition is false",
-	func(conditionType gardencorev1beta1.ConditionType, deleteCondition, backup bool, expected gomegatypes.GomegaMatcher) {
-		var seedBackup *gardencorev1beta1.SeedBackup
-		if backup {
-			seedBackup = &gardencorev1beta1.SeedBackup{}
-		}
-
-		seed := &gardencorev1beta1.Seed{
-			Spec: gardencorev1beta1.SeedSpec{
-				Backup: seedBackup,
-			},
-			Status: gardencorev1beta1.SeedStatus{
-				Conditions: []gardencorev1beta1.Condition{
-					{Type: gardencorev1beta1.SeedGardenletReady, Status: gardencorev1beta1.ConditionTrue},
-					{Type: gardencorev1beta1.SeedBackupBucketsReady, Status: gardencorev1beta1.ConditionTrue},
-					{Type: gardencorev1beta1.SeedExtensionsReady, Status: gardencorev1beta1.ConditionTrue},
-				},
-				LastOperation: &gardencorev1beta1.LastOperation{},
-			},
-		}
-
-		for i, cond := range seed.Status.Conditions {
-			if cond.Type == conditionType {
-				if deleteCondition {
-					seed.Status.Conditions = append(seed.Status.Conditions[:i], seed.Status.Conditions[i+1:]...)
-				} else {
-					seed.Status.Conditions[i].Status = gardencorev1beta1.ConditionFalse
-				}
-				break
-			}
-		}
-
-		Expect(verifySeedReadiness(seed)).To(expected)
-	},
-
-	Entry("SeedGardenletReady is missing", gardencorev1beta1.SeedGardenletReady, true, true, BeFalse()),
-	Entry("SeedGardenletReady is false", gardencorev1beta1.SeedGardenletReady, false, true, BeFalse()),
-	Entry("SeedBackupBucketsReady is missing", gardencorev1beta1.SeedBackupBucketsReady, true, true, BeFalse()),
-	Entry("SeedBackupBucketsReady is missing but no backup specified", gardencorev1beta1.SeedBackupBucketsReady, true, false, BeTrue()),
-	Entry("SeedBackupBucketsReady is false", gardencorev1beta1.SeedBackupBucketsReady, false, true, BeFalse()),
-	Entry("SeedBackupBucketsReady is false but no backup specified", gardencorev1beta1.SeedBackupBucketsReady, false, false, BeTrue()),
-	Entry("SeedExtensionsReady is missing", gardencorev1beta1.SeedExtensionsReady, true, true, BeTrue()),
-	Entry("SeedExtensionsReady is false", gardencorev1beta1.SeedExtensionsReady, false, true, BeTrue()),
-)
@@ -0,0 +1,284 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+)
+
+// Framework runs the configured filter and score plugins in order to determine a seed for a shoot.
+type Framework struct {
+	filterPlugins []FilterPlugin
+	scorePlugins  []weightedScorePlugin
+}
+
+type weightedScorePlugin struct {
+	ScorePlugin
+	weight int64
+}
+
+// Rejection describes which filter plugin rejected a seed and why.
+type Rejection struct {
+	// Plugin is the name of the filter plugin which rejected the seed.
+	Plugin string
+	// Reason is the reason of the rejection.
+	Reason error
+}
+
+// Diagnosis maps the names of seeds which were rejected during a scheduling cycle to their rejection.
+type Diagnosis map[string]Rejection
+
+// SeedScore is a seed candidate together with the sum of its weighted scores.
+type SeedScore struct {
+	// Seed is the seed candidate.
+	Seed gardencorev1beta1.Seed
+	// Score is the sum of all weighted scores of the seed.
+	Score int64
+}
+
+// Result is the result of a scheduling cycle.
+type Result struct {
+	// Diagnosis contains the seeds which were rejected by a filter plugin.
+	Diagnosis Diagnosis
+	// Ranking contains the seed candidates which passed all filter plugins, ordered from the best to the worst.
+	Ranking []SeedScore
+}
+
+// SelectedSeed returns the best seed candidate, or nil if there is none.
+func (r *Result) SelectedSeed() *SeedScore {
+	if r == nil || len(r.Ranking) == 0 {
+		return nil
+	}
+	return &r.Ranking[0]
+}
+
+// NewFramework creates a new framework with the plugins of the given registry. The plugins which are configured in the
+// scheduler configuration are merged with the given default plugins.
+func NewFramework(registry Registry, defaultPlugins config.Plugins, cfg *config.ShootSchedulerConfiguration) (*Framework, error) {
+	var (
+		f         = &Framework{}
+		instances = map[string]Plugin{}
+		custom    = ptr.Deref(cfg.Plugins, config.Plugins{})
+	)
+
+	getOrCreate := func(name string) (Plugin, error) {
+		if plugin, ok := instances[name]; ok {
+			return plugin, nil
+		}
+
+		factory, ok := registry[name]
+		if !ok {
+			return nil, fmt.Errorf("plugin %q does not exist", name)
+		}
+
+		plugin, err := factory(cfg)
+		if err != nil {
+			return nil, fmt.Errorf("failed initializing plugin %q: %w", name, err)
+		}
+
+		instances[name] = plugin
+		return plugin, nil
+	}
+
+	for _, p := range mergePluginSets(defaultPlugins.Filter, custom.Filter) {
+		plugin, err := getOrCreate(p.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		filterPlugin, ok := plugin.(FilterPlugin)
+		if !ok {
+			return nil, fmt.Errorf("plugin %q does not implement the filter extension point", p.Name)
+		}
+		f.filterPlugins = append(f.filterPlugins, filterPlugin)
+	}
+
+	for _, p := range mergePluginSets(defaultPlugins.Score, custom.Score) {
+		plugin, err := getOrCreate(p.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		scorePlugin, ok := plugin.(ScorePlugin)
+		if !ok {
+			return nil, fmt.Errorf("plugin %q does not implement the score extension point", p.Name)
+		}
+		f.scorePlugins = append(f.scorePlugins, weightedScorePlugin{ScorePlugin: scorePlugin, weight: ptr.Deref(p.Weight, 1)})
+	}
+
+	return f, nil
+}
+
+// mergePluginSets returns the default plugins which are not disabled followed by the additionally enabled plugins.
+// Enabled plugins which are also default plugins keep the position of the default plugin.
+func mergePluginSets(defaults, custom config.PluginSet) []config.Plugin {
+	var (
+		enabled  []config.Plugin
+		indices  = map[string]int{}
+		disabled = sets.New[string]()
+	)
+
+	for _, plugin := range custom.Disabled {
+		disabled.Insert(plugin.Name)
+	}
+
+	if !disabled.Has("*") {
+		for _, plugin := range defaults.Enabled {
+			if disabled.Has(plugin.Name) {
+				continue
+			}
+			indices[plugin.Name] = len(enabled)
+			enabled = append(enabled, plugin)
+		}
+	}
+
+	for _, plugin := range custom.Enabled {
+		if i, ok := indices[plugin.Name]; ok {
+			enabled[i] = plugin
+			continue
+		}
+		enabled = append(enabled, plugin)
+	}
+
+	return enabled
+}
+
+// FilterPluginNames returns the names of the enabled filter plugins in the order they are called.
+func (f *Framework) FilterPluginNames() []string {
+	names := make([]string, 0, len(f.filterPlugins))
+	for _, plugin := range f.filterPlugins {
+		names = append(names, plugin.Name())
+	}
+	return names
+}
+
+// ScorePluginNames returns the names of the enabled score plugins.
+func (f *Framework) ScorePluginNames() []string {
+	names := make([]string, 0, len(f.scorePlugins))
+	for _, plugin := range f.scorePlugins {
+		names = append(names, plugin.Name())
+	}
+	return names
+}
+
+// HasScorePlugins returns true if at least one score plugin is enabled.
+func (f *Framework) HasScorePlugins() bool {
+	return len(f.scorePlugins) > 0
+}
+
+// RunFilterPlugins calls all filter plugins in order and returns the seeds which passed all of them. The returned
+// diagnosis contains the seeds which were rejected and the reasons. It returns an error as soon as no seed is left.
+func (f *Framework) RunFilterPlugins(ctx context.Context, log logr.Logger, state *CycleState, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, Diagnosis, error) {
+	diagnosis := Diagnosis{}
+
+	for _, plugin := range f.filterPlugins {
+		filtered, rejections, err := plugin.Filter(ctx, log, state, seeds)
+		for seedName, reason := range rejections {
+			diagnosis[seedName] = Rejection{Plugin: plugin.Name(), Reason: reason}
+		}
+		if err != nil {
+			return nil, diagnosis, err
+		}
+		if len(filtered) == 0 {
+			return nil, diagnosis, fmt.Errorf("filter plugin %q rejected all of the %d seeds", plugin.Name(), len(seeds))
+		}
+		seeds = filtered
+	}
+
+	return seeds, diagnosis, nil
+}
+
+// RunScorePlugins calls all score plugins and returns the sum of the weighted scores for each of the given seeds.
+func (f *Framework) RunScorePlugins(ctx context.Context, log logr.Logger, state *CycleState, seeds []gardencorev1beta1.Seed) ([]SeedScore, error) {
+	scores := make([]SeedScore, 0, len(seeds))
+	for _, seed := range seeds {
+		scores = append(scores, SeedScore{Seed: seed})
+	}
+
+	for _, plugin := range f.scorePlugins {
+		pluginScores, err := plugin.Score(ctx, log, state, seeds)
+		if err != nil {
+			return nil, fmt.Errorf("score plugin %q failed: %w", plugin.Name(), err)
+		}
+
+		for i := range scores {
+			score := pluginScores[scores[i].Seed.Name]
+			if score < MinScore || score > MaxScore {
+				return nil, fmt.Errorf("score plugin %q returned an invalid score %d for seed %q, it must be in the range of [%d, %d]", plugin.Name(), score, scores[i].Seed.Name, MinScore, MaxScore)
+			}
+			scores[i].Score += score * plugin.weight
+		}
+	}
+
+	return scores, nil
+}
+
+// Schedule runs all filter and score plugins and ranks the remaining seed candidates. If the scheduling fails, the
+// returned result still contains the diagnosis of the seeds which were rejected so far.
+func (f *Framework) Schedule(ctx context.Context, log logr.Logger, state *CycleState, seeds []gardencorev1beta1.Seed) (*Result, error) {
+	filteredSeeds, diagnosis, err := f.RunFilterPlugins(ctx, log, state, seeds)
+	result := &Result{Diagnosis: diagnosis}
+	if err != nil {
+		return result, err
+	}
+
+	scores, err := f.RunScorePlugins(ctx, log, state, filteredSeeds)
+	if err != nil {
+		return result, err
+	}
+
+	result.Ranking = RankSeeds(scores, state.ShootList)
+	return result, nil
+}
+
+// SelectSeed returns the candidate with the highest score. If multiple candidates have the same score, the one
+// managing the smallest number of shoots right now is chosen.
+func SelectSeed(scores []SeedScore, shootList []*gardencorev1beta1.Shoot) *SeedScore {
+	ranking := RankSeeds(scores, shootList)
+	if len(ranking) == 0 {
+		return nil
+	}
+	return &ranking[0]
+}
+
+// RankSeeds returns the candidates ordered by their score, starting with the highest one. Candidates with the same
+// score are ordered by the number of shoots they are managing right now, starting with the smallest one. Otherwise,
+// the given order is kept.
+func RankSeeds(scores []SeedScore, shootList []*gardencorev1beta1.Shoot) []SeedScore {
+	var (
+		ranking   = slices.Clone(scores)
+		seedUsage = v1beta1helper.CalculateSeedUsage(shootList)
+	)
+
+	slices.SortStableFunc(ranking, func(a, b SeedScore) int {
+		if a.Score != b.Score {
+			return cmp.Compare(b.Score, a.Score)
+		}
+		return cmp.Compare(seedUsage[a.Seed.Name], seedUsage[b.Seed.Name])
+	})
+
+	return ranking
+}
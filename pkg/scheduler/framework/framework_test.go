@@ -0,0 +1,290 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	. "github.com/gardener/gardener/pkg/scheduler/framework"
+)
+
+// fakePlugin rejects the configured seeds and returns the configured scores.
+type fakePlugin struct {
+	name   string
+	reject map[string]bool
+	scores map[string]int64
+}
+
+func (p *fakePlugin) Name() string { return p.name }
+
+func (p *fakePlugin) Filter(_ context.Context, _ logr.Logger, _ *CycleState, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, Rejections, error) {
+	var (
+		filtered   []gardencorev1beta1.Seed
+		rejections = Rejections{}
+	)
+
+	for _, seed := range seeds {
+		if p.reject[seed.Name] {
+			rejections[seed.Name] = fmt.Errorf("rejected by %s", p.name)
+			continue
+		}
+		filtered = append(filtered, seed)
+	}
+
+	return filtered, rejections, nil
+}
+
+func (p *fakePlugin) Score(_ context.Context, _ logr.Logger, _ *CycleState, _ []gardencorev1beta1.Seed) (map[string]int64, error) {
+	return p.scores, nil
+}
+
+// scoreOnlyPlugin only implements the score extension point.
+type scoreOnlyPlugin struct{}
+
+func (p *scoreOnlyPlugin) Name() string { return "ScoreOnly" }
+
+func (p *scoreOnlyPlugin) Score(_ context.Context, _ logr.Logger, _ *CycleState, _ []gardencorev1beta1.Seed) (map[string]int64, error) {
+	return nil, nil
+}
+
+var _ = Describe("Framework", func() {
+	var (
+		ctx = context.Background()
+		log = logr.Discard()
+
+		plugins  map[string]*fakePlugin
+		registry Registry
+		defaults config.Plugins
+		cfg      *config.ShootSchedulerConfiguration
+
+		seed1, seed2, seed3 gardencorev1beta1.Seed
+	)
+
+	BeforeEach(func() {
+		plugins = map[string]*fakePlugin{
+			"A": {name: "A"},
+			"B": {name: "B"},
+			"C": {name: "C"},
+		}
+
+		registry = Registry{}
+		for name, plugin := range plugins {
+			plugin := plugin
+			Expect(registry.Register(name, func(*config.ShootSchedulerConfiguration) (Plugin, error) { return plugin, nil })).To(Succeed())
+		}
+		Expect(registry.Register("ScoreOnly", func(*config.ShootSchedulerConfiguration) (Plugin, error) { return &scoreOnlyPlugin{}, nil })).To(Succeed())
+
+		defaults = config.Plugins{
+			Filter: config.PluginSet{Enabled: []config.Plugin{{Name: "A"}, {Name: "B"}}},
+			Score:  config.PluginSet{Enabled: []config.Plugin{{Name: "A"}}},
+		}
+		cfg = &config.ShootSchedulerConfiguration{}
+
+		seed1 = gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed-1"}}
+		seed2 = gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed-2"}}
+		seed3 = gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: "seed-3"}}
+	})
+
+	Describe("Registry", func() {
+		It("should fail to register a plugin twice", func() {
+			Expect(registry.Register("A", nil)).To(MatchError(`a plugin named "A" already exists`))
+		})
+
+		It("should fail to merge registries with the same plugins", func() {
+			Expect(registry.Merge(Registry{"D": nil})).To(Succeed())
+			Expect(registry.Merge(Registry{"B": nil})).To(MatchError(`a plugin named "B" already exists`))
+		})
+	})
+
+	Describe("#NewFramework", func() {
+		It("should enable the default plugins", func() {
+			f, err := NewFramework(registry, defaults, cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.FilterPluginNames()).To(Equal([]string{"A", "B"}))
+			Expect(f.ScorePluginNames()).To(Equal([]string{"A"}))
+			Expect(f.HasScorePlugins()).To(BeTrue())
+		})
+
+		It("should merge the configured plugins with the default plugins", func() {
+			cfg.Plugins = &config.Plugins{
+				Filter: config.PluginSet{
+					Enabled:  []config.Plugin{{Name: "C"}},
+					Disabled: []config.Plugin{{Name: "A"}},
+				},
+				Score: config.PluginSet{
+					Disabled: []config.Plugin{{Name: "A"}},
+				},
+			}
+
+			f, err := NewFramework(registry, defaults, cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.FilterPluginNames()).To(Equal([]string{"B", "C"}))
+			Expect(f.ScorePluginNames()).To(BeEmpty())
+			Expect(f.HasScorePlugins()).To(BeFalse())
+		})
+
+		It("should allow reordering the default plugins", func() {
+			cfg.Plugins = &config.Plugins{
+				Filter: config.PluginSet{
+					Enabled:  []config.Plugin{{Name: "C"}, {Name: "B"}, {Name: "A"}},
+					Disabled: []config.Plugin{{Name: "*"}},
+				},
+			}
+
+			f, err := NewFramework(registry, defaults, cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.FilterPluginNames()).To(Equal([]string{"C", "B", "A"}))
+		})
+
+		It("should fail for unknown plugins", func() {
+			cfg.Plugins = &config.Plugins{Filter: config.PluginSet{Enabled: []config.Plugin{{Name: "D"}}}}
+
+			_, err := NewFramework(registry, defaults, cfg)
+			Expect(err).To(MatchError(`plugin "D" does not exist`))
+		})
+
+		It("should fail for plugins which do not implement the extension point", func() {
+			cfg.Plugins = &config.Plugins{Filter: config.PluginSet{Enabled: []config.Plugin{{Name: "ScoreOnly"}}}}
+
+			_, err := NewFramework(registry, defaults, cfg)
+			Expect(err).To(MatchError(`plugin "ScoreOnly" does not implement the filter extension point`))
+		})
+
+		It("should fail if a plugin cannot be initialized", func() {
+			Expect(registry.Register("Broken", func(*config.ShootSchedulerConfiguration) (Plugin, error) { return nil, fmt.Errorf("broken") })).To(Succeed())
+			cfg.Plugins = &config.Plugins{Score: config.PluginSet{Enabled: []config.Plugin{{Name: "Broken"}}}}
+
+			_, err := NewFramework(registry, defaults, cfg)
+			Expect(err).To(MatchError(`failed initializing plugin "Broken": broken`))
+		})
+	})
+
+	Describe("#RunFilterPlugins", func() {
+		It("should return the seeds which passed all filter plugins and the diagnosis", func() {
+			plugins["A"].reject = map[string]bool{"seed-1": true}
+			plugins["B"].reject = map[string]bool{"seed-3": true}
+
+			f, err := NewFramework(registry, defaults, cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			seeds, diagnosis, err := f.RunFilterPlugins(ctx, log, &CycleState{}, []gardencorev1beta1.Seed{seed1, seed2, seed3})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(seeds).To(Equal([]gardencorev1beta1.Seed{seed2}))
+			Expect(diagnosis).To(Equal(Diagnosis{
+				"seed-1": {Plugin: "A", Reason: fmt.Errorf("rejected by A")},
+				"seed-3": {Plugin: "B", Reason: fmt.Errorf("rejected by B")},
+			}))
+		})
+
+		It("should fail if a filter plugin rejects all seeds", func() {
+			plugins["B"].reject = map[string]bool{"seed-1": true, "seed-2": true}
+
+			f, err := NewFramework(registry, defaults, cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, diagnosis, err := f.RunFilterPlugins(ctx, log, &CycleState{}, []gardencorev1beta1.Seed{seed1, seed2})
+			Expect(err).To(MatchError(`filter plugin "B" rejected all of the 2 seeds`))
+			Expect(diagnosis).To(HaveLen(2))
+		})
+	})
+
+	Describe("#RunScorePlugins", func() {
+		It("should sum up the weighted scores", func() {
+			plugins["A"].scores = map[string]int64{"seed-1": 10, "seed-2": 20}
+			plugins["C"].scores = map[string]int64{"seed-1": 50, "seed-2": 0}
+			cfg.Plugins = &config.Plugins{Score: config.PluginSet{Enabled: []config.Plugin{{Name: "C", Weight: ptr.To[int64](2)}}}}
+
+			f, err := NewFramework(registry, defaults, cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			scores, err := f.RunScorePlugins(ctx, log, &CycleState{}, []gardencorev1beta1.Seed{seed1, seed2})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scores).To(Equal([]SeedScore{{Seed: seed1, Score: 110}, {Seed: seed2, Score: 20}}))
+		})
+
+		It("should fail if a score plugin returns an invalid score", func() {
+			plugins["A"].scores = map[string]int64{"seed-1": 101}
+
+			f, err := NewFramework(registry, defaults, cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = f.RunScorePlugins(ctx, log, &CycleState{}, []gardencorev1beta1.Seed{seed1})
+			Expect(err).To(MatchError(`score plugin "A" returned an invalid score 101 for seed "seed-1", it must be in the range of [0, 100]`))
+		})
+	})
+
+	Describe("#Schedule", func() {
+		It("should return the ranking of the candidates and the diagnosis", func() {
+			plugins["A"].reject = map[string]bool{"seed-1": true}
+			plugins["A"].scores = map[string]int64{"seed-2": 10, "seed-3": 20}
+
+			f, err := NewFramework(registry, defaults, cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := f.Schedule(ctx, log, &CycleState{}, []gardencorev1beta1.Seed{seed1, seed2, seed3})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Ranking).To(Equal([]SeedScore{{Seed: seed3, Score: 20}, {Seed: seed2, Score: 10}}))
+			Expect(result.Diagnosis).To(HaveKey("seed-1"))
+			Expect(result.SelectedSeed()).To(Equal(&SeedScore{Seed: seed3, Score: 20}))
+		})
+
+		It("should return the diagnosis if the scheduling fails", func() {
+			plugins["B"].reject = map[string]bool{"seed-1": true}
+
+			f, err := NewFramework(registry, defaults, cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := f.Schedule(ctx, log, &CycleState{}, []gardencorev1beta1.Seed{seed1})
+			Expect(err).To(HaveOccurred())
+			Expect(result.Diagnosis).To(Equal(Diagnosis{"seed-1": {Plugin: "B", Reason: fmt.Errorf("rejected by B")}}))
+			Expect(result.SelectedSeed()).To(BeNil())
+		})
+	})
+
+	Describe("#RankSeeds", func() {
+		It("should order the seeds by score and usage", func() {
+			shootList := []*gardencorev1beta1.Shoot{{Spec: gardencorev1beta1.ShootSpec{SeedName: ptr.To("seed-1")}}}
+
+			Expect(RankSeeds([]SeedScore{{Seed: seed1, Score: 50}, {Seed: seed2, Score: 50}, {Seed: seed3, Score: 60}}, shootList)).To(Equal(
+				[]SeedScore{{Seed: seed3, Score: 60}, {Seed: seed2, Score: 50}, {Seed: seed1, Score: 50}},
+			))
+		})
+	})
+
+	Describe("#SelectSeed", func() {
+		It("should return nil if there are no candidates", func() {
+			Expect(SelectSeed(nil, nil)).To(BeNil())
+		})
+
+		It("should return the seed with the highest score", func() {
+			Expect(SelectSeed([]SeedScore{{Seed: seed1, Score: 40}, {Seed: seed2, Score: 60}, {Seed: seed3, Score: 50}}, nil)).To(Equal(&SeedScore{Seed: seed2, Score: 60}))
+		})
+
+		It("should return the seed with the least shoots deployed if scores are equal", func() {
+			shootList := []*gardencorev1beta1.Shoot{{Spec: gardencorev1beta1.ShootSpec{SeedName: ptr.To("seed-1")}}}
+
+			Expect(SelectSeed([]SeedScore{{Seed: seed1, Score: 50}, {Seed: seed2, Score: 50}}, shootList)).To(Equal(&SeedScore{Seed: seed2, Score: 50}))
+		})
+	})
+})
@@ -0,0 +1,67 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+)
+
+const (
+	// MinScore is the minimum score a score plugin is expected to return.
+	MinScore int64 = 0
+	// MaxScore is the maximum score a score plugin is expected to return.
+	MaxScore int64 = 100
+)
+
+// CycleState holds the data of a scheduling cycle which is shared between all plugins.
+type CycleState struct {
+	// Shoot is the shoot which is scheduled.
+	Shoot *gardencorev1beta1.Shoot
+	// ShootList contains all shoots known to the scheduler.
+	ShootList []*gardencorev1beta1.Shoot
+	// CloudProfile is the cloud profile referenced by the shoot.
+	CloudProfile *gardencorev1beta1.CloudProfile
+	// RegionConfig is the region config map of the scheduler for the cloud profile, if any.
+	RegionConfig *corev1.ConfigMap
+}
+
+// Plugin is the parent type of all scheduling framework plugins.
+type Plugin interface {
+	// Name returns the name of the plugin.
+	Name() string
+}
+
+// Rejections maps the names of seeds which were rejected by a filter plugin to the reason of their rejection.
+type Rejections map[string]error
+
+// FilterPlugin is a plugin which filters out seeds that are not suitable for hosting the shoot's control plane.
+type FilterPlugin interface {
+	Plugin
+	// Filter returns the given seeds which are suitable for the shoot together with the reasons why the other seeds
+	// were rejected. It returns an error if none of the seeds is suitable.
+	Filter(ctx context.Context, log logr.Logger, state *CycleState, seeds []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, Rejections, error)
+}
+
+// ScorePlugin is a plugin which ranks the seed candidates which passed all filter plugins.
+type ScorePlugin interface {
+	Plugin
+	// Score returns a score between MinScore and MaxScore for each of the given seeds, keyed by the seed names.
+	Score(ctx context.Context, log logr.Logger, state *CycleState, seeds []gardencorev1beta1.Seed) (map[string]int64, error)
+}
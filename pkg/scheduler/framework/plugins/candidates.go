@@ -0,0 +1,157 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugins
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
+	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
+)
+
+type networkDisjointedness struct{}
+
+func newNetworkDisjointedness(_ *config.ShootSchedulerConfiguration) (framework.Plugin, error) {
+	return &networkDisjointedness{}, nil
+}
+
+func (p *networkDisjointedness) Name() string {
+	return NetworkDisjointedness
+}
+
+func (p *networkDisjointedness) Filter(_ context.Context, _ logr.Logger, state *framework.CycleState, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, framework.Rejections, error) {
+	if state.Shoot.Spec.Networking == nil {
+		return seedList, nil, nil
+	}
+
+	return filterCandidates(seedList, func(seed *gardencorev1beta1.Seed) error {
+		if disjointed, err := networksAreDisjointed(seed, state.Shoot); !disjointed {
+			return err
+		}
+		return nil
+	})
+}
+
+type taintToleration struct{}
+
+func newTaintToleration(_ *config.ShootSchedulerConfiguration) (framework.Plugin, error) {
+	return &taintToleration{}, nil
+}
+
+func (p *taintToleration) Name() string {
+	return TaintToleration
+}
+
+func (p *taintToleration) Filter(_ context.Context, _ logr.Logger, state *framework.CycleState, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, framework.Rejections, error) {
+	return filterCandidates(seedList, func(seed *gardencorev1beta1.Seed) error {
+		if !v1beta1helper.TaintsAreTolerated(seed.Spec.Taints, state.Shoot.Spec.Tolerations) {
+			return fmt.Errorf("shoot does not tolerate the seed's taints")
+		}
+		return nil
+	})
+}
+
+type seedCapacity struct{}
+
+func newSeedCapacity(_ *config.ShootSchedulerConfiguration) (framework.Plugin, error) {
+	return &seedCapacity{}, nil
+}
+
+func (p *seedCapacity) Name() string {
+	return SeedCapacity
+}
+
+func (p *seedCapacity) Filter(_ context.Context, _ logr.Logger, state *framework.CycleState, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, framework.Rejections, error) {
+	seedUsage := v1beta1helper.CalculateSeedUsage(state.ShootList)
+
+	return filterCandidates(seedList, func(seed *gardencorev1beta1.Seed) error {
+		if allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok && int64(seedUsage[seed.Name]) >= allocatableShoots.Value() {
+			return fmt.Errorf("seed does not have available capacity for shoots")
+		}
+		return nil
+	})
+}
+
+// filterCandidates returns the seeds for which the given check does not return an error.
+func filterCandidates(seedList []gardencorev1beta1.Seed, check func(*gardencorev1beta1.Seed) error) ([]gardencorev1beta1.Seed, framework.Rejections, error) {
+	var (
+		candidates      []gardencorev1beta1.Seed
+		candidateErrors = framework.Rejections{}
+	)
+
+	for _, seed := range seedList {
+		if err := check(&seed); err != nil {
+			candidateErrors[seed.Name] = err
+			continue
+		}
+		candidates = append(candidates, seed)
+	}
+
+	if candidates == nil {
+		return nil, candidateErrors, fmt.Errorf("0/%d seed cluster candidate(s) are eligible for scheduling: %v", len(seedList), errorMapToString(candidateErrors))
+	}
+	return candidates, candidateErrors, nil
+}
+
+func networksAreDisjointed(seed *gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot) (bool, error) {
+	var (
+		shootPodsNetwork     = shoot.Spec.Networking.Pods
+		shootServicesNetwork = shoot.Spec.Networking.Services
+
+		errorMessages []string
+		workerless    = v1beta1helper.IsWorkerless(shoot)
+	)
+
+	if seed.Spec.Networks.ShootDefaults != nil {
+		if shootPodsNetwork == nil && !workerless {
+			shootPodsNetwork = seed.Spec.Networks.ShootDefaults.Pods
+		}
+		if shootServicesNetwork == nil {
+			shootServicesNetwork = seed.Spec.Networks.ShootDefaults.Services
+		}
+	}
+
+	for _, e := range cidrvalidation.ValidateNetworkDisjointedness(
+		field.NewPath(""),
+		shoot.Spec.Networking.Nodes,
+		shootPodsNetwork,
+		shootServicesNetwork,
+		seed.Spec.Networks.Nodes,
+		seed.Spec.Networks.Pods,
+		seed.Spec.Networks.Services,
+		workerless,
+	) {
+		errorMessages = append(errorMessages, e.ErrorBody())
+	}
+
+	return len(errorMessages) == 0, fmt.Errorf("invalid networks: %s", errorMessages)
+}
+
+func errorMapToString(errs map[string]error) string {
+	res := "{"
+	for k, v := range errs {
+		res += fmt.Sprintf("%s => %s, ", k, v.Error())
+	}
+	res = strings.TrimSuffix(res, ", ") + "}"
+	return res
+}
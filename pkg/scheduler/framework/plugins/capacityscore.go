@@ -0,0 +1,121 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
+)
+
+// standardControlPlaneWeight is the weight of a standard shoot control plane, i.e., one which consumes exactly one unit
+// of the seed's allocatable shoots.
+const standardControlPlaneWeight int64 = 100
+
+// capacityScore scores seed candidates based on their allocatable capacity and the estimated control plane footprint
+// of the shoots already scheduled onto them.
+type capacityScore struct {
+	config *config.CapacityScoringConfiguration
+}
+
+func newCapacityScore(cfg *config.ShootSchedulerConfiguration) (framework.Plugin, error) {
+	if cfg.CapacityScoring == nil {
+		return nil, fmt.Errorf("capacity scoring is not configured")
+	}
+	return &capacityScore{config: cfg.CapacityScoring}, nil
+}
+
+func (p *capacityScore) Name() string {
+	return CapacityScore
+}
+
+// controlPlaneWeight estimates the footprint of the given shoot's control plane based on its purpose, its high
+// availability configuration and its number of worker nodes.
+func (p *capacityScore) controlPlaneWeight(shoot *gardencorev1beta1.Shoot) int64 {
+	purpose := ptr.Deref(shoot.Spec.Purpose, gardencorev1beta1.ShootPurposeEvaluation)
+
+	weight, ok := p.config.PurposeWeights[string(purpose)]
+	if !ok {
+		weight = standardControlPlaneWeight
+	}
+
+	if failureToleranceType := v1beta1helper.GetFailureToleranceType(shoot); failureToleranceType != nil {
+		weight += p.config.HighAvailabilityWeights[string(*failureToleranceType)]
+	}
+
+	if workerNodeWeight := ptr.Deref(p.config.WorkerNodeWeight, 0); workerNodeWeight > 0 {
+		for _, worker := range shoot.Spec.Provider.Workers {
+			weight += int64(worker.Maximum) * workerNodeWeight
+		}
+	}
+
+	return weight
+}
+
+// seedWeights returns a map representing the sum of the control plane weights per seed. Like
+// v1beta1helper.CalculateSeedUsage, it takes both spec.seedName and status.seedName into account.
+func (p *capacityScore) seedWeights(shootList []*gardencorev1beta1.Shoot) map[string]int64 {
+	m := map[string]int64{}
+
+	for _, shoot := range shootList {
+		var (
+			specSeed   = ptr.Deref(shoot.Spec.SeedName, "")
+			statusSeed = ptr.Deref(shoot.Status.SeedName, "")
+			weight     = p.controlPlaneWeight(shoot)
+		)
+
+		if specSeed != "" {
+			m[specSeed] += weight
+		}
+		if statusSeed != "" && specSeed != statusSeed {
+			m[statusSeed] += weight
+		}
+	}
+
+	return m
+}
+
+// Score computes a score for each of the given seeds. The score reflects the share of the seed's capacity which would
+// still be free if the shoot was scheduled onto it.
+func (p *capacityScore) Score(_ context.Context, _ logr.Logger, state *framework.CycleState, seedList []gardencorev1beta1.Seed) (map[string]int64, error) {
+	var (
+		scores      = make(map[string]int64, len(seedList))
+		seedWeights = p.seedWeights(state.ShootList)
+		shootWeight = p.controlPlaneWeight(state.Shoot)
+	)
+
+	for _, seed := range seedList {
+		capacity := ptr.Deref(p.config.DefaultSeedCapacity, 0)
+		if allocatableShoots, ok := seed.Status.Allocatable[gardencorev1beta1.ResourceShoots]; ok {
+			capacity = allocatableShoots.Value()
+		}
+
+		var score int64
+		if capacityWeight := capacity * standardControlPlaneWeight; capacityWeight > 0 {
+			score = (capacityWeight - seedWeights[seed.Name] - shootWeight) * framework.MaxScore / capacityWeight
+		}
+
+		scores[seed.Name] = min(max(score, framework.MinScore), framework.MaxScore)
+	}
+
+	return scores, nil
+}
@@ -0,0 +1,159 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugins
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
+)
+
+var _ = Describe("CapacityScore", func() {
+	var (
+		ctx = context.Background()
+		log = logr.Discard()
+
+		plugin *capacityScore
+		shoot  *gardencorev1beta1.Shoot
+	)
+
+	BeforeEach(func() {
+		plugin = &capacityScore{config: &config.CapacityScoringConfiguration{
+			PurposeWeights: map[string]int64{
+				"evaluation": 100,
+				"production": 200,
+			},
+			HighAvailabilityWeights: map[string]int64{
+				"node": 50,
+				"zone": 100,
+			},
+			WorkerNodeWeight:    ptr.To[int64](1),
+			DefaultSeedCapacity: ptr.To[int64](10),
+		}}
+
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-foo"},
+		}
+	})
+
+	newSeed := func(name string, allocatableShoots *string) gardencorev1beta1.Seed {
+		seed := gardencorev1beta1.Seed{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		if allocatableShoots != nil {
+			seed.Status.Allocatable = corev1.ResourceList{gardencorev1beta1.ResourceShoots: resource.MustParse(*allocatableShoots)}
+		}
+		return seed
+	}
+
+	newScheduledShoot := func(name, seedName string, purpose gardencorev1beta1.ShootPurpose) *gardencorev1beta1.Shoot {
+		return &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "garden-foo"},
+			Spec: gardencorev1beta1.ShootSpec{
+				Purpose:  &purpose,
+				SeedName: &seedName,
+			},
+		}
+	}
+
+	Describe("#controlPlaneWeight", func() {
+		It("should weight shoots without purpose like evaluation shoots", func() {
+			Expect(plugin.controlPlaneWeight(shoot)).To(Equal(int64(100)))
+		})
+
+		It("should use the standard weight for purposes which are not configured", func() {
+			shoot.Spec.Purpose = ptr.To(gardencorev1beta1.ShootPurposeInfrastructure)
+
+			Expect(plugin.controlPlaneWeight(shoot)).To(Equal(int64(100)))
+		})
+
+		It("should add the weights for high availability and worker nodes", func() {
+			shoot.Spec.Purpose = ptr.To(gardencorev1beta1.ShootPurposeProduction)
+			shoot.Spec.ControlPlane = &gardencorev1beta1.ControlPlane{HighAvailability: &gardencorev1beta1.HighAvailability{FailureTolerance: gardencorev1beta1.FailureTolerance{Type: gardencorev1beta1.FailureToleranceTypeZone}}}
+			shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{{Maximum: 20}, {Maximum: 5}}
+
+			Expect(plugin.controlPlaneWeight(shoot)).To(Equal(int64(325)))
+		})
+
+		It("should not add weights for worker nodes if the worker node weight is zero", func() {
+			plugin.config.WorkerNodeWeight = ptr.To[int64](0)
+			shoot.Spec.Provider.Workers = []gardencorev1beta1.Worker{{Maximum: 20}}
+
+			Expect(plugin.controlPlaneWeight(shoot)).To(Equal(int64(100)))
+		})
+	})
+
+	Describe("#Score", func() {
+		It("should score seeds based on their remaining capacity", func() {
+			seed1 := newSeed("seed-1", ptr.To("10"))
+			seed2 := newSeed("seed-2", ptr.To("4"))
+			seed3 := newSeed("seed-3", nil)
+
+			shootList := []*gardencorev1beta1.Shoot{
+				newScheduledShoot("shoot-1", "seed-1", gardencorev1beta1.ShootPurposeProduction),
+				newScheduledShoot("shoot-2", "seed-1", gardencorev1beta1.ShootPurposeProduction),
+				newScheduledShoot("shoot-3", "seed-2", gardencorev1beta1.ShootPurposeEvaluation),
+				newScheduledShoot("shoot-4", "seed-3", gardencorev1beta1.ShootPurposeEvaluation),
+			}
+
+			Expect(plugin.Score(ctx, log, &framework.CycleState{Shoot: shoot, ShootList: shootList}, []gardencorev1beta1.Seed{seed1, seed2, seed3})).To(Equal(map[string]int64{
+				seed1.Name: 50,
+				seed2.Name: 50,
+				seed3.Name: 80,
+			}))
+		})
+
+		It("should take both spec.seedName and status.seedName into account", func() {
+			seed1 := newSeed("seed-1", ptr.To("4"))
+			seed2 := newSeed("seed-2", ptr.To("4"))
+
+			migratingShoot := newScheduledShoot("shoot-1", "seed-1", gardencorev1beta1.ShootPurposeEvaluation)
+			migratingShoot.Status.SeedName = ptr.To("seed-2")
+
+			Expect(plugin.Score(ctx, log, &framework.CycleState{Shoot: shoot, ShootList: []*gardencorev1beta1.Shoot{migratingShoot}}, []gardencorev1beta1.Seed{seed1, seed2})).To(Equal(map[string]int64{
+				seed1.Name: 50,
+				seed2.Name: 50,
+			}))
+		})
+
+		It("should not return negative scores for overloaded seeds", func() {
+			seed := newSeed("seed-1", ptr.To("1"))
+
+			shootList := []*gardencorev1beta1.Shoot{
+				newScheduledShoot("shoot-1", "seed-1", gardencorev1beta1.ShootPurposeProduction),
+			}
+
+			Expect(plugin.Score(ctx, log, &framework.CycleState{Shoot: shoot, ShootList: shootList}, []gardencorev1beta1.Seed{seed})).To(Equal(map[string]int64{
+				seed.Name: 0,
+			}))
+		})
+
+		It("should score seeds without allocatable capacity with zero", func() {
+			seed := newSeed("seed-1", ptr.To("0"))
+
+			Expect(plugin.Score(ctx, log, &framework.CycleState{Shoot: shoot}, []gardencorev1beta1.Seed{seed})).To(Equal(map[string]int64{
+				seed.Name: 0,
+			}))
+		})
+	})
+})
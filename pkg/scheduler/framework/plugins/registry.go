@@ -0,0 +1,102 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugins
+
+import (
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
+)
+
+const (
+	// SeedUsable is the name of the filter plugin which rejects seeds that are being deleted, invisible or not ready.
+	SeedUsable = "SeedUsable"
+	// CloudProfileSeedSelector is the name of the filter plugin which rejects seeds that do not match the seed selector
+	// of the shoot's cloud profile.
+	CloudProfileSeedSelector = "CloudProfileSeedSelector"
+	// ShootSeedSelector is the name of the filter plugin which rejects seeds that do not match the seed selector of the
+	// shoot.
+	ShootSeedSelector = "ShootSeedSelector"
+	// SeedProvider is the name of the filter plugin which rejects seeds whose provider type is not allowed for the
+	// shoot.
+	SeedProvider = "SeedProvider"
+	// ZonalControlPlane is the name of the filter plugin which rejects seeds with less than three zones for shoots with
+	// failure tolerance type 'zone'.
+	ZonalControlPlane = "ZonalControlPlane"
+	// NetworkDisjointedness is the name of the filter plugin which rejects seeds whose networks overlap with the
+	// shoot's networks.
+	NetworkDisjointedness = "NetworkDisjointedness"
+	// TaintToleration is the name of the filter plugin which rejects seeds whose taints are not tolerated by the shoot.
+	TaintToleration = "TaintToleration"
+	// SeedCapacity is the name of the filter plugin which rejects seeds without available capacity for shoots.
+	SeedCapacity = "SeedCapacity"
+	// CandidateDeterminationStrategy is the name of the filter plugin which applies the configured candidate
+	// determination strategy.
+	CandidateDeterminationStrategy = "CandidateDeterminationStrategy"
+	// CapacityScore is the name of the score plugin which ranks seeds based on their allocatable capacity and the
+	// estimated control plane footprint of the shoots already scheduled onto them.
+	CapacityScore = "CapacityScore"
+)
+
+// NewInTreeRegistry returns a registry containing all in-tree plugins.
+func NewInTreeRegistry() framework.Registry {
+	return framework.Registry{
+		SeedUsable:                     newSeedUsable,
+		CloudProfileSeedSelector:       newCloudProfileSeedSelector,
+		ShootSeedSelector:              newShootSeedSelector,
+		SeedProvider:                   newSeedProvider,
+		ZonalControlPlane:              newZonalControlPlane,
+		NetworkDisjointedness:          newNetworkDisjointedness,
+		TaintToleration:                newTaintToleration,
+		SeedCapacity:                   newSeedCapacity,
+		CandidateDeterminationStrategy: newCandidateDeterminationStrategy,
+		CapacityScore:                  newCapacityScore,
+	}
+}
+
+// DefaultPlugins returns the plugins which are enabled by default for the given configuration. The 'CapacityScore'
+// plugin is only enabled by default if capacity scoring is configured.
+func DefaultPlugins(cfg *config.ShootSchedulerConfiguration) config.Plugins {
+	plugins := config.Plugins{
+		Filter: config.PluginSet{
+			Enabled: []config.Plugin{
+				{Name: SeedUsable},
+				{Name: CloudProfileSeedSelector},
+				{Name: ShootSeedSelector},
+				{Name: SeedProvider},
+				{Name: ZonalControlPlane},
+				{Name: NetworkDisjointedness},
+				{Name: TaintToleration},
+				{Name: SeedCapacity},
+				{Name: CandidateDeterminationStrategy},
+			},
+		},
+	}
+
+	if cfg.CapacityScoring != nil {
+		plugins.Score.Enabled = append(plugins.Score.Enabled, config.Plugin{Name: CapacityScore})
+	}
+
+	return plugins
+}
+
+// NewFramework returns a new scheduling framework with all in-tree plugins and the given out-of-tree plugins.
+func NewFramework(cfg *config.ShootSchedulerConfiguration, outOfTreeRegistry framework.Registry) (*framework.Framework, error) {
+	registry := NewInTreeRegistry()
+	if err := registry.Merge(outOfTreeRegistry); err != nil {
+		return nil, err
+	}
+
+	return framework.NewFramework(registry, DefaultPlugins(cfg), cfg)
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugins_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
+	. "github.com/gardener/gardener/pkg/scheduler/framework/plugins"
+)
+
+var _ = Describe("Registry", func() {
+	var cfg *config.ShootSchedulerConfiguration
+
+	BeforeEach(func() {
+		cfg = &config.ShootSchedulerConfiguration{Strategy: config.SameRegion}
+	})
+
+	Describe("#NewFramework", func() {
+		It("should enable the default filter plugins in order and no score plugins", func() {
+			f, err := NewFramework(cfg, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.FilterPluginNames()).To(Equal([]string{
+				SeedUsable,
+				CloudProfileSeedSelector,
+				ShootSeedSelector,
+				SeedProvider,
+				ZonalControlPlane,
+				NetworkDisjointedness,
+				TaintToleration,
+				SeedCapacity,
+				CandidateDeterminationStrategy,
+			}))
+			Expect(f.HasScorePlugins()).To(BeFalse())
+		})
+
+		It("should enable the capacity score plugin if capacity scoring is configured", func() {
+			cfg.CapacityScoring = &config.CapacityScoringConfiguration{}
+
+			f, err := NewFramework(cfg, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(f.ScorePluginNames()).To(Equal([]string{CapacityScore}))
+		})
+
+		It("should fail to enable the capacity score plugin if capacity scoring is not configured", func() {
+			cfg.Plugins = &config.Plugins{Score: config.PluginSet{Enabled: []config.Plugin{{Name: CapacityScore}}}}
+
+			_, err := NewFramework(cfg, nil)
+			Expect(err).To(MatchError(ContainSubstring("capacity scoring is not configured")))
+		})
+
+		It("should fail if an out-of-tree plugin has the same name as an in-tree plugin", func() {
+			_, err := NewFramework(cfg, framework.Registry{SeedUsable: nil})
+			Expect(err).To(MatchError(ContainSubstring("already exists")))
+		})
+	})
+})
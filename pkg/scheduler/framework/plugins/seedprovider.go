@@ -0,0 +1,73 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
+)
+
+type seedProvider struct{}
+
+func newSeedProvider(_ *config.ShootSchedulerConfiguration) (framework.Plugin, error) {
+	return &seedProvider{}, nil
+}
+
+func (p *seedProvider) Name() string {
+	return SeedProvider
+}
+
+func (p *seedProvider) Filter(_ context.Context, _ logr.Logger, state *framework.CycleState, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, framework.Rejections, error) {
+	var possibleProviders []string
+	if state.CloudProfile.Spec.SeedSelector != nil {
+		possibleProviders = state.CloudProfile.Spec.SeedSelector.ProviderTypes
+	}
+
+	var (
+		matchingSeeds []gardencorev1beta1.Seed
+		rejections    = framework.Rejections{}
+	)
+
+	for _, seed := range seedList {
+		if !matchProvider(seed.Spec.Provider.Type, state.Shoot.Spec.Provider.Type, possibleProviders) {
+			rejections[seed.Name] = fmt.Errorf("seed provider type %q does not match %q", seed.Spec.Provider.Type, state.Shoot.Spec.Provider.Type)
+			continue
+		}
+		matchingSeeds = append(matchingSeeds, seed)
+	}
+
+	if len(matchingSeeds) == 0 {
+		return nil, rejections, fmt.Errorf("none out of the %d seeds has a matching provider for %q", len(seedList), state.Shoot.Spec.Provider.Type)
+	}
+	return matchingSeeds, rejections, nil
+}
+
+func matchProvider(seedProviderType, shootProviderType string, enabledProviderTypes []string) bool {
+	if len(enabledProviderTypes) == 0 {
+		return seedProviderType == shootProviderType
+	}
+	for _, p := range enabledProviderTypes {
+		if p == "*" || p == seedProviderType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,90 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
+)
+
+type seedSelector struct {
+	name         string
+	kind         string
+	seedSelector func(state *framework.CycleState) *gardencorev1beta1.SeedSelector
+}
+
+func newCloudProfileSeedSelector(_ *config.ShootSchedulerConfiguration) (framework.Plugin, error) {
+	return &seedSelector{
+		name: CloudProfileSeedSelector,
+		kind: "CloudProfile",
+		seedSelector: func(state *framework.CycleState) *gardencorev1beta1.SeedSelector {
+			return state.CloudProfile.Spec.SeedSelector
+		},
+	}, nil
+}
+
+func newShootSeedSelector(_ *config.ShootSchedulerConfiguration) (framework.Plugin, error) {
+	return &seedSelector{
+		name: ShootSeedSelector,
+		kind: "Shoot",
+		seedSelector: func(state *framework.CycleState) *gardencorev1beta1.SeedSelector {
+			return state.Shoot.Spec.SeedSelector
+		},
+	}, nil
+}
+
+func (p *seedSelector) Name() string {
+	return p.name
+}
+
+func (p *seedSelector) Filter(_ context.Context, _ logr.Logger, state *framework.CycleState, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, framework.Rejections, error) {
+	return filterSeedsMatchingLabelSelector(seedList, p.seedSelector(state), p.kind)
+}
+
+func filterSeedsMatchingLabelSelector(seedList []gardencorev1beta1.Seed, seedSelector *gardencorev1beta1.SeedSelector, kind string) ([]gardencorev1beta1.Seed, framework.Rejections, error) {
+	if seedSelector == nil {
+		return seedList, nil, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(&seedSelector.LabelSelector)
+	if err != nil {
+		return nil, nil, fmt.Errorf("label selector conversion failed: %v for seedSelector: %w", seedSelector.LabelSelector, err)
+	}
+
+	var (
+		matchingSeeds []gardencorev1beta1.Seed
+		rejections    = framework.Rejections{}
+	)
+
+	for _, seed := range seedList {
+		if !selector.Matches(labels.Set(seed.Labels)) {
+			rejections[seed.Name] = fmt.Errorf("seed labels do not match seed selector of '%s' (selector: '%s')", kind, selector.String())
+			continue
+		}
+		matchingSeeds = append(matchingSeeds, seed)
+	}
+
+	if len(matchingSeeds) == 0 {
+		return nil, rejections, fmt.Errorf("none out of the %d seeds has the matching labels required by seed selector of '%s' (selector: '%s')", len(seedList), kind, selector.String())
+	}
+	return matchingSeeds, rejections, nil
+}
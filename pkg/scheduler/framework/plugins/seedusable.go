@@ -0,0 +1,87 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
+)
+
+type seedUsable struct{}
+
+func newSeedUsable(_ *config.ShootSchedulerConfiguration) (framework.Plugin, error) {
+	return &seedUsable{}, nil
+}
+
+func (p *seedUsable) Name() string {
+	return SeedUsable
+}
+
+func (p *seedUsable) Filter(_ context.Context, _ logr.Logger, _ *framework.CycleState, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, framework.Rejections, error) {
+	var (
+		matchingSeeds []gardencorev1beta1.Seed
+		rejections    = framework.Rejections{}
+	)
+
+	for _, seed := range seedList {
+		if err := isUsableSeed(&seed); err != nil {
+			rejections[seed.Name] = err
+			continue
+		}
+		matchingSeeds = append(matchingSeeds, seed)
+	}
+
+	if len(matchingSeeds) == 0 {
+		return nil, rejections, fmt.Errorf("none of the %d seeds is valid for scheduling (not deleting, visible and ready)", len(seedList))
+	}
+	return matchingSeeds, rejections, nil
+}
+
+func isUsableSeed(seed *gardencorev1beta1.Seed) error {
+	switch {
+	case seed.DeletionTimestamp != nil:
+		return fmt.Errorf("seed is being deleted")
+	case !seed.Spec.Settings.Scheduling.Visible:
+		return fmt.Errorf("seed is not visible for scheduling")
+	case !verifySeedReadiness(seed):
+		return fmt.Errorf("seed is not ready")
+	}
+	return nil
+}
+
+func verifySeedReadiness(seed *gardencorev1beta1.Seed) bool {
+	if seed.Status.LastOperation == nil {
+		return false
+	}
+
+	if cond := v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedGardenletReady); cond == nil || cond.Status != gardencorev1beta1.ConditionTrue {
+		return false
+	}
+
+	if seed.Spec.Backup != nil {
+		if cond := v1beta1helper.GetCondition(seed.Status.Conditions, gardencorev1beta1.SeedBackupBucketsReady); cond == nil || cond.Status != gardencorev1beta1.ConditionTrue {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,197 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugins
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/yaml"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
+)
+
+type candidateDeterminationStrategy struct {
+	strategy config.CandidateDeterminationStrategy
+}
+
+func newCandidateDeterminationStrategy(cfg *config.ShootSchedulerConfiguration) (framework.Plugin, error) {
+	return &candidateDeterminationStrategy{strategy: cfg.Strategy}, nil
+}
+
+func (p *candidateDeterminationStrategy) Name() string {
+	return CandidateDeterminationStrategy
+}
+
+func (p *candidateDeterminationStrategy) Filter(_ context.Context, log logr.Logger, state *framework.CycleState, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, framework.Rejections, error) {
+	var (
+		candidateNames = sets.New[string]()
+		rejections     = framework.Rejections{}
+	)
+
+	candidates, err := applyStrategy(log, state.Shoot, seedList, p.strategy, state.RegionConfig)
+	if err != nil {
+		for _, seed := range seedList {
+			rejections[seed.Name] = err
+		}
+		return nil, rejections, err
+	}
+
+	for _, candidate := range candidates {
+		candidateNames.Insert(candidate.Name)
+	}
+	for _, seed := range seedList {
+		if !candidateNames.Has(seed.Name) {
+			rejections[seed.Name] = fmt.Errorf("seed is not a candidate according to seed determination strategy '%s'", p.strategy)
+		}
+	}
+
+	return candidates, rejections, nil
+}
+
+func applyStrategy(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed, strategy config.CandidateDeterminationStrategy, regionConfig *corev1.ConfigMap) ([]gardencorev1beta1.Seed, error) {
+	var candidates []gardencorev1beta1.Seed
+
+	switch {
+	case shoot.Spec.Purpose != nil && *shoot.Spec.Purpose == gardencorev1beta1.ShootPurposeTesting:
+		candidates = determineCandidatesOfSameProvider(seedList, shoot)
+	case strategy == config.SameRegion:
+		candidates = determineCandidatesWithSameRegionStrategy(seedList, shoot)
+	case strategy == config.MinimalDistance:
+		var err error
+		candidates, err = determineCandidatesWithMinimalDistanceStrategy(log, shoot, seedList, regionConfig)
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("failed to determine seed candidates. shoot purpose: '%s', strategy: '%s', valid strategies are: %v", *shoot.Spec.Purpose, strategy, config.Strategies)
+	}
+
+	if candidates == nil {
+		return nil, fmt.Errorf("no matching seed candidate found for Configuration (Cloud Profile '%s', Region '%s', SeedDeterminationStrategy '%s')", shoot.Spec.CloudProfileName, shoot.Spec.Region, strategy)
+	}
+	return candidates, nil
+}
+
+func determineCandidatesOfSameProvider(seedList []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot) []gardencorev1beta1.Seed {
+	var candidates []gardencorev1beta1.Seed
+	// Determine all candidate seed clusters matching the shoot's provider and region.
+	for _, seed := range seedList {
+		if seed.Spec.Provider.Type == shoot.Spec.Provider.Type {
+			candidates = append(candidates, seed)
+		}
+	}
+	return candidates
+}
+
+// determineCandidatesWithSameRegionStrategy get all seed clusters matching the shoot's provider and region.
+func determineCandidatesWithSameRegionStrategy(seedList []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot) []gardencorev1beta1.Seed {
+	var candidates []gardencorev1beta1.Seed
+	for _, seed := range seedList {
+		if seed.Spec.Provider.Type == shoot.Spec.Provider.Type && seed.Spec.Provider.Region == shoot.Spec.Region {
+			candidates = append(candidates, seed)
+		}
+	}
+	return candidates
+}
+
+func determineCandidatesWithMinimalDistanceStrategy(log logr.Logger, shoot *gardencorev1beta1.Shoot, seedList []gardencorev1beta1.Seed, regionConfig *corev1.ConfigMap) ([]gardencorev1beta1.Seed, error) {
+	candidates, err := regionConfigMinimalDistance(log, seedList, shoot, regionConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fall back to Levenshtein minimal distance in case we didn't find any candidates.
+	if len(candidates) == 0 {
+		log.Info("No candidates found with minimal distance of region config. Falling back to Levenshtein minimal distance")
+		candidates = levenshteinMinimalDistance(seedList, shoot)
+	}
+	return candidates, nil
+}
+
+func regionConfigMinimalDistance(log logr.Logger, seeds []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot, regionConfig *corev1.ConfigMap) ([]gardencorev1beta1.Seed, error) {
+	var candidates []gardencorev1beta1.Seed
+
+	if regionConfig == nil || regionConfig.Data[shoot.Spec.Region] == "" {
+		log.Info("Region ConfigMap not provided or Shoot region not available", "region", shoot.Spec.Region)
+		return candidates, nil
+	}
+
+	regionConfigData := make(map[string]int)
+	if err := yaml.Unmarshal([]byte(regionConfig.Data[shoot.Spec.Region]), &regionConfigData); err != nil {
+		return nil, fmt.Errorf("failed to determine seed candidates. Wrong format in region ConfigMap %s/%s, Region %q: %w", regionConfig.Namespace, regionConfig.Name, shoot.Spec.Region, err)
+	}
+
+	// If not configured otherwise, assume that a region has the smallest possible distance to itself.
+	if _, ok := regionConfigData[shoot.Spec.Region]; !ok {
+		regionConfigData[shoot.Spec.Region] = 0
+	}
+
+	minDistance := math.MaxInt32
+	for _, seed := range seeds {
+		dist, ok := regionConfigData[seed.Spec.Provider.Region]
+		if !ok {
+			log.Info("Seed region not available in scheduler region ConfigMap for shoot region", "seedName", seed.Name, "shootRegion", shoot.Spec.Region, "seedRegion", seed.Spec.Provider.Region)
+			continue
+		}
+
+		if dist == minDistance {
+			candidates = append(candidates, seed)
+			continue
+		}
+
+		if dist < minDistance {
+			minDistance = dist
+			candidates = []gardencorev1beta1.Seed{seed}
+		}
+	}
+
+	return candidates, nil
+}
+
+func levenshteinMinimalDistance(seeds []gardencorev1beta1.Seed, shoot *gardencorev1beta1.Shoot) []gardencorev1beta1.Seed {
+	var (
+		minDistance   = 1000
+		shootRegion   = shoot.Spec.Region
+		shootProvider = shoot.Spec.Provider.Type
+		candidates    []gardencorev1beta1.Seed
+	)
+
+	for _, seed := range seeds {
+		seedRegion := seed.Spec.Provider.Region
+		dist := distance(seedRegion, shootRegion)
+
+		if shootProvider != seed.Spec.Provider.Type {
+			dist = dist + 2
+		}
+
+		if dist == minDistance {
+			candidates = append(candidates, seed)
+			continue
+		}
+
+		if dist < minDistance {
+			minDistance = dist
+			candidates = []gardencorev1beta1.Seed{seed}
+		}
+	}
+	return candidates
+}
@@ -0,0 +1,112 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugins
+
+import (
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+)
+
+var _ = Describe("CandidateDeterminationStrategy", func() {
+	var (
+		log = logr.Discard()
+
+		seed  *gardencorev1beta1.Seed
+		shoot *gardencorev1beta1.Shoot
+	)
+
+	Context("#DetermineBestSeedCandidate", func() {
+		BeforeEach(func() {
+			seed = &gardencorev1beta1.Seed{
+				Spec: gardencorev1beta1.SeedSpec{
+					Provider: gardencorev1beta1.SeedProvider{
+						Type:   "foo",
+						Region: "europe",
+					},
+				},
+			}
+			shoot = &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					CloudProfileName: "cloudprofile-1",
+					Region:           "europe",
+					Provider: gardencorev1beta1.Provider{
+						Type: "foo",
+					},
+				},
+			}
+		})
+
+		It("should find two seeds candidates having the same amount of matching characters", func() {
+			oldSeedEnvironment1 := *seed
+			oldSeedEnvironment1.Spec.Provider.Type = "some-type"
+			oldSeedEnvironment1.Spec.Provider.Region = "eu-de-200"
+			oldSeedEnvironment1.Name = "seed1"
+
+			newSeedEnvironment2 := *seed
+			newSeedEnvironment2.Spec.Provider.Type = "some-type"
+			newSeedEnvironment2.Spec.Provider.Region = "eu-de-2111"
+			newSeedEnvironment2.Name = "seed2"
+
+			otherSeedEnvironment2 := *seed
+			otherSeedEnvironment2.Spec.Provider.Type = "some-type"
+			otherSeedEnvironment2.Spec.Provider.Region = "eu-nl-1"
+			otherSeedEnvironment2.Name = "xyz"
+
+			// shoot
+			testShoot := shoot
+			testShoot.Spec.Region = "eu-de-2xzxzzx"
+			testShoot.Spec.CloudProfileName = "cloudprofile2"
+			testShoot.Spec.Provider.Type = "some-type"
+
+			candidates, err := applyStrategy(log, testShoot, []gardencorev1beta1.Seed{newSeedEnvironment2, oldSeedEnvironment1, otherSeedEnvironment2}, config.MinimalDistance, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(candidates).To(HaveLen(2))
+			Expect(candidates[0].Name).To(Equal(newSeedEnvironment2.Name))
+			Expect(candidates[1].Name).To(Equal(oldSeedEnvironment1.Name))
+		})
+
+		It("should find single seed candidate", func() {
+			oldSeedEnvironment1 := *seed
+			oldSeedEnvironment1.Spec.Provider.Type = "some-type"
+			oldSeedEnvironment1.Spec.Provider.Region = "eu-de-200"
+			oldSeedEnvironment1.Name = "seed1"
+
+			newSeedEnvironment2 := *seed
+			newSeedEnvironment2.Spec.Provider.Type = "some-type"
+			newSeedEnvironment2.Spec.Provider.Region = "eu-de-2111"
+			newSeedEnvironment2.Name = "seed2"
+
+			otherSeedEnvironment2 := *seed
+			otherSeedEnvironment2.Spec.Provider.Type = "some-type"
+			otherSeedEnvironment2.Spec.Provider.Region = "eu-nl-1"
+			otherSeedEnvironment2.Name = "xyz"
+
+			// shoot
+			testShoot := shoot
+			testShoot.Spec.Region = "eu-de-20"
+			testShoot.Spec.CloudProfileName = "cloudprofile2"
+			testShoot.Spec.Provider.Type = "some-type"
+
+			candidates, err := applyStrategy(log, testShoot, []gardencorev1beta1.Seed{newSeedEnvironment2, oldSeedEnvironment1, otherSeedEnvironment2}, config.MinimalDistance, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(candidates).To(HaveLen(1))
+			Expect(candidates[0].Name).To(Equal(oldSeedEnvironment1.Name))
+		})
+	})
+})
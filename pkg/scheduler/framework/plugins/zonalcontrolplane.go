@@ -0,0 +1,62 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plugins
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
+)
+
+type zonalControlPlane struct{}
+
+func newZonalControlPlane(_ *config.ShootSchedulerConfiguration) (framework.Plugin, error) {
+	return &zonalControlPlane{}, nil
+}
+
+func (p *zonalControlPlane) Name() string {
+	return ZonalControlPlane
+}
+
+// Filter filters seeds with at least three zones in case the shoot's failure tolerance type is 'zone'.
+func (p *zonalControlPlane) Filter(_ context.Context, _ logr.Logger, state *framework.CycleState, seedList []gardencorev1beta1.Seed) ([]gardencorev1beta1.Seed, framework.Rejections, error) {
+	if !v1beta1helper.IsMultiZonalShootControlPlane(state.Shoot) {
+		return seedList, nil, nil
+	}
+
+	var (
+		seedsWithAtLeastThreeZones []gardencorev1beta1.Seed
+		rejections                 = framework.Rejections{}
+	)
+
+	for _, seed := range seedList {
+		if len(seed.Spec.Provider.Zones) < 3 {
+			rejections[seed.Name] = fmt.Errorf("seed has less than 3 zones for hosting a shoot control plane with failure tolerance type 'zone'")
+			continue
+		}
+		seedsWithAtLeastThreeZones = append(seedsWithAtLeastThreeZones, seed)
+	}
+
+	if len(seedsWithAtLeastThreeZones) == 0 {
+		return nil, rejections, fmt.Errorf("none of the %d seeds has at least 3 zones for hosting a shoot control plane with failure tolerance type 'zone'", len(seedList))
+	}
+	return seedsWithAtLeastThreeZones, rejections, nil
+}
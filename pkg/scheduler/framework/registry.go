@@ -0,0 +1,46 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+)
+
+// PluginFactory creates a new plugin based on the given scheduler configuration.
+type PluginFactory func(cfg *config.ShootSchedulerConfiguration) (Plugin, error)
+
+// Registry is a collection of all available plugins, keyed by their names.
+type Registry map[string]PluginFactory
+
+// Register adds a new plugin to the registry. It returns an error if a plugin with the same name already exists.
+func (r Registry) Register(name string, factory PluginFactory) error {
+	if _, ok := r[name]; ok {
+		return fmt.Errorf("a plugin named %q already exists", name)
+	}
+	r[name] = factory
+	return nil
+}
+
+// Merge merges the given registry into this registry. It returns an error if a plugin exists in both registries.
+func (r Registry) Merge(in Registry) error {
+	for name, factory := range in {
+		if err := r.Register(name, factory); err != nil {
+			return err
+		}
+	}
+	return nil
+}
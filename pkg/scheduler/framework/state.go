@@ -0,0 +1,88 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package framework
+
+import (
+	"context"
+	"strings"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
+)
+
+// NewCycleState reads all data which is needed for scheduling the given shoot and returns the cycle state together
+// with all existing seeds.
+func NewCycleState(ctx context.Context, log logr.Logger, reader client.Reader, gardenNamespace string, shoot *gardencorev1beta1.Shoot) (*CycleState, []gardencorev1beta1.Seed, error) {
+	seedList := &gardencorev1beta1.SeedList{}
+	if err := reader.List(ctx, seedList); err != nil {
+		return nil, nil, err
+	}
+
+	shootList := &gardencorev1beta1.ShootList{}
+	if err := reader.List(ctx, shootList); err != nil {
+		return nil, nil, err
+	}
+
+	cloudProfile := &gardencorev1beta1.CloudProfile{}
+	if err := reader.Get(ctx, kubernetesutils.Key(shoot.Spec.CloudProfileName), cloudProfile); err != nil {
+		return nil, nil, err
+	}
+
+	regionConfig, err := getRegionConfigMap(ctx, log, reader, gardenNamespace, cloudProfile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &CycleState{
+		Shoot:        shoot,
+		ShootList:    v1beta1helper.ConvertShootList(shootList.Items),
+		CloudProfile: cloudProfile,
+		RegionConfig: regionConfig,
+	}, seedList.Items, nil
+}
+
+func getRegionConfigMap(ctx context.Context, log logr.Logger, reader client.Reader, gardenNamespace string, cloudProfile *gardencorev1beta1.CloudProfile) (*corev1.ConfigMap, error) {
+	regionConfigList := &corev1.ConfigMapList{}
+	if err := reader.List(ctx, regionConfigList, client.InNamespace(gardenNamespace), client.MatchingLabels{v1beta1constants.SchedulingPurpose: v1beta1constants.SchedulingPurposeRegionConfig}); err != nil {
+		return nil, err
+	}
+
+	var regionConfig *corev1.ConfigMap
+	for _, regionConf := range regionConfigList.Items {
+		profileNames := strings.Split(regionConf.Annotations[v1beta1constants.AnnotationSchedulingCloudProfiles], ",")
+		for _, name := range profileNames {
+			if name != cloudProfile.Name {
+				continue
+			}
+			if regionConfig == nil {
+				regionConfig = regionConf.DeepCopy()
+			} else {
+				log.Info("Duplicate scheduler region config found", "configMap", client.ObjectKeyFromObject(&regionConf), "cloudProfileName", cloudProfile.Name, "chosenConfigMap", client.ObjectKeyFromObject(regionConfig))
+			}
+			break
+		}
+	}
+
+	if regionConfig == nil {
+		log.Info("No region config found", "cloudProfileName", cloudProfile.Name)
+	}
+	return regionConfig, nil
+}
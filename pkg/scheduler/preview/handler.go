@@ -0,0 +1,145 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preview
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/scheduler/framework"
+)
+
+// Path is the path of the scheduling preview endpoint.
+const Path = "/scheduling/preview"
+
+// maxRequestBodySize is the maximum size of a shoot manifest which is accepted by the handler.
+const maxRequestBodySize = 3 * 1024 * 1024
+
+// SchedulingPreview is the response of the scheduling preview endpoint.
+type SchedulingPreview struct {
+	// SeedName is the name of the seed the shoot would be scheduled to. It is empty if no seed is suitable.
+	SeedName string `json:"seedName,omitempty"`
+	// Error is the reason why the shoot cannot be scheduled.
+	Error string `json:"error,omitempty"`
+	// Seeds contains all existing seeds, starting with the ranked candidates followed by the rejected seeds.
+	Seeds []SeedPreview `json:"seeds"`
+}
+
+// SeedPreview describes the result of a scheduling cycle for a single seed.
+type SeedPreview struct {
+	// Name is the name of the seed.
+	Name string `json:"name"`
+	// Rank is the position of the seed in the ranking of all candidates, starting with 1.
+	Rank int `json:"rank,omitempty"`
+	// Score is the sum of all weighted scores of the seed. It is only set if score plugins are enabled.
+	Score *int64 `json:"score,omitempty"`
+	// RejectedBy is the name of the filter plugin which rejected the seed.
+	RejectedBy string `json:"rejectedBy,omitempty"`
+	// Reason is the reason why the seed was rejected.
+	Reason string `json:"reason,omitempty"`
+}
+
+// Handler previews where a shoot would be scheduled to. It runs the same scheduling framework as the Shoot
+// controller, but does not bind the shoot to the seed.
+type Handler struct {
+	Client          client.Reader
+	Framework       *framework.Framework
+	GardenNamespace string
+	Logger          logr.Logger
+}
+
+// ServeHTTP expects a shoot manifest in the request body and responds with the scheduling preview.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, fmt.Sprintf("method %s is not allowed", r.Method), http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxRequestBodySize))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed reading request body: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := json.Unmarshal(body, shoot); err != nil {
+		http.Error(w, fmt.Sprintf("failed decoding shoot: %v", err), http.StatusBadRequest)
+		return
+	}
+	if shoot.Spec.CloudProfileName == "" || shoot.Spec.Region == "" {
+		http.Error(w, "shoot must specify .spec.cloudProfileName and .spec.region", http.StatusBadRequest)
+		return
+	}
+
+	ctx := r.Context()
+	log := h.Logger.WithValues("shoot", client.ObjectKeyFromObject(shoot))
+
+	state, seeds, err := framework.NewCycleState(ctx, log, h.Client, h.GardenNamespace, shoot)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed reading scheduling data: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	result, err := h.Framework.Schedule(ctx, log, state, seeds)
+	preview := h.newSchedulingPreview(seeds, result, err)
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(preview); err != nil {
+		log.Error(err, "Failed writing scheduling preview")
+	}
+}
+
+func (h *Handler) newSchedulingPreview(seeds []gardencorev1beta1.Seed, result *framework.Result, schedulingErr error) *SchedulingPreview {
+	preview := &SchedulingPreview{Seeds: make([]SeedPreview, 0, len(seeds))}
+	if schedulingErr != nil {
+		preview.Error = schedulingErr.Error()
+	}
+	if best := result.SelectedSeed(); best != nil {
+		preview.SeedName = best.Seed.Name
+	}
+
+	ranked := make(map[string]bool, len(result.Ranking))
+	for i, candidate := range result.Ranking {
+		seedPreview := SeedPreview{Name: candidate.Seed.Name, Rank: i + 1}
+		if h.Framework.HasScorePlugins() {
+			score := candidate.Score
+			seedPreview.Score = &score
+		}
+		preview.Seeds = append(preview.Seeds, seedPreview)
+		ranked[candidate.Seed.Name] = true
+	}
+
+	for _, seed := range seeds {
+		if ranked[seed.Name] {
+			continue
+		}
+
+		seedPreview := SeedPreview{Name: seed.Name}
+		if rejection, ok := result.Diagnosis[seed.Name]; ok {
+			seedPreview.RejectedBy = rejection.Plugin
+			seedPreview.Reason = rejection.Reason.Error()
+		}
+		preview.Seeds = append(preview.Seeds, seedPreview)
+	}
+
+	return preview
+}
@@ -0,0 +1,175 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preview_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/scheduler/apis/config"
+	"github.com/gardener/gardener/pkg/scheduler/framework/plugins"
+	. "github.com/gardener/gardener/pkg/scheduler/preview"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		ctx        = context.Background()
+		fakeClient client.Client
+		handler    *Handler
+		cfg        *config.ShootSchedulerConfiguration
+
+		cloudProfile *gardencorev1beta1.CloudProfile
+		shoot        *gardencorev1beta1.Shoot
+	)
+
+	newSeed := func(name, region string, visible bool) *gardencorev1beta1.Seed {
+		return &gardencorev1beta1.Seed{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: gardencorev1beta1.SeedSpec{
+				Provider: gardencorev1beta1.SeedProvider{Type: "foo", Region: region},
+				Networks: gardencorev1beta1.SeedNetworks{
+					Pods:     "10.20.0.0/16",
+					Services: "10.30.0.0/16",
+				},
+				Settings: &gardencorev1beta1.SeedSettings{
+					Scheduling: &gardencorev1beta1.SeedSettingScheduling{Visible: visible},
+				},
+			},
+			Status: gardencorev1beta1.SeedStatus{
+				LastOperation: &gardencorev1beta1.LastOperation{},
+				Conditions: []gardencorev1beta1.Condition{
+					{Type: gardencorev1beta1.SeedGardenletReady, Status: gardencorev1beta1.ConditionTrue},
+				},
+			},
+		}
+	}
+
+	serve := func(method string, obj any) *httptest.ResponseRecorder {
+		body, err := json.Marshal(obj)
+		Expect(err).NotTo(HaveOccurred())
+
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(method, Path, bytes.NewReader(body)).WithContext(ctx))
+		return recorder
+	}
+
+	BeforeEach(func() {
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.GardenScheme).Build()
+		cfg = &config.ShootSchedulerConfiguration{Strategy: config.SameRegion}
+
+		cloudProfile = &gardencorev1beta1.CloudProfile{ObjectMeta: metav1.ObjectMeta{Name: "cloudprofile"}}
+		shoot = &gardencorev1beta1.Shoot{
+			ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-dev"},
+			Spec: gardencorev1beta1.ShootSpec{
+				CloudProfileName: cloudProfile.Name,
+				Region:           "europe",
+				Provider:         gardencorev1beta1.Provider{Type: "foo"},
+				Networking:       &gardencorev1beta1.Networking{Pods: ptr.To("10.40.0.0/16"), Services: ptr.To("10.50.0.0/16")},
+			},
+		}
+
+		Expect(fakeClient.Create(ctx, cloudProfile)).To(Succeed())
+		Expect(fakeClient.Create(ctx, newSeed("seed-1", "europe", true))).To(Succeed())
+		Expect(fakeClient.Create(ctx, newSeed("seed-2", "europe", false))).To(Succeed())
+		Expect(fakeClient.Create(ctx, newSeed("seed-3", "asia", true))).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		f, err := plugins.NewFramework(cfg, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		handler = &Handler{
+			Client:          fakeClient,
+			Framework:       f,
+			GardenNamespace: "garden",
+			Logger:          logr.Discard(),
+		}
+	})
+
+	It("should return the chosen seed and explain why the other seeds were rejected", func() {
+		recorder := serve(http.MethodPost, shoot)
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		preview := &SchedulingPreview{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), preview)).To(Succeed())
+		Expect(preview).To(Equal(&SchedulingPreview{
+			SeedName: "seed-1",
+			Seeds: []SeedPreview{
+				{Name: "seed-1", Rank: 1},
+				{Name: "seed-2", RejectedBy: plugins.SeedUsable, Reason: "seed is not visible for scheduling"},
+				{Name: "seed-3", RejectedBy: plugins.CandidateDeterminationStrategy, Reason: "seed is not a candidate according to seed determination strategy 'SameRegion'"},
+			},
+		}))
+	})
+
+	It("should return the scores of the candidates if score plugins are enabled", func() {
+		cfg.CapacityScoring = &config.CapacityScoringConfiguration{DefaultSeedCapacity: ptr.To[int64](100)}
+		f, err := plugins.NewFramework(cfg, nil)
+		Expect(err).NotTo(HaveOccurred())
+		handler.Framework = f
+
+		recorder := serve(http.MethodPost, shoot)
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		preview := &SchedulingPreview{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), preview)).To(Succeed())
+		Expect(preview.SeedName).To(Equal("seed-1"))
+		Expect(preview.Seeds[0]).To(Equal(SeedPreview{Name: "seed-1", Rank: 1, Score: ptr.To[int64](99)}))
+	})
+
+	It("should return the error if the shoot cannot be scheduled", func() {
+		shoot.Spec.Region = "america"
+
+		recorder := serve(http.MethodPost, shoot)
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		preview := &SchedulingPreview{}
+		Expect(json.Unmarshal(recorder.Body.Bytes(), preview)).To(Succeed())
+		Expect(preview.SeedName).To(BeEmpty())
+		Expect(preview.Error).To(Equal("no matching seed candidate found for Configuration (Cloud Profile 'cloudprofile', Region 'america', SeedDeterminationStrategy 'SameRegion')"))
+		Expect(preview.Seeds).To(Equal([]SeedPreview{
+			{Name: "seed-1", RejectedBy: plugins.CandidateDeterminationStrategy, Reason: preview.Error},
+			{Name: "seed-2", RejectedBy: plugins.SeedUsable, Reason: "seed is not visible for scheduling"},
+			{Name: "seed-3", RejectedBy: plugins.CandidateDeterminationStrategy, Reason: preview.Error},
+		}))
+	})
+
+	It("should reject other methods than POST", func() {
+		Expect(serve(http.MethodGet, shoot).Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+
+	It("should reject shoots without cloud profile or region", func() {
+		shoot.Spec.Region = ""
+		Expect(serve(http.MethodPost, shoot).Code).To(Equal(http.StatusBadRequest))
+	})
+
+	It("should fail if the cloud profile does not exist", func() {
+		shoot.Spec.CloudProfileName = "foo"
+		Expect(serve(http.MethodPost, shoot).Code).To(Equal(http.StatusInternalServerError))
+	})
+})
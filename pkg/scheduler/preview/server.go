@@ -0,0 +1,78 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package preview
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// BindAddress is the address the scheduling preview endpoint is bound to. Requests are not authenticated, hence the
+// endpoint is only reachable from within the pod, e.g. via `kubectl port-forward`.
+const BindAddress = "127.0.0.1"
+
+// AddToManager adds a server serving the scheduling preview endpoint on the given port of localhost to the given
+// manager. The server runs on all replicas, independent of the leader election.
+func (h *Handler) AddToManager(mgr manager.Manager, port int) error {
+	if h.Client == nil {
+		h.Client = mgr.GetClient()
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(Path, h)
+
+	return mgr.Add(&server{
+		server: &http.Server{
+			Addr:              net.JoinHostPort(BindAddress, strconv.Itoa(port)),
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+		},
+	})
+}
+
+type server struct {
+	server *http.Server
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+func (s *server) NeedLeaderElection() bool {
+	return false
+}
+
+// Start implements manager.Runnable.
+func (s *server) Start(ctx context.Context) error {
+	errCh := make(chan error, 1)
+	go func() {
+		if err := s.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			errCh <- err
+		}
+		close(errCh)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		return s.server.Shutdown(shutdownCtx)
+	}
+}
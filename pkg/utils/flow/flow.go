@@ -18,7 +18,9 @@ package flow
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -76,10 +78,11 @@ func (f *Flow) Len() int {
 // node is a compiled Task that contains the triggered Tasks, the
 // number of triggers the node itself requires and its payload function.
 type node struct {
-	targetIDs TaskIDs
-	required  int
-	fn        TaskFn
-	skip      bool
+	targetIDs    TaskIDs
+	required     int
+	fn           TaskFn
+	skip         bool
+	skipOnResume bool
 }
 
 func (n *node) String() string {
@@ -106,6 +109,11 @@ type Opts struct {
 	ErrorCleaner func(ctx context.Context, taskID string)
 	// ErrorContext is used to store any error related context.
 	ErrorContext *errorsutils.ErrorContext
+	// Trace is used to record the execution of the flow, e.g. the start and end times, the retries and the errors of
+	// all tasks. Any previously recorded data is overwritten.
+	Trace *Trace
+	// Resume configures the execution to skip tasks which have already succeeded in a previous execution.
+	Resume *Resume
 }
 
 // Run starts an execution of a Flow.
@@ -118,6 +126,11 @@ type nodeResult struct {
 	TaskID  TaskID
 	Error   error
 	skipped bool
+	resumed bool
+
+	start   time.Time
+	end     time.Time
+	retries int32
 }
 
 // Stats are the statistics of a Flow execution.
@@ -178,6 +191,16 @@ func newExecution(flow *Flow, opts Opts) *execution {
 		log = opts.Log.WithValues(logKeyFlow, flow.name)
 	}
 
+	if opts.Trace != nil {
+		opts.Trace.init(flow)
+	}
+
+	var completed TaskIDs
+	if opts.Resume != nil {
+		completed = opts.Resume.completedTaskIDs()
+		opts.Resume.resumed = false
+	}
+
 	return &execution{
 		flow,
 		InitialStats(flow.name, all),
@@ -186,6 +209,9 @@ func newExecution(flow *Flow, opts Opts) *execution {
 		opts.ProgressReporter,
 		opts.ErrorCleaner,
 		opts.ErrorContext,
+		opts.Trace,
+		opts.Resume,
+		completed,
 		make(chan *nodeResult),
 		make(map[TaskID]int),
 	}
@@ -201,6 +227,9 @@ type execution struct {
 	progressReporter ProgressReporter
 	errorCleaner     ErrorCleaner
 	errorContext     *errorsutils.ErrorContext
+	trace            *Trace
+	resume           *Resume
+	completed        TaskIDs
 
 	done          chan *nodeResult
 	triggerCounts map[TaskID]int
@@ -213,6 +242,9 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 	if node.skip {
 		log.V(1).Info("Skipped")
 		e.stats.Skipped.Insert(id)
+		if e.trace != nil {
+			e.trace.Task(id).State = TaskStateSkipped
+		}
 		go func() {
 			e.done <- &nodeResult{TaskID: id, Error: nil, skipped: true}
 		}()
@@ -220,12 +252,37 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 		return
 	}
 
+	e.stats.Pending.Delete(id)
+	e.stats.Running.Insert(id)
+
+	if e.resume != nil {
+		if e.completed.Has(id) && node.skipOnResume {
+			log.V(1).Info("Skipped, already succeeded")
+			e.resume.resumed = true
+			if e.trace != nil {
+				e.trace.Task(id).State = TaskStateAlreadySucceeded
+			}
+			go func() {
+				e.done <- &nodeResult{TaskID: id, Error: nil, resumed: true}
+			}()
+
+			return
+		}
+
+		if !e.completed.Has(id) {
+			e.invalidateCompleted(id)
+		}
+	}
+
 	if e.errorContext != nil {
 		e.errorContext.AddErrorID(string(id))
 	}
-	e.stats.Pending.Delete(id)
-	e.stats.Running.Insert(id)
 	go func() {
+		var retries atomic.Int32
+		if e.trace != nil {
+			ctx = contextWithRetryCounter(ctx, &retries)
+		}
+
 		start := time.Now().UTC()
 		log.V(1).Info("Started")
 		err := node.fn(ctx)
@@ -239,13 +296,38 @@ func (e *execution) runNode(ctx context.Context, id TaskID) {
 			log.Info("Succeeded")
 		}
 
-		e.done <- &nodeResult{TaskID: id, Error: err}
+		e.done <- &nodeResult{TaskID: id, Error: err, start: start, end: end, retries: retries.Load()}
 	}()
 }
 
 func (e *execution) updateSuccess(id TaskID) {
 	e.stats.Running.Delete(id)
 	e.stats.Succeeded.Insert(id)
+	if e.resume != nil {
+		e.completed.Insert(id)
+	}
+}
+
+// invalidateCompleted removes the given task and all tasks depending on it (transitively) from the completed tasks,
+// since the task has not succeeded before and might change their inputs.
+func (e *execution) invalidateCompleted(id TaskID) {
+	var (
+		queue   = TaskIDSlice{id}
+		visited = NewTaskIDs(id)
+	)
+
+	for len(queue) > 0 && e.completed.Len() > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		e.completed.Delete(current)
+		for target := range e.flow.nodes[current].targetIDs {
+			if !visited.Has(target) {
+				visited.Insert(target)
+				queue = append(queue, target)
+			}
+		}
+	}
 }
 
 func (e *execution) updateFailure(id TaskID) {
@@ -253,6 +335,20 @@ func (e *execution) updateFailure(id TaskID) {
 	e.stats.Failed.Insert(id)
 }
 
+func (e *execution) recordTrace(result *nodeResult) {
+	if e.trace == nil {
+		return
+	}
+
+	task := e.trace.Task(result.TaskID)
+	task.Start, task.End, task.Retries = &result.start, &result.end, result.retries
+	task.State = TaskStateSucceeded
+	if result.Error != nil {
+		task.State = TaskStateFailed
+		task.Error = errors.Unwrap(result.Error).Error()
+	}
+}
+
 func (e *execution) processTriggers(ctx context.Context, id TaskID) {
 	node := e.flow.nodes[id]
 	for target := range node.targetIDs {
@@ -287,6 +383,10 @@ func (e *execution) run(ctx context.Context) error {
 
 	e.log.Info("Starting")
 	e.reportProgress(ctx)
+	if e.trace != nil {
+		start := time.Now().UTC()
+		e.trace.Start = &start
+	}
 
 	var (
 		cancelErr error
@@ -307,6 +407,9 @@ func (e *execution) run(ctx context.Context) error {
 				e.processTriggers(ctx, result.TaskID)
 			}
 		} else {
+			if !result.resumed {
+				e.recordTrace(result)
+			}
 			if result.Error != nil {
 				e.taskErrors = append(e.taskErrors, errorsutils.WithID(string(result.TaskID), result.Error))
 				e.updateFailure(result.TaskID)
@@ -323,6 +426,13 @@ func (e *execution) run(ctx context.Context) error {
 		e.reportProgress(ctx)
 	}
 
+	if e.trace != nil {
+		end := time.Now().UTC()
+		e.trace.End = &end
+	}
+	if e.resume != nil {
+		e.resume.record(e.completed)
+	}
 	e.log.Info("Finished")
 	return e.result(cancelErr)
 }
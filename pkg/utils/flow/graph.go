@@ -25,6 +25,10 @@ type Task struct {
 	Fn           TaskFn
 	SkipIf       bool
 	Dependencies TaskIDs
+	// SkipOnResume allows skipping the task if it has already succeeded in a previous execution which is resumed. It
+	// must only be set for tasks which neither initialize state required by other tasks nor have side effects which
+	// must be repeated in every execution. All other tasks are always executed.
+	SkipOnResume bool
 }
 
 // Spec returns the TaskSpec of a task.
@@ -33,6 +37,7 @@ func (t *Task) Spec() *TaskSpec {
 		t.Fn,
 		t.SkipIf,
 		t.Dependencies.Copy(),
+		t.SkipOnResume,
 	}
 }
 
@@ -42,6 +47,7 @@ type TaskSpec struct {
 	Fn           TaskFn
 	Skip         bool
 	Dependencies TaskIDs
+	SkipOnResume bool
 }
 
 // Tasks is a mapping from TaskID to TaskSpec.
@@ -95,6 +101,7 @@ func (g *Graph) Compile() *Flow {
 		node := nodes.getOrCreate(taskName)
 		node.fn = taskSpec.Fn
 		node.skip = taskSpec.Skip
+		node.skipOnResume = taskSpec.SkipOnResume
 		node.required = taskSpec.Dependencies.Len()
 	}
 
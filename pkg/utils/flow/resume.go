@@ -0,0 +1,57 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flow
+
+// CompletedTasks is a record of the tasks which have succeeded in previous executions of a flow for an object. It is
+// meant to be persisted between executions, e.g. as JSON.
+type CompletedTasks struct {
+	// Generation is the generation of the object for which the tasks have succeeded.
+	Generation int64 `json:"generation"`
+	// TaskIDs are the ids of the tasks which have succeeded.
+	TaskIDs TaskIDSlice `json:"taskIDs,omitempty"`
+}
+
+// Resume configures a flow execution to skip the tasks which have already succeeded in a previous execution for the
+// same generation of an object. Only tasks with SkipOnResume are skipped, and only if none of the tasks they depend on
+// (transitively) has failed or not been executed in a previous execution, i.e. if their inputs have not changed.
+type Resume struct {
+	// Generation is the current generation of the object the flow is executed for.
+	Generation int64
+	// Completed is the record of previous executions. It is ignored if it was recorded for another generation. After
+	// the execution, it contains the tasks which have succeeded for the current generation and should be persisted.
+	Completed *CompletedTasks
+
+	resumed bool
+}
+
+// Resumed returns true if at least one task was skipped in the execution because it has already succeeded before.
+// Callers must not rely on the side effects of skipped tasks, e.g. on the secrets they would have generated.
+func (r *Resume) Resumed() bool {
+	return r != nil && r.resumed
+}
+
+func (r *Resume) completedTaskIDs() TaskIDs {
+	if r.Completed == nil || r.Completed.Generation != r.Generation {
+		return NewTaskIDs()
+	}
+	return NewTaskIDs(r.Completed.TaskIDs)
+}
+
+func (r *Resume) record(completed TaskIDs) {
+	r.Completed = &CompletedTasks{
+		Generation: r.Generation,
+		TaskIDs:    completed.List(),
+	}
+}
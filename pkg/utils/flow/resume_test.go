@@ -0,0 +1,140 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flow_test
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("Resume", func() {
+	var (
+		ctx = context.Background()
+
+		executed *AtomicStringList
+		failing  map[string]bool
+		g        *flow.Graph
+	)
+
+	task := func(name string) flow.TaskFn {
+		return func(_ context.Context) error {
+			executed.Append(name)
+			if failing[name] {
+				return errors.New("fail")
+			}
+			return nil
+		}
+	}
+
+	BeforeEach(func() {
+		executed = NewAtomicStringList()
+		failing = map[string]bool{}
+
+		// a -> b -> d
+		// a -> c -> d
+		// init (always executed) -> c
+		g = flow.NewGraph("foo")
+		a := g.Add(flow.Task{Name: "a", Fn: task("a"), SkipOnResume: true})
+		b := g.Add(flow.Task{Name: "b", Fn: task("b"), Dependencies: flow.NewTaskIDs(a), SkipOnResume: true})
+		initialize := g.Add(flow.Task{Name: "init", Fn: task("init")})
+		c := g.Add(flow.Task{Name: "c", Fn: task("c"), Dependencies: flow.NewTaskIDs(a, initialize), SkipOnResume: true})
+		_ = g.Add(flow.Task{Name: "d", Fn: task("d"), Dependencies: flow.NewTaskIDs(b, c), SkipOnResume: true})
+	})
+
+	It("should record the succeeded tasks", func() {
+		failing["c"] = true
+		resume := &flow.Resume{Generation: 1}
+
+		Expect(g.Compile().Run(ctx, flow.Opts{Resume: resume})).To(HaveOccurred())
+		Expect(resume.Completed).To(Equal(&flow.CompletedTasks{Generation: 1, TaskIDs: flow.TaskIDSlice{"a", "b", "init"}}))
+	})
+
+	It("should skip the tasks which have already succeeded", func() {
+		resume := &flow.Resume{
+			Generation: 1,
+			Completed:  &flow.CompletedTasks{Generation: 1, TaskIDs: flow.TaskIDSlice{"a", "b", "init"}},
+		}
+		trace := &flow.Trace{}
+
+		Expect(g.Compile().Run(ctx, flow.Opts{Resume: resume, Trace: trace})).To(Succeed())
+		Expect(executed.Values()).To(ConsistOf("init", "c", "d"))
+		Expect(resume.Completed).To(Equal(&flow.CompletedTasks{Generation: 1, TaskIDs: flow.TaskIDSlice{"a", "b", "c", "d", "init"}}))
+		Expect(trace.Task("a").State).To(Equal(flow.TaskStateAlreadySucceeded))
+		Expect(trace.Task("c").State).To(Equal(flow.TaskStateSucceeded))
+		Expect(resume.Resumed()).To(BeTrue())
+	})
+
+	It("should execute succeeded tasks again if one of their dependencies has not succeeded before", func() {
+		resume := &flow.Resume{
+			Generation: 1,
+			Completed:  &flow.CompletedTasks{Generation: 1, TaskIDs: flow.TaskIDSlice{"a", "b", "c", "d"}},
+		}
+
+		Expect(g.Compile().Run(ctx, flow.Opts{Resume: resume})).To(Succeed())
+		Expect(executed.Values()).To(ConsistOf("init", "c", "d"))
+	})
+
+	It("should not skip tasks without SkipOnResume", func() {
+		g = flow.NewGraph("bar")
+		e := g.Add(flow.Task{Name: "e", Fn: task("e")})
+		_ = g.Add(flow.Task{Name: "f", Fn: task("f"), Dependencies: flow.NewTaskIDs(e)})
+		resume := &flow.Resume{
+			Generation: 1,
+			Completed:  &flow.CompletedTasks{Generation: 1, TaskIDs: flow.TaskIDSlice{"e", "f"}},
+		}
+
+		Expect(g.Compile().Run(ctx, flow.Opts{Resume: resume})).To(Succeed())
+		Expect(executed.Values()).To(ConsistOf("e", "f"))
+		Expect(resume.Resumed()).To(BeFalse())
+	})
+
+	It("should execute succeeded tasks again if one of their dependencies is executed again", func() {
+		resume := &flow.Resume{
+			Generation: 1,
+			Completed:  &flow.CompletedTasks{Generation: 1, TaskIDs: flow.TaskIDSlice{"b", "c", "d"}},
+		}
+
+		Expect(g.Compile().Run(ctx, flow.Opts{Resume: resume})).To(Succeed())
+		Expect(executed.Values()).To(ConsistOf("a", "b", "init", "c", "d"))
+	})
+
+	It("should forget succeeded tasks whose dependencies are executed again", func() {
+		failing["a"] = true
+		resume := &flow.Resume{
+			Generation: 1,
+			Completed:  &flow.CompletedTasks{Generation: 1, TaskIDs: flow.TaskIDSlice{"b", "c", "d"}},
+		}
+
+		Expect(g.Compile().Run(ctx, flow.Opts{Resume: resume})).To(HaveOccurred())
+		Expect(resume.Completed).To(Equal(&flow.CompletedTasks{Generation: 1, TaskIDs: flow.TaskIDSlice{"init"}}))
+	})
+
+	It("should ignore the record of another generation", func() {
+		resume := &flow.Resume{
+			Generation: 2,
+			Completed:  &flow.CompletedTasks{Generation: 1, TaskIDs: flow.TaskIDSlice{"a", "b", "c", "d", "init"}},
+		}
+
+		Expect(g.Compile().Run(ctx, flow.Opts{Resume: resume})).To(Succeed())
+		Expect(executed.Values()).To(ConsistOf("a", "b", "init", "c", "d"))
+		Expect(resume.Completed.Generation).To(Equal(int64(2)))
+		Expect(resume.Resumed()).To(BeFalse())
+	})
+})
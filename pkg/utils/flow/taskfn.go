@@ -54,6 +54,7 @@ func (t TaskFn) RetryUntilTimeout(interval, timeout time.Duration) TaskFn {
 
 		return retry.Until(ctx, interval, func(ctx context.Context) (done bool, err error) {
 			if err := t(ctx); err != nil {
+				recordRetry(ctx)
 				return retry.MinorError(err)
 			}
 			return retry.Ok()
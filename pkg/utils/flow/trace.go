@@ -0,0 +1,229 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flow
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// TaskState is the state of a task in a Trace.
+type TaskState string
+
+const (
+	// TaskStatePending is the state of a task which has not been started.
+	TaskStatePending TaskState = "Pending"
+	// TaskStateSkipped is the state of a task which has been skipped.
+	TaskStateSkipped TaskState = "Skipped"
+	// TaskStateSucceeded is the state of a task which has finished successfully.
+	TaskStateSucceeded TaskState = "Succeeded"
+	// TaskStateFailed is the state of a task which has finished with an error.
+	TaskStateFailed TaskState = "Failed"
+	// TaskStateAlreadySucceeded is the state of a task which has been skipped because it has already succeeded in a
+	// previous execution, see Resume.
+	TaskStateAlreadySucceeded TaskState = "AlreadySucceeded"
+)
+
+// TaskTrace records the execution of a single task.
+type TaskTrace struct {
+	// ID is the id of the task.
+	ID TaskID `json:"id"`
+	// Dependencies are the ids of the tasks the task depends on.
+	Dependencies TaskIDSlice `json:"dependencies,omitempty"`
+	// State is the state of the task.
+	State TaskState `json:"state"`
+	// Start is the time when the task was started.
+	Start *time.Time `json:"start,omitempty"`
+	// End is the time when the task has finished.
+	End *time.Time `json:"end,omitempty"`
+	// Retries is the number of failed attempts of the task which are retried by TaskFn.RetryUntilTimeout.
+	Retries int32 `json:"retries,omitempty"`
+	// Error is the error message if the task has failed.
+	Error string `json:"error,omitempty"`
+}
+
+// Duration returns the duration of the task execution, or zero if the task has not finished.
+func (t *TaskTrace) Duration() time.Duration {
+	if t.Start == nil || t.End == nil {
+		return 0
+	}
+	return t.End.Sub(*t.Start)
+}
+
+// Trace records the execution of a Flow. It can be exported as JSON (via encoding/json) or in the DOT format.
+// A Trace which has not been passed to a Flow execution describes the compiled graph only.
+type Trace struct {
+	// FlowName is the name of the flow.
+	FlowName string `json:"flowName"`
+	// Start is the time when the flow execution was started.
+	Start *time.Time `json:"start,omitempty"`
+	// End is the time when the flow execution has finished.
+	End *time.Time `json:"end,omitempty"`
+	// Tasks contains the traces of all tasks, ordered by their ids.
+	Tasks []*TaskTrace `json:"tasks"`
+}
+
+// NewTrace returns a trace of the given flow in which all tasks are pending.
+func NewTrace(flow *Flow) *Trace {
+	t := &Trace{}
+	t.init(flow)
+	return t
+}
+
+func (t *Trace) init(flow *Flow) {
+	dependencies := make(map[TaskID]TaskIDSlice, len(flow.nodes))
+	for id, node := range flow.nodes {
+		for target := range node.targetIDs {
+			dependencies[target] = append(dependencies[target], id)
+		}
+	}
+
+	t.FlowName = flow.name
+	t.Start, t.End = nil, nil
+	t.Tasks = make([]*TaskTrace, 0, len(flow.nodes))
+	for id := range flow.nodes {
+		slices.Sort(dependencies[id])
+		t.Tasks = append(t.Tasks, &TaskTrace{ID: id, Dependencies: dependencies[id], State: TaskStatePending})
+	}
+	slices.SortFunc(t.Tasks, func(a, b *TaskTrace) int { return strings.Compare(string(a.ID), string(b.ID)) })
+}
+
+// Task returns the trace of the task with the given id, or nil if the flow does not contain such a task.
+func (t *Trace) Task(id TaskID) *TaskTrace {
+	i, ok := slices.BinarySearchFunc(t.Tasks, id, func(task *TaskTrace, id TaskID) int {
+		return strings.Compare(string(task.ID), string(id))
+	})
+	if !ok {
+		return nil
+	}
+	return t.Tasks[i]
+}
+
+// Duration returns the duration of the flow execution, or zero if the flow has not finished.
+func (t *Trace) Duration() time.Duration {
+	if t.Start == nil || t.End == nil {
+		return 0
+	}
+	return t.End.Sub(*t.Start)
+}
+
+// CriticalPath returns the ids of the tasks which determined the duration of the flow execution, starting with the
+// first task. The path ends with the task which has finished last, each other task of the path is the dependency
+// which has finished last before its successor could be started. Skipped tasks (including tasks which have already
+// succeeded in a previous execution) are not part of the path.
+func (t *Trace) CriticalPath() TaskIDSlice {
+	var all TaskIDSlice
+	for _, task := range t.Tasks {
+		all = append(all, task.ID)
+	}
+
+	var path TaskIDSlice
+	for task := t.lastFinished(all); task != nil; task = t.lastFinished(task.Dependencies) {
+		path = append(path, task.ID)
+	}
+	slices.Reverse(path)
+	return path
+}
+
+// lastFinished returns the task of the given ones which has finished last. Tasks which have not finished (e.g. because
+// they were skipped) are replaced with their dependencies.
+func (t *Trace) lastFinished(ids TaskIDSlice) *TaskTrace {
+	var last *TaskTrace
+	for _, id := range ids {
+		candidate := t.Task(id)
+		if candidate == nil {
+			continue
+		}
+		if candidate.End == nil {
+			if candidate.State != TaskStateSkipped && candidate.State != TaskStateAlreadySucceeded {
+				continue
+			}
+			if candidate = t.lastFinished(candidate.Dependencies); candidate == nil {
+				continue
+			}
+		}
+		if last == nil || candidate.End.After(*last.End) {
+			last = candidate
+		}
+	}
+	return last
+}
+
+// DOT returns the trace in the DOT format of Graphviz. Tasks are colored according to their state and labeled with
+// their duration, the edges of the critical path are highlighted.
+func (t *Trace) DOT() string {
+	var (
+		buf          bytes.Buffer
+		criticalPath = t.CriticalPath()
+		critical     = make(map[TaskID]TaskID, len(criticalPath))
+	)
+
+	for i := 1; i < len(criticalPath); i++ {
+		critical[criticalPath[i]] = criticalPath[i-1]
+	}
+
+	fmt.Fprintf(&buf, "digraph %q {\n", t.FlowName)
+	buf.WriteString("  node [shape=box, style=filled, fillcolor=white];\n")
+	for _, task := range t.Tasks {
+		label := string(task.ID)
+		if task.End != nil {
+			label += "\n" + task.Duration().Round(time.Millisecond).String()
+		}
+		if task.Retries > 0 {
+			label += fmt.Sprintf("\n%d retries", task.Retries)
+		}
+		fmt.Fprintf(&buf, "  %q [label=%q, fillcolor=%q];\n", task.ID, label, dotColors[task.State])
+	}
+	for _, task := range t.Tasks {
+		for _, dependency := range task.Dependencies {
+			if critical[task.ID] == dependency {
+				fmt.Fprintf(&buf, "  %q -> %q [color=\"blue\", penwidth=3];\n", dependency, task.ID)
+				continue
+			}
+			fmt.Fprintf(&buf, "  %q -> %q;\n", dependency, task.ID)
+		}
+	}
+	buf.WriteString("}\n")
+
+	return buf.String()
+}
+
+var dotColors = map[TaskState]string{
+	TaskStatePending:   "white",
+	TaskStateSkipped:   "lightgrey",
+	TaskStateSucceeded: "palegreen",
+	TaskStateFailed:    "salmon",
+
+	TaskStateAlreadySucceeded: "honeydew",
+}
+
+type retryCounterKey struct{}
+
+// contextWithRetryCounter returns a context which counts the retries of a task, see recordRetry.
+func contextWithRetryCounter(ctx context.Context, counter *atomic.Int32) context.Context {
+	return context.WithValue(ctx, retryCounterKey{}, counter)
+}
+
+// recordRetry increments the retry counter of the task which is executed with the given context, if any.
+func recordRetry(ctx context.Context) {
+	if counter, ok := ctx.Value(retryCounterKey{}).(*atomic.Int32); ok {
+		counter.Add(1)
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flow_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+)
+
+var _ = Describe("Trace", func() {
+	var (
+		ctx = context.Background()
+		now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		g          *flow.Graph
+		a, b, c, d flow.TaskID
+		noop       = func(context.Context) error { return nil }
+	)
+
+	at := func(seconds int) *time.Time {
+		t := now.Add(time.Duration(seconds) * time.Second)
+		return &t
+	}
+
+	BeforeEach(func() {
+		g = flow.NewGraph("foo")
+		a = g.Add(flow.Task{Name: "a", Fn: noop})
+		b = g.Add(flow.Task{Name: "b", Fn: noop, Dependencies: flow.NewTaskIDs(a)})
+		c = g.Add(flow.Task{Name: "c", Fn: noop, Dependencies: flow.NewTaskIDs(a), SkipIf: true})
+		d = g.Add(flow.Task{Name: "d", Fn: noop, Dependencies: flow.NewTaskIDs(b, c)})
+	})
+
+	Describe("#NewTrace", func() {
+		It("should describe the compiled graph", func() {
+			trace := flow.NewTrace(g.Compile())
+
+			Expect(trace.FlowName).To(Equal("foo"))
+			Expect(trace.Tasks).To(Equal([]*flow.TaskTrace{
+				{ID: a, State: flow.TaskStatePending},
+				{ID: b, Dependencies: flow.TaskIDSlice{a}, State: flow.TaskStatePending},
+				{ID: c, Dependencies: flow.TaskIDSlice{a}, State: flow.TaskStatePending},
+				{ID: d, Dependencies: flow.TaskIDSlice{b, c}, State: flow.TaskStatePending},
+			}))
+			Expect(trace.CriticalPath()).To(BeEmpty())
+		})
+	})
+
+	Describe("#Run", func() {
+		It("should record the execution of all tasks", func() {
+			var (
+				attempts int
+				g        = flow.NewGraph("foo")
+				x        = g.Add(flow.Task{Name: "x", Fn: flow.TaskFn(func(context.Context) error {
+					if attempts++; attempts < 3 {
+						return errors.New("not yet")
+					}
+					return nil
+				}).RetryUntilTimeout(time.Millisecond, time.Second)})
+				y = g.Add(flow.Task{Name: "y", Fn: func(context.Context) error { return errors.New("fail") }, Dependencies: flow.NewTaskIDs(x)})
+				z = g.Add(flow.Task{Name: "z", Fn: noop, Dependencies: flow.NewTaskIDs(y)})
+
+				trace = &flow.Trace{}
+			)
+
+			Expect(g.Compile().Run(ctx, flow.Opts{Trace: trace})).To(HaveOccurred())
+
+			Expect(trace.Start).NotTo(BeNil())
+			Expect(trace.End).NotTo(BeNil())
+
+			Expect(trace.Task(x).State).To(Equal(flow.TaskStateSucceeded))
+			Expect(trace.Task(x).Retries).To(Equal(int32(2)))
+			Expect(trace.Task(x).Start).NotTo(BeNil())
+			Expect(trace.Task(x).End).NotTo(BeNil())
+
+			Expect(trace.Task(y).State).To(Equal(flow.TaskStateFailed))
+			Expect(trace.Task(y).Error).To(Equal("fail"))
+
+			Expect(trace.Task(z).State).To(Equal(flow.TaskStatePending))
+			Expect(trace.Task(z).Start).To(BeNil())
+
+			Expect(trace.CriticalPath()).To(Equal(flow.TaskIDSlice{x, y}))
+		})
+
+		It("should record skipped tasks", func() {
+			trace := &flow.Trace{}
+			Expect(g.Compile().Run(ctx, flow.Opts{Trace: trace})).To(Succeed())
+
+			Expect(trace.Task(c).State).To(Equal(flow.TaskStateSkipped))
+			Expect(trace.Task(d).State).To(Equal(flow.TaskStateSucceeded))
+		})
+	})
+
+	Describe("#CriticalPath", func() {
+		It("should follow the dependencies which have finished last and skip skipped tasks", func() {
+			trace := flow.NewTrace(g.Compile())
+			trace.Task(a).State, trace.Task(a).Start, trace.Task(a).End = flow.TaskStateSucceeded, at(0), at(10)
+			trace.Task(b).State, trace.Task(b).Start, trace.Task(b).End = flow.TaskStateSucceeded, at(10), at(15)
+			trace.Task(c).State = flow.TaskStateSkipped
+			trace.Task(d).State, trace.Task(d).Start, trace.Task(d).End = flow.TaskStateSucceeded, at(15), at(20)
+
+			Expect(trace.CriticalPath()).To(Equal(flow.TaskIDSlice{a, b, d}))
+		})
+	})
+
+	Describe("#DOT", func() {
+		It("should export the trace in the DOT format", func() {
+			trace := flow.NewTrace(g.Compile())
+			trace.Task(a).State, trace.Task(a).Start, trace.Task(a).End = flow.TaskStateSucceeded, at(0), at(10)
+			trace.Task(b).State, trace.Task(b).Start, trace.Task(b).End, trace.Task(b).Retries = flow.TaskStateFailed, at(10), at(15), 2
+			trace.Task(c).State = flow.TaskStateSkipped
+
+			Expect(trace.DOT()).To(Equal(`digraph "foo" {
+  node [shape=box, style=filled, fillcolor=white];
+  "a" [label="a\n10s", fillcolor="palegreen"];
+  "b" [label="b\n5s\n2 retries", fillcolor="salmon"];
+  "c" [label="c", fillcolor="lightgrey"];
+  "d" [label="d", fillcolor="white"];
+  "a" -> "b" [color="blue", penwidth=3];
+  "a" -> "c";
+  "b" -> "d";
+  "c" -> "d";
+}
+`))
+		})
+	})
+
+	Describe("JSON", func() {
+		It("should export the trace as JSON", func() {
+			trace := flow.NewTrace(g.Compile())
+			trace.Task(a).State, trace.Task(a).Start, trace.Task(a).End = flow.TaskStateFailed, at(0), at(10)
+			trace.Task(a).Error = "fail"
+
+			data, err := json.Marshal(trace)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).To(Equal(`{"flowName":"foo","tasks":[` +
+				`{"id":"a","state":"Failed","start":"2024-01-01T00:00:00Z","end":"2024-01-01T00:00:10Z","error":"fail"},` +
+				`{"id":"b","dependencies":["a"],"state":"Pending"},` +
+				`{"id":"c","dependencies":["a"],"state":"Pending"},` +
+				`{"id":"d","dependencies":["b","c"],"state":"Pending"}]}`))
+
+			decoded := &flow.Trace{}
+			Expect(json.Unmarshal(data, decoded)).To(Succeed())
+			Expect(decoded.Task(a).Duration()).To(Equal(10 * time.Second))
+		})
+	})
+})
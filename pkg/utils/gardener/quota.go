@@ -0,0 +1,149 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gardener
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+)
+
+// QuotaMetricNames is the list of metrics which can be constrained by Quotas.
+var QuotaMetricNames = []corev1.ResourceName{
+	gardencorev1beta1.QuotaMetricCPU,
+	gardencorev1beta1.QuotaMetricGPU,
+	gardencorev1beta1.QuotaMetricMemory,
+	gardencorev1beta1.QuotaMetricStorageStandard,
+	gardencorev1beta1.QuotaMetricStoragePremium,
+	gardencorev1beta1.QuotaMetricLoadbalancer,
+}
+
+// ShootQuotaUsage computes the amount of resources the given Shoot allocates with respect to the Quota metrics. For
+// now, the maximum amount of resources of all worker pools is always used for the computation.
+func ShootQuotaUsage(shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) (corev1.ResourceList, error) {
+	var (
+		countLB   int64 = 1
+		resources       = make(corev1.ResourceList)
+	)
+
+	for _, worker := range shootWorkersWithVolumes(shoot, cloudProfile) {
+		var (
+			machineType *gardencorev1beta1.MachineType
+			volumeType  *gardencorev1beta1.VolumeType
+		)
+
+		for _, m := range cloudProfile.Spec.MachineTypes {
+			if m.Name == worker.Machine.Type {
+				machineType = m.DeepCopy()
+				break
+			}
+		}
+		if machineType == nil {
+			return nil, fmt.Errorf("machineType %s not found in CloudProfile %s", worker.Machine.Type, cloudProfile.Name)
+		}
+
+		if worker.Volume != nil {
+			if machineType.Storage != nil {
+				volumeType = &gardencorev1beta1.VolumeType{
+					Class: machineType.Storage.Class,
+				}
+			} else {
+				for _, v := range cloudProfile.Spec.VolumeTypes {
+					if worker.Volume.Type != nil && v.Name == *worker.Volume.Type {
+						volumeType = v.DeepCopy()
+						break
+					}
+				}
+			}
+		}
+		if volumeType == nil {
+			return nil, fmt.Errorf("VolumeType %s not found in CloudProfile %s", worker.Machine.Type, cloudProfile.Name)
+		}
+
+		resources[gardencorev1beta1.QuotaMetricCPU] = SumQuantities(resources[gardencorev1beta1.QuotaMetricCPU], multiplyQuantity(machineType.CPU, worker.Maximum))
+		resources[gardencorev1beta1.QuotaMetricGPU] = SumQuantities(resources[gardencorev1beta1.QuotaMetricGPU], multiplyQuantity(machineType.GPU, worker.Maximum))
+		resources[gardencorev1beta1.QuotaMetricMemory] = SumQuantities(resources[gardencorev1beta1.QuotaMetricMemory], multiplyQuantity(machineType.Memory, worker.Maximum))
+
+		size := resource.MustParse("0Gi")
+		if worker.Volume != nil {
+			var err error
+			if size, err = resource.ParseQuantity(worker.Volume.VolumeSize); err != nil {
+				return nil, err
+			}
+		}
+
+		switch volumeType.Class {
+		case gardencorev1beta1.VolumeClassStandard:
+			resources[gardencorev1beta1.QuotaMetricStorageStandard] = SumQuantities(resources[gardencorev1beta1.QuotaMetricStorageStandard], multiplyQuantity(size, worker.Maximum))
+		case gardencorev1beta1.VolumeClassPremium:
+			resources[gardencorev1beta1.QuotaMetricStoragePremium] = SumQuantities(resources[gardencorev1beta1.QuotaMetricStoragePremium], multiplyQuantity(size, worker.Maximum))
+		default:
+			return nil, fmt.Errorf("unknown volumeType class %s", volumeType.Class)
+		}
+	}
+
+	if v1beta1helper.NginxIngressEnabled(shoot.Spec.Addons) {
+		countLB++
+	}
+	resources[gardencorev1beta1.QuotaMetricLoadbalancer] = *resource.NewQuantity(countLB, resource.DecimalSI)
+
+	return resources, nil
+}
+
+// shootWorkersWithVolumes returns the workers of the given Shoot. Workers without a volume get the volume of their
+// machine type if the CloudProfile defines a fixed storage for it.
+func shootWorkersWithVolumes(shoot *gardencorev1beta1.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) []gardencorev1beta1.Worker {
+	workers := make([]gardencorev1beta1.Worker, 0, len(shoot.Spec.Provider.Workers))
+
+	for _, worker := range shoot.Spec.Provider.Workers {
+		workerCopy := worker.DeepCopy()
+
+		if worker.Volume == nil {
+			for _, machineType := range cloudProfile.Spec.MachineTypes {
+				if worker.Machine.Type == machineType.Name && machineType.Storage != nil && machineType.Storage.StorageSize != nil {
+					workerCopy.Volume = &gardencorev1beta1.Volume{
+						Type:       &machineType.Storage.Type,
+						VolumeSize: machineType.Storage.StorageSize.String(),
+					}
+				}
+			}
+		}
+
+		workers = append(workers, *workerCopy)
+	}
+
+	return workers
+}
+
+// SumQuantities returns the sum of the given quantities.
+func SumQuantities(values ...resource.Quantity) resource.Quantity {
+	res := resource.Quantity{}
+	for _, v := range values {
+		res.Add(v)
+	}
+	return res
+}
+
+func multiplyQuantity(quantity resource.Quantity, multiplier int32) resource.Quantity {
+	res := resource.Quantity{}
+	for i := 0; i < int(multiplier); i++ {
+		res.Add(quantity)
+	}
+	return res
+}
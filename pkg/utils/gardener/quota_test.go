@@ -0,0 +1,128 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gardener_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	. "github.com/gardener/gardener/pkg/utils/gardener"
+)
+
+var _ = Describe("Quota", func() {
+	Describe("#ShootQuotaUsage", func() {
+		var (
+			cloudProfile *gardencorev1beta1.CloudProfile
+			shoot        *gardencorev1beta1.Shoot
+		)
+
+		BeforeEach(func() {
+			cloudProfile = &gardencorev1beta1.CloudProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile"},
+				Spec: gardencorev1beta1.CloudProfileSpec{
+					MachineTypes: []gardencorev1beta1.MachineType{
+						{
+							Name:   "machine-type",
+							CPU:    resource.MustParse("2"),
+							GPU:    resource.MustParse("1"),
+							Memory: resource.MustParse("4Gi"),
+						},
+						{
+							Name:   "machine-type-with-storage",
+							CPU:    resource.MustParse("4"),
+							GPU:    resource.MustParse("0"),
+							Memory: resource.MustParse("8Gi"),
+							Storage: &gardencorev1beta1.MachineTypeStorage{
+								Class:       gardencorev1beta1.VolumeClassPremium,
+								StorageSize: ptr.To(resource.MustParse("50Gi")),
+								Type:        "ssd",
+							},
+						},
+					},
+					VolumeTypes: []gardencorev1beta1.VolumeType{{
+						Name:  "volume-type",
+						Class: gardencorev1beta1.VolumeClassStandard,
+					}},
+				},
+			}
+
+			shoot = &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Provider: gardencorev1beta1.Provider{
+						Workers: []gardencorev1beta1.Worker{
+							{
+								Name:    "worker1",
+								Machine: gardencorev1beta1.Machine{Type: "machine-type"},
+								Maximum: 2,
+								Volume:  &gardencorev1beta1.Volume{Type: ptr.To("volume-type"), VolumeSize: "20Gi"},
+							},
+							{
+								Name:    "worker2",
+								Machine: gardencorev1beta1.Machine{Type: "machine-type-with-storage"},
+								Maximum: 3,
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should compute the usage based on the maximum of the worker pools", func() {
+			usage, err := ShootQuotaUsage(shoot, cloudProfile)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(usage.Cpu().Cmp(resource.MustParse("16"))).To(Equal(0))
+			Expect(usage.Name(gardencorev1beta1.QuotaMetricGPU, resource.DecimalSI).Cmp(resource.MustParse("2"))).To(Equal(0))
+			Expect(usage.Memory().Cmp(resource.MustParse("32Gi"))).To(Equal(0))
+			Expect(usage.Name(gardencorev1beta1.QuotaMetricStorageStandard, resource.BinarySI).Cmp(resource.MustParse("40Gi"))).To(Equal(0))
+			Expect(usage.Name(gardencorev1beta1.QuotaMetricStoragePremium, resource.BinarySI).Cmp(resource.MustParse("150Gi"))).To(Equal(0))
+			Expect(usage.Name(gardencorev1beta1.QuotaMetricLoadbalancer, resource.DecimalSI).Cmp(resource.MustParse("1"))).To(Equal(0))
+		})
+
+		It("should account an additional load balancer if the nginx-ingress addon is enabled", func() {
+			shoot.Spec.Addons = &gardencorev1beta1.Addons{NginxIngress: &gardencorev1beta1.NginxIngress{Addon: gardencorev1beta1.Addon{Enabled: true}}}
+
+			usage, err := ShootQuotaUsage(shoot, cloudProfile)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(usage.Name(gardencorev1beta1.QuotaMetricLoadbalancer, resource.DecimalSI).Cmp(resource.MustParse("2"))).To(Equal(0))
+		})
+
+		It("should fail if the machine type is unknown", func() {
+			shoot.Spec.Provider.Workers[0].Machine.Type = "unknown"
+
+			_, err := ShootQuotaUsage(shoot, cloudProfile)
+			Expect(err).To(MatchError("machineType unknown not found in CloudProfile profile"))
+		})
+
+		It("should fail if the volume type is unknown", func() {
+			shoot.Spec.Provider.Workers[0].Volume.Type = ptr.To("unknown")
+
+			_, err := ShootQuotaUsage(shoot, cloudProfile)
+			Expect(err).To(MatchError(ContainSubstring("not found in CloudProfile profile")))
+		})
+	})
+
+	Describe("#SumQuantities", func() {
+		It("should sum up the quantities", func() {
+			sum := SumQuantities(resource.MustParse("1"), resource.MustParse("500m"), resource.MustParse("2"))
+			Expect(sum.Cmp(resource.MustParse("3500m"))).To(Equal(0))
+		})
+	})
+})
@@ -25,7 +25,9 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/sets"
@@ -143,19 +145,59 @@ func EffectiveMaintenanceTimeWindow(timeWindow *timewindow.MaintenanceTimeWindow
 	return timeWindow.WithEnd(timeWindow.End().Add(0, -15, 0))
 }
 
-// EffectiveShootMaintenanceTimeWindow returns the effective MaintenanceTimeWindow of the given Shoot.
+// EffectiveShootMaintenanceTimeWindow returns the effective MaintenanceTimeWindow of the given Shoot. It considers the
+// weekdays and freeze periods of the Shoot's maintenance configuration.
 func EffectiveShootMaintenanceTimeWindow(shoot *gardencorev1beta1.Shoot) *timewindow.MaintenanceTimeWindow {
 	maintenance := shoot.Spec.Maintenance
-	if maintenance == nil || maintenance.TimeWindow == nil {
+	if maintenance == nil {
 		return timewindow.AlwaysTimeWindow
 	}
 
-	timeWindow, err := timewindow.ParseMaintenanceTimeWindow(maintenance.TimeWindow.Begin, maintenance.TimeWindow.End)
+	timeWindow := timewindow.AlwaysTimeWindow
+	if maintenance.TimeWindow != nil {
+		if parsed, err := timewindow.ParseMaintenanceTimeWindowWithWeekdays(maintenance.TimeWindow.Begin, maintenance.TimeWindow.End, maintenance.TimeWindow.Weekdays); err == nil {
+			timeWindow = EffectiveMaintenanceTimeWindow(parsed)
+		}
+	}
+
+	if len(maintenance.FreezePeriods) > 0 {
+		timeWindow = timeWindow.WithFreezePeriods(FreezePeriods(maintenance.FreezePeriods)...)
+	}
+
+	return timeWindow
+}
+
+// EffectiveShootMaintenanceTimeWindowWithProject returns the effective MaintenanceTimeWindow of the given Shoot which
+// additionally honors the maintenance freeze periods of the Shoot's Project.
+func EffectiveShootMaintenanceTimeWindowWithProject(ctx context.Context, reader client.Reader, shoot *gardencorev1beta1.Shoot) (*timewindow.MaintenanceTimeWindow, error) {
+	project, err := ProjectForNamespaceFromReader(ctx, reader, shoot.Namespace)
 	if err != nil {
-		return timewindow.AlwaysTimeWindow
+		if apierrors.IsNotFound(err) {
+			return EffectiveShootMaintenanceTimeWindow(shoot), nil
+		}
+		return nil, fmt.Errorf("failed reading project for namespace %q: %w", shoot.Namespace, err)
 	}
 
-	return EffectiveMaintenanceTimeWindow(timeWindow)
+	return EffectiveShootMaintenanceTimeWindowForProject(shoot, project), nil
+}
+
+// EffectiveShootMaintenanceTimeWindowForProject returns the effective MaintenanceTimeWindow of the given Shoot which
+// additionally honors the maintenance freeze periods of the given Project. The Project may be nil.
+func EffectiveShootMaintenanceTimeWindowForProject(shoot *gardencorev1beta1.Shoot, project *gardencorev1beta1.Project) *timewindow.MaintenanceTimeWindow {
+	window := EffectiveShootMaintenanceTimeWindow(shoot)
+	if project != nil && len(project.Spec.MaintenanceFreezePeriods) > 0 {
+		window = window.WithFreezePeriods(FreezePeriods(project.Spec.MaintenanceFreezePeriods)...)
+	}
+	return window
+}
+
+// FreezePeriods converts the given maintenance freeze periods to freeze periods of a MaintenanceTimeWindow.
+func FreezePeriods(freezePeriods []gardencorev1beta1.MaintenanceFreezePeriod) []timewindow.FreezePeriod {
+	out := make([]timewindow.FreezePeriod, 0, len(freezePeriods))
+	for _, freezePeriod := range freezePeriods {
+		out = append(out, timewindow.FreezePeriod{Begin: freezePeriod.Begin.UTC(), End: freezePeriod.End.UTC()})
+	}
+	return out
 }
 
 // GetShootNameFromOwnerReferences attempts to get the name of the Shoot object which owns the passed in object.
@@ -710,6 +752,51 @@ func ComputeRequiredExtensionsForShoot(shoot *gardencorev1beta1.Shoot, seed *gar
 	return requiredExtensions
 }
 
+// ComputeMissingExtensionsForMigration computes the extension kind/type combinations which are required for migrating
+// the control plane of the given shoot from the source seed to the target seed but for which no ControllerRegistration
+// can be deployed to the target seed. The DNS providers of the internal and default domains are not considered since
+// they do not depend on the shoot and are required by all shoots on the target seed anyway.
+func ComputeMissingExtensionsForMigration(shoot *gardencorev1beta1.Shoot, sourceSeed, targetSeed *gardencorev1beta1.Seed, controllerRegistrationList *gardencorev1beta1.ControllerRegistrationList) []string {
+	requiredExtensions := ComputeRequiredExtensionsForShoot(shoot, targetSeed, controllerRegistrationList, nil, nil)
+	// The etcd backups are copied from the backup bucket of the source seed while the control plane is restored.
+	if sourceSeed.Spec.Backup != nil {
+		requiredExtensions.Insert(ExtensionsID(extensionsv1alpha1.BackupEntryResource, sourceSeed.Spec.Backup.Provider))
+	}
+
+	var missingExtensions []string
+	for _, id := range sets.List(requiredExtensions) {
+		if !slices.ContainsFunc(controllerRegistrationList.Items, func(controllerRegistration gardencorev1beta1.ControllerRegistration) bool {
+			return controllerRegistrationProvidesExtensionForSeed(&controllerRegistration, id, targetSeed)
+		}) {
+			missingExtensions = append(missingExtensions, id)
+		}
+	}
+
+	return missingExtensions
+}
+
+func controllerRegistrationProvidesExtensionForSeed(controllerRegistration *gardencorev1beta1.ControllerRegistration, id string, seed *gardencorev1beta1.Seed) bool {
+	if controllerRegistration.DeletionTimestamp != nil {
+		return false
+	}
+
+	if !slices.ContainsFunc(controllerRegistration.Spec.Resources, func(resource gardencorev1beta1.ControllerResource) bool {
+		return ExtensionsID(resource.Kind, resource.Type) == id
+	}) {
+		return false
+	}
+
+	if deployment := controllerRegistration.Spec.Deployment; deployment != nil && deployment.SeedSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(deployment.SeedSelector)
+		if err != nil {
+			return false
+		}
+		return selector.Matches(labels.Set(seed.Labels))
+	}
+
+	return true
+}
+
 // ExtensionsID returns an identifier for the given extension kind/type.
 func ExtensionsID(extensionKind, extensionType string) string {
 	return fmt.Sprintf("%s/%s", extensionKind, extensionType)
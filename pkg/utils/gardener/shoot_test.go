@@ -36,9 +36,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/gardener/pkg/api/indexer"
+	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
 	. "github.com/gardener/gardener/pkg/utils/gardener"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
@@ -264,8 +267,87 @@ var _ = Describe("Shoot", func() {
 			timewindow.NewMaintenanceTimeWindow(
 				timewindow.NewMaintenanceTime(1, 0, 0),
 				timewindow.NewMaintenanceTime(1, 45, 0))),
+		Entry("valid time window with weekdays",
+			&gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Maintenance: &gardencorev1beta1.Maintenance{
+						TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{
+							Begin:    "003000+0100",
+							End:      "013000+0100",
+							Weekdays: []string{"Tuesday"},
+						},
+					},
+				},
+			},
+			timewindow.NewMaintenanceTimeWindow(
+				timewindow.NewMaintenanceTime(23, 30, 0),
+				timewindow.NewMaintenanceTime(0, 15, 0)).WithWeekdays(time.Monday)),
+		Entry("freeze periods without time window",
+			&gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Maintenance: &gardencorev1beta1.Maintenance{
+						FreezePeriods: []gardencorev1beta1.MaintenanceFreezePeriod{{
+							Begin: metav1.Date(2024, time.December, 20, 0, 0, 0, 0, time.UTC),
+							End:   metav1.Date(2025, time.January, 6, 0, 0, 0, 0, time.UTC),
+						}},
+					},
+				},
+			},
+			timewindow.AlwaysTimeWindow.WithFreezePeriods(timewindow.FreezePeriod{
+				Begin: time.Date(2024, time.December, 20, 0, 0, 0, 0, time.UTC),
+				End:   time.Date(2025, time.January, 6, 0, 0, 0, 0, time.UTC),
+			})),
 	)
 
+	Describe("#EffectiveShootMaintenanceTimeWindowWithProject", func() {
+		var (
+			ctx        = context.Background()
+			fakeClient client.Client
+			shoot      *gardencorev1beta1.Shoot
+			freezeTime = time.Date(2024, time.December, 24, 22, 30, 0, 0, time.UTC)
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().
+				WithScheme(kubernetes.GardenScheme).
+				WithIndex(&gardencorev1beta1.Project{}, core.ProjectNamespace, indexer.ProjectNamespaceIndexerFunc).
+				Build()
+
+			shoot = &gardencorev1beta1.Shoot{
+				ObjectMeta: metav1.ObjectMeta{Name: "shoot", Namespace: "garden-foo"},
+				Spec: gardencorev1beta1.ShootSpec{
+					Maintenance: &gardencorev1beta1.Maintenance{
+						TimeWindow: &gardencorev1beta1.MaintenanceTimeWindow{Begin: "220000+0000", End: "230000+0000"},
+					},
+				},
+			}
+		})
+
+		It("should return the shoot's time window if there is no project", func() {
+			window, err := EffectiveShootMaintenanceTimeWindowWithProject(ctx, fakeClient, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(window).To(Equal(EffectiveShootMaintenanceTimeWindow(shoot)))
+		})
+
+		It("should honor the freeze periods of the project", func() {
+			Expect(fakeClient.Create(ctx, &gardencorev1beta1.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "foo"},
+				Spec: gardencorev1beta1.ProjectSpec{
+					Namespace: ptr.To("garden-foo"),
+					MaintenanceFreezePeriods: []gardencorev1beta1.MaintenanceFreezePeriod{{
+						Begin: metav1.Date(2024, time.December, 20, 0, 0, 0, 0, time.UTC),
+						End:   metav1.Date(2024, time.December, 27, 0, 0, 0, 0, time.UTC),
+					}},
+				},
+			})).To(Succeed())
+
+			window, err := EffectiveShootMaintenanceTimeWindowWithProject(ctx, fakeClient, shoot)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(window.FreezePeriods()).To(HaveLen(1))
+			Expect(window.Contains(freezeTime)).To(BeFalse())
+		})
+	})
+
 	DescribeTable("#GetShootNameFromOwnerReferences",
 		func(ownerRefs []metav1.OwnerReference, expectedName string) {
 			obj := &gardencorev1beta1.BackupEntry{
@@ -1271,6 +1353,87 @@ var _ = Describe("Shoot", func() {
 		})
 	})
 
+	Describe("#ComputeMissingExtensionsForMigration", func() {
+		var (
+			shoot                      *gardencorev1beta1.Shoot
+			sourceSeed, targetSeed     *gardencorev1beta1.Seed
+			controllerRegistrationList *gardencorev1beta1.ControllerRegistrationList
+		)
+
+		BeforeEach(func() {
+			shoot = &gardencorev1beta1.Shoot{
+				Spec: gardencorev1beta1.ShootSpec{
+					Extensions: []gardencorev1beta1.Extension{{Type: "foo"}},
+				},
+			}
+			sourceSeed = &gardencorev1beta1.Seed{
+				Spec: gardencorev1beta1.SeedSpec{
+					Backup:   &gardencorev1beta1.SeedBackup{Provider: "source"},
+					Provider: gardencorev1beta1.SeedProvider{Type: "local"},
+				},
+			}
+			targetSeed = &gardencorev1beta1.Seed{
+				ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"foo": "bar"}},
+				Spec: gardencorev1beta1.SeedSpec{
+					Backup:   &gardencorev1beta1.SeedBackup{Provider: "target"},
+					Provider: gardencorev1beta1.SeedProvider{Type: "local"},
+				},
+			}
+			controllerRegistrationList = &gardencorev1beta1.ControllerRegistrationList{
+				Items: []gardencorev1beta1.ControllerRegistration{
+					{
+						Spec: gardencorev1beta1.ControllerRegistrationSpec{
+							Resources: []gardencorev1beta1.ControllerResource{
+								{Kind: extensionsv1alpha1.BackupBucketResource, Type: "target"},
+								{Kind: extensionsv1alpha1.BackupEntryResource, Type: "target"},
+								{Kind: extensionsv1alpha1.BackupEntryResource, Type: "source"},
+								{Kind: extensionsv1alpha1.ControlPlaneResource, Type: "local"},
+							},
+						},
+					},
+					{
+						Spec: gardencorev1beta1.ControllerRegistrationSpec{
+							Resources: []gardencorev1beta1.ControllerResource{
+								{Kind: extensionsv1alpha1.ExtensionResource, Type: "foo"},
+							},
+							Deployment: &gardencorev1beta1.ControllerRegistrationDeployment{
+								SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+							},
+						},
+					},
+				},
+			}
+		})
+
+		It("should return nothing if all required extensions can be deployed to the target seed", func() {
+			Expect(ComputeMissingExtensionsForMigration(shoot, sourceSeed, targetSeed, controllerRegistrationList)).To(BeEmpty())
+		})
+
+		It("should return the extensions whose ControllerRegistration does not select the target seed", func() {
+			targetSeed.Labels = nil
+
+			Expect(ComputeMissingExtensionsForMigration(shoot, sourceSeed, targetSeed, controllerRegistrationList)).To(ConsistOf(
+				ExtensionsID(extensionsv1alpha1.ExtensionResource, "foo"),
+			))
+		})
+
+		It("should return the extensions whose ControllerRegistration is being deleted", func() {
+			controllerRegistrationList.Items[1].DeletionTimestamp = &metav1.Time{}
+
+			Expect(ComputeMissingExtensionsForMigration(shoot, sourceSeed, targetSeed, controllerRegistrationList)).To(ConsistOf(
+				ExtensionsID(extensionsv1alpha1.ExtensionResource, "foo"),
+			))
+		})
+
+		It("should require the backup entry extension of the source seed", func() {
+			sourceSeed.Spec.Backup.Provider = "other"
+
+			Expect(ComputeMissingExtensionsForMigration(shoot, sourceSeed, targetSeed, controllerRegistrationList)).To(ConsistOf(
+				ExtensionsID(extensionsv1alpha1.BackupEntryResource, "other"),
+			))
+		})
+	})
+
 	Describe("#ExtensionsID", func() {
 		It("should return the expected identifier", func() {
 			Expect(ExtensionsID("foo", "bar")).To(Equal("foo/bar"))
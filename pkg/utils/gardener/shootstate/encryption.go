@@ -0,0 +1,423 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootstate
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
+	"github.com/gardener/gardener/pkg/utils"
+)
+
+const (
+	// keySize is the size of the key encryption keys and the data encryption keys (AES-256).
+	keySize = 32
+	// ciphertextField is the name of the only field of the JSON object which replaces encrypted data in ShootStates.
+	// Keys of secret data must not contain slashes, hence, the field cannot collide with persisted secret data.
+	ciphertextField = "gardener.cloud/ciphertext"
+)
+
+// Encryption contains the key encryption keys used for the envelope encryption of sensitive data persisted in
+// ShootState resources. Each ShootState gets its own data encryption key which is stored in the ShootState after
+// being wrapped with the primary key encryption key. The other key encryption keys are only used for unwrapping data
+// encryption keys which were wrapped before the primary key was rotated.
+type Encryption struct {
+	keys           map[string][]byte
+	primaryKeyName string
+}
+
+// NewEncryption returns a new Encryption for the given key encryption keys.
+func NewEncryption(keys map[string][]byte, primaryKeyName string) (*Encryption, error) {
+	if _, ok := keys[primaryKeyName]; !ok {
+		return nil, fmt.Errorf("primary key encryption key %q is not among the provided keys", primaryKeyName)
+	}
+
+	for name, key := range keys {
+		if len(key) != keySize {
+			return nil, fmt.Errorf("key encryption key %q must have %d bytes but has %d", name, keySize, len(key))
+		}
+	}
+
+	return &Encryption{keys: keys, primaryKeyName: primaryKeyName}, nil
+}
+
+// GetEncryption reads the key encryption keys from the secret with the given name and namespace and returns a new
+// Encryption for them.
+func GetEncryption(ctx context.Context, c client.Reader, namespace, secretName, primaryKeyName string) (*Encryption, error) {
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: secretName}, secret); err != nil {
+		return nil, fmt.Errorf("failed reading secret %s with key encryption keys for ShootStates: %w", client.ObjectKey{Namespace: namespace, Name: secretName}, err)
+	}
+
+	return NewEncryption(secret.Data, primaryKeyName)
+}
+
+// Decrypt decrypts all data in the given ShootState which was encrypted with its data encryption key. It is a no-op
+// for ShootStates without a data encryption key. An error is returned if the ShootState contains a data encryption key
+// but the given Encryption is nil or does not contain the key encryption key used for wrapping it.
+func Decrypt(shootState *gardencorev1beta1.ShootState, encryption *Encryption) error {
+	dataKeyData := getDataEncryptionKey(shootState)
+	if dataKeyData == nil {
+		return nil
+	}
+
+	if encryption == nil {
+		return fmt.Errorf("ShootState %s contains encrypted data but no key encryption keys are configured", client.ObjectKeyFromObject(shootState))
+	}
+
+	dataKey, err := encryption.unwrap(shootState, dataKeyData.Data)
+	if err != nil {
+		return fmt.Errorf("failed unwrapping data encryption key of ShootState %s: %w", client.ObjectKeyFromObject(shootState), err)
+	}
+
+	return transformSpec(&shootState.Spec, func(entryKey string, raw *runtime.RawExtension) error {
+		return decryptRawExtension(dataKey, additionalData(shootState, entryKey), raw)
+	})
+}
+
+// MissingKeyEncryptionKey returns the name of the key encryption key which is needed for unwrapping the data encryption
+// key of the given ShootState but which is not available to the gardenlet of the target seed, as reported in the
+// seeds' statuses. If the ShootState is nil or does not have a data encryption key yet then the primary key of the
+// source seed is considered since the gardenlet of the source seed wraps the data encryption key with it when it
+// persists the state of the shoot. An empty name is returned if the target seed has the needed key (or no key is
+// needed at all). Keys are matched by their fingerprints.
+func MissingKeyEncryptionKey(shootState *gardencorev1beta1.ShootState, sourceSeed, targetSeed *gardencorev1beta1.Seed) (string, error) {
+	var keyName, keyFingerprint string
+
+	if dataKeyData := getDataEncryptionKey(shootState); dataKeyData != nil {
+		wrapped := wrappedDataEncryptionKey{}
+		if err := json.Unmarshal(dataKeyData.Data.Raw, &wrapped); err != nil {
+			return "", fmt.Errorf("failed unmarshalling data encryption key of ShootState %s: %w", client.ObjectKeyFromObject(shootState), err)
+		}
+		keyName, keyFingerprint = wrapped.KeyName, wrapped.KeyFingerprint
+	} else {
+		for _, key := range sourceSeed.Status.ShootStateEncryptionKeys {
+			if key.Primary {
+				keyName, keyFingerprint = key.Name, key.Fingerprint
+				break
+			}
+		}
+	}
+
+	if keyFingerprint == "" {
+		return "", nil
+	}
+
+	for _, key := range targetSeed.Status.ShootStateEncryptionKeys {
+		if key.Fingerprint == keyFingerprint {
+			return "", nil
+		}
+	}
+	return keyName, nil
+}
+
+func getDataEncryptionKey(shootState *gardencorev1beta1.ShootState) *gardencorev1beta1.GardenerResourceData {
+	if shootState == nil {
+		return nil
+	}
+
+	gardenerData := v1beta1helper.GardenerResourceDataList(shootState.Spec.Gardener)
+	if data := gardenerData.Get(v1beta1constants.DataTypeDataEncryptionKey); data != nil && data.Type == v1beta1constants.DataTypeDataEncryptionKey {
+		return data
+	}
+	return nil
+}
+
+// wrappedDataEncryptionKey is the format in which a data encryption key is persisted in ShootStates.
+type wrappedDataEncryptionKey struct {
+	// KeyName is the name of the key encryption key used for wrapping the data encryption key.
+	KeyName string `json:"keyName"`
+	// KeyFingerprint is the fingerprint of the key encryption key used for wrapping the data encryption key.
+	KeyFingerprint string `json:"keyFingerprint"`
+	// WrappedKey is the data encryption key encrypted with the key encryption key.
+	WrappedKey []byte `json:"wrappedKey"`
+}
+
+// dataEncryptionKey returns the data encryption key to use for the given ShootState. If reuse is true and the
+// ShootState already has a data encryption key then it gets unwrapped and returned, otherwise a new key is generated.
+func (e *Encryption) dataEncryptionKey(shootState *gardencorev1beta1.ShootState, reuse bool) ([]byte, error) {
+	if dataKeyData := getDataEncryptionKey(shootState); reuse && dataKeyData != nil {
+		return e.unwrap(shootState, dataKeyData.Data)
+	}
+
+	dataKey := make([]byte, keySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, fmt.Errorf("failed generating data encryption key: %w", err)
+	}
+	return dataKey, nil
+}
+
+// wrap encrypts the given data encryption key of the given ShootState with the primary key encryption key.
+func (e *Encryption) wrap(shootState *gardencorev1beta1.ShootState, dataKey []byte) (*gardencorev1beta1.GardenerResourceData, error) {
+	primaryKey := e.keys[e.primaryKeyName]
+
+	wrappedKey, err := seal(primaryKey, dataKey, additionalData(shootState, dataEncryptionKeyEntry))
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := json.Marshal(wrappedDataEncryptionKey{KeyName: e.primaryKeyName, KeyFingerprint: fingerprint(primaryKey), WrappedKey: wrappedKey})
+	if err != nil {
+		return nil, err
+	}
+
+	return &gardencorev1beta1.GardenerResourceData{
+		Name: v1beta1constants.DataTypeDataEncryptionKey,
+		Type: v1beta1constants.DataTypeDataEncryptionKey,
+		Data: runtime.RawExtension{Raw: raw},
+	}, nil
+}
+
+// unwrap decrypts the given persisted data encryption key of the given ShootState with the key encryption key it was
+// wrapped with. The key encryption key is looked up by its fingerprint, hence, it may have a different name in this
+// Encryption.
+func (e *Encryption) unwrap(shootState *gardencorev1beta1.ShootState, data runtime.RawExtension) ([]byte, error) {
+	wrapped := wrappedDataEncryptionKey{}
+	if err := json.Unmarshal(data.Raw, &wrapped); err != nil {
+		return nil, fmt.Errorf("failed unmarshalling data encryption key: %w", err)
+	}
+
+	for _, key := range e.keys {
+		if fingerprint(key) == wrapped.KeyFingerprint {
+			return open(key, wrapped.WrappedKey, additionalData(shootState, dataEncryptionKeyEntry))
+		}
+	}
+
+	return nil, fmt.Errorf("key encryption key %q (fingerprint %q) used for wrapping the data encryption key is unknown (known keys: %v)", wrapped.KeyName, wrapped.KeyFingerprint, e.KeyNames())
+}
+
+// Keys returns the key encryption keys sorted by their names. The keys themselves are not returned but only their
+// fingerprints.
+func (e *Encryption) Keys() []gardencorev1beta1.ShootStateEncryptionKey {
+	keys := make([]gardencorev1beta1.ShootStateEncryptionKey, 0, len(e.keys))
+	for _, name := range e.KeyNames() {
+		keys = append(keys, gardencorev1beta1.ShootStateEncryptionKey{
+			Name:        name,
+			Fingerprint: fingerprint(e.keys[name]),
+			Primary:     name == e.primaryKeyName,
+		})
+	}
+	return keys
+}
+
+// KeyNames returns the sorted names of the key encryption keys.
+func (e *Encryption) KeyNames() []string {
+	names := make([]string, 0, len(e.keys))
+	for name := range e.keys {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// fingerprint returns the fingerprint of the given key encryption key.
+func fingerprint(key []byte) string {
+	return utils.ComputeSHA256Hex(key)
+}
+
+// dataEncryptionKeyEntry is the entry key of the data encryption key in ShootStates, see transformSpec.
+var dataEncryptionKeyEntry = gardenerDataEntry(v1beta1constants.DataTypeDataEncryptionKey)
+
+// additionalData returns the additional data which is authenticated along with the data of the entry with the given
+// key in the given ShootState. It binds the encrypted data to its location, i.e., encrypted data cannot be moved to
+// another entry or to the ShootState of another shoot without failing the decryption.
+func additionalData(shootState metav1.Object, entryKey string) []byte {
+	return []byte(shootState.GetNamespace() + "/" + shootState.GetName() + "/" + entryKey)
+}
+
+func gardenerDataEntry(name string) string {
+	return "gardener/" + name
+}
+
+func extensionEntry(extension gardencorev1beta1.ExtensionResourceState) string {
+	return "extensions/" + extension.Kind + "/" + ptr.Deref(extension.Name, "") + "/" + ptr.Deref(extension.Purpose, "")
+}
+
+func resourceEntry(resource gardencorev1beta1.ResourceData) string {
+	return "resources/" + resource.APIVersion + "/" + resource.Kind + "/" + resource.Name
+}
+
+// encryptSpec encrypts the sensitive data in the given spec of the given ShootState with the given data encryption
+// key. These are the persisted secrets, the states of the extensions, and the resources referenced by the extensions.
+func encryptSpec(dataKey []byte, shootState *gardencorev1beta1.ShootState, spec *gardencorev1beta1.ShootStateSpec) error {
+	encrypt := func(entryKey string, raw *runtime.RawExtension) error {
+		return encryptRawExtension(dataKey, additionalData(shootState, entryKey), raw)
+	}
+
+	for i := range spec.Gardener {
+		if spec.Gardener[i].Type != v1beta1constants.DataTypeSecret {
+			continue
+		}
+		if err := encrypt(gardenerDataEntry(spec.Gardener[i].Name), &spec.Gardener[i].Data); err != nil {
+			return fmt.Errorf("failed encrypting data %q: %w", spec.Gardener[i].Name, err)
+		}
+	}
+
+	return transformExtensionsAndResources(spec, encrypt)
+}
+
+// transformSpec applies the given function to all data in the given ShootState spec which might be encrypted. The
+// function is called with a key which uniquely identifies the entry of the data in the spec.
+func transformSpec(spec *gardencorev1beta1.ShootStateSpec, fn func(string, *runtime.RawExtension) error) error {
+	for i := range spec.Gardener {
+		if err := fn(gardenerDataEntry(spec.Gardener[i].Name), &spec.Gardener[i].Data); err != nil {
+			return fmt.Errorf("failed transforming data %q: %w", spec.Gardener[i].Name, err)
+		}
+	}
+
+	return transformExtensionsAndResources(spec, fn)
+}
+
+func transformExtensionsAndResources(spec *gardencorev1beta1.ShootStateSpec, fn func(string, *runtime.RawExtension) error) error {
+	for i := range spec.Extensions {
+		if spec.Extensions[i].State == nil {
+			continue
+		}
+		if err := fn(extensionEntry(spec.Extensions[i]), spec.Extensions[i].State); err != nil {
+			return fmt.Errorf("failed transforming state of extension %s/%s: %w", spec.Extensions[i].Kind, ptr.Deref(spec.Extensions[i].Name, ""), err)
+		}
+	}
+
+	for i := range spec.Resources {
+		if err := fn(resourceEntry(spec.Resources[i]), &spec.Resources[i].Data); err != nil {
+			return fmt.Errorf("failed transforming resource %s/%s: %w", spec.Resources[i].Kind, spec.Resources[i].Name, err)
+		}
+	}
+
+	return nil
+}
+
+func encryptRawExtension(dataKey, additionalData []byte, raw *runtime.RawExtension) error {
+	plaintext := raw.Raw
+	if plaintext == nil && raw.Object != nil {
+		var err error
+		if plaintext, err = json.Marshal(raw.Object); err != nil {
+			return err
+		}
+	}
+
+	if len(plaintext) == 0 {
+		return nil
+	}
+	if _, encrypted := ciphertextOf(plaintext); encrypted {
+		return nil
+	}
+
+	ciphertext, err := seal(dataKey, plaintext, additionalData)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(map[string][]byte{ciphertextField: ciphertext})
+	if err != nil {
+		return err
+	}
+
+	*raw = runtime.RawExtension{Raw: data}
+	return nil
+}
+
+func decryptRawExtension(dataKey, additionalData []byte, raw *runtime.RawExtension) error {
+	ciphertext, encrypted := ciphertextOf(raw.Raw)
+	if !encrypted {
+		return nil
+	}
+
+	plaintext, err := open(dataKey, ciphertext, additionalData)
+	if err != nil {
+		return err
+	}
+
+	*raw = runtime.RawExtension{Raw: plaintext}
+	return nil
+}
+
+// ciphertextOf returns the ciphertext and true if the given data is a JSON object which only contains the ciphertext
+// field. Otherwise, it returns false.
+func ciphertextOf(data []byte) ([]byte, bool) {
+	obj := map[string]json.RawMessage{}
+	if err := json.Unmarshal(data, &obj); err != nil || len(obj) != 1 {
+		return nil, false
+	}
+
+	field, ok := obj[ciphertextField]
+	if !ok {
+		return nil, false
+	}
+
+	var ciphertext []byte
+	if err := json.Unmarshal(field, &ciphertext); err != nil {
+		return nil, false
+	}
+	return ciphertext, true
+}
+
+// seal encrypts the given plaintext and authenticates it along with the given additional data with AES-GCM. It returns
+// the nonce followed by the ciphertext.
+func seal(key, plaintext, additionalData []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("failed generating nonce: %w", err)
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// open decrypts the given data which must consist of the nonce followed by the ciphertext. The given additional data
+// must be the same as the one used for encrypting the data.
+func open(key, data, additionalData []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) < aead.NonceSize() {
+		return nil, fmt.Errorf("encrypted data is too short")
+	}
+
+	plaintext, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], additionalData)
+	if err != nil {
+		return nil, fmt.Errorf("failed decrypting data: %w", err)
+	}
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,181 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shootstate_test
+
+import (
+	"bytes"
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils"
+	. "github.com/gardener/gardener/pkg/utils/gardener/shootstate"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Encryption", func() {
+	var key = bytes.Repeat([]byte("k"), 32)
+
+	Describe("#NewEncryption", func() {
+		It("should succeed for valid keys", func() {
+			Expect(NewEncryption(map[string][]byte{"key1": key, "key2": key}, "key2")).NotTo(BeNil())
+		})
+
+		It("should fail when the primary key is not among the keys", func() {
+			_, err := NewEncryption(map[string][]byte{"key1": key}, "key2")
+			Expect(err).To(MatchError(ContainSubstring(`primary key encryption key "key2" is not among the provided keys`)))
+		})
+
+		It("should fail when a key has an invalid size", func() {
+			_, err := NewEncryption(map[string][]byte{"key1": key, "key2": []byte("too-short")}, "key1")
+			Expect(err).To(MatchError(ContainSubstring(`key encryption key "key2" must have 32 bytes but has 9`)))
+		})
+	})
+
+	Describe("#KeyNames", func() {
+		It("should return the sorted key names", func() {
+			encryption, err := NewEncryption(map[string][]byte{"key2": key, "key1": key}, "key2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encryption.KeyNames()).To(Equal([]string{"key1", "key2"}))
+		})
+	})
+
+	Describe("#Keys", func() {
+		It("should return the sorted keys with their fingerprints", func() {
+			otherKey := bytes.Repeat([]byte("o"), 32)
+
+			encryption, err := NewEncryption(map[string][]byte{"key2": key, "key1": otherKey}, "key2")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(encryption.Keys()).To(Equal([]gardencorev1beta1.ShootStateEncryptionKey{
+				{Name: "key1", Fingerprint: utils.ComputeSHA256Hex(otherKey)},
+				{Name: "key2", Fingerprint: utils.ComputeSHA256Hex(key), Primary: true},
+			}))
+		})
+	})
+
+	Describe("#MissingKeyEncryptionKey", func() {
+		var (
+			shootState             *gardencorev1beta1.ShootState
+			sourceSeed, targetSeed *gardencorev1beta1.Seed
+		)
+
+		BeforeEach(func() {
+			shootState = &gardencorev1beta1.ShootState{
+				Spec: gardencorev1beta1.ShootStateSpec{
+					Gardener: []gardencorev1beta1.GardenerResourceData{{
+						Name: "data-encryption-key",
+						Type: "data-encryption-key",
+						Data: runtime.RawExtension{Raw: []byte(`{"keyName":"key1","keyFingerprint":"fingerprint1","wrappedKey":""}`)},
+					}},
+				},
+			}
+			sourceSeed = &gardencorev1beta1.Seed{Status: gardencorev1beta1.SeedStatus{ShootStateEncryptionKeys: []gardencorev1beta1.ShootStateEncryptionKey{
+				{Name: "key1", Fingerprint: "fingerprint1"},
+				{Name: "key2", Fingerprint: "fingerprint2", Primary: true},
+			}}}
+			targetSeed = &gardencorev1beta1.Seed{Status: gardencorev1beta1.SeedStatus{ShootStateEncryptionKeys: []gardencorev1beta1.ShootStateEncryptionKey{
+				{Name: "key2", Fingerprint: "fingerprint2"},
+			}}}
+		})
+
+		It("should return the key used for the data encryption key of the ShootState if the target seed lacks it", func() {
+			Expect(MissingKeyEncryptionKey(shootState, sourceSeed, targetSeed)).To(Equal("key1"))
+		})
+
+		It("should return nothing if the target seed has the key used for the ShootState under a different name", func() {
+			targetSeed.Status.ShootStateEncryptionKeys = []gardencorev1beta1.ShootStateEncryptionKey{{Name: "other", Fingerprint: "fingerprint1"}}
+			Expect(MissingKeyEncryptionKey(shootState, sourceSeed, targetSeed)).To(BeEmpty())
+		})
+
+		It("should not match keys by their names", func() {
+			targetSeed.Status.ShootStateEncryptionKeys = []gardencorev1beta1.ShootStateEncryptionKey{{Name: "key1", Fingerprint: "other"}}
+			Expect(MissingKeyEncryptionKey(shootState, sourceSeed, targetSeed)).To(Equal("key1"))
+		})
+
+		It("should consider the primary key of the source seed if there is no ShootState", func() {
+			Expect(MissingKeyEncryptionKey(nil, sourceSeed, targetSeed)).To(BeEmpty())
+
+			targetSeed.Status.ShootStateEncryptionKeys = nil
+			Expect(MissingKeyEncryptionKey(nil, sourceSeed, targetSeed)).To(Equal("key2"))
+		})
+
+		It("should consider the primary key of the source seed if the ShootState has no data encryption key", func() {
+			shootState.Spec.Gardener = nil
+			Expect(MissingKeyEncryptionKey(shootState, sourceSeed, targetSeed)).To(BeEmpty())
+		})
+
+		It("should return nothing if no key is needed", func() {
+			sourceSeed.Status.ShootStateEncryptionKeys = nil
+			Expect(MissingKeyEncryptionKey(nil, sourceSeed, targetSeed)).To(BeEmpty())
+		})
+
+		It("should fail if the data encryption key of the ShootState cannot be read", func() {
+			shootState.Spec.Gardener[0].Data.Raw = []byte(`{`)
+			_, err := MissingKeyEncryptionKey(shootState, sourceSeed, targetSeed)
+			Expect(err).To(MatchError(ContainSubstring("failed unmarshalling data encryption key")))
+		})
+	})
+
+	Describe("#GetEncryption", func() {
+		var (
+			ctx        = context.TODO()
+			fakeClient client.Client
+		)
+
+		BeforeEach(func() {
+			fakeClient = fakeclient.NewClientBuilder().WithScheme(kubernetes.SeedScheme).Build()
+		})
+
+		It("should read the keys from the secret", func() {
+			Expect(fakeClient.Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "shootstate-encryption", Namespace: "garden"},
+				Data:       map[string][]byte{"key1": key},
+			})).To(Succeed())
+
+			Expect(GetEncryption(ctx, fakeClient, "garden", "shootstate-encryption", "key1")).NotTo(BeNil())
+		})
+
+		It("should fail when the secret does not exist", func() {
+			_, err := GetEncryption(ctx, fakeClient, "garden", "shootstate-encryption", "key1")
+			Expect(err).To(BeNotFoundError())
+		})
+	})
+
+	Describe("#Decrypt", func() {
+		It("should do nothing for ShootStates without data encryption key", func() {
+			shootState := &gardencorev1beta1.ShootState{
+				Spec: gardencorev1beta1.ShootStateSpec{
+					Gardener: []gardencorev1beta1.GardenerResourceData{{
+						Name: "secret",
+						Type: "secret",
+						Data: runtime.RawExtension{Raw: []byte(`{"gardener.cloud/ciphertext":"Zm9v"}`)},
+					}},
+				},
+			}
+			expected := shootState.DeepCopy()
+
+			Expect(Decrypt(shootState, nil)).To(Succeed())
+			Expect(shootState).To(Equal(expected))
+		})
+	})
+})
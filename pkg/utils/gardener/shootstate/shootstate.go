@@ -41,8 +41,10 @@ import (
 )
 
 // Deploy deploys the ShootState resource with the effective state for the given shoot into the garden
-// cluster.
-func Deploy(ctx context.Context, clock clock.Clock, gardenClient, seedClient client.Client, shoot *gardencorev1beta1.Shoot, overwriteSpec bool) error {
+// cluster. If an encryption is given then the sensitive data is encrypted with the data encryption key of the
+// ShootState, and the data encryption key is (re-)wrapped with the primary key encryption key. When the spec is
+// overwritten, a new data encryption key is generated, otherwise the existing one is reused.
+func Deploy(ctx context.Context, clock clock.Clock, gardenClient, seedClient client.Client, shoot *gardencorev1beta1.Shoot, overwriteSpec bool, encryption *Encryption) error {
 	shootState := &gardencorev1beta1.ShootState{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      shoot.Name,
@@ -58,19 +60,37 @@ func Deploy(ctx context.Context, clock clock.Clock, gardenClient, seedClient cli
 	_, err = controllerutils.GetAndCreateOrStrategicMergePatch(ctx, gardenClient, shootState, func() error {
 		metav1.SetMetaDataAnnotation(&shootState.ObjectMeta, v1beta1constants.GardenerTimestamp, clock.Now().UTC().Format(time.RFC3339))
 
+		newSpec := spec.DeepCopy()
+		if encryption != nil {
+			dataKey, err := encryption.dataEncryptionKey(shootState, !overwriteSpec)
+			if err != nil {
+				return fmt.Errorf("failed determining data encryption key: %w", err)
+			}
+
+			if err := encryptSpec(dataKey, shootState, newSpec); err != nil {
+				return fmt.Errorf("failed encrypting data: %w", err)
+			}
+
+			wrappedDataKey, err := encryption.wrap(shootState, dataKey)
+			if err != nil {
+				return fmt.Errorf("failed wrapping data encryption key: %w", err)
+			}
+			newSpec.Gardener = append(newSpec.Gardener, *wrappedDataKey)
+		}
+
 		if overwriteSpec {
-			shootState.Spec = *spec
+			shootState.Spec = *newSpec
 			return nil
 		}
 
 		gardenerData := v1beta1helper.GardenerResourceDataList(shootState.Spec.Gardener)
-		for _, data := range spec.Gardener {
+		for _, data := range newSpec.Gardener {
 			gardenerData.Upsert(data.DeepCopy())
 		}
 		shootState.Spec.Gardener = gardenerData
 
 		extensionsData := v1beta1helper.ExtensionResourceStateList(shootState.Spec.Extensions)
-		for _, data := range spec.Extensions {
+		for _, data := range newSpec.Extensions {
 			extensionsData.Upsert(data.DeepCopy())
 		}
 		// Temporarily not persist the Worker state since this data is already explicitly persisted by gardenlet in `.spec.gardener[]`.
@@ -79,7 +99,7 @@ func Deploy(ctx context.Context, clock clock.Clock, gardenClient, seedClient cli
 		shootState.Spec.Extensions = extensionsData
 
 		resourcesData := v1beta1helper.ResourceDataList(shootState.Spec.Resources)
-		for _, data := range spec.Resources {
+		for _, data := range newSpec.Resources {
 			resourcesData.Upsert(data.DeepCopy())
 		}
 		shootState.Spec.Resources = resourcesData
@@ -15,6 +15,7 @@
 package shootstate_test
 
 import (
+	"bytes"
 	"context"
 	"time"
 
@@ -33,8 +34,10 @@ import (
 
 	"github.com/gardener/gardener/pkg/api/extensions"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"github.com/gardener/gardener/pkg/utils"
 	. "github.com/gardener/gardener/pkg/utils/gardener/shootstate"
 	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
@@ -76,7 +79,7 @@ var _ = Describe("ShootState", func() {
 
 	Describe("#Deploy", func() {
 		It("should deploy an empty ShootState when there is nothing to persist", func() {
-			Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true)).To(Succeed())
+			Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true, nil)).To(Succeed())
 			Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
 			Expect(shootState.Spec).To(Equal(gardencorev1beta1.ShootStateSpec{
 				Gardener: []gardencorev1beta1.GardenerResourceData{{Name: "machine-state", Type: "machine-state"}},
@@ -222,13 +225,13 @@ var _ = Describe("ShootState", func() {
 			})
 
 			It("should compute the expected spec for both gardener and extensions data and overwrite the spec", func() {
-				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true)).To(Succeed())
+				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true, nil)).To(Succeed())
 				Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
 				Expect(shootState.Spec).To(Equal(expectedSpec))
 			})
 
 			It("should compute the expected spec for both gardener and extensions data and keep existing data in the spec", func() {
-				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, false)).To(Succeed())
+				Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, false, nil)).To(Succeed())
 				Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
 
 				expectedSpec.Gardener = append(existingGardenerData, expectedSpec.Gardener...)
@@ -236,6 +239,118 @@ var _ = Describe("ShootState", func() {
 				expectedSpec.Resources = append(existingResourcesData, expectedSpec.Resources...)
 				Expect(shootState.Spec).To(Equal(expectedSpec))
 			})
+
+			Context("with encryption", func() {
+				var (
+					key1, key2 = bytes.Repeat([]byte("1"), 32), bytes.Repeat([]byte("2"), 32)
+					encryption *Encryption
+
+					plaintextSpec = func() gardencorev1beta1.ShootStateSpec {
+						plaintextShootState := &gardencorev1beta1.ShootState{}
+						Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true, nil)).To(Succeed())
+						Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), plaintextShootState)).To(Succeed())
+						return plaintextShootState.Spec
+					}
+				)
+
+				BeforeEach(func() {
+					var err error
+					encryption, err = NewEncryption(map[string][]byte{"key1": key1}, "key1")
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("should encrypt the sensitive data and decrypt it again", func() {
+					Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true, encryption)).To(Succeed())
+					Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+
+					for _, data := range shootState.Spec.Gardener {
+						switch data.Type {
+						case "secret":
+							Expect(string(data.Data.Raw)).To(HavePrefix(`{"gardener.cloud/ciphertext":`), data.Name)
+						case "data-encryption-key":
+							Expect(string(data.Data.Raw)).To(HavePrefix(`{"keyFingerprint":"` + utils.ComputeSHA256Hex(key1) + `","keyName":"key1","wrappedKey":`))
+						}
+					}
+					for _, data := range shootState.Spec.Extensions {
+						Expect(string(data.State.Raw)).To(HavePrefix(`{"gardener.cloud/ciphertext":`), data.Kind)
+					}
+					for _, data := range shootState.Spec.Resources {
+						Expect(string(data.Data.Raw)).To(HavePrefix(`{"gardener.cloud/ciphertext":`), data.Name)
+					}
+
+					Expect(Decrypt(shootState, encryption)).To(Succeed())
+					Expect(withoutDataEncryptionKey(shootState.Spec)).To(Equal(plaintextSpec()))
+				})
+
+				It("should fail decrypting when no encryption is configured", func() {
+					Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true, encryption)).To(Succeed())
+					Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+
+					Expect(Decrypt(shootState, nil)).To(MatchError(ContainSubstring("contains encrypted data but no key encryption keys are configured")))
+				})
+
+				It("should reuse the data encryption key and re-wrap it with the new primary key when keeping existing data", func() {
+					Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true, encryption)).To(Succeed())
+
+					rotatedEncryption, err := NewEncryption(map[string][]byte{"key1": key1, "key2": key2}, "key2")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, false, rotatedEncryption)).To(Succeed())
+					Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+
+					onlyNewEncryption, err := NewEncryption(map[string][]byte{"key2": key2}, "key2")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(Decrypt(shootState, onlyNewEncryption)).To(Succeed())
+					Expect(withoutDataEncryptionKey(shootState.Spec)).To(Equal(plaintextSpec()))
+				})
+
+				It("should fail keeping existing data when the data encryption key cannot be unwrapped", func() {
+					Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true, encryption)).To(Succeed())
+
+					otherEncryption, err := NewEncryption(map[string][]byte{"key2": key2}, "key2")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, false, otherEncryption)).To(MatchError(ContainSubstring(`key encryption key "key1" (fingerprint "` + utils.ComputeSHA256Hex(key1) + `") used for wrapping the data encryption key is unknown`)))
+				})
+
+				It("should find the key encryption key by its fingerprint when it has a different name", func() {
+					Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true, encryption)).To(Succeed())
+					Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+
+					renamedEncryption, err := NewEncryption(map[string][]byte{"renamed": key1}, "renamed")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(Decrypt(shootState, renamedEncryption)).To(Succeed())
+					Expect(withoutDataEncryptionKey(shootState.Spec)).To(Equal(plaintextSpec()))
+				})
+
+				It("should fail decrypting when the data is moved to the ShootState of another shoot", func() {
+					Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true, encryption)).To(Succeed())
+					Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+
+					shootState.Name = "other-shoot"
+					Expect(Decrypt(shootState, encryption)).To(MatchError(ContainSubstring("failed unwrapping data encryption key")))
+				})
+
+				It("should fail decrypting when the data is moved to another entry of the ShootState", func() {
+					Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true, encryption)).To(Succeed())
+					Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+
+					Expect(shootState.Spec.Extensions).NotTo(BeEmpty())
+					Expect(shootState.Spec.Resources).NotTo(BeEmpty())
+					shootState.Spec.Resources[0].Data = *shootState.Spec.Extensions[0].State.DeepCopy()
+					Expect(Decrypt(shootState, encryption)).To(MatchError(ContainSubstring("failed decrypting data")))
+				})
+
+				It("should generate a new data encryption key when overwriting the spec", func() {
+					Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true, encryption)).To(Succeed())
+
+					otherEncryption, err := NewEncryption(map[string][]byte{"key2": key2}, "key2")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(Deploy(ctx, fakeClock, fakeGardenClient, fakeSeedClient, shoot, true, otherEncryption)).To(Succeed())
+					Expect(fakeGardenClient.Get(ctx, client.ObjectKeyFromObject(shootState), shootState)).To(Succeed())
+
+					Expect(Decrypt(shootState, otherEncryption)).To(Succeed())
+					Expect(withoutDataEncryptionKey(shootState.Spec)).To(Equal(plaintextSpec()))
+				})
+			})
 		})
 	})
 
@@ -379,3 +494,10 @@ func createMachineObjects(
 	}
 	ExpectWithOffset(1, fakeSeedClient.Create(ctx, machineDeployment2)).To(Succeed())
 }
+
+func withoutDataEncryptionKey(spec gardencorev1beta1.ShootStateSpec) gardencorev1beta1.ShootStateSpec {
+	gardenerData := v1beta1helper.GardenerResourceDataList(spec.Gardener)
+	gardenerData.Delete("data-encryption-key")
+	spec.Gardener = gardenerData
+	return spec
+}
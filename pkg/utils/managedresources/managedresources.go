@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
 	"strings"
 	"time"
 
@@ -32,7 +33,9 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	resourcesv1alpha1helper "github.com/gardener/gardener/pkg/apis/resources/v1alpha1/helper"
 	"github.com/gardener/gardener/pkg/chartrenderer"
+	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/utils/chart"
 	errorsutils "github.com/gardener/gardener/pkg/utils/errors"
 	"github.com/gardener/gardener/pkg/utils/imagevector"
@@ -101,6 +104,102 @@ func NewSecret(client client.Client, namespace, name string, data map[string][]b
 		Unique()
 }
 
+var (
+	// CompressionThreshold is the size (in bytes) of the data of a ManagedResource secret above which the values are
+	// compressed if the CompressManagedResourceSecrets feature gate is enabled.
+	CompressionThreshold = 256 * 1024
+	// MaxSecretDataSize is the maximum size (in bytes) of the data of a single ManagedResource secret. If the compressed
+	// data exceeds this size, it is sharded over multiple secrets. It leaves some headroom to the 1 MiB limit of etcd
+	// for the metadata of the secret.
+	MaxSecretDataSize = 900 * 1024
+	// CompressionAlgorithm is the algorithm used for compressing the data of ManagedResource secrets.
+	CompressionAlgorithm = resourcesv1alpha1.CompressionAlgorithmGzip
+)
+
+// NewSecrets initiates new immutable Secret objects which can be reconciled. If the CompressManagedResourceSecrets
+// feature gate is enabled and the size of the given data exceeds CompressionThreshold, the values are compressed with
+// CompressionAlgorithm. Compression is opt-in because gardener-resource-manager versions which do not know about
+// compressed secrets cannot read them. If the compressed data still exceeds MaxSecretDataSize, it is sharded over
+// multiple secrets. The keys are distributed in sorted order, i.e., the
+// gardener-resource-manager reads the data in the same order as if it was stored in a single secret.
+func NewSecrets(client client.Client, namespace, name string, data map[string][]byte, secretNameWithPrefix bool) ([]string, []*builder.Secret, error) {
+	if !compressionEnabled() || dataSize(data) <= CompressionThreshold {
+		secretName, secret := NewSecret(client, namespace, name, data, secretNameWithPrefix)
+		return []string{secretName}, []*builder.Secret{secret}, nil
+	}
+
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	var (
+		shards      []map[string][]byte
+		currentSize int
+	)
+
+	for _, key := range keys {
+		compressed, err := resourcesv1alpha1helper.Compress(CompressionAlgorithm, data[key])
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed compressing value of key %q: %w", key, err)
+		}
+
+		size := len(key) + len(compressed)
+		if size > MaxSecretDataSize {
+			return nil, nil, fmt.Errorf("compressed value of key %q exceeds the maximum secret data size (%d > %d bytes)", key, size, MaxSecretDataSize)
+		}
+
+		if len(shards) == 0 || currentSize+size > MaxSecretDataSize {
+			shards = append(shards, map[string][]byte{})
+			currentSize = 0
+		}
+
+		shards[len(shards)-1][key] = compressed
+		currentSize += size
+	}
+
+	var (
+		secretNames = make([]string, 0, len(shards))
+		secrets     = make([]*builder.Secret, 0, len(shards))
+	)
+
+	for i, shard := range shards {
+		shardName := secretName(name, secretNameWithPrefix)
+		if i > 0 {
+			shardName += fmt.Sprintf("-%d", i)
+		}
+
+		secretName, secret := builder.NewSecret(client).
+			WithNamespacedName(namespace, shardName).
+			WithAnnotations(map[string]string{resourcesv1alpha1.CompressionAlgorithm: CompressionAlgorithm}).
+			WithKeyValues(shard).
+			Unique()
+
+		secretNames = append(secretNames, secretName)
+		secrets = append(secrets, secret)
+	}
+
+	return secretNames, secrets, nil
+}
+
+// compressionEnabled returns whether the CompressManagedResourceSecrets feature gate is enabled. Components which
+// do not register this feature gate (e.g., extensions) never compress the data of ManagedResource secrets.
+func compressionEnabled() bool {
+	if _, registered := features.DefaultFeatureGate.GetAll()[features.CompressManagedResourceSecrets]; !registered {
+		return false
+	}
+	return features.DefaultFeatureGate.Enabled(features.CompressManagedResourceSecrets)
+}
+
+func dataSize(data map[string][]byte) int {
+	var size int
+	for key, value := range data {
+		size += len(key) + len(value)
+	}
+	return size
+}
+
 // secretName returns the name of a corev1.Secret for the given name of a resourcesv1alpha1.ManagedResource. If
 // <withPrefix> is set then the name will be prefixed with 'managedresource-'.
 func secretName(name string, withPrefix bool) string {
@@ -150,12 +249,13 @@ func Update(
 	injectedLabels map[string]string,
 	forceOverwriteAnnotations *bool,
 ) error {
-	var (
-		secretName, secret = NewSecret(client, namespace, name, data, secretNameWithPrefix)
-		managedResource    = New(client, namespace, name, class, keepObjects, labels, injectedLabels, forceOverwriteAnnotations).WithSecretRef(secretName).CreateIfNotExists(false)
-	)
+	secretNames, secrets, err := NewSecrets(client, namespace, name, data, secretNameWithPrefix)
+	if err != nil {
+		return err
+	}
 
-	return deployManagedResource(ctx, secret, managedResource)
+	managedResource := New(client, namespace, name, class, keepObjects, labels, injectedLabels, forceOverwriteAnnotations).WithSecretRefs(secretRefs(secretNames)).CreateIfNotExists(false)
+	return deployManagedResource(ctx, secrets, managedResource)
 }
 
 // Create creates a managed resource and its secret with the given name, class, key, and data in the given namespace.
@@ -171,33 +271,36 @@ func Create(
 	injectedLabels map[string]string,
 	forceOverwriteAnnotations *bool,
 ) error {
-	var (
-		secretName, secret = NewSecret(client, namespace, name, data, secretNameWithPrefix)
-		managedResource    = New(client, namespace, name, class, keepObjects, labels, injectedLabels, forceOverwriteAnnotations).WithSecretRef(secretName)
-	)
+	secretNames, secrets, err := NewSecrets(client, namespace, name, data, secretNameWithPrefix)
+	if err != nil {
+		return err
+	}
 
-	return deployManagedResource(ctx, secret, managedResource)
+	managedResource := New(client, namespace, name, class, keepObjects, labels, injectedLabels, forceOverwriteAnnotations).WithSecretRefs(secretRefs(secretNames))
+	return deployManagedResource(ctx, secrets, managedResource)
 }
 
 // CreateForSeed deploys a ManagedResource CR for the seed's gardener-resource-manager.
 func CreateForSeed(ctx context.Context, client client.Client, namespace, name string, keepObjects bool, data map[string][]byte) error {
-	var (
-		secretName, secret = NewSecret(client, namespace, name, data, true)
-		managedResource    = NewForSeed(client, namespace, name, keepObjects).WithSecretRef(secretName)
-	)
+	secretNames, secrets, err := NewSecrets(client, namespace, name, data, true)
+	if err != nil {
+		return err
+	}
 
-	return deployManagedResource(ctx, secret, managedResource)
+	managedResource := NewForSeed(client, namespace, name, keepObjects).WithSecretRefs(secretRefs(secretNames))
+	return deployManagedResource(ctx, secrets, managedResource)
 }
 
 // CreateForSeedWithLabels deploys a ManagedResource CR for the seed's gardener-resource-manager and allows providing
 // additional labels.
 func CreateForSeedWithLabels(ctx context.Context, client client.Client, namespace, name string, keepObjects bool, labels map[string]string, data map[string][]byte) error {
-	var (
-		secretName, secret = NewSecret(client, namespace, name, data, true)
-		managedResource    = NewForSeed(client, namespace, name, keepObjects).WithSecretRef(secretName).WithLabels(labels)
-	)
+	secretNames, secrets, err := NewSecrets(client, namespace, name, data, true)
+	if err != nil {
+		return err
+	}
 
-	return deployManagedResource(ctx, secret, managedResource)
+	managedResource := NewForSeed(client, namespace, name, keepObjects).WithSecretRefs(secretRefs(secretNames)).WithLabels(labels)
+	return deployManagedResource(ctx, secrets, managedResource)
 }
 
 // CreateForShoot deploys a ManagedResource CR for the shoot's gardener-resource-manager.
@@ -205,12 +308,13 @@ func CreateForSeedWithLabels(ctx context.Context, client client.Client, namespac
 // with "origin=gardener" label. External callers (extension controllers or other components)
 // of this function should provide their own unique origin value.
 func CreateForShoot(ctx context.Context, client client.Client, namespace, name, origin string, keepObjects bool, data map[string][]byte) error {
-	var (
-		secretName, secret = NewSecret(client, namespace, name, data, true)
-		managedResource    = NewForShoot(client, namespace, name, origin, keepObjects).WithSecretRef(secretName)
-	)
+	secretNames, secrets, err := NewSecrets(client, namespace, name, data, true)
+	if err != nil {
+		return err
+	}
 
-	return deployManagedResource(ctx, secret, managedResource)
+	managedResource := NewForShoot(client, namespace, name, origin, keepObjects).WithSecretRefs(secretRefs(secretNames))
+	return deployManagedResource(ctx, secrets, managedResource)
 }
 
 // CreateForShootWithLabels deploys a ManagedResource CR for the shoot's gardener-resource-manager. The origin is used
@@ -218,17 +322,28 @@ func CreateForShoot(ctx context.Context, client client.Client, namespace, name,
 // callers (extension controllers or other components) of this function should provide their own unique origin value.
 // This function allows providing additional labels.
 func CreateForShootWithLabels(ctx context.Context, client client.Client, namespace, name, origin string, keepObjects bool, labels map[string]string, data map[string][]byte) error {
-	var (
-		secretName, secret = NewSecret(client, namespace, name, data, true)
-		managedResource    = NewForShoot(client, namespace, name, origin, keepObjects).WithSecretRef(secretName).WithLabels(labels)
-	)
+	secretNames, secrets, err := NewSecrets(client, namespace, name, data, true)
+	if err != nil {
+		return err
+	}
 
-	return deployManagedResource(ctx, secret, managedResource)
+	managedResource := NewForShoot(client, namespace, name, origin, keepObjects).WithSecretRefs(secretRefs(secretNames)).WithLabels(labels)
+	return deployManagedResource(ctx, secrets, managedResource)
 }
 
-func deployManagedResource(ctx context.Context, secret *builder.Secret, managedResource *builder.ManagedResource) error {
-	if err := secret.Reconcile(ctx); err != nil {
-		return fmt.Errorf("could not create or update secret of managed resources: %w", err)
+func secretRefs(secretNames []string) []corev1.LocalObjectReference {
+	refs := make([]corev1.LocalObjectReference, 0, len(secretNames))
+	for _, secretName := range secretNames {
+		refs = append(refs, corev1.LocalObjectReference{Name: secretName})
+	}
+	return refs
+}
+
+func deployManagedResource(ctx context.Context, secrets []*builder.Secret, managedResource *builder.ManagedResource) error {
+	for _, secret := range secrets {
+		if err := secret.Reconcile(ctx); err != nil {
+			return fmt.Errorf("could not create or update secret of managed resources: %w", err)
+		}
 	}
 
 	if err := managedResource.Reconcile(ctx); err != nil {
@@ -31,8 +31,10 @@ import (
 
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	resourcesv1alpha1helper "github.com/gardener/gardener/pkg/apis/resources/v1alpha1/helper"
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllerutils"
+	"github.com/gardener/gardener/pkg/features"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
 	"github.com/gardener/gardener/pkg/utils"
 	kubernetesutils "github.com/gardener/gardener/pkg/utils/kubernetes"
@@ -150,6 +152,112 @@ var _ = Describe("managedresources", func() {
 		})
 	})
 
+	Describe("#NewSecrets", func() {
+		BeforeEach(func() {
+			DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.CompressManagedResourceSecrets, true))
+			DeferCleanup(test.WithVars(
+				&CompressionThreshold, 16,
+				&MaxSecretDataSize, 80,
+			))
+		})
+
+		It("should not compress the data if compression is disabled", func() {
+			DeferCleanup(test.WithFeatureGate(features.DefaultFeatureGate, features.CompressManagedResourceSecrets, false))
+			data := map[string][]byte{"foo": []byte("some data which exceeds the threshold")}
+
+			secretNames, secrets, err := NewSecrets(fakeClient, namespace, name, data, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			secretName, _ := NewSecret(fakeClient, namespace, name, data, true)
+			Expect(secretNames).To(ConsistOf(secretName))
+			Expect(secrets).To(HaveLen(1))
+		})
+
+		It("should return a single uncompressed secret if the data does not exceed the compression threshold", func() {
+			secretNames, secrets, err := NewSecrets(fakeClient, namespace, name, data, true)
+			Expect(err).NotTo(HaveOccurred())
+
+			secretName, _ := NewSecret(fakeClient, namespace, name, data, true)
+			Expect(secretNames).To(ConsistOf(secretName))
+			Expect(secrets).To(HaveLen(1))
+		})
+
+		It("should compress the data if it exceeds the compression threshold", func() {
+			data := map[string][]byte{"foo": []byte("some data which exceeds the threshold")}
+
+			secretNames, secrets, err := NewSecrets(fakeClient, namespace, name, data, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secretNames).To(HaveLen(1))
+			Expect(secretNames[0]).To(HavePrefix("managedresource-" + name + "-"))
+
+			Expect(secrets[0].Reconcile(ctx)).To(Succeed())
+
+			secret := &corev1.Secret{}
+			Expect(fakeClient.Get(ctx, kubernetesutils.Key(namespace, secretNames[0]), secret)).To(Succeed())
+			Expect(secret.Annotations).To(HaveKeyWithValue("resources.gardener.cloud/compression-algorithm", "gzip"))
+			Expect(secret.Data["foo"]).NotTo(Equal(data["foo"]))
+
+			secretData, err := resourcesv1alpha1helper.SecretData(secret)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secretData).To(Equal(data))
+		})
+
+		It("should shard the data over multiple secrets if it exceeds the maximum secret data size", func() {
+			data := map[string][]byte{
+				"a": []byte(utils.ComputeSHA256Hex([]byte("a"))[:24]),
+				"b": []byte(utils.ComputeSHA256Hex([]byte("b"))[:24]),
+				"c": []byte(utils.ComputeSHA256Hex([]byte("c"))[:24]),
+			}
+
+			secretNames, secrets, err := NewSecrets(fakeClient, namespace, name, data, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(secretNames).To(HaveLen(3))
+			Expect(secretNames[0]).To(MatchRegexp("^" + name + "-[a-z0-9]{8}$"))
+			Expect(secretNames[1]).To(MatchRegexp("^" + name + "-1-[a-z0-9]{8}$"))
+			Expect(secretNames[2]).To(MatchRegexp("^" + name + "-2-[a-z0-9]{8}$"))
+
+			for i, key := range []string{"a", "b", "c"} {
+				Expect(secrets[i].Reconcile(ctx)).To(Succeed())
+
+				secret := &corev1.Secret{}
+				Expect(fakeClient.Get(ctx, kubernetesutils.Key(namespace, secretNames[i]), secret)).To(Succeed())
+
+				secretData, err := resourcesv1alpha1helper.SecretData(secret)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(secretData).To(Equal(map[string][]byte{key: data[key]}))
+			}
+		})
+
+		It("should fail if a single compressed value exceeds the maximum secret data size", func() {
+			data := map[string][]byte{"foo": []byte(utils.ComputeSHA256Hex([]byte("foo")) + utils.ComputeSHA256Hex([]byte("bar")))}
+
+			_, _, err := NewSecrets(fakeClient, namespace, name, data, true)
+			Expect(err).To(MatchError(ContainSubstring("exceeds the maximum secret data size")))
+		})
+
+		It("should reference all shards in the managed resource", func() {
+			data := map[string][]byte{
+				"a": []byte(utils.ComputeSHA256Hex([]byte("a"))[:24]),
+				"b": []byte(utils.ComputeSHA256Hex([]byte("b"))[:24]),
+			}
+
+			Expect(CreateForSeed(ctx, fakeClient, namespace, name, false, data)).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(mr), mr)).To(Succeed())
+			Expect(mr.Spec.SecretRefs).To(HaveLen(2))
+
+			var secretsData []map[string][]byte
+			for _, ref := range mr.Spec.SecretRefs {
+				secret := &corev1.Secret{}
+				Expect(fakeClient.Get(ctx, kubernetesutils.Key(namespace, ref.Name), secret)).To(Succeed())
+
+				secretData, err := resourcesv1alpha1helper.SecretData(secret)
+				Expect(err).NotTo(HaveOccurred())
+				secretsData = append(secretsData, secretData)
+			}
+			Expect(secretsData).To(Equal([]map[string][]byte{{"a": data["a"]}, {"b": data["b"]}}))
+		})
+	})
+
 	Describe("#Update", func() {
 		It("should fail to update managed resource because it doesn't exist", func() {
 			Expect(Update(ctx, fakeClient, namespace, name, nil, false, "", data, nil, nil, nil)).To(BeNotFoundError())
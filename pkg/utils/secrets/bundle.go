@@ -37,6 +37,23 @@ func (s *CertificateBundleSecretConfig) Generate() (DataInterface, error) {
 	return newBundle(s.Name, s.CertificatePEMs, DataKeyCertificateBundle)
 }
 
+// PrivateKeyBundleSecretConfig is configuration for private key bundles. In contrast to RSAPrivateKeyBundleSecretConfig,
+// the bundled private keys may be of any algorithm.
+type PrivateKeyBundleSecretConfig struct {
+	Name           string
+	PrivateKeyPEMs [][]byte
+}
+
+// GetName returns the name of the secret.
+func (s *PrivateKeyBundleSecretConfig) GetName() string {
+	return s.Name
+}
+
+// Generate implements ConfigInterface.
+func (s *PrivateKeyBundleSecretConfig) Generate() (DataInterface, error) {
+	return newBundle(s.Name, s.PrivateKeyPEMs, DataKeyPrivateKeyBundle)
+}
+
 // RSAPrivateKeyBundleSecretConfig is configuration for certificate bundles.
 type RSAPrivateKeyBundleSecretConfig struct {
 	Name           string
@@ -92,6 +92,38 @@ var _ = Describe("Bundle Secrets", func() {
 		})
 	})
 
+	Describe("PrivateKeyBundleSecretConfig", func() {
+		var config *PrivateKeyBundleSecretConfig
+
+		BeforeEach(func() {
+			config = &PrivateKeyBundleSecretConfig{
+				Name:           name,
+				PrivateKeyPEMs: [][]byte{entry1, entry2},
+			}
+		})
+
+		Describe("#GetName", func() {
+			It("should return the name", func() {
+				Expect(config.GetName()).To(Equal(name))
+			})
+		})
+
+		Describe("#Generate", func() {
+			It("should generate the bundle", func() {
+				obj, err := config.Generate()
+				Expect(err).NotTo(HaveOccurred())
+
+				bundle, ok := obj.(*Bundle)
+				Expect(ok).To(BeTrue())
+
+				Expect(bundle.Name).To(Equal(name))
+				Expect(bundle.Bundle).To(Equal(append(entry1, entry2...)))
+
+				Expect(bundle.SecretData()).To(Equal(map[string][]byte{"bundle.key": bundle.Bundle}))
+			})
+		})
+	})
+
 	Describe("Bundle", func() {
 		var (
 			bundle  *Bundle
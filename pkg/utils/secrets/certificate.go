@@ -15,6 +15,7 @@
 package secrets
 
 import (
+	"crypto"
 	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
@@ -59,7 +60,7 @@ const (
 )
 
 // CertificateSecretConfig contains the specification a to-be-generated CA, server, or client certificate.
-// It always contains a 3072-bit RSA private key.
+// It contains a 3072-bit RSA private key unless another KeyAlgorithm is specified.
 type CertificateSecretConfig struct {
 	Name string
 
@@ -68,9 +69,10 @@ type CertificateSecretConfig struct {
 	DNSNames     []string
 	IPAddresses  []net.IP
 
-	CertType  CertType
-	SigningCA *Certificate
-	PKCS      int
+	CertType     CertType
+	SigningCA    *Certificate
+	PKCS         int
+	KeyAlgorithm KeyAlgorithm
 
 	Validity                          *time.Duration
 	SkipPublishingCACertificate       bool
@@ -87,7 +89,12 @@ type Certificate struct {
 	SkipPublishingCACertificate       bool
 	IncludeCACertificateInServerChain bool
 
-	PrivateKey    *rsa.PrivateKey
+	// PrivateKey is the RSA private key of the certificate. It is nil if the certificate has a private key of another
+	// algorithm, see Signer.
+	PrivateKey *rsa.PrivateKey
+	// Signer is the private key of the certificate independent of its algorithm. It is not considered for the checksums
+	// of secret configs since it duplicates PrivateKey for RSA keys, i.e., it would change the names of existing secrets.
+	Signer        crypto.Signer `hash:"ignore"`
 	PrivateKeyPEM []byte
 
 	Certificate    *x509.Certificate
@@ -116,20 +123,20 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 
 	// If no cert type is given then we only return a certificate object that contains the CA.
 	if s.CertType != "" {
-		privateKey, err := GenerateKey(rand.Reader, 3072)
+		privateKey, err := generatePrivateKey(s.KeyAlgorithm, 3072)
 		if err != nil {
 			return nil, err
 		}
 
 		var (
-			certificate       = s.generateCertificateTemplate()
+			certificate       = s.generateCertificateTemplate(privateKey)
 			certificateSigner = certificate
 			privateKeySigner  = privateKey
 		)
 
 		if s.SigningCA != nil {
 			certificateSigner = s.SigningCA.Certificate
-			privateKeySigner = s.SigningCA.PrivateKey
+			privateKeySigner = s.SigningCA.signer()
 		}
 
 		certificatePEM, err := signCertificate(certificate, privateKey, certificateSigner, privateKeySigner)
@@ -137,18 +144,12 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 			return nil, err
 		}
 
-		var pk []byte
-		if s.PKCS == PKCS1 {
-			pk = utils.EncodePrivateKey(privateKey)
-		} else if s.PKCS == PKCS8 {
-			pk, err = utils.EncodePrivateKeyInPKCS8(privateKey)
-
-			if err != nil {
-				return nil, err
-			}
+		pk, err := encodePrivateKey(privateKey, s.PKCS)
+		if err != nil {
+			return nil, err
 		}
 
-		certificateObj.PrivateKey = privateKey
+		certificateObj.setPrivateKey(privateKey)
 		certificateObj.PrivateKeyPEM = pk
 		certificateObj.Certificate = certificate
 		certificateObj.CertificatePEM = certificatePEM
@@ -157,6 +158,22 @@ func (s *CertificateSecretConfig) GenerateCertificate() (*Certificate, error) {
 	return certificateObj, nil
 }
 
+func (c *Certificate) setPrivateKey(privateKey crypto.Signer) {
+	c.Signer = privateKey
+	if rsaPrivateKey, ok := privateKey.(*rsa.PrivateKey); ok {
+		c.PrivateKey = rsaPrivateKey
+	}
+}
+
+// signer returns the private key of the certificate. It falls back to the RSA private key for certificates which were
+// constructed without a Signer.
+func (c *Certificate) signer() crypto.Signer {
+	if c.Signer == nil && c.PrivateKey != nil {
+		return c.PrivateKey
+	}
+	return c.Signer
+}
+
 // SecretData computes the data map which can be used in a Kubernetes secret.
 func (c *Certificate) SecretData() map[string][]byte {
 	data := map[string][]byte{}
@@ -189,7 +206,7 @@ func (c *Certificate) SecretData() map[string][]byte {
 // LoadCertificate takes a byte slice representation of a certificate and the corresponding private key, and returns its de-serialized private
 // key, certificate template and PEM certificate which can be used to sign other x509 certificates.
 func LoadCertificate(name string, privateKeyPEM, certificatePEM []byte) (*Certificate, error) {
-	privateKey, err := utils.DecodePrivateKey(privateKeyPEM)
+	privateKey, err := DecodePrivateKey(privateKeyPEM)
 	if err != nil {
 		return nil, err
 	}
@@ -198,22 +215,24 @@ func LoadCertificate(name string, privateKeyPEM, certificatePEM []byte) (*Certif
 		return nil, err
 	}
 
-	return &Certificate{
+	certificateObj := &Certificate{
 		Name: name,
 
-		PrivateKey:    privateKey,
 		PrivateKeyPEM: privateKeyPEM,
 
 		Certificate:    certificate,
 		CertificatePEM: certificatePEM,
-	}, nil
+	}
+	certificateObj.setPrivateKey(privateKey)
+
+	return certificateObj, nil
 }
 
 // generateCertificateTemplate creates a X509 Certificate object based on the provided information regarding
 // common name, organization, SANs (DNS names and IP addresses). It can create a server or a client certificate
 // or both, depending on the <certType> value. If <isCACert> is true, then a CA certificate is being created.
 // The certificates a valid for 10 years.
-func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificate {
+func (s *CertificateSecretConfig) generateCertificateTemplate(privateKey crypto.Signer) *x509.Certificate {
 	now := Clock.Now()
 
 	expiration := now.AddDate(10, 0, 0) // + 10 years
@@ -231,7 +250,7 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 			SerialNumber:          serialNumber,
 			NotBefore:             now,
 			NotAfter:              expiration,
-			KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+			KeyUsage:              x509.KeyUsageDigitalSignature,
 			Subject: pkix.Name{
 				CommonName:   s.CommonName,
 				Organization: s.Organization,
@@ -241,6 +260,11 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 		}
 	)
 
+	// Key encipherment is only possible with RSA keys, see https://www.rfc-editor.org/rfc/rfc5480#section-3.
+	if _, ok := privateKey.(*rsa.PrivateKey); ok {
+		template.KeyUsage |= x509.KeyUsageKeyEncipherment
+	}
+
 	switch s.CertType {
 	case CACert:
 		template.KeyUsage |= x509.KeyUsageCertSign | x509.KeyUsageCRLSign
@@ -258,8 +282,8 @@ func (s *CertificateSecretConfig) generateCertificateTemplate() *x509.Certificat
 // SignCertificate takes a <certificateTemplate> and a <certificateTemplateSigner> which is used to sign
 // the first. It also requires the corresponding private keys of both certificates. The created certificate
 // is returned as byte slice.
-func signCertificate(certificateTemplate *x509.Certificate, privateKey *rsa.PrivateKey, certificateTemplateSigner *x509.Certificate, privateKeySigner *rsa.PrivateKey) ([]byte, error) {
-	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, &privateKey.PublicKey, privateKeySigner)
+func signCertificate(certificateTemplate *x509.Certificate, privateKey crypto.Signer, certificateTemplateSigner *x509.Certificate, privateKeySigner crypto.Signer) ([]byte, error) {
+	certificate, err := x509.CreateCertificate(rand.Reader, certificateTemplate, certificateTemplateSigner, privateKey.Public(), privateKeySigner)
 	if err != nil {
 		return nil, err
 	}
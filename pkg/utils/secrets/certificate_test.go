@@ -15,6 +15,12 @@
 package secrets_test
 
 import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
@@ -47,6 +53,124 @@ var _ = Describe("Certificate Secrets", func() {
 				Expect(certificate.Certificate).NotTo(BeNil())
 				Expect(certificate.CA).To(BeNil())
 			})
+
+			DescribeTable("should generate certificates with the requested key algorithm",
+				func(algorithm KeyAlgorithm, pkcs int, expectedPEMType string, matchKey func(any)) {
+					certificateConfig.KeyAlgorithm = algorithm
+					ca, err := certificateConfig.GenerateCertificate()
+					Expect(err).NotTo(HaveOccurred())
+					matchKey(ca.Signer)
+
+					serverCertificate, err := (&CertificateSecretConfig{
+						Name:         "server",
+						CommonName:   "server",
+						DNSNames:     []string{"server.example.com"},
+						CertType:     ServerCert,
+						SigningCA:    ca,
+						PKCS:         pkcs,
+						KeyAlgorithm: algorithm,
+					}).GenerateCertificate()
+					Expect(err).NotTo(HaveOccurred())
+					matchKey(serverCertificate.Signer)
+					Expect(string(serverCertificate.PrivateKeyPEM)).To(HavePrefix("-----BEGIN " + expectedPEMType + "-----"))
+
+					loadedCA, err := LoadCertificate("ca", ca.PrivateKeyPEM, ca.CertificatePEM)
+					Expect(err).NotTo(HaveOccurred())
+					loaded, err := LoadCertificate("server", serverCertificate.PrivateKeyPEM, serverCertificate.CertificatePEM)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(loaded.Signer).To(Equal(serverCertificate.Signer))
+					Expect(loaded.PrivateKey).To(Equal(serverCertificate.PrivateKey))
+					Expect(loaded.Certificate.CheckSignatureFrom(loadedCA.Certificate)).To(Succeed())
+				},
+
+				Entry("RSA (default)", KeyAlgorithm(""), PKCS1, "RSA PRIVATE KEY", func(key any) {
+					Expect(key).To(BeAssignableToTypeOf(&rsa.PrivateKey{}))
+				}),
+				Entry("RSA in PKCS8 format", KeyAlgorithmRSA, PKCS8, "RSA PRIVATE KEY", func(key any) {
+					Expect(key).To(BeAssignableToTypeOf(&rsa.PrivateKey{}))
+				}),
+				Entry("ECDSA P-256", KeyAlgorithmECDSAP256, PKCS1, "EC PRIVATE KEY", func(key any) {
+					Expect(key).To(BeAssignableToTypeOf(&ecdsa.PrivateKey{}))
+					Expect(key.(*ecdsa.PrivateKey).Curve).To(Equal(elliptic.P256()))
+				}),
+				Entry("ECDSA P-384 in PKCS8 format", KeyAlgorithmECDSAP384, PKCS8, "PRIVATE KEY", func(key any) {
+					Expect(key).To(BeAssignableToTypeOf(&ecdsa.PrivateKey{}))
+					Expect(key.(*ecdsa.PrivateKey).Curve).To(Equal(elliptic.P384()))
+				}),
+				Entry("Ed25519", KeyAlgorithmEd25519, PKCS1, "PRIVATE KEY", func(key any) {
+					Expect(key).To(BeAssignableToTypeOf(ed25519.PrivateKey{}))
+				}),
+			)
+
+			It("should only set the RSA private key for RSA keys", func() {
+				certificate, err := certificateConfig.GenerateCertificate()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(certificate.PrivateKey).NotTo(BeNil())
+				Expect(certificate.Signer).To(BeIdenticalTo(certificate.PrivateKey))
+
+				certificateConfig.KeyAlgorithm = KeyAlgorithmEd25519
+				certificate, err = certificateConfig.GenerateCertificate()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(certificate.PrivateKey).To(BeNil())
+				Expect(certificate.Signer).To(BeAssignableToTypeOf(ed25519.PrivateKey{}))
+			})
+
+			It("should sign certificates with a CA which only has an RSA private key", func() {
+				ca, err := certificateConfig.GenerateCertificate()
+				Expect(err).NotTo(HaveOccurred())
+				ca.Signer = nil
+
+				serverCertificate, err := (&CertificateSecretConfig{
+					Name:       "server",
+					CommonName: "server",
+					CertType:   ServerCert,
+					SigningCA:  ca,
+				}).GenerateCertificate()
+				Expect(err).NotTo(HaveOccurred())
+
+				loadedCA, err := LoadCertificate("ca", ca.PrivateKeyPEM, ca.CertificatePEM)
+				Expect(err).NotTo(HaveOccurred())
+				loaded, err := LoadCertificate("server", serverCertificate.PrivateKeyPEM, serverCertificate.CertificatePEM)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(loaded.Certificate.CheckSignatureFrom(loadedCA.Certificate)).To(Succeed())
+			})
+
+			It("should only set the key encipherment usage for RSA keys", func() {
+				certificate, err := certificateConfig.GenerateCertificate()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(certificate.Certificate.KeyUsage & x509.KeyUsageKeyEncipherment).NotTo(BeZero())
+
+				certificateConfig.KeyAlgorithm = KeyAlgorithmECDSAP256
+				certificate, err = certificateConfig.GenerateCertificate()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(certificate.Certificate.KeyUsage & x509.KeyUsageKeyEncipherment).To(BeZero())
+			})
+
+			It("should sign certificates with ECDSA keys by CAs with RSA keys", func() {
+				ca, err := certificateConfig.GenerateCertificate()
+				Expect(err).NotTo(HaveOccurred())
+
+				clientCertificate, err := (&CertificateSecretConfig{
+					Name:         "client",
+					CommonName:   "client",
+					CertType:     ClientCert,
+					SigningCA:    ca,
+					KeyAlgorithm: KeyAlgorithmECDSAP256,
+				}).GenerateCertificate()
+				Expect(err).NotTo(HaveOccurred())
+
+				loadedCA, err := LoadCertificate("ca", ca.PrivateKeyPEM, ca.CertificatePEM)
+				Expect(err).NotTo(HaveOccurred())
+				loaded, err := LoadCertificate("client", clientCertificate.PrivateKeyPEM, clientCertificate.CertificatePEM)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(loaded.Certificate.CheckSignatureFrom(loadedCA.Certificate)).To(Succeed())
+			})
+
+			It("should fail for unsupported key algorithms", func() {
+				certificateConfig.KeyAlgorithm = "DSA"
+				_, err := certificateConfig.Generate()
+				Expect(err).To(MatchError(ContainSubstring(`unsupported key algorithm "DSA"`)))
+			})
 		})
 	})
 
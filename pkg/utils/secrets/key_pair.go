@@ -0,0 +1,122 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"crypto"
+	"fmt"
+
+	"golang.org/x/crypto/ssh"
+)
+
+// KeyPairSecretConfig contains the specification of a to-be-generated private key and its public key. In contrast to
+// RSASecretConfig, the algorithm of the private key can be chosen.
+type KeyPairSecretConfig struct {
+	Name string
+
+	// Algorithm is the algorithm of the private key. Defaults to KeyAlgorithmRSA.
+	Algorithm KeyAlgorithm
+	// Bits is the size of RSA private keys. It is ignored for other algorithms. Defaults to 3072.
+	Bits       int
+	UsedForSSH bool
+}
+
+// KeyPair contains the private key, the public key, and optionally the OpenSSH-formatted authorized keys file data.
+type KeyPair struct {
+	Name string
+
+	Algorithm  KeyAlgorithm
+	PrivateKey crypto.Signer
+	PublicKey  crypto.PublicKey
+
+	PrivateKeyPEM        []byte
+	OpenSSHAuthorizedKey []byte
+}
+
+// GetName returns the name of the secret.
+func (s *KeyPairSecretConfig) GetName() string {
+	return s.Name
+}
+
+// Generate implements ConfigInterface.
+func (s *KeyPairSecretConfig) Generate() (DataInterface, error) {
+	algorithm := s.Algorithm
+	if algorithm == "" {
+		algorithm = KeyAlgorithmRSA
+	}
+
+	bits := s.Bits
+	if bits == 0 {
+		bits = 3072
+	}
+
+	privateKey, err := generatePrivateKey(algorithm, bits)
+	if err != nil {
+		return nil, err
+	}
+
+	privateKeyPEM, err := encodePrivateKey(privateKey, PKCS1)
+	if err != nil {
+		return nil, err
+	}
+
+	keyPair := &KeyPair{
+		Name: s.Name,
+
+		Algorithm:     algorithm,
+		PrivateKey:    privateKey,
+		PublicKey:     privateKey.Public(),
+		PrivateKeyPEM: privateKeyPEM,
+	}
+
+	if s.UsedForSSH {
+		sshPublicKey, err := ssh.NewPublicKey(keyPair.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed computing SSH public key: %w", err)
+		}
+		keyPair.OpenSSHAuthorizedKey = trimNewline(ssh.MarshalAuthorizedKey(sshPublicKey))
+	}
+
+	return keyPair, nil
+}
+
+// SecretData computes the data map which can be used in a Kubernetes secret. The data keys follow the naming of
+// `ssh-keygen`, e.g. `id_ecdsa` and `id_ecdsa.pub`. Hence, RSA key pairs use the same data keys as RSASecretConfig.
+func (k *KeyPair) SecretData() map[string][]byte {
+	dataKey := KeyPairSecretDataKeyPrivateKey(k.Algorithm)
+
+	data := map[string][]byte{
+		dataKey: k.PrivateKeyPEM,
+	}
+
+	if k.OpenSSHAuthorizedKey != nil {
+		data[dataKey+".pub"] = k.OpenSSHAuthorizedKey
+	}
+
+	return data
+}
+
+// KeyPairSecretDataKeyPrivateKey returns the data key inside a Secret generated by a KeyPairSecretConfig whose value
+// contains the private key of the given algorithm.
+func KeyPairSecretDataKeyPrivateKey(algorithm KeyAlgorithm) string {
+	switch algorithm {
+	case KeyAlgorithmECDSAP256, KeyAlgorithmECDSAP384:
+		return "id_ecdsa"
+	case KeyAlgorithmEd25519:
+		return "id_ed25519"
+	default:
+		return DataKeyRSAPrivateKey
+	}
+}
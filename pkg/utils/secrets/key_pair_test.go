@@ -0,0 +1,91 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener/pkg/utils/secrets"
+)
+
+var _ = Describe("Key Pair Secrets", func() {
+	Describe("Key Pair Secret Configuration", func() {
+		DescribeTable("#Generate",
+			func(algorithm KeyAlgorithm, dataKey, sshKeyType string, matchKey func(any)) {
+				obj, err := (&KeyPairSecretConfig{
+					Name:       "key-pair",
+					Algorithm:  algorithm,
+					Bits:       2048,
+					UsedForSSH: true,
+				}).Generate()
+				Expect(err).NotTo(HaveOccurred())
+
+				keyPair, ok := obj.(*KeyPair)
+				Expect(ok).To(BeTrue())
+				matchKey(keyPair.PrivateKey)
+				Expect(keyPair.PublicKey).To(Equal(keyPair.PrivateKey.Public()))
+
+				data := keyPair.SecretData()
+				Expect(data).To(HaveLen(2))
+				Expect(data).To(HaveKey(dataKey))
+				Expect(string(data[dataKey+".pub"])).To(HavePrefix(sshKeyType + " "))
+				Expect(strings.HasSuffix(string(data[dataKey+".pub"]), "\n")).To(BeFalse())
+
+				decoded, err := DecodePrivateKey(data[dataKey])
+				Expect(err).NotTo(HaveOccurred())
+				Expect(decoded).To(Equal(keyPair.PrivateKey))
+			},
+
+			Entry("RSA (default)", KeyAlgorithm(""), "id_rsa", "ssh-rsa", func(key any) {
+				Expect(key).To(BeAssignableToTypeOf(&rsa.PrivateKey{}))
+				Expect(key.(*rsa.PrivateKey).N.BitLen()).To(Equal(2048))
+			}),
+			Entry("ECDSA P-256", KeyAlgorithmECDSAP256, "id_ecdsa", "ecdsa-sha2-nistp256", func(key any) {
+				Expect(key).To(BeAssignableToTypeOf(&ecdsa.PrivateKey{}))
+			}),
+			Entry("ECDSA P-384", KeyAlgorithmECDSAP384, "id_ecdsa", "ecdsa-sha2-nistp384", func(key any) {
+				Expect(key).To(BeAssignableToTypeOf(&ecdsa.PrivateKey{}))
+			}),
+			Entry("Ed25519", KeyAlgorithmEd25519, "id_ed25519", "ssh-ed25519", func(key any) {
+				Expect(key).To(BeAssignableToTypeOf(ed25519.PrivateKey{}))
+			}),
+		)
+
+		It("should not compute the OpenSSH authorized key if not used for SSH", func() {
+			obj, err := (&KeyPairSecretConfig{Name: "key-pair", Algorithm: KeyAlgorithmEd25519}).Generate()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(obj.SecretData()).To(HaveLen(1))
+			Expect(obj.SecretData()).To(HaveKey("id_ed25519"))
+		})
+	})
+
+	Describe("#DecodePrivateKey", func() {
+		It("should fail for invalid PEM data", func() {
+			_, err := DecodePrivateKey([]byte("foo"))
+			Expect(err).To(MatchError("could not decode the PEM-encoded private key"))
+		})
+
+		It("should fail for unsupported PEM block types", func() {
+			_, err := DecodePrivateKey([]byte("-----BEGIN CERTIFICATE-----\nZm9v\n-----END CERTIFICATE-----\n"))
+			Expect(err).To(MatchError(`unsupported PEM block type "CERTIFICATE" for private keys`))
+		})
+	})
+})
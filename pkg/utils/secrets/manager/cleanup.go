@@ -22,7 +22,10 @@ import (
 	"github.com/gardener/gardener/pkg/utils/flow"
 )
 
-func (m *manager) Cleanup(ctx context.Context) error {
+func (m *manager) Cleanup(ctx context.Context, opts ...CleanupOption) error {
+	options := &CleanupOptions{}
+	options.ApplyOptions(opts)
+
 	secretList, err := m.listSecrets(ctx)
 	if err != nil {
 		return err
@@ -38,7 +41,11 @@ func (m *manager) Cleanup(ctx context.Context) error {
 			name = v
 		}
 
-		if secrets, found := m.getFromStore(name); found &&
+		secrets, found := m.getFromStore(name)
+		if !found && options.KeepUngenerated {
+			continue
+		}
+		if found &&
 			(secrets.current.obj.Name == secret.Name ||
 				(secrets.old != nil && secrets.old.obj.Name == secret.Name) ||
 				(secrets.bundle != nil && secrets.bundle.obj.Name == secret.Name)) {
@@ -53,3 +60,28 @@ func (m *manager) Cleanup(ctx context.Context) error {
 
 	return flow.Parallel(fns...)(ctx)
 }
+
+// CleanupOption is some configuration that modifies options for a Cleanup request.
+type CleanupOption func(*CleanupOptions)
+
+// CleanupOptions are options for Cleanup calls.
+type CleanupOptions struct {
+	// KeepUngenerated specifies that the secrets of configurations which were not passed to prior Generate calls are
+	// kept. Only the stale secrets of generated configurations (e.g., after their rotation) are deleted.
+	KeepUngenerated bool
+}
+
+// ApplyOptions applies the given cleanup options on these options.
+func (o *CleanupOptions) ApplyOptions(opts []CleanupOption) {
+	for _, opt := range opts {
+		opt(o)
+	}
+}
+
+// KeepUngenerated returns a function which sets the 'KeepUngenerated' field to true. It can be used if not all
+// Generate calls were executed before Cleanup, e.g. because parts of a flow were skipped.
+func KeepUngenerated() CleanupOption {
+	return func(options *CleanupOptions) {
+		options.KeepUngenerated = true
+	}
+}
@@ -102,6 +102,28 @@ var _ = Describe("Cleanup", func() {
 			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secrets[7]), &corev1.Secret{})).To(BeNotFoundError())
 		})
 
+		It("should keep secrets of configurations which were not generated", func() {
+			secrets := secretList(testIdentity)
+			for i := range secrets {
+				Expect(fakeClient.Create(ctx, secrets[i])).To(Succeed())
+			}
+
+			Expect(m.addToStore("first", secrets[0], current)).To(Succeed())
+			Expect(m.addToStore("first", secrets[2], bundle)).To(Succeed())
+			Expect(m.addToStore("second", secrets[3], current)).To(Succeed())
+
+			Expect(m.Cleanup(ctx, KeepUngenerated())).To(Succeed())
+
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secrets[0]), &corev1.Secret{})).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secrets[1]), &corev1.Secret{})).To(BeNotFoundError())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secrets[2]), &corev1.Secret{})).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secrets[3]), &corev1.Secret{})).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secrets[4]), &corev1.Secret{})).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secrets[5]), &corev1.Secret{})).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secrets[6]), &corev1.Secret{})).To(Succeed())
+			Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(secrets[7]), &corev1.Secret{})).To(Succeed())
+		})
+
 		It("should not touch secrets from other manager instance", func() {
 			secrets := secretList(testIdentity + "other")
 			for i := range secrets {
@@ -114,6 +114,6 @@ func (m *fakeManager) Generate(ctx context.Context, config secretsutils.ConfigIn
 	return secret, nil
 }
 
-func (m *fakeManager) Cleanup(_ context.Context) error {
+func (m *fakeManager) Cleanup(_ context.Context, _ ...secretsmanager.CleanupOption) error {
 	return nil
 }
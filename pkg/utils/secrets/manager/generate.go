@@ -203,6 +203,15 @@ func (m *manager) storeOldSecrets(ctx context.Context, name, currentSecretName s
 	return m.addToStore(oldSecret.Labels[LabelKeyName], oldSecret, old)
 }
 
+func keyPairPrivateKey(secret *corev1.Secret) []byte {
+	for _, algorithm := range []secretsutils.KeyAlgorithm{secretsutils.KeyAlgorithmRSA, secretsutils.KeyAlgorithmECDSAP256, secretsutils.KeyAlgorithmEd25519} {
+		if data, ok := secret.Data[secretsutils.KeyPairSecretDataKeyPrivateKey(algorithm)]; ok {
+			return data
+		}
+	}
+	return nil
+}
+
 func (m *manager) generateBundleSecret(ctx context.Context, config secretsutils.ConfigInterface) error {
 	var bundleConfig secretsutils.ConfigInterface
 
@@ -237,6 +246,21 @@ func (m *manager) generateBundleSecret(ctx context.Context, config secretsutils.
 				PrivateKeyPEMs: keys,
 			}
 		}
+
+	case *secretsutils.KeyPairSecretConfig:
+		if !c.UsedForSSH {
+			// The old secret might contain a private key of a different algorithm if the rotation was triggered by
+			// changing the algorithm, hence the data key is determined for each secret individually.
+			keys := [][]byte{keyPairPrivateKey(secrets.current.obj)}
+			if secrets.old != nil {
+				keys = append(keys, keyPairPrivateKey(secrets.old.obj))
+			}
+
+			bundleConfig = &secretsutils.PrivateKeyBundleSecretConfig{
+				Name:           config.GetName() + nameSuffixBundle,
+				PrivateKeyPEMs: keys,
+			}
+		}
 	}
 
 	if bundleConfig == nil {
@@ -362,7 +386,8 @@ type GenerateOptions struct {
 	// Validity specifies for how long the secret should be valid.
 	Validity time.Duration
 	// IgnoreConfigChecksumForCASecretName specifies whether the secret config checksum should be ignored when
-	// computing the secret name for CA secrets.
+	// computing the secret name for CA secrets. Consequently, config changes (e.g., of the key algorithm) of such CA
+	// secrets only take effect with their next rotation.
 	IgnoreConfigChecksumForCASecretName bool
 
 	signingCAChecksum *string
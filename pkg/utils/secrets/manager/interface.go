@@ -41,6 +41,6 @@ type Interface interface {
 
 	// Cleanup deletes no longer required secrets. No longer required secrets are those still existing in the system
 	// which weren't detected by prior Generate calls. Consequently, only call Cleanup after you have executed Generate
-	// calls for all desired secrets.
-	Cleanup(context.Context) error
+	// calls for all desired secrets, or pass KeepUngenerated.
+	Cleanup(context.Context, ...CleanupOption) error
 }
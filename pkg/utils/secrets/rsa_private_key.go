@@ -100,6 +100,10 @@ func generateSSHAuthorizedKeys(privateKey *rsa.PrivateKey) ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	publicKey := ssh.MarshalAuthorizedKey(pubKey)
-	return bytes.Trim(publicKey, "\x0a"), nil
+	return trimNewline(ssh.MarshalAuthorizedKey(pubKey)), nil
+}
+
+// trimNewline trims the new-line at the end of serialized OpenSSH authorized keys.
+func trimNewline(publicKey []byte) []byte {
+	return bytes.Trim(publicKey, "\x0a")
 }
@@ -16,6 +16,8 @@ package timewindow
 
 import (
 	"fmt"
+	"slices"
+	"strings"
 	"time"
 
 	"k8s.io/apimachinery/pkg/util/rand"
@@ -124,9 +126,26 @@ func (m *MaintenanceTime) adjust(t time.Time) time.Time {
 }
 
 // MaintenanceTimeWindow contains the beginning and the end of a time window in which maintenance operations can be performed.
+// Optionally, the time window can be restricted to certain days of the week and be suspended during freeze periods.
 type MaintenanceTimeWindow struct {
 	begin *MaintenanceTime
 	end   *MaintenanceTime
+	// weekdays are the days of the week (in UTC) on which the time window may begin. If empty, all days are allowed.
+	weekdays      []time.Weekday
+	freezePeriods []FreezePeriod
+}
+
+// FreezePeriod is an absolute period of time in which no maintenance operations must be performed.
+type FreezePeriod struct {
+	// Begin is the beginning of the freeze period (inclusive).
+	Begin time.Time
+	// End is the end of the freeze period (exclusive).
+	End time.Time
+}
+
+// Contains returns true in case the given time is within the freeze period.
+func (f FreezePeriod) Contains(t time.Time) bool {
+	return !t.Before(f.Begin) && t.Before(f.End)
 }
 
 // AlwaysTimeWindow is a MaintenanceTimeWindow that contains all durations.
@@ -134,7 +153,53 @@ var AlwaysTimeWindow = NewMaintenanceTimeWindow(NewMaintenanceTime(0, 0, 0), New
 
 // NewMaintenanceTimeWindow takes a begin and an end of a time window and returns a pointer to a MaintenanceTimeWindow structure.
 func NewMaintenanceTimeWindow(begin, end *MaintenanceTime) *MaintenanceTimeWindow {
-	return &MaintenanceTimeWindow{begin, end}
+	return &MaintenanceTimeWindow{begin: begin, end: end}
+}
+
+// ParseMaintenanceTimeWindowWithWeekdays is like ParseMaintenanceTimeWindow but additionally restricts the time window
+// to the given days of the week (e.g. "Tuesday"). The days refer to the time zone of <begin>, i.e., they are shifted
+// accordingly if the begin of the time window falls on a different day in UTC.
+func ParseMaintenanceTimeWindowWithWeekdays(begin, end string, weekdays []string) (*MaintenanceTimeWindow, error) {
+	timeWindow, err := ParseMaintenanceTimeWindow(begin, end)
+	if err != nil {
+		return nil, err
+	}
+	if len(weekdays) == 0 {
+		return timeWindow, nil
+	}
+
+	days, err := ParseWeekdays(weekdays)
+	if err != nil {
+		return nil, err
+	}
+
+	// The begin has already been parsed successfully above, hence, we can ignore the error here.
+	t, _ := time.Parse(maintenanceTimeLayout, begin)
+	dayShift := (int(t.UTC().Weekday()) - int(t.Weekday()) + 7) % 7
+	for i, day := range days {
+		days[i] = time.Weekday((int(day) + dayShift) % 7)
+	}
+
+	return timeWindow.WithWeekdays(days...), nil
+}
+
+// ParseWeekdays parses the given names of days of the week (e.g. "Monday"). In case an unknown name is given, an error
+// is returned.
+func ParseWeekdays(names []string) ([]time.Weekday, error) {
+	weekdays := make([]time.Weekday, 0, len(names))
+
+outer:
+	for _, name := range names {
+		for day := time.Sunday; day <= time.Saturday; day++ {
+			if day.String() == name {
+				weekdays = append(weekdays, day)
+				continue outer
+			}
+		}
+		return nil, fmt.Errorf("unknown day of the week %q", name)
+	}
+
+	return weekdays, nil
 }
 
 // ParseMaintenanceTimeWindow takes a begin and an end of a time window in the maintenance format and returns a pointer
@@ -153,12 +218,20 @@ func ParseMaintenanceTimeWindow(begin, end string) (*MaintenanceTimeWindow, erro
 
 // String returns the string representation of the time window.
 func (m *MaintenanceTimeWindow) String() string {
-	return fmt.Sprintf("begin=%s, end=%s", m.begin, m.end)
+	if len(m.weekdays) == 0 {
+		return fmt.Sprintf("begin=%s, end=%s", m.begin, m.end)
+	}
+
+	weekdays := make([]string, 0, len(m.weekdays))
+	for _, day := range m.weekdays {
+		weekdays = append(weekdays, day.String())
+	}
+	return fmt.Sprintf("begin=%s, end=%s, weekdays=%s", m.begin, m.end, strings.Join(weekdays, ","))
 }
 
-// Equal returns true if the time windows are the same.
+// Equal returns true if the time windows are the same. Freeze periods are not considered.
 func (m *MaintenanceTimeWindow) Equal(o *MaintenanceTimeWindow) bool {
-	return m.Begin().Compare(o.Begin()) == 0 && m.End().Compare(o.End()) == 0
+	return m.Begin().Compare(o.Begin()) == 0 && m.End().Compare(o.End()) == 0 && slices.Equal(m.weekdays, o.weekdays)
 }
 
 // Begin returns the begin of the time window.
@@ -171,18 +244,73 @@ func (m *MaintenanceTimeWindow) End() *MaintenanceTime {
 	return m.end
 }
 
+// Weekdays returns the days of the week (in UTC) on which the time window may begin. If empty, all days are allowed.
+func (m *MaintenanceTimeWindow) Weekdays() []time.Weekday {
+	return m.weekdays
+}
+
+// FreezePeriods returns the freeze periods of the time window.
+func (m *MaintenanceTimeWindow) FreezePeriods() []FreezePeriod {
+	return m.freezePeriods
+}
+
 // WithBegin returns a new maintenance time window with the given <begin> (ending will be kept).
 func (m *MaintenanceTimeWindow) WithBegin(begin *MaintenanceTime) *MaintenanceTimeWindow {
-	return NewMaintenanceTimeWindow(begin, m.end)
+	out := *m
+	out.begin = begin
+	return &out
 }
 
 // WithEnd returns a new maintenance time window with the given <end> (beginning will be kept).
 func (m *MaintenanceTimeWindow) WithEnd(end *MaintenanceTime) *MaintenanceTimeWindow {
-	return NewMaintenanceTimeWindow(m.begin, end)
+	out := *m
+	out.end = end
+	return &out
+}
+
+// WithWeekdays returns a new maintenance time window which may only begin on the given days of the week (in UTC). If
+// no days are given, the time window may begin on all days.
+func (m *MaintenanceTimeWindow) WithWeekdays(weekdays ...time.Weekday) *MaintenanceTimeWindow {
+	out := *m
+	out.weekdays = nil
+	if len(weekdays) > 0 {
+		out.weekdays = slices.Clone(weekdays)
+		slices.Sort(out.weekdays)
+		out.weekdays = slices.Compact(out.weekdays)
+	}
+	return &out
+}
+
+// WithFreezePeriods returns a new maintenance time window which is suspended during the given freeze periods (in
+// addition to the already existing ones).
+func (m *MaintenanceTimeWindow) WithFreezePeriods(freezePeriods ...FreezePeriod) *MaintenanceTimeWindow {
+	out := *m
+	out.freezePeriods = append(slices.Clone(m.freezePeriods), freezePeriods...)
+	return &out
 }
 
-// Contains returns true in case the given time is within the time window.
+// Contains returns true in case the given time is within the time window, the time window may begin on the respective
+// day of the week, and the given time is not within a freeze period.
 func (m *MaintenanceTimeWindow) Contains(tTime time.Time) bool {
+	if !m.containsTimeOfDay(tTime) || m.isFrozen(tTime) {
+		return false
+	}
+
+	// If the time window spans different days and the given time is before the begin, the time window has begun on
+	// the previous day.
+	occurrenceBegin := tTime.UTC()
+	if timeToMaintenanceTime(tTime).Compare(m.begin) < 0 {
+		occurrenceBegin = occurrenceBegin.AddDate(0, 0, -1)
+	}
+	return m.isAllowedWeekday(occurrenceBegin)
+}
+
+// IsFrozen returns true in case the given time is within one of the freeze periods of the time window.
+func (m *MaintenanceTimeWindow) IsFrozen(t time.Time) bool {
+	return m.isFrozen(t)
+}
+
+func (m *MaintenanceTimeWindow) containsTimeOfDay(tTime time.Time) bool {
 	t := timeToMaintenanceTime(tTime)
 
 	if m.spansDifferentDays() {
@@ -191,6 +319,19 @@ func (m *MaintenanceTimeWindow) Contains(tTime time.Time) bool {
 	return t.Compare(m.begin) >= 0 && t.Compare(m.end) <= 0
 }
 
+func (m *MaintenanceTimeWindow) isFrozen(t time.Time) bool {
+	for _, freezePeriod := range m.freezePeriods {
+		if freezePeriod.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *MaintenanceTimeWindow) isAllowedWeekday(t time.Time) bool {
+	return len(m.weekdays) == 0 || slices.Contains(m.weekdays, t.UTC().Weekday())
+}
+
 // RandomFunc is a function that computes a random number.
 var RandomFunc = rand.Int63nRange
 
@@ -202,6 +343,8 @@ var RandomFunc = rand.Int63nRange
 // changed to <from> if <from> is already inside the maintenance time window (otherwise, it has no effect). As a
 // consequence, this will return a random duration from <from> until the end of the maintenance time window which is
 // shorter than 24h.
+// Occurrences of the time window which begin on a day of the week that is not allowed are skipped. Occurrences which
+// overlap with a freeze period are shortened accordingly, or skipped if they are entirely frozen.
 func (m *MaintenanceTimeWindow) RandomDurationUntilNext(from time.Time, shiftBeginToFromIfContained bool) time.Duration {
 	from = from.UTC()
 
@@ -211,23 +354,67 @@ func (m *MaintenanceTimeWindow) RandomDurationUntilNext(from time.Time, shiftBeg
 	)
 
 	if shiftBeginToFromIfContained && m.Contains(from) {
-		dayOffset := 0
 		if from.Hour()-begin.Hour() < 0 {
-			dayOffset = 1
+			end = end.AddDate(0, 0, -1)
 		}
-		begin = from.AddDate(0, 0, dayOffset)
-		from = from.AddDate(0, 0, dayOffset)
-	}
-
-	if begin.Sub(from) < 0 && (m.Contains(from) || from.After(end)) {
+		begin = from
+	} else if begin.Sub(from) < 0 && (m.containsTimeOfDay(from) || from.After(end)) {
 		begin = begin.AddDate(0, 0, 1)
 		end = end.AddDate(0, 0, 1)
 	}
 
+	begin, end = m.nextAllowedOccurrence(begin, end)
+
 	delta := end.Sub(begin)
 	return time.Duration(int64(begin.Sub(from)) + RandomFunc(0, delta.Nanoseconds()))
 }
 
+// nextAllowedOccurrence returns the first (part of an) occurrence of the time window, starting with the given one,
+// which begins on an allowed day of the week and which is not within a freeze period.
+func (m *MaintenanceTimeWindow) nextAllowedOccurrence(begin, end time.Time) (time.Time, time.Time) {
+	// The given begin might have been shifted already, hence, the begin of the occurrence is computed from its end.
+	occurrenceBegin := end.Add(-m.Duration())
+
+	moveTo := func(day time.Time) {
+		occurrenceBegin = m.adjustedBegin(day)
+		begin, end = occurrenceBegin, m.adjustedEnd(day)
+	}
+
+outer:
+	for {
+		if !m.isAllowedWeekday(occurrenceBegin) {
+			moveTo(occurrenceBegin.AddDate(0, 0, 1))
+			continue
+		}
+
+		for _, freezePeriod := range m.freezePeriods {
+			if !freezePeriod.Begin.Before(end) || !freezePeriod.End.After(begin) {
+				continue
+			}
+
+			switch {
+			case freezePeriod.Begin.After(begin):
+				// The occurrence starts before the freeze period, use the part until the freeze period begins.
+				end = freezePeriod.Begin
+			case freezePeriod.End.Before(end):
+				// The occurrence starts within the freeze period, use the part after the freeze period ends.
+				begin = freezePeriod.End
+			default:
+				// The occurrence is entirely frozen, continue with the first occurrence which might end after the freeze
+				// period (this might be the one which begins on the day before the freeze period ends).
+				next := freezePeriod.End.UTC().AddDate(0, 0, -1)
+				if !m.adjustedBegin(next).After(occurrenceBegin) {
+					next = occurrenceBegin.AddDate(0, 0, 1)
+				}
+				moveTo(next)
+			}
+			continue outer
+		}
+
+		return begin, end
+	}
+}
+
 // Duration returns the duration of the maintenance time window.
 func (m *MaintenanceTimeWindow) Duration() time.Duration {
 	var (
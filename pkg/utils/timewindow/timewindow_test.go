@@ -165,10 +165,40 @@ var _ = Describe("utils", func() {
 			Entry("valid maintenance time window", begin.Formatted(), end.Formatted(), Not(HaveOccurred()), Equal(maintenanceTimeWindow)),
 		)
 
+		DescribeTable("#ParseMaintenanceTimeWindowWithWeekdays",
+			func(begin, end string, weekdays []string, errorMatcher gomegatypes.GomegaMatcher, expectedWeekdays []time.Weekday) {
+				timeWindow, err := ParseMaintenanceTimeWindowWithWeekdays(begin, end, weekdays)
+
+				Expect(err).To(errorMatcher)
+				if err == nil {
+					Expect(timeWindow.Weekdays()).To(Equal(expectedWeekdays))
+				}
+			},
+
+			Entry("invalid begin", "foo", "010000+0000", []string{"Tuesday"}, HaveOccurred(), nil),
+			Entry("unknown weekday", "000000+0000", "010000+0000", []string{"Tue"}, MatchError(ContainSubstring(`unknown day of the week "Tue"`)), nil),
+			Entry("no weekdays", "000000+0000", "010000+0000", nil, Not(HaveOccurred()), nil),
+			Entry("weekdays in UTC", "220000+0000", "230000+0000", []string{"Thursday", "Tuesday", "Thursday"}, Not(HaveOccurred()), []time.Weekday{time.Tuesday, time.Thursday}),
+			Entry("weekdays shifted to the previous day", "003000+0100", "013000+0100", []string{"Sunday", "Tuesday"}, Not(HaveOccurred()), []time.Weekday{time.Monday, time.Saturday}),
+			Entry("weekdays shifted to the next day", "230000-0200", "235900-0200", []string{"Saturday"}, Not(HaveOccurred()), []time.Weekday{time.Sunday}),
+		)
+
 		Describe("#String", func() {
 			It("should return the correct string representation", func() {
 				Expect(maintenanceTimeWindow.String()).To(Equal(fmt.Sprintf("begin=%s, end=%s", begin, end)))
 			})
+
+			It("should return the correct string representation with weekdays", func() {
+				Expect(maintenanceTimeWindow.WithWeekdays(time.Thursday, time.Tuesday).String()).To(Equal(fmt.Sprintf("begin=%s, end=%s, weekdays=Tuesday,Thursday", begin, end)))
+			})
+		})
+
+		Describe("#Equal", func() {
+			It("should consider the weekdays", func() {
+				Expect(maintenanceTimeWindow.Equal(maintenanceTimeWindow.WithWeekdays())).To(BeTrue())
+				Expect(maintenanceTimeWindow.Equal(maintenanceTimeWindow.WithWeekdays(time.Monday))).To(BeFalse())
+				Expect(maintenanceTimeWindow.WithWeekdays(time.Monday).Equal(maintenanceTimeWindow.WithWeekdays(time.Monday, time.Monday))).To(BeTrue())
+			})
 		})
 
 		Describe("#Begin", func() {
@@ -195,6 +225,18 @@ var _ = Describe("utils", func() {
 				newEnd := NewMaintenanceTime(4, 4, 4)
 				Expect(maintenanceTimeWindow.WithEnd(newEnd)).To(Equal(NewMaintenanceTimeWindow(begin, newEnd)))
 			})
+
+			It("should keep the weekdays and freeze periods", func() {
+				var (
+					newEnd       = NewMaintenanceTime(4, 4, 4)
+					freezePeriod = FreezePeriod{Begin: newTime(0, 0, 0, 0), End: newTime(1, 0, 0, 0)}
+					timeWindow   = maintenanceTimeWindow.WithWeekdays(time.Friday).WithFreezePeriods(freezePeriod).WithEnd(newEnd)
+				)
+
+				Expect(timeWindow.End()).To(Equal(newEnd))
+				Expect(timeWindow.Weekdays()).To(ConsistOf(time.Friday))
+				Expect(timeWindow.FreezePeriods()).To(ConsistOf(freezePeriod))
+			})
 		})
 
 		var (
@@ -238,6 +280,14 @@ var _ = Describe("utils", func() {
 			Entry("begin and end on different day (23-0)", from23to0, newTime(23, 0, 0, 0), true),
 			Entry("begin and end on different day (23-0)", from23to0, newTime(0, 0, 0, 0), true),
 			Entry("begin and end on different day (23-0)", from23to0, newTime(23, 45, 0, 0), true),
+
+			// newTime returns a time on a Monday
+			Entry("restricted to Tuesdays (16-19), on Monday", from16to19.WithWeekdays(time.Tuesday), newTime(17, 0, 0, 0), false),
+			Entry("restricted to Tuesdays (16-19), on Tuesday", from16to19.WithWeekdays(time.Tuesday), newTime(17, 0, 0, 0).AddDate(0, 0, 1), true),
+			Entry("restricted to Mondays (23-1), began on Monday", from23to1.WithWeekdays(time.Monday), newTime(0, 30, 0, 0).AddDate(0, 0, 1), true),
+			Entry("restricted to Mondays (23-1), began on Sunday", from23to1.WithWeekdays(time.Monday), newTime(0, 30, 0, 0), false),
+			Entry("within freeze period (16-19)", from16to19.WithFreezePeriods(FreezePeriod{Begin: newTime(16, 30, 0, 0), End: newTime(18, 0, 0, 0)}), newTime(17, 0, 0, 0), false),
+			Entry("after freeze period (16-19)", from16to19.WithFreezePeriods(FreezePeriod{Begin: newTime(16, 30, 0, 0), End: newTime(18, 0, 0, 0)}), newTime(18, 0, 0, 0), true),
 		)
 
 		DescribeTable("#RandomDurationUntilNext",
@@ -282,6 +332,22 @@ var _ = Describe("utils", func() {
 			Entry("(23-0), shift begin if contained, does contain now", from23to0, true, newTime(23, 30, 0, 0), 30*time.Minute),
 			Entry("(23-0), shift begin if contained, does not contain now (before)", from23to0, true, newTime(20, 0, 0, 0), 4*time.Hour),
 			Entry("(23-0), shift begin if contained, does not contain now (after)", from23to0, true, newTime(0, 59, 1, 0), 23*time.Hour+59*time.Second),
+
+			// newTime returns a time on a Monday, begin should be moved to the next allowed day of the week
+			Entry("(16-19), restricted to Tuesdays, before on Monday", from16to19.WithWeekdays(time.Tuesday), false, newTime(15, 0, 0, 0), 28*time.Hour),
+			Entry("(16-19), restricted to Tuesdays, contained on Monday", from16to19.WithWeekdays(time.Tuesday), true, newTime(17, 0, 0, 0), 26*time.Hour),
+			Entry("(16-19), restricted to Tuesdays, contained on Tuesday", from16to19.WithWeekdays(time.Tuesday), true, newTime(17, 0, 0, 0).AddDate(0, 0, 1), 2*time.Hour),
+			Entry("(16-19), restricted to Tuesdays, after on Wednesday", from16to19.WithWeekdays(time.Tuesday), false, newTime(17, 0, 0, 0).AddDate(0, 0, 2), 6*24*time.Hour+2*time.Hour),
+			Entry("(23-1), restricted to Mondays, contained on Tuesday", from23to1.WithWeekdays(time.Monday), true, newTime(0, 30, 0, 0).AddDate(0, 0, 1), 30*time.Minute),
+
+			// begin and end should be moved out of freeze periods
+			Entry("(16-19), entirely frozen for two days", from16to19.WithFreezePeriods(FreezePeriod{Begin: newTime(0, 0, 0, 0), End: newTime(0, 0, 0, 0).AddDate(0, 0, 2)}), false, newTime(10, 0, 0, 0), 57*time.Hour),
+			Entry("(16-19), freeze period begins within the time window", from16to19.WithFreezePeriods(FreezePeriod{Begin: newTime(17, 0, 0, 0), End: newTime(18, 0, 0, 0)}), false, newTime(10, 0, 0, 0), 7*time.Hour),
+			Entry("(16-19), freeze period ends within the time window", from16to19.WithFreezePeriods(FreezePeriod{Begin: newTime(15, 0, 0, 0), End: newTime(17, 30, 0, 0)}), false, newTime(10, 0, 0, 0), 9*time.Hour),
+			Entry("(16-19), freeze period ends within the time window, shift begin if contained", from16to19.WithFreezePeriods(FreezePeriod{Begin: newTime(18, 0, 0, 0), End: newTime(20, 0, 0, 0)}), true, newTime(17, 0, 0, 0), time.Hour),
+			Entry("(23-1), freeze period ends after midnight", from23to1.WithFreezePeriods(FreezePeriod{Begin: newTime(20, 0, 0, 0), End: newTime(0, 30, 0, 0).AddDate(0, 0, 1)}), false, newTime(10, 0, 0, 0), 15*time.Hour),
+			Entry("(23-1), entirely frozen, freeze period ends after midnight", from23to1.WithFreezePeriods(FreezePeriod{Begin: newTime(20, 0, 0, 0), End: newTime(0, 30, 0, 0).AddDate(0, 0, 2)}), false, newTime(10, 0, 0, 0), 39*time.Hour),
+			Entry("(16-19), restricted to Tuesdays and frozen on Tuesday", from16to19.WithWeekdays(time.Tuesday).WithFreezePeriods(FreezePeriod{Begin: newTime(0, 0, 0, 0).AddDate(0, 0, 1), End: newTime(0, 0, 0, 0).AddDate(0, 0, 2)}), false, newTime(10, 0, 0, 0), 8*24*time.Hour+9*time.Hour),
 		)
 
 		DescribeTable("#Duration",
@@ -302,6 +302,12 @@ func (e *ExtensionValidator) validateShoot(kindToTypesMap map[string]sets.Set[st
 		}
 	}
 
+	// The KMS plugin is run next to the kube-apiserver by the control plane webhook of the extension which is registered
+	// for the KMS type.
+	if spec.Kubernetes.KubeAPIServer != nil && spec.Kubernetes.KubeAPIServer.EncryptionConfig != nil && spec.Kubernetes.KubeAPIServer.EncryptionConfig.KMS != nil {
+		requiredExtensions = append(requiredExtensions, requiredExtension{extensionsv1alpha1.ControlPlaneResource, spec.Kubernetes.KubeAPIServer.EncryptionConfig.KMS.Type, fmt.Sprintf("%s KMS type: %s", message, field.NewPath("spec", "kubernetes", "kubeAPIServer", "encryptionConfig", "kms", "type"))})
+	}
+
 	for i, extension := range spec.Extensions {
 		requiredExtensions = append(requiredExtensions, requiredExtension{extensionsv1alpha1.ExtensionResource, extension.Type, fmt.Sprintf("extension type: %s", field.NewPath("spec", "extensions").Index(i).Child("type"))})
 	}
@@ -296,6 +296,13 @@ var _ = Describe("ExtensionValidator", func() {
 					{Type: "foo1"},
 					{Type: "foo2"},
 				},
+				Kubernetes: core.Kubernetes{
+					KubeAPIServer: &core.KubeAPIServerConfig{
+						EncryptionConfig: &core.EncryptionConfig{
+							KMS: &core.KMSConfig{Type: "foo7"},
+						},
+					},
+				},
 				Networking: &core.Networking{
 					Type: ptr.To("foo3"),
 				},
@@ -337,6 +344,7 @@ var _ = Describe("ExtensionValidator", func() {
 				{extensionsv1alpha1.WorkerResource, shoot.Spec.Provider.Type},
 				{extensionsv1alpha1.ContainerRuntimeResource, shoot.Spec.Provider.Workers[1].CRI.ContainerRuntimes[0].Type},
 				{extensionsv1alpha1.ContainerRuntimeResource, shoot.Spec.Provider.Workers[1].CRI.ContainerRuntimes[1].Type},
+				{extensionsv1alpha1.ControlPlaneResource, shoot.Spec.Kubernetes.KubeAPIServer.EncryptionConfig.KMS.Type},
 			}
 			registerAllExtensions = func() {
 				for _, registration := range kindToTypes {
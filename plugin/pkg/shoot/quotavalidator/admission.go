@@ -36,20 +36,11 @@ import (
 	admissioninitializer "github.com/gardener/gardener/pkg/apiserver/admission/initializer"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	gardencorev1beta1listers "github.com/gardener/gardener/pkg/client/core/listers/core/v1beta1"
+	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
 	timeutils "github.com/gardener/gardener/pkg/utils/time"
 	plugin "github.com/gardener/gardener/plugin/pkg"
 )
 
-var (
-	quotaMetricNames = [6]corev1.ResourceName{
-		core.QuotaMetricCPU,
-		core.QuotaMetricGPU,
-		core.QuotaMetricMemory,
-		core.QuotaMetricStorageStandard,
-		core.QuotaMetricStoragePremium,
-		core.QuotaMetricLoadbalancer}
-)
-
 // Register registers a plugin.
 func Register(plugins *admission.Plugins) {
 	plugins.Register(plugin.PluginNameShootQuotaValidator, func(_ io.Reader) (admission.Interface, error) {
@@ -251,7 +242,7 @@ func (q *QuotaValidator) isQuotaExceeded(shoot core.Shoot, quota gardencorev1bet
 	}
 
 	exceededMetrics := make([]corev1.ResourceName, 0)
-	for _, metric := range quotaMetricNames {
+	for _, metric := range gardenerutils.QuotaMetricNames {
 		if _, ok := quota.Spec.Metrics[metric]; !ok {
 			continue
 		}
@@ -271,15 +262,21 @@ func (q *QuotaValidator) determineAllocatedResources(quota gardencorev1beta1.Quo
 		return nil, err
 	}
 
+	// Use the usage reported in the quota status if it is up-to-date to avoid computing the resources of all shoots.
+	allocatedResources, err := q.allocatedResourcesFromStatus(quota, shoot, shoots)
+	if err != nil || allocatedResources != nil {
+		return allocatedResources, err
+	}
+
 	// Collect the resources which are allocated according to the shoot specs
-	allocatedResources := make(corev1.ResourceList)
+	allocatedResources = make(corev1.ResourceList)
 	for _, s := range shoots {
 		shootResources, err := q.getShootResources(s)
 		if err != nil {
 			return nil, err
 		}
-		for _, metric := range quotaMetricNames {
-			allocatedResources[metric] = sumQuantity(allocatedResources[metric], shootResources[metric])
+		for _, metric := range gardenerutils.QuotaMetricNames {
+			allocatedResources[metric] = gardenerutils.SumQuantities(allocatedResources[metric], shootResources[metric])
 		}
 	}
 
@@ -289,9 +286,78 @@ func (q *QuotaValidator) determineAllocatedResources(quota gardencorev1beta1.Quo
 	return allocatedResources, nil
 }
 
-func (q *QuotaValidator) findShootsReferQuota(quota gardencorev1beta1.Quota, shoot core.Shoot) ([]core.Shoot, error) {
+// allocatedResourcesFromStatus returns the resources allocated by the given other shoots as reported in the status of
+// the quota. The usage of the given shoot itself is excluded. Nil is returned if the status is stale, i.e., if it was
+// not computed for the current quota metrics or for the current generations of exactly the given shoots.
+func (q *QuotaValidator) allocatedResourcesFromStatus(quota gardencorev1beta1.Quota, shoot core.Shoot, otherShoots []*gardencorev1beta1.Shoot) (corev1.ResourceList, error) {
+	if !apiequality.Semantic.DeepEqual(quota.Status.Hard, quota.Spec.Metrics) {
+		return nil, nil
+	}
+
+	scope, err := helper.QuotaScope(quota.Spec.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	var usage *gardencorev1beta1.QuotaUsage
+	for i, u := range quota.Status.Usages {
+		if scope != "project" || ptr.Deref(u.Namespace, "") == shoot.Namespace {
+			usage = &quota.Status.Usages[i]
+			break
+		}
+	}
+	if usage == nil {
+		return nil, nil
+	}
+
+	generations := make(map[string]int64, len(usage.Shoots))
+	for _, s := range usage.Shoots {
+		generations[s.Namespace+"/"+s.Name] = s.Generation
+	}
+
+	for _, s := range otherShoots {
+		if generation, ok := generations[s.Namespace+"/"+s.Name]; !ok || generation != s.Generation {
+			return nil, nil
+		}
+		delete(generations, s.Namespace+"/"+s.Name)
+	}
+
+	allocatedResources := usage.Used.DeepCopy()
+
+	// The status may still account the version of the shoot which is currently stored, hence its usage is subtracted.
+	if generation, ok := generations[shoot.Namespace+"/"+shoot.Name]; ok {
+		storedShoot, err := q.shootLister.Shoots(shoot.Namespace).Get(shoot.Name)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		if storedShoot.Generation != generation {
+			return nil, nil
+		}
+
+		shootResources, err := q.getShootResources(storedShoot)
+		if err != nil {
+			return nil, err
+		}
+		for metric, quantity := range allocatedResources {
+			quantity.Sub(shootResources[metric])
+			allocatedResources[metric] = quantity
+		}
+		delete(generations, shoot.Namespace+"/"+shoot.Name)
+	}
+
+	if len(generations) > 0 {
+		return nil, nil
+	}
+
+	return allocatedResources, nil
+}
+
+func (q *QuotaValidator) findShootsReferQuota(quota gardencorev1beta1.Quota, shoot core.Shoot) ([]*gardencorev1beta1.Shoot, error) {
 	var (
-		shootsReferQuota []core.Shoot
+		shootsReferQuota []*gardencorev1beta1.Shoot
 		secretBindings   []gardencorev1beta1.SecretBinding
 	)
 
@@ -326,12 +392,7 @@ func (q *QuotaValidator) findShootsReferQuota(quota gardencorev1beta1.Quota, sho
 				continue
 			}
 			if ptr.Deref(s.Spec.SecretBindingName, "") == binding.Name {
-				coreShoot := &core.Shoot{}
-				if err := gardencorev1beta1.Convert_v1beta1_Shoot_To_core_Shoot(s, coreShoot, nil); err != nil {
-					return nil, apierrors.NewInternalError(err)
-				}
-
-				shootsReferQuota = append(shootsReferQuota, *coreShoot)
+				shootsReferQuota = append(shootsReferQuota, s)
 			}
 		}
 	}
@@ -339,122 +400,30 @@ func (q *QuotaValidator) findShootsReferQuota(quota gardencorev1beta1.Quota, sho
 }
 
 func (q *QuotaValidator) determineRequiredResources(allocatedResources corev1.ResourceList, shoot core.Shoot) (corev1.ResourceList, error) {
-	shootResources, err := q.getShootResources(shoot)
+	v1beta1Shoot := &gardencorev1beta1.Shoot{}
+	if err := gardencorev1beta1.Convert_core_Shoot_To_v1beta1_Shoot(&shoot, v1beta1Shoot, nil); err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+
+	shootResources, err := q.getShootResources(v1beta1Shoot)
 	if err != nil {
 		return nil, err
 	}
 
 	requiredResources := make(corev1.ResourceList)
-	for _, metric := range quotaMetricNames {
-		requiredResources[metric] = sumQuantity(allocatedResources[metric], shootResources[metric])
+	for _, metric := range gardenerutils.QuotaMetricNames {
+		requiredResources[metric] = gardenerutils.SumQuantities(allocatedResources[metric], shootResources[metric])
 	}
 	return requiredResources, nil
 }
 
-func (q *QuotaValidator) getShootResources(shoot core.Shoot) (corev1.ResourceList, error) {
+func (q *QuotaValidator) getShootResources(shoot *gardencorev1beta1.Shoot) (corev1.ResourceList, error) {
 	cloudProfile, err := q.cloudProfileLister.Get(shoot.Spec.CloudProfileName)
 	if err != nil {
 		return nil, apierrors.NewInternalError(fmt.Errorf("could not find referenced cloud profile: %+v", err.Error()))
 	}
 
-	var (
-		countLB      int64 = 1
-		resources          = make(corev1.ResourceList)
-		workers            = getShootWorkerResources(&shoot, cloudProfile)
-		machineTypes       = cloudProfile.Spec.MachineTypes
-		volumeTypes        = cloudProfile.Spec.VolumeTypes
-	)
-
-	for _, worker := range workers {
-		var (
-			machineType *gardencorev1beta1.MachineType
-			volumeType  *gardencorev1beta1.VolumeType
-		)
-
-		// Get the proper machineType
-		for _, e := range machineTypes {
-			element := e
-			if element.Name == worker.Machine.Type {
-				machineType = &element
-				break
-			}
-		}
-		if machineType == nil {
-			return nil, fmt.Errorf("machineType %s not found in CloudProfile %s", worker.Machine.Type, cloudProfile.Name)
-		}
-
-		if worker.Volume != nil {
-			if machineType.Storage != nil {
-				volumeType = &gardencorev1beta1.VolumeType{
-					Class: machineType.Storage.Class,
-				}
-			} else {
-				// Get the proper VolumeType
-				for _, e := range volumeTypes {
-					element := e
-					if worker.Volume.Type != nil && element.Name == *worker.Volume.Type {
-						volumeType = &element
-						break
-					}
-				}
-			}
-		}
-		if volumeType == nil {
-			return nil, fmt.Errorf("VolumeType %s not found in CloudProfile %s", worker.Machine.Type, cloudProfile.Name)
-		}
-
-		// For now we always use the max. amount of resources for quota calculation
-		resources[core.QuotaMetricCPU] = sumQuantity(resources[core.QuotaMetricCPU], multiplyQuantity(machineType.CPU, worker.Maximum))
-		resources[core.QuotaMetricGPU] = sumQuantity(resources[core.QuotaMetricGPU], multiplyQuantity(machineType.GPU, worker.Maximum))
-		resources[core.QuotaMetricMemory] = sumQuantity(resources[core.QuotaMetricMemory], multiplyQuantity(machineType.Memory, worker.Maximum))
-
-		size, _ := resource.ParseQuantity("0Gi")
-		if worker.Volume != nil {
-			size, err = resource.ParseQuantity(worker.Volume.VolumeSize)
-			if err != nil {
-				return nil, err
-			}
-		}
-
-		switch volumeType.Class {
-		case core.VolumeClassStandard:
-			resources[core.QuotaMetricStorageStandard] = sumQuantity(resources[core.QuotaMetricStorageStandard], multiplyQuantity(size, worker.Maximum))
-		case core.VolumeClassPremium:
-			resources[core.QuotaMetricStoragePremium] = sumQuantity(resources[core.QuotaMetricStoragePremium], multiplyQuantity(size, worker.Maximum))
-		default:
-			return nil, fmt.Errorf("unknown volumeType class %s", volumeType.Class)
-		}
-	}
-
-	if helper.NginxIngressEnabled(shoot.Spec.Addons) {
-		countLB++
-	}
-	resources[core.QuotaMetricLoadbalancer] = *resource.NewQuantity(countLB, resource.DecimalSI)
-
-	return resources, nil
-}
-
-func getShootWorkerResources(shoot *core.Shoot, cloudProfile *gardencorev1beta1.CloudProfile) []core.Worker {
-	workers := make([]core.Worker, 0, len(shoot.Spec.Provider.Workers))
-
-	for _, worker := range shoot.Spec.Provider.Workers {
-		workerCopy := worker.DeepCopy()
-
-		if worker.Volume == nil {
-			for _, machineType := range cloudProfile.Spec.MachineTypes {
-				if worker.Machine.Type == machineType.Name && machineType.Storage != nil && machineType.Storage.StorageSize != nil {
-					workerCopy.Volume = &core.Volume{
-						Type:       &machineType.Storage.Type,
-						VolumeSize: machineType.Storage.StorageSize.String(),
-					}
-				}
-			}
-		}
-
-		workers = append(workers, *workerCopy)
-	}
-
-	return workers
+	return gardenerutils.ShootQuotaUsage(shoot, cloudProfile)
 }
 
 func lifetimeVerificationNeeded(new, old core.Shoot) bool {
@@ -494,19 +463,3 @@ func hasSufficientQuota(limit, required resource.Quantity) bool {
 	compareCode := limit.Cmp(required)
 	return compareCode != -1
 }
-
-func sumQuantity(values ...resource.Quantity) resource.Quantity {
-	res := resource.Quantity{}
-	for _, v := range values {
-		res.Add(v)
-	}
-	return res
-}
-
-func multiplyQuantity(quantity resource.Quantity, multiplier int32) resource.Quantity {
-	res := resource.Quantity{}
-	for i := 0; i < int(multiplier); i++ {
-		res.Add(quantity)
-	}
-	return res
-}
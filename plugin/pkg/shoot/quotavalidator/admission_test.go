@@ -351,6 +351,90 @@ var _ = Describe("quotavalidator", func() {
 			})
 		})
 
+		Context("tests for Quotas reporting their usage in the status", func() {
+			var shoot2 gardencorev1beta1.Shoot
+
+			BeforeEach(func() {
+				secretBinding.Quotas = []corev1.ObjectReference{{Namespace: trialNamespace, Name: "secret-quota"}}
+				Expect(coreInformerFactory.Core().V1beta1().SecretBindings().Informer().GetStore().Add(&secretBinding)).To(Succeed())
+
+				shoot2 = *versionedShootBase.DeepCopy()
+				shoot2.Name = "test-shoot-2"
+				shoot2.Generation = 1
+				Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(&shoot2)).To(Succeed())
+			})
+
+			setUsage := func(cpu string, shoots ...gardencorev1beta1.QuotaUsageShoot) {
+				quotaSecret.Status = gardencorev1beta1.QuotaStatus{
+					Hard: quotaSecret.Spec.Metrics.DeepCopy(),
+					Usages: []gardencorev1beta1.QuotaUsage{{
+						Used: corev1.ResourceList{
+							core.QuotaMetricCPU:             resource.MustParse(cpu),
+							core.QuotaMetricGPU:             resource.MustParse("0"),
+							core.QuotaMetricMemory:          resource.MustParse("5Gi"),
+							core.QuotaMetricStorageStandard: resource.MustParse("30Gi"),
+							core.QuotaMetricStoragePremium:  resource.MustParse("0Gi"),
+							core.QuotaMetricLoadbalancer:    resource.MustParse("1"),
+						},
+						Shoots: shoots,
+					}},
+				}
+				Expect(coreInformerFactory.Core().V1beta1().Quotas().Informer().GetStore().Add(&quotaSecret)).To(Succeed())
+			}
+
+			It("should use the usage reported in the status if it is up-to-date", func() {
+				setUsage("3", gardencorev1beta1.QuotaUsageShoot{Namespace: namespace, Name: shoot2.Name, Generation: 1})
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring("quota limits exceeded")))
+			})
+
+			It("should compute the usage if the status was computed for another generation of a shoot", func() {
+				setUsage("3", gardencorev1beta1.QuotaUsageShoot{Namespace: namespace, Name: shoot2.Name, Generation: 2})
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+			})
+
+			It("should compute the usage if the status does not account all shoots", func() {
+				setUsage("3")
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+			})
+
+			It("should compute the usage if the status was computed for other quota metrics", func() {
+				setUsage("3", gardencorev1beta1.QuotaUsageShoot{Namespace: namespace, Name: shoot2.Name, Generation: 1})
+				quotaSecret.Status.Hard[core.QuotaMetricCPU] = resource.MustParse("5")
+				Expect(coreInformerFactory.Core().V1beta1().Quotas().Informer().GetStore().Add(&quotaSecret)).To(Succeed())
+
+				attrs := admission.NewAttributesRecord(&shoot, nil, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Create, &metav1.CreateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+			})
+
+			It("should subtract the usage of the stored version of the shoot which is updated", func() {
+				Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Delete(&shoot2)).To(Succeed())
+				storedShoot := *versionedShootBase.DeepCopy()
+				storedShoot.Generation = 1
+				Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Add(&storedShoot)).To(Succeed())
+				setUsage("3", gardencorev1beta1.QuotaUsageShoot{Namespace: namespace, Name: shoot.Name, Generation: 1})
+
+				oldShoot = *shoot.DeepCopy()
+				shoot.Spec.Provider.Workers[0].Maximum = 2
+				attrs := admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "", admission.Update, &metav1.UpdateOptions{}, false, nil)
+
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(MatchError(ContainSubstring("quota limits exceeded")))
+
+				storedShoot.Generation = 2
+				Expect(coreInformerFactory.Core().V1beta1().Shoots().Informer().GetStore().Update(&storedShoot)).To(Succeed())
+				Expect(admissionHandler.Validate(context.TODO(), attrs, nil)).To(Succeed())
+			})
+		})
+
 		Context("tests for Quota validation corner cases", func() {
 			It("should pass because shoot is intended to get deleted", func() {
 				var now metav1.Time
@@ -53,6 +53,7 @@ import (
 	"github.com/gardener/gardener/pkg/client/kubernetes"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
+	"github.com/gardener/gardener/pkg/utils/gardener/shootstate"
 	cidrvalidation "github.com/gardener/gardener/pkg/utils/validation/cidr"
 	versionutils "github.com/gardener/gardener/pkg/utils/version"
 	plugin "github.com/gardener/gardener/plugin/pkg"
@@ -71,14 +72,16 @@ func Register(plugins *admission.Plugins) {
 // ValidateShoot contains listers and admission handler.
 type ValidateShoot struct {
 	*admission.Handler
-	authorizer          authorizer.Authorizer
-	secretLister        kubecorev1listers.SecretLister
-	cloudProfileLister  gardencorev1beta1listers.CloudProfileLister
-	seedLister          gardencorev1beta1listers.SeedLister
-	shootLister         gardencorev1beta1listers.ShootLister
-	projectLister       gardencorev1beta1listers.ProjectLister
-	secretBindingLister gardencorev1beta1listers.SecretBindingLister
-	readyFunc           admission.ReadyFunc
+	authorizer                   authorizer.Authorizer
+	secretLister                 kubecorev1listers.SecretLister
+	cloudProfileLister           gardencorev1beta1listers.CloudProfileLister
+	seedLister                   gardencorev1beta1listers.SeedLister
+	shootLister                  gardencorev1beta1listers.ShootLister
+	projectLister                gardencorev1beta1listers.ProjectLister
+	secretBindingLister          gardencorev1beta1listers.SecretBindingLister
+	controllerRegistrationLister gardencorev1beta1listers.ControllerRegistrationLister
+	shootStateLister             gardencorev1beta1listers.ShootStateLister
+	readyFunc                    admission.ReadyFunc
 }
 
 var (
@@ -124,6 +127,12 @@ func (v *ValidateShoot) SetCoreInformerFactory(f gardencoreinformers.SharedInfor
 	secretBindingInformer := f.Core().V1beta1().SecretBindings()
 	v.secretBindingLister = secretBindingInformer.Lister()
 
+	controllerRegistrationInformer := f.Core().V1beta1().ControllerRegistrations()
+	v.controllerRegistrationLister = controllerRegistrationInformer.Lister()
+
+	shootStateInformer := f.Core().V1beta1().ShootStates()
+	v.shootStateLister = shootStateInformer.Lister()
+
 	readyFuncs = append(
 		readyFuncs,
 		seedInformer.Informer().HasSynced,
@@ -131,6 +140,8 @@ func (v *ValidateShoot) SetCoreInformerFactory(f gardencoreinformers.SharedInfor
 		cloudProfileInformer.Informer().HasSynced,
 		projectInformer.Informer().HasSynced,
 		secretBindingInformer.Informer().HasSynced,
+		controllerRegistrationInformer.Informer().HasSynced,
+		shootStateInformer.Informer().HasSynced,
 	)
 }
 
@@ -162,6 +173,12 @@ func (v *ValidateShoot) ValidateInitialization() error {
 	if v.projectLister == nil {
 		return errors.New("missing project lister")
 	}
+	if v.controllerRegistrationLister == nil {
+		return errors.New("missing controllerRegistration lister")
+	}
+	if v.shootStateLister == nil {
+		return errors.New("missing shootState lister")
+	}
 	return nil
 }
 
@@ -275,7 +292,7 @@ func (v *ValidateShoot) Admit(ctx context.Context, a admission.Attributes, _ adm
 	if err := validationContext.validateProjectMembership(a); err != nil {
 		return err
 	}
-	if err := validationContext.validateScheduling(ctx, a, v.authorizer, v.shootLister, v.seedLister); err != nil {
+	if err := validationContext.validateScheduling(ctx, a, v.authorizer, v.shootLister, v.seedLister, v.controllerRegistrationLister, v.shootStateLister); err != nil {
 		return err
 	}
 	if err := validationContext.validateDeletion(a); err != nil {
@@ -366,7 +383,15 @@ func (c *validationContext) validateSeedSelectionForMultiZonalShoot() error {
 	return nil
 }
 
-func (c *validationContext) validateScheduling(ctx context.Context, a admission.Attributes, authorizer authorizer.Authorizer, shootLister gardencorev1beta1listers.ShootLister, seedLister gardencorev1beta1listers.SeedLister) error {
+func (c *validationContext) validateScheduling(
+	ctx context.Context,
+	a admission.Attributes,
+	authorizer authorizer.Authorizer,
+	shootLister gardencorev1beta1listers.ShootLister,
+	seedLister gardencorev1beta1listers.SeedLister,
+	controllerRegistrationLister gardencorev1beta1listers.ControllerRegistrationLister,
+	shootStateLister gardencorev1beta1listers.ShootStateLister,
+) error {
 	var (
 		shootIsBeingScheduled          = c.oldShoot.Spec.SeedName == nil && c.shoot.Spec.SeedName != nil
 		shootIsBeingRescheduled        = c.oldShoot.Spec.SeedName != nil && c.shoot.Spec.SeedName != nil && *c.shoot.Spec.SeedName != *c.oldShoot.Spec.SeedName
@@ -462,6 +487,26 @@ func (c *validationContext) validateScheduling(ctx context.Context, a admission.
 		if oldSeed.Spec.Provider.Type != c.seed.Spec.Provider.Type {
 			return admission.NewForbidden(a, fmt.Errorf("cannot change seed because cloud provider for new seed (%s) is not equal to cloud provider for old seed (%s)", c.seed.Spec.Provider.Type, oldSeed.Spec.Provider.Type))
 		}
+
+		if err := c.validateRequiredExtensionsForMigration(a, oldSeed, controllerRegistrationLister); err != nil {
+			return err
+		}
+
+		shootState, err := shootStateLister.ShootStates(c.shoot.Namespace).Get(c.shoot.Name)
+		if err != nil {
+			if !apierrors.IsNotFound(err) {
+				return apierrors.NewInternalError(fmt.Errorf("could not get ShootState: %+v", err.Error()))
+			}
+			shootState = nil
+		}
+
+		missingKey, err := shootstate.MissingKeyEncryptionKey(shootState, oldSeed, c.seed)
+		if err != nil {
+			return apierrors.NewInternalError(err)
+		}
+		if missingKey != "" {
+			return admission.NewForbidden(a, fmt.Errorf("cannot change seed because the key encryption key %q for the ShootState is available to the gardenlet of old seed %q but not to the gardenlet of new seed %q", missingKey, oldSeed.Name, c.seed.Name))
+		}
 	} else if !reflect.DeepEqual(c.oldShoot.Spec, c.shoot.Spec) {
 		if wasShootRescheduledToNewSeed(c.shoot) {
 			return admission.NewForbidden(a, fmt.Errorf("shoot spec cannot be changed because shoot has been rescheduled to a new seed"))
@@ -508,6 +553,32 @@ func (c *validationContext) validateScheduling(ctx context.Context, a admission.
 	return nil
 }
 
+// validateRequiredExtensionsForMigration checks that all extensions required by the shoot can be deployed to the new
+// seed before the control plane migration is started. Otherwise, the control plane would be torn down on the old seed
+// and could not be restored on the new seed.
+func (c *validationContext) validateRequiredExtensionsForMigration(a admission.Attributes, oldSeed *gardencorev1beta1.Seed, controllerRegistrationLister gardencorev1beta1listers.ControllerRegistrationLister) error {
+	controllerRegistrations, err := controllerRegistrationLister.List(labels.Everything())
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("could not list controller registrations: %w", err))
+	}
+
+	shoot := &gardencorev1beta1.Shoot{}
+	if err := gardencorev1beta1.Convert_core_Shoot_To_v1beta1_Shoot(c.shoot, shoot, nil); err != nil {
+		return apierrors.NewInternalError(err)
+	}
+
+	controllerRegistrationList := &gardencorev1beta1.ControllerRegistrationList{}
+	for _, controllerRegistration := range controllerRegistrations {
+		controllerRegistrationList.Items = append(controllerRegistrationList.Items, *controllerRegistration)
+	}
+
+	if missingExtensions := gardenerutils.ComputeMissingExtensionsForMigration(shoot, oldSeed, c.seed, controllerRegistrationList); len(missingExtensions) > 0 {
+		return admission.NewForbidden(a, fmt.Errorf("cannot change seed because no ControllerRegistration can be deployed to new seed %q for the following extensions required by the shoot: %s", c.seed.Name, strings.Join(missingExtensions, ", ")))
+	}
+
+	return nil
+}
+
 func getNumberOfShootsOnSeed(shootLister gardencorev1beta1listers.ShootLister, seedName string) (int64, error) {
 	allShoots, err := shootLister.Shoots(metav1.NamespaceAll).List(labels.Everything())
 	if err != nil {
@@ -40,6 +40,7 @@ import (
 	"github.com/gardener/gardener/pkg/apis/core"
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	gardencoreinformers "github.com/gardener/gardener/pkg/client/core/informers/externalversions"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	gardenerutils "github.com/gardener/gardener/pkg/utils/gardener"
@@ -288,6 +289,23 @@ var _ = Describe("validator", func() {
 					Namespace: namespaceName,
 				},
 			}
+
+			controllerRegistrationBase = gardencorev1beta1.ControllerRegistration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "extensions",
+				},
+				Spec: gardencorev1beta1.ControllerRegistrationSpec{
+					Resources: []gardencorev1beta1.ControllerResource{
+						{Kind: extensionsv1alpha1.BackupBucketResource},
+						{Kind: extensionsv1alpha1.BackupEntryResource},
+						{Kind: extensionsv1alpha1.ControlPlaneResource},
+						{Kind: extensionsv1alpha1.ControlPlaneResource, Type: "unknown"},
+						{Kind: extensionsv1alpha1.InfrastructureResource, Type: "unknown"},
+						{Kind: extensionsv1alpha1.WorkerResource, Type: "unknown"},
+						{Kind: extensionsv1alpha1.OperatingSystemConfigResource, Type: validMachineImageName},
+					},
+				},
+			}
 		)
 
 		BeforeEach(func() {
@@ -308,6 +326,7 @@ var _ = Describe("validator", func() {
 			admissionHandler.SetKubeInformerFactory(kubeInformerFactory)
 			coreInformerFactory = gardencoreinformers.NewSharedInformerFactory(nil, 0)
 			admissionHandler.SetCoreInformerFactory(coreInformerFactory)
+			Expect(coreInformerFactory.Core().V1beta1().ControllerRegistrations().Informer().GetStore().Add(controllerRegistrationBase.DeepCopy())).To(Succeed())
 
 			authorizeAttributes = authorizer.AttributesRecord{
 				User:            userInfo,
@@ -4608,6 +4627,126 @@ var _ = Describe("validator", func() {
 					Expect(err).To(BeForbiddenError())
 					Expect(err.Error()).To(ContainSubstring("cannot change seed because cloud provider for new seed (%s) is not equal to cloud provider for old seed (%s)", newSeed.Spec.Provider.Type, seed.Spec.Provider.Type))
 				})
+
+				Context("required extensions", func() {
+					var controllerRegistration *gardencorev1beta1.ControllerRegistration
+
+					BeforeEach(func() {
+						controllerRegistration = controllerRegistrationBase.DeepCopy()
+					})
+
+					updateControllerRegistration := func() {
+						ExpectWithOffset(1, coreInformerFactory.Core().V1beta1().ControllerRegistrations().Informer().GetStore().Update(controllerRegistration)).To(Succeed())
+					}
+
+					It("should allow update of binding because all required extensions can be deployed to the new Seed", func() {
+						attrs := admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "binding", admission.Update, &metav1.UpdateOptions{}, false, nil)
+						Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(Succeed())
+					})
+
+					It("should reject update of binding because required extensions are not registered", func() {
+						controllerRegistration.Spec.Resources = controllerRegistrationBase.Spec.Resources[1:5]
+						updateControllerRegistration()
+
+						attrs := admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "binding", admission.Update, &metav1.UpdateOptions{}, false, nil)
+						err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+						Expect(err).To(BeForbiddenError())
+						Expect(err).To(MatchError(ContainSubstring(fmt.Sprintf("cannot change seed because no ControllerRegistration can be deployed to new seed %q for the following extensions required by the shoot: BackupBucket/, OperatingSystemConfig/%s, Worker/unknown", newSeedName, validMachineImageName))))
+					})
+
+					It("should reject update of binding because the seed selector of the ControllerRegistration does not match the new Seed", func() {
+						controllerRegistration.Spec.Deployment = &gardencorev1beta1.ControllerRegistrationDeployment{
+							SeedSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"foo": "bar"}},
+						}
+						updateControllerRegistration()
+
+						attrs := admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "binding", admission.Update, &metav1.UpdateOptions{}, false, nil)
+						err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+						Expect(err).To(BeForbiddenError())
+						Expect(err).To(MatchError(ContainSubstring("cannot change seed because no ControllerRegistration can be deployed to new seed %q", newSeedName)))
+					})
+
+					It("should reject update of binding because the ControllerRegistration is being deleted", func() {
+						controllerRegistration.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+						updateControllerRegistration()
+
+						attrs := admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "binding", admission.Update, &metav1.UpdateOptions{}, false, nil)
+						err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+						Expect(err).To(BeForbiddenError())
+						Expect(err).To(MatchError(ContainSubstring("cannot change seed because no ControllerRegistration can be deployed to new seed %q", newSeedName)))
+					})
+
+					It("should reject update of binding because the backup provider of the old Seed is not supported on the new Seed", func() {
+						seed.Spec.Backup = &gardencorev1beta1.SeedBackup{Provider: "other"}
+
+						attrs := admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "binding", admission.Update, &metav1.UpdateOptions{}, false, nil)
+						err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+						Expect(err).To(BeForbiddenError())
+						Expect(err).To(MatchError(ContainSubstring("for the following extensions required by the shoot: BackupEntry/other")))
+					})
+				})
+
+				Context("ShootState encryption", func() {
+					BeforeEach(func() {
+						seed.Status.ShootStateEncryptionKeys = []gardencorev1beta1.ShootStateEncryptionKey{
+							{Name: "key1", Fingerprint: "fingerprint1"},
+							{Name: "key2", Fingerprint: "fingerprint2", Primary: true},
+						}
+					})
+
+					addShootState := func(keyName, keyFingerprint string) {
+						Expect(coreInformerFactory.Core().V1beta1().ShootStates().Informer().GetStore().Add(&gardencorev1beta1.ShootState{
+							ObjectMeta: metav1.ObjectMeta{Name: shoot.Name, Namespace: shoot.Namespace},
+							Spec: gardencorev1beta1.ShootStateSpec{
+								Gardener: []gardencorev1beta1.GardenerResourceData{{
+									Name: "data-encryption-key",
+									Type: "data-encryption-key",
+									Data: runtime.RawExtension{Raw: []byte(`{"keyName":"` + keyName + `","keyFingerprint":"` + keyFingerprint + `","wrappedKey":""}`)},
+								}},
+							},
+						})).To(Succeed())
+					}
+
+					It("should allow update of binding because the new Seed has the primary key encryption key of the old Seed", func() {
+						newSeed.Status.ShootStateEncryptionKeys = []gardencorev1beta1.ShootStateEncryptionKey{{Name: "other-name", Fingerprint: "fingerprint2"}}
+
+						attrs := admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "binding", admission.Update, &metav1.UpdateOptions{}, false, nil)
+						Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(Succeed())
+					})
+
+					It("should reject update of binding because the new Seed lacks the primary key encryption key of the old Seed", func() {
+						newSeed.Status.ShootStateEncryptionKeys = []gardencorev1beta1.ShootStateEncryptionKey{{Name: "key2", Fingerprint: "fingerprint1"}}
+
+						attrs := admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "binding", admission.Update, &metav1.UpdateOptions{}, false, nil)
+						err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+						Expect(err).To(BeForbiddenError())
+						Expect(err).To(MatchError(ContainSubstring("cannot change seed because the key encryption key %q for the ShootState is available to the gardenlet of old seed %q but not to the gardenlet of new seed %q", "key2", seedName, newSeedName)))
+					})
+
+					It("should allow update of binding because the new Seed has the key encryption key used for the existing ShootState", func() {
+						addShootState("key1", "fingerprint1")
+						newSeed.Status.ShootStateEncryptionKeys = []gardencorev1beta1.ShootStateEncryptionKey{{Name: "key1", Fingerprint: "fingerprint1"}}
+
+						attrs := admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "binding", admission.Update, &metav1.UpdateOptions{}, false, nil)
+						Expect(admissionHandler.Admit(context.TODO(), attrs, nil)).To(Succeed())
+					})
+
+					It("should reject update of binding because the new Seed lacks the key encryption key used for the existing ShootState", func() {
+						addShootState("key1", "fingerprint1")
+						newSeed.Status.ShootStateEncryptionKeys = []gardencorev1beta1.ShootStateEncryptionKey{{Name: "key2", Fingerprint: "fingerprint2"}}
+
+						attrs := admission.NewAttributesRecord(&shoot, &oldShoot, core.Kind("Shoot").WithVersion("version"), shoot.Namespace, shoot.Name, core.Resource("shoots").WithVersion("version"), "binding", admission.Update, &metav1.UpdateOptions{}, false, nil)
+						err := admissionHandler.Admit(context.TODO(), attrs, nil)
+
+						Expect(err).To(BeForbiddenError())
+						Expect(err).To(MatchError(ContainSubstring("cannot change seed because the key encryption key %q for the ShootState is available to the gardenlet of old seed %q but not to the gardenlet of new seed %q", "key1", seedName, newSeedName)))
+					})
+				})
 			})
 
 			Context("taints and tolerations", func() {
@@ -18,16 +18,20 @@ import (
 	"context"
 	"path"
 	"path/filepath"
+	"reflect"
 	"time"
 
 	"github.com/Masterminds/semver/v3"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	"github.com/spf13/afero"
+	coordinationv1 "k8s.io/api/coordination/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -41,6 +45,7 @@ import (
 	fakeregistry "github.com/gardener/gardener/pkg/nodeagent/registry/fake"
 	"github.com/gardener/gardener/pkg/utils"
 	"github.com/gardener/gardener/pkg/utils/test"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
 )
 
 var _ = Describe("OperatingSystemConfig controller tests", func() {
@@ -63,6 +68,9 @@ var _ = Describe("OperatingSystemConfig controller tests", func() {
 
 		imageMountDirectory                string
 		cancelFunc                         cancelFuncEnsurer
+		syncPeriod                         time.Duration
+		healthGate                         *config.OperatingSystemConfigHealthGate
+		rollout                            *config.OperatingSystemConfigRolloutConfig
 		pathBootstrapTokenFile             = filepath.Join("/", "var", "lib", "gardener-node-agent", "credentials", "bootstrap-token")
 		pathKubeletBootstrapKubeconfigFile = filepath.Join("/", "var", "lib", "kubelet", "kubeconfig-bootstrap")
 	)
@@ -78,22 +86,17 @@ var _ = Describe("OperatingSystemConfig controller tests", func() {
 		DeferCleanup(func() { Expect(fakeFS.RemoveAll(imageMountDirectory)).To(Succeed()) })
 
 		cancelFunc = cancelFuncEnsurer{}
-
-		By("Setup manager")
-		mgr, err := manager.New(restConfig, manager.Options{
-			Metrics: metricsserver.Options{BindAddress: "0"},
-			Cache: cache.Options{
-				DefaultLabelSelector: labels.SelectorFromSet(labels.Set{testID: testRunID}),
-			},
-		})
-		Expect(err).NotTo(HaveOccurred())
+		syncPeriod = time.Hour
+		healthGate = nil
+		rollout = nil
 
 		node = &corev1.Node{
 			ObjectMeta: metav1.ObjectMeta{
 				Name: testRunID,
 				Labels: map[string]string{
-					testID:                   testRunID,
-					"kubernetes.io/hostname": hostName,
+					testID:                       testRunID,
+					"kubernetes.io/hostname":     hostName,
+					"worker.gardener.cloud/pool": testRunID,
 				},
 			},
 		}
@@ -105,34 +108,6 @@ var _ = Describe("OperatingSystemConfig controller tests", func() {
 			Expect(testClient.Delete(ctx, node)).To(Succeed())
 		})
 
-		By("Register controller")
-		Expect((&operatingsystemconfig.Reconciler{
-			Config: config.OperatingSystemConfigControllerConfig{
-				SyncPeriod:        &metav1.Duration{Duration: time.Hour},
-				SecretName:        oscSecretName,
-				KubernetesVersion: kubernetesVersion,
-				SyncJitterPeriod:  &metav1.Duration{Duration: 0},
-			},
-			DBus:          fakeDBus,
-			FS:            fakeFS,
-			HostName:      hostName,
-			Extractor:     fakeregistry.NewExtractor(fakeFS, imageMountDirectory),
-			CancelContext: cancelFunc.cancel,
-		}).AddToManager(ctx, mgr)).To(Succeed())
-
-		By("Start manager")
-		mgrContext, mgrCancel := context.WithCancel(ctx)
-
-		go func() {
-			defer GinkgoRecover()
-			Expect(mgr.Start(mgrContext)).To(Succeed())
-		}()
-
-		DeferCleanup(func() {
-			By("Stop manager")
-			mgrCancel()
-		})
-
 		file1 = extensionsv1alpha1.File{
 			Path:        "/example/file",
 			Content:     extensionsv1alpha1.FileContent{Inline: &extensionsv1alpha1.FileContentInline{Encoding: "", Data: "file1"}},
@@ -306,6 +281,47 @@ var _ = Describe("OperatingSystemConfig controller tests", func() {
 		})
 	})
 
+	JustBeforeEach(func() {
+		By("Setup manager")
+		mgr, err := manager.New(restConfig, manager.Options{
+			Metrics: metricsserver.Options{BindAddress: "0"},
+			Cache: cache.Options{
+				DefaultLabelSelector: labels.SelectorFromSet(labels.Set{testID: testRunID}),
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		By("Register controller")
+		Expect((&operatingsystemconfig.Reconciler{
+			Config: config.OperatingSystemConfigControllerConfig{
+				SyncPeriod:        &metav1.Duration{Duration: syncPeriod},
+				SecretName:        oscSecretName,
+				KubernetesVersion: kubernetesVersion,
+				SyncJitterPeriod:  &metav1.Duration{Duration: 0},
+				HealthGate:        healthGate,
+				Rollout:           rollout,
+			},
+			DBus:          fakeDBus,
+			FS:            fakeFS,
+			HostName:      hostName,
+			Extractor:     fakeregistry.NewExtractor(fakeFS, imageMountDirectory),
+			CancelContext: cancelFunc.cancel,
+		}).AddToManager(ctx, mgr)).To(Succeed())
+
+		By("Start manager")
+		mgrContext, mgrCancel := context.WithCancel(ctx)
+
+		go func() {
+			defer GinkgoRecover()
+			Expect(mgr.Start(mgrContext)).To(Succeed())
+		}()
+
+		DeferCleanup(func() {
+			By("Stop manager")
+			mgrCancel()
+		})
+	})
+
 	It("should reconcile the configuration when there is no previous OSC", func() {
 		By("Wait for node annotations to be updated")
 		Eventually(func(g Gomega) map[string]string {
@@ -534,6 +550,481 @@ var _ = Describe("OperatingSystemConfig controller tests", func() {
 		By("Expect that cancel func has been called")
 		Expect(cancelFunc.called).To(BeTrue())
 	})
+
+	It("should not restart units when only their health check changes", func() {
+		var lastAppliedOSC []byte
+		By("Wait last-applied OSC file to be persisted")
+		Eventually(func() error {
+			var err error
+			lastAppliedOSC, err = fakeFS.ReadFile("/var/lib/gardener-node-agent/last-applied-osc.yaml")
+			return err
+		}).Should(Succeed())
+
+		fakeDBus.Actions = nil // reset actions on dbus to not repeat assertions from above for update scenario
+
+		By("Update Operating System Config")
+		operatingSystemConfig.Spec.Units[5].HealthCheck = &extensionsv1alpha1.UnitHealthCheck{FailureThreshold: ptr.To[int32](5)}
+
+		var err error
+		oscRaw, err = runtime.Encode(codec, operatingSystemConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		By("Update Secret containing the operating system config")
+		patch := client.MergeFrom(oscSecret.DeepCopy())
+		oscSecret.Annotations["checksum/data-script"] = utils.ComputeSHA256Hex(oscRaw)
+		oscSecret.Data["osc.yaml"] = oscRaw
+		Expect(testClient.Patch(ctx, oscSecret, patch)).To(Succeed())
+
+		By("Wait last-applied OSC file to be updated")
+		Eventually(func(g Gomega) []byte {
+			content, err := fakeFS.ReadFile("/var/lib/gardener-node-agent/last-applied-osc.yaml")
+			g.Expect(err).NotTo(HaveOccurred())
+			return content
+		}).ShouldNot(Equal(lastAppliedOSC))
+
+		By("Assert that no unit has been restarted")
+		Expect(fakeDBus.Actions).NotTo(ContainElement(HaveField("Action", fakedbus.ActionRestart)))
+	})
+
+	Context("with rollout coordination", func() {
+		var otherLease *coordinationv1.Lease
+
+		BeforeEach(func() {
+			rollout = &config.OperatingSystemConfigRolloutConfig{
+				MaxUnavailable: intstr.FromInt32(1),
+				LeaseDuration:  &metav1.Duration{Duration: time.Hour},
+			}
+
+			By("Create rollout lease held by another node")
+			otherLease = &coordinationv1.Lease{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "gardener-node-agent-rollout-" + testRunID + "-0",
+					Namespace: metav1.NamespaceSystem,
+					Labels: map[string]string{
+						"app":                        "gardener-node-agent-rollout",
+						"worker.gardener.cloud/pool": testRunID,
+					},
+				},
+				Spec: coordinationv1.LeaseSpec{
+					HolderIdentity:       ptr.To("other-node"),
+					LeaseDurationSeconds: ptr.To(int32(3600)),
+					RenewTime:            ptr.To(metav1.NewMicroTime(time.Now())),
+				},
+			}
+			Expect(testClient.Create(ctx, otherLease)).To(Succeed())
+			DeferCleanup(func() {
+				Expect(testClient.Delete(ctx, otherLease)).To(Or(Succeed(), BeNotFoundError()))
+			})
+		})
+
+		It("should wait for a free rollout slot and release it once the node is ready again", func() {
+			By("Mark node as ready before the changes are applied")
+			patch := client.MergeFrom(node.DeepCopy())
+			node.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue, LastHeartbeatTime: metav1.NewTime(time.Now().Add(-time.Hour))}}
+			Expect(testClient.Status().Patch(ctx, node, patch)).To(Succeed())
+
+			By("Assert that the configuration is not applied while all rollout slots are taken")
+			Consistently(func(g Gomega) map[string]string {
+				updatedNode := &corev1.Node{}
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+				return updatedNode.Annotations
+			}).ShouldNot(HaveKey("checksum/cloud-config-data"))
+
+			By("Release rollout lease of other node")
+			patch = client.MergeFrom(otherLease.DeepCopy())
+			otherLease.Spec.HolderIdentity = nil
+			Expect(testClient.Patch(ctx, otherLease, patch)).To(Succeed())
+
+			By("Wait for node annotations to be updated")
+			Eventually(func(g Gomega) map[string]string {
+				updatedNode := &corev1.Node{}
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+				return updatedNode.Annotations
+			}).WithTimeout(time.Minute).Should(HaveKeyWithValue("checksum/cloud-config-data", utils.ComputeSHA256Hex(oscRaw)))
+
+			By("Assert that the node keeps the rollout slot since its Ready condition is older than the changes")
+			Consistently(func(g Gomega) *string {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(otherLease), otherLease)).To(Succeed())
+				return otherLease.Spec.HolderIdentity
+			}).Should(PointTo(Equal(node.Name)))
+
+			By("Report ready heartbeat and wait for rollout slot to be released")
+			Eventually(func(g Gomega) *string {
+				reportReadyHeartbeat(g, node)
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(otherLease), otherLease)).To(Succeed())
+				return otherLease.Spec.HolderIdentity
+			}).WithTimeout(time.Minute).WithPolling(time.Second).Should(BeNil())
+		})
+
+		Context("with health gate", func() {
+			BeforeEach(func() {
+				healthGate = &config.OperatingSystemConfigHealthGate{
+					Timeout:        &metav1.Duration{Duration: 2 * time.Second},
+					KubeletHealthy: ptr.To(false),
+				}
+			})
+
+			It("should release the rollout slot when the changes are rolled back", func() {
+				By("Release rollout lease of other node")
+				patch := client.MergeFrom(otherLease.DeepCopy())
+				otherLease.Spec.HolderIdentity = nil
+				Expect(testClient.Patch(ctx, otherLease, patch)).To(Succeed())
+
+				By("Wait for node annotations to be updated")
+				Eventually(func(g Gomega) map[string]string {
+					updatedNode := &corev1.Node{}
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+					return updatedNode.Annotations
+				}).WithTimeout(time.Minute).Should(HaveKeyWithValue("checksum/cloud-config-data", utils.ComputeSHA256Hex(oscRaw)))
+
+				By("Report ready heartbeat and wait for rollout slot to be released")
+				Eventually(func(g Gomega) *string {
+					reportReadyHeartbeat(g, node)
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(otherLease), otherLease)).To(Succeed())
+					return otherLease.Spec.HolderIdentity
+				}).WithTimeout(time.Minute).WithPolling(time.Second).Should(BeNil())
+
+				By("Update Operating System Config such that a failing unit is restarted")
+				fakeDBus.SetActiveState(unit7.Name, "failed")
+				operatingSystemConfig.Spec.Files[2].Content.Inline.Data = "changeme"
+
+				var err error
+				oscRaw, err = runtime.Encode(codec, operatingSystemConfig)
+				Expect(err).NotTo(HaveOccurred())
+
+				patch = client.MergeFrom(oscSecret.DeepCopy())
+				oscSecret.Annotations["checksum/data-script"] = utils.ComputeSHA256Hex(oscRaw)
+				oscSecret.Data["osc.yaml"] = oscRaw
+				Expect(testClient.Patch(ctx, oscSecret, patch)).To(Succeed())
+
+				By("Wait for the changes to be rolled back")
+				Eventually(func(g Gomega) map[string]string {
+					updatedNode := &corev1.Node{}
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+					return updatedNode.Annotations
+				}).WithTimeout(time.Minute).Should(HaveKeyWithValue("checksum/rolled-back-cloud-config-data", utils.ComputeSHA256Hex(oscRaw)))
+
+				By("Assert that the rollout slot has been released")
+				Expect(testClient.Get(ctx, client.ObjectKeyFromObject(otherLease), otherLease)).To(Succeed())
+				Expect(otherLease.Spec.HolderIdentity).To(BeNil())
+			})
+		})
+	})
+
+	Context("with drain", func() {
+		BeforeEach(func() {
+			rollout = &config.OperatingSystemConfigRolloutConfig{
+				MaxUnavailable: intstr.FromInt32(1),
+				LeaseDuration:  &metav1.Duration{Duration: time.Hour},
+				Drain:          &config.OperatingSystemConfigDrainConfig{Timeout: &metav1.Duration{Duration: time.Hour}},
+			}
+		})
+
+		// The pods are evicted by gardener-resource-manager, hence this test only simulates it by marking the node as
+		// drained.
+		markNodeDrained := func() {
+			By("Wait for node to be marked for draining")
+			Eventually(func(g Gomega) {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+				g.Expect(node.Spec.Unschedulable).To(BeTrue())
+				g.Expect(node.Annotations).To(HaveKey("worker.gardener.cloud/drain-start-time"))
+			}).WithTimeout(time.Minute).Should(Succeed())
+
+			By("Assert that the changes are not applied before the node has been drained")
+			Consistently(func(g Gomega) map[string]string {
+				updatedNode := &corev1.Node{}
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+				return updatedNode.Annotations
+			}).WithTimeout(2 * time.Second).ShouldNot(HaveKey("checksum/cloud-config-data"))
+
+			By("Mark node as drained")
+			patch := client.MergeFrom(node.DeepCopy())
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, "worker.gardener.cloud/drained", "true")
+			Expect(testClient.Patch(ctx, node, patch)).To(Succeed())
+		}
+
+		It("should drain the node before applying the changes and uncordon it once it is ready again", func() {
+			markNodeDrained()
+
+			By("Wait for node annotations to be updated")
+			Eventually(func(g Gomega) {
+				updatedNode := &corev1.Node{}
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+				g.Expect(updatedNode.Spec.Unschedulable).To(BeTrue())
+				g.Expect(updatedNode.Annotations).To(HaveKeyWithValue("checksum/cloud-config-data", utils.ComputeSHA256Hex(oscRaw)))
+			}).WithTimeout(time.Minute).Should(Succeed())
+
+			By("Report ready heartbeat and wait for node to be uncordoned")
+			Eventually(func(g Gomega) {
+				reportReadyHeartbeat(g, node)
+				updatedNode := &corev1.Node{}
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+				g.Expect(updatedNode.Spec.Unschedulable).To(BeFalse())
+				g.Expect(updatedNode.Annotations).NotTo(Or(
+					HaveKey("worker.gardener.cloud/drain-start-time"),
+					HaveKey("worker.gardener.cloud/drained"),
+				))
+			}).WithTimeout(time.Minute).WithPolling(time.Second).Should(Succeed())
+		})
+
+		Context("when the node has already been cordoned", func() {
+			BeforeEach(func() {
+				By("Cordon node")
+				patch := client.MergeFrom(node.DeepCopy())
+				node.Spec.Unschedulable = true
+				Expect(testClient.Patch(ctx, node, patch)).To(Succeed())
+			})
+
+			It("should drain the node before applying the changes and keep it cordoned", func() {
+				markNodeDrained()
+
+				By("Wait for node annotations to be updated")
+				Eventually(func(g Gomega) map[string]string {
+					updatedNode := &corev1.Node{}
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+					return updatedNode.Annotations
+				}).WithTimeout(time.Minute).Should(And(
+					Not(HaveKey("worker.gardener.cloud/cordoned-for-drain")),
+					HaveKeyWithValue("checksum/cloud-config-data", utils.ComputeSHA256Hex(oscRaw)),
+				))
+
+				By("Report ready heartbeat and assert that the node stays cordoned")
+				Eventually(func(g Gomega) {
+					reportReadyHeartbeat(g, node)
+					updatedNode := &corev1.Node{}
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+					g.Expect(updatedNode.Annotations).NotTo(HaveKey("worker.gardener.cloud/drain-start-time"))
+					g.Expect(updatedNode.Spec.Unschedulable).To(BeTrue())
+				}).WithTimeout(time.Minute).WithPolling(time.Second).Should(Succeed())
+			})
+		})
+	})
+
+	Context("with health gate", func() {
+		BeforeEach(func() {
+			healthGate = &config.OperatingSystemConfigHealthGate{
+				Timeout:        &metav1.Duration{Duration: 2 * time.Second},
+				KubeletHealthy: ptr.To(false),
+			}
+		})
+
+		It("should report that the configuration has been applied", func() {
+			By("Wait for node annotations to be updated")
+			Eventually(func(g Gomega) map[string]string {
+				updatedNode := &corev1.Node{}
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+				return updatedNode.Annotations
+			}).Should(HaveKeyWithValue("checksum/cloud-config-data", utils.ComputeSHA256Hex(oscRaw)))
+
+			By("Assert that node condition has been set")
+			Eventually(func(g Gomega) []corev1.NodeCondition {
+				updatedNode := &corev1.Node{}
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+				return updatedNode.Status.Conditions
+			}).Should(ContainElement(And(
+				HaveField("Type", corev1.NodeConditionType("OperatingSystemConfigApplied")),
+				HaveField("Status", corev1.ConditionTrue),
+				HaveField("Reason", "Applied"),
+			)))
+		})
+
+		It("should restore the previous configuration when the health gate fails", func() {
+			previousOSCChecksum := utils.ComputeSHA256Hex(oscRaw)
+
+			By("Wait for node annotations to be updated")
+			Eventually(func(g Gomega) map[string]string {
+				updatedNode := &corev1.Node{}
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+				return updatedNode.Annotations
+			}).Should(HaveKeyWithValue("checksum/cloud-config-data", previousOSCChecksum))
+
+			fakeDBus.SetActiveState(unit7.Name, "failed")
+
+			By("Update Operating System Config")
+			// the content of file5 (belonging to unit7) is changed, so unit7 is restarting (and fails)
+			// unit10 is added and must be removed again during the rollback
+			unit10 := extensionsv1alpha1.Unit{Name: "unit10", Content: ptr.To("#unit10")}
+			operatingSystemConfig.Spec.Units = append(operatingSystemConfig.Spec.Units, unit10)
+			operatingSystemConfig.Spec.Files[2].Content.Inline.Data = "changeme"
+
+			var err error
+			oscRaw, err = runtime.Encode(codec, operatingSystemConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Update Secret containing the operating system config")
+			patch := client.MergeFrom(oscSecret.DeepCopy())
+			oscSecret.Annotations["checksum/data-script"] = utils.ComputeSHA256Hex(oscRaw)
+			oscSecret.Data["osc.yaml"] = oscRaw
+			Expect(testClient.Patch(ctx, oscSecret, patch)).To(Succeed())
+
+			By("Wait for node condition to report the rollback")
+			Eventually(func(g Gomega) []corev1.NodeCondition {
+				updatedNode := &corev1.Node{}
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+				return updatedNode.Status.Conditions
+			}).Should(ContainElement(And(
+				HaveField("Type", corev1.NodeConditionType("OperatingSystemConfigApplied")),
+				HaveField("Status", corev1.ConditionFalse),
+				HaveField("Reason", "RolledBack"),
+			)))
+
+			By("Assert that files and units have been restored")
+			test.AssertFileOnDisk(fakeFS, file5.Path, "file5", 0750)
+			test.AssertFileOnDisk(fakeFS, "/etc/systemd/system/"+unit7.Name, "#unit7", 0600)
+			test.AssertNoFileOnDisk(fakeFS, "/etc/systemd/system/"+unit10.Name)
+
+			By("Assert that unit actions have been applied")
+			Expect(fakeDBus.Actions).To(ContainElements(
+				fakedbus.SystemdAction{Action: fakedbus.ActionEnable, UnitNames: []string{unit10.Name}},
+				fakedbus.SystemdAction{Action: fakedbus.ActionRestart, UnitNames: []string{unit10.Name}},
+				fakedbus.SystemdAction{Action: fakedbus.ActionDisable, UnitNames: []string{unit10.Name}},
+				fakedbus.SystemdAction{Action: fakedbus.ActionStop, UnitNames: []string{unit10.Name}},
+				fakedbus.SystemdAction{Action: fakedbus.ActionRestart, UnitNames: []string{unit7.Name}},
+			))
+
+			By("Assert that the node is still annotated with the checksum of the previous configuration")
+			updatedNode := &corev1.Node{}
+			Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+			Expect(updatedNode.Annotations).To(HaveKeyWithValue("checksum/cloud-config-data", previousOSCChecksum))
+
+			By("Assert that the failed configuration is recorded on the node")
+			Eventually(func(g Gomega) map[string]string {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+				return updatedNode.Annotations
+			}).Should(And(
+				HaveKeyWithValue("checksum/rolled-back-cloud-config-data", utils.ComputeSHA256Hex(oscRaw)),
+				HaveKeyWithValue("worker.gardener.cloud/osc-rollbacks", "1"),
+				HaveKey("worker.gardener.cloud/osc-last-rollback-time"),
+			))
+
+			By("Assert that the failed configuration is not applied again before the backoff has passed")
+			enableUnit10 := fakedbus.SystemdAction{Action: fakedbus.ActionEnable, UnitNames: []string{unit10.Name}}
+			Consistently(func() int {
+				return countActions(fakeDBus.Actions, enableUnit10)
+			}).Should(Equal(1))
+		})
+
+		It("should apply a rolled back configuration again after the backoff and give up after too many rollbacks", func() {
+			By("Wait for node annotations to be updated")
+			Eventually(func(g Gomega) map[string]string {
+				updatedNode := &corev1.Node{}
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+				return updatedNode.Annotations
+			}).Should(HaveKeyWithValue("checksum/cloud-config-data", utils.ComputeSHA256Hex(oscRaw)))
+
+			fakeDBus.SetActiveState(unit7.Name, "failed")
+
+			By("Update Operating System Config")
+			unit10 := extensionsv1alpha1.Unit{Name: "unit10", Content: ptr.To("#unit10")}
+			operatingSystemConfig.Spec.Units = append(operatingSystemConfig.Spec.Units, unit10)
+			operatingSystemConfig.Spec.Files[2].Content.Inline.Data = "changeme"
+
+			var err error
+			oscRaw, err = runtime.Encode(codec, operatingSystemConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Record previous rollbacks of the configuration on the node whose backoff has passed")
+			nodePatch := client.MergeFrom(node.DeepCopy())
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, "checksum/rolled-back-cloud-config-data", utils.ComputeSHA256Hex(oscRaw))
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, "worker.gardener.cloud/osc-rollbacks", "4")
+			metav1.SetMetaDataAnnotation(&node.ObjectMeta, "worker.gardener.cloud/osc-last-rollback-time", time.Now().Add(-time.Hour).UTC().Format(time.RFC3339))
+			Expect(testClient.Patch(ctx, node, nodePatch)).To(Succeed())
+
+			By("Update Secret containing the operating system config")
+			patch := client.MergeFrom(oscSecret.DeepCopy())
+			oscSecret.Annotations["checksum/data-script"] = utils.ComputeSHA256Hex(oscRaw)
+			oscSecret.Data["osc.yaml"] = oscRaw
+			Expect(testClient.Patch(ctx, oscSecret, patch)).To(Succeed())
+
+			By("Wait for node condition to report that applying the configuration failed permanently")
+			Eventually(func(g Gomega) []corev1.NodeCondition {
+				updatedNode := &corev1.Node{}
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+				return updatedNode.Status.Conditions
+			}).Should(ContainElement(And(
+				HaveField("Type", corev1.NodeConditionType("OperatingSystemConfigApplied")),
+				HaveField("Status", corev1.ConditionFalse),
+				HaveField("Reason", "ApplyAttemptsExhausted"),
+			)))
+
+			By("Assert that the rollback is recorded on the node")
+			Eventually(func(g Gomega) map[string]string {
+				updatedNode := &corev1.Node{}
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+				return updatedNode.Annotations
+			}).Should(HaveKeyWithValue("worker.gardener.cloud/osc-rollbacks", "5"))
+
+			By("Assert that the failed configuration is not applied again")
+			enableUnit10 := fakedbus.SystemdAction{Action: fakedbus.ActionEnable, UnitNames: []string{unit10.Name}}
+			Consistently(func() int {
+				return countActions(fakeDBus.Actions, enableUnit10)
+			}).Should(Equal(1))
+		})
+	})
+
+	Context("with drift detection", func() {
+		BeforeEach(func() {
+			syncPeriod = time.Second
+		})
+
+		It("should report drifted files and units and restore files with drift policy 'Restore'", func() {
+			By("Wait for node condition to report that there is no drift")
+			Eventually(func(g Gomega) []corev1.NodeCondition {
+				updatedNode := &corev1.Node{}
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+				return updatedNode.Status.Conditions
+			}).Should(ContainElement(And(
+				HaveField("Type", corev1.NodeConditionType("OperatingSystemConfigDrifted")),
+				HaveField("Status", corev1.ConditionFalse),
+				HaveField("Reason", "NoDriftDetected"),
+			)))
+
+			By("Update Operating System Config")
+			operatingSystemConfig.Status.ExtensionFiles[0].DriftPolicy = ptr.To(extensionsv1alpha1.FileDriftPolicyRestore)
+
+			var err error
+			oscRaw, err = runtime.Encode(codec, operatingSystemConfig)
+			Expect(err).NotTo(HaveOccurred())
+
+			By("Update Secret containing the operating system config")
+			patch := client.MergeFrom(oscSecret.DeepCopy())
+			oscSecret.Annotations["checksum/data-script"] = utils.ComputeSHA256Hex(oscRaw)
+			oscSecret.Data["osc.yaml"] = oscRaw
+			Expect(testClient.Patch(ctx, oscSecret, patch)).To(Succeed())
+
+			By("Wait for node annotations to be updated")
+			Eventually(func(g Gomega) map[string]string {
+				updatedNode := &corev1.Node{}
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+				return updatedNode.Annotations
+			}).Should(HaveKeyWithValue("checksum/cloud-config-data", utils.ComputeSHA256Hex(oscRaw)))
+
+			By("Manually change files and units on the node")
+			Expect(fakeFS.WriteFile(file1.Path, []byte("manually-changed"), 0777)).To(Succeed())
+			Expect(fakeFS.WriteFile(file2.Path, []byte("manually-changed"), 0600)).To(Succeed())
+			Expect(fakeFS.Chmod("/etc/systemd/system/"+unit1.Name, 0644)).To(Succeed())
+
+			By("Wait for node condition to report the drift")
+			Eventually(func(g Gomega) []corev1.NodeCondition {
+				updatedNode := &corev1.Node{}
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), updatedNode)).To(Succeed())
+				return updatedNode.Status.Conditions
+			}).WithTimeout(10 * time.Second).Should(ContainElement(And(
+				HaveField("Type", corev1.NodeConditionType("OperatingSystemConfigDrifted")),
+				HaveField("Status", corev1.ConditionTrue),
+				HaveField("Reason", "DriftDetected"),
+				HaveField("Message", And(
+					ContainSubstring(file1.Path),
+					ContainSubstring("/etc/systemd/system/"+unit1.Name),
+					Not(ContainSubstring(file2.Path)),
+				)),
+			)))
+
+			By("Assert that only the file with drift policy 'Restore' has been restored")
+			test.AssertFileOnDisk(fakeFS, file1.Path, "manually-changed", 0777)
+			test.AssertFileOnDisk(fakeFS, file2.Path, "file2", 0600)
+		})
+	})
 })
 
 type cancelFuncEnsurer struct {
@@ -543,3 +1034,20 @@ type cancelFuncEnsurer struct {
 func (c *cancelFuncEnsurer) cancel() {
 	c.called = true
 }
+
+func countActions(actions []fakedbus.SystemdAction, action fakedbus.SystemdAction) int {
+	var count int
+	for _, a := range actions {
+		if reflect.DeepEqual(a, action) {
+			count++
+		}
+	}
+	return count
+}
+
+func reportReadyHeartbeat(g Gomega, node *corev1.Node) {
+	g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+	patch := client.MergeFrom(node.DeepCopy())
+	node.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue, LastHeartbeatTime: metav1.Now()}}
+	g.Expect(testClient.Status().Patch(ctx, node, patch)).To(Succeed())
+}
@@ -20,6 +20,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -35,6 +36,7 @@ import (
 	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	v1beta1helper "github.com/gardener/gardener/pkg/apis/core/v1beta1/helper"
 	resourcesv1alpha1 "github.com/gardener/gardener/pkg/apis/resources/v1alpha1"
+	resourcesv1alpha1helper "github.com/gardener/gardener/pkg/apis/resources/v1alpha1/helper"
 	"github.com/gardener/gardener/pkg/controllerutils"
 	"github.com/gardener/gardener/pkg/resourcemanager/controller/garbagecollector/references"
 	"github.com/gardener/gardener/pkg/utils"
@@ -158,6 +160,36 @@ var _ = Describe("ManagedResource controller tests", func() {
 			})
 		})
 
+		Context("compressed secret data", func() {
+			var expectedChecksum string
+
+			BeforeEach(func() {
+				data := jsonDataForObject(configMap)
+				expectedChecksum = utils.ComputeSHA256Hex(data)
+
+				compressed, err := resourcesv1alpha1helper.Compress(resourcesv1alpha1.CompressionAlgorithmBrotli, data)
+				Expect(err).NotTo(HaveOccurred())
+
+				metav1.SetMetaDataAnnotation(&secretForManagedResource.ObjectMeta, resourcesv1alpha1.CompressionAlgorithm, resourcesv1alpha1.CompressionAlgorithmBrotli)
+				secretForManagedResource.Data = map[string][]byte{dataKey: compressed}
+			})
+
+			It("should decompress the data and compute the checksum based on the decompressed data", func() {
+				Eventually(func() error {
+					return testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)
+				}).Should(Succeed())
+				Expect(configMap.Data).To(Equal(map[string]string{"abc": "xyz"}))
+
+				Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+					g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+					return managedResource.Status.Conditions
+				}).Should(
+					ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionTrue), WithReason(resourcesv1alpha1.ConditionApplySucceeded)),
+				)
+				Expect(managedResource.Status.SecretsDataChecksum).To(PointTo(Equal(expectedChecksum)))
+			})
+		})
+
 		Context("missing TypeMeta in object", func() {
 			BeforeEach(func() {
 				newConfigMap := &corev1.ConfigMap{}
@@ -843,6 +875,122 @@ var _ = Describe("ManagedResource controller tests", func() {
 			})
 		})
 
+		Describe("Server-Side Apply", func() {
+			managedFieldsOf := func(g Gomega) []metav1.ManagedFieldsEntry {
+				g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+				return configMap.ManagedFields
+			}
+
+			triggerReconciliation := func() {
+				patch := client.MergeFrom(managedResource.DeepCopy())
+				metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, "gardener.cloud/operation", "reconcile")
+				ExpectWithOffset(1, testClient.Patch(ctx, managedResource, patch)).To(Succeed())
+			}
+
+			Context("server-side apply enabled from the beginning", func() {
+				BeforeEach(func() {
+					managedResource.Spec.ServerSideApply = &resourcesv1alpha1.ServerSideApply{}
+				})
+
+				It("should apply the resources with the server-side apply field manager", func() {
+					Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+						g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+						return managedResource.Status.Conditions
+					}).Should(
+						ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionTrue), WithReason(resourcesv1alpha1.ConditionApplySucceeded)),
+					)
+
+					Eventually(managedFieldsOf).Should(ContainElement(And(
+						HaveField("Manager", resourcesv1alpha1.FieldManagerServerSideApply),
+						HaveField("Operation", metav1.ManagedFieldsOperationApply),
+					)))
+					Expect(configMap.Data).To(Equal(map[string]string{"abc": "xyz"}))
+					Expect(configMap.Labels).To(HaveKeyWithValue(resourcesv1alpha1.ManagedBy, "gardener"))
+					Expect(configMap.Annotations).To(HaveKey(resourcesv1alpha1.OriginAnnotation))
+				})
+
+				It("should report conflicts with other field managers and resolve them when forcing conflicts", func() {
+					Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+						g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+						return managedResource.Status.Conditions
+					}).Should(
+						ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionTrue), WithReason(resourcesv1alpha1.ConditionApplySucceeded)),
+					)
+
+					By("Take over ownership of field with another field manager")
+					conflictingConfigMap := &corev1.ConfigMap{
+						TypeMeta:   configMap.TypeMeta,
+						ObjectMeta: metav1.ObjectMeta{Name: configMap.Name, Namespace: configMap.Namespace},
+						Data:       map[string]string{"abc": "other"},
+					}
+					Expect(testClient.Patch(ctx, conflictingConfigMap, client.Apply, client.FieldOwner("other-manager"), client.ForceOwnership)).To(Succeed())
+
+					triggerReconciliation()
+
+					Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+						g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+						return managedResource.Status.Conditions
+					}).Should(
+						ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionFalse), WithReason(resourcesv1alpha1.ConditionApplyConflict), WithMessageSubstrings("other-manager", ".data.abc")),
+					)
+					Expect(testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+					Expect(configMap.Data).To(HaveKeyWithValue("abc", "other"))
+
+					By("Force conflicts")
+					patch := client.MergeFrom(managedResource.DeepCopy())
+					managedResource.Spec.ServerSideApply.ForceConflicts = ptr.To(true)
+					Expect(testClient.Patch(ctx, managedResource, patch)).To(Succeed())
+
+					Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+						g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+						return managedResource.Status.Conditions
+					}).Should(
+						ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionTrue), WithReason(resourcesv1alpha1.ConditionApplySucceeded)),
+					)
+					Expect(testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+					Expect(configMap.Data).To(HaveKeyWithValue("abc", "xyz"))
+				})
+			})
+
+			Context("switching from merge mode to server-side apply", func() {
+				It("should hand over the ownership of fields and remove fields which are no longer desired", func() {
+					Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+						g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+						return managedResource.Status.Conditions
+					}).Should(
+						ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionTrue), WithReason(resourcesv1alpha1.ConditionApplySucceeded)),
+					)
+
+					By("Enable server-side apply")
+					configMap.Data = map[string]string{"foo": "bar"}
+					patch := client.MergeFrom(secretForManagedResource.DeepCopy())
+					secretForManagedResource.Data = secretDataForObject(configMap, dataKey)
+					Expect(testClient.Patch(ctx, secretForManagedResource, patch)).To(Succeed())
+
+					patch = client.MergeFrom(managedResource.DeepCopy())
+					managedResource.Spec.ServerSideApply = &resourcesv1alpha1.ServerSideApply{}
+					Expect(testClient.Patch(ctx, managedResource, patch)).To(Succeed())
+
+					Eventually(func(g Gomega) map[string]string {
+						g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(configMap), configMap)).To(Succeed())
+						return configMap.Data
+					}).Should(Equal(map[string]string{"foo": "bar"}))
+
+					Eventually(managedFieldsOf).Should(And(
+						ContainElement(HaveField("Manager", resourcesv1alpha1.FieldManagerServerSideApply)),
+						Not(ContainElement(HaveField("Operation", metav1.ManagedFieldsOperationUpdate))),
+					))
+
+					Eventually(func(g Gomega) []gardencorev1beta1.Condition {
+						g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+						return managedResource.Status.Conditions
+					}).Should(
+						ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionTrue), WithReason(resourcesv1alpha1.ConditionApplySucceeded)),
+					)
+				})
+			})
+		})
+
 		Describe("Preserve Replica/Resource", func() {
 			var (
 				defaultPodTemplateSpec *corev1.PodTemplateSpec
@@ -1032,6 +1180,104 @@ var _ = Describe("ManagedResource controller tests", func() {
 					})
 				})
 			})
+
+			Describe("Server-Side Apply", func() {
+				var newPodTemplateSpec *corev1.PodTemplateSpec
+
+				BeforeEach(func() {
+					newPodTemplateSpec = defaultPodTemplateSpec.DeepCopy()
+					newPodTemplateSpec.Spec.Containers[0].Resources.Requests = corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("35m"),
+						corev1.ResourceMemory: resource.MustParse("35Mi"),
+					}
+
+					deployment.SetAnnotations(map[string]string{
+						resourcesv1alpha1.PreserveReplicas:  "true",
+						resourcesv1alpha1.PreserveResources: "true",
+					})
+					deployment.Spec.Replicas = ptr.To(int32(3))
+					secretForManagedResource.Data = secretDataForObject(deployment, "deployment.yaml")
+				})
+
+				waitForAppliedCondition := func() {
+					EventuallyWithOffset(1, func(g Gomega) []gardencorev1beta1.Condition {
+						g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(managedResource), managedResource)).To(Succeed())
+						return managedResource.Status.Conditions
+					}).Should(
+						ContainCondition(OfType(resourcesv1alpha1.ResourcesApplied), WithStatus(gardencorev1beta1.ConditionTrue), WithReason(resourcesv1alpha1.ConditionApplySucceeded)),
+					)
+				}
+
+				triggerReconciliation := func() {
+					patch := client.MergeFrom(managedResource.DeepCopy())
+					metav1.SetMetaDataAnnotation(&managedResource.ObjectMeta, "gardener.cloud/operation", "reconcile")
+					ExpectWithOffset(1, testClient.Patch(ctx, managedResource, patch)).To(Succeed())
+				}
+
+				Context("server-side apply enabled from the beginning", func() {
+					BeforeEach(func() {
+						managedResource.Spec.ServerSideApply = &resourcesv1alpha1.ServerSideApply{}
+					})
+
+					It("should create the resource with the desired replicas and resources and preserve changes of other field managers", func() {
+						waitForAppliedCondition()
+
+						Expect(testClient.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+						Expect(deployment.Spec.Replicas).To(PointTo(BeEquivalentTo(3)))
+						Expect(deployment.Spec.Template.Spec.Containers[0].Resources).To(DeepEqual(defaultPodTemplateSpec.Spec.Containers[0].Resources))
+
+						By("Change replicas and resources with other field managers")
+						patch := client.MergeFrom(deployment.DeepCopy())
+						deployment.Spec.Replicas = ptr.To(int32(5))
+						Expect(testClient.Patch(ctx, deployment, patch, client.FieldOwner("horizontal-pod-autoscaler"))).To(Succeed())
+
+						patch = client.MergeFrom(deployment.DeepCopy())
+						deployment.Spec.Template = *newPodTemplateSpec
+						Expect(testClient.Patch(ctx, deployment, patch, client.FieldOwner("vpa-updater"))).To(Succeed())
+
+						triggerReconciliation()
+
+						Consistently(func(g Gomega) {
+							g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+							g.Expect(deployment.Spec.Replicas).To(PointTo(BeEquivalentTo(5)))
+							g.Expect(deployment.Spec.Template.Spec.Containers[0].Resources).To(DeepEqual(newPodTemplateSpec.Spec.Containers[0].Resources))
+						}).Should(Succeed())
+					})
+				})
+
+				Context("switching from merge mode to server-side apply", func() {
+					It("should keep the ownership of the preserved fields with the legacy field manager and not remove them", func() {
+						waitForAppliedCondition()
+
+						By("Enable server-side apply")
+						patch := client.MergeFrom(managedResource.DeepCopy())
+						managedResource.Spec.ServerSideApply = &resourcesv1alpha1.ServerSideApply{}
+						Expect(testClient.Patch(ctx, managedResource, patch)).To(Succeed())
+
+						Eventually(func(g Gomega) []metav1.ManagedFieldsEntry {
+							g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+							return deployment.ManagedFields
+						}).Should(ContainElement(HaveField("Manager", resourcesv1alpha1.FieldManagerServerSideApply)))
+						waitForAppliedCondition()
+
+						By("Reconcile again")
+						triggerReconciliation()
+
+						Consistently(func(g Gomega) {
+							g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(deployment), deployment)).To(Succeed())
+							g.Expect(deployment.Spec.Replicas).To(PointTo(BeEquivalentTo(3)))
+							g.Expect(deployment.Spec.Template.Spec.Containers[0].Resources).To(DeepEqual(defaultPodTemplateSpec.Spec.Containers[0].Resources))
+						}).Should(Succeed())
+
+						for _, entry := range deployment.ManagedFields {
+							if entry.Manager == resourcesv1alpha1.FieldManagerServerSideApply {
+								Expect(string(entry.FieldsV1.Raw)).NotTo(ContainSubstring(`"f:replicas"`))
+								Expect(string(entry.FieldsV1.Raw)).NotTo(ContainSubstring(`"f:cpu"`))
+							}
+						}
+					})
+				})
+			})
 		})
 	})
 
@@ -0,0 +1,104 @@
+// Copyright 2024 SAP SE or an SAP affiliate company. All rights reserved. This file is licensed under the Apache Software License, v. 2 except as noted otherwise in the LICENSE file
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package drain_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/uuid"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/gardener/gardener/pkg/utils"
+	. "github.com/gardener/gardener/pkg/utils/test/matchers"
+)
+
+var _ = Describe("Node drain tests", func() {
+	var (
+		resourceName string
+
+		node *corev1.Node
+		pod  *corev1.Pod
+	)
+
+	BeforeEach(func() {
+		resourceName = "test-" + utils.ComputeSHA256Hex([]byte(uuid.NewUUID()))[:8]
+
+		node = &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   resourceName,
+				Labels: map[string]string{testID: testRunID},
+			},
+		}
+
+		pod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resourceName,
+				Namespace: testNamespace.Name,
+			},
+			Spec: corev1.PodSpec{
+				NodeName:                      node.Name,
+				TerminationGracePeriodSeconds: ptr.To[int64](0),
+				Containers:                    []corev1.Container{{Name: "app", Image: "app"}},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		By("Create Node")
+		Expect(testClient.Create(ctx, node)).To(Succeed())
+		log.Info("Created Node for test", "node", node.Name)
+
+		DeferCleanup(func() {
+			By("Delete Node")
+			Expect(testClient.Delete(ctx, node)).To(Or(Succeed(), BeNotFoundError()))
+		})
+
+		By("Create Pod")
+		Expect(testClient.Create(ctx, pod)).To(Succeed())
+
+		DeferCleanup(func() {
+			By("Delete Pod")
+			Expect(testClient.Delete(ctx, pod)).To(Or(Succeed(), BeNotFoundError()))
+		})
+	})
+
+	It("should not evict pods from nodes which are not marked for draining", func() {
+		Consistently(func() error {
+			return testClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)
+		}).Should(Succeed())
+	})
+
+	It("should evict the pods and mark the node as drained once gardener-node-agent has started to drain it", func() {
+		By("Cordon node and mark it for draining")
+		patch := client.MergeFrom(node.DeepCopy())
+		node.Spec.Unschedulable = true
+		metav1.SetMetaDataAnnotation(&node.ObjectMeta, "worker.gardener.cloud/drain-start-time", "2024-01-01T00:00:00Z")
+		Expect(testClient.Patch(ctx, node, patch)).To(Succeed())
+
+		By("Wait for pod to be evicted")
+		Eventually(func() error {
+			return testClient.Get(ctx, client.ObjectKeyFromObject(pod), pod)
+		}).Should(BeNotFoundError())
+
+		By("Wait for node to be marked as drained")
+		Eventually(func(g Gomega) map[string]string {
+			g.Expect(testClient.Get(ctx, client.ObjectKeyFromObject(node), node)).To(Succeed())
+			return node.Annotations
+		}).Should(HaveKeyWithValue("worker.gardener.cloud/drained", "true"))
+	})
+})